@@ -0,0 +1,44 @@
+import { queryOptions } from "@tanstack/react-query";
+import { z } from "zod/v4";
+import { baseFetchJSON, v1Url } from ".";
+
+export const accessEventSchema = z.object({
+  id: z.int(),
+  type: z.enum(["view", "token", "auth_failed", "download", "file_view"]),
+  createdAt: z.string(),
+  address: z.string(),
+  userAgent: z.string(),
+  bytesServed: z.int(),
+  fileId: z.uuid().nullable(),
+  fileName: z.string().nullable(),
+  recipient: z.string().nullable(),
+});
+export type AccessEvent = z.infer<typeof accessEventSchema>;
+
+export async function fetchTicketEvents(ticketId: string) {
+  return baseFetchJSON(
+    v1Url(`/ticket/${ticketId}/event`),
+    accessEventSchema.array(),
+  );
+}
+
+export function ticketEventsQueryOptions(ticketId: string) {
+  return queryOptions({
+    queryKey: ["TICKET", ticketId, "EVENT"],
+    queryFn: () => fetchTicketEvents(ticketId),
+  });
+}
+
+export async function fetchGrantEvents(grantId: string) {
+  return baseFetchJSON(
+    v1Url(`/grant/${grantId}/event`),
+    accessEventSchema.array(),
+  );
+}
+
+export function grantEventsQueryOptions(grantId: string) {
+  return queryOptions({
+    queryKey: ["GRANT", grantId, "EVENT"],
+    queryFn: () => fetchGrantEvents(grantId),
+  });
+}
@@ -20,15 +20,26 @@ function v1FileUrl(url: string) {
 export const fileSchema = z.object({
   id: z.string(),
   sha512: z.string(),
+  sha256: z.string().nullable(),
   name: z.string(),
   size: z.int(),
   timesDownloaded: z.int(),
   createdAt: z.coerce.date(),
   lastDownloaded: z.coerce.date().nullable(),
+  timesViewed: z.int(),
+  lastViewed: z.coerce.date().nullable(),
   estimatedExpiry: z.coerce.date().nullable(),
+  scanStatus: z.enum(["pending", "clean", "infected"]),
+  mimeType: z.string().nullable(),
+  previewType: z.string().nullable(),
+  viewable: z.boolean(),
   owner: publicUserSchema,
 });
 
+export function getFilePreviewUrl(fileId: string) {
+  return v1FileUrl(`/${fileId}/preview`);
+}
+
 export function fetchReceivedFiles() {
   return baseFetchJSON(v1FileUrl("/received"), fileSchema.array());
 }
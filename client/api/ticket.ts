@@ -11,7 +11,7 @@ import i18n, { availableLanguages } from "~/i18n";
 import { errorNotification, successNotification } from "~/util/notifications";
 import { ProgressHandle } from "~/util/progress";
 import { baseFetchJSON, expiryType, FetchError, v1Url } from ".";
-import { fileSchema } from "./file";
+import { fileSchema, filesKey } from "./file";
 import { publicUserSchema } from "./user";
 
 export const ticketsKey = ["TICKET"];
@@ -42,6 +42,7 @@ export const createTicketSchemaFactory = (t: typeof i18n.t) =>
     files: z.file().array().min(1),
     creatorLang: z.enum(availableLanguages),
     receiverLang: z.enum(availableLanguages),
+    viewsCountAsDownloads: z.boolean(),
   });
 
 export const createTicketSchema = createTicketSchemaFactory(i18n.t);
@@ -54,6 +55,7 @@ export const ticketSchema = z.object({
   createdAt: z.coerce.date(),
   estimatedExpiry: z.coerce.date().nullable(),
   comment: z.string().nullable(),
+  viewsCountAsDownloads: z.boolean(),
 });
 
 export type Ticket = z.infer<typeof ticketSchema>;
@@ -89,6 +91,260 @@ export function useCreateTicketMutation(progressHandle?: ProgressHandle) {
   });
 }
 
+const fileExpirySchema = z.object({
+  id: z.uuid(),
+  expiryType: expiryType,
+  expiryTotalDays: z.int(),
+  expiryDaysSinceLastDownload: z.int(),
+  expiryTotalDownloads: z.int(),
+});
+
+export const patchTicketSchema = z
+  .object({
+    comment: z.string(),
+    password: z.string().min(1),
+    expiryType: expiryType,
+    expiryTotalDays: z.int(),
+    expiryDaysSinceLastDownload: z.int(),
+    expiryTotalDownloads: z.int(),
+    emailOnDownload: z.email().array(),
+    viewsCountAsDownloads: z.boolean(),
+    files: fileExpirySchema.array(),
+    email: z.email().array(),
+    emailPassword: z.boolean(),
+    receiverLang: z.enum(availableLanguages),
+  })
+  .partial();
+export type PatchTicket = z.infer<typeof patchTicketSchema>;
+
+export async function patchTicket({
+  ticketId,
+  data,
+}: {
+  ticketId: string;
+  data: PatchTicket;
+}) {
+  const resp = await axios.patch(v1TicketUrl(`/${ticketId}`), data);
+  return ticketSchema.parse(resp.data);
+}
+
+export function usePatchTicketMutation() {
+  const { t } = useTranslation("notifications");
+  const queryClient = useQueryClient();
+  return useMutation<
+    Ticket,
+    AxiosError,
+    { ticketId: string; data: PatchTicket }
+  >({
+    mutationFn: patchTicket,
+    onSuccess() {
+      queryClient.invalidateQueries({ queryKey: ticketsKey });
+      successNotification(t("ticket_patch_success"));
+    },
+    onError() {
+      errorNotification(t("ticket_patch_failed"));
+    },
+  });
+}
+
+export async function addTicketFiles({
+  ticketId,
+  files,
+  email,
+  receiverLang,
+}: {
+  ticketId: string;
+  files: File[];
+  email?: string[];
+  receiverLang?: string;
+}) {
+  const resp = await axios.postForm(v1TicketUrl(`/${ticketId}/file`), {
+    files,
+    email,
+    receiverLang,
+  });
+  return ticketSchema.parse(resp.data);
+}
+
+export function useAddTicketFilesMutation() {
+  const { t } = useTranslation("notifications");
+  const queryClient = useQueryClient();
+  return useMutation<
+    Ticket,
+    AxiosError,
+    Parameters<typeof addTicketFiles>[0]
+  >({
+    mutationFn: addTicketFiles,
+    onSuccess() {
+      queryClient.invalidateQueries({ queryKey: ticketsKey });
+      successNotification(t("ticket_patch_success"));
+    },
+    onError() {
+      errorNotification(t("ticket_patch_failed"));
+    },
+  });
+}
+
+export async function removeTicketFile({
+  ticketId,
+  fileId,
+  email,
+}: {
+  ticketId: string;
+  fileId: string;
+  email?: string[];
+}) {
+  const resp = await axios.delete(v1TicketUrl(`/${ticketId}/file/${fileId}`), {
+    params: { email },
+    paramsSerializer: { indexes: null },
+  });
+  return ticketSchema.parse(resp.data);
+}
+
+export function useRemoveTicketFileMutation() {
+  const { t } = useTranslation("notifications");
+  const queryClient = useQueryClient();
+  return useMutation<
+    Ticket,
+    AxiosError,
+    Parameters<typeof removeTicketFile>[0]
+  >({
+    mutationFn: removeTicketFile,
+    onSuccess() {
+      queryClient.invalidateQueries({ queryKey: ticketsKey });
+      successNotification(t("ticket_patch_success"));
+    },
+    onError() {
+      errorNotification(t("ticket_patch_failed"));
+    },
+  });
+}
+
+export const reshareTicketSchema = createTicketSchema
+  .omit({ files: true })
+  .extend({ files: z.uuid().array().min(1) });
+export type ReshareTicket = z.infer<typeof reshareTicketSchema>;
+
+export async function reshareTicket(data: ReshareTicket) {
+  const resp = await axios.post(v1TicketUrl("/reshare"), data);
+  return ticketSchema.parse(resp.data);
+}
+
+export function useReshareTicketMutation() {
+  const { t } = useTranslation("notifications");
+  const queryClient = useQueryClient();
+  return useMutation<Ticket, AxiosError, ReshareTicket>({
+    mutationFn: reshareTicket,
+    onSuccess() {
+      queryClient.invalidateQueries({ queryKey: ticketsKey });
+      queryClient.invalidateQueries({ queryKey: filesKey });
+      successNotification(t("ticket_reshare_success"));
+    },
+    onError() {
+      errorNotification(t("ticket_reshare_failed"));
+    },
+  });
+}
+
+export const recipientSchema = z.object({
+  id: z.uuid(),
+  name: z.string(),
+  email: z.email().nullable(),
+  link: z.string().nullable(),
+  createdAt: z.string(),
+  revokedAt: z.string().nullable(),
+  timesDownloaded: z.int(),
+  lastDownloaded: z.string().nullable(),
+});
+export type Recipient = z.infer<typeof recipientSchema>;
+
+export const createRecipientSchema = z.object({
+  name: z.string().min(1).max(255),
+  email: z.email().optional(),
+  receiverLang: z.enum(availableLanguages).optional(),
+});
+export type CreateRecipient = z.infer<typeof createRecipientSchema>;
+
+function recipientsKey(ticketId: string) {
+  return [...ticketsKey, ticketId, "RECIPIENT"];
+}
+
+export async function fetchRecipients(ticketId: string) {
+  return baseFetchJSON(
+    v1TicketUrl(`/${ticketId}/recipient`),
+    recipientSchema.array(),
+  );
+}
+
+export function recipientsQueryOptions(ticketId: string) {
+  return queryOptions({
+    queryKey: recipientsKey(ticketId),
+    queryFn: () => fetchRecipients(ticketId),
+  });
+}
+
+export async function createRecipient({
+  ticketId,
+  data,
+}: {
+  ticketId: string;
+  data: CreateRecipient;
+}) {
+  const resp = await axios.post(v1TicketUrl(`/${ticketId}/recipient`), data);
+  return recipientSchema.parse(resp.data);
+}
+
+export function useCreateRecipientMutation() {
+  const { t } = useTranslation("notifications");
+  const queryClient = useQueryClient();
+  return useMutation<
+    Recipient,
+    AxiosError,
+    Parameters<typeof createRecipient>[0]
+  >({
+    mutationFn: createRecipient,
+    onSuccess(_, { ticketId }) {
+      queryClient.invalidateQueries({ queryKey: recipientsKey(ticketId) });
+      successNotification(t("recipient_create_success"));
+    },
+    onError() {
+      errorNotification(t("recipient_create_failed"));
+    },
+  });
+}
+
+export async function revokeRecipient({
+  ticketId,
+  recipientId,
+}: {
+  ticketId: string;
+  recipientId: string;
+}) {
+  const resp = await axios.delete(
+    v1TicketUrl(`/${ticketId}/recipient/${recipientId}`),
+  );
+  return recipientSchema.parse(resp.data);
+}
+
+export function useRevokeRecipientMutation() {
+  const { t } = useTranslation("notifications");
+  const queryClient = useQueryClient();
+  return useMutation<
+    Recipient,
+    AxiosError,
+    Parameters<typeof revokeRecipient>[0]
+  >({
+    mutationFn: revokeRecipient,
+    onSuccess(_, { ticketId }) {
+      queryClient.invalidateQueries({ queryKey: recipientsKey(ticketId) });
+      successNotification(t("recipient_revoke_success"));
+    },
+    onError() {
+      errorNotification(t("recipient_revoke_failed"));
+    },
+  });
+}
+
 export function deleteTicket(ticketId: string) {
   return axios.delete(v1TicketUrl(`/${ticketId}`));
 }
@@ -154,3 +410,37 @@ export function getTicketShareFileUrl({
 }) {
   return v1Url(`/share/ticket/${ticketId}/file/${fileId}`);
 }
+
+export function getTicketShareFilePreviewUrl({
+  fileId,
+  ticketId,
+}: {
+  ticketId: string;
+  fileId: string;
+}) {
+  return v1Url(`/share/ticket/${ticketId}/file/${fileId}/preview`);
+}
+
+export function getTicketShareFileViewUrl({
+  fileId,
+  ticketId,
+}: {
+  ticketId: string;
+  fileId: string;
+}) {
+  return v1Url(`/share/ticket/${ticketId}/file/${fileId}/view`);
+}
+
+export function getTicketShareChecksumsUrl({
+  ticketId,
+  algorithm,
+}: {
+  ticketId: string;
+  algorithm: "sha256" | "sha512";
+}) {
+  return v1Url(`/share/ticket/${ticketId}/${algorithm.toUpperCase()}SUMS`);
+}
+
+export function getTicketShareMetalinkUrl(ticketId: string) {
+  return v1Url(`/share/ticket/${ticketId}/metalink`);
+}
@@ -20,6 +20,20 @@ export const userSchema = publicUserSchema.extend({
   submittedGrants: z.int(),
   activeGrants: z.int(),
   totalDataSize: z.int(),
+  physicalDataSize: z.int(),
+});
+
+export const quotaSchema = z.object({
+  usedBytes: z.int(),
+  maxBytes: z.int(),
+  activeTickets: z.int(),
+  maxActiveTickets: z.int(),
+  activeGrants: z.int(),
+  maxActiveGrants: z.int(),
+});
+
+export const meSchema = userSchema.extend({
+  quota: quotaSchema,
 });
 
 function v1UserUrl(url: string) {
@@ -27,7 +41,7 @@ function v1UserUrl(url: string) {
 }
 
 export async function fetchMe() {
-  return baseFetchJSON(v1UserUrl("/me"), userSchema);
+  return baseFetchJSON(v1UserUrl("/me"), meSchema);
 }
 
 export const meQueryOptions = queryOptions({
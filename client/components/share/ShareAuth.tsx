@@ -21,6 +21,8 @@ export interface ShareAuthProps<TData> extends Omit<
   dataFetcher: (password: string) => Promise<TData>;
   prompt: React.ReactNode;
   submitButtonLabel: React.ReactNode;
+  // password of a personal link, which opens the share without asking
+  initialPassword?: string;
 }
 
 function TokenGenerator({
@@ -50,9 +52,12 @@ export function ShareAuth<TData>({
   shareTokenGenerator,
   dataQueryKey,
   submitButtonLabel,
+  initialPassword,
 }: ShareAuthProps<TData>) {
   const form = useForm({ initialValues: { password: "" } });
-  const [password, setPassword] = useState<string | null>(null);
+  const [password, setPassword] = useState<string | null>(
+    initialPassword || null,
+  );
   const { t } = useTranslation("share");
   const fetchData = useCallback(() => {
     if (password) {
@@ -3,6 +3,7 @@ import {
   Box,
   Button,
   Center,
+  Checkbox,
   Flex,
   Group,
   SimpleGrid,
@@ -62,6 +63,7 @@ function NewTicketForm() {
       emailOnDownload: null,
       creatorLang: i18n.language as AvailableLanguage,
       files: [],
+      viewsCountAsDownloads: false,
     },
     validate: translatedValidator,
   });
@@ -134,6 +136,12 @@ function NewTicketForm() {
             variant="ticket"
             label={t("label_expiry")}
           />
+          <Checkbox
+            {...form.getInputProps("viewsCountAsDownloads", {
+              type: "checkbox",
+            })}
+            label={t("label_views_count_as_downloads")}
+          />
           <MyEmailSection form={form} variant="download" />
           <Flex justify="space-evenly">
             <Button
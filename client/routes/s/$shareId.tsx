@@ -12,7 +12,8 @@ function RouteComponent() {
     fetch(`${window.fransRootPath}/s/${shareId}`).then((resp) => {
       const nextLocation = new URL(resp.url).pathname;
 
-      navigate({ to: nextLocation });
+      // keep the token of personal links
+      navigate({ to: nextLocation, hash: window.location.hash.slice(1) });
     });
   }, [navigate, shareId]);
 
@@ -1,12 +1,14 @@
-import { List, Stack, Text } from "@mantine/core";
-import { createFileRoute } from "@tanstack/react-router";
+import { Anchor, Group, List, Stack, Text } from "@mantine/core";
+import { createFileRoute, useLocation } from "@tanstack/react-router";
 import React, { useCallback, useContext, useMemo } from "react";
 import { useTranslation } from "react-i18next";
 import z from "zod/v4";
 import {
   fetchTicketShare,
   fetchTicketShareAccessToken,
+  getTicketShareChecksumsUrl,
   getTicketShareFileUrl,
+  getTicketShareMetalinkUrl,
   Ticket,
 } from "~/api/ticket";
 import { FileRef } from "~/components/file/FileRef";
@@ -49,6 +51,26 @@ function TicketShare() {
           </List.Item>
         ))}
       </List>
+      <Group gap="xs">
+        <Text>{t("checksums")}:</Text>
+        <Anchor
+          href={getTicketShareChecksumsUrl({
+            ticketId: ticket.id,
+            algorithm: "sha256",
+          })}
+        >
+          SHA256SUMS
+        </Anchor>
+        <Anchor
+          href={getTicketShareChecksumsUrl({
+            ticketId: ticket.id,
+            algorithm: "sha512",
+          })}
+        >
+          SHA512SUMS
+        </Anchor>
+        <Anchor href={getTicketShareMetalinkUrl(ticket.id)}>Metalink</Anchor>
+      </Group>
       <Text>
         <b>{t("comment")}: </b>
         {ticket.comment}
@@ -68,6 +90,8 @@ function RouteComponent() {
     (password: string) => fetchTicketShareAccessToken({ ticketId, password }),
     [ticketId],
   );
+  // personal links of recipients carry their token in the fragment
+  const recipientToken = useLocation({ select: (l) => l.hash });
   const { t } = useTranslation("share");
   return (
     <ShareAuth
@@ -77,6 +101,7 @@ function RouteComponent() {
       shareTokenGenerator={shareTokenGenerator}
       prompt={t("ticket_prompt")}
       submitButtonLabel={t("ticket_submit")}
+      initialPassword={recipientToken}
     >
       <TicketShare />
     </ShareAuth>
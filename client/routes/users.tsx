@@ -27,6 +27,7 @@ function RouteComponent() {
           <Table.Td>{t("grants_submitted")}</Table.Td>
           <Table.Td>{t("grants_active")}</Table.Td>
           <Table.Td>{t("current_size")}</Table.Td>
+          <Table.Td>{t("stored_size")}</Table.Td>
         </Table.Tr>
       </Table.Thead>
       <Table.Tbody>
@@ -45,6 +46,7 @@ function RouteComponent() {
             <Table.Td>{user.submittedGrants}</Table.Td>
             <Table.Td>{user.activeGrants}</Table.Td>
             <Table.Td>{fileSizeFormatter(user.totalDataSize)}</Table.Td>
+            <Table.Td>{fileSizeFormatter(user.physicalDataSize)}</Table.Td>
           </Table.Tr>
         ))}
       </Table.Tbody>
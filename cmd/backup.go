@@ -0,0 +1,61 @@
+package cmd
+
+import (
+	"log"
+
+	"codeberg.org/jvllmr/frans/internal/db"
+	"codeberg.org/jvllmr/frans/internal/services"
+	fransCron "codeberg.org/jvllmr/frans/internal/tasks"
+	"github.com/spf13/cobra"
+)
+
+var backupCmd = &cobra.Command{
+	Use:   "backup <archive>",
+	Short: "Write users, shares and stored files to a backup archive",
+	Long: "Write users, tickets, grants, files and their stored blobs to a backup archive. " +
+		"With --incremental-from only blobs which are not part of the given backup or its bases " +
+		"are written. Sessions, unfinished uploads and previews are not backed up. " +
+		"Blobs are copied as stored, so restoring encrypted files requires the same master keys.",
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		configValue, dbCon := getConfigAndDBClient()
+		defer func() {
+			if err := dbCon.Close(); err != nil {
+				log.Fatalf("could not close db connection: %v", err)
+			}
+		}()
+		basePath, err := cmd.Flags().GetString("incremental-from")
+		if err != nil {
+			log.Fatalf("could not read incremental-from flag: %v", err)
+		}
+		fs := services.NewFileService(configValue, dbCon)
+		if err := fransCron.BackupTask(fs, args[0], basePath); err != nil {
+			log.Fatalf("Could not write backup: %v", err)
+		}
+	},
+}
+
+var restoreCmd = &cobra.Command{
+	Use:   "restore <archive> [incremental archive...]",
+	Short: "Rebuild an empty instance from backup archives",
+	Long: "Rebuild an empty instance from backup archives. " +
+		"Incremental backups are given after the backups they are based on, " +
+		"starting with a full backup. The configured database type may differ from the " +
+		"one the backup was taken from.",
+	Args: cobra.MinimumNArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		configValue, dbCon := getConfigAndDBClient()
+		defer func() {
+			if err := dbCon.Close(); err != nil {
+				log.Fatalf("could not close db connection: %v", err)
+			}
+		}()
+		if !configValue.DevMode {
+			db.Migrate(configValue.DBConfig)
+		}
+		fs := services.NewFileService(configValue, dbCon)
+		if err := fransCron.RestoreTask(fs, args); err != nil {
+			log.Fatalf("Could not restore backup: %v", err)
+		}
+	},
+}
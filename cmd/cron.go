@@ -2,9 +2,11 @@ package cmd
 
 import (
 	"log"
+	"time"
 
 	"codeberg.org/jvllmr/frans/internal/config"
 	"codeberg.org/jvllmr/frans/internal/ent"
+	"codeberg.org/jvllmr/frans/internal/mail"
 	"codeberg.org/jvllmr/frans/internal/services"
 	fransCron "codeberg.org/jvllmr/frans/internal/tasks"
 	"github.com/robfig/cron/v3"
@@ -47,6 +49,74 @@ func startCronScheduler(configValue config.Config, db *ent.Client) {
 		log.Fatalf("create grants cronjob: %v", err)
 	}
 
+	us := services.NewUploadService(configValue, db)
+	_, err = cronRunner.AddFunc("@every 1m", func() {
+		fransCron.UploadLifecycleTask(db, us)
+	})
+
+	if err != nil {
+		log.Fatalf("create uploads cronjob: %v", err)
+	}
+
+	_, err = cronRunner.AddFunc("@every 1h", func() {
+		fransCron.GarbageCollectionTask(
+			fs,
+			time.Duration(configValue.GCGraceHours)*time.Hour,
+			configValue.GCDryRun,
+		)
+	})
+
+	if err != nil {
+		log.Fatalf("create garbage collection cronjob: %v", err)
+	}
+
+	if configValue.AccessEventRetentionDays > 0 {
+		aes := services.NewAccessEventService(db)
+		_, err = cronRunner.AddFunc("@every 1h", func() {
+			fransCron.AccessEventRetentionTask(
+				aes,
+				time.Duration(configValue.AccessEventRetentionDays)*24*time.Hour,
+			)
+		})
+
+		if err != nil {
+			log.Fatalf("create access event retention cronjob: %v", err)
+		}
+	}
+
+	if configValue.FilesAntivirus.Enabled {
+		mailer := mail.NewMailer(configValue)
+		ss := services.NewScanService(configValue, db, &mailer)
+		_, err = cronRunner.AddFunc("@every 1m", func() {
+			fransCron.ScanFilesTask(ss)
+		})
+
+		if err != nil {
+			log.Fatalf("create file scan cronjob: %v", err)
+		}
+	}
+
+	if configValue.FilesPreviews.Enabled {
+		ps := services.NewPreviewService(configValue, db)
+		_, err = cronRunner.AddFunc("@every 1m", func() {
+			fransCron.GeneratePreviewsTask(ps)
+		})
+
+		if err != nil {
+			log.Fatalf("create preview generation cronjob: %v", err)
+		}
+	}
+
+	if configValue.VerifyStorageSchedule != "" {
+		_, err = cronRunner.AddFunc(configValue.VerifyStorageSchedule, func() {
+			fransCron.VerifyStorageTask(fs)
+		})
+
+		if err != nil {
+			log.Fatalf("create storage verification cronjob: %v", err)
+		}
+	}
+
 	cronRunner.Run()
 
 }
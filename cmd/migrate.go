@@ -5,6 +5,8 @@ import (
 
 	"codeberg.org/jvllmr/frans/internal/config"
 	"codeberg.org/jvllmr/frans/internal/db"
+	"codeberg.org/jvllmr/frans/internal/services"
+	fransCron "codeberg.org/jvllmr/frans/internal/tasks"
 	"github.com/spf13/cobra"
 )
 
@@ -19,3 +21,20 @@ var migrateCmd = &cobra.Command{
 		db.Migrate(dbConfig.DBConfig)
 	},
 }
+
+var migrateStorageCmd = &cobra.Command{
+	Use:   "migrate-storage",
+	Short: "Move stored files to the configured files layout",
+	Long: "Move stored files to the configured files layout. " +
+		"It is safe to run while frans is serving files and to run it again after it was interrupted.",
+	Run: func(cmd *cobra.Command, args []string) {
+		configValue, dbCon := getConfigAndDBClient()
+		defer func() {
+			if err := dbCon.Close(); err != nil {
+				log.Fatalf("could not close db connection: %v", err)
+			}
+		}()
+		fs := services.NewFileService(configValue, dbCon)
+		fransCron.MigrateStorageTask(fs)
+	},
+}
@@ -46,13 +46,30 @@ func Main() {
 		log.Fatalf("could not setup logging: %v", err)
 	}
 
+	gcTaskCommand.Flags().Bool("dry-run", false, "Only log what would be removed")
+	backupCmd.Flags().String("incremental-from", "", "Backup to leave out the blobs of")
 	taskCommand.AddCommand(
 		sessionLifecycleTaskCommand,
 		ticketLifecycleTaskCommand,
 		fileLifecycleTaskCommand,
 		grantLifecycleTaskCommand,
+		uploadLifecycleTaskCommand,
+		rotateKeysTaskCommand,
+		verifyStorageTaskCommand,
+		scanFilesTaskCommand,
+		generatePreviewsTaskCommand,
+		gcTaskCommand,
+		accessEventRetentionTaskCommand,
+	)
+	rootCmd.AddCommand(
+		taskCommand,
+		cronCmd,
+		serveCmd,
+		migrateCmd,
+		migrateStorageCmd,
+		backupCmd,
+		restoreCmd,
 	)
-	rootCmd.AddCommand(taskCommand, cronCmd, serveCmd, migrateCmd)
 
 	cobra.CheckErr(rootCmd.Execute())
 }
@@ -11,6 +11,7 @@ import (
 	"codeberg.org/jvllmr/frans/internal/ent"
 	"codeberg.org/jvllmr/frans/internal/otel"
 	"codeberg.org/jvllmr/frans/internal/routes"
+	"codeberg.org/jvllmr/frans/internal/services"
 	"github.com/gin-gonic/gin"
 	"github.com/spf13/cobra"
 	"go.opentelemetry.io/contrib/instrumentation/github.com/gin-gonic/gin/otelgin"
@@ -35,6 +36,14 @@ func startGin(ctx context.Context, configValue config.Config, db *ent.Client) {
 		os.Exit(1)
 	}
 	defer metricsCleanup()
+	if err := services.NewDiskService(configValue).RegisterMetrics(); err != nil {
+		slog.Error("Setup failed", "err", err)
+		os.Exit(1)
+	}
+	if err := services.NewStorageAccountingService(db).RegisterMetrics(); err != nil {
+		slog.Error("Setup failed", "err", err)
+		os.Exit(1)
+	}
 	r := gin.New()
 	r.Use(otelgin.Middleware(otel.TracingService))
 	err = routes.SetupRootRouter(r, configValue, db)
@@ -2,7 +2,10 @@ package cmd
 
 import (
 	"log"
+	"log/slog"
+	"time"
 
+	"codeberg.org/jvllmr/frans/internal/mail"
 	"codeberg.org/jvllmr/frans/internal/services"
 	fransCron "codeberg.org/jvllmr/frans/internal/tasks"
 	"github.com/spf13/cobra"
@@ -71,3 +74,122 @@ var fileLifecycleTaskCommand = &cobra.Command{
 		fransCron.FileLifecycleTask(db, fs)
 	},
 }
+
+var uploadLifecycleTaskCommand = &cobra.Command{
+	Use:   "lifecycle-upload",
+	Short: "Delete expired resumable uploads",
+	Run: func(cmd *cobra.Command, args []string) {
+		configValue, db := getConfigAndDBClient()
+		defer func() {
+			if err := db.Close(); err != nil {
+				log.Fatalf("could not close db connection: %v", err)
+			}
+		}()
+		us := services.NewUploadService(configValue, db)
+		fransCron.UploadLifecycleTask(db, us)
+	},
+}
+
+var rotateKeysTaskCommand = &cobra.Command{
+	Use:   "rotate-keys",
+	Short: "Wrap all data keys of encrypted files with the current master key",
+	Run: func(cmd *cobra.Command, args []string) {
+		configValue, db := getConfigAndDBClient()
+		defer func() {
+			if err := db.Close(); err != nil {
+				log.Fatalf("could not close db connection: %v", err)
+			}
+		}()
+		fs := services.NewFileService(configValue, db)
+		fransCron.RotateKeysTask(db, fs)
+	},
+}
+
+var verifyStorageTaskCommand = &cobra.Command{
+	Use:   "verify-storage",
+	Short: "Rehash all stored files and report missing, altered and orphaned blobs",
+	Run: func(cmd *cobra.Command, args []string) {
+		configValue, db := getConfigAndDBClient()
+		defer func() {
+			if err := db.Close(); err != nil {
+				log.Fatalf("could not close db connection: %v", err)
+			}
+		}()
+		fs := services.NewFileService(configValue, db)
+		fransCron.VerifyStorageTask(fs)
+	},
+}
+
+var scanFilesTaskCommand = &cobra.Command{
+	Use:   "scan-files",
+	Short: "Scan files which were not scanned for malware yet",
+	Run: func(cmd *cobra.Command, args []string) {
+		configValue, db := getConfigAndDBClient()
+		defer func() {
+			if err := db.Close(); err != nil {
+				log.Fatalf("could not close db connection: %v", err)
+			}
+		}()
+		mailer := mail.NewMailer(configValue)
+		ss := services.NewScanService(configValue, db, &mailer)
+		fransCron.ScanFilesTask(ss)
+	},
+}
+
+var generatePreviewsTaskCommand = &cobra.Command{
+	Use:   "generate-previews",
+	Short: "Generate previews for files which were not previewed yet",
+	Run: func(cmd *cobra.Command, args []string) {
+		configValue, db := getConfigAndDBClient()
+		defer func() {
+			if err := db.Close(); err != nil {
+				log.Fatalf("could not close db connection: %v", err)
+			}
+		}()
+		fransCron.GeneratePreviewsTask(services.NewPreviewService(configValue, db))
+	},
+}
+
+var gcTaskCommand = &cobra.Command{
+	Use:   "gc",
+	Short: "Remove orphaned blobs, temporary files and unused file data",
+	Run: func(cmd *cobra.Command, args []string) {
+		configValue, db := getConfigAndDBClient()
+		defer func() {
+			if err := db.Close(); err != nil {
+				log.Fatalf("could not close db connection: %v", err)
+			}
+		}()
+		dryRun, err := cmd.Flags().GetBool("dry-run")
+		if err != nil {
+			log.Fatalf("could not read dry-run flag: %v", err)
+		}
+		fs := services.NewFileService(configValue, db)
+		fransCron.GarbageCollectionTask(
+			fs,
+			time.Duration(configValue.GCGraceHours)*time.Hour,
+			dryRun || configValue.GCDryRun,
+		)
+	},
+}
+
+var accessEventRetentionTaskCommand = &cobra.Command{
+	Use:   "prune-access-events",
+	Short: "Delete access events which are older than the configured retention",
+	Run: func(cmd *cobra.Command, args []string) {
+		configValue, db := getConfigAndDBClient()
+		defer func() {
+			if err := db.Close(); err != nil {
+				log.Fatalf("could not close db connection: %v", err)
+			}
+		}()
+		if configValue.AccessEventRetentionDays == 0 {
+			slog.Info("Access events are kept forever, nothing to delete")
+			return
+		}
+		fransCron.AccessEventRetentionTask(
+			services.NewAccessEventService(db),
+			time.Duration(configValue.AccessEventRetentionDays)*24*time.Hour,
+		)
+	},
+}
@@ -0,0 +1,115 @@
+package antivirus
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"strings"
+	"time"
+)
+
+// chunkSize is the size of the chunks the content is streamed to clamd in
+const chunkSize = 64 * 1024
+
+var ErrScanFailed = errors.New("scan failed")
+
+type ScanResult struct {
+	Infected bool
+	// Signature names the detected malware
+	Signature string
+}
+
+// Clamd scans content with the INSTREAM command of a ClamAV daemon
+type Clamd struct {
+	network string
+	address string
+	timeout time.Duration
+}
+
+func (cd *Clamd) dial(ctx context.Context) (net.Conn, error) {
+	dialer := net.Dialer{Timeout: cd.timeout}
+	conn, err := dialer.DialContext(ctx, cd.network, cd.address)
+	if err != nil {
+		return nil, fmt.Errorf("connect to clamd: %w", err)
+	}
+	deadline := time.Now().Add(cd.timeout)
+	if ctxDeadline, ok := ctx.Deadline(); ok && ctxDeadline.Before(deadline) {
+		deadline = ctxDeadline
+	}
+	if err := conn.SetDeadline(deadline); err != nil {
+		_ = conn.Close()
+		return nil, err
+	}
+	return conn, nil
+}
+
+// Scan streams the content of reader to clamd
+func (cd *Clamd) Scan(ctx context.Context, reader io.Reader) (ScanResult, error) {
+	conn, err := cd.dial(ctx)
+	if err != nil {
+		return ScanResult{}, err
+	}
+	defer func() { _ = conn.Close() }()
+	writer := bufio.NewWriterSize(conn, chunkSize+4)
+	if _, err := writer.WriteString("zINSTREAM\x00"); err != nil {
+		return ScanResult{}, fmt.Errorf("send to clamd: %w", err)
+	}
+	chunk := make([]byte, chunkSize)
+	for {
+		n, readErr := io.ReadFull(reader, chunk)
+		if n > 0 {
+			if err := binary.Write(writer, binary.BigEndian, uint32(n)); err != nil {
+				return ScanResult{}, fmt.Errorf("send to clamd: %w", err)
+			}
+			if _, err := writer.Write(chunk[:n]); err != nil {
+				return ScanResult{}, fmt.Errorf("send to clamd: %w", err)
+			}
+		}
+		if errors.Is(readErr, io.EOF) || errors.Is(readErr, io.ErrUnexpectedEOF) {
+			break
+		}
+		if readErr != nil {
+			return ScanResult{}, readErr
+		}
+	}
+	// a chunk of length zero ends the stream
+	if err := binary.Write(writer, binary.BigEndian, uint32(0)); err != nil {
+		return ScanResult{}, fmt.Errorf("send to clamd: %w", err)
+	}
+	if err := writer.Flush(); err != nil {
+		return ScanResult{}, fmt.Errorf("send to clamd: %w", err)
+	}
+	reply, err := bufio.NewReader(conn).ReadBytes(0)
+	if err != nil {
+		return ScanResult{}, fmt.Errorf("read reply of clamd: %w", err)
+	}
+	return parseReply(string(bytes.TrimSuffix(reply, []byte{0})))
+}
+
+// parseReply interprets replies like "stream: OK" or "stream: Eicar-Signature FOUND"
+func parseReply(reply string) (ScanResult, error) {
+	result := strings.TrimSpace(strings.TrimPrefix(reply, "stream:"))
+	switch {
+	case result == "OK":
+		return ScanResult{}, nil
+	case strings.HasSuffix(result, " FOUND"):
+		return ScanResult{Infected: true, Signature: strings.TrimSuffix(result, " FOUND")}, nil
+	default:
+		return ScanResult{}, fmt.Errorf("%w: clamd replied %q", ErrScanFailed, reply)
+	}
+}
+
+// NewClamd creates a client for clamd listening on address,
+// which is either tcp://host:port or unix:///path/to/socket
+func NewClamd(address string, timeout time.Duration) (*Clamd, error) {
+	network, path, ok := strings.Cut(address, "://")
+	if !ok || (network != "tcp" && network != "unix") {
+		return nil, fmt.Errorf("clamd address %s is not supported by frans", address)
+	}
+	return &Clamd{network: network, address: path, timeout: timeout}, nil
+}
@@ -25,10 +25,66 @@ type SMTPConfig struct {
 	SMTPInsecureSkipVerify bool    `mapstructure:"insecureSkipVerify"`
 }
 
+type FilesS3Config struct {
+	Endpoint        string `mapstructure:"endpoint"`
+	Region          string `mapstructure:"region"`
+	Bucket          string `mapstructure:"bucket"`
+	Prefix          string `mapstructure:"prefix"`
+	AccessKeyID     string `mapstructure:"access_key_id"`
+	SecretAccessKey string `mapstructure:"secret_access_key"`
+	Insecure        bool   `mapstructure:"insecure"`
+}
+
+type FilesBackendConfig struct {
+	Type string        `mapstructure:"type"`
+	S3   FilesS3Config `mapstructure:"s3"`
+}
+
+type FilesEncryptionConfig struct {
+	Key     string   `mapstructure:"key"`
+	OldKeys []string `mapstructure:"old_keys"`
+}
+
+type FilesCompressionConfig struct {
+	Enabled bool `mapstructure:"enabled"`
+	Level   int  `mapstructure:"level"`
+}
+
+type FilesAntivirusConfig struct {
+	Enabled        bool   `mapstructure:"enabled"`
+	Address        string `mapstructure:"address"`
+	TimeoutSeconds uint16 `mapstructure:"timeout_seconds"`
+}
+
+// FilesTypesConfig restricts which files can be uploaded. Entries are either
+// file extensions like .exe or MIME types like application/pdf or image/*.
+type FilesTypesConfig struct {
+	Allow          []string `mapstructure:"allow"`
+	Deny           []string `mapstructure:"deny"`
+	RejectMismatch bool     `mapstructure:"reject_mismatch"`
+}
+
+type FilesPreviewsConfig struct {
+	Enabled       bool   `mapstructure:"enabled"`
+	MaxDimension  uint16 `mapstructure:"max_dimension"`
+	MaxSourceSize int64  `mapstructure:"max_source_size"`
+	PdfCommand    string `mapstructure:"pdf_command"`
+}
+
 type FilesConfig struct {
-	FilesDir string `mapstructure:"dir"`
-	MaxSizes int64  `mapstructure:"max_size"`
-	MaxFiles uint8  `mapstructure:"max_per_upload"`
+	FilesDir         string                 `mapstructure:"dir"`
+	MaxSizes         int64                  `mapstructure:"max_size"`
+	MaxFiles         uint8                  `mapstructure:"max_per_upload"`
+	HighWatermark    float64                `mapstructure:"high_watermark"`
+	LowWatermark     float64                `mapstructure:"low_watermark"`
+	FilesLayout      string                 `mapstructure:"layout"`
+	FilesBackend     FilesBackendConfig     `mapstructure:"backend"`
+	FilesEncryption  FilesEncryptionConfig  `mapstructure:"encryption"`
+	FilesCompression FilesCompressionConfig `mapstructure:"compression"`
+	FilesAntivirus   FilesAntivirusConfig   `mapstructure:"antivirus"`
+	FilesTypes       FilesTypesConfig       `mapstructure:"types"`
+	FilesPreviews    FilesPreviewsConfig    `mapstructure:"previews"`
+	InlineTypes      []string               `mapstructure:"inline_types"`
 }
 
 type ExpiryConfig struct {
@@ -43,6 +99,34 @@ type GrantExpiryConfig struct {
 	GrantDefaultExpiryTotalDays           uint8 `mapstructure:"total_days"`
 }
 
+type TasksConfig struct {
+	VerifyStorageSchedule string `mapstructure:"verify_storage_schedule"`
+	GCGraceHours          uint16 `mapstructure:"gc_grace_hours"`
+	GCDryRun              bool   `mapstructure:"gc_dry_run"`
+	// access events older than this are deleted, 0 keeps them forever
+	AccessEventRetentionDays uint16 `mapstructure:"access_event_retention_days"`
+}
+
+type QuotaLimits struct {
+	MaxBytes         int64 `mapstructure:"max_bytes"`
+	MaxActiveTickets int   `mapstructure:"max_active_tickets"`
+	MaxActiveGrants  int   `mapstructure:"max_active_grants"`
+}
+
+// GroupQuotaConfig overrides the default quota for members of an OIDC group.
+// Limits which are not set fall back to the default quota.
+type GroupQuotaConfig struct {
+	Group            string `mapstructure:"group"`
+	MaxBytes         *int64 `mapstructure:"max_bytes"`
+	MaxActiveTickets *int   `mapstructure:"max_active_tickets"`
+	MaxActiveGrants  *int   `mapstructure:"max_active_grants"`
+}
+
+type QuotaConfig struct {
+	QuotaLimits `mapstructure:",squash"`
+	QuotaGroups []GroupQuotaConfig `mapstructure:"groups"`
+}
+
 type ColorsConfig struct {
 	Color       string     `mapstructure:"preset"`
 	CustomColor [10]string `mapstructure:"custom_preset"`
@@ -55,6 +139,8 @@ type Config struct {
 	FilesConfig       `mapstructure:"files"`
 	ExpiryConfig      `mapstructure:"expiry"`
 	GrantExpiryConfig `mapstructure:"grant_expiry"`
+	TasksConfig       `mapstructure:"tasks"`
+	QuotaConfig       `mapstructure:"quota"`
 	LogConfig         `mapstructure:"log"`
 	ColorsConfig      `mapstructure:"colors"`
 	Otel              `mapstructure:"otel"`
@@ -95,6 +181,41 @@ func NewConfig() (Config, error) {
 	fransConf.SetDefault("files.dir", "files")
 	fransConf.SetDefault("files.max_per_upload", 20)
 	fransConf.SetDefault("files.max_size", 2_000_000_000) // 2GB
+	fransConf.SetDefault("files.high_watermark", 95)
+	fransConf.SetDefault("files.low_watermark", 90)
+	fransConf.SetDefault("files.layout", FilesLayoutSharded)
+	fransConf.SetDefault("files.backend.type", FilesBackendLocal)
+	fransConf.SetDefault("files.backend.s3.endpoint", "")
+	fransConf.SetDefault("files.backend.s3.region", "")
+	fransConf.SetDefault("files.backend.s3.bucket", "")
+	fransConf.SetDefault("files.backend.s3.prefix", "")
+	fransConf.SetDefault("files.backend.s3.access_key_id", "")
+	fransConf.SetDefault("files.backend.s3.secret_access_key", "")
+	fransConf.SetDefault("files.backend.s3.insecure", false)
+	fransConf.SetDefault("files.encryption.key", "")
+	fransConf.SetDefault("files.encryption.old_keys", []string{})
+	fransConf.SetDefault("files.compression.enabled", false)
+	fransConf.SetDefault("files.compression.level", 3)
+	fransConf.SetDefault("files.antivirus.enabled", false)
+	fransConf.SetDefault("files.antivirus.address", "tcp://127.0.0.1:3310")
+	fransConf.SetDefault("files.antivirus.timeout_seconds", 60)
+	fransConf.SetDefault("files.types.allow", []string{})
+	fransConf.SetDefault("files.types.deny", []string{})
+	fransConf.SetDefault("files.types.reject_mismatch", false)
+	fransConf.SetDefault("files.previews.enabled", false)
+	fransConf.SetDefault("files.previews.max_dimension", 320)
+	fransConf.SetDefault("files.previews.max_source_size", 50_000_000) // 50MB
+	fransConf.SetDefault("files.previews.pdf_command", "pdftoppm")
+	fransConf.SetDefault("files.inline_types", []string{
+		"application/pdf",
+		"image/png",
+		"image/jpeg",
+		"image/gif",
+		"image/webp",
+		"text/plain",
+		"audio/mpeg",
+		"video/mp4",
+	})
 
 	fransConf.SetDefault("expiry.days_since_last_download", 7)
 	fransConf.SetDefault("expiry.total_downloads", 10)
@@ -104,6 +225,16 @@ func NewConfig() (Config, error) {
 	fransConf.SetDefault("grant_expiry.total_uploads", 10)
 	fransConf.SetDefault("grant_expiry.total_days", 30)
 
+	fransConf.SetDefault("tasks.verify_storage_schedule", "")
+	fransConf.SetDefault("tasks.gc_grace_hours", 24)
+	fransConf.SetDefault("tasks.gc_dry_run", false)
+	fransConf.SetDefault("tasks.access_event_retention_days", 90)
+
+	fransConf.SetDefault("quota.max_bytes", 0)
+	fransConf.SetDefault("quota.max_active_tickets", 0)
+	fransConf.SetDefault("quota.max_active_grants", 0)
+	fransConf.SetDefault("quota.groups", []GroupQuotaConfig{})
+
 	setDBConfigDefaults(fransConf)
 
 	fransConf.SetDefault("oidc.issuer", "")
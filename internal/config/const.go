@@ -17,6 +17,11 @@ const (
 	UserGinContext     = "user"
 	ShareTicketContext = "shareTicket"
 	ShareGrantContext  = "shareGrant"
+	// identifies the share access of a request for download accounting.
+	// It is empty if the credentials of the request are shared by several clients.
+	ShareDownloadTokenContext = "shareDownloadToken"
+	// the recipient who opened a ticket with their personal link
+	ShareRecipientContext = "shareRecipient"
 )
 
 const (
@@ -26,4 +31,44 @@ const (
 	TicketExpiryTypeCustom = "custom"
 )
 
+const (
+	FilesBackendLocal = "local"
+	FilesBackendS3    = "s3"
+)
+
+const (
+	FileScanStatusPending  = "pending"
+	FileScanStatusClean    = "clean"
+	FileScanStatusInfected = "infected"
+)
+
+const (
+	FilePreviewStatusPending     = "pending"
+	FilePreviewStatusReady       = "ready"
+	FilePreviewStatusUnavailable = "unavailable"
+)
+
+const (
+	FilesLayoutFlat    = "flat"
+	FilesLayoutSharded = "sharded"
+)
+
+const (
+	// a share page was opened
+	AccessEventTypeView = "view"
+	// an access token for downloads was issued
+	AccessEventTypeToken = "token"
+	// a wrong password was given for a share
+	AccessEventTypeAuthFailed = "auth_failed"
+	AccessEventTypeDownload   = "download"
+	// a file was viewed in the browser
+	AccessEventTypeFileView = "file_view"
+)
+
 const ShareAccessTokenExpirySeconds = 10
+
+// Unfinished or unattached resumable uploads are deleted after this time
+const UploadExpiryHours = 24
+
+// Progress of downloads which were not completed is deleted after this time
+const DownloadProgressExpiryHours = 24
@@ -0,0 +1,223 @@
+package db
+
+import (
+	"context"
+
+	"codeberg.org/jvllmr/frans/internal/encryption"
+	"codeberg.org/jvllmr/frans/internal/ent"
+	"codeberg.org/jvllmr/frans/internal/ent/file"
+	"codeberg.org/jvllmr/frans/internal/ent/filedata"
+	"codeberg.org/jvllmr/frans/internal/ent/hook"
+	"codeberg.org/jvllmr/frans/internal/ent/user"
+	"github.com/google/uuid"
+)
+
+// StoredBlobSize returns the size of the blob of fileData in the storage backend
+func StoredBlobSize(fileData *ent.FileData) int64 {
+	contentSize := int64(fileData.Size)
+	if fileData.CompressedSize != nil {
+		contentSize = int64(*fileData.CompressedSize)
+	}
+	if fileData.KeyID != nil {
+		return encryption.EncryptedSize(contentSize)
+	}
+	return contentSize
+}
+
+// fileUsage is a user storing a FileData through one or more files
+type fileUsage struct {
+	ownerID uuid.UUID
+	dataID  string
+}
+
+// useStorageAccounting registers the hooks maintaining the storage counters.
+// Every user counts the bytes of all their files as logical bytes and the bytes of
+// every blob they reference once as physical bytes. The counters are updated
+// with the client of the mutation, so they are part of its transaction.
+func useStorageAccounting(client *ent.Client) {
+	client.File.Use(fileAccountingHook)
+	client.FileData.Use(
+		hook.On(storedSizeHook, ent.OpCreate),
+		hook.On(hook.If(storedSizeUpdateHook, changesBlob), ent.OpUpdate|ent.OpUpdateOne),
+	)
+}
+
+// changesBlob reports whether a FileData mutation changes how its blob is stored
+func changesBlob(_ context.Context, m ent.Mutation) bool {
+	for _, field := range []string{
+		filedata.FieldSize,
+		filedata.FieldCompressedSize,
+		filedata.FieldKeyID,
+	} {
+		if _, exists := m.Field(field); exists || m.FieldCleared(field) {
+			return true
+		}
+	}
+	return false
+}
+
+// queryFileUsages returns the usages of the files with the given IDs
+func queryFileUsages(
+	ctx context.Context,
+	client *ent.Client,
+	ids []uuid.UUID,
+) ([]fileUsage, error) {
+	files, err := client.File.Query().
+		Where(file.IDIn(ids...)).
+		WithOwner(func(uq *ent.UserQuery) { uq.Select(user.FieldID) }).
+		WithData(func(fdq *ent.FileDataQuery) { fdq.Select(filedata.FieldID) }).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	usages := make([]fileUsage, len(files))
+	for i, fileValue := range files {
+		usages[i] = fileUsage{
+			ownerID: fileValue.Edges.Owner.ID,
+			dataID:  fileValue.Edges.Data.ID,
+		}
+	}
+	return usages, nil
+}
+
+// applyUsageChanges updates the counters of users after files were added or removed.
+// changes holds the number of added (positive) or removed (negative) files per usage.
+func applyUsageChanges(
+	ctx context.Context,
+	client *ent.Client,
+	changes map[fileUsage]int64,
+) error {
+	for usage, count := range changes {
+		if count == 0 {
+			continue
+		}
+		fileData, err := client.FileData.Query().
+			Where(filedata.ID(usage.dataID)).
+			Select(filedata.FieldSize, filedata.FieldStoredSize).
+			Only(ctx)
+		if err != nil {
+			return err
+		}
+		copies, err := client.File.Query().
+			Where(
+				file.HasOwnerWith(user.ID(usage.ownerID)),
+				file.HasDataWith(filedata.ID(usage.dataID)),
+			).
+			Count(ctx)
+		if err != nil {
+			return err
+		}
+		update := client.User.UpdateOneID(usage.ownerID).
+			AddTotalDataSize(count * int64(fileData.Size))
+		// physical bytes only change with the first and the last copy of a blob
+		if count > 0 && int64(copies) == count {
+			update.AddPhysicalDataSize(fileData.StoredSize)
+		} else if count < 0 && copies == 0 {
+			update.AddPhysicalDataSize(-fileData.StoredSize)
+		}
+		if err := update.Exec(ctx); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func fileAccountingHook(next ent.Mutator) ent.Mutator {
+	return hook.FileFunc(func(ctx context.Context, m *ent.FileMutation) (ent.Value, error) {
+		changes := make(map[fileUsage]int64)
+		var ids []uuid.UUID
+		switch {
+		case m.Op().Is(ent.OpCreate):
+			ownerID, ownerExists := m.OwnerID()
+			dataID, dataExists := m.DataID()
+			if ownerExists && dataExists {
+				changes[fileUsage{ownerID: ownerID, dataID: dataID}] += 1
+			}
+		case m.Op().Is(ent.OpDelete) || m.Op().Is(ent.OpDeleteOne) ||
+			m.OwnerCleared() || len(m.OwnerIDs()) > 0 ||
+			m.DataCleared() || len(m.DataIDs()) > 0:
+			var err error
+			ids, err = m.IDs(ctx)
+			if err != nil {
+				return nil, err
+			}
+			usages, err := queryFileUsages(ctx, m.Client(), ids)
+			if err != nil {
+				return nil, err
+			}
+			for _, usage := range usages {
+				changes[usage] -= 1
+			}
+		default:
+			return next.Mutate(ctx, m)
+		}
+
+		value, err := next.Mutate(ctx, m)
+		if err != nil {
+			return nil, err
+		}
+		if m.Op().Is(ent.OpUpdate) || m.Op().Is(ent.OpUpdateOne) {
+			usages, err := queryFileUsages(ctx, m.Client(), ids)
+			if err != nil {
+				return nil, err
+			}
+			for _, usage := range usages {
+				changes[usage] += 1
+			}
+		}
+		return value, applyUsageChanges(ctx, m.Client(), changes)
+	})
+}
+
+func storedSizeHook(next ent.Mutator) ent.Mutator {
+	return hook.FileDataFunc(func(ctx context.Context, m *ent.FileDataMutation) (ent.Value, error) {
+		size, _ := m.Size()
+		fileData := &ent.FileData{Size: size}
+		if compressedSize, exists := m.CompressedSize(); exists {
+			fileData.CompressedSize = &compressedSize
+		}
+		if keyID, exists := m.KeyID(); exists {
+			fileData.KeyID = &keyID
+		}
+		m.SetStoredSize(StoredBlobSize(fileData))
+		return next.Mutate(ctx, m)
+	})
+}
+
+// storedSizeUpdateHook recomputes the stored size of FileData whose blob was rewritten
+// and moves the difference to the physical bytes of every user referencing it
+func storedSizeUpdateHook(next ent.Mutator) ent.Mutator {
+	return hook.FileDataFunc(func(ctx context.Context, m *ent.FileDataMutation) (ent.Value, error) {
+		ids, err := m.IDs(ctx)
+		if err != nil {
+			return nil, err
+		}
+		value, err := next.Mutate(ctx, m)
+		if err != nil {
+			return nil, err
+		}
+		client := m.Client()
+		fileDatas, err := client.FileData.Query().Where(filedata.IDIn(ids...)).All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, fileData := range fileDatas {
+			delta := StoredBlobSize(fileData) - fileData.StoredSize
+			if delta == 0 {
+				continue
+			}
+			err := client.FileData.UpdateOne(fileData).AddStoredSize(delta).Exec(ctx)
+			if err != nil {
+				return nil, err
+			}
+			err = client.User.Update().
+				Where(user.HasFilesWith(file.HasDataWith(filedata.ID(fileData.ID)))).
+				AddPhysicalDataSize(delta).
+				Exec(ctx)
+			if err != nil {
+				return nil, err
+			}
+		}
+		return value, nil
+	})
+}
@@ -32,7 +32,9 @@ func NewDBClient(dbConfig config.DBConfig) (*ent.Client, error) {
 		}
 
 		drv := entsql.OpenDB(dialect.Postgres, db)
-		return ent.NewClient(ent.Driver(drv)), nil
+		client := ent.NewClient(ent.Driver(drv))
+		useStorageAccounting(client)
+		return client, nil
 	case "mysql":
 		if dbConfig.DBPort == 0 {
 			dbConfig.DBPort = 3306
@@ -58,5 +60,6 @@ func NewDBClient(dbConfig config.DBConfig) (*ent.Client, error) {
 	if err != nil {
 		return nil, fmt.Errorf("connect db: %w", err)
 	}
+	useStorageAccounting(client)
 	return client, nil
 }
@@ -0,0 +1,2 @@
+-- Modify "file_data" table
+ALTER TABLE `file_data` ADD COLUMN `key_id` varchar(255) NULL, ADD COLUMN `encrypted_key` longblob NULL;
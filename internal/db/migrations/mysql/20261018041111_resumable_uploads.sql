@@ -0,0 +1,16 @@
+-- Create "uploads" table
+CREATE TABLE `uploads` (
+  `id` char(36) NOT NULL,
+  `name` varchar(255) NOT NULL,
+  `size` bigint NOT NULL,
+  `offset` bigint NOT NULL DEFAULT 0,
+  `created_at` timestamp NOT NULL,
+  `expiry` timestamp NOT NULL,
+  `grant_uploads` char(36) NULL,
+  `user_uploads` char(36) NULL,
+  PRIMARY KEY (`id`),
+  INDEX `uploads_grants_uploads` (`grant_uploads`),
+  INDEX `uploads_users_uploads` (`user_uploads`),
+  CONSTRAINT `uploads_grants_uploads` FOREIGN KEY (`grant_uploads`) REFERENCES `grants` (`id`) ON UPDATE NO ACTION ON DELETE SET NULL,
+  CONSTRAINT `uploads_users_uploads` FOREIGN KEY (`user_uploads`) REFERENCES `users` (`id`) ON UPDATE NO ACTION ON DELETE SET NULL
+) CHARSET utf8mb4 COLLATE utf8mb4_bin;
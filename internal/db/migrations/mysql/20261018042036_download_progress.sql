@@ -0,0 +1,13 @@
+-- Create "download_progresses" table
+CREATE TABLE `download_progresses` (
+  `id` bigint NOT NULL AUTO_INCREMENT,
+  `token` varchar(255) NOT NULL,
+  `ranges` json NULL,
+  `updated_at` timestamp NOT NULL,
+  `version` bigint NOT NULL DEFAULT 0,
+  `file_download_progresses` char(36) NOT NULL,
+  PRIMARY KEY (`id`),
+  INDEX `download_progresses_files_download_progresses` (`file_download_progresses`),
+  UNIQUE INDEX `downloadprogress_token_file_download_progresses` (`token`, `file_download_progresses`),
+  CONSTRAINT `download_progresses_files_download_progresses` FOREIGN KEY (`file_download_progresses`) REFERENCES `files` (`id`) ON UPDATE NO ACTION ON DELETE CASCADE
+) CHARSET utf8mb4 COLLATE utf8mb4_bin;
@@ -0,0 +1,9 @@
+-- Create "storage_verifications" table
+CREATE TABLE `storage_verifications` (
+  `id` bigint NOT NULL AUTO_INCREMENT,
+  `started_at` timestamp NOT NULL,
+  `finished_at` timestamp NULL,
+  `checked_blobs` bigint NOT NULL DEFAULT 0,
+  `issues` json NULL,
+  PRIMARY KEY (`id`)
+) CHARSET utf8mb4 COLLATE utf8mb4_bin;
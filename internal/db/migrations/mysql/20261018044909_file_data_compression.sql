@@ -0,0 +1,2 @@
+-- Modify "file_data" table
+ALTER TABLE `file_data` ADD COLUMN `compression` varchar(255) NULL, ADD COLUMN `compressed_size` bigint unsigned NULL;
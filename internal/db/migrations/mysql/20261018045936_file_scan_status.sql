@@ -0,0 +1,2 @@
+-- Modify "files" table
+ALTER TABLE `files` ADD COLUMN `scan_status` varchar(255) NOT NULL DEFAULT "clean", ADD COLUMN `scan_signature` varchar(255) NULL;
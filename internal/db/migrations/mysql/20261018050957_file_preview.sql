@@ -0,0 +1,2 @@
+-- Modify "file_data" table
+ALTER TABLE `file_data` ADD COLUMN `preview_status` varchar(255) NOT NULL DEFAULT "pending", ADD COLUMN `preview_type` varchar(255) NULL;
@@ -0,0 +1,4 @@
+-- Modify "files" table
+ALTER TABLE `files` ADD COLUMN `last_view` timestamp NULL, ADD COLUMN `times_viewed` bigint unsigned NOT NULL DEFAULT 0;
+-- Modify "tickets" table
+ALTER TABLE `tickets` ADD COLUMN `views_count_as_downloads` bool NOT NULL DEFAULT 0;
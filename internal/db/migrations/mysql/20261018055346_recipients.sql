@@ -0,0 +1,19 @@
+-- Create "recipients" table
+CREATE TABLE `recipients` (
+  `id` char(36) NOT NULL,
+  `name` varchar(255) NOT NULL,
+  `email` varchar(255) NULL,
+  `hashed_token` varchar(255) NOT NULL,
+  `created_at` timestamp NOT NULL,
+  `revoked_at` timestamp NULL,
+  `last_download` timestamp NULL,
+  `times_downloaded` bigint unsigned NOT NULL DEFAULT 0,
+  `downloaded_files` json NULL,
+  `ticket_recipients` char(36) NOT NULL,
+  PRIMARY KEY (`id`),
+  INDEX `recipients_tickets_recipients` (`ticket_recipients`),
+  UNIQUE INDEX `hashed_token` (`hashed_token`),
+  CONSTRAINT `recipients_tickets_recipients` FOREIGN KEY (`ticket_recipients`) REFERENCES `tickets` (`id`) ON UPDATE NO ACTION ON DELETE CASCADE
+) CHARSET utf8mb4 COLLATE utf8mb4_bin;
+-- Modify "share_access_tokens" table
+ALTER TABLE `share_access_tokens` ADD COLUMN `recipient_shareaccesstokens` char(36) NULL, ADD INDEX `share_access_tokens_recipients_shareaccesstokens` (`recipient_shareaccesstokens`), ADD CONSTRAINT `share_access_tokens_recipients_shareaccesstokens` FOREIGN KEY (`recipient_shareaccesstokens`) REFERENCES `recipients` (`id`) ON UPDATE NO ACTION ON DELETE CASCADE;
@@ -0,0 +1,24 @@
+-- Create "access_events" table
+CREATE TABLE `access_events` (
+  `id` bigint NOT NULL AUTO_INCREMENT,
+  `type` varchar(255) NOT NULL,
+  `created_at` timestamp NOT NULL,
+  `address` varchar(255) NOT NULL,
+  `user_agent` varchar(255) NOT NULL DEFAULT "",
+  `bytes_served` bigint NOT NULL DEFAULT 0,
+  `file_name` varchar(255) NULL,
+  `file_access_events` char(36) NULL,
+  `grant_access_events` char(36) NULL,
+  `recipient_access_events` char(36) NULL,
+  `ticket_access_events` char(36) NULL,
+  PRIMARY KEY (`id`),
+  INDEX `access_events_files_access_events` (`file_access_events`),
+  INDEX `access_events_grants_access_events` (`grant_access_events`),
+  INDEX `access_events_recipients_access_events` (`recipient_access_events`),
+  INDEX `access_events_tickets_access_events` (`ticket_access_events`),
+  INDEX `accessevent_created_at` (`created_at`),
+  CONSTRAINT `access_events_files_access_events` FOREIGN KEY (`file_access_events`) REFERENCES `files` (`id`) ON UPDATE NO ACTION ON DELETE SET NULL,
+  CONSTRAINT `access_events_grants_access_events` FOREIGN KEY (`grant_access_events`) REFERENCES `grants` (`id`) ON UPDATE NO ACTION ON DELETE CASCADE,
+  CONSTRAINT `access_events_recipients_access_events` FOREIGN KEY (`recipient_access_events`) REFERENCES `recipients` (`id`) ON UPDATE NO ACTION ON DELETE SET NULL,
+  CONSTRAINT `access_events_tickets_access_events` FOREIGN KEY (`ticket_access_events`) REFERENCES `tickets` (`id`) ON UPDATE NO ACTION ON DELETE CASCADE
+) CHARSET utf8mb4 COLLATE utf8mb4_bin;
@@ -0,0 +1,2 @@
+-- Modify "file_data" table
+ALTER TABLE "file_data" ADD COLUMN "key_id" character varying NULL, ADD COLUMN "encrypted_key" bytea NULL;
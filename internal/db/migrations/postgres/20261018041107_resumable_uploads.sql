@@ -0,0 +1,14 @@
+-- Create "uploads" table
+CREATE TABLE "uploads" (
+  "id" uuid NOT NULL,
+  "name" character varying NOT NULL,
+  "size" bigint NOT NULL,
+  "offset" bigint NOT NULL DEFAULT 0,
+  "created_at" timestamptz NOT NULL,
+  "expiry" timestamptz NOT NULL,
+  "grant_uploads" uuid NULL,
+  "user_uploads" uuid NULL,
+  PRIMARY KEY ("id"),
+  CONSTRAINT "uploads_grants_uploads" FOREIGN KEY ("grant_uploads") REFERENCES "grants" ("id") ON UPDATE NO ACTION ON DELETE SET NULL,
+  CONSTRAINT "uploads_users_uploads" FOREIGN KEY ("user_uploads") REFERENCES "users" ("id") ON UPDATE NO ACTION ON DELETE SET NULL
+);
@@ -0,0 +1,13 @@
+-- Create "download_progresses" table
+CREATE TABLE "download_progresses" (
+  "id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY,
+  "token" character varying NOT NULL,
+  "ranges" jsonb NULL,
+  "updated_at" timestamptz NOT NULL,
+  "version" bigint NOT NULL DEFAULT 0,
+  "file_download_progresses" uuid NOT NULL,
+  PRIMARY KEY ("id"),
+  CONSTRAINT "download_progresses_files_download_progresses" FOREIGN KEY ("file_download_progresses") REFERENCES "files" ("id") ON UPDATE NO ACTION ON DELETE CASCADE
+);
+-- Create index "downloadprogress_token_file_download_progresses" to table: "download_progresses"
+CREATE UNIQUE INDEX "downloadprogress_token_file_download_progresses" ON "download_progresses" ("token", "file_download_progresses");
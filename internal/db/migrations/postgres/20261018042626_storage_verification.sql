@@ -0,0 +1,9 @@
+-- Create "storage_verifications" table
+CREATE TABLE "storage_verifications" (
+  "id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY,
+  "started_at" timestamptz NOT NULL,
+  "finished_at" timestamptz NULL,
+  "checked_blobs" bigint NOT NULL DEFAULT 0,
+  "issues" jsonb NULL,
+  PRIMARY KEY ("id")
+);
@@ -0,0 +1,2 @@
+-- Modify "files" table
+ALTER TABLE "files" ADD COLUMN "scan_status" character varying NOT NULL DEFAULT 'clean', ADD COLUMN "scan_signature" character varying NULL;
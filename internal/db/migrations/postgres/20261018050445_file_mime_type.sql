@@ -0,0 +1,4 @@
+-- Modify "file_data" table
+ALTER TABLE "file_data" ADD COLUMN "mime_type" character varying NULL;
+-- Modify "files" table
+ALTER TABLE "files" ADD COLUMN "mime_type" character varying NULL;
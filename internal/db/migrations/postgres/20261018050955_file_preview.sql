@@ -0,0 +1,2 @@
+-- Modify "file_data" table
+ALTER TABLE "file_data" ADD COLUMN "preview_status" character varying NOT NULL DEFAULT 'pending', ADD COLUMN "preview_type" character varying NULL;
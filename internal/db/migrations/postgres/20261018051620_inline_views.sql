@@ -0,0 +1,4 @@
+-- Modify "files" table
+ALTER TABLE "files" ADD COLUMN "last_view" timestamptz NULL, ADD COLUMN "times_viewed" bigint NOT NULL DEFAULT 0;
+-- Modify "tickets" table
+ALTER TABLE "tickets" ADD COLUMN "views_count_as_downloads" boolean NOT NULL DEFAULT false;
@@ -0,0 +1,2 @@
+-- Modify "file_data" table
+ALTER TABLE "file_data" ADD COLUMN "sha256" character varying NULL;
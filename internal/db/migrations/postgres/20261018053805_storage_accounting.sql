@@ -0,0 +1,19 @@
+-- Modify "file_data" table
+ALTER TABLE "file_data" ADD COLUMN "stored_size" bigint NOT NULL DEFAULT 0;
+-- Modify "users" table
+ALTER TABLE "users" ADD COLUMN "physical_data_size" bigint NOT NULL DEFAULT 0;
+-- Backfill stored sizes, encrypted blobs carry a 16 byte tag per 64 KiB chunk
+UPDATE "file_data" SET "stored_size" = COALESCE("compressed_size", "size") + CASE
+  WHEN "key_id" IS NULL THEN 0
+  WHEN COALESCE("compressed_size", "size") = 0 THEN 16
+  ELSE (COALESCE("compressed_size", "size") + 65535) / 65536 * 16
+END;
+-- Backfill logical and physical data sizes of users
+UPDATE "users" SET "total_data_size" = (
+  SELECT COALESCE(SUM("file_data"."size"), 0) FROM "files"
+  JOIN "file_data" ON "file_data"."id" = "files"."file_data"
+  WHERE "files"."user_files" = "users"."id"
+), "physical_data_size" = (
+  SELECT COALESCE(SUM("file_data"."stored_size"), 0) FROM "file_data"
+  WHERE "file_data"."id" IN (SELECT "file_data" FROM "files" WHERE "user_files" = "users"."id")
+);
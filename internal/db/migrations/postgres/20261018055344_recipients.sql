@@ -0,0 +1,19 @@
+-- Create "recipients" table
+CREATE TABLE "recipients" (
+  "id" uuid NOT NULL,
+  "name" character varying NOT NULL,
+  "email" character varying NULL,
+  "hashed_token" character varying NOT NULL,
+  "created_at" timestamptz NOT NULL,
+  "revoked_at" timestamptz NULL,
+  "last_download" timestamptz NULL,
+  "times_downloaded" bigint NOT NULL DEFAULT 0,
+  "downloaded_files" jsonb NULL,
+  "ticket_recipients" uuid NOT NULL,
+  PRIMARY KEY ("id"),
+  CONSTRAINT "recipients_tickets_recipients" FOREIGN KEY ("ticket_recipients") REFERENCES "tickets" ("id") ON UPDATE NO ACTION ON DELETE CASCADE
+);
+-- Create index "recipients_hashed_token_key" to table: "recipients"
+CREATE UNIQUE INDEX "recipients_hashed_token_key" ON "recipients" ("hashed_token");
+-- Modify "share_access_tokens" table
+ALTER TABLE "share_access_tokens" ADD COLUMN "recipient_shareaccesstokens" uuid NULL, ADD CONSTRAINT "share_access_tokens_recipients_shareaccesstokens" FOREIGN KEY ("recipient_shareaccesstokens") REFERENCES "recipients" ("id") ON UPDATE NO ACTION ON DELETE CASCADE;
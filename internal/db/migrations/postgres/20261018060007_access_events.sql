@@ -0,0 +1,21 @@
+-- Create "access_events" table
+CREATE TABLE "access_events" (
+  "id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY,
+  "type" character varying NOT NULL,
+  "created_at" timestamptz NOT NULL,
+  "address" character varying NOT NULL,
+  "user_agent" character varying NOT NULL DEFAULT '',
+  "bytes_served" bigint NOT NULL DEFAULT 0,
+  "file_name" character varying NULL,
+  "file_access_events" uuid NULL,
+  "grant_access_events" uuid NULL,
+  "recipient_access_events" uuid NULL,
+  "ticket_access_events" uuid NULL,
+  PRIMARY KEY ("id"),
+  CONSTRAINT "access_events_files_access_events" FOREIGN KEY ("file_access_events") REFERENCES "files" ("id") ON UPDATE NO ACTION ON DELETE SET NULL,
+  CONSTRAINT "access_events_grants_access_events" FOREIGN KEY ("grant_access_events") REFERENCES "grants" ("id") ON UPDATE NO ACTION ON DELETE CASCADE,
+  CONSTRAINT "access_events_recipients_access_events" FOREIGN KEY ("recipient_access_events") REFERENCES "recipients" ("id") ON UPDATE NO ACTION ON DELETE SET NULL,
+  CONSTRAINT "access_events_tickets_access_events" FOREIGN KEY ("ticket_access_events") REFERENCES "tickets" ("id") ON UPDATE NO ACTION ON DELETE CASCADE
+);
+-- Create index "accessevent_created_at" to table: "access_events"
+CREATE INDEX "accessevent_created_at" ON "access_events" ("created_at");
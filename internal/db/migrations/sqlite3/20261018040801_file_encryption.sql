@@ -0,0 +1,4 @@
+-- Add column "key_id" to table: "file_data"
+ALTER TABLE `file_data` ADD COLUMN `key_id` text NULL;
+-- Add column "encrypted_key" to table: "file_data"
+ALTER TABLE `file_data` ADD COLUMN `encrypted_key` blob NULL;
@@ -0,0 +1,14 @@
+-- Create "uploads" table
+CREATE TABLE `uploads` (
+  `id` uuid NOT NULL,
+  `name` text NOT NULL,
+  `size` integer NOT NULL,
+  `offset` integer NOT NULL DEFAULT 0,
+  `created_at` datetime NOT NULL,
+  `expiry` datetime NOT NULL,
+  `grant_uploads` uuid NULL,
+  `user_uploads` uuid NULL,
+  PRIMARY KEY (`id`),
+  CONSTRAINT `uploads_grants_uploads` FOREIGN KEY (`grant_uploads`) REFERENCES `grants` (`id`) ON UPDATE NO ACTION ON DELETE SET NULL,
+  CONSTRAINT `uploads_users_uploads` FOREIGN KEY (`user_uploads`) REFERENCES `users` (`id`) ON UPDATE NO ACTION ON DELETE SET NULL
+);
@@ -0,0 +1,12 @@
+-- Create "download_progresses" table
+CREATE TABLE `download_progresses` (
+  `id` integer NOT NULL PRIMARY KEY AUTOINCREMENT,
+  `token` text NOT NULL,
+  `ranges` json NULL,
+  `updated_at` datetime NOT NULL,
+  `version` integer NOT NULL DEFAULT 0,
+  `file_download_progresses` uuid NOT NULL,
+  CONSTRAINT `download_progresses_files_download_progresses` FOREIGN KEY (`file_download_progresses`) REFERENCES `files` (`id`) ON DELETE CASCADE
+);
+-- Create index "downloadprogress_token_file_download_progresses" to table: "download_progresses"
+CREATE UNIQUE INDEX `downloadprogress_token_file_download_progresses` ON `download_progresses` (`token`, `file_download_progresses`);
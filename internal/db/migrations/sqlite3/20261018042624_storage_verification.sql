@@ -0,0 +1,8 @@
+-- Create "storage_verifications" table
+CREATE TABLE `storage_verifications` (
+  `id` integer NOT NULL PRIMARY KEY AUTOINCREMENT,
+  `started_at` datetime NOT NULL,
+  `finished_at` datetime NULL,
+  `checked_blobs` integer NOT NULL DEFAULT (0),
+  `issues` json NULL
+);
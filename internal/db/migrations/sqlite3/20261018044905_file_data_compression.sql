@@ -0,0 +1,4 @@
+-- Add column "compression" to table: "file_data"
+ALTER TABLE `file_data` ADD COLUMN `compression` text NULL;
+-- Add column "compressed_size" to table: "file_data"
+ALTER TABLE `file_data` ADD COLUMN `compressed_size` integer NULL;
@@ -0,0 +1,4 @@
+-- Add column "mime_type" to table: "file_data"
+ALTER TABLE `file_data` ADD COLUMN `mime_type` text NULL;
+-- Add column "mime_type" to table: "files"
+ALTER TABLE `files` ADD COLUMN `mime_type` text NULL;
@@ -0,0 +1,12 @@
+-- Disable the enforcement of foreign-keys constraints
+PRAGMA foreign_keys = off;
+-- Create "new_file_data" table
+CREATE TABLE `new_file_data` (`id` text NOT NULL, `size` integer NOT NULL, `key_id` text NULL, `encrypted_key` blob NULL, `compression` text NULL, `compressed_size` integer NULL, `mime_type` text NULL, `preview_status` text NOT NULL DEFAULT ('pending'), `preview_type` text NULL, PRIMARY KEY (`id`));
+-- Copy rows from old table "file_data" to new temporary table "new_file_data"
+INSERT INTO `new_file_data` (`id`, `size`, `key_id`, `encrypted_key`, `compression`, `compressed_size`, `mime_type`) SELECT `id`, `size`, `key_id`, `encrypted_key`, `compression`, `compressed_size`, `mime_type` FROM `file_data`;
+-- Drop "file_data" table after copying rows
+DROP TABLE `file_data`;
+-- Rename temporary table "new_file_data" to "file_data"
+ALTER TABLE `new_file_data` RENAME TO `file_data`;
+-- Enable back the enforcement of foreign-keys constraints
+PRAGMA foreign_keys = on;
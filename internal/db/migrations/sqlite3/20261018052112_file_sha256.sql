@@ -0,0 +1,2 @@
+-- Add column "sha256" to table: "file_data"
+ALTER TABLE `file_data` ADD COLUMN `sha256` text NULL;
@@ -0,0 +1,35 @@
+-- Disable the enforcement of foreign-keys constraints
+PRAGMA foreign_keys = off;
+-- Create "new_users" table
+CREATE TABLE `new_users` (`id` uuid NOT NULL, `username` text NOT NULL, `full_name` text NOT NULL, `email` text NOT NULL, `groups` json NOT NULL, `is_admin` bool NOT NULL, `created_at` datetime NOT NULL, `submitted_tickets` integer NOT NULL DEFAULT (0), `submitted_grants` integer NOT NULL DEFAULT (0), `total_data_size` integer NOT NULL DEFAULT (0), `physical_data_size` integer NOT NULL DEFAULT (0), PRIMARY KEY (`id`));
+-- Copy rows from old table "users" to new temporary table "new_users"
+INSERT INTO `new_users` (`id`, `username`, `full_name`, `email`, `groups`, `is_admin`, `created_at`, `submitted_tickets`, `submitted_grants`, `total_data_size`) SELECT `id`, `username`, `full_name`, `email`, `groups`, `is_admin`, `created_at`, `submitted_tickets`, `submitted_grants`, `total_data_size` FROM `users`;
+-- Drop "users" table after copying rows
+DROP TABLE `users`;
+-- Rename temporary table "new_users" to "users"
+ALTER TABLE `new_users` RENAME TO `users`;
+-- Create "new_file_data" table
+CREATE TABLE `new_file_data` (`id` text NOT NULL, `size` integer NOT NULL, `sha256` text NULL, `key_id` text NULL, `encrypted_key` blob NULL, `compression` text NULL, `compressed_size` integer NULL, `stored_size` integer NOT NULL DEFAULT (0), `mime_type` text NULL, `preview_status` text NOT NULL DEFAULT ('pending'), `preview_type` text NULL, PRIMARY KEY (`id`));
+-- Copy rows from old table "file_data" to new temporary table "new_file_data"
+INSERT INTO `new_file_data` (`id`, `size`, `sha256`, `key_id`, `encrypted_key`, `compression`, `compressed_size`, `mime_type`, `preview_status`, `preview_type`) SELECT `id`, `size`, `sha256`, `key_id`, `encrypted_key`, `compression`, `compressed_size`, `mime_type`, `preview_status`, `preview_type` FROM `file_data`;
+-- Drop "file_data" table after copying rows
+DROP TABLE `file_data`;
+-- Rename temporary table "new_file_data" to "file_data"
+ALTER TABLE `new_file_data` RENAME TO `file_data`;
+-- Enable back the enforcement of foreign-keys constraints
+PRAGMA foreign_keys = on;
+-- Backfill stored sizes, encrypted blobs carry a 16 byte tag per 64 KiB chunk
+UPDATE `file_data` SET `stored_size` = COALESCE(`compressed_size`, `size`) + CASE
+  WHEN `key_id` IS NULL THEN 0
+  WHEN COALESCE(`compressed_size`, `size`) = 0 THEN 16
+  ELSE (COALESCE(`compressed_size`, `size`) + 65535) / 65536 * 16
+END;
+-- Backfill logical and physical data sizes of users
+UPDATE `users` SET `total_data_size` = (
+  SELECT COALESCE(SUM(`file_data`.`size`), 0) FROM `files`
+  JOIN `file_data` ON `file_data`.`id` = `files`.`file_data`
+  WHERE `files`.`user_files` = `users`.`id`
+), `physical_data_size` = (
+  SELECT COALESCE(SUM(`file_data`.`stored_size`), 0) FROM `file_data`
+  WHERE `file_data`.`id` IN (SELECT `file_data` FROM `files` WHERE `user_files` = `users`.`id`)
+);
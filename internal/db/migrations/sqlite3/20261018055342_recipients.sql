@@ -0,0 +1,16 @@
+-- Disable the enforcement of foreign-keys constraints
+PRAGMA foreign_keys = off;
+-- Create "new_share_access_tokens" table
+CREATE TABLE `new_share_access_tokens` (`id` text NOT NULL, `expiry` datetime NOT NULL, `grant_shareaccesstokens` uuid NULL, `recipient_shareaccesstokens` uuid NULL, `ticket_shareaccesstokens` uuid NULL, PRIMARY KEY (`id`), CONSTRAINT `share_access_tokens_grants_shareaccesstokens` FOREIGN KEY (`grant_shareaccesstokens`) REFERENCES `grants` (`id`) ON DELETE SET NULL, CONSTRAINT `share_access_tokens_recipients_shareaccesstokens` FOREIGN KEY (`recipient_shareaccesstokens`) REFERENCES `recipients` (`id`) ON DELETE CASCADE, CONSTRAINT `share_access_tokens_tickets_shareaccesstokens` FOREIGN KEY (`ticket_shareaccesstokens`) REFERENCES `tickets` (`id`) ON DELETE SET NULL);
+-- Copy rows from old table "share_access_tokens" to new temporary table "new_share_access_tokens"
+INSERT INTO `new_share_access_tokens` (`id`, `expiry`, `grant_shareaccesstokens`, `ticket_shareaccesstokens`) SELECT `id`, `expiry`, `grant_shareaccesstokens`, `ticket_shareaccesstokens` FROM `share_access_tokens`;
+-- Drop "share_access_tokens" table after copying rows
+DROP TABLE `share_access_tokens`;
+-- Rename temporary table "new_share_access_tokens" to "share_access_tokens"
+ALTER TABLE `new_share_access_tokens` RENAME TO `share_access_tokens`;
+-- Create "recipients" table
+CREATE TABLE `recipients` (`id` uuid NOT NULL, `name` text NOT NULL, `email` text NULL, `hashed_token` text NOT NULL, `created_at` datetime NOT NULL, `revoked_at` datetime NULL, `last_download` datetime NULL, `times_downloaded` integer NOT NULL DEFAULT (0), `downloaded_files` json NULL, `ticket_recipients` uuid NOT NULL, PRIMARY KEY (`id`), CONSTRAINT `recipients_tickets_recipients` FOREIGN KEY (`ticket_recipients`) REFERENCES `tickets` (`id`) ON DELETE CASCADE);
+-- Create index "recipients_hashed_token_key" to table: "recipients"
+CREATE UNIQUE INDEX `recipients_hashed_token_key` ON `recipients` (`hashed_token`);
+-- Enable back the enforcement of foreign-keys constraints
+PRAGMA foreign_keys = on;
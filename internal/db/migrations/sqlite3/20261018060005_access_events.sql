@@ -0,0 +1,4 @@
+-- Create "access_events" table
+CREATE TABLE `access_events` (`id` integer NOT NULL PRIMARY KEY AUTOINCREMENT, `type` text NOT NULL, `created_at` datetime NOT NULL, `address` text NOT NULL, `user_agent` text NOT NULL DEFAULT (''), `bytes_served` integer NOT NULL DEFAULT (0), `file_name` text NULL, `file_access_events` uuid NULL, `grant_access_events` uuid NULL, `recipient_access_events` uuid NULL, `ticket_access_events` uuid NULL, CONSTRAINT `access_events_files_access_events` FOREIGN KEY (`file_access_events`) REFERENCES `files` (`id`) ON DELETE SET NULL, CONSTRAINT `access_events_grants_access_events` FOREIGN KEY (`grant_access_events`) REFERENCES `grants` (`id`) ON DELETE CASCADE, CONSTRAINT `access_events_recipients_access_events` FOREIGN KEY (`recipient_access_events`) REFERENCES `recipients` (`id`) ON DELETE SET NULL, CONSTRAINT `access_events_tickets_access_events` FOREIGN KEY (`ticket_access_events`) REFERENCES `tickets` (`id`) ON DELETE CASCADE);
+-- Create index "accessevent_created_at" to table: "access_events"
+CREATE INDEX `accessevent_created_at` ON `access_events` (`created_at`);
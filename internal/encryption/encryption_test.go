@@ -0,0 +1,131 @@
+package encryption
+
+import (
+	"bytes"
+	"crypto/rand"
+	"encoding/base64"
+	"io"
+	"testing"
+
+	"codeberg.org/jvllmr/frans/internal/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type nopSeekCloser struct {
+	io.ReadSeeker
+}
+
+func (nopSeekCloser) Close() error { return nil }
+
+func newTestKey(t *testing.T) string {
+	key := make([]byte, keySize)
+	_, err := rand.Read(key)
+	require.NoError(t, err)
+	return base64.StdEncoding.EncodeToString(key)
+}
+
+func encrypt(t *testing.T, plain []byte, dataKey []byte) []byte {
+	reader, err := NewEncryptingReader(bytes.NewReader(plain), dataKey, int64(len(plain)))
+	require.NoError(t, err)
+	encrypted, err := io.ReadAll(reader)
+	require.NoError(t, err)
+	assert.Equal(t, EncryptedSize(int64(len(plain))), int64(len(encrypted)))
+	return encrypted
+}
+
+func TestStreamRoundTrip(t *testing.T) {
+	dataKey := make([]byte, keySize)
+	_, err := rand.Read(dataKey)
+	require.NoError(t, err)
+
+	for _, size := range []int{0, 1, chunkSize - 1, chunkSize, chunkSize + 1, 3*chunkSize + 42} {
+		plain := make([]byte, size)
+		_, err := rand.Read(plain)
+		require.NoError(t, err)
+		encrypted := encrypt(t, plain, dataKey)
+		assert.Equal(t, int64(size), PlainSize(int64(len(encrypted))), "size %d", size)
+
+		decrypter, err := NewDecryptingReadSeeker(
+			nopSeekCloser{bytes.NewReader(encrypted)},
+			dataKey,
+			int64(size),
+		)
+		require.NoError(t, err)
+		decrypted, err := io.ReadAll(decrypter)
+		require.NoError(t, err)
+		assert.Equal(t, plain, decrypted, "size %d", size)
+
+		if size < 2 {
+			continue
+		}
+		offset := int64(size / 2)
+		_, err = decrypter.Seek(offset, io.SeekStart)
+		require.NoError(t, err)
+		part := make([]byte, min(int64(size)-offset, 10))
+		_, err = io.ReadFull(decrypter, part)
+		require.NoError(t, err)
+		assert.Equal(t, plain[offset:offset+int64(len(part))], part, "size %d", size)
+	}
+}
+
+func TestStreamDetectsTampering(t *testing.T) {
+	dataKey := make([]byte, keySize)
+	plain := bytes.Repeat([]byte("frans"), chunkSize)
+	encrypted := encrypt(t, plain, dataKey)
+
+	truncated := encrypted[:encryptedChunkSize*2]
+	decrypter, err := NewDecryptingReadSeeker(
+		nopSeekCloser{bytes.NewReader(truncated)},
+		dataKey,
+		int64(chunkSize*2),
+	)
+	require.NoError(t, err)
+	_, err = io.ReadAll(decrypter)
+	assert.Error(t, err)
+
+	encrypted[10] ^= 1
+	decrypter, err = NewDecryptingReadSeeker(
+		nopSeekCloser{bytes.NewReader(encrypted)},
+		dataKey,
+		int64(len(plain)),
+	)
+	require.NoError(t, err)
+	_, err = io.ReadAll(decrypter)
+	assert.Error(t, err)
+}
+
+func TestKeyringRotation(t *testing.T) {
+	oldKey := newTestKey(t)
+	oldKeyring, err := NewKeyring(config.FilesEncryptionConfig{Key: oldKey})
+	require.NoError(t, err)
+	assert.True(t, oldKeyring.Enabled())
+
+	keyID, wrappedKey, err := oldKeyring.NewDataKey("abc")
+	require.NoError(t, err)
+	dataKey, err := oldKeyring.UnwrapKey(keyID, "abc", wrappedKey)
+	require.NoError(t, err)
+	_, err = oldKeyring.UnwrapKey(keyID, "def", wrappedKey)
+	assert.Error(t, err)
+
+	newKeyring, err := NewKeyring(config.FilesEncryptionConfig{
+		Key:     newTestKey(t),
+		OldKeys: []string{oldKey},
+	})
+	require.NoError(t, err)
+	newKeyID, newWrappedKey, err := newKeyring.RewrapKey(keyID, "abc", wrappedKey)
+	require.NoError(t, err)
+	assert.NotEqual(t, keyID, newKeyID)
+	rewrappedDataKey, err := newKeyring.UnwrapKey(newKeyID, "abc", newWrappedKey)
+	require.NoError(t, err)
+	assert.Equal(t, dataKey, rewrappedDataKey)
+
+	_, err = oldKeyring.UnwrapKey(newKeyID, "abc", newWrappedKey)
+	assert.ErrorIs(t, err, ErrUnknownKey)
+
+	disabledKeyring, err := NewKeyring(config.FilesEncryptionConfig{})
+	require.NoError(t, err)
+	assert.False(t, disabledKeyring.Enabled())
+	_, err = NewKeyring(config.FilesEncryptionConfig{Key: "dG9vIHNob3J0"})
+	assert.Error(t, err)
+}
@@ -0,0 +1,169 @@
+package encryption
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+
+	"codeberg.org/jvllmr/frans/internal/config"
+)
+
+const keySize = 32
+
+var ErrUnknownKey = errors.New("unknown master key")
+
+type masterKey struct {
+	id   string
+	aead cipher.AEAD
+}
+
+func newAEAD(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+func parseMasterKey(encodedKey string) (*masterKey, error) {
+	key, err := base64.StdEncoding.DecodeString(encodedKey)
+	if err != nil {
+		return nil, fmt.Errorf("parse master key: %w", err)
+	}
+	if len(key) != keySize {
+		return nil, fmt.Errorf(
+			"parse master key: expected %d bytes, but got %d bytes",
+			keySize,
+			len(key),
+		)
+	}
+	aead, err := newAEAD(key)
+	if err != nil {
+		return nil, fmt.Errorf("parse master key: %w", err)
+	}
+	keyHash := sha256.Sum256(key)
+	return &masterKey{id: hex.EncodeToString(keyHash[:8]), aead: aead}, nil
+}
+
+// Keyring holds the master keys used to wrap the data keys of stored blobs.
+// New data keys are always wrapped with the current master key,
+// old master keys are only used for unwrapping.
+type Keyring struct {
+	current *masterKey
+	keys    map[string]*masterKey
+}
+
+// Enabled reports whether a master key is configured and new blobs should be encrypted
+func (kr *Keyring) Enabled() bool {
+	return kr.current != nil
+}
+
+// CurrentKeyID returns the ID of the master key new data keys are wrapped with
+func (kr *Keyring) CurrentKeyID() string {
+	if kr.current == nil {
+		return ""
+	}
+	return kr.current.id
+}
+
+// NewDataKey generates a data key and wraps it with the current master key.
+// The wrapped key is bound to the given blob id.
+func (kr *Keyring) NewDataKey(blobID string) (keyID string, wrappedKey []byte, err error) {
+	if kr.current == nil {
+		return "", nil, errors.New("new data key: no master key configured")
+	}
+	dataKey := make([]byte, keySize)
+	if _, err := rand.Read(dataKey); err != nil {
+		return "", nil, fmt.Errorf("new data key: %w", err)
+	}
+	wrappedKey, err = kr.wrap(kr.current, blobID, dataKey)
+	if err != nil {
+		return "", nil, err
+	}
+	return kr.current.id, wrappedKey, nil
+}
+
+// DeriveKey derives a key for other content which belongs to a blob, e.g. its preview.
+// Chunk nonces are derived from the chunk index, so the data key itself must
+// never encrypt anything but the blob.
+func DeriveKey(dataKey []byte, purpose string) []byte {
+	mac := hmac.New(sha256.New, dataKey)
+	mac.Write([]byte(purpose))
+	return mac.Sum(nil)
+}
+
+func (kr *Keyring) wrap(key *masterKey, blobID string, dataKey []byte) ([]byte, error) {
+	nonce := make([]byte, key.aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, fmt.Errorf("wrap data key: %w", err)
+	}
+	return key.aead.Seal(nonce, nonce, dataKey, []byte(blobID)), nil
+}
+
+// UnwrapKey decrypts a data key which was wrapped with the master key with the given ID
+func (kr *Keyring) UnwrapKey(keyID string, blobID string, wrappedKey []byte) ([]byte, error) {
+	key, ok := kr.keys[keyID]
+	if !ok {
+		return nil, fmt.Errorf("unwrap data key: %w: %s", ErrUnknownKey, keyID)
+	}
+	nonceSize := key.aead.NonceSize()
+	if len(wrappedKey) < nonceSize {
+		return nil, errors.New("unwrap data key: wrapped key too short")
+	}
+	dataKey, err := key.aead.Open(
+		nil,
+		wrappedKey[:nonceSize],
+		wrappedKey[nonceSize:],
+		[]byte(blobID),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("unwrap data key: %w", err)
+	}
+	return dataKey, nil
+}
+
+// RewrapKey wraps a data key again with the current master key
+func (kr *Keyring) RewrapKey(
+	keyID string,
+	blobID string,
+	wrappedKey []byte,
+) (newKeyID string, newWrappedKey []byte, err error) {
+	if kr.current == nil {
+		return "", nil, errors.New("rewrap data key: no master key configured")
+	}
+	dataKey, err := kr.UnwrapKey(keyID, blobID, wrappedKey)
+	if err != nil {
+		return "", nil, err
+	}
+	newWrappedKey, err = kr.wrap(kr.current, blobID, dataKey)
+	if err != nil {
+		return "", nil, err
+	}
+	return kr.current.id, newWrappedKey, nil
+}
+
+func NewKeyring(cfg config.FilesEncryptionConfig) (*Keyring, error) {
+	kr := &Keyring{keys: make(map[string]*masterKey)}
+	for _, encodedKey := range cfg.OldKeys {
+		key, err := parseMasterKey(encodedKey)
+		if err != nil {
+			return nil, err
+		}
+		kr.keys[key.id] = key
+	}
+	if cfg.Key != "" {
+		key, err := parseMasterKey(cfg.Key)
+		if err != nil {
+			return nil, err
+		}
+		kr.keys[key.id] = key
+		kr.current = key
+	}
+	return kr, nil
+}
@@ -0,0 +1,206 @@
+package encryption
+
+import (
+	"crypto/cipher"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+)
+
+// Blobs are encrypted in chunks with AES-256-GCM so that they can be
+// decrypted while streaming and read from arbitrary offsets.
+// The nonce of every chunk is derived from its index and marks the final chunk
+// to detect reordered or truncated blobs.
+const (
+	chunkSize          = 64 * 1024
+	tagSize            = 16
+	encryptedChunkSize = chunkSize + tagSize
+)
+
+// ErrCorruptedBlob is returned if an encrypted chunk fails authentication
+var ErrCorruptedBlob = errors.New("corrupted blob")
+
+func chunkCount(plainSize int64) int64 {
+	if plainSize == 0 {
+		return 1
+	}
+	return (plainSize + chunkSize - 1) / chunkSize
+}
+
+// EncryptedSize returns the size of an encrypted blob with the given plaintext size
+func EncryptedSize(plainSize int64) int64 {
+	return plainSize + chunkCount(plainSize)*tagSize
+}
+
+// PlainSize returns the plaintext size of an encrypted blob with the given size
+func PlainSize(encryptedSize int64) int64 {
+	fullChunks := encryptedSize / encryptedChunkSize
+	lastChunk := max(encryptedSize%encryptedChunkSize-tagSize, 0)
+	return fullChunks*chunkSize + lastChunk
+}
+
+func chunkNonce(index int64, final bool) []byte {
+	nonce := make([]byte, 12)
+	if final {
+		nonce[0] = 1
+	}
+	binary.BigEndian.PutUint64(nonce[4:], uint64(index))
+	return nonce
+}
+
+type encryptingReader struct {
+	source    io.Reader
+	aead      cipher.AEAD
+	plainSize int64
+	index     int64
+	plain     []byte
+	sealed    []byte
+	buffer    []byte
+	done      bool
+}
+
+func (er *encryptingReader) Read(p []byte) (int, error) {
+	for len(er.buffer) == 0 {
+		if er.done {
+			return 0, io.EOF
+		}
+		if err := er.nextChunk(); err != nil {
+			return 0, err
+		}
+	}
+	n := copy(p, er.buffer)
+	er.buffer = er.buffer[n:]
+	return n, nil
+}
+
+func (er *encryptingReader) nextChunk() error {
+	final := er.index == chunkCount(er.plainSize)-1
+	length := int64(chunkSize)
+	if final {
+		length = er.plainSize - er.index*chunkSize
+	}
+	plain := er.plain[:length]
+	if _, err := io.ReadFull(er.source, plain); err != nil {
+		return fmt.Errorf("encrypt chunk: %w", err)
+	}
+	er.buffer = er.aead.Seal(er.sealed[:0], chunkNonce(er.index, final), plain, nil)
+	er.index++
+	er.done = final
+	return nil
+}
+
+// NewEncryptingReader encrypts plainSize bytes read from source with dataKey.
+// The returned reader yields exactly EncryptedSize(plainSize) bytes.
+func NewEncryptingReader(source io.Reader, dataKey []byte, plainSize int64) (io.Reader, error) {
+	aead, err := newAEAD(dataKey)
+	if err != nil {
+		return nil, fmt.Errorf("new encrypting reader: %w", err)
+	}
+	return &encryptingReader{
+		source:    source,
+		aead:      aead,
+		plainSize: plainSize,
+		plain:     make([]byte, chunkSize),
+		sealed:    make([]byte, 0, encryptedChunkSize),
+	}, nil
+}
+
+type decryptingReadSeeker struct {
+	source    io.ReadSeekCloser
+	aead      cipher.AEAD
+	plainSize int64
+	offset    int64
+	// index of the next chunk read from source, -1 if source has to be repositioned
+	index  int64
+	chunk  []byte
+	buffer []byte
+	plain  []byte
+}
+
+func (dr *decryptingReadSeeker) Read(p []byte) (int, error) {
+	if dr.offset >= dr.plainSize {
+		return 0, io.EOF
+	}
+	if len(dr.buffer) == 0 {
+		if err := dr.nextChunk(); err != nil {
+			return 0, err
+		}
+	}
+	n := copy(p, dr.buffer)
+	dr.buffer = dr.buffer[n:]
+	dr.offset += int64(n)
+	return n, nil
+}
+
+func (dr *decryptingReadSeeker) nextChunk() error {
+	index := dr.offset / chunkSize
+	if dr.index != index {
+		if _, err := dr.source.Seek(index*encryptedChunkSize, io.SeekStart); err != nil {
+			return fmt.Errorf("decrypt chunk: %w", err)
+		}
+	}
+	final := index == chunkCount(dr.plainSize)-1
+	length := int64(encryptedChunkSize)
+	if final {
+		length = EncryptedSize(dr.plainSize) - index*encryptedChunkSize
+	}
+	chunk := dr.chunk[:length]
+	if _, err := io.ReadFull(dr.source, chunk); err != nil {
+		return fmt.Errorf("decrypt chunk: %w", err)
+	}
+	plain, err := dr.aead.Open(dr.plain[:0], chunkNonce(index, final), chunk, nil)
+	if err != nil {
+		return fmt.Errorf("decrypt chunk %d: %w: %w", index, ErrCorruptedBlob, err)
+	}
+	dr.index = index + 1
+	dr.buffer = plain[dr.offset-index*chunkSize:]
+	return nil
+}
+
+func (dr *decryptingReadSeeker) Seek(offset int64, whence int) (int64, error) {
+	var newOffset int64
+	switch whence {
+	case io.SeekStart:
+		newOffset = offset
+	case io.SeekCurrent:
+		newOffset = dr.offset + offset
+	case io.SeekEnd:
+		newOffset = dr.plainSize + offset
+	default:
+		return 0, errors.New("seek: invalid whence")
+	}
+	if newOffset < 0 {
+		return 0, errors.New("seek: negative position")
+	}
+	if newOffset != dr.offset {
+		dr.offset = newOffset
+		dr.buffer = nil
+		dr.index = -1
+	}
+	return dr.offset, nil
+}
+
+func (dr *decryptingReadSeeker) Close() error {
+	return dr.source.Close()
+}
+
+// NewDecryptingReadSeeker decrypts a blob encrypted with NewEncryptingReader.
+// Seeking is done in plaintext offsets and only reads the chunks needed.
+func NewDecryptingReadSeeker(
+	source io.ReadSeekCloser,
+	dataKey []byte,
+	plainSize int64,
+) (io.ReadSeekCloser, error) {
+	aead, err := newAEAD(dataKey)
+	if err != nil {
+		return nil, fmt.Errorf("new decrypting reader: %w", err)
+	}
+	return &decryptingReadSeeker{
+		source:    source,
+		aead:      aead,
+		plainSize: plainSize,
+		chunk:     make([]byte, encryptedChunkSize),
+		plain:     make([]byte, 0, chunkSize),
+	}, nil
+}
@@ -0,0 +1,291 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"codeberg.org/jvllmr/frans/internal/ent/accessevent"
+	"codeberg.org/jvllmr/frans/internal/ent/file"
+	"codeberg.org/jvllmr/frans/internal/ent/grant"
+	"codeberg.org/jvllmr/frans/internal/ent/recipient"
+	"codeberg.org/jvllmr/frans/internal/ent/ticket"
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+)
+
+// AccessEvent is the model entity for the AccessEvent schema.
+type AccessEvent struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Type holds the value of the "type" field.
+	Type string `json:"type,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Address holds the value of the "address" field.
+	Address string `json:"address,omitempty"`
+	// UserAgent holds the value of the "user_agent" field.
+	UserAgent string `json:"user_agent,omitempty"`
+	// BytesServed holds the value of the "bytes_served" field.
+	BytesServed int64 `json:"bytes_served,omitempty"`
+	// FileName holds the value of the "file_name" field.
+	FileName *string `json:"file_name,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the AccessEventQuery when eager-loading is set.
+	Edges                   AccessEventEdges `json:"edges"`
+	file_access_events      *uuid.UUID
+	grant_access_events     *uuid.UUID
+	recipient_access_events *uuid.UUID
+	ticket_access_events    *uuid.UUID
+	selectValues            sql.SelectValues
+}
+
+// AccessEventEdges holds the relations/edges for other nodes in the graph.
+type AccessEventEdges struct {
+	// Ticket holds the value of the ticket edge.
+	Ticket *Ticket `json:"ticket,omitempty"`
+	// Grant holds the value of the grant edge.
+	Grant *Grant `json:"grant,omitempty"`
+	// Recipient holds the value of the recipient edge.
+	Recipient *Recipient `json:"recipient,omitempty"`
+	// File holds the value of the file edge.
+	File *File `json:"file,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [4]bool
+}
+
+// TicketOrErr returns the Ticket value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e AccessEventEdges) TicketOrErr() (*Ticket, error) {
+	if e.Ticket != nil {
+		return e.Ticket, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: ticket.Label}
+	}
+	return nil, &NotLoadedError{edge: "ticket"}
+}
+
+// GrantOrErr returns the Grant value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e AccessEventEdges) GrantOrErr() (*Grant, error) {
+	if e.Grant != nil {
+		return e.Grant, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: grant.Label}
+	}
+	return nil, &NotLoadedError{edge: "grant"}
+}
+
+// RecipientOrErr returns the Recipient value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e AccessEventEdges) RecipientOrErr() (*Recipient, error) {
+	if e.Recipient != nil {
+		return e.Recipient, nil
+	} else if e.loadedTypes[2] {
+		return nil, &NotFoundError{label: recipient.Label}
+	}
+	return nil, &NotLoadedError{edge: "recipient"}
+}
+
+// FileOrErr returns the File value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e AccessEventEdges) FileOrErr() (*File, error) {
+	if e.File != nil {
+		return e.File, nil
+	} else if e.loadedTypes[3] {
+		return nil, &NotFoundError{label: file.Label}
+	}
+	return nil, &NotLoadedError{edge: "file"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*AccessEvent) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case accessevent.FieldID, accessevent.FieldBytesServed:
+			values[i] = new(sql.NullInt64)
+		case accessevent.FieldType, accessevent.FieldAddress, accessevent.FieldUserAgent, accessevent.FieldFileName:
+			values[i] = new(sql.NullString)
+		case accessevent.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		case accessevent.ForeignKeys[0]: // file_access_events
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		case accessevent.ForeignKeys[1]: // grant_access_events
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		case accessevent.ForeignKeys[2]: // recipient_access_events
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		case accessevent.ForeignKeys[3]: // ticket_access_events
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the AccessEvent fields.
+func (_m *AccessEvent) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case accessevent.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case accessevent.FieldType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field type", values[i])
+			} else if value.Valid {
+				_m.Type = value.String
+			}
+		case accessevent.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case accessevent.FieldAddress:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field address", values[i])
+			} else if value.Valid {
+				_m.Address = value.String
+			}
+		case accessevent.FieldUserAgent:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field user_agent", values[i])
+			} else if value.Valid {
+				_m.UserAgent = value.String
+			}
+		case accessevent.FieldBytesServed:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field bytes_served", values[i])
+			} else if value.Valid {
+				_m.BytesServed = value.Int64
+			}
+		case accessevent.FieldFileName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field file_name", values[i])
+			} else if value.Valid {
+				_m.FileName = new(string)
+				*_m.FileName = value.String
+			}
+		case accessevent.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field file_access_events", values[i])
+			} else if value.Valid {
+				_m.file_access_events = new(uuid.UUID)
+				*_m.file_access_events = *value.S.(*uuid.UUID)
+			}
+		case accessevent.ForeignKeys[1]:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field grant_access_events", values[i])
+			} else if value.Valid {
+				_m.grant_access_events = new(uuid.UUID)
+				*_m.grant_access_events = *value.S.(*uuid.UUID)
+			}
+		case accessevent.ForeignKeys[2]:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field recipient_access_events", values[i])
+			} else if value.Valid {
+				_m.recipient_access_events = new(uuid.UUID)
+				*_m.recipient_access_events = *value.S.(*uuid.UUID)
+			}
+		case accessevent.ForeignKeys[3]:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field ticket_access_events", values[i])
+			} else if value.Valid {
+				_m.ticket_access_events = new(uuid.UUID)
+				*_m.ticket_access_events = *value.S.(*uuid.UUID)
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the AccessEvent.
+// This includes values selected through modifiers, order, etc.
+func (_m *AccessEvent) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryTicket queries the "ticket" edge of the AccessEvent entity.
+func (_m *AccessEvent) QueryTicket() *TicketQuery {
+	return NewAccessEventClient(_m.config).QueryTicket(_m)
+}
+
+// QueryGrant queries the "grant" edge of the AccessEvent entity.
+func (_m *AccessEvent) QueryGrant() *GrantQuery {
+	return NewAccessEventClient(_m.config).QueryGrant(_m)
+}
+
+// QueryRecipient queries the "recipient" edge of the AccessEvent entity.
+func (_m *AccessEvent) QueryRecipient() *RecipientQuery {
+	return NewAccessEventClient(_m.config).QueryRecipient(_m)
+}
+
+// QueryFile queries the "file" edge of the AccessEvent entity.
+func (_m *AccessEvent) QueryFile() *FileQuery {
+	return NewAccessEventClient(_m.config).QueryFile(_m)
+}
+
+// Update returns a builder for updating this AccessEvent.
+// Note that you need to call AccessEvent.Unwrap() before calling this method if this AccessEvent
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *AccessEvent) Update() *AccessEventUpdateOne {
+	return NewAccessEventClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the AccessEvent entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *AccessEvent) Unwrap() *AccessEvent {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: AccessEvent is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *AccessEvent) String() string {
+	var builder strings.Builder
+	builder.WriteString("AccessEvent(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("type=")
+	builder.WriteString(_m.Type)
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("address=")
+	builder.WriteString(_m.Address)
+	builder.WriteString(", ")
+	builder.WriteString("user_agent=")
+	builder.WriteString(_m.UserAgent)
+	builder.WriteString(", ")
+	builder.WriteString("bytes_served=")
+	builder.WriteString(fmt.Sprintf("%v", _m.BytesServed))
+	builder.WriteString(", ")
+	if v := _m.FileName; v != nil {
+		builder.WriteString("file_name=")
+		builder.WriteString(*v)
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// AccessEvents is a parsable slice of AccessEvent.
+type AccessEvents []*AccessEvent
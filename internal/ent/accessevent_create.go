@@ -0,0 +1,445 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"codeberg.org/jvllmr/frans/internal/ent/accessevent"
+	"codeberg.org/jvllmr/frans/internal/ent/file"
+	"codeberg.org/jvllmr/frans/internal/ent/grant"
+	"codeberg.org/jvllmr/frans/internal/ent/recipient"
+	"codeberg.org/jvllmr/frans/internal/ent/ticket"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// AccessEventCreate is the builder for creating a AccessEvent entity.
+type AccessEventCreate struct {
+	config
+	mutation *AccessEventMutation
+	hooks    []Hook
+}
+
+// SetType sets the "type" field.
+func (_c *AccessEventCreate) SetType(v string) *AccessEventCreate {
+	_c.mutation.SetType(v)
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *AccessEventCreate) SetCreatedAt(v time.Time) *AccessEventCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *AccessEventCreate) SetNillableCreatedAt(v *time.Time) *AccessEventCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetAddress sets the "address" field.
+func (_c *AccessEventCreate) SetAddress(v string) *AccessEventCreate {
+	_c.mutation.SetAddress(v)
+	return _c
+}
+
+// SetUserAgent sets the "user_agent" field.
+func (_c *AccessEventCreate) SetUserAgent(v string) *AccessEventCreate {
+	_c.mutation.SetUserAgent(v)
+	return _c
+}
+
+// SetNillableUserAgent sets the "user_agent" field if the given value is not nil.
+func (_c *AccessEventCreate) SetNillableUserAgent(v *string) *AccessEventCreate {
+	if v != nil {
+		_c.SetUserAgent(*v)
+	}
+	return _c
+}
+
+// SetBytesServed sets the "bytes_served" field.
+func (_c *AccessEventCreate) SetBytesServed(v int64) *AccessEventCreate {
+	_c.mutation.SetBytesServed(v)
+	return _c
+}
+
+// SetNillableBytesServed sets the "bytes_served" field if the given value is not nil.
+func (_c *AccessEventCreate) SetNillableBytesServed(v *int64) *AccessEventCreate {
+	if v != nil {
+		_c.SetBytesServed(*v)
+	}
+	return _c
+}
+
+// SetFileName sets the "file_name" field.
+func (_c *AccessEventCreate) SetFileName(v string) *AccessEventCreate {
+	_c.mutation.SetFileName(v)
+	return _c
+}
+
+// SetNillableFileName sets the "file_name" field if the given value is not nil.
+func (_c *AccessEventCreate) SetNillableFileName(v *string) *AccessEventCreate {
+	if v != nil {
+		_c.SetFileName(*v)
+	}
+	return _c
+}
+
+// SetTicketID sets the "ticket" edge to the Ticket entity by ID.
+func (_c *AccessEventCreate) SetTicketID(id uuid.UUID) *AccessEventCreate {
+	_c.mutation.SetTicketID(id)
+	return _c
+}
+
+// SetNillableTicketID sets the "ticket" edge to the Ticket entity by ID if the given value is not nil.
+func (_c *AccessEventCreate) SetNillableTicketID(id *uuid.UUID) *AccessEventCreate {
+	if id != nil {
+		_c = _c.SetTicketID(*id)
+	}
+	return _c
+}
+
+// SetTicket sets the "ticket" edge to the Ticket entity.
+func (_c *AccessEventCreate) SetTicket(v *Ticket) *AccessEventCreate {
+	return _c.SetTicketID(v.ID)
+}
+
+// SetGrantID sets the "grant" edge to the Grant entity by ID.
+func (_c *AccessEventCreate) SetGrantID(id uuid.UUID) *AccessEventCreate {
+	_c.mutation.SetGrantID(id)
+	return _c
+}
+
+// SetNillableGrantID sets the "grant" edge to the Grant entity by ID if the given value is not nil.
+func (_c *AccessEventCreate) SetNillableGrantID(id *uuid.UUID) *AccessEventCreate {
+	if id != nil {
+		_c = _c.SetGrantID(*id)
+	}
+	return _c
+}
+
+// SetGrant sets the "grant" edge to the Grant entity.
+func (_c *AccessEventCreate) SetGrant(v *Grant) *AccessEventCreate {
+	return _c.SetGrantID(v.ID)
+}
+
+// SetRecipientID sets the "recipient" edge to the Recipient entity by ID.
+func (_c *AccessEventCreate) SetRecipientID(id uuid.UUID) *AccessEventCreate {
+	_c.mutation.SetRecipientID(id)
+	return _c
+}
+
+// SetNillableRecipientID sets the "recipient" edge to the Recipient entity by ID if the given value is not nil.
+func (_c *AccessEventCreate) SetNillableRecipientID(id *uuid.UUID) *AccessEventCreate {
+	if id != nil {
+		_c = _c.SetRecipientID(*id)
+	}
+	return _c
+}
+
+// SetRecipient sets the "recipient" edge to the Recipient entity.
+func (_c *AccessEventCreate) SetRecipient(v *Recipient) *AccessEventCreate {
+	return _c.SetRecipientID(v.ID)
+}
+
+// SetFileID sets the "file" edge to the File entity by ID.
+func (_c *AccessEventCreate) SetFileID(id uuid.UUID) *AccessEventCreate {
+	_c.mutation.SetFileID(id)
+	return _c
+}
+
+// SetNillableFileID sets the "file" edge to the File entity by ID if the given value is not nil.
+func (_c *AccessEventCreate) SetNillableFileID(id *uuid.UUID) *AccessEventCreate {
+	if id != nil {
+		_c = _c.SetFileID(*id)
+	}
+	return _c
+}
+
+// SetFile sets the "file" edge to the File entity.
+func (_c *AccessEventCreate) SetFile(v *File) *AccessEventCreate {
+	return _c.SetFileID(v.ID)
+}
+
+// Mutation returns the AccessEventMutation object of the builder.
+func (_c *AccessEventCreate) Mutation() *AccessEventMutation {
+	return _c.mutation
+}
+
+// Save creates the AccessEvent in the database.
+func (_c *AccessEventCreate) Save(ctx context.Context) (*AccessEvent, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *AccessEventCreate) SaveX(ctx context.Context) *AccessEvent {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *AccessEventCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *AccessEventCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *AccessEventCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := accessevent.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UserAgent(); !ok {
+		v := accessevent.DefaultUserAgent
+		_c.mutation.SetUserAgent(v)
+	}
+	if _, ok := _c.mutation.BytesServed(); !ok {
+		v := accessevent.DefaultBytesServed
+		_c.mutation.SetBytesServed(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *AccessEventCreate) check() error {
+	if _, ok := _c.mutation.GetType(); !ok {
+		return &ValidationError{Name: "type", err: errors.New(`ent: missing required field "AccessEvent.type"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "AccessEvent.created_at"`)}
+	}
+	if _, ok := _c.mutation.Address(); !ok {
+		return &ValidationError{Name: "address", err: errors.New(`ent: missing required field "AccessEvent.address"`)}
+	}
+	if _, ok := _c.mutation.UserAgent(); !ok {
+		return &ValidationError{Name: "user_agent", err: errors.New(`ent: missing required field "AccessEvent.user_agent"`)}
+	}
+	if _, ok := _c.mutation.BytesServed(); !ok {
+		return &ValidationError{Name: "bytes_served", err: errors.New(`ent: missing required field "AccessEvent.bytes_served"`)}
+	}
+	return nil
+}
+
+func (_c *AccessEventCreate) sqlSave(ctx context.Context) (*AccessEvent, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *AccessEventCreate) createSpec() (*AccessEvent, *sqlgraph.CreateSpec) {
+	var (
+		_node = &AccessEvent{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(accessevent.Table, sqlgraph.NewFieldSpec(accessevent.FieldID, field.TypeInt))
+	)
+	if value, ok := _c.mutation.GetType(); ok {
+		_spec.SetField(accessevent.FieldType, field.TypeString, value)
+		_node.Type = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(accessevent.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.Address(); ok {
+		_spec.SetField(accessevent.FieldAddress, field.TypeString, value)
+		_node.Address = value
+	}
+	if value, ok := _c.mutation.UserAgent(); ok {
+		_spec.SetField(accessevent.FieldUserAgent, field.TypeString, value)
+		_node.UserAgent = value
+	}
+	if value, ok := _c.mutation.BytesServed(); ok {
+		_spec.SetField(accessevent.FieldBytesServed, field.TypeInt64, value)
+		_node.BytesServed = value
+	}
+	if value, ok := _c.mutation.FileName(); ok {
+		_spec.SetField(accessevent.FieldFileName, field.TypeString, value)
+		_node.FileName = &value
+	}
+	if nodes := _c.mutation.TicketIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   accessevent.TicketTable,
+			Columns: []string{accessevent.TicketColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ticket.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.ticket_access_events = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.GrantIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   accessevent.GrantTable,
+			Columns: []string{accessevent.GrantColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(grant.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.grant_access_events = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.RecipientIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   accessevent.RecipientTable,
+			Columns: []string{accessevent.RecipientColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(recipient.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.recipient_access_events = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.FileIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   accessevent.FileTable,
+			Columns: []string{accessevent.FileColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(file.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.file_access_events = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// AccessEventCreateBulk is the builder for creating many AccessEvent entities in bulk.
+type AccessEventCreateBulk struct {
+	config
+	err      error
+	builders []*AccessEventCreate
+}
+
+// Save creates the AccessEvent entities in the database.
+func (_c *AccessEventCreateBulk) Save(ctx context.Context) ([]*AccessEvent, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*AccessEvent, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*AccessEventMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *AccessEventCreateBulk) SaveX(ctx context.Context) []*AccessEvent {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *AccessEventCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *AccessEventCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,855 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"codeberg.org/jvllmr/frans/internal/ent/accessevent"
+	"codeberg.org/jvllmr/frans/internal/ent/file"
+	"codeberg.org/jvllmr/frans/internal/ent/grant"
+	"codeberg.org/jvllmr/frans/internal/ent/predicate"
+	"codeberg.org/jvllmr/frans/internal/ent/recipient"
+	"codeberg.org/jvllmr/frans/internal/ent/ticket"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// AccessEventUpdate is the builder for updating AccessEvent entities.
+type AccessEventUpdate struct {
+	config
+	hooks    []Hook
+	mutation *AccessEventMutation
+}
+
+// Where appends a list predicates to the AccessEventUpdate builder.
+func (_u *AccessEventUpdate) Where(ps ...predicate.AccessEvent) *AccessEventUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetType sets the "type" field.
+func (_u *AccessEventUpdate) SetType(v string) *AccessEventUpdate {
+	_u.mutation.SetType(v)
+	return _u
+}
+
+// SetNillableType sets the "type" field if the given value is not nil.
+func (_u *AccessEventUpdate) SetNillableType(v *string) *AccessEventUpdate {
+	if v != nil {
+		_u.SetType(*v)
+	}
+	return _u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_u *AccessEventUpdate) SetCreatedAt(v time.Time) *AccessEventUpdate {
+	_u.mutation.SetCreatedAt(v)
+	return _u
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_u *AccessEventUpdate) SetNillableCreatedAt(v *time.Time) *AccessEventUpdate {
+	if v != nil {
+		_u.SetCreatedAt(*v)
+	}
+	return _u
+}
+
+// SetAddress sets the "address" field.
+func (_u *AccessEventUpdate) SetAddress(v string) *AccessEventUpdate {
+	_u.mutation.SetAddress(v)
+	return _u
+}
+
+// SetNillableAddress sets the "address" field if the given value is not nil.
+func (_u *AccessEventUpdate) SetNillableAddress(v *string) *AccessEventUpdate {
+	if v != nil {
+		_u.SetAddress(*v)
+	}
+	return _u
+}
+
+// SetUserAgent sets the "user_agent" field.
+func (_u *AccessEventUpdate) SetUserAgent(v string) *AccessEventUpdate {
+	_u.mutation.SetUserAgent(v)
+	return _u
+}
+
+// SetNillableUserAgent sets the "user_agent" field if the given value is not nil.
+func (_u *AccessEventUpdate) SetNillableUserAgent(v *string) *AccessEventUpdate {
+	if v != nil {
+		_u.SetUserAgent(*v)
+	}
+	return _u
+}
+
+// SetBytesServed sets the "bytes_served" field.
+func (_u *AccessEventUpdate) SetBytesServed(v int64) *AccessEventUpdate {
+	_u.mutation.ResetBytesServed()
+	_u.mutation.SetBytesServed(v)
+	return _u
+}
+
+// SetNillableBytesServed sets the "bytes_served" field if the given value is not nil.
+func (_u *AccessEventUpdate) SetNillableBytesServed(v *int64) *AccessEventUpdate {
+	if v != nil {
+		_u.SetBytesServed(*v)
+	}
+	return _u
+}
+
+// AddBytesServed adds value to the "bytes_served" field.
+func (_u *AccessEventUpdate) AddBytesServed(v int64) *AccessEventUpdate {
+	_u.mutation.AddBytesServed(v)
+	return _u
+}
+
+// SetFileName sets the "file_name" field.
+func (_u *AccessEventUpdate) SetFileName(v string) *AccessEventUpdate {
+	_u.mutation.SetFileName(v)
+	return _u
+}
+
+// SetNillableFileName sets the "file_name" field if the given value is not nil.
+func (_u *AccessEventUpdate) SetNillableFileName(v *string) *AccessEventUpdate {
+	if v != nil {
+		_u.SetFileName(*v)
+	}
+	return _u
+}
+
+// ClearFileName clears the value of the "file_name" field.
+func (_u *AccessEventUpdate) ClearFileName() *AccessEventUpdate {
+	_u.mutation.ClearFileName()
+	return _u
+}
+
+// SetTicketID sets the "ticket" edge to the Ticket entity by ID.
+func (_u *AccessEventUpdate) SetTicketID(id uuid.UUID) *AccessEventUpdate {
+	_u.mutation.SetTicketID(id)
+	return _u
+}
+
+// SetNillableTicketID sets the "ticket" edge to the Ticket entity by ID if the given value is not nil.
+func (_u *AccessEventUpdate) SetNillableTicketID(id *uuid.UUID) *AccessEventUpdate {
+	if id != nil {
+		_u = _u.SetTicketID(*id)
+	}
+	return _u
+}
+
+// SetTicket sets the "ticket" edge to the Ticket entity.
+func (_u *AccessEventUpdate) SetTicket(v *Ticket) *AccessEventUpdate {
+	return _u.SetTicketID(v.ID)
+}
+
+// SetGrantID sets the "grant" edge to the Grant entity by ID.
+func (_u *AccessEventUpdate) SetGrantID(id uuid.UUID) *AccessEventUpdate {
+	_u.mutation.SetGrantID(id)
+	return _u
+}
+
+// SetNillableGrantID sets the "grant" edge to the Grant entity by ID if the given value is not nil.
+func (_u *AccessEventUpdate) SetNillableGrantID(id *uuid.UUID) *AccessEventUpdate {
+	if id != nil {
+		_u = _u.SetGrantID(*id)
+	}
+	return _u
+}
+
+// SetGrant sets the "grant" edge to the Grant entity.
+func (_u *AccessEventUpdate) SetGrant(v *Grant) *AccessEventUpdate {
+	return _u.SetGrantID(v.ID)
+}
+
+// SetRecipientID sets the "recipient" edge to the Recipient entity by ID.
+func (_u *AccessEventUpdate) SetRecipientID(id uuid.UUID) *AccessEventUpdate {
+	_u.mutation.SetRecipientID(id)
+	return _u
+}
+
+// SetNillableRecipientID sets the "recipient" edge to the Recipient entity by ID if the given value is not nil.
+func (_u *AccessEventUpdate) SetNillableRecipientID(id *uuid.UUID) *AccessEventUpdate {
+	if id != nil {
+		_u = _u.SetRecipientID(*id)
+	}
+	return _u
+}
+
+// SetRecipient sets the "recipient" edge to the Recipient entity.
+func (_u *AccessEventUpdate) SetRecipient(v *Recipient) *AccessEventUpdate {
+	return _u.SetRecipientID(v.ID)
+}
+
+// SetFileID sets the "file" edge to the File entity by ID.
+func (_u *AccessEventUpdate) SetFileID(id uuid.UUID) *AccessEventUpdate {
+	_u.mutation.SetFileID(id)
+	return _u
+}
+
+// SetNillableFileID sets the "file" edge to the File entity by ID if the given value is not nil.
+func (_u *AccessEventUpdate) SetNillableFileID(id *uuid.UUID) *AccessEventUpdate {
+	if id != nil {
+		_u = _u.SetFileID(*id)
+	}
+	return _u
+}
+
+// SetFile sets the "file" edge to the File entity.
+func (_u *AccessEventUpdate) SetFile(v *File) *AccessEventUpdate {
+	return _u.SetFileID(v.ID)
+}
+
+// Mutation returns the AccessEventMutation object of the builder.
+func (_u *AccessEventUpdate) Mutation() *AccessEventMutation {
+	return _u.mutation
+}
+
+// ClearTicket clears the "ticket" edge to the Ticket entity.
+func (_u *AccessEventUpdate) ClearTicket() *AccessEventUpdate {
+	_u.mutation.ClearTicket()
+	return _u
+}
+
+// ClearGrant clears the "grant" edge to the Grant entity.
+func (_u *AccessEventUpdate) ClearGrant() *AccessEventUpdate {
+	_u.mutation.ClearGrant()
+	return _u
+}
+
+// ClearRecipient clears the "recipient" edge to the Recipient entity.
+func (_u *AccessEventUpdate) ClearRecipient() *AccessEventUpdate {
+	_u.mutation.ClearRecipient()
+	return _u
+}
+
+// ClearFile clears the "file" edge to the File entity.
+func (_u *AccessEventUpdate) ClearFile() *AccessEventUpdate {
+	_u.mutation.ClearFile()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *AccessEventUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *AccessEventUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *AccessEventUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *AccessEventUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (_u *AccessEventUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(accessevent.Table, accessevent.Columns, sqlgraph.NewFieldSpec(accessevent.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.GetType(); ok {
+		_spec.SetField(accessevent.FieldType, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.CreatedAt(); ok {
+		_spec.SetField(accessevent.FieldCreatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Address(); ok {
+		_spec.SetField(accessevent.FieldAddress, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.UserAgent(); ok {
+		_spec.SetField(accessevent.FieldUserAgent, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.BytesServed(); ok {
+		_spec.SetField(accessevent.FieldBytesServed, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedBytesServed(); ok {
+		_spec.AddField(accessevent.FieldBytesServed, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.FileName(); ok {
+		_spec.SetField(accessevent.FieldFileName, field.TypeString, value)
+	}
+	if _u.mutation.FileNameCleared() {
+		_spec.ClearField(accessevent.FieldFileName, field.TypeString)
+	}
+	if _u.mutation.TicketCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   accessevent.TicketTable,
+			Columns: []string{accessevent.TicketColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ticket.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.TicketIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   accessevent.TicketTable,
+			Columns: []string{accessevent.TicketColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ticket.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.GrantCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   accessevent.GrantTable,
+			Columns: []string{accessevent.GrantColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(grant.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.GrantIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   accessevent.GrantTable,
+			Columns: []string{accessevent.GrantColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(grant.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.RecipientCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   accessevent.RecipientTable,
+			Columns: []string{accessevent.RecipientColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(recipient.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RecipientIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   accessevent.RecipientTable,
+			Columns: []string{accessevent.RecipientColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(recipient.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.FileCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   accessevent.FileTable,
+			Columns: []string{accessevent.FileColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(file.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.FileIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   accessevent.FileTable,
+			Columns: []string{accessevent.FileColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(file.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{accessevent.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// AccessEventUpdateOne is the builder for updating a single AccessEvent entity.
+type AccessEventUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *AccessEventMutation
+}
+
+// SetType sets the "type" field.
+func (_u *AccessEventUpdateOne) SetType(v string) *AccessEventUpdateOne {
+	_u.mutation.SetType(v)
+	return _u
+}
+
+// SetNillableType sets the "type" field if the given value is not nil.
+func (_u *AccessEventUpdateOne) SetNillableType(v *string) *AccessEventUpdateOne {
+	if v != nil {
+		_u.SetType(*v)
+	}
+	return _u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_u *AccessEventUpdateOne) SetCreatedAt(v time.Time) *AccessEventUpdateOne {
+	_u.mutation.SetCreatedAt(v)
+	return _u
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_u *AccessEventUpdateOne) SetNillableCreatedAt(v *time.Time) *AccessEventUpdateOne {
+	if v != nil {
+		_u.SetCreatedAt(*v)
+	}
+	return _u
+}
+
+// SetAddress sets the "address" field.
+func (_u *AccessEventUpdateOne) SetAddress(v string) *AccessEventUpdateOne {
+	_u.mutation.SetAddress(v)
+	return _u
+}
+
+// SetNillableAddress sets the "address" field if the given value is not nil.
+func (_u *AccessEventUpdateOne) SetNillableAddress(v *string) *AccessEventUpdateOne {
+	if v != nil {
+		_u.SetAddress(*v)
+	}
+	return _u
+}
+
+// SetUserAgent sets the "user_agent" field.
+func (_u *AccessEventUpdateOne) SetUserAgent(v string) *AccessEventUpdateOne {
+	_u.mutation.SetUserAgent(v)
+	return _u
+}
+
+// SetNillableUserAgent sets the "user_agent" field if the given value is not nil.
+func (_u *AccessEventUpdateOne) SetNillableUserAgent(v *string) *AccessEventUpdateOne {
+	if v != nil {
+		_u.SetUserAgent(*v)
+	}
+	return _u
+}
+
+// SetBytesServed sets the "bytes_served" field.
+func (_u *AccessEventUpdateOne) SetBytesServed(v int64) *AccessEventUpdateOne {
+	_u.mutation.ResetBytesServed()
+	_u.mutation.SetBytesServed(v)
+	return _u
+}
+
+// SetNillableBytesServed sets the "bytes_served" field if the given value is not nil.
+func (_u *AccessEventUpdateOne) SetNillableBytesServed(v *int64) *AccessEventUpdateOne {
+	if v != nil {
+		_u.SetBytesServed(*v)
+	}
+	return _u
+}
+
+// AddBytesServed adds value to the "bytes_served" field.
+func (_u *AccessEventUpdateOne) AddBytesServed(v int64) *AccessEventUpdateOne {
+	_u.mutation.AddBytesServed(v)
+	return _u
+}
+
+// SetFileName sets the "file_name" field.
+func (_u *AccessEventUpdateOne) SetFileName(v string) *AccessEventUpdateOne {
+	_u.mutation.SetFileName(v)
+	return _u
+}
+
+// SetNillableFileName sets the "file_name" field if the given value is not nil.
+func (_u *AccessEventUpdateOne) SetNillableFileName(v *string) *AccessEventUpdateOne {
+	if v != nil {
+		_u.SetFileName(*v)
+	}
+	return _u
+}
+
+// ClearFileName clears the value of the "file_name" field.
+func (_u *AccessEventUpdateOne) ClearFileName() *AccessEventUpdateOne {
+	_u.mutation.ClearFileName()
+	return _u
+}
+
+// SetTicketID sets the "ticket" edge to the Ticket entity by ID.
+func (_u *AccessEventUpdateOne) SetTicketID(id uuid.UUID) *AccessEventUpdateOne {
+	_u.mutation.SetTicketID(id)
+	return _u
+}
+
+// SetNillableTicketID sets the "ticket" edge to the Ticket entity by ID if the given value is not nil.
+func (_u *AccessEventUpdateOne) SetNillableTicketID(id *uuid.UUID) *AccessEventUpdateOne {
+	if id != nil {
+		_u = _u.SetTicketID(*id)
+	}
+	return _u
+}
+
+// SetTicket sets the "ticket" edge to the Ticket entity.
+func (_u *AccessEventUpdateOne) SetTicket(v *Ticket) *AccessEventUpdateOne {
+	return _u.SetTicketID(v.ID)
+}
+
+// SetGrantID sets the "grant" edge to the Grant entity by ID.
+func (_u *AccessEventUpdateOne) SetGrantID(id uuid.UUID) *AccessEventUpdateOne {
+	_u.mutation.SetGrantID(id)
+	return _u
+}
+
+// SetNillableGrantID sets the "grant" edge to the Grant entity by ID if the given value is not nil.
+func (_u *AccessEventUpdateOne) SetNillableGrantID(id *uuid.UUID) *AccessEventUpdateOne {
+	if id != nil {
+		_u = _u.SetGrantID(*id)
+	}
+	return _u
+}
+
+// SetGrant sets the "grant" edge to the Grant entity.
+func (_u *AccessEventUpdateOne) SetGrant(v *Grant) *AccessEventUpdateOne {
+	return _u.SetGrantID(v.ID)
+}
+
+// SetRecipientID sets the "recipient" edge to the Recipient entity by ID.
+func (_u *AccessEventUpdateOne) SetRecipientID(id uuid.UUID) *AccessEventUpdateOne {
+	_u.mutation.SetRecipientID(id)
+	return _u
+}
+
+// SetNillableRecipientID sets the "recipient" edge to the Recipient entity by ID if the given value is not nil.
+func (_u *AccessEventUpdateOne) SetNillableRecipientID(id *uuid.UUID) *AccessEventUpdateOne {
+	if id != nil {
+		_u = _u.SetRecipientID(*id)
+	}
+	return _u
+}
+
+// SetRecipient sets the "recipient" edge to the Recipient entity.
+func (_u *AccessEventUpdateOne) SetRecipient(v *Recipient) *AccessEventUpdateOne {
+	return _u.SetRecipientID(v.ID)
+}
+
+// SetFileID sets the "file" edge to the File entity by ID.
+func (_u *AccessEventUpdateOne) SetFileID(id uuid.UUID) *AccessEventUpdateOne {
+	_u.mutation.SetFileID(id)
+	return _u
+}
+
+// SetNillableFileID sets the "file" edge to the File entity by ID if the given value is not nil.
+func (_u *AccessEventUpdateOne) SetNillableFileID(id *uuid.UUID) *AccessEventUpdateOne {
+	if id != nil {
+		_u = _u.SetFileID(*id)
+	}
+	return _u
+}
+
+// SetFile sets the "file" edge to the File entity.
+func (_u *AccessEventUpdateOne) SetFile(v *File) *AccessEventUpdateOne {
+	return _u.SetFileID(v.ID)
+}
+
+// Mutation returns the AccessEventMutation object of the builder.
+func (_u *AccessEventUpdateOne) Mutation() *AccessEventMutation {
+	return _u.mutation
+}
+
+// ClearTicket clears the "ticket" edge to the Ticket entity.
+func (_u *AccessEventUpdateOne) ClearTicket() *AccessEventUpdateOne {
+	_u.mutation.ClearTicket()
+	return _u
+}
+
+// ClearGrant clears the "grant" edge to the Grant entity.
+func (_u *AccessEventUpdateOne) ClearGrant() *AccessEventUpdateOne {
+	_u.mutation.ClearGrant()
+	return _u
+}
+
+// ClearRecipient clears the "recipient" edge to the Recipient entity.
+func (_u *AccessEventUpdateOne) ClearRecipient() *AccessEventUpdateOne {
+	_u.mutation.ClearRecipient()
+	return _u
+}
+
+// ClearFile clears the "file" edge to the File entity.
+func (_u *AccessEventUpdateOne) ClearFile() *AccessEventUpdateOne {
+	_u.mutation.ClearFile()
+	return _u
+}
+
+// Where appends a list predicates to the AccessEventUpdate builder.
+func (_u *AccessEventUpdateOne) Where(ps ...predicate.AccessEvent) *AccessEventUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *AccessEventUpdateOne) Select(field string, fields ...string) *AccessEventUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated AccessEvent entity.
+func (_u *AccessEventUpdateOne) Save(ctx context.Context) (*AccessEvent, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *AccessEventUpdateOne) SaveX(ctx context.Context) *AccessEvent {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *AccessEventUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *AccessEventUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (_u *AccessEventUpdateOne) sqlSave(ctx context.Context) (_node *AccessEvent, err error) {
+	_spec := sqlgraph.NewUpdateSpec(accessevent.Table, accessevent.Columns, sqlgraph.NewFieldSpec(accessevent.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "AccessEvent.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, accessevent.FieldID)
+		for _, f := range fields {
+			if !accessevent.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != accessevent.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.GetType(); ok {
+		_spec.SetField(accessevent.FieldType, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.CreatedAt(); ok {
+		_spec.SetField(accessevent.FieldCreatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Address(); ok {
+		_spec.SetField(accessevent.FieldAddress, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.UserAgent(); ok {
+		_spec.SetField(accessevent.FieldUserAgent, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.BytesServed(); ok {
+		_spec.SetField(accessevent.FieldBytesServed, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedBytesServed(); ok {
+		_spec.AddField(accessevent.FieldBytesServed, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.FileName(); ok {
+		_spec.SetField(accessevent.FieldFileName, field.TypeString, value)
+	}
+	if _u.mutation.FileNameCleared() {
+		_spec.ClearField(accessevent.FieldFileName, field.TypeString)
+	}
+	if _u.mutation.TicketCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   accessevent.TicketTable,
+			Columns: []string{accessevent.TicketColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ticket.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.TicketIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   accessevent.TicketTable,
+			Columns: []string{accessevent.TicketColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ticket.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.GrantCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   accessevent.GrantTable,
+			Columns: []string{accessevent.GrantColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(grant.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.GrantIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   accessevent.GrantTable,
+			Columns: []string{accessevent.GrantColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(grant.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.RecipientCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   accessevent.RecipientTable,
+			Columns: []string{accessevent.RecipientColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(recipient.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RecipientIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   accessevent.RecipientTable,
+			Columns: []string{accessevent.RecipientColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(recipient.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.FileCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   accessevent.FileTable,
+			Columns: []string{accessevent.FileColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(file.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.FileIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   accessevent.FileTable,
+			Columns: []string{accessevent.FileColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(file.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &AccessEvent{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{accessevent.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -12,12 +12,17 @@ import (
 	"codeberg.org/jvllmr/frans/internal/ent/migrate"
 	"github.com/google/uuid"
 
+	"codeberg.org/jvllmr/frans/internal/ent/accessevent"
+	"codeberg.org/jvllmr/frans/internal/ent/downloadprogress"
 	"codeberg.org/jvllmr/frans/internal/ent/file"
 	"codeberg.org/jvllmr/frans/internal/ent/filedata"
 	"codeberg.org/jvllmr/frans/internal/ent/grant"
+	"codeberg.org/jvllmr/frans/internal/ent/recipient"
 	"codeberg.org/jvllmr/frans/internal/ent/session"
 	"codeberg.org/jvllmr/frans/internal/ent/shareaccesstoken"
+	"codeberg.org/jvllmr/frans/internal/ent/storageverification"
 	"codeberg.org/jvllmr/frans/internal/ent/ticket"
+	"codeberg.org/jvllmr/frans/internal/ent/upload"
 	"codeberg.org/jvllmr/frans/internal/ent/user"
 	"entgo.io/ent"
 	"entgo.io/ent/dialect"
@@ -30,18 +35,28 @@ type Client struct {
 	config
 	// Schema is the client for creating, migrating and dropping schema.
 	Schema *migrate.Schema
+	// AccessEvent is the client for interacting with the AccessEvent builders.
+	AccessEvent *AccessEventClient
+	// DownloadProgress is the client for interacting with the DownloadProgress builders.
+	DownloadProgress *DownloadProgressClient
 	// File is the client for interacting with the File builders.
 	File *FileClient
 	// FileData is the client for interacting with the FileData builders.
 	FileData *FileDataClient
 	// Grant is the client for interacting with the Grant builders.
 	Grant *GrantClient
+	// Recipient is the client for interacting with the Recipient builders.
+	Recipient *RecipientClient
 	// Session is the client for interacting with the Session builders.
 	Session *SessionClient
 	// ShareAccessToken is the client for interacting with the ShareAccessToken builders.
 	ShareAccessToken *ShareAccessTokenClient
+	// StorageVerification is the client for interacting with the StorageVerification builders.
+	StorageVerification *StorageVerificationClient
 	// Ticket is the client for interacting with the Ticket builders.
 	Ticket *TicketClient
+	// Upload is the client for interacting with the Upload builders.
+	Upload *UploadClient
 	// User is the client for interacting with the User builders.
 	User *UserClient
 }
@@ -55,12 +70,17 @@ func NewClient(opts ...Option) *Client {
 
 func (c *Client) init() {
 	c.Schema = migrate.NewSchema(c.driver)
+	c.AccessEvent = NewAccessEventClient(c.config)
+	c.DownloadProgress = NewDownloadProgressClient(c.config)
 	c.File = NewFileClient(c.config)
 	c.FileData = NewFileDataClient(c.config)
 	c.Grant = NewGrantClient(c.config)
+	c.Recipient = NewRecipientClient(c.config)
 	c.Session = NewSessionClient(c.config)
 	c.ShareAccessToken = NewShareAccessTokenClient(c.config)
+	c.StorageVerification = NewStorageVerificationClient(c.config)
 	c.Ticket = NewTicketClient(c.config)
+	c.Upload = NewUploadClient(c.config)
 	c.User = NewUserClient(c.config)
 }
 
@@ -152,15 +172,20 @@ func (c *Client) Tx(ctx context.Context) (*Tx, error) {
 	cfg := c.config
 	cfg.driver = tx
 	return &Tx{
-		ctx:              ctx,
-		config:           cfg,
-		File:             NewFileClient(cfg),
-		FileData:         NewFileDataClient(cfg),
-		Grant:            NewGrantClient(cfg),
-		Session:          NewSessionClient(cfg),
-		ShareAccessToken: NewShareAccessTokenClient(cfg),
-		Ticket:           NewTicketClient(cfg),
-		User:             NewUserClient(cfg),
+		ctx:                 ctx,
+		config:              cfg,
+		AccessEvent:         NewAccessEventClient(cfg),
+		DownloadProgress:    NewDownloadProgressClient(cfg),
+		File:                NewFileClient(cfg),
+		FileData:            NewFileDataClient(cfg),
+		Grant:               NewGrantClient(cfg),
+		Recipient:           NewRecipientClient(cfg),
+		Session:             NewSessionClient(cfg),
+		ShareAccessToken:    NewShareAccessTokenClient(cfg),
+		StorageVerification: NewStorageVerificationClient(cfg),
+		Ticket:              NewTicketClient(cfg),
+		Upload:              NewUploadClient(cfg),
+		User:                NewUserClient(cfg),
 	}, nil
 }
 
@@ -178,22 +203,27 @@ func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error)
 	cfg := c.config
 	cfg.driver = &txDriver{tx: tx, drv: c.driver}
 	return &Tx{
-		ctx:              ctx,
-		config:           cfg,
-		File:             NewFileClient(cfg),
-		FileData:         NewFileDataClient(cfg),
-		Grant:            NewGrantClient(cfg),
-		Session:          NewSessionClient(cfg),
-		ShareAccessToken: NewShareAccessTokenClient(cfg),
-		Ticket:           NewTicketClient(cfg),
-		User:             NewUserClient(cfg),
+		ctx:                 ctx,
+		config:              cfg,
+		AccessEvent:         NewAccessEventClient(cfg),
+		DownloadProgress:    NewDownloadProgressClient(cfg),
+		File:                NewFileClient(cfg),
+		FileData:            NewFileDataClient(cfg),
+		Grant:               NewGrantClient(cfg),
+		Recipient:           NewRecipientClient(cfg),
+		Session:             NewSessionClient(cfg),
+		ShareAccessToken:    NewShareAccessTokenClient(cfg),
+		StorageVerification: NewStorageVerificationClient(cfg),
+		Ticket:              NewTicketClient(cfg),
+		Upload:              NewUploadClient(cfg),
+		User:                NewUserClient(cfg),
 	}, nil
 }
 
 // Debug returns a new debug-client. It's used to get verbose logging on specific operations.
 //
 //	client.Debug().
-//		File.
+//		AccessEvent.
 //		Query().
 //		Count(ctx)
 func (c *Client) Debug() *Client {
@@ -216,7 +246,9 @@ func (c *Client) Close() error {
 // In order to add hooks to a specific client, call: `client.Node.Use(...)`.
 func (c *Client) Use(hooks ...Hook) {
 	for _, n := range []interface{ Use(...Hook) }{
-		c.File, c.FileData, c.Grant, c.Session, c.ShareAccessToken, c.Ticket, c.User,
+		c.AccessEvent, c.DownloadProgress, c.File, c.FileData, c.Grant, c.Recipient,
+		c.Session, c.ShareAccessToken, c.StorageVerification, c.Ticket, c.Upload,
+		c.User,
 	} {
 		n.Use(hooks...)
 	}
@@ -226,7 +258,9 @@ func (c *Client) Use(hooks ...Hook) {
 // In order to add interceptors to a specific client, call: `client.Node.Intercept(...)`.
 func (c *Client) Intercept(interceptors ...Interceptor) {
 	for _, n := range []interface{ Intercept(...Interceptor) }{
-		c.File, c.FileData, c.Grant, c.Session, c.ShareAccessToken, c.Ticket, c.User,
+		c.AccessEvent, c.DownloadProgress, c.File, c.FileData, c.Grant, c.Recipient,
+		c.Session, c.ShareAccessToken, c.StorageVerification, c.Ticket, c.Upload,
+		c.User,
 	} {
 		n.Intercept(interceptors...)
 	}
@@ -235,18 +269,28 @@ func (c *Client) Intercept(interceptors ...Interceptor) {
 // Mutate implements the ent.Mutator interface.
 func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 	switch m := m.(type) {
+	case *AccessEventMutation:
+		return c.AccessEvent.mutate(ctx, m)
+	case *DownloadProgressMutation:
+		return c.DownloadProgress.mutate(ctx, m)
 	case *FileMutation:
 		return c.File.mutate(ctx, m)
 	case *FileDataMutation:
 		return c.FileData.mutate(ctx, m)
 	case *GrantMutation:
 		return c.Grant.mutate(ctx, m)
+	case *RecipientMutation:
+		return c.Recipient.mutate(ctx, m)
 	case *SessionMutation:
 		return c.Session.mutate(ctx, m)
 	case *ShareAccessTokenMutation:
 		return c.ShareAccessToken.mutate(ctx, m)
+	case *StorageVerificationMutation:
+		return c.StorageVerification.mutate(ctx, m)
 	case *TicketMutation:
 		return c.Ticket.mutate(ctx, m)
+	case *UploadMutation:
+		return c.Upload.mutate(ctx, m)
 	case *UserMutation:
 		return c.User.mutate(ctx, m)
 	default:
@@ -254,6 +298,352 @@ func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 	}
 }
 
+// AccessEventClient is a client for the AccessEvent schema.
+type AccessEventClient struct {
+	config
+}
+
+// NewAccessEventClient returns a client for the AccessEvent from the given config.
+func NewAccessEventClient(c config) *AccessEventClient {
+	return &AccessEventClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `accessevent.Hooks(f(g(h())))`.
+func (c *AccessEventClient) Use(hooks ...Hook) {
+	c.hooks.AccessEvent = append(c.hooks.AccessEvent, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `accessevent.Intercept(f(g(h())))`.
+func (c *AccessEventClient) Intercept(interceptors ...Interceptor) {
+	c.inters.AccessEvent = append(c.inters.AccessEvent, interceptors...)
+}
+
+// Create returns a builder for creating a AccessEvent entity.
+func (c *AccessEventClient) Create() *AccessEventCreate {
+	mutation := newAccessEventMutation(c.config, OpCreate)
+	return &AccessEventCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of AccessEvent entities.
+func (c *AccessEventClient) CreateBulk(builders ...*AccessEventCreate) *AccessEventCreateBulk {
+	return &AccessEventCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *AccessEventClient) MapCreateBulk(slice any, setFunc func(*AccessEventCreate, int)) *AccessEventCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &AccessEventCreateBulk{err: fmt.Errorf("calling to AccessEventClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*AccessEventCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &AccessEventCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for AccessEvent.
+func (c *AccessEventClient) Update() *AccessEventUpdate {
+	mutation := newAccessEventMutation(c.config, OpUpdate)
+	return &AccessEventUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *AccessEventClient) UpdateOne(_m *AccessEvent) *AccessEventUpdateOne {
+	mutation := newAccessEventMutation(c.config, OpUpdateOne, withAccessEvent(_m))
+	return &AccessEventUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *AccessEventClient) UpdateOneID(id int) *AccessEventUpdateOne {
+	mutation := newAccessEventMutation(c.config, OpUpdateOne, withAccessEventID(id))
+	return &AccessEventUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for AccessEvent.
+func (c *AccessEventClient) Delete() *AccessEventDelete {
+	mutation := newAccessEventMutation(c.config, OpDelete)
+	return &AccessEventDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *AccessEventClient) DeleteOne(_m *AccessEvent) *AccessEventDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *AccessEventClient) DeleteOneID(id int) *AccessEventDeleteOne {
+	builder := c.Delete().Where(accessevent.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &AccessEventDeleteOne{builder}
+}
+
+// Query returns a query builder for AccessEvent.
+func (c *AccessEventClient) Query() *AccessEventQuery {
+	return &AccessEventQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeAccessEvent},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a AccessEvent entity by its id.
+func (c *AccessEventClient) Get(ctx context.Context, id int) (*AccessEvent, error) {
+	return c.Query().Where(accessevent.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *AccessEventClient) GetX(ctx context.Context, id int) *AccessEvent {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryTicket queries the ticket edge of a AccessEvent.
+func (c *AccessEventClient) QueryTicket(_m *AccessEvent) *TicketQuery {
+	query := (&TicketClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(accessevent.Table, accessevent.FieldID, id),
+			sqlgraph.To(ticket.Table, ticket.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, accessevent.TicketTable, accessevent.TicketColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryGrant queries the grant edge of a AccessEvent.
+func (c *AccessEventClient) QueryGrant(_m *AccessEvent) *GrantQuery {
+	query := (&GrantClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(accessevent.Table, accessevent.FieldID, id),
+			sqlgraph.To(grant.Table, grant.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, accessevent.GrantTable, accessevent.GrantColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryRecipient queries the recipient edge of a AccessEvent.
+func (c *AccessEventClient) QueryRecipient(_m *AccessEvent) *RecipientQuery {
+	query := (&RecipientClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(accessevent.Table, accessevent.FieldID, id),
+			sqlgraph.To(recipient.Table, recipient.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, accessevent.RecipientTable, accessevent.RecipientColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryFile queries the file edge of a AccessEvent.
+func (c *AccessEventClient) QueryFile(_m *AccessEvent) *FileQuery {
+	query := (&FileClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(accessevent.Table, accessevent.FieldID, id),
+			sqlgraph.To(file.Table, file.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, accessevent.FileTable, accessevent.FileColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *AccessEventClient) Hooks() []Hook {
+	return c.hooks.AccessEvent
+}
+
+// Interceptors returns the client interceptors.
+func (c *AccessEventClient) Interceptors() []Interceptor {
+	return c.inters.AccessEvent
+}
+
+func (c *AccessEventClient) mutate(ctx context.Context, m *AccessEventMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&AccessEventCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&AccessEventUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&AccessEventUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&AccessEventDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown AccessEvent mutation op: %q", m.Op())
+	}
+}
+
+// DownloadProgressClient is a client for the DownloadProgress schema.
+type DownloadProgressClient struct {
+	config
+}
+
+// NewDownloadProgressClient returns a client for the DownloadProgress from the given config.
+func NewDownloadProgressClient(c config) *DownloadProgressClient {
+	return &DownloadProgressClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `downloadprogress.Hooks(f(g(h())))`.
+func (c *DownloadProgressClient) Use(hooks ...Hook) {
+	c.hooks.DownloadProgress = append(c.hooks.DownloadProgress, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `downloadprogress.Intercept(f(g(h())))`.
+func (c *DownloadProgressClient) Intercept(interceptors ...Interceptor) {
+	c.inters.DownloadProgress = append(c.inters.DownloadProgress, interceptors...)
+}
+
+// Create returns a builder for creating a DownloadProgress entity.
+func (c *DownloadProgressClient) Create() *DownloadProgressCreate {
+	mutation := newDownloadProgressMutation(c.config, OpCreate)
+	return &DownloadProgressCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of DownloadProgress entities.
+func (c *DownloadProgressClient) CreateBulk(builders ...*DownloadProgressCreate) *DownloadProgressCreateBulk {
+	return &DownloadProgressCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *DownloadProgressClient) MapCreateBulk(slice any, setFunc func(*DownloadProgressCreate, int)) *DownloadProgressCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &DownloadProgressCreateBulk{err: fmt.Errorf("calling to DownloadProgressClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*DownloadProgressCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &DownloadProgressCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for DownloadProgress.
+func (c *DownloadProgressClient) Update() *DownloadProgressUpdate {
+	mutation := newDownloadProgressMutation(c.config, OpUpdate)
+	return &DownloadProgressUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *DownloadProgressClient) UpdateOne(_m *DownloadProgress) *DownloadProgressUpdateOne {
+	mutation := newDownloadProgressMutation(c.config, OpUpdateOne, withDownloadProgress(_m))
+	return &DownloadProgressUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *DownloadProgressClient) UpdateOneID(id int) *DownloadProgressUpdateOne {
+	mutation := newDownloadProgressMutation(c.config, OpUpdateOne, withDownloadProgressID(id))
+	return &DownloadProgressUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for DownloadProgress.
+func (c *DownloadProgressClient) Delete() *DownloadProgressDelete {
+	mutation := newDownloadProgressMutation(c.config, OpDelete)
+	return &DownloadProgressDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *DownloadProgressClient) DeleteOne(_m *DownloadProgress) *DownloadProgressDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *DownloadProgressClient) DeleteOneID(id int) *DownloadProgressDeleteOne {
+	builder := c.Delete().Where(downloadprogress.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &DownloadProgressDeleteOne{builder}
+}
+
+// Query returns a query builder for DownloadProgress.
+func (c *DownloadProgressClient) Query() *DownloadProgressQuery {
+	return &DownloadProgressQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeDownloadProgress},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a DownloadProgress entity by its id.
+func (c *DownloadProgressClient) Get(ctx context.Context, id int) (*DownloadProgress, error) {
+	return c.Query().Where(downloadprogress.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *DownloadProgressClient) GetX(ctx context.Context, id int) *DownloadProgress {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryFile queries the file edge of a DownloadProgress.
+func (c *DownloadProgressClient) QueryFile(_m *DownloadProgress) *FileQuery {
+	query := (&FileClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(downloadprogress.Table, downloadprogress.FieldID, id),
+			sqlgraph.To(file.Table, file.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, downloadprogress.FileTable, downloadprogress.FileColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *DownloadProgressClient) Hooks() []Hook {
+	return c.hooks.DownloadProgress
+}
+
+// Interceptors returns the client interceptors.
+func (c *DownloadProgressClient) Interceptors() []Interceptor {
+	return c.inters.DownloadProgress
+}
+
+func (c *DownloadProgressClient) mutate(ctx context.Context, m *DownloadProgressMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&DownloadProgressCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&DownloadProgressUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&DownloadProgressUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&DownloadProgressDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown DownloadProgress mutation op: %q", m.Op())
+	}
+}
+
 // FileClient is a client for the File schema.
 type FileClient struct {
 	config
@@ -426,6 +816,38 @@ func (c *FileClient) QueryData(_m *File) *FileDataQuery {
 	return query
 }
 
+// QueryDownloadProgresses queries the download_progresses edge of a File.
+func (c *FileClient) QueryDownloadProgresses(_m *File) *DownloadProgressQuery {
+	query := (&DownloadProgressClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(file.Table, file.FieldID, id),
+			sqlgraph.To(downloadprogress.Table, downloadprogress.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, file.DownloadProgressesTable, file.DownloadProgressesColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryAccessEvents queries the access_events edge of a File.
+func (c *FileClient) QueryAccessEvents(_m *File) *AccessEventQuery {
+	query := (&AccessEventClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(file.Table, file.FieldID, id),
+			sqlgraph.To(accessevent.Table, accessevent.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, file.AccessEventsTable, file.AccessEventsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
 // Hooks returns the client hooks.
 func (c *FileClient) Hooks() []Hook {
 	return c.hooks.File
@@ -689,18 +1111,231 @@ func (c *GrantClient) DeleteOneID(id uuid.UUID) *GrantDeleteOne {
 func (c *GrantClient) Query() *GrantQuery {
 	return &GrantQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeGrant},
+		ctx:    &QueryContext{Type: TypeGrant},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Grant entity by its id.
+func (c *GrantClient) Get(ctx context.Context, id uuid.UUID) (*Grant, error) {
+	return c.Query().Where(grant.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *GrantClient) GetX(ctx context.Context, id uuid.UUID) *Grant {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryFiles queries the files edge of a Grant.
+func (c *GrantClient) QueryFiles(_m *Grant) *FileQuery {
+	query := (&FileClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(grant.Table, grant.FieldID, id),
+			sqlgraph.To(file.Table, file.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, grant.FilesTable, grant.FilesColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryOwner queries the owner edge of a Grant.
+func (c *GrantClient) QueryOwner(_m *Grant) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(grant.Table, grant.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, grant.OwnerTable, grant.OwnerColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryShareaccesstokens queries the shareaccesstokens edge of a Grant.
+func (c *GrantClient) QueryShareaccesstokens(_m *Grant) *ShareAccessTokenQuery {
+	query := (&ShareAccessTokenClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(grant.Table, grant.FieldID, id),
+			sqlgraph.To(shareaccesstoken.Table, shareaccesstoken.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, grant.ShareaccesstokensTable, grant.ShareaccesstokensColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryUploads queries the uploads edge of a Grant.
+func (c *GrantClient) QueryUploads(_m *Grant) *UploadQuery {
+	query := (&UploadClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(grant.Table, grant.FieldID, id),
+			sqlgraph.To(upload.Table, upload.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, grant.UploadsTable, grant.UploadsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryAccessEvents queries the access_events edge of a Grant.
+func (c *GrantClient) QueryAccessEvents(_m *Grant) *AccessEventQuery {
+	query := (&AccessEventClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(grant.Table, grant.FieldID, id),
+			sqlgraph.To(accessevent.Table, accessevent.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, grant.AccessEventsTable, grant.AccessEventsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *GrantClient) Hooks() []Hook {
+	return c.hooks.Grant
+}
+
+// Interceptors returns the client interceptors.
+func (c *GrantClient) Interceptors() []Interceptor {
+	return c.inters.Grant
+}
+
+func (c *GrantClient) mutate(ctx context.Context, m *GrantMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&GrantCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&GrantUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&GrantUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&GrantDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Grant mutation op: %q", m.Op())
+	}
+}
+
+// RecipientClient is a client for the Recipient schema.
+type RecipientClient struct {
+	config
+}
+
+// NewRecipientClient returns a client for the Recipient from the given config.
+func NewRecipientClient(c config) *RecipientClient {
+	return &RecipientClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `recipient.Hooks(f(g(h())))`.
+func (c *RecipientClient) Use(hooks ...Hook) {
+	c.hooks.Recipient = append(c.hooks.Recipient, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `recipient.Intercept(f(g(h())))`.
+func (c *RecipientClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Recipient = append(c.inters.Recipient, interceptors...)
+}
+
+// Create returns a builder for creating a Recipient entity.
+func (c *RecipientClient) Create() *RecipientCreate {
+	mutation := newRecipientMutation(c.config, OpCreate)
+	return &RecipientCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Recipient entities.
+func (c *RecipientClient) CreateBulk(builders ...*RecipientCreate) *RecipientCreateBulk {
+	return &RecipientCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *RecipientClient) MapCreateBulk(slice any, setFunc func(*RecipientCreate, int)) *RecipientCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &RecipientCreateBulk{err: fmt.Errorf("calling to RecipientClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*RecipientCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &RecipientCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Recipient.
+func (c *RecipientClient) Update() *RecipientUpdate {
+	mutation := newRecipientMutation(c.config, OpUpdate)
+	return &RecipientUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *RecipientClient) UpdateOne(_m *Recipient) *RecipientUpdateOne {
+	mutation := newRecipientMutation(c.config, OpUpdateOne, withRecipient(_m))
+	return &RecipientUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *RecipientClient) UpdateOneID(id uuid.UUID) *RecipientUpdateOne {
+	mutation := newRecipientMutation(c.config, OpUpdateOne, withRecipientID(id))
+	return &RecipientUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Recipient.
+func (c *RecipientClient) Delete() *RecipientDelete {
+	mutation := newRecipientMutation(c.config, OpDelete)
+	return &RecipientDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *RecipientClient) DeleteOne(_m *Recipient) *RecipientDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *RecipientClient) DeleteOneID(id uuid.UUID) *RecipientDeleteOne {
+	builder := c.Delete().Where(recipient.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &RecipientDeleteOne{builder}
+}
+
+// Query returns a query builder for Recipient.
+func (c *RecipientClient) Query() *RecipientQuery {
+	return &RecipientQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeRecipient},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a Grant entity by its id.
-func (c *GrantClient) Get(ctx context.Context, id uuid.UUID) (*Grant, error) {
-	return c.Query().Where(grant.ID(id)).Only(ctx)
+// Get returns a Recipient entity by its id.
+func (c *RecipientClient) Get(ctx context.Context, id uuid.UUID) (*Recipient, error) {
+	return c.Query().Where(recipient.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *GrantClient) GetX(ctx context.Context, id uuid.UUID) *Grant {
+func (c *RecipientClient) GetX(ctx context.Context, id uuid.UUID) *Recipient {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -708,15 +1343,15 @@ func (c *GrantClient) GetX(ctx context.Context, id uuid.UUID) *Grant {
 	return obj
 }
 
-// QueryFiles queries the files edge of a Grant.
-func (c *GrantClient) QueryFiles(_m *Grant) *FileQuery {
-	query := (&FileClient{config: c.config}).Query()
+// QueryTicket queries the ticket edge of a Recipient.
+func (c *RecipientClient) QueryTicket(_m *Recipient) *TicketQuery {
+	query := (&TicketClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(grant.Table, grant.FieldID, id),
-			sqlgraph.To(file.Table, file.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, grant.FilesTable, grant.FilesColumn),
+			sqlgraph.From(recipient.Table, recipient.FieldID, id),
+			sqlgraph.To(ticket.Table, ticket.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, recipient.TicketTable, recipient.TicketColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -724,15 +1359,15 @@ func (c *GrantClient) QueryFiles(_m *Grant) *FileQuery {
 	return query
 }
 
-// QueryOwner queries the owner edge of a Grant.
-func (c *GrantClient) QueryOwner(_m *Grant) *UserQuery {
-	query := (&UserClient{config: c.config}).Query()
+// QueryShareaccesstokens queries the shareaccesstokens edge of a Recipient.
+func (c *RecipientClient) QueryShareaccesstokens(_m *Recipient) *ShareAccessTokenQuery {
+	query := (&ShareAccessTokenClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(grant.Table, grant.FieldID, id),
-			sqlgraph.To(user.Table, user.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, grant.OwnerTable, grant.OwnerColumn),
+			sqlgraph.From(recipient.Table, recipient.FieldID, id),
+			sqlgraph.To(shareaccesstoken.Table, shareaccesstoken.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, recipient.ShareaccesstokensTable, recipient.ShareaccesstokensColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -740,15 +1375,15 @@ func (c *GrantClient) QueryOwner(_m *Grant) *UserQuery {
 	return query
 }
 
-// QueryShareaccesstokens queries the shareaccesstokens edge of a Grant.
-func (c *GrantClient) QueryShareaccesstokens(_m *Grant) *ShareAccessTokenQuery {
-	query := (&ShareAccessTokenClient{config: c.config}).Query()
+// QueryAccessEvents queries the access_events edge of a Recipient.
+func (c *RecipientClient) QueryAccessEvents(_m *Recipient) *AccessEventQuery {
+	query := (&AccessEventClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(grant.Table, grant.FieldID, id),
-			sqlgraph.To(shareaccesstoken.Table, shareaccesstoken.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, grant.ShareaccesstokensTable, grant.ShareaccesstokensColumn),
+			sqlgraph.From(recipient.Table, recipient.FieldID, id),
+			sqlgraph.To(accessevent.Table, accessevent.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, recipient.AccessEventsTable, recipient.AccessEventsColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -757,27 +1392,27 @@ func (c *GrantClient) QueryShareaccesstokens(_m *Grant) *ShareAccessTokenQuery {
 }
 
 // Hooks returns the client hooks.
-func (c *GrantClient) Hooks() []Hook {
-	return c.hooks.Grant
+func (c *RecipientClient) Hooks() []Hook {
+	return c.hooks.Recipient
 }
 
 // Interceptors returns the client interceptors.
-func (c *GrantClient) Interceptors() []Interceptor {
-	return c.inters.Grant
+func (c *RecipientClient) Interceptors() []Interceptor {
+	return c.inters.Recipient
 }
 
-func (c *GrantClient) mutate(ctx context.Context, m *GrantMutation) (Value, error) {
+func (c *RecipientClient) mutate(ctx context.Context, m *RecipientMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&GrantCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&RecipientCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&GrantUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&RecipientUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&GrantUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&RecipientUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&GrantDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&RecipientDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown Grant mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown Recipient mutation op: %q", m.Op())
 	}
 }
 
@@ -1070,6 +1705,22 @@ func (c *ShareAccessTokenClient) QueryGrant(_m *ShareAccessToken) *GrantQuery {
 	return query
 }
 
+// QueryRecipient queries the recipient edge of a ShareAccessToken.
+func (c *ShareAccessTokenClient) QueryRecipient(_m *ShareAccessToken) *RecipientQuery {
+	query := (&RecipientClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(shareaccesstoken.Table, shareaccesstoken.FieldID, id),
+			sqlgraph.To(recipient.Table, recipient.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, shareaccesstoken.RecipientTable, shareaccesstoken.RecipientColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
 // Hooks returns the client hooks.
 func (c *ShareAccessTokenClient) Hooks() []Hook {
 	return c.hooks.ShareAccessToken
@@ -1095,6 +1746,139 @@ func (c *ShareAccessTokenClient) mutate(ctx context.Context, m *ShareAccessToken
 	}
 }
 
+// StorageVerificationClient is a client for the StorageVerification schema.
+type StorageVerificationClient struct {
+	config
+}
+
+// NewStorageVerificationClient returns a client for the StorageVerification from the given config.
+func NewStorageVerificationClient(c config) *StorageVerificationClient {
+	return &StorageVerificationClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `storageverification.Hooks(f(g(h())))`.
+func (c *StorageVerificationClient) Use(hooks ...Hook) {
+	c.hooks.StorageVerification = append(c.hooks.StorageVerification, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `storageverification.Intercept(f(g(h())))`.
+func (c *StorageVerificationClient) Intercept(interceptors ...Interceptor) {
+	c.inters.StorageVerification = append(c.inters.StorageVerification, interceptors...)
+}
+
+// Create returns a builder for creating a StorageVerification entity.
+func (c *StorageVerificationClient) Create() *StorageVerificationCreate {
+	mutation := newStorageVerificationMutation(c.config, OpCreate)
+	return &StorageVerificationCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of StorageVerification entities.
+func (c *StorageVerificationClient) CreateBulk(builders ...*StorageVerificationCreate) *StorageVerificationCreateBulk {
+	return &StorageVerificationCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *StorageVerificationClient) MapCreateBulk(slice any, setFunc func(*StorageVerificationCreate, int)) *StorageVerificationCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &StorageVerificationCreateBulk{err: fmt.Errorf("calling to StorageVerificationClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*StorageVerificationCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &StorageVerificationCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for StorageVerification.
+func (c *StorageVerificationClient) Update() *StorageVerificationUpdate {
+	mutation := newStorageVerificationMutation(c.config, OpUpdate)
+	return &StorageVerificationUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *StorageVerificationClient) UpdateOne(_m *StorageVerification) *StorageVerificationUpdateOne {
+	mutation := newStorageVerificationMutation(c.config, OpUpdateOne, withStorageVerification(_m))
+	return &StorageVerificationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *StorageVerificationClient) UpdateOneID(id int) *StorageVerificationUpdateOne {
+	mutation := newStorageVerificationMutation(c.config, OpUpdateOne, withStorageVerificationID(id))
+	return &StorageVerificationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for StorageVerification.
+func (c *StorageVerificationClient) Delete() *StorageVerificationDelete {
+	mutation := newStorageVerificationMutation(c.config, OpDelete)
+	return &StorageVerificationDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *StorageVerificationClient) DeleteOne(_m *StorageVerification) *StorageVerificationDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *StorageVerificationClient) DeleteOneID(id int) *StorageVerificationDeleteOne {
+	builder := c.Delete().Where(storageverification.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &StorageVerificationDeleteOne{builder}
+}
+
+// Query returns a query builder for StorageVerification.
+func (c *StorageVerificationClient) Query() *StorageVerificationQuery {
+	return &StorageVerificationQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeStorageVerification},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a StorageVerification entity by its id.
+func (c *StorageVerificationClient) Get(ctx context.Context, id int) (*StorageVerification, error) {
+	return c.Query().Where(storageverification.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *StorageVerificationClient) GetX(ctx context.Context, id int) *StorageVerification {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *StorageVerificationClient) Hooks() []Hook {
+	return c.hooks.StorageVerification
+}
+
+// Interceptors returns the client interceptors.
+func (c *StorageVerificationClient) Interceptors() []Interceptor {
+	return c.inters.StorageVerification
+}
+
+func (c *StorageVerificationClient) mutate(ctx context.Context, m *StorageVerificationMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&StorageVerificationCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&StorageVerificationUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&StorageVerificationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&StorageVerificationDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown StorageVerification mutation op: %q", m.Op())
+	}
+}
+
 // TicketClient is a client for the Ticket schema.
 type TicketClient struct {
 	config
@@ -1251,6 +2035,38 @@ func (c *TicketClient) QueryShareaccesstokens(_m *Ticket) *ShareAccessTokenQuery
 	return query
 }
 
+// QueryRecipients queries the recipients edge of a Ticket.
+func (c *TicketClient) QueryRecipients(_m *Ticket) *RecipientQuery {
+	query := (&RecipientClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(ticket.Table, ticket.FieldID, id),
+			sqlgraph.To(recipient.Table, recipient.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, ticket.RecipientsTable, ticket.RecipientsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryAccessEvents queries the access_events edge of a Ticket.
+func (c *TicketClient) QueryAccessEvents(_m *Ticket) *AccessEventQuery {
+	query := (&AccessEventClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(ticket.Table, ticket.FieldID, id),
+			sqlgraph.To(accessevent.Table, accessevent.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, ticket.AccessEventsTable, ticket.AccessEventsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
 // Hooks returns the client hooks.
 func (c *TicketClient) Hooks() []Hook {
 	return c.hooks.Ticket
@@ -1276,6 +2092,171 @@ func (c *TicketClient) mutate(ctx context.Context, m *TicketMutation) (Value, er
 	}
 }
 
+// UploadClient is a client for the Upload schema.
+type UploadClient struct {
+	config
+}
+
+// NewUploadClient returns a client for the Upload from the given config.
+func NewUploadClient(c config) *UploadClient {
+	return &UploadClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `upload.Hooks(f(g(h())))`.
+func (c *UploadClient) Use(hooks ...Hook) {
+	c.hooks.Upload = append(c.hooks.Upload, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `upload.Intercept(f(g(h())))`.
+func (c *UploadClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Upload = append(c.inters.Upload, interceptors...)
+}
+
+// Create returns a builder for creating a Upload entity.
+func (c *UploadClient) Create() *UploadCreate {
+	mutation := newUploadMutation(c.config, OpCreate)
+	return &UploadCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Upload entities.
+func (c *UploadClient) CreateBulk(builders ...*UploadCreate) *UploadCreateBulk {
+	return &UploadCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *UploadClient) MapCreateBulk(slice any, setFunc func(*UploadCreate, int)) *UploadCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &UploadCreateBulk{err: fmt.Errorf("calling to UploadClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*UploadCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &UploadCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Upload.
+func (c *UploadClient) Update() *UploadUpdate {
+	mutation := newUploadMutation(c.config, OpUpdate)
+	return &UploadUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *UploadClient) UpdateOne(_m *Upload) *UploadUpdateOne {
+	mutation := newUploadMutation(c.config, OpUpdateOne, withUpload(_m))
+	return &UploadUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *UploadClient) UpdateOneID(id uuid.UUID) *UploadUpdateOne {
+	mutation := newUploadMutation(c.config, OpUpdateOne, withUploadID(id))
+	return &UploadUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Upload.
+func (c *UploadClient) Delete() *UploadDelete {
+	mutation := newUploadMutation(c.config, OpDelete)
+	return &UploadDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *UploadClient) DeleteOne(_m *Upload) *UploadDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *UploadClient) DeleteOneID(id uuid.UUID) *UploadDeleteOne {
+	builder := c.Delete().Where(upload.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &UploadDeleteOne{builder}
+}
+
+// Query returns a query builder for Upload.
+func (c *UploadClient) Query() *UploadQuery {
+	return &UploadQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeUpload},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Upload entity by its id.
+func (c *UploadClient) Get(ctx context.Context, id uuid.UUID) (*Upload, error) {
+	return c.Query().Where(upload.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *UploadClient) GetX(ctx context.Context, id uuid.UUID) *Upload {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryOwner queries the owner edge of a Upload.
+func (c *UploadClient) QueryOwner(_m *Upload) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(upload.Table, upload.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, upload.OwnerTable, upload.OwnerColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryGrant queries the grant edge of a Upload.
+func (c *UploadClient) QueryGrant(_m *Upload) *GrantQuery {
+	query := (&GrantClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(upload.Table, upload.FieldID, id),
+			sqlgraph.To(grant.Table, grant.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, upload.GrantTable, upload.GrantColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *UploadClient) Hooks() []Hook {
+	return c.hooks.Upload
+}
+
+// Interceptors returns the client interceptors.
+func (c *UploadClient) Interceptors() []Interceptor {
+	return c.inters.Upload
+}
+
+func (c *UploadClient) mutate(ctx context.Context, m *UploadMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&UploadCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&UploadUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&UploadUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&UploadDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Upload mutation op: %q", m.Op())
+	}
+}
+
 // UserClient is a client for the User schema.
 type UserClient struct {
 	config
@@ -1448,6 +2429,22 @@ func (c *UserClient) QueryFiles(_m *User) *FileQuery {
 	return query
 }
 
+// QueryUploads queries the uploads edge of a User.
+func (c *UserClient) QueryUploads(_m *User) *UploadQuery {
+	query := (&UploadClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(upload.Table, upload.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.UploadsTable, user.UploadsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
 // Hooks returns the client hooks.
 func (c *UserClient) Hooks() []Hook {
 	return c.hooks.User
@@ -1476,9 +2473,11 @@ func (c *UserClient) mutate(ctx context.Context, m *UserMutation) (Value, error)
 // hooks and interceptors per client, for fast access.
 type (
 	hooks struct {
-		File, FileData, Grant, Session, ShareAccessToken, Ticket, User []ent.Hook
+		AccessEvent, DownloadProgress, File, FileData, Grant, Recipient, Session,
+		ShareAccessToken, StorageVerification, Ticket, Upload, User []ent.Hook
 	}
 	inters struct {
-		File, FileData, Grant, Session, ShareAccessToken, Ticket, User []ent.Interceptor
+		AccessEvent, DownloadProgress, File, FileData, Grant, Recipient, Session,
+		ShareAccessToken, StorageVerification, Ticket, Upload, User []ent.Interceptor
 	}
 )
@@ -0,0 +1,184 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"codeberg.org/jvllmr/frans/internal/ent/downloadprogress"
+	"codeberg.org/jvllmr/frans/internal/ent/file"
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+)
+
+// DownloadProgress is the model entity for the DownloadProgress schema.
+type DownloadProgress struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Token holds the value of the "token" field.
+	Token string `json:"token,omitempty"`
+	// Ranges holds the value of the "ranges" field.
+	Ranges [][2]int64 `json:"ranges,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// Version holds the value of the "version" field.
+	Version int64 `json:"version,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the DownloadProgressQuery when eager-loading is set.
+	Edges                    DownloadProgressEdges `json:"edges"`
+	file_download_progresses *uuid.UUID
+	selectValues             sql.SelectValues
+}
+
+// DownloadProgressEdges holds the relations/edges for other nodes in the graph.
+type DownloadProgressEdges struct {
+	// File holds the value of the file edge.
+	File *File `json:"file,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// FileOrErr returns the File value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e DownloadProgressEdges) FileOrErr() (*File, error) {
+	if e.File != nil {
+		return e.File, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: file.Label}
+	}
+	return nil, &NotLoadedError{edge: "file"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*DownloadProgress) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case downloadprogress.FieldRanges:
+			values[i] = new([]byte)
+		case downloadprogress.FieldID, downloadprogress.FieldVersion:
+			values[i] = new(sql.NullInt64)
+		case downloadprogress.FieldToken:
+			values[i] = new(sql.NullString)
+		case downloadprogress.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		case downloadprogress.ForeignKeys[0]: // file_download_progresses
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the DownloadProgress fields.
+func (_m *DownloadProgress) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case downloadprogress.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case downloadprogress.FieldToken:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field token", values[i])
+			} else if value.Valid {
+				_m.Token = value.String
+			}
+		case downloadprogress.FieldRanges:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field ranges", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.Ranges); err != nil {
+					return fmt.Errorf("unmarshal field ranges: %w", err)
+				}
+			}
+		case downloadprogress.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		case downloadprogress.FieldVersion:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field version", values[i])
+			} else if value.Valid {
+				_m.Version = value.Int64
+			}
+		case downloadprogress.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field file_download_progresses", values[i])
+			} else if value.Valid {
+				_m.file_download_progresses = new(uuid.UUID)
+				*_m.file_download_progresses = *value.S.(*uuid.UUID)
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the DownloadProgress.
+// This includes values selected through modifiers, order, etc.
+func (_m *DownloadProgress) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryFile queries the "file" edge of the DownloadProgress entity.
+func (_m *DownloadProgress) QueryFile() *FileQuery {
+	return NewDownloadProgressClient(_m.config).QueryFile(_m)
+}
+
+// Update returns a builder for updating this DownloadProgress.
+// Note that you need to call DownloadProgress.Unwrap() before calling this method if this DownloadProgress
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *DownloadProgress) Update() *DownloadProgressUpdateOne {
+	return NewDownloadProgressClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the DownloadProgress entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *DownloadProgress) Unwrap() *DownloadProgress {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: DownloadProgress is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *DownloadProgress) String() string {
+	var builder strings.Builder
+	builder.WriteString("DownloadProgress(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("token=")
+	builder.WriteString(_m.Token)
+	builder.WriteString(", ")
+	builder.WriteString("ranges=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Ranges))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("version=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Version))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// DownloadProgresses is a parsable slice of DownloadProgress.
+type DownloadProgresses []*DownloadProgress
@@ -0,0 +1,283 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"codeberg.org/jvllmr/frans/internal/ent/downloadprogress"
+	"codeberg.org/jvllmr/frans/internal/ent/file"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// DownloadProgressCreate is the builder for creating a DownloadProgress entity.
+type DownloadProgressCreate struct {
+	config
+	mutation *DownloadProgressMutation
+	hooks    []Hook
+}
+
+// SetToken sets the "token" field.
+func (_c *DownloadProgressCreate) SetToken(v string) *DownloadProgressCreate {
+	_c.mutation.SetToken(v)
+	return _c
+}
+
+// SetRanges sets the "ranges" field.
+func (_c *DownloadProgressCreate) SetRanges(v [][2]int64) *DownloadProgressCreate {
+	_c.mutation.SetRanges(v)
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *DownloadProgressCreate) SetUpdatedAt(v time.Time) *DownloadProgressCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *DownloadProgressCreate) SetNillableUpdatedAt(v *time.Time) *DownloadProgressCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetVersion sets the "version" field.
+func (_c *DownloadProgressCreate) SetVersion(v int64) *DownloadProgressCreate {
+	_c.mutation.SetVersion(v)
+	return _c
+}
+
+// SetNillableVersion sets the "version" field if the given value is not nil.
+func (_c *DownloadProgressCreate) SetNillableVersion(v *int64) *DownloadProgressCreate {
+	if v != nil {
+		_c.SetVersion(*v)
+	}
+	return _c
+}
+
+// SetFileID sets the "file" edge to the File entity by ID.
+func (_c *DownloadProgressCreate) SetFileID(id uuid.UUID) *DownloadProgressCreate {
+	_c.mutation.SetFileID(id)
+	return _c
+}
+
+// SetFile sets the "file" edge to the File entity.
+func (_c *DownloadProgressCreate) SetFile(v *File) *DownloadProgressCreate {
+	return _c.SetFileID(v.ID)
+}
+
+// Mutation returns the DownloadProgressMutation object of the builder.
+func (_c *DownloadProgressCreate) Mutation() *DownloadProgressMutation {
+	return _c.mutation
+}
+
+// Save creates the DownloadProgress in the database.
+func (_c *DownloadProgressCreate) Save(ctx context.Context) (*DownloadProgress, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *DownloadProgressCreate) SaveX(ctx context.Context) *DownloadProgress {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *DownloadProgressCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *DownloadProgressCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *DownloadProgressCreate) defaults() {
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := downloadprogress.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := _c.mutation.Version(); !ok {
+		v := downloadprogress.DefaultVersion
+		_c.mutation.SetVersion(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *DownloadProgressCreate) check() error {
+	if _, ok := _c.mutation.Token(); !ok {
+		return &ValidationError{Name: "token", err: errors.New(`ent: missing required field "DownloadProgress.token"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "DownloadProgress.updated_at"`)}
+	}
+	if _, ok := _c.mutation.Version(); !ok {
+		return &ValidationError{Name: "version", err: errors.New(`ent: missing required field "DownloadProgress.version"`)}
+	}
+	if len(_c.mutation.FileIDs()) == 0 {
+		return &ValidationError{Name: "file", err: errors.New(`ent: missing required edge "DownloadProgress.file"`)}
+	}
+	return nil
+}
+
+func (_c *DownloadProgressCreate) sqlSave(ctx context.Context) (*DownloadProgress, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *DownloadProgressCreate) createSpec() (*DownloadProgress, *sqlgraph.CreateSpec) {
+	var (
+		_node = &DownloadProgress{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(downloadprogress.Table, sqlgraph.NewFieldSpec(downloadprogress.FieldID, field.TypeInt))
+	)
+	if value, ok := _c.mutation.Token(); ok {
+		_spec.SetField(downloadprogress.FieldToken, field.TypeString, value)
+		_node.Token = value
+	}
+	if value, ok := _c.mutation.Ranges(); ok {
+		_spec.SetField(downloadprogress.FieldRanges, field.TypeJSON, value)
+		_node.Ranges = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(downloadprogress.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := _c.mutation.Version(); ok {
+		_spec.SetField(downloadprogress.FieldVersion, field.TypeInt64, value)
+		_node.Version = value
+	}
+	if nodes := _c.mutation.FileIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   downloadprogress.FileTable,
+			Columns: []string{downloadprogress.FileColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(file.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.file_download_progresses = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// DownloadProgressCreateBulk is the builder for creating many DownloadProgress entities in bulk.
+type DownloadProgressCreateBulk struct {
+	config
+	err      error
+	builders []*DownloadProgressCreate
+}
+
+// Save creates the DownloadProgress entities in the database.
+func (_c *DownloadProgressCreateBulk) Save(ctx context.Context) ([]*DownloadProgress, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*DownloadProgress, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*DownloadProgressMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *DownloadProgressCreateBulk) SaveX(ctx context.Context) []*DownloadProgress {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *DownloadProgressCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *DownloadProgressCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
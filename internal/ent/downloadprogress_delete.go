@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"codeberg.org/jvllmr/frans/internal/ent/downloadprogress"
+	"codeberg.org/jvllmr/frans/internal/ent/predicate"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// DownloadProgressDelete is the builder for deleting a DownloadProgress entity.
+type DownloadProgressDelete struct {
+	config
+	hooks    []Hook
+	mutation *DownloadProgressMutation
+}
+
+// Where appends a list predicates to the DownloadProgressDelete builder.
+func (_d *DownloadProgressDelete) Where(ps ...predicate.DownloadProgress) *DownloadProgressDelete {
+	_d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (_d *DownloadProgressDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, _d.sqlExec, _d.mutation, _d.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *DownloadProgressDelete) ExecX(ctx context.Context) int {
+	n, err := _d.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (_d *DownloadProgressDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(downloadprogress.Table, sqlgraph.NewFieldSpec(downloadprogress.FieldID, field.TypeInt))
+	if ps := _d.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, _d.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	_d.mutation.done = true
+	return affected, err
+}
+
+// DownloadProgressDeleteOne is the builder for deleting a single DownloadProgress entity.
+type DownloadProgressDeleteOne struct {
+	_d *DownloadProgressDelete
+}
+
+// Where appends a list predicates to the DownloadProgressDelete builder.
+func (_d *DownloadProgressDeleteOne) Where(ps ...predicate.DownloadProgress) *DownloadProgressDeleteOne {
+	_d._d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query.
+func (_d *DownloadProgressDeleteOne) Exec(ctx context.Context) error {
+	n, err := _d._d.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{downloadprogress.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *DownloadProgressDeleteOne) ExecX(ctx context.Context) {
+	if err := _d.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
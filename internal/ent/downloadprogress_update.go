@@ -0,0 +1,475 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"codeberg.org/jvllmr/frans/internal/ent/downloadprogress"
+	"codeberg.org/jvllmr/frans/internal/ent/file"
+	"codeberg.org/jvllmr/frans/internal/ent/predicate"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// DownloadProgressUpdate is the builder for updating DownloadProgress entities.
+type DownloadProgressUpdate struct {
+	config
+	hooks    []Hook
+	mutation *DownloadProgressMutation
+}
+
+// Where appends a list predicates to the DownloadProgressUpdate builder.
+func (_u *DownloadProgressUpdate) Where(ps ...predicate.DownloadProgress) *DownloadProgressUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetToken sets the "token" field.
+func (_u *DownloadProgressUpdate) SetToken(v string) *DownloadProgressUpdate {
+	_u.mutation.SetToken(v)
+	return _u
+}
+
+// SetNillableToken sets the "token" field if the given value is not nil.
+func (_u *DownloadProgressUpdate) SetNillableToken(v *string) *DownloadProgressUpdate {
+	if v != nil {
+		_u.SetToken(*v)
+	}
+	return _u
+}
+
+// SetRanges sets the "ranges" field.
+func (_u *DownloadProgressUpdate) SetRanges(v [][2]int64) *DownloadProgressUpdate {
+	_u.mutation.SetRanges(v)
+	return _u
+}
+
+// AppendRanges appends value to the "ranges" field.
+func (_u *DownloadProgressUpdate) AppendRanges(v [][2]int64) *DownloadProgressUpdate {
+	_u.mutation.AppendRanges(v)
+	return _u
+}
+
+// ClearRanges clears the value of the "ranges" field.
+func (_u *DownloadProgressUpdate) ClearRanges() *DownloadProgressUpdate {
+	_u.mutation.ClearRanges()
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *DownloadProgressUpdate) SetUpdatedAt(v time.Time) *DownloadProgressUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetVersion sets the "version" field.
+func (_u *DownloadProgressUpdate) SetVersion(v int64) *DownloadProgressUpdate {
+	_u.mutation.ResetVersion()
+	_u.mutation.SetVersion(v)
+	return _u
+}
+
+// SetNillableVersion sets the "version" field if the given value is not nil.
+func (_u *DownloadProgressUpdate) SetNillableVersion(v *int64) *DownloadProgressUpdate {
+	if v != nil {
+		_u.SetVersion(*v)
+	}
+	return _u
+}
+
+// AddVersion adds value to the "version" field.
+func (_u *DownloadProgressUpdate) AddVersion(v int64) *DownloadProgressUpdate {
+	_u.mutation.AddVersion(v)
+	return _u
+}
+
+// SetFileID sets the "file" edge to the File entity by ID.
+func (_u *DownloadProgressUpdate) SetFileID(id uuid.UUID) *DownloadProgressUpdate {
+	_u.mutation.SetFileID(id)
+	return _u
+}
+
+// SetFile sets the "file" edge to the File entity.
+func (_u *DownloadProgressUpdate) SetFile(v *File) *DownloadProgressUpdate {
+	return _u.SetFileID(v.ID)
+}
+
+// Mutation returns the DownloadProgressMutation object of the builder.
+func (_u *DownloadProgressUpdate) Mutation() *DownloadProgressMutation {
+	return _u.mutation
+}
+
+// ClearFile clears the "file" edge to the File entity.
+func (_u *DownloadProgressUpdate) ClearFile() *DownloadProgressUpdate {
+	_u.mutation.ClearFile()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *DownloadProgressUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *DownloadProgressUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *DownloadProgressUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *DownloadProgressUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *DownloadProgressUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := downloadprogress.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *DownloadProgressUpdate) check() error {
+	if _u.mutation.FileCleared() && len(_u.mutation.FileIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "DownloadProgress.file"`)
+	}
+	return nil
+}
+
+func (_u *DownloadProgressUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(downloadprogress.Table, downloadprogress.Columns, sqlgraph.NewFieldSpec(downloadprogress.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Token(); ok {
+		_spec.SetField(downloadprogress.FieldToken, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Ranges(); ok {
+		_spec.SetField(downloadprogress.FieldRanges, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedRanges(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, downloadprogress.FieldRanges, value)
+		})
+	}
+	if _u.mutation.RangesCleared() {
+		_spec.ClearField(downloadprogress.FieldRanges, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(downloadprogress.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Version(); ok {
+		_spec.SetField(downloadprogress.FieldVersion, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedVersion(); ok {
+		_spec.AddField(downloadprogress.FieldVersion, field.TypeInt64, value)
+	}
+	if _u.mutation.FileCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   downloadprogress.FileTable,
+			Columns: []string{downloadprogress.FileColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(file.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.FileIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   downloadprogress.FileTable,
+			Columns: []string{downloadprogress.FileColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(file.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{downloadprogress.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// DownloadProgressUpdateOne is the builder for updating a single DownloadProgress entity.
+type DownloadProgressUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *DownloadProgressMutation
+}
+
+// SetToken sets the "token" field.
+func (_u *DownloadProgressUpdateOne) SetToken(v string) *DownloadProgressUpdateOne {
+	_u.mutation.SetToken(v)
+	return _u
+}
+
+// SetNillableToken sets the "token" field if the given value is not nil.
+func (_u *DownloadProgressUpdateOne) SetNillableToken(v *string) *DownloadProgressUpdateOne {
+	if v != nil {
+		_u.SetToken(*v)
+	}
+	return _u
+}
+
+// SetRanges sets the "ranges" field.
+func (_u *DownloadProgressUpdateOne) SetRanges(v [][2]int64) *DownloadProgressUpdateOne {
+	_u.mutation.SetRanges(v)
+	return _u
+}
+
+// AppendRanges appends value to the "ranges" field.
+func (_u *DownloadProgressUpdateOne) AppendRanges(v [][2]int64) *DownloadProgressUpdateOne {
+	_u.mutation.AppendRanges(v)
+	return _u
+}
+
+// ClearRanges clears the value of the "ranges" field.
+func (_u *DownloadProgressUpdateOne) ClearRanges() *DownloadProgressUpdateOne {
+	_u.mutation.ClearRanges()
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *DownloadProgressUpdateOne) SetUpdatedAt(v time.Time) *DownloadProgressUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetVersion sets the "version" field.
+func (_u *DownloadProgressUpdateOne) SetVersion(v int64) *DownloadProgressUpdateOne {
+	_u.mutation.ResetVersion()
+	_u.mutation.SetVersion(v)
+	return _u
+}
+
+// SetNillableVersion sets the "version" field if the given value is not nil.
+func (_u *DownloadProgressUpdateOne) SetNillableVersion(v *int64) *DownloadProgressUpdateOne {
+	if v != nil {
+		_u.SetVersion(*v)
+	}
+	return _u
+}
+
+// AddVersion adds value to the "version" field.
+func (_u *DownloadProgressUpdateOne) AddVersion(v int64) *DownloadProgressUpdateOne {
+	_u.mutation.AddVersion(v)
+	return _u
+}
+
+// SetFileID sets the "file" edge to the File entity by ID.
+func (_u *DownloadProgressUpdateOne) SetFileID(id uuid.UUID) *DownloadProgressUpdateOne {
+	_u.mutation.SetFileID(id)
+	return _u
+}
+
+// SetFile sets the "file" edge to the File entity.
+func (_u *DownloadProgressUpdateOne) SetFile(v *File) *DownloadProgressUpdateOne {
+	return _u.SetFileID(v.ID)
+}
+
+// Mutation returns the DownloadProgressMutation object of the builder.
+func (_u *DownloadProgressUpdateOne) Mutation() *DownloadProgressMutation {
+	return _u.mutation
+}
+
+// ClearFile clears the "file" edge to the File entity.
+func (_u *DownloadProgressUpdateOne) ClearFile() *DownloadProgressUpdateOne {
+	_u.mutation.ClearFile()
+	return _u
+}
+
+// Where appends a list predicates to the DownloadProgressUpdate builder.
+func (_u *DownloadProgressUpdateOne) Where(ps ...predicate.DownloadProgress) *DownloadProgressUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *DownloadProgressUpdateOne) Select(field string, fields ...string) *DownloadProgressUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated DownloadProgress entity.
+func (_u *DownloadProgressUpdateOne) Save(ctx context.Context) (*DownloadProgress, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *DownloadProgressUpdateOne) SaveX(ctx context.Context) *DownloadProgress {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *DownloadProgressUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *DownloadProgressUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *DownloadProgressUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := downloadprogress.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *DownloadProgressUpdateOne) check() error {
+	if _u.mutation.FileCleared() && len(_u.mutation.FileIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "DownloadProgress.file"`)
+	}
+	return nil
+}
+
+func (_u *DownloadProgressUpdateOne) sqlSave(ctx context.Context) (_node *DownloadProgress, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(downloadprogress.Table, downloadprogress.Columns, sqlgraph.NewFieldSpec(downloadprogress.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "DownloadProgress.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, downloadprogress.FieldID)
+		for _, f := range fields {
+			if !downloadprogress.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != downloadprogress.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Token(); ok {
+		_spec.SetField(downloadprogress.FieldToken, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Ranges(); ok {
+		_spec.SetField(downloadprogress.FieldRanges, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedRanges(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, downloadprogress.FieldRanges, value)
+		})
+	}
+	if _u.mutation.RangesCleared() {
+		_spec.ClearField(downloadprogress.FieldRanges, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(downloadprogress.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Version(); ok {
+		_spec.SetField(downloadprogress.FieldVersion, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedVersion(); ok {
+		_spec.AddField(downloadprogress.FieldVersion, field.TypeInt64, value)
+	}
+	if _u.mutation.FileCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   downloadprogress.FileTable,
+			Columns: []string{downloadprogress.FileColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(file.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.FileIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   downloadprogress.FileTable,
+			Columns: []string{downloadprogress.FileColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(file.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &DownloadProgress{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{downloadprogress.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -9,12 +9,17 @@ import (
 	"reflect"
 	"sync"
 
+	"codeberg.org/jvllmr/frans/internal/ent/accessevent"
+	"codeberg.org/jvllmr/frans/internal/ent/downloadprogress"
 	"codeberg.org/jvllmr/frans/internal/ent/file"
 	"codeberg.org/jvllmr/frans/internal/ent/filedata"
 	"codeberg.org/jvllmr/frans/internal/ent/grant"
+	"codeberg.org/jvllmr/frans/internal/ent/recipient"
 	"codeberg.org/jvllmr/frans/internal/ent/session"
 	"codeberg.org/jvllmr/frans/internal/ent/shareaccesstoken"
+	"codeberg.org/jvllmr/frans/internal/ent/storageverification"
 	"codeberg.org/jvllmr/frans/internal/ent/ticket"
+	"codeberg.org/jvllmr/frans/internal/ent/upload"
 	"codeberg.org/jvllmr/frans/internal/ent/user"
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
@@ -79,13 +84,18 @@ var (
 func checkColumn(t, c string) error {
 	initCheck.Do(func() {
 		columnCheck = sql.NewColumnCheck(map[string]func(string) bool{
-			file.Table:             file.ValidColumn,
-			filedata.Table:         filedata.ValidColumn,
-			grant.Table:            grant.ValidColumn,
-			session.Table:          session.ValidColumn,
-			shareaccesstoken.Table: shareaccesstoken.ValidColumn,
-			ticket.Table:           ticket.ValidColumn,
-			user.Table:             user.ValidColumn,
+			accessevent.Table:         accessevent.ValidColumn,
+			downloadprogress.Table:    downloadprogress.ValidColumn,
+			file.Table:                file.ValidColumn,
+			filedata.Table:            filedata.ValidColumn,
+			grant.Table:               grant.ValidColumn,
+			recipient.Table:           recipient.ValidColumn,
+			session.Table:             session.ValidColumn,
+			shareaccesstoken.Table:    shareaccesstoken.ValidColumn,
+			storageverification.Table: storageverification.ValidColumn,
+			ticket.Table:              ticket.ValidColumn,
+			upload.Table:              upload.ValidColumn,
+			user.Table:                user.ValidColumn,
 		})
 	})
 	return columnCheck(t, c)
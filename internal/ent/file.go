@@ -30,6 +30,10 @@ type File struct {
 	LastDownload *time.Time `json:"last_download,omitempty"`
 	// TimesDownloaded holds the value of the "times_downloaded" field.
 	TimesDownloaded uint64 `json:"times_downloaded,omitempty"`
+	// LastView holds the value of the "last_view" field.
+	LastView *time.Time `json:"last_view,omitempty"`
+	// TimesViewed holds the value of the "times_viewed" field.
+	TimesViewed uint64 `json:"times_viewed,omitempty"`
 	// ExpiryType holds the value of the "expiry_type" field.
 	ExpiryType string `json:"expiry_type,omitempty"`
 	// ExpiryTotalDays holds the value of the "expiry_total_days" field.
@@ -38,6 +42,12 @@ type File struct {
 	ExpiryDaysSinceLastDownload uint8 `json:"expiry_days_since_last_download,omitempty"`
 	// ExpiryTotalDownloads holds the value of the "expiry_total_downloads" field.
 	ExpiryTotalDownloads uint8 `json:"expiry_total_downloads,omitempty"`
+	// ScanStatus holds the value of the "scan_status" field.
+	ScanStatus string `json:"scan_status,omitempty"`
+	// ScanSignature holds the value of the "scan_signature" field.
+	ScanSignature *string `json:"scan_signature,omitempty"`
+	// MimeType holds the value of the "mime_type" field.
+	MimeType *string `json:"mime_type,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the FileQuery when eager-loading is set.
 	Edges        FileEdges `json:"edges"`
@@ -58,9 +68,13 @@ type FileEdges struct {
 	Owner *User `json:"owner,omitempty"`
 	// Data holds the value of the data edge.
 	Data *FileData `json:"data,omitempty"`
+	// DownloadProgresses holds the value of the download_progresses edge.
+	DownloadProgresses []*DownloadProgress `json:"download_progresses,omitempty"`
+	// AccessEvents holds the value of the access_events edge.
+	AccessEvents []*AccessEvent `json:"access_events,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [4]bool
+	loadedTypes [6]bool
 }
 
 // TicketOrErr returns the Ticket value or an error if the edge
@@ -107,16 +121,34 @@ func (e FileEdges) DataOrErr() (*FileData, error) {
 	return nil, &NotLoadedError{edge: "data"}
 }
 
+// DownloadProgressesOrErr returns the DownloadProgresses value or an error if the edge
+// was not loaded in eager-loading.
+func (e FileEdges) DownloadProgressesOrErr() ([]*DownloadProgress, error) {
+	if e.loadedTypes[4] {
+		return e.DownloadProgresses, nil
+	}
+	return nil, &NotLoadedError{edge: "download_progresses"}
+}
+
+// AccessEventsOrErr returns the AccessEvents value or an error if the edge
+// was not loaded in eager-loading.
+func (e FileEdges) AccessEventsOrErr() ([]*AccessEvent, error) {
+	if e.loadedTypes[5] {
+		return e.AccessEvents, nil
+	}
+	return nil, &NotLoadedError{edge: "access_events"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*File) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case file.FieldTimesDownloaded, file.FieldExpiryTotalDays, file.FieldExpiryDaysSinceLastDownload, file.FieldExpiryTotalDownloads:
+		case file.FieldTimesDownloaded, file.FieldTimesViewed, file.FieldExpiryTotalDays, file.FieldExpiryDaysSinceLastDownload, file.FieldExpiryTotalDownloads:
 			values[i] = new(sql.NullInt64)
-		case file.FieldName, file.FieldExpiryType:
+		case file.FieldName, file.FieldExpiryType, file.FieldScanStatus, file.FieldScanSignature, file.FieldMimeType:
 			values[i] = new(sql.NullString)
-		case file.FieldCreatedAt, file.FieldLastDownload:
+		case file.FieldCreatedAt, file.FieldLastDownload, file.FieldLastView:
 			values[i] = new(sql.NullTime)
 		case file.FieldID:
 			values[i] = new(uuid.UUID)
@@ -174,6 +206,19 @@ func (_m *File) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.TimesDownloaded = uint64(value.Int64)
 			}
+		case file.FieldLastView:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field last_view", values[i])
+			} else if value.Valid {
+				_m.LastView = new(time.Time)
+				*_m.LastView = value.Time
+			}
+		case file.FieldTimesViewed:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field times_viewed", values[i])
+			} else if value.Valid {
+				_m.TimesViewed = uint64(value.Int64)
+			}
 		case file.FieldExpiryType:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field expiry_type", values[i])
@@ -198,6 +243,26 @@ func (_m *File) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.ExpiryTotalDownloads = uint8(value.Int64)
 			}
+		case file.FieldScanStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field scan_status", values[i])
+			} else if value.Valid {
+				_m.ScanStatus = value.String
+			}
+		case file.FieldScanSignature:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field scan_signature", values[i])
+			} else if value.Valid {
+				_m.ScanSignature = new(string)
+				*_m.ScanSignature = value.String
+			}
+		case file.FieldMimeType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field mime_type", values[i])
+			} else if value.Valid {
+				_m.MimeType = new(string)
+				*_m.MimeType = value.String
+			}
 		case file.ForeignKeys[0]:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field file_data", values[i])
@@ -259,6 +324,16 @@ func (_m *File) QueryData() *FileDataQuery {
 	return NewFileClient(_m.config).QueryData(_m)
 }
 
+// QueryDownloadProgresses queries the "download_progresses" edge of the File entity.
+func (_m *File) QueryDownloadProgresses() *DownloadProgressQuery {
+	return NewFileClient(_m.config).QueryDownloadProgresses(_m)
+}
+
+// QueryAccessEvents queries the "access_events" edge of the File entity.
+func (_m *File) QueryAccessEvents() *AccessEventQuery {
+	return NewFileClient(_m.config).QueryAccessEvents(_m)
+}
+
 // Update returns a builder for updating this File.
 // Note that you need to call File.Unwrap() before calling this method if this File
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -296,6 +371,14 @@ func (_m *File) String() string {
 	builder.WriteString("times_downloaded=")
 	builder.WriteString(fmt.Sprintf("%v", _m.TimesDownloaded))
 	builder.WriteString(", ")
+	if v := _m.LastView; v != nil {
+		builder.WriteString("last_view=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("times_viewed=")
+	builder.WriteString(fmt.Sprintf("%v", _m.TimesViewed))
+	builder.WriteString(", ")
 	builder.WriteString("expiry_type=")
 	builder.WriteString(_m.ExpiryType)
 	builder.WriteString(", ")
@@ -307,6 +390,19 @@ func (_m *File) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("expiry_total_downloads=")
 	builder.WriteString(fmt.Sprintf("%v", _m.ExpiryTotalDownloads))
+	builder.WriteString(", ")
+	builder.WriteString("scan_status=")
+	builder.WriteString(_m.ScanStatus)
+	builder.WriteString(", ")
+	if v := _m.ScanSignature; v != nil {
+		builder.WriteString("scan_signature=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := _m.MimeType; v != nil {
+		builder.WriteString("mime_type=")
+		builder.WriteString(*v)
+	}
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -22,6 +22,10 @@ const (
 	FieldLastDownload = "last_download"
 	// FieldTimesDownloaded holds the string denoting the times_downloaded field in the database.
 	FieldTimesDownloaded = "times_downloaded"
+	// FieldLastView holds the string denoting the last_view field in the database.
+	FieldLastView = "last_view"
+	// FieldTimesViewed holds the string denoting the times_viewed field in the database.
+	FieldTimesViewed = "times_viewed"
 	// FieldExpiryType holds the string denoting the expiry_type field in the database.
 	FieldExpiryType = "expiry_type"
 	// FieldExpiryTotalDays holds the string denoting the expiry_total_days field in the database.
@@ -30,6 +34,12 @@ const (
 	FieldExpiryDaysSinceLastDownload = "expiry_days_since_last_download"
 	// FieldExpiryTotalDownloads holds the string denoting the expiry_total_downloads field in the database.
 	FieldExpiryTotalDownloads = "expiry_total_downloads"
+	// FieldScanStatus holds the string denoting the scan_status field in the database.
+	FieldScanStatus = "scan_status"
+	// FieldScanSignature holds the string denoting the scan_signature field in the database.
+	FieldScanSignature = "scan_signature"
+	// FieldMimeType holds the string denoting the mime_type field in the database.
+	FieldMimeType = "mime_type"
 	// EdgeTicket holds the string denoting the ticket edge name in mutations.
 	EdgeTicket = "ticket"
 	// EdgeGrant holds the string denoting the grant edge name in mutations.
@@ -38,6 +48,10 @@ const (
 	EdgeOwner = "owner"
 	// EdgeData holds the string denoting the data edge name in mutations.
 	EdgeData = "data"
+	// EdgeDownloadProgresses holds the string denoting the download_progresses edge name in mutations.
+	EdgeDownloadProgresses = "download_progresses"
+	// EdgeAccessEvents holds the string denoting the access_events edge name in mutations.
+	EdgeAccessEvents = "access_events"
 	// Table holds the table name of the file in the database.
 	Table = "files"
 	// TicketTable is the table that holds the ticket relation/edge.
@@ -68,6 +82,20 @@ const (
 	DataInverseTable = "file_data"
 	// DataColumn is the table column denoting the data relation/edge.
 	DataColumn = "file_data"
+	// DownloadProgressesTable is the table that holds the download_progresses relation/edge.
+	DownloadProgressesTable = "download_progresses"
+	// DownloadProgressesInverseTable is the table name for the DownloadProgress entity.
+	// It exists in this package in order to avoid circular dependency with the "downloadprogress" package.
+	DownloadProgressesInverseTable = "download_progresses"
+	// DownloadProgressesColumn is the table column denoting the download_progresses relation/edge.
+	DownloadProgressesColumn = "file_download_progresses"
+	// AccessEventsTable is the table that holds the access_events relation/edge.
+	AccessEventsTable = "access_events"
+	// AccessEventsInverseTable is the table name for the AccessEvent entity.
+	// It exists in this package in order to avoid circular dependency with the "accessevent" package.
+	AccessEventsInverseTable = "access_events"
+	// AccessEventsColumn is the table column denoting the access_events relation/edge.
+	AccessEventsColumn = "file_access_events"
 )
 
 // Columns holds all SQL columns for file fields.
@@ -77,10 +105,15 @@ var Columns = []string{
 	FieldCreatedAt,
 	FieldLastDownload,
 	FieldTimesDownloaded,
+	FieldLastView,
+	FieldTimesViewed,
 	FieldExpiryType,
 	FieldExpiryTotalDays,
 	FieldExpiryDaysSinceLastDownload,
 	FieldExpiryTotalDownloads,
+	FieldScanStatus,
+	FieldScanSignature,
+	FieldMimeType,
 }
 
 // ForeignKeys holds the SQL foreign-keys that are owned by the "files"
@@ -112,6 +145,10 @@ var (
 	DefaultCreatedAt func() time.Time
 	// DefaultTimesDownloaded holds the default value on creation for the "times_downloaded" field.
 	DefaultTimesDownloaded uint64
+	// DefaultTimesViewed holds the default value on creation for the "times_viewed" field.
+	DefaultTimesViewed uint64
+	// DefaultScanStatus holds the default value on creation for the "scan_status" field.
+	DefaultScanStatus string
 )
 
 // OrderOption defines the ordering options for the File queries.
@@ -142,6 +179,16 @@ func ByTimesDownloaded(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldTimesDownloaded, opts...).ToFunc()
 }
 
+// ByLastView orders the results by the last_view field.
+func ByLastView(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLastView, opts...).ToFunc()
+}
+
+// ByTimesViewed orders the results by the times_viewed field.
+func ByTimesViewed(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTimesViewed, opts...).ToFunc()
+}
+
 // ByExpiryType orders the results by the expiry_type field.
 func ByExpiryType(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldExpiryType, opts...).ToFunc()
@@ -162,6 +209,21 @@ func ByExpiryTotalDownloads(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldExpiryTotalDownloads, opts...).ToFunc()
 }
 
+// ByScanStatus orders the results by the scan_status field.
+func ByScanStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldScanStatus, opts...).ToFunc()
+}
+
+// ByScanSignature orders the results by the scan_signature field.
+func ByScanSignature(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldScanSignature, opts...).ToFunc()
+}
+
+// ByMimeType orders the results by the mime_type field.
+func ByMimeType(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMimeType, opts...).ToFunc()
+}
+
 // ByTicketField orders the results by ticket field.
 func ByTicketField(field string, opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -189,6 +251,34 @@ func ByDataField(field string, opts ...sql.OrderTermOption) OrderOption {
 		sqlgraph.OrderByNeighborTerms(s, newDataStep(), sql.OrderByField(field, opts...))
 	}
 }
+
+// ByDownloadProgressesCount orders the results by download_progresses count.
+func ByDownloadProgressesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newDownloadProgressesStep(), opts...)
+	}
+}
+
+// ByDownloadProgresses orders the results by download_progresses terms.
+func ByDownloadProgresses(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newDownloadProgressesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByAccessEventsCount orders the results by access_events count.
+func ByAccessEventsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newAccessEventsStep(), opts...)
+	}
+}
+
+// ByAccessEvents orders the results by access_events terms.
+func ByAccessEvents(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newAccessEventsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
 func newTicketStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -217,3 +307,17 @@ func newDataStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.M2O, false, DataTable, DataColumn),
 	)
 }
+func newDownloadProgressesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(DownloadProgressesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, DownloadProgressesTable, DownloadProgressesColumn),
+	)
+}
+func newAccessEventsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(AccessEventsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, AccessEventsTable, AccessEventsColumn),
+	)
+}
@@ -76,6 +76,16 @@ func TimesDownloaded(v uint64) predicate.File {
 	return predicate.File(sql.FieldEQ(FieldTimesDownloaded, v))
 }
 
+// LastView applies equality check predicate on the "last_view" field. It's identical to LastViewEQ.
+func LastView(v time.Time) predicate.File {
+	return predicate.File(sql.FieldEQ(FieldLastView, v))
+}
+
+// TimesViewed applies equality check predicate on the "times_viewed" field. It's identical to TimesViewedEQ.
+func TimesViewed(v uint64) predicate.File {
+	return predicate.File(sql.FieldEQ(FieldTimesViewed, v))
+}
+
 // ExpiryType applies equality check predicate on the "expiry_type" field. It's identical to ExpiryTypeEQ.
 func ExpiryType(v string) predicate.File {
 	return predicate.File(sql.FieldEQ(FieldExpiryType, v))
@@ -96,6 +106,21 @@ func ExpiryTotalDownloads(v uint8) predicate.File {
 	return predicate.File(sql.FieldEQ(FieldExpiryTotalDownloads, v))
 }
 
+// ScanStatus applies equality check predicate on the "scan_status" field. It's identical to ScanStatusEQ.
+func ScanStatus(v string) predicate.File {
+	return predicate.File(sql.FieldEQ(FieldScanStatus, v))
+}
+
+// ScanSignature applies equality check predicate on the "scan_signature" field. It's identical to ScanSignatureEQ.
+func ScanSignature(v string) predicate.File {
+	return predicate.File(sql.FieldEQ(FieldScanSignature, v))
+}
+
+// MimeType applies equality check predicate on the "mime_type" field. It's identical to MimeTypeEQ.
+func MimeType(v string) predicate.File {
+	return predicate.File(sql.FieldEQ(FieldMimeType, v))
+}
+
 // NameEQ applies the EQ predicate on the "name" field.
 func NameEQ(v string) predicate.File {
 	return predicate.File(sql.FieldEQ(FieldName, v))
@@ -291,6 +316,96 @@ func TimesDownloadedLTE(v uint64) predicate.File {
 	return predicate.File(sql.FieldLTE(FieldTimesDownloaded, v))
 }
 
+// LastViewEQ applies the EQ predicate on the "last_view" field.
+func LastViewEQ(v time.Time) predicate.File {
+	return predicate.File(sql.FieldEQ(FieldLastView, v))
+}
+
+// LastViewNEQ applies the NEQ predicate on the "last_view" field.
+func LastViewNEQ(v time.Time) predicate.File {
+	return predicate.File(sql.FieldNEQ(FieldLastView, v))
+}
+
+// LastViewIn applies the In predicate on the "last_view" field.
+func LastViewIn(vs ...time.Time) predicate.File {
+	return predicate.File(sql.FieldIn(FieldLastView, vs...))
+}
+
+// LastViewNotIn applies the NotIn predicate on the "last_view" field.
+func LastViewNotIn(vs ...time.Time) predicate.File {
+	return predicate.File(sql.FieldNotIn(FieldLastView, vs...))
+}
+
+// LastViewGT applies the GT predicate on the "last_view" field.
+func LastViewGT(v time.Time) predicate.File {
+	return predicate.File(sql.FieldGT(FieldLastView, v))
+}
+
+// LastViewGTE applies the GTE predicate on the "last_view" field.
+func LastViewGTE(v time.Time) predicate.File {
+	return predicate.File(sql.FieldGTE(FieldLastView, v))
+}
+
+// LastViewLT applies the LT predicate on the "last_view" field.
+func LastViewLT(v time.Time) predicate.File {
+	return predicate.File(sql.FieldLT(FieldLastView, v))
+}
+
+// LastViewLTE applies the LTE predicate on the "last_view" field.
+func LastViewLTE(v time.Time) predicate.File {
+	return predicate.File(sql.FieldLTE(FieldLastView, v))
+}
+
+// LastViewIsNil applies the IsNil predicate on the "last_view" field.
+func LastViewIsNil() predicate.File {
+	return predicate.File(sql.FieldIsNull(FieldLastView))
+}
+
+// LastViewNotNil applies the NotNil predicate on the "last_view" field.
+func LastViewNotNil() predicate.File {
+	return predicate.File(sql.FieldNotNull(FieldLastView))
+}
+
+// TimesViewedEQ applies the EQ predicate on the "times_viewed" field.
+func TimesViewedEQ(v uint64) predicate.File {
+	return predicate.File(sql.FieldEQ(FieldTimesViewed, v))
+}
+
+// TimesViewedNEQ applies the NEQ predicate on the "times_viewed" field.
+func TimesViewedNEQ(v uint64) predicate.File {
+	return predicate.File(sql.FieldNEQ(FieldTimesViewed, v))
+}
+
+// TimesViewedIn applies the In predicate on the "times_viewed" field.
+func TimesViewedIn(vs ...uint64) predicate.File {
+	return predicate.File(sql.FieldIn(FieldTimesViewed, vs...))
+}
+
+// TimesViewedNotIn applies the NotIn predicate on the "times_viewed" field.
+func TimesViewedNotIn(vs ...uint64) predicate.File {
+	return predicate.File(sql.FieldNotIn(FieldTimesViewed, vs...))
+}
+
+// TimesViewedGT applies the GT predicate on the "times_viewed" field.
+func TimesViewedGT(v uint64) predicate.File {
+	return predicate.File(sql.FieldGT(FieldTimesViewed, v))
+}
+
+// TimesViewedGTE applies the GTE predicate on the "times_viewed" field.
+func TimesViewedGTE(v uint64) predicate.File {
+	return predicate.File(sql.FieldGTE(FieldTimesViewed, v))
+}
+
+// TimesViewedLT applies the LT predicate on the "times_viewed" field.
+func TimesViewedLT(v uint64) predicate.File {
+	return predicate.File(sql.FieldLT(FieldTimesViewed, v))
+}
+
+// TimesViewedLTE applies the LTE predicate on the "times_viewed" field.
+func TimesViewedLTE(v uint64) predicate.File {
+	return predicate.File(sql.FieldLTE(FieldTimesViewed, v))
+}
+
 // ExpiryTypeEQ applies the EQ predicate on the "expiry_type" field.
 func ExpiryTypeEQ(v string) predicate.File {
 	return predicate.File(sql.FieldEQ(FieldExpiryType, v))
@@ -476,6 +591,221 @@ func ExpiryTotalDownloadsLTE(v uint8) predicate.File {
 	return predicate.File(sql.FieldLTE(FieldExpiryTotalDownloads, v))
 }
 
+// ScanStatusEQ applies the EQ predicate on the "scan_status" field.
+func ScanStatusEQ(v string) predicate.File {
+	return predicate.File(sql.FieldEQ(FieldScanStatus, v))
+}
+
+// ScanStatusNEQ applies the NEQ predicate on the "scan_status" field.
+func ScanStatusNEQ(v string) predicate.File {
+	return predicate.File(sql.FieldNEQ(FieldScanStatus, v))
+}
+
+// ScanStatusIn applies the In predicate on the "scan_status" field.
+func ScanStatusIn(vs ...string) predicate.File {
+	return predicate.File(sql.FieldIn(FieldScanStatus, vs...))
+}
+
+// ScanStatusNotIn applies the NotIn predicate on the "scan_status" field.
+func ScanStatusNotIn(vs ...string) predicate.File {
+	return predicate.File(sql.FieldNotIn(FieldScanStatus, vs...))
+}
+
+// ScanStatusGT applies the GT predicate on the "scan_status" field.
+func ScanStatusGT(v string) predicate.File {
+	return predicate.File(sql.FieldGT(FieldScanStatus, v))
+}
+
+// ScanStatusGTE applies the GTE predicate on the "scan_status" field.
+func ScanStatusGTE(v string) predicate.File {
+	return predicate.File(sql.FieldGTE(FieldScanStatus, v))
+}
+
+// ScanStatusLT applies the LT predicate on the "scan_status" field.
+func ScanStatusLT(v string) predicate.File {
+	return predicate.File(sql.FieldLT(FieldScanStatus, v))
+}
+
+// ScanStatusLTE applies the LTE predicate on the "scan_status" field.
+func ScanStatusLTE(v string) predicate.File {
+	return predicate.File(sql.FieldLTE(FieldScanStatus, v))
+}
+
+// ScanStatusContains applies the Contains predicate on the "scan_status" field.
+func ScanStatusContains(v string) predicate.File {
+	return predicate.File(sql.FieldContains(FieldScanStatus, v))
+}
+
+// ScanStatusHasPrefix applies the HasPrefix predicate on the "scan_status" field.
+func ScanStatusHasPrefix(v string) predicate.File {
+	return predicate.File(sql.FieldHasPrefix(FieldScanStatus, v))
+}
+
+// ScanStatusHasSuffix applies the HasSuffix predicate on the "scan_status" field.
+func ScanStatusHasSuffix(v string) predicate.File {
+	return predicate.File(sql.FieldHasSuffix(FieldScanStatus, v))
+}
+
+// ScanStatusEqualFold applies the EqualFold predicate on the "scan_status" field.
+func ScanStatusEqualFold(v string) predicate.File {
+	return predicate.File(sql.FieldEqualFold(FieldScanStatus, v))
+}
+
+// ScanStatusContainsFold applies the ContainsFold predicate on the "scan_status" field.
+func ScanStatusContainsFold(v string) predicate.File {
+	return predicate.File(sql.FieldContainsFold(FieldScanStatus, v))
+}
+
+// ScanSignatureEQ applies the EQ predicate on the "scan_signature" field.
+func ScanSignatureEQ(v string) predicate.File {
+	return predicate.File(sql.FieldEQ(FieldScanSignature, v))
+}
+
+// ScanSignatureNEQ applies the NEQ predicate on the "scan_signature" field.
+func ScanSignatureNEQ(v string) predicate.File {
+	return predicate.File(sql.FieldNEQ(FieldScanSignature, v))
+}
+
+// ScanSignatureIn applies the In predicate on the "scan_signature" field.
+func ScanSignatureIn(vs ...string) predicate.File {
+	return predicate.File(sql.FieldIn(FieldScanSignature, vs...))
+}
+
+// ScanSignatureNotIn applies the NotIn predicate on the "scan_signature" field.
+func ScanSignatureNotIn(vs ...string) predicate.File {
+	return predicate.File(sql.FieldNotIn(FieldScanSignature, vs...))
+}
+
+// ScanSignatureGT applies the GT predicate on the "scan_signature" field.
+func ScanSignatureGT(v string) predicate.File {
+	return predicate.File(sql.FieldGT(FieldScanSignature, v))
+}
+
+// ScanSignatureGTE applies the GTE predicate on the "scan_signature" field.
+func ScanSignatureGTE(v string) predicate.File {
+	return predicate.File(sql.FieldGTE(FieldScanSignature, v))
+}
+
+// ScanSignatureLT applies the LT predicate on the "scan_signature" field.
+func ScanSignatureLT(v string) predicate.File {
+	return predicate.File(sql.FieldLT(FieldScanSignature, v))
+}
+
+// ScanSignatureLTE applies the LTE predicate on the "scan_signature" field.
+func ScanSignatureLTE(v string) predicate.File {
+	return predicate.File(sql.FieldLTE(FieldScanSignature, v))
+}
+
+// ScanSignatureContains applies the Contains predicate on the "scan_signature" field.
+func ScanSignatureContains(v string) predicate.File {
+	return predicate.File(sql.FieldContains(FieldScanSignature, v))
+}
+
+// ScanSignatureHasPrefix applies the HasPrefix predicate on the "scan_signature" field.
+func ScanSignatureHasPrefix(v string) predicate.File {
+	return predicate.File(sql.FieldHasPrefix(FieldScanSignature, v))
+}
+
+// ScanSignatureHasSuffix applies the HasSuffix predicate on the "scan_signature" field.
+func ScanSignatureHasSuffix(v string) predicate.File {
+	return predicate.File(sql.FieldHasSuffix(FieldScanSignature, v))
+}
+
+// ScanSignatureIsNil applies the IsNil predicate on the "scan_signature" field.
+func ScanSignatureIsNil() predicate.File {
+	return predicate.File(sql.FieldIsNull(FieldScanSignature))
+}
+
+// ScanSignatureNotNil applies the NotNil predicate on the "scan_signature" field.
+func ScanSignatureNotNil() predicate.File {
+	return predicate.File(sql.FieldNotNull(FieldScanSignature))
+}
+
+// ScanSignatureEqualFold applies the EqualFold predicate on the "scan_signature" field.
+func ScanSignatureEqualFold(v string) predicate.File {
+	return predicate.File(sql.FieldEqualFold(FieldScanSignature, v))
+}
+
+// ScanSignatureContainsFold applies the ContainsFold predicate on the "scan_signature" field.
+func ScanSignatureContainsFold(v string) predicate.File {
+	return predicate.File(sql.FieldContainsFold(FieldScanSignature, v))
+}
+
+// MimeTypeEQ applies the EQ predicate on the "mime_type" field.
+func MimeTypeEQ(v string) predicate.File {
+	return predicate.File(sql.FieldEQ(FieldMimeType, v))
+}
+
+// MimeTypeNEQ applies the NEQ predicate on the "mime_type" field.
+func MimeTypeNEQ(v string) predicate.File {
+	return predicate.File(sql.FieldNEQ(FieldMimeType, v))
+}
+
+// MimeTypeIn applies the In predicate on the "mime_type" field.
+func MimeTypeIn(vs ...string) predicate.File {
+	return predicate.File(sql.FieldIn(FieldMimeType, vs...))
+}
+
+// MimeTypeNotIn applies the NotIn predicate on the "mime_type" field.
+func MimeTypeNotIn(vs ...string) predicate.File {
+	return predicate.File(sql.FieldNotIn(FieldMimeType, vs...))
+}
+
+// MimeTypeGT applies the GT predicate on the "mime_type" field.
+func MimeTypeGT(v string) predicate.File {
+	return predicate.File(sql.FieldGT(FieldMimeType, v))
+}
+
+// MimeTypeGTE applies the GTE predicate on the "mime_type" field.
+func MimeTypeGTE(v string) predicate.File {
+	return predicate.File(sql.FieldGTE(FieldMimeType, v))
+}
+
+// MimeTypeLT applies the LT predicate on the "mime_type" field.
+func MimeTypeLT(v string) predicate.File {
+	return predicate.File(sql.FieldLT(FieldMimeType, v))
+}
+
+// MimeTypeLTE applies the LTE predicate on the "mime_type" field.
+func MimeTypeLTE(v string) predicate.File {
+	return predicate.File(sql.FieldLTE(FieldMimeType, v))
+}
+
+// MimeTypeContains applies the Contains predicate on the "mime_type" field.
+func MimeTypeContains(v string) predicate.File {
+	return predicate.File(sql.FieldContains(FieldMimeType, v))
+}
+
+// MimeTypeHasPrefix applies the HasPrefix predicate on the "mime_type" field.
+func MimeTypeHasPrefix(v string) predicate.File {
+	return predicate.File(sql.FieldHasPrefix(FieldMimeType, v))
+}
+
+// MimeTypeHasSuffix applies the HasSuffix predicate on the "mime_type" field.
+func MimeTypeHasSuffix(v string) predicate.File {
+	return predicate.File(sql.FieldHasSuffix(FieldMimeType, v))
+}
+
+// MimeTypeIsNil applies the IsNil predicate on the "mime_type" field.
+func MimeTypeIsNil() predicate.File {
+	return predicate.File(sql.FieldIsNull(FieldMimeType))
+}
+
+// MimeTypeNotNil applies the NotNil predicate on the "mime_type" field.
+func MimeTypeNotNil() predicate.File {
+	return predicate.File(sql.FieldNotNull(FieldMimeType))
+}
+
+// MimeTypeEqualFold applies the EqualFold predicate on the "mime_type" field.
+func MimeTypeEqualFold(v string) predicate.File {
+	return predicate.File(sql.FieldEqualFold(FieldMimeType, v))
+}
+
+// MimeTypeContainsFold applies the ContainsFold predicate on the "mime_type" field.
+func MimeTypeContainsFold(v string) predicate.File {
+	return predicate.File(sql.FieldContainsFold(FieldMimeType, v))
+}
+
 // HasTicket applies the HasEdge predicate on the "ticket" edge.
 func HasTicket() predicate.File {
 	return predicate.File(func(s *sql.Selector) {
@@ -568,6 +898,52 @@ func HasDataWith(preds ...predicate.FileData) predicate.File {
 	})
 }
 
+// HasDownloadProgresses applies the HasEdge predicate on the "download_progresses" edge.
+func HasDownloadProgresses() predicate.File {
+	return predicate.File(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, DownloadProgressesTable, DownloadProgressesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasDownloadProgressesWith applies the HasEdge predicate on the "download_progresses" edge with a given conditions (other predicates).
+func HasDownloadProgressesWith(preds ...predicate.DownloadProgress) predicate.File {
+	return predicate.File(func(s *sql.Selector) {
+		step := newDownloadProgressesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasAccessEvents applies the HasEdge predicate on the "access_events" edge.
+func HasAccessEvents() predicate.File {
+	return predicate.File(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, AccessEventsTable, AccessEventsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasAccessEventsWith applies the HasEdge predicate on the "access_events" edge with a given conditions (other predicates).
+func HasAccessEventsWith(preds ...predicate.AccessEvent) predicate.File {
+	return predicate.File(func(s *sql.Selector) {
+		step := newAccessEventsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.File) predicate.File {
 	return predicate.File(sql.AndPredicates(predicates...))
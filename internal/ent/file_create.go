@@ -8,6 +8,8 @@ import (
 	"fmt"
 	"time"
 
+	"codeberg.org/jvllmr/frans/internal/ent/accessevent"
+	"codeberg.org/jvllmr/frans/internal/ent/downloadprogress"
 	"codeberg.org/jvllmr/frans/internal/ent/file"
 	"codeberg.org/jvllmr/frans/internal/ent/filedata"
 	"codeberg.org/jvllmr/frans/internal/ent/grant"
@@ -73,6 +75,34 @@ func (_c *FileCreate) SetNillableTimesDownloaded(v *uint64) *FileCreate {
 	return _c
 }
 
+// SetLastView sets the "last_view" field.
+func (_c *FileCreate) SetLastView(v time.Time) *FileCreate {
+	_c.mutation.SetLastView(v)
+	return _c
+}
+
+// SetNillableLastView sets the "last_view" field if the given value is not nil.
+func (_c *FileCreate) SetNillableLastView(v *time.Time) *FileCreate {
+	if v != nil {
+		_c.SetLastView(*v)
+	}
+	return _c
+}
+
+// SetTimesViewed sets the "times_viewed" field.
+func (_c *FileCreate) SetTimesViewed(v uint64) *FileCreate {
+	_c.mutation.SetTimesViewed(v)
+	return _c
+}
+
+// SetNillableTimesViewed sets the "times_viewed" field if the given value is not nil.
+func (_c *FileCreate) SetNillableTimesViewed(v *uint64) *FileCreate {
+	if v != nil {
+		_c.SetTimesViewed(*v)
+	}
+	return _c
+}
+
 // SetExpiryType sets the "expiry_type" field.
 func (_c *FileCreate) SetExpiryType(v string) *FileCreate {
 	_c.mutation.SetExpiryType(v)
@@ -97,6 +127,48 @@ func (_c *FileCreate) SetExpiryTotalDownloads(v uint8) *FileCreate {
 	return _c
 }
 
+// SetScanStatus sets the "scan_status" field.
+func (_c *FileCreate) SetScanStatus(v string) *FileCreate {
+	_c.mutation.SetScanStatus(v)
+	return _c
+}
+
+// SetNillableScanStatus sets the "scan_status" field if the given value is not nil.
+func (_c *FileCreate) SetNillableScanStatus(v *string) *FileCreate {
+	if v != nil {
+		_c.SetScanStatus(*v)
+	}
+	return _c
+}
+
+// SetScanSignature sets the "scan_signature" field.
+func (_c *FileCreate) SetScanSignature(v string) *FileCreate {
+	_c.mutation.SetScanSignature(v)
+	return _c
+}
+
+// SetNillableScanSignature sets the "scan_signature" field if the given value is not nil.
+func (_c *FileCreate) SetNillableScanSignature(v *string) *FileCreate {
+	if v != nil {
+		_c.SetScanSignature(*v)
+	}
+	return _c
+}
+
+// SetMimeType sets the "mime_type" field.
+func (_c *FileCreate) SetMimeType(v string) *FileCreate {
+	_c.mutation.SetMimeType(v)
+	return _c
+}
+
+// SetNillableMimeType sets the "mime_type" field if the given value is not nil.
+func (_c *FileCreate) SetNillableMimeType(v *string) *FileCreate {
+	if v != nil {
+		_c.SetMimeType(*v)
+	}
+	return _c
+}
+
 // SetID sets the "id" field.
 func (_c *FileCreate) SetID(v uuid.UUID) *FileCreate {
 	_c.mutation.SetID(v)
@@ -163,6 +235,36 @@ func (_c *FileCreate) SetData(v *FileData) *FileCreate {
 	return _c.SetDataID(v.ID)
 }
 
+// AddDownloadProgressIDs adds the "download_progresses" edge to the DownloadProgress entity by IDs.
+func (_c *FileCreate) AddDownloadProgressIDs(ids ...int) *FileCreate {
+	_c.mutation.AddDownloadProgressIDs(ids...)
+	return _c
+}
+
+// AddDownloadProgresses adds the "download_progresses" edges to the DownloadProgress entity.
+func (_c *FileCreate) AddDownloadProgresses(v ...*DownloadProgress) *FileCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddDownloadProgressIDs(ids...)
+}
+
+// AddAccessEventIDs adds the "access_events" edge to the AccessEvent entity by IDs.
+func (_c *FileCreate) AddAccessEventIDs(ids ...int) *FileCreate {
+	_c.mutation.AddAccessEventIDs(ids...)
+	return _c
+}
+
+// AddAccessEvents adds the "access_events" edges to the AccessEvent entity.
+func (_c *FileCreate) AddAccessEvents(v ...*AccessEvent) *FileCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddAccessEventIDs(ids...)
+}
+
 // Mutation returns the FileMutation object of the builder.
 func (_c *FileCreate) Mutation() *FileMutation {
 	return _c.mutation
@@ -206,6 +308,14 @@ func (_c *FileCreate) defaults() {
 		v := file.DefaultTimesDownloaded
 		_c.mutation.SetTimesDownloaded(v)
 	}
+	if _, ok := _c.mutation.TimesViewed(); !ok {
+		v := file.DefaultTimesViewed
+		_c.mutation.SetTimesViewed(v)
+	}
+	if _, ok := _c.mutation.ScanStatus(); !ok {
+		v := file.DefaultScanStatus
+		_c.mutation.SetScanStatus(v)
+	}
 }
 
 // check runs all checks and user-defined validators on the builder.
@@ -219,6 +329,9 @@ func (_c *FileCreate) check() error {
 	if _, ok := _c.mutation.TimesDownloaded(); !ok {
 		return &ValidationError{Name: "times_downloaded", err: errors.New(`ent: missing required field "File.times_downloaded"`)}
 	}
+	if _, ok := _c.mutation.TimesViewed(); !ok {
+		return &ValidationError{Name: "times_viewed", err: errors.New(`ent: missing required field "File.times_viewed"`)}
+	}
 	if _, ok := _c.mutation.ExpiryType(); !ok {
 		return &ValidationError{Name: "expiry_type", err: errors.New(`ent: missing required field "File.expiry_type"`)}
 	}
@@ -231,6 +344,9 @@ func (_c *FileCreate) check() error {
 	if _, ok := _c.mutation.ExpiryTotalDownloads(); !ok {
 		return &ValidationError{Name: "expiry_total_downloads", err: errors.New(`ent: missing required field "File.expiry_total_downloads"`)}
 	}
+	if _, ok := _c.mutation.ScanStatus(); !ok {
+		return &ValidationError{Name: "scan_status", err: errors.New(`ent: missing required field "File.scan_status"`)}
+	}
 	if len(_c.mutation.OwnerIDs()) == 0 {
 		return &ValidationError{Name: "owner", err: errors.New(`ent: missing required edge "File.owner"`)}
 	}
@@ -288,6 +404,14 @@ func (_c *FileCreate) createSpec() (*File, *sqlgraph.CreateSpec) {
 		_spec.SetField(file.FieldTimesDownloaded, field.TypeUint64, value)
 		_node.TimesDownloaded = value
 	}
+	if value, ok := _c.mutation.LastView(); ok {
+		_spec.SetField(file.FieldLastView, field.TypeTime, value)
+		_node.LastView = &value
+	}
+	if value, ok := _c.mutation.TimesViewed(); ok {
+		_spec.SetField(file.FieldTimesViewed, field.TypeUint64, value)
+		_node.TimesViewed = value
+	}
 	if value, ok := _c.mutation.ExpiryType(); ok {
 		_spec.SetField(file.FieldExpiryType, field.TypeString, value)
 		_node.ExpiryType = value
@@ -304,6 +428,18 @@ func (_c *FileCreate) createSpec() (*File, *sqlgraph.CreateSpec) {
 		_spec.SetField(file.FieldExpiryTotalDownloads, field.TypeUint8, value)
 		_node.ExpiryTotalDownloads = value
 	}
+	if value, ok := _c.mutation.ScanStatus(); ok {
+		_spec.SetField(file.FieldScanStatus, field.TypeString, value)
+		_node.ScanStatus = value
+	}
+	if value, ok := _c.mutation.ScanSignature(); ok {
+		_spec.SetField(file.FieldScanSignature, field.TypeString, value)
+		_node.ScanSignature = &value
+	}
+	if value, ok := _c.mutation.MimeType(); ok {
+		_spec.SetField(file.FieldMimeType, field.TypeString, value)
+		_node.MimeType = &value
+	}
 	if nodes := _c.mutation.TicketIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -372,6 +508,38 @@ func (_c *FileCreate) createSpec() (*File, *sqlgraph.CreateSpec) {
 		_node.file_data = &nodes[0]
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := _c.mutation.DownloadProgressesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   file.DownloadProgressesTable,
+			Columns: []string{file.DownloadProgressesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(downloadprogress.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.AccessEventsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   file.AccessEventsTable,
+			Columns: []string{file.AccessEventsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(accessevent.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
@@ -4,9 +4,12 @@ package ent
 
 import (
 	"context"
+	"database/sql/driver"
 	"fmt"
 	"math"
 
+	"codeberg.org/jvllmr/frans/internal/ent/accessevent"
+	"codeberg.org/jvllmr/frans/internal/ent/downloadprogress"
 	"codeberg.org/jvllmr/frans/internal/ent/file"
 	"codeberg.org/jvllmr/frans/internal/ent/filedata"
 	"codeberg.org/jvllmr/frans/internal/ent/grant"
@@ -23,15 +26,17 @@ import (
 // FileQuery is the builder for querying File entities.
 type FileQuery struct {
 	config
-	ctx        *QueryContext
-	order      []file.OrderOption
-	inters     []Interceptor
-	predicates []predicate.File
-	withTicket *TicketQuery
-	withGrant  *GrantQuery
-	withOwner  *UserQuery
-	withData   *FileDataQuery
-	withFKs    bool
+	ctx                    *QueryContext
+	order                  []file.OrderOption
+	inters                 []Interceptor
+	predicates             []predicate.File
+	withTicket             *TicketQuery
+	withGrant              *GrantQuery
+	withOwner              *UserQuery
+	withData               *FileDataQuery
+	withDownloadProgresses *DownloadProgressQuery
+	withAccessEvents       *AccessEventQuery
+	withFKs                bool
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -156,6 +161,50 @@ func (_q *FileQuery) QueryData() *FileDataQuery {
 	return query
 }
 
+// QueryDownloadProgresses chains the current query on the "download_progresses" edge.
+func (_q *FileQuery) QueryDownloadProgresses() *DownloadProgressQuery {
+	query := (&DownloadProgressClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(file.Table, file.FieldID, selector),
+			sqlgraph.To(downloadprogress.Table, downloadprogress.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, file.DownloadProgressesTable, file.DownloadProgressesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryAccessEvents chains the current query on the "access_events" edge.
+func (_q *FileQuery) QueryAccessEvents() *AccessEventQuery {
+	query := (&AccessEventClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(file.Table, file.FieldID, selector),
+			sqlgraph.To(accessevent.Table, accessevent.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, file.AccessEventsTable, file.AccessEventsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first File entity from the query.
 // Returns a *NotFoundError when no File was found.
 func (_q *FileQuery) First(ctx context.Context) (*File, error) {
@@ -343,15 +392,17 @@ func (_q *FileQuery) Clone() *FileQuery {
 		return nil
 	}
 	return &FileQuery{
-		config:     _q.config,
-		ctx:        _q.ctx.Clone(),
-		order:      append([]file.OrderOption{}, _q.order...),
-		inters:     append([]Interceptor{}, _q.inters...),
-		predicates: append([]predicate.File{}, _q.predicates...),
-		withTicket: _q.withTicket.Clone(),
-		withGrant:  _q.withGrant.Clone(),
-		withOwner:  _q.withOwner.Clone(),
-		withData:   _q.withData.Clone(),
+		config:                 _q.config,
+		ctx:                    _q.ctx.Clone(),
+		order:                  append([]file.OrderOption{}, _q.order...),
+		inters:                 append([]Interceptor{}, _q.inters...),
+		predicates:             append([]predicate.File{}, _q.predicates...),
+		withTicket:             _q.withTicket.Clone(),
+		withGrant:              _q.withGrant.Clone(),
+		withOwner:              _q.withOwner.Clone(),
+		withData:               _q.withData.Clone(),
+		withDownloadProgresses: _q.withDownloadProgresses.Clone(),
+		withAccessEvents:       _q.withAccessEvents.Clone(),
 		// clone intermediate query.
 		sql:  _q.sql.Clone(),
 		path: _q.path,
@@ -402,6 +453,28 @@ func (_q *FileQuery) WithData(opts ...func(*FileDataQuery)) *FileQuery {
 	return _q
 }
 
+// WithDownloadProgresses tells the query-builder to eager-load the nodes that are connected to
+// the "download_progresses" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *FileQuery) WithDownloadProgresses(opts ...func(*DownloadProgressQuery)) *FileQuery {
+	query := (&DownloadProgressClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withDownloadProgresses = query
+	return _q
+}
+
+// WithAccessEvents tells the query-builder to eager-load the nodes that are connected to
+// the "access_events" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *FileQuery) WithAccessEvents(opts ...func(*AccessEventQuery)) *FileQuery {
+	query := (&AccessEventClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withAccessEvents = query
+	return _q
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -481,11 +554,13 @@ func (_q *FileQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*File, e
 		nodes       = []*File{}
 		withFKs     = _q.withFKs
 		_spec       = _q.querySpec()
-		loadedTypes = [4]bool{
+		loadedTypes = [6]bool{
 			_q.withTicket != nil,
 			_q.withGrant != nil,
 			_q.withOwner != nil,
 			_q.withData != nil,
+			_q.withDownloadProgresses != nil,
+			_q.withAccessEvents != nil,
 		}
 	)
 	if _q.withTicket != nil || _q.withGrant != nil || _q.withOwner != nil || _q.withData != nil {
@@ -536,6 +611,20 @@ func (_q *FileQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*File, e
 			return nil, err
 		}
 	}
+	if query := _q.withDownloadProgresses; query != nil {
+		if err := _q.loadDownloadProgresses(ctx, query, nodes,
+			func(n *File) { n.Edges.DownloadProgresses = []*DownloadProgress{} },
+			func(n *File, e *DownloadProgress) { n.Edges.DownloadProgresses = append(n.Edges.DownloadProgresses, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withAccessEvents; query != nil {
+		if err := _q.loadAccessEvents(ctx, query, nodes,
+			func(n *File) { n.Edges.AccessEvents = []*AccessEvent{} },
+			func(n *File, e *AccessEvent) { n.Edges.AccessEvents = append(n.Edges.AccessEvents, e) }); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -667,6 +756,68 @@ func (_q *FileQuery) loadData(ctx context.Context, query *FileDataQuery, nodes [
 	}
 	return nil
 }
+func (_q *FileQuery) loadDownloadProgresses(ctx context.Context, query *DownloadProgressQuery, nodes []*File, init func(*File), assign func(*File, *DownloadProgress)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*File)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.DownloadProgress(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(file.DownloadProgressesColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.file_download_progresses
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "file_download_progresses" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "file_download_progresses" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *FileQuery) loadAccessEvents(ctx context.Context, query *AccessEventQuery, nodes []*File, init func(*File), assign func(*File, *AccessEvent)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*File)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.AccessEvent(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(file.AccessEventsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.file_access_events
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "file_access_events" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "file_access_events" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
 
 func (_q *FileQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := _q.querySpec()
@@ -8,6 +8,8 @@ import (
 	"fmt"
 	"time"
 
+	"codeberg.org/jvllmr/frans/internal/ent/accessevent"
+	"codeberg.org/jvllmr/frans/internal/ent/downloadprogress"
 	"codeberg.org/jvllmr/frans/internal/ent/file"
 	"codeberg.org/jvllmr/frans/internal/ent/filedata"
 	"codeberg.org/jvllmr/frans/internal/ent/grant"
@@ -102,6 +104,47 @@ func (_u *FileUpdate) AddTimesDownloaded(v int64) *FileUpdate {
 	return _u
 }
 
+// SetLastView sets the "last_view" field.
+func (_u *FileUpdate) SetLastView(v time.Time) *FileUpdate {
+	_u.mutation.SetLastView(v)
+	return _u
+}
+
+// SetNillableLastView sets the "last_view" field if the given value is not nil.
+func (_u *FileUpdate) SetNillableLastView(v *time.Time) *FileUpdate {
+	if v != nil {
+		_u.SetLastView(*v)
+	}
+	return _u
+}
+
+// ClearLastView clears the value of the "last_view" field.
+func (_u *FileUpdate) ClearLastView() *FileUpdate {
+	_u.mutation.ClearLastView()
+	return _u
+}
+
+// SetTimesViewed sets the "times_viewed" field.
+func (_u *FileUpdate) SetTimesViewed(v uint64) *FileUpdate {
+	_u.mutation.ResetTimesViewed()
+	_u.mutation.SetTimesViewed(v)
+	return _u
+}
+
+// SetNillableTimesViewed sets the "times_viewed" field if the given value is not nil.
+func (_u *FileUpdate) SetNillableTimesViewed(v *uint64) *FileUpdate {
+	if v != nil {
+		_u.SetTimesViewed(*v)
+	}
+	return _u
+}
+
+// AddTimesViewed adds value to the "times_viewed" field.
+func (_u *FileUpdate) AddTimesViewed(v int64) *FileUpdate {
+	_u.mutation.AddTimesViewed(v)
+	return _u
+}
+
 // SetExpiryType sets the "expiry_type" field.
 func (_u *FileUpdate) SetExpiryType(v string) *FileUpdate {
 	_u.mutation.SetExpiryType(v)
@@ -179,6 +222,60 @@ func (_u *FileUpdate) AddExpiryTotalDownloads(v int8) *FileUpdate {
 	return _u
 }
 
+// SetScanStatus sets the "scan_status" field.
+func (_u *FileUpdate) SetScanStatus(v string) *FileUpdate {
+	_u.mutation.SetScanStatus(v)
+	return _u
+}
+
+// SetNillableScanStatus sets the "scan_status" field if the given value is not nil.
+func (_u *FileUpdate) SetNillableScanStatus(v *string) *FileUpdate {
+	if v != nil {
+		_u.SetScanStatus(*v)
+	}
+	return _u
+}
+
+// SetScanSignature sets the "scan_signature" field.
+func (_u *FileUpdate) SetScanSignature(v string) *FileUpdate {
+	_u.mutation.SetScanSignature(v)
+	return _u
+}
+
+// SetNillableScanSignature sets the "scan_signature" field if the given value is not nil.
+func (_u *FileUpdate) SetNillableScanSignature(v *string) *FileUpdate {
+	if v != nil {
+		_u.SetScanSignature(*v)
+	}
+	return _u
+}
+
+// ClearScanSignature clears the value of the "scan_signature" field.
+func (_u *FileUpdate) ClearScanSignature() *FileUpdate {
+	_u.mutation.ClearScanSignature()
+	return _u
+}
+
+// SetMimeType sets the "mime_type" field.
+func (_u *FileUpdate) SetMimeType(v string) *FileUpdate {
+	_u.mutation.SetMimeType(v)
+	return _u
+}
+
+// SetNillableMimeType sets the "mime_type" field if the given value is not nil.
+func (_u *FileUpdate) SetNillableMimeType(v *string) *FileUpdate {
+	if v != nil {
+		_u.SetMimeType(*v)
+	}
+	return _u
+}
+
+// ClearMimeType clears the value of the "mime_type" field.
+func (_u *FileUpdate) ClearMimeType() *FileUpdate {
+	_u.mutation.ClearMimeType()
+	return _u
+}
+
 // SetTicketID sets the "ticket" edge to the Ticket entity by ID.
 func (_u *FileUpdate) SetTicketID(id uuid.UUID) *FileUpdate {
 	_u.mutation.SetTicketID(id)
@@ -239,6 +336,36 @@ func (_u *FileUpdate) SetData(v *FileData) *FileUpdate {
 	return _u.SetDataID(v.ID)
 }
 
+// AddDownloadProgressIDs adds the "download_progresses" edge to the DownloadProgress entity by IDs.
+func (_u *FileUpdate) AddDownloadProgressIDs(ids ...int) *FileUpdate {
+	_u.mutation.AddDownloadProgressIDs(ids...)
+	return _u
+}
+
+// AddDownloadProgresses adds the "download_progresses" edges to the DownloadProgress entity.
+func (_u *FileUpdate) AddDownloadProgresses(v ...*DownloadProgress) *FileUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddDownloadProgressIDs(ids...)
+}
+
+// AddAccessEventIDs adds the "access_events" edge to the AccessEvent entity by IDs.
+func (_u *FileUpdate) AddAccessEventIDs(ids ...int) *FileUpdate {
+	_u.mutation.AddAccessEventIDs(ids...)
+	return _u
+}
+
+// AddAccessEvents adds the "access_events" edges to the AccessEvent entity.
+func (_u *FileUpdate) AddAccessEvents(v ...*AccessEvent) *FileUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddAccessEventIDs(ids...)
+}
+
 // Mutation returns the FileMutation object of the builder.
 func (_u *FileUpdate) Mutation() *FileMutation {
 	return _u.mutation
@@ -268,6 +395,48 @@ func (_u *FileUpdate) ClearData() *FileUpdate {
 	return _u
 }
 
+// ClearDownloadProgresses clears all "download_progresses" edges to the DownloadProgress entity.
+func (_u *FileUpdate) ClearDownloadProgresses() *FileUpdate {
+	_u.mutation.ClearDownloadProgresses()
+	return _u
+}
+
+// RemoveDownloadProgressIDs removes the "download_progresses" edge to DownloadProgress entities by IDs.
+func (_u *FileUpdate) RemoveDownloadProgressIDs(ids ...int) *FileUpdate {
+	_u.mutation.RemoveDownloadProgressIDs(ids...)
+	return _u
+}
+
+// RemoveDownloadProgresses removes "download_progresses" edges to DownloadProgress entities.
+func (_u *FileUpdate) RemoveDownloadProgresses(v ...*DownloadProgress) *FileUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveDownloadProgressIDs(ids...)
+}
+
+// ClearAccessEvents clears all "access_events" edges to the AccessEvent entity.
+func (_u *FileUpdate) ClearAccessEvents() *FileUpdate {
+	_u.mutation.ClearAccessEvents()
+	return _u
+}
+
+// RemoveAccessEventIDs removes the "access_events" edge to AccessEvent entities by IDs.
+func (_u *FileUpdate) RemoveAccessEventIDs(ids ...int) *FileUpdate {
+	_u.mutation.RemoveAccessEventIDs(ids...)
+	return _u
+}
+
+// RemoveAccessEvents removes "access_events" edges to AccessEvent entities.
+func (_u *FileUpdate) RemoveAccessEvents(v ...*AccessEvent) *FileUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveAccessEventIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (_u *FileUpdate) Save(ctx context.Context) (int, error) {
 	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
@@ -336,6 +505,18 @@ func (_u *FileUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if value, ok := _u.mutation.AddedTimesDownloaded(); ok {
 		_spec.AddField(file.FieldTimesDownloaded, field.TypeUint64, value)
 	}
+	if value, ok := _u.mutation.LastView(); ok {
+		_spec.SetField(file.FieldLastView, field.TypeTime, value)
+	}
+	if _u.mutation.LastViewCleared() {
+		_spec.ClearField(file.FieldLastView, field.TypeTime)
+	}
+	if value, ok := _u.mutation.TimesViewed(); ok {
+		_spec.SetField(file.FieldTimesViewed, field.TypeUint64, value)
+	}
+	if value, ok := _u.mutation.AddedTimesViewed(); ok {
+		_spec.AddField(file.FieldTimesViewed, field.TypeUint64, value)
+	}
 	if value, ok := _u.mutation.ExpiryType(); ok {
 		_spec.SetField(file.FieldExpiryType, field.TypeString, value)
 	}
@@ -357,6 +538,21 @@ func (_u *FileUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if value, ok := _u.mutation.AddedExpiryTotalDownloads(); ok {
 		_spec.AddField(file.FieldExpiryTotalDownloads, field.TypeUint8, value)
 	}
+	if value, ok := _u.mutation.ScanStatus(); ok {
+		_spec.SetField(file.FieldScanStatus, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ScanSignature(); ok {
+		_spec.SetField(file.FieldScanSignature, field.TypeString, value)
+	}
+	if _u.mutation.ScanSignatureCleared() {
+		_spec.ClearField(file.FieldScanSignature, field.TypeString)
+	}
+	if value, ok := _u.mutation.MimeType(); ok {
+		_spec.SetField(file.FieldMimeType, field.TypeString, value)
+	}
+	if _u.mutation.MimeTypeCleared() {
+		_spec.ClearField(file.FieldMimeType, field.TypeString)
+	}
 	if _u.mutation.TicketCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -473,6 +669,96 @@ func (_u *FileUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.DownloadProgressesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   file.DownloadProgressesTable,
+			Columns: []string{file.DownloadProgressesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(downloadprogress.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedDownloadProgressesIDs(); len(nodes) > 0 && !_u.mutation.DownloadProgressesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   file.DownloadProgressesTable,
+			Columns: []string{file.DownloadProgressesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(downloadprogress.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.DownloadProgressesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   file.DownloadProgressesTable,
+			Columns: []string{file.DownloadProgressesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(downloadprogress.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.AccessEventsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   file.AccessEventsTable,
+			Columns: []string{file.AccessEventsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(accessevent.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedAccessEventsIDs(); len(nodes) > 0 && !_u.mutation.AccessEventsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   file.AccessEventsTable,
+			Columns: []string{file.AccessEventsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(accessevent.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.AccessEventsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   file.AccessEventsTable,
+			Columns: []string{file.AccessEventsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(accessevent.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{file.Label}
@@ -562,6 +848,47 @@ func (_u *FileUpdateOne) AddTimesDownloaded(v int64) *FileUpdateOne {
 	return _u
 }
 
+// SetLastView sets the "last_view" field.
+func (_u *FileUpdateOne) SetLastView(v time.Time) *FileUpdateOne {
+	_u.mutation.SetLastView(v)
+	return _u
+}
+
+// SetNillableLastView sets the "last_view" field if the given value is not nil.
+func (_u *FileUpdateOne) SetNillableLastView(v *time.Time) *FileUpdateOne {
+	if v != nil {
+		_u.SetLastView(*v)
+	}
+	return _u
+}
+
+// ClearLastView clears the value of the "last_view" field.
+func (_u *FileUpdateOne) ClearLastView() *FileUpdateOne {
+	_u.mutation.ClearLastView()
+	return _u
+}
+
+// SetTimesViewed sets the "times_viewed" field.
+func (_u *FileUpdateOne) SetTimesViewed(v uint64) *FileUpdateOne {
+	_u.mutation.ResetTimesViewed()
+	_u.mutation.SetTimesViewed(v)
+	return _u
+}
+
+// SetNillableTimesViewed sets the "times_viewed" field if the given value is not nil.
+func (_u *FileUpdateOne) SetNillableTimesViewed(v *uint64) *FileUpdateOne {
+	if v != nil {
+		_u.SetTimesViewed(*v)
+	}
+	return _u
+}
+
+// AddTimesViewed adds value to the "times_viewed" field.
+func (_u *FileUpdateOne) AddTimesViewed(v int64) *FileUpdateOne {
+	_u.mutation.AddTimesViewed(v)
+	return _u
+}
+
 // SetExpiryType sets the "expiry_type" field.
 func (_u *FileUpdateOne) SetExpiryType(v string) *FileUpdateOne {
 	_u.mutation.SetExpiryType(v)
@@ -639,6 +966,60 @@ func (_u *FileUpdateOne) AddExpiryTotalDownloads(v int8) *FileUpdateOne {
 	return _u
 }
 
+// SetScanStatus sets the "scan_status" field.
+func (_u *FileUpdateOne) SetScanStatus(v string) *FileUpdateOne {
+	_u.mutation.SetScanStatus(v)
+	return _u
+}
+
+// SetNillableScanStatus sets the "scan_status" field if the given value is not nil.
+func (_u *FileUpdateOne) SetNillableScanStatus(v *string) *FileUpdateOne {
+	if v != nil {
+		_u.SetScanStatus(*v)
+	}
+	return _u
+}
+
+// SetScanSignature sets the "scan_signature" field.
+func (_u *FileUpdateOne) SetScanSignature(v string) *FileUpdateOne {
+	_u.mutation.SetScanSignature(v)
+	return _u
+}
+
+// SetNillableScanSignature sets the "scan_signature" field if the given value is not nil.
+func (_u *FileUpdateOne) SetNillableScanSignature(v *string) *FileUpdateOne {
+	if v != nil {
+		_u.SetScanSignature(*v)
+	}
+	return _u
+}
+
+// ClearScanSignature clears the value of the "scan_signature" field.
+func (_u *FileUpdateOne) ClearScanSignature() *FileUpdateOne {
+	_u.mutation.ClearScanSignature()
+	return _u
+}
+
+// SetMimeType sets the "mime_type" field.
+func (_u *FileUpdateOne) SetMimeType(v string) *FileUpdateOne {
+	_u.mutation.SetMimeType(v)
+	return _u
+}
+
+// SetNillableMimeType sets the "mime_type" field if the given value is not nil.
+func (_u *FileUpdateOne) SetNillableMimeType(v *string) *FileUpdateOne {
+	if v != nil {
+		_u.SetMimeType(*v)
+	}
+	return _u
+}
+
+// ClearMimeType clears the value of the "mime_type" field.
+func (_u *FileUpdateOne) ClearMimeType() *FileUpdateOne {
+	_u.mutation.ClearMimeType()
+	return _u
+}
+
 // SetTicketID sets the "ticket" edge to the Ticket entity by ID.
 func (_u *FileUpdateOne) SetTicketID(id uuid.UUID) *FileUpdateOne {
 	_u.mutation.SetTicketID(id)
@@ -699,6 +1080,36 @@ func (_u *FileUpdateOne) SetData(v *FileData) *FileUpdateOne {
 	return _u.SetDataID(v.ID)
 }
 
+// AddDownloadProgressIDs adds the "download_progresses" edge to the DownloadProgress entity by IDs.
+func (_u *FileUpdateOne) AddDownloadProgressIDs(ids ...int) *FileUpdateOne {
+	_u.mutation.AddDownloadProgressIDs(ids...)
+	return _u
+}
+
+// AddDownloadProgresses adds the "download_progresses" edges to the DownloadProgress entity.
+func (_u *FileUpdateOne) AddDownloadProgresses(v ...*DownloadProgress) *FileUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddDownloadProgressIDs(ids...)
+}
+
+// AddAccessEventIDs adds the "access_events" edge to the AccessEvent entity by IDs.
+func (_u *FileUpdateOne) AddAccessEventIDs(ids ...int) *FileUpdateOne {
+	_u.mutation.AddAccessEventIDs(ids...)
+	return _u
+}
+
+// AddAccessEvents adds the "access_events" edges to the AccessEvent entity.
+func (_u *FileUpdateOne) AddAccessEvents(v ...*AccessEvent) *FileUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddAccessEventIDs(ids...)
+}
+
 // Mutation returns the FileMutation object of the builder.
 func (_u *FileUpdateOne) Mutation() *FileMutation {
 	return _u.mutation
@@ -728,6 +1139,48 @@ func (_u *FileUpdateOne) ClearData() *FileUpdateOne {
 	return _u
 }
 
+// ClearDownloadProgresses clears all "download_progresses" edges to the DownloadProgress entity.
+func (_u *FileUpdateOne) ClearDownloadProgresses() *FileUpdateOne {
+	_u.mutation.ClearDownloadProgresses()
+	return _u
+}
+
+// RemoveDownloadProgressIDs removes the "download_progresses" edge to DownloadProgress entities by IDs.
+func (_u *FileUpdateOne) RemoveDownloadProgressIDs(ids ...int) *FileUpdateOne {
+	_u.mutation.RemoveDownloadProgressIDs(ids...)
+	return _u
+}
+
+// RemoveDownloadProgresses removes "download_progresses" edges to DownloadProgress entities.
+func (_u *FileUpdateOne) RemoveDownloadProgresses(v ...*DownloadProgress) *FileUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveDownloadProgressIDs(ids...)
+}
+
+// ClearAccessEvents clears all "access_events" edges to the AccessEvent entity.
+func (_u *FileUpdateOne) ClearAccessEvents() *FileUpdateOne {
+	_u.mutation.ClearAccessEvents()
+	return _u
+}
+
+// RemoveAccessEventIDs removes the "access_events" edge to AccessEvent entities by IDs.
+func (_u *FileUpdateOne) RemoveAccessEventIDs(ids ...int) *FileUpdateOne {
+	_u.mutation.RemoveAccessEventIDs(ids...)
+	return _u
+}
+
+// RemoveAccessEvents removes "access_events" edges to AccessEvent entities.
+func (_u *FileUpdateOne) RemoveAccessEvents(v ...*AccessEvent) *FileUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveAccessEventIDs(ids...)
+}
+
 // Where appends a list predicates to the FileUpdate builder.
 func (_u *FileUpdateOne) Where(ps ...predicate.File) *FileUpdateOne {
 	_u.mutation.Where(ps...)
@@ -826,6 +1279,18 @@ func (_u *FileUpdateOne) sqlSave(ctx context.Context) (_node *File, err error) {
 	if value, ok := _u.mutation.AddedTimesDownloaded(); ok {
 		_spec.AddField(file.FieldTimesDownloaded, field.TypeUint64, value)
 	}
+	if value, ok := _u.mutation.LastView(); ok {
+		_spec.SetField(file.FieldLastView, field.TypeTime, value)
+	}
+	if _u.mutation.LastViewCleared() {
+		_spec.ClearField(file.FieldLastView, field.TypeTime)
+	}
+	if value, ok := _u.mutation.TimesViewed(); ok {
+		_spec.SetField(file.FieldTimesViewed, field.TypeUint64, value)
+	}
+	if value, ok := _u.mutation.AddedTimesViewed(); ok {
+		_spec.AddField(file.FieldTimesViewed, field.TypeUint64, value)
+	}
 	if value, ok := _u.mutation.ExpiryType(); ok {
 		_spec.SetField(file.FieldExpiryType, field.TypeString, value)
 	}
@@ -847,6 +1312,21 @@ func (_u *FileUpdateOne) sqlSave(ctx context.Context) (_node *File, err error) {
 	if value, ok := _u.mutation.AddedExpiryTotalDownloads(); ok {
 		_spec.AddField(file.FieldExpiryTotalDownloads, field.TypeUint8, value)
 	}
+	if value, ok := _u.mutation.ScanStatus(); ok {
+		_spec.SetField(file.FieldScanStatus, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ScanSignature(); ok {
+		_spec.SetField(file.FieldScanSignature, field.TypeString, value)
+	}
+	if _u.mutation.ScanSignatureCleared() {
+		_spec.ClearField(file.FieldScanSignature, field.TypeString)
+	}
+	if value, ok := _u.mutation.MimeType(); ok {
+		_spec.SetField(file.FieldMimeType, field.TypeString, value)
+	}
+	if _u.mutation.MimeTypeCleared() {
+		_spec.ClearField(file.FieldMimeType, field.TypeString)
+	}
 	if _u.mutation.TicketCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -963,6 +1443,96 @@ func (_u *FileUpdateOne) sqlSave(ctx context.Context) (_node *File, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.DownloadProgressesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   file.DownloadProgressesTable,
+			Columns: []string{file.DownloadProgressesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(downloadprogress.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedDownloadProgressesIDs(); len(nodes) > 0 && !_u.mutation.DownloadProgressesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   file.DownloadProgressesTable,
+			Columns: []string{file.DownloadProgressesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(downloadprogress.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.DownloadProgressesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   file.DownloadProgressesTable,
+			Columns: []string{file.DownloadProgressesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(downloadprogress.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.AccessEventsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   file.AccessEventsTable,
+			Columns: []string{file.AccessEventsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(accessevent.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedAccessEventsIDs(); len(nodes) > 0 && !_u.mutation.AccessEventsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   file.AccessEventsTable,
+			Columns: []string{file.AccessEventsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(accessevent.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.AccessEventsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   file.AccessEventsTable,
+			Columns: []string{file.AccessEventsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(accessevent.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &File{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
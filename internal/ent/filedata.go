@@ -18,6 +18,24 @@ type FileData struct {
 	ID string `json:"id,omitempty"`
 	// Size holds the value of the "size" field.
 	Size uint64 `json:"size,omitempty"`
+	// Sha256 holds the value of the "sha256" field.
+	Sha256 *string `json:"sha256,omitempty"`
+	// KeyID holds the value of the "key_id" field.
+	KeyID *string `json:"key_id,omitempty"`
+	// EncryptedKey holds the value of the "encrypted_key" field.
+	EncryptedKey []byte `json:"encrypted_key,omitempty"`
+	// Compression holds the value of the "compression" field.
+	Compression *string `json:"compression,omitempty"`
+	// CompressedSize holds the value of the "compressed_size" field.
+	CompressedSize *uint64 `json:"compressed_size,omitempty"`
+	// StoredSize holds the value of the "stored_size" field.
+	StoredSize int64 `json:"stored_size,omitempty"`
+	// MimeType holds the value of the "mime_type" field.
+	MimeType *string `json:"mime_type,omitempty"`
+	// PreviewStatus holds the value of the "preview_status" field.
+	PreviewStatus string `json:"preview_status,omitempty"`
+	// PreviewType holds the value of the "preview_type" field.
+	PreviewType *string `json:"preview_type,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the FileDataQuery when eager-loading is set.
 	Edges        FileDataEdges `json:"edges"`
@@ -47,9 +65,11 @@ func (*FileData) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case filedata.FieldSize:
+		case filedata.FieldEncryptedKey:
+			values[i] = new([]byte)
+		case filedata.FieldSize, filedata.FieldCompressedSize, filedata.FieldStoredSize:
 			values[i] = new(sql.NullInt64)
-		case filedata.FieldID:
+		case filedata.FieldID, filedata.FieldSha256, filedata.FieldKeyID, filedata.FieldCompression, filedata.FieldMimeType, filedata.FieldPreviewStatus, filedata.FieldPreviewType:
 			values[i] = new(sql.NullString)
 		default:
 			values[i] = new(sql.UnknownType)
@@ -78,6 +98,66 @@ func (_m *FileData) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.Size = uint64(value.Int64)
 			}
+		case filedata.FieldSha256:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field sha256", values[i])
+			} else if value.Valid {
+				_m.Sha256 = new(string)
+				*_m.Sha256 = value.String
+			}
+		case filedata.FieldKeyID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field key_id", values[i])
+			} else if value.Valid {
+				_m.KeyID = new(string)
+				*_m.KeyID = value.String
+			}
+		case filedata.FieldEncryptedKey:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field encrypted_key", values[i])
+			} else if value != nil {
+				_m.EncryptedKey = *value
+			}
+		case filedata.FieldCompression:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field compression", values[i])
+			} else if value.Valid {
+				_m.Compression = new(string)
+				*_m.Compression = value.String
+			}
+		case filedata.FieldCompressedSize:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field compressed_size", values[i])
+			} else if value.Valid {
+				_m.CompressedSize = new(uint64)
+				*_m.CompressedSize = uint64(value.Int64)
+			}
+		case filedata.FieldStoredSize:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field stored_size", values[i])
+			} else if value.Valid {
+				_m.StoredSize = value.Int64
+			}
+		case filedata.FieldMimeType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field mime_type", values[i])
+			} else if value.Valid {
+				_m.MimeType = new(string)
+				*_m.MimeType = value.String
+			}
+		case filedata.FieldPreviewStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field preview_status", values[i])
+			} else if value.Valid {
+				_m.PreviewStatus = value.String
+			}
+		case filedata.FieldPreviewType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field preview_type", values[i])
+			} else if value.Valid {
+				_m.PreviewType = new(string)
+				*_m.PreviewType = value.String
+			}
 		default:
 			_m.selectValues.Set(columns[i], values[i])
 		}
@@ -121,6 +201,45 @@ func (_m *FileData) String() string {
 	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
 	builder.WriteString("size=")
 	builder.WriteString(fmt.Sprintf("%v", _m.Size))
+	builder.WriteString(", ")
+	if v := _m.Sha256; v != nil {
+		builder.WriteString("sha256=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := _m.KeyID; v != nil {
+		builder.WriteString("key_id=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	builder.WriteString("encrypted_key=")
+	builder.WriteString(fmt.Sprintf("%v", _m.EncryptedKey))
+	builder.WriteString(", ")
+	if v := _m.Compression; v != nil {
+		builder.WriteString("compression=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := _m.CompressedSize; v != nil {
+		builder.WriteString("compressed_size=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("stored_size=")
+	builder.WriteString(fmt.Sprintf("%v", _m.StoredSize))
+	builder.WriteString(", ")
+	if v := _m.MimeType; v != nil {
+		builder.WriteString("mime_type=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	builder.WriteString("preview_status=")
+	builder.WriteString(_m.PreviewStatus)
+	builder.WriteString(", ")
+	if v := _m.PreviewType; v != nil {
+		builder.WriteString("preview_type=")
+		builder.WriteString(*v)
+	}
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -14,6 +14,24 @@ const (
 	FieldID = "id"
 	// FieldSize holds the string denoting the size field in the database.
 	FieldSize = "size"
+	// FieldSha256 holds the string denoting the sha256 field in the database.
+	FieldSha256 = "sha256"
+	// FieldKeyID holds the string denoting the key_id field in the database.
+	FieldKeyID = "key_id"
+	// FieldEncryptedKey holds the string denoting the encrypted_key field in the database.
+	FieldEncryptedKey = "encrypted_key"
+	// FieldCompression holds the string denoting the compression field in the database.
+	FieldCompression = "compression"
+	// FieldCompressedSize holds the string denoting the compressed_size field in the database.
+	FieldCompressedSize = "compressed_size"
+	// FieldStoredSize holds the string denoting the stored_size field in the database.
+	FieldStoredSize = "stored_size"
+	// FieldMimeType holds the string denoting the mime_type field in the database.
+	FieldMimeType = "mime_type"
+	// FieldPreviewStatus holds the string denoting the preview_status field in the database.
+	FieldPreviewStatus = "preview_status"
+	// FieldPreviewType holds the string denoting the preview_type field in the database.
+	FieldPreviewType = "preview_type"
 	// EdgeFiles holds the string denoting the files edge name in mutations.
 	EdgeFiles = "files"
 	// Table holds the table name of the filedata in the database.
@@ -31,6 +49,15 @@ const (
 var Columns = []string{
 	FieldID,
 	FieldSize,
+	FieldSha256,
+	FieldKeyID,
+	FieldEncryptedKey,
+	FieldCompression,
+	FieldCompressedSize,
+	FieldStoredSize,
+	FieldMimeType,
+	FieldPreviewStatus,
+	FieldPreviewType,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -43,6 +70,13 @@ func ValidColumn(column string) bool {
 	return false
 }
 
+var (
+	// DefaultStoredSize holds the default value on creation for the "stored_size" field.
+	DefaultStoredSize int64
+	// DefaultPreviewStatus holds the default value on creation for the "preview_status" field.
+	DefaultPreviewStatus string
+)
+
 // OrderOption defines the ordering options for the FileData queries.
 type OrderOption func(*sql.Selector)
 
@@ -56,6 +90,46 @@ func BySize(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldSize, opts...).ToFunc()
 }
 
+// BySha256 orders the results by the sha256 field.
+func BySha256(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSha256, opts...).ToFunc()
+}
+
+// ByKeyID orders the results by the key_id field.
+func ByKeyID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldKeyID, opts...).ToFunc()
+}
+
+// ByCompression orders the results by the compression field.
+func ByCompression(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCompression, opts...).ToFunc()
+}
+
+// ByCompressedSize orders the results by the compressed_size field.
+func ByCompressedSize(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCompressedSize, opts...).ToFunc()
+}
+
+// ByStoredSize orders the results by the stored_size field.
+func ByStoredSize(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStoredSize, opts...).ToFunc()
+}
+
+// ByMimeType orders the results by the mime_type field.
+func ByMimeType(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMimeType, opts...).ToFunc()
+}
+
+// ByPreviewStatus orders the results by the preview_status field.
+func ByPreviewStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPreviewStatus, opts...).ToFunc()
+}
+
+// ByPreviewType orders the results by the preview_type field.
+func ByPreviewType(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPreviewType, opts...).ToFunc()
+}
+
 // ByFilesCount orders the results by files count.
 func ByFilesCount(opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
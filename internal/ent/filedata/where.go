@@ -68,6 +68,51 @@ func Size(v uint64) predicate.FileData {
 	return predicate.FileData(sql.FieldEQ(FieldSize, v))
 }
 
+// Sha256 applies equality check predicate on the "sha256" field. It's identical to Sha256EQ.
+func Sha256(v string) predicate.FileData {
+	return predicate.FileData(sql.FieldEQ(FieldSha256, v))
+}
+
+// KeyID applies equality check predicate on the "key_id" field. It's identical to KeyIDEQ.
+func KeyID(v string) predicate.FileData {
+	return predicate.FileData(sql.FieldEQ(FieldKeyID, v))
+}
+
+// EncryptedKey applies equality check predicate on the "encrypted_key" field. It's identical to EncryptedKeyEQ.
+func EncryptedKey(v []byte) predicate.FileData {
+	return predicate.FileData(sql.FieldEQ(FieldEncryptedKey, v))
+}
+
+// Compression applies equality check predicate on the "compression" field. It's identical to CompressionEQ.
+func Compression(v string) predicate.FileData {
+	return predicate.FileData(sql.FieldEQ(FieldCompression, v))
+}
+
+// CompressedSize applies equality check predicate on the "compressed_size" field. It's identical to CompressedSizeEQ.
+func CompressedSize(v uint64) predicate.FileData {
+	return predicate.FileData(sql.FieldEQ(FieldCompressedSize, v))
+}
+
+// StoredSize applies equality check predicate on the "stored_size" field. It's identical to StoredSizeEQ.
+func StoredSize(v int64) predicate.FileData {
+	return predicate.FileData(sql.FieldEQ(FieldStoredSize, v))
+}
+
+// MimeType applies equality check predicate on the "mime_type" field. It's identical to MimeTypeEQ.
+func MimeType(v string) predicate.FileData {
+	return predicate.FileData(sql.FieldEQ(FieldMimeType, v))
+}
+
+// PreviewStatus applies equality check predicate on the "preview_status" field. It's identical to PreviewStatusEQ.
+func PreviewStatus(v string) predicate.FileData {
+	return predicate.FileData(sql.FieldEQ(FieldPreviewStatus, v))
+}
+
+// PreviewType applies equality check predicate on the "preview_type" field. It's identical to PreviewTypeEQ.
+func PreviewType(v string) predicate.FileData {
+	return predicate.FileData(sql.FieldEQ(FieldPreviewType, v))
+}
+
 // SizeEQ applies the EQ predicate on the "size" field.
 func SizeEQ(v uint64) predicate.FileData {
 	return predicate.FileData(sql.FieldEQ(FieldSize, v))
@@ -108,6 +153,586 @@ func SizeLTE(v uint64) predicate.FileData {
 	return predicate.FileData(sql.FieldLTE(FieldSize, v))
 }
 
+// Sha256EQ applies the EQ predicate on the "sha256" field.
+func Sha256EQ(v string) predicate.FileData {
+	return predicate.FileData(sql.FieldEQ(FieldSha256, v))
+}
+
+// Sha256NEQ applies the NEQ predicate on the "sha256" field.
+func Sha256NEQ(v string) predicate.FileData {
+	return predicate.FileData(sql.FieldNEQ(FieldSha256, v))
+}
+
+// Sha256In applies the In predicate on the "sha256" field.
+func Sha256In(vs ...string) predicate.FileData {
+	return predicate.FileData(sql.FieldIn(FieldSha256, vs...))
+}
+
+// Sha256NotIn applies the NotIn predicate on the "sha256" field.
+func Sha256NotIn(vs ...string) predicate.FileData {
+	return predicate.FileData(sql.FieldNotIn(FieldSha256, vs...))
+}
+
+// Sha256GT applies the GT predicate on the "sha256" field.
+func Sha256GT(v string) predicate.FileData {
+	return predicate.FileData(sql.FieldGT(FieldSha256, v))
+}
+
+// Sha256GTE applies the GTE predicate on the "sha256" field.
+func Sha256GTE(v string) predicate.FileData {
+	return predicate.FileData(sql.FieldGTE(FieldSha256, v))
+}
+
+// Sha256LT applies the LT predicate on the "sha256" field.
+func Sha256LT(v string) predicate.FileData {
+	return predicate.FileData(sql.FieldLT(FieldSha256, v))
+}
+
+// Sha256LTE applies the LTE predicate on the "sha256" field.
+func Sha256LTE(v string) predicate.FileData {
+	return predicate.FileData(sql.FieldLTE(FieldSha256, v))
+}
+
+// Sha256Contains applies the Contains predicate on the "sha256" field.
+func Sha256Contains(v string) predicate.FileData {
+	return predicate.FileData(sql.FieldContains(FieldSha256, v))
+}
+
+// Sha256HasPrefix applies the HasPrefix predicate on the "sha256" field.
+func Sha256HasPrefix(v string) predicate.FileData {
+	return predicate.FileData(sql.FieldHasPrefix(FieldSha256, v))
+}
+
+// Sha256HasSuffix applies the HasSuffix predicate on the "sha256" field.
+func Sha256HasSuffix(v string) predicate.FileData {
+	return predicate.FileData(sql.FieldHasSuffix(FieldSha256, v))
+}
+
+// Sha256IsNil applies the IsNil predicate on the "sha256" field.
+func Sha256IsNil() predicate.FileData {
+	return predicate.FileData(sql.FieldIsNull(FieldSha256))
+}
+
+// Sha256NotNil applies the NotNil predicate on the "sha256" field.
+func Sha256NotNil() predicate.FileData {
+	return predicate.FileData(sql.FieldNotNull(FieldSha256))
+}
+
+// Sha256EqualFold applies the EqualFold predicate on the "sha256" field.
+func Sha256EqualFold(v string) predicate.FileData {
+	return predicate.FileData(sql.FieldEqualFold(FieldSha256, v))
+}
+
+// Sha256ContainsFold applies the ContainsFold predicate on the "sha256" field.
+func Sha256ContainsFold(v string) predicate.FileData {
+	return predicate.FileData(sql.FieldContainsFold(FieldSha256, v))
+}
+
+// KeyIDEQ applies the EQ predicate on the "key_id" field.
+func KeyIDEQ(v string) predicate.FileData {
+	return predicate.FileData(sql.FieldEQ(FieldKeyID, v))
+}
+
+// KeyIDNEQ applies the NEQ predicate on the "key_id" field.
+func KeyIDNEQ(v string) predicate.FileData {
+	return predicate.FileData(sql.FieldNEQ(FieldKeyID, v))
+}
+
+// KeyIDIn applies the In predicate on the "key_id" field.
+func KeyIDIn(vs ...string) predicate.FileData {
+	return predicate.FileData(sql.FieldIn(FieldKeyID, vs...))
+}
+
+// KeyIDNotIn applies the NotIn predicate on the "key_id" field.
+func KeyIDNotIn(vs ...string) predicate.FileData {
+	return predicate.FileData(sql.FieldNotIn(FieldKeyID, vs...))
+}
+
+// KeyIDGT applies the GT predicate on the "key_id" field.
+func KeyIDGT(v string) predicate.FileData {
+	return predicate.FileData(sql.FieldGT(FieldKeyID, v))
+}
+
+// KeyIDGTE applies the GTE predicate on the "key_id" field.
+func KeyIDGTE(v string) predicate.FileData {
+	return predicate.FileData(sql.FieldGTE(FieldKeyID, v))
+}
+
+// KeyIDLT applies the LT predicate on the "key_id" field.
+func KeyIDLT(v string) predicate.FileData {
+	return predicate.FileData(sql.FieldLT(FieldKeyID, v))
+}
+
+// KeyIDLTE applies the LTE predicate on the "key_id" field.
+func KeyIDLTE(v string) predicate.FileData {
+	return predicate.FileData(sql.FieldLTE(FieldKeyID, v))
+}
+
+// KeyIDContains applies the Contains predicate on the "key_id" field.
+func KeyIDContains(v string) predicate.FileData {
+	return predicate.FileData(sql.FieldContains(FieldKeyID, v))
+}
+
+// KeyIDHasPrefix applies the HasPrefix predicate on the "key_id" field.
+func KeyIDHasPrefix(v string) predicate.FileData {
+	return predicate.FileData(sql.FieldHasPrefix(FieldKeyID, v))
+}
+
+// KeyIDHasSuffix applies the HasSuffix predicate on the "key_id" field.
+func KeyIDHasSuffix(v string) predicate.FileData {
+	return predicate.FileData(sql.FieldHasSuffix(FieldKeyID, v))
+}
+
+// KeyIDIsNil applies the IsNil predicate on the "key_id" field.
+func KeyIDIsNil() predicate.FileData {
+	return predicate.FileData(sql.FieldIsNull(FieldKeyID))
+}
+
+// KeyIDNotNil applies the NotNil predicate on the "key_id" field.
+func KeyIDNotNil() predicate.FileData {
+	return predicate.FileData(sql.FieldNotNull(FieldKeyID))
+}
+
+// KeyIDEqualFold applies the EqualFold predicate on the "key_id" field.
+func KeyIDEqualFold(v string) predicate.FileData {
+	return predicate.FileData(sql.FieldEqualFold(FieldKeyID, v))
+}
+
+// KeyIDContainsFold applies the ContainsFold predicate on the "key_id" field.
+func KeyIDContainsFold(v string) predicate.FileData {
+	return predicate.FileData(sql.FieldContainsFold(FieldKeyID, v))
+}
+
+// EncryptedKeyEQ applies the EQ predicate on the "encrypted_key" field.
+func EncryptedKeyEQ(v []byte) predicate.FileData {
+	return predicate.FileData(sql.FieldEQ(FieldEncryptedKey, v))
+}
+
+// EncryptedKeyNEQ applies the NEQ predicate on the "encrypted_key" field.
+func EncryptedKeyNEQ(v []byte) predicate.FileData {
+	return predicate.FileData(sql.FieldNEQ(FieldEncryptedKey, v))
+}
+
+// EncryptedKeyIn applies the In predicate on the "encrypted_key" field.
+func EncryptedKeyIn(vs ...[]byte) predicate.FileData {
+	return predicate.FileData(sql.FieldIn(FieldEncryptedKey, vs...))
+}
+
+// EncryptedKeyNotIn applies the NotIn predicate on the "encrypted_key" field.
+func EncryptedKeyNotIn(vs ...[]byte) predicate.FileData {
+	return predicate.FileData(sql.FieldNotIn(FieldEncryptedKey, vs...))
+}
+
+// EncryptedKeyGT applies the GT predicate on the "encrypted_key" field.
+func EncryptedKeyGT(v []byte) predicate.FileData {
+	return predicate.FileData(sql.FieldGT(FieldEncryptedKey, v))
+}
+
+// EncryptedKeyGTE applies the GTE predicate on the "encrypted_key" field.
+func EncryptedKeyGTE(v []byte) predicate.FileData {
+	return predicate.FileData(sql.FieldGTE(FieldEncryptedKey, v))
+}
+
+// EncryptedKeyLT applies the LT predicate on the "encrypted_key" field.
+func EncryptedKeyLT(v []byte) predicate.FileData {
+	return predicate.FileData(sql.FieldLT(FieldEncryptedKey, v))
+}
+
+// EncryptedKeyLTE applies the LTE predicate on the "encrypted_key" field.
+func EncryptedKeyLTE(v []byte) predicate.FileData {
+	return predicate.FileData(sql.FieldLTE(FieldEncryptedKey, v))
+}
+
+// EncryptedKeyIsNil applies the IsNil predicate on the "encrypted_key" field.
+func EncryptedKeyIsNil() predicate.FileData {
+	return predicate.FileData(sql.FieldIsNull(FieldEncryptedKey))
+}
+
+// EncryptedKeyNotNil applies the NotNil predicate on the "encrypted_key" field.
+func EncryptedKeyNotNil() predicate.FileData {
+	return predicate.FileData(sql.FieldNotNull(FieldEncryptedKey))
+}
+
+// CompressionEQ applies the EQ predicate on the "compression" field.
+func CompressionEQ(v string) predicate.FileData {
+	return predicate.FileData(sql.FieldEQ(FieldCompression, v))
+}
+
+// CompressionNEQ applies the NEQ predicate on the "compression" field.
+func CompressionNEQ(v string) predicate.FileData {
+	return predicate.FileData(sql.FieldNEQ(FieldCompression, v))
+}
+
+// CompressionIn applies the In predicate on the "compression" field.
+func CompressionIn(vs ...string) predicate.FileData {
+	return predicate.FileData(sql.FieldIn(FieldCompression, vs...))
+}
+
+// CompressionNotIn applies the NotIn predicate on the "compression" field.
+func CompressionNotIn(vs ...string) predicate.FileData {
+	return predicate.FileData(sql.FieldNotIn(FieldCompression, vs...))
+}
+
+// CompressionGT applies the GT predicate on the "compression" field.
+func CompressionGT(v string) predicate.FileData {
+	return predicate.FileData(sql.FieldGT(FieldCompression, v))
+}
+
+// CompressionGTE applies the GTE predicate on the "compression" field.
+func CompressionGTE(v string) predicate.FileData {
+	return predicate.FileData(sql.FieldGTE(FieldCompression, v))
+}
+
+// CompressionLT applies the LT predicate on the "compression" field.
+func CompressionLT(v string) predicate.FileData {
+	return predicate.FileData(sql.FieldLT(FieldCompression, v))
+}
+
+// CompressionLTE applies the LTE predicate on the "compression" field.
+func CompressionLTE(v string) predicate.FileData {
+	return predicate.FileData(sql.FieldLTE(FieldCompression, v))
+}
+
+// CompressionContains applies the Contains predicate on the "compression" field.
+func CompressionContains(v string) predicate.FileData {
+	return predicate.FileData(sql.FieldContains(FieldCompression, v))
+}
+
+// CompressionHasPrefix applies the HasPrefix predicate on the "compression" field.
+func CompressionHasPrefix(v string) predicate.FileData {
+	return predicate.FileData(sql.FieldHasPrefix(FieldCompression, v))
+}
+
+// CompressionHasSuffix applies the HasSuffix predicate on the "compression" field.
+func CompressionHasSuffix(v string) predicate.FileData {
+	return predicate.FileData(sql.FieldHasSuffix(FieldCompression, v))
+}
+
+// CompressionIsNil applies the IsNil predicate on the "compression" field.
+func CompressionIsNil() predicate.FileData {
+	return predicate.FileData(sql.FieldIsNull(FieldCompression))
+}
+
+// CompressionNotNil applies the NotNil predicate on the "compression" field.
+func CompressionNotNil() predicate.FileData {
+	return predicate.FileData(sql.FieldNotNull(FieldCompression))
+}
+
+// CompressionEqualFold applies the EqualFold predicate on the "compression" field.
+func CompressionEqualFold(v string) predicate.FileData {
+	return predicate.FileData(sql.FieldEqualFold(FieldCompression, v))
+}
+
+// CompressionContainsFold applies the ContainsFold predicate on the "compression" field.
+func CompressionContainsFold(v string) predicate.FileData {
+	return predicate.FileData(sql.FieldContainsFold(FieldCompression, v))
+}
+
+// CompressedSizeEQ applies the EQ predicate on the "compressed_size" field.
+func CompressedSizeEQ(v uint64) predicate.FileData {
+	return predicate.FileData(sql.FieldEQ(FieldCompressedSize, v))
+}
+
+// CompressedSizeNEQ applies the NEQ predicate on the "compressed_size" field.
+func CompressedSizeNEQ(v uint64) predicate.FileData {
+	return predicate.FileData(sql.FieldNEQ(FieldCompressedSize, v))
+}
+
+// CompressedSizeIn applies the In predicate on the "compressed_size" field.
+func CompressedSizeIn(vs ...uint64) predicate.FileData {
+	return predicate.FileData(sql.FieldIn(FieldCompressedSize, vs...))
+}
+
+// CompressedSizeNotIn applies the NotIn predicate on the "compressed_size" field.
+func CompressedSizeNotIn(vs ...uint64) predicate.FileData {
+	return predicate.FileData(sql.FieldNotIn(FieldCompressedSize, vs...))
+}
+
+// CompressedSizeGT applies the GT predicate on the "compressed_size" field.
+func CompressedSizeGT(v uint64) predicate.FileData {
+	return predicate.FileData(sql.FieldGT(FieldCompressedSize, v))
+}
+
+// CompressedSizeGTE applies the GTE predicate on the "compressed_size" field.
+func CompressedSizeGTE(v uint64) predicate.FileData {
+	return predicate.FileData(sql.FieldGTE(FieldCompressedSize, v))
+}
+
+// CompressedSizeLT applies the LT predicate on the "compressed_size" field.
+func CompressedSizeLT(v uint64) predicate.FileData {
+	return predicate.FileData(sql.FieldLT(FieldCompressedSize, v))
+}
+
+// CompressedSizeLTE applies the LTE predicate on the "compressed_size" field.
+func CompressedSizeLTE(v uint64) predicate.FileData {
+	return predicate.FileData(sql.FieldLTE(FieldCompressedSize, v))
+}
+
+// CompressedSizeIsNil applies the IsNil predicate on the "compressed_size" field.
+func CompressedSizeIsNil() predicate.FileData {
+	return predicate.FileData(sql.FieldIsNull(FieldCompressedSize))
+}
+
+// CompressedSizeNotNil applies the NotNil predicate on the "compressed_size" field.
+func CompressedSizeNotNil() predicate.FileData {
+	return predicate.FileData(sql.FieldNotNull(FieldCompressedSize))
+}
+
+// StoredSizeEQ applies the EQ predicate on the "stored_size" field.
+func StoredSizeEQ(v int64) predicate.FileData {
+	return predicate.FileData(sql.FieldEQ(FieldStoredSize, v))
+}
+
+// StoredSizeNEQ applies the NEQ predicate on the "stored_size" field.
+func StoredSizeNEQ(v int64) predicate.FileData {
+	return predicate.FileData(sql.FieldNEQ(FieldStoredSize, v))
+}
+
+// StoredSizeIn applies the In predicate on the "stored_size" field.
+func StoredSizeIn(vs ...int64) predicate.FileData {
+	return predicate.FileData(sql.FieldIn(FieldStoredSize, vs...))
+}
+
+// StoredSizeNotIn applies the NotIn predicate on the "stored_size" field.
+func StoredSizeNotIn(vs ...int64) predicate.FileData {
+	return predicate.FileData(sql.FieldNotIn(FieldStoredSize, vs...))
+}
+
+// StoredSizeGT applies the GT predicate on the "stored_size" field.
+func StoredSizeGT(v int64) predicate.FileData {
+	return predicate.FileData(sql.FieldGT(FieldStoredSize, v))
+}
+
+// StoredSizeGTE applies the GTE predicate on the "stored_size" field.
+func StoredSizeGTE(v int64) predicate.FileData {
+	return predicate.FileData(sql.FieldGTE(FieldStoredSize, v))
+}
+
+// StoredSizeLT applies the LT predicate on the "stored_size" field.
+func StoredSizeLT(v int64) predicate.FileData {
+	return predicate.FileData(sql.FieldLT(FieldStoredSize, v))
+}
+
+// StoredSizeLTE applies the LTE predicate on the "stored_size" field.
+func StoredSizeLTE(v int64) predicate.FileData {
+	return predicate.FileData(sql.FieldLTE(FieldStoredSize, v))
+}
+
+// MimeTypeEQ applies the EQ predicate on the "mime_type" field.
+func MimeTypeEQ(v string) predicate.FileData {
+	return predicate.FileData(sql.FieldEQ(FieldMimeType, v))
+}
+
+// MimeTypeNEQ applies the NEQ predicate on the "mime_type" field.
+func MimeTypeNEQ(v string) predicate.FileData {
+	return predicate.FileData(sql.FieldNEQ(FieldMimeType, v))
+}
+
+// MimeTypeIn applies the In predicate on the "mime_type" field.
+func MimeTypeIn(vs ...string) predicate.FileData {
+	return predicate.FileData(sql.FieldIn(FieldMimeType, vs...))
+}
+
+// MimeTypeNotIn applies the NotIn predicate on the "mime_type" field.
+func MimeTypeNotIn(vs ...string) predicate.FileData {
+	return predicate.FileData(sql.FieldNotIn(FieldMimeType, vs...))
+}
+
+// MimeTypeGT applies the GT predicate on the "mime_type" field.
+func MimeTypeGT(v string) predicate.FileData {
+	return predicate.FileData(sql.FieldGT(FieldMimeType, v))
+}
+
+// MimeTypeGTE applies the GTE predicate on the "mime_type" field.
+func MimeTypeGTE(v string) predicate.FileData {
+	return predicate.FileData(sql.FieldGTE(FieldMimeType, v))
+}
+
+// MimeTypeLT applies the LT predicate on the "mime_type" field.
+func MimeTypeLT(v string) predicate.FileData {
+	return predicate.FileData(sql.FieldLT(FieldMimeType, v))
+}
+
+// MimeTypeLTE applies the LTE predicate on the "mime_type" field.
+func MimeTypeLTE(v string) predicate.FileData {
+	return predicate.FileData(sql.FieldLTE(FieldMimeType, v))
+}
+
+// MimeTypeContains applies the Contains predicate on the "mime_type" field.
+func MimeTypeContains(v string) predicate.FileData {
+	return predicate.FileData(sql.FieldContains(FieldMimeType, v))
+}
+
+// MimeTypeHasPrefix applies the HasPrefix predicate on the "mime_type" field.
+func MimeTypeHasPrefix(v string) predicate.FileData {
+	return predicate.FileData(sql.FieldHasPrefix(FieldMimeType, v))
+}
+
+// MimeTypeHasSuffix applies the HasSuffix predicate on the "mime_type" field.
+func MimeTypeHasSuffix(v string) predicate.FileData {
+	return predicate.FileData(sql.FieldHasSuffix(FieldMimeType, v))
+}
+
+// MimeTypeIsNil applies the IsNil predicate on the "mime_type" field.
+func MimeTypeIsNil() predicate.FileData {
+	return predicate.FileData(sql.FieldIsNull(FieldMimeType))
+}
+
+// MimeTypeNotNil applies the NotNil predicate on the "mime_type" field.
+func MimeTypeNotNil() predicate.FileData {
+	return predicate.FileData(sql.FieldNotNull(FieldMimeType))
+}
+
+// MimeTypeEqualFold applies the EqualFold predicate on the "mime_type" field.
+func MimeTypeEqualFold(v string) predicate.FileData {
+	return predicate.FileData(sql.FieldEqualFold(FieldMimeType, v))
+}
+
+// MimeTypeContainsFold applies the ContainsFold predicate on the "mime_type" field.
+func MimeTypeContainsFold(v string) predicate.FileData {
+	return predicate.FileData(sql.FieldContainsFold(FieldMimeType, v))
+}
+
+// PreviewStatusEQ applies the EQ predicate on the "preview_status" field.
+func PreviewStatusEQ(v string) predicate.FileData {
+	return predicate.FileData(sql.FieldEQ(FieldPreviewStatus, v))
+}
+
+// PreviewStatusNEQ applies the NEQ predicate on the "preview_status" field.
+func PreviewStatusNEQ(v string) predicate.FileData {
+	return predicate.FileData(sql.FieldNEQ(FieldPreviewStatus, v))
+}
+
+// PreviewStatusIn applies the In predicate on the "preview_status" field.
+func PreviewStatusIn(vs ...string) predicate.FileData {
+	return predicate.FileData(sql.FieldIn(FieldPreviewStatus, vs...))
+}
+
+// PreviewStatusNotIn applies the NotIn predicate on the "preview_status" field.
+func PreviewStatusNotIn(vs ...string) predicate.FileData {
+	return predicate.FileData(sql.FieldNotIn(FieldPreviewStatus, vs...))
+}
+
+// PreviewStatusGT applies the GT predicate on the "preview_status" field.
+func PreviewStatusGT(v string) predicate.FileData {
+	return predicate.FileData(sql.FieldGT(FieldPreviewStatus, v))
+}
+
+// PreviewStatusGTE applies the GTE predicate on the "preview_status" field.
+func PreviewStatusGTE(v string) predicate.FileData {
+	return predicate.FileData(sql.FieldGTE(FieldPreviewStatus, v))
+}
+
+// PreviewStatusLT applies the LT predicate on the "preview_status" field.
+func PreviewStatusLT(v string) predicate.FileData {
+	return predicate.FileData(sql.FieldLT(FieldPreviewStatus, v))
+}
+
+// PreviewStatusLTE applies the LTE predicate on the "preview_status" field.
+func PreviewStatusLTE(v string) predicate.FileData {
+	return predicate.FileData(sql.FieldLTE(FieldPreviewStatus, v))
+}
+
+// PreviewStatusContains applies the Contains predicate on the "preview_status" field.
+func PreviewStatusContains(v string) predicate.FileData {
+	return predicate.FileData(sql.FieldContains(FieldPreviewStatus, v))
+}
+
+// PreviewStatusHasPrefix applies the HasPrefix predicate on the "preview_status" field.
+func PreviewStatusHasPrefix(v string) predicate.FileData {
+	return predicate.FileData(sql.FieldHasPrefix(FieldPreviewStatus, v))
+}
+
+// PreviewStatusHasSuffix applies the HasSuffix predicate on the "preview_status" field.
+func PreviewStatusHasSuffix(v string) predicate.FileData {
+	return predicate.FileData(sql.FieldHasSuffix(FieldPreviewStatus, v))
+}
+
+// PreviewStatusEqualFold applies the EqualFold predicate on the "preview_status" field.
+func PreviewStatusEqualFold(v string) predicate.FileData {
+	return predicate.FileData(sql.FieldEqualFold(FieldPreviewStatus, v))
+}
+
+// PreviewStatusContainsFold applies the ContainsFold predicate on the "preview_status" field.
+func PreviewStatusContainsFold(v string) predicate.FileData {
+	return predicate.FileData(sql.FieldContainsFold(FieldPreviewStatus, v))
+}
+
+// PreviewTypeEQ applies the EQ predicate on the "preview_type" field.
+func PreviewTypeEQ(v string) predicate.FileData {
+	return predicate.FileData(sql.FieldEQ(FieldPreviewType, v))
+}
+
+// PreviewTypeNEQ applies the NEQ predicate on the "preview_type" field.
+func PreviewTypeNEQ(v string) predicate.FileData {
+	return predicate.FileData(sql.FieldNEQ(FieldPreviewType, v))
+}
+
+// PreviewTypeIn applies the In predicate on the "preview_type" field.
+func PreviewTypeIn(vs ...string) predicate.FileData {
+	return predicate.FileData(sql.FieldIn(FieldPreviewType, vs...))
+}
+
+// PreviewTypeNotIn applies the NotIn predicate on the "preview_type" field.
+func PreviewTypeNotIn(vs ...string) predicate.FileData {
+	return predicate.FileData(sql.FieldNotIn(FieldPreviewType, vs...))
+}
+
+// PreviewTypeGT applies the GT predicate on the "preview_type" field.
+func PreviewTypeGT(v string) predicate.FileData {
+	return predicate.FileData(sql.FieldGT(FieldPreviewType, v))
+}
+
+// PreviewTypeGTE applies the GTE predicate on the "preview_type" field.
+func PreviewTypeGTE(v string) predicate.FileData {
+	return predicate.FileData(sql.FieldGTE(FieldPreviewType, v))
+}
+
+// PreviewTypeLT applies the LT predicate on the "preview_type" field.
+func PreviewTypeLT(v string) predicate.FileData {
+	return predicate.FileData(sql.FieldLT(FieldPreviewType, v))
+}
+
+// PreviewTypeLTE applies the LTE predicate on the "preview_type" field.
+func PreviewTypeLTE(v string) predicate.FileData {
+	return predicate.FileData(sql.FieldLTE(FieldPreviewType, v))
+}
+
+// PreviewTypeContains applies the Contains predicate on the "preview_type" field.
+func PreviewTypeContains(v string) predicate.FileData {
+	return predicate.FileData(sql.FieldContains(FieldPreviewType, v))
+}
+
+// PreviewTypeHasPrefix applies the HasPrefix predicate on the "preview_type" field.
+func PreviewTypeHasPrefix(v string) predicate.FileData {
+	return predicate.FileData(sql.FieldHasPrefix(FieldPreviewType, v))
+}
+
+// PreviewTypeHasSuffix applies the HasSuffix predicate on the "preview_type" field.
+func PreviewTypeHasSuffix(v string) predicate.FileData {
+	return predicate.FileData(sql.FieldHasSuffix(FieldPreviewType, v))
+}
+
+// PreviewTypeIsNil applies the IsNil predicate on the "preview_type" field.
+func PreviewTypeIsNil() predicate.FileData {
+	return predicate.FileData(sql.FieldIsNull(FieldPreviewType))
+}
+
+// PreviewTypeNotNil applies the NotNil predicate on the "preview_type" field.
+func PreviewTypeNotNil() predicate.FileData {
+	return predicate.FileData(sql.FieldNotNull(FieldPreviewType))
+}
+
+// PreviewTypeEqualFold applies the EqualFold predicate on the "preview_type" field.
+func PreviewTypeEqualFold(v string) predicate.FileData {
+	return predicate.FileData(sql.FieldEqualFold(FieldPreviewType, v))
+}
+
+// PreviewTypeContainsFold applies the ContainsFold predicate on the "preview_type" field.
+func PreviewTypeContainsFold(v string) predicate.FileData {
+	return predicate.FileData(sql.FieldContainsFold(FieldPreviewType, v))
+}
+
 // HasFiles applies the HasEdge predicate on the "files" edge.
 func HasFiles() predicate.FileData {
 	return predicate.FileData(func(s *sql.Selector) {
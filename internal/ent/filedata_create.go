@@ -27,6 +27,124 @@ func (_c *FileDataCreate) SetSize(v uint64) *FileDataCreate {
 	return _c
 }
 
+// SetSha256 sets the "sha256" field.
+func (_c *FileDataCreate) SetSha256(v string) *FileDataCreate {
+	_c.mutation.SetSha256(v)
+	return _c
+}
+
+// SetNillableSha256 sets the "sha256" field if the given value is not nil.
+func (_c *FileDataCreate) SetNillableSha256(v *string) *FileDataCreate {
+	if v != nil {
+		_c.SetSha256(*v)
+	}
+	return _c
+}
+
+// SetKeyID sets the "key_id" field.
+func (_c *FileDataCreate) SetKeyID(v string) *FileDataCreate {
+	_c.mutation.SetKeyID(v)
+	return _c
+}
+
+// SetNillableKeyID sets the "key_id" field if the given value is not nil.
+func (_c *FileDataCreate) SetNillableKeyID(v *string) *FileDataCreate {
+	if v != nil {
+		_c.SetKeyID(*v)
+	}
+	return _c
+}
+
+// SetEncryptedKey sets the "encrypted_key" field.
+func (_c *FileDataCreate) SetEncryptedKey(v []byte) *FileDataCreate {
+	_c.mutation.SetEncryptedKey(v)
+	return _c
+}
+
+// SetCompression sets the "compression" field.
+func (_c *FileDataCreate) SetCompression(v string) *FileDataCreate {
+	_c.mutation.SetCompression(v)
+	return _c
+}
+
+// SetNillableCompression sets the "compression" field if the given value is not nil.
+func (_c *FileDataCreate) SetNillableCompression(v *string) *FileDataCreate {
+	if v != nil {
+		_c.SetCompression(*v)
+	}
+	return _c
+}
+
+// SetCompressedSize sets the "compressed_size" field.
+func (_c *FileDataCreate) SetCompressedSize(v uint64) *FileDataCreate {
+	_c.mutation.SetCompressedSize(v)
+	return _c
+}
+
+// SetNillableCompressedSize sets the "compressed_size" field if the given value is not nil.
+func (_c *FileDataCreate) SetNillableCompressedSize(v *uint64) *FileDataCreate {
+	if v != nil {
+		_c.SetCompressedSize(*v)
+	}
+	return _c
+}
+
+// SetStoredSize sets the "stored_size" field.
+func (_c *FileDataCreate) SetStoredSize(v int64) *FileDataCreate {
+	_c.mutation.SetStoredSize(v)
+	return _c
+}
+
+// SetNillableStoredSize sets the "stored_size" field if the given value is not nil.
+func (_c *FileDataCreate) SetNillableStoredSize(v *int64) *FileDataCreate {
+	if v != nil {
+		_c.SetStoredSize(*v)
+	}
+	return _c
+}
+
+// SetMimeType sets the "mime_type" field.
+func (_c *FileDataCreate) SetMimeType(v string) *FileDataCreate {
+	_c.mutation.SetMimeType(v)
+	return _c
+}
+
+// SetNillableMimeType sets the "mime_type" field if the given value is not nil.
+func (_c *FileDataCreate) SetNillableMimeType(v *string) *FileDataCreate {
+	if v != nil {
+		_c.SetMimeType(*v)
+	}
+	return _c
+}
+
+// SetPreviewStatus sets the "preview_status" field.
+func (_c *FileDataCreate) SetPreviewStatus(v string) *FileDataCreate {
+	_c.mutation.SetPreviewStatus(v)
+	return _c
+}
+
+// SetNillablePreviewStatus sets the "preview_status" field if the given value is not nil.
+func (_c *FileDataCreate) SetNillablePreviewStatus(v *string) *FileDataCreate {
+	if v != nil {
+		_c.SetPreviewStatus(*v)
+	}
+	return _c
+}
+
+// SetPreviewType sets the "preview_type" field.
+func (_c *FileDataCreate) SetPreviewType(v string) *FileDataCreate {
+	_c.mutation.SetPreviewType(v)
+	return _c
+}
+
+// SetNillablePreviewType sets the "preview_type" field if the given value is not nil.
+func (_c *FileDataCreate) SetNillablePreviewType(v *string) *FileDataCreate {
+	if v != nil {
+		_c.SetPreviewType(*v)
+	}
+	return _c
+}
+
 // SetID sets the "id" field.
 func (_c *FileDataCreate) SetID(v string) *FileDataCreate {
 	_c.mutation.SetID(v)
@@ -55,6 +173,7 @@ func (_c *FileDataCreate) Mutation() *FileDataMutation {
 
 // Save creates the FileData in the database.
 func (_c *FileDataCreate) Save(ctx context.Context) (*FileData, error) {
+	_c.defaults()
 	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
 }
 
@@ -80,11 +199,29 @@ func (_c *FileDataCreate) ExecX(ctx context.Context) {
 	}
 }
 
+// defaults sets the default values of the builder before save.
+func (_c *FileDataCreate) defaults() {
+	if _, ok := _c.mutation.StoredSize(); !ok {
+		v := filedata.DefaultStoredSize
+		_c.mutation.SetStoredSize(v)
+	}
+	if _, ok := _c.mutation.PreviewStatus(); !ok {
+		v := filedata.DefaultPreviewStatus
+		_c.mutation.SetPreviewStatus(v)
+	}
+}
+
 // check runs all checks and user-defined validators on the builder.
 func (_c *FileDataCreate) check() error {
 	if _, ok := _c.mutation.Size(); !ok {
 		return &ValidationError{Name: "size", err: errors.New(`ent: missing required field "FileData.size"`)}
 	}
+	if _, ok := _c.mutation.StoredSize(); !ok {
+		return &ValidationError{Name: "stored_size", err: errors.New(`ent: missing required field "FileData.stored_size"`)}
+	}
+	if _, ok := _c.mutation.PreviewStatus(); !ok {
+		return &ValidationError{Name: "preview_status", err: errors.New(`ent: missing required field "FileData.preview_status"`)}
+	}
 	return nil
 }
 
@@ -124,6 +261,42 @@ func (_c *FileDataCreate) createSpec() (*FileData, *sqlgraph.CreateSpec) {
 		_spec.SetField(filedata.FieldSize, field.TypeUint64, value)
 		_node.Size = value
 	}
+	if value, ok := _c.mutation.Sha256(); ok {
+		_spec.SetField(filedata.FieldSha256, field.TypeString, value)
+		_node.Sha256 = &value
+	}
+	if value, ok := _c.mutation.KeyID(); ok {
+		_spec.SetField(filedata.FieldKeyID, field.TypeString, value)
+		_node.KeyID = &value
+	}
+	if value, ok := _c.mutation.EncryptedKey(); ok {
+		_spec.SetField(filedata.FieldEncryptedKey, field.TypeBytes, value)
+		_node.EncryptedKey = value
+	}
+	if value, ok := _c.mutation.Compression(); ok {
+		_spec.SetField(filedata.FieldCompression, field.TypeString, value)
+		_node.Compression = &value
+	}
+	if value, ok := _c.mutation.CompressedSize(); ok {
+		_spec.SetField(filedata.FieldCompressedSize, field.TypeUint64, value)
+		_node.CompressedSize = &value
+	}
+	if value, ok := _c.mutation.StoredSize(); ok {
+		_spec.SetField(filedata.FieldStoredSize, field.TypeInt64, value)
+		_node.StoredSize = value
+	}
+	if value, ok := _c.mutation.MimeType(); ok {
+		_spec.SetField(filedata.FieldMimeType, field.TypeString, value)
+		_node.MimeType = &value
+	}
+	if value, ok := _c.mutation.PreviewStatus(); ok {
+		_spec.SetField(filedata.FieldPreviewStatus, field.TypeString, value)
+		_node.PreviewStatus = value
+	}
+	if value, ok := _c.mutation.PreviewType(); ok {
+		_spec.SetField(filedata.FieldPreviewType, field.TypeString, value)
+		_node.PreviewType = &value
+	}
 	if nodes := _c.mutation.FilesIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -161,6 +334,7 @@ func (_c *FileDataCreateBulk) Save(ctx context.Context) ([]*FileData, error) {
 	for i := range _c.builders {
 		func(i int, root context.Context) {
 			builder := _c.builders[i]
+			builder.defaults()
 			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
 				mutation, ok := m.(*FileDataMutation)
 				if !ok {
@@ -50,6 +50,180 @@ func (_u *FileDataUpdate) AddSize(v int64) *FileDataUpdate {
 	return _u
 }
 
+// SetSha256 sets the "sha256" field.
+func (_u *FileDataUpdate) SetSha256(v string) *FileDataUpdate {
+	_u.mutation.SetSha256(v)
+	return _u
+}
+
+// SetNillableSha256 sets the "sha256" field if the given value is not nil.
+func (_u *FileDataUpdate) SetNillableSha256(v *string) *FileDataUpdate {
+	if v != nil {
+		_u.SetSha256(*v)
+	}
+	return _u
+}
+
+// ClearSha256 clears the value of the "sha256" field.
+func (_u *FileDataUpdate) ClearSha256() *FileDataUpdate {
+	_u.mutation.ClearSha256()
+	return _u
+}
+
+// SetKeyID sets the "key_id" field.
+func (_u *FileDataUpdate) SetKeyID(v string) *FileDataUpdate {
+	_u.mutation.SetKeyID(v)
+	return _u
+}
+
+// SetNillableKeyID sets the "key_id" field if the given value is not nil.
+func (_u *FileDataUpdate) SetNillableKeyID(v *string) *FileDataUpdate {
+	if v != nil {
+		_u.SetKeyID(*v)
+	}
+	return _u
+}
+
+// ClearKeyID clears the value of the "key_id" field.
+func (_u *FileDataUpdate) ClearKeyID() *FileDataUpdate {
+	_u.mutation.ClearKeyID()
+	return _u
+}
+
+// SetEncryptedKey sets the "encrypted_key" field.
+func (_u *FileDataUpdate) SetEncryptedKey(v []byte) *FileDataUpdate {
+	_u.mutation.SetEncryptedKey(v)
+	return _u
+}
+
+// ClearEncryptedKey clears the value of the "encrypted_key" field.
+func (_u *FileDataUpdate) ClearEncryptedKey() *FileDataUpdate {
+	_u.mutation.ClearEncryptedKey()
+	return _u
+}
+
+// SetCompression sets the "compression" field.
+func (_u *FileDataUpdate) SetCompression(v string) *FileDataUpdate {
+	_u.mutation.SetCompression(v)
+	return _u
+}
+
+// SetNillableCompression sets the "compression" field if the given value is not nil.
+func (_u *FileDataUpdate) SetNillableCompression(v *string) *FileDataUpdate {
+	if v != nil {
+		_u.SetCompression(*v)
+	}
+	return _u
+}
+
+// ClearCompression clears the value of the "compression" field.
+func (_u *FileDataUpdate) ClearCompression() *FileDataUpdate {
+	_u.mutation.ClearCompression()
+	return _u
+}
+
+// SetCompressedSize sets the "compressed_size" field.
+func (_u *FileDataUpdate) SetCompressedSize(v uint64) *FileDataUpdate {
+	_u.mutation.ResetCompressedSize()
+	_u.mutation.SetCompressedSize(v)
+	return _u
+}
+
+// SetNillableCompressedSize sets the "compressed_size" field if the given value is not nil.
+func (_u *FileDataUpdate) SetNillableCompressedSize(v *uint64) *FileDataUpdate {
+	if v != nil {
+		_u.SetCompressedSize(*v)
+	}
+	return _u
+}
+
+// AddCompressedSize adds value to the "compressed_size" field.
+func (_u *FileDataUpdate) AddCompressedSize(v int64) *FileDataUpdate {
+	_u.mutation.AddCompressedSize(v)
+	return _u
+}
+
+// ClearCompressedSize clears the value of the "compressed_size" field.
+func (_u *FileDataUpdate) ClearCompressedSize() *FileDataUpdate {
+	_u.mutation.ClearCompressedSize()
+	return _u
+}
+
+// SetStoredSize sets the "stored_size" field.
+func (_u *FileDataUpdate) SetStoredSize(v int64) *FileDataUpdate {
+	_u.mutation.ResetStoredSize()
+	_u.mutation.SetStoredSize(v)
+	return _u
+}
+
+// SetNillableStoredSize sets the "stored_size" field if the given value is not nil.
+func (_u *FileDataUpdate) SetNillableStoredSize(v *int64) *FileDataUpdate {
+	if v != nil {
+		_u.SetStoredSize(*v)
+	}
+	return _u
+}
+
+// AddStoredSize adds value to the "stored_size" field.
+func (_u *FileDataUpdate) AddStoredSize(v int64) *FileDataUpdate {
+	_u.mutation.AddStoredSize(v)
+	return _u
+}
+
+// SetMimeType sets the "mime_type" field.
+func (_u *FileDataUpdate) SetMimeType(v string) *FileDataUpdate {
+	_u.mutation.SetMimeType(v)
+	return _u
+}
+
+// SetNillableMimeType sets the "mime_type" field if the given value is not nil.
+func (_u *FileDataUpdate) SetNillableMimeType(v *string) *FileDataUpdate {
+	if v != nil {
+		_u.SetMimeType(*v)
+	}
+	return _u
+}
+
+// ClearMimeType clears the value of the "mime_type" field.
+func (_u *FileDataUpdate) ClearMimeType() *FileDataUpdate {
+	_u.mutation.ClearMimeType()
+	return _u
+}
+
+// SetPreviewStatus sets the "preview_status" field.
+func (_u *FileDataUpdate) SetPreviewStatus(v string) *FileDataUpdate {
+	_u.mutation.SetPreviewStatus(v)
+	return _u
+}
+
+// SetNillablePreviewStatus sets the "preview_status" field if the given value is not nil.
+func (_u *FileDataUpdate) SetNillablePreviewStatus(v *string) *FileDataUpdate {
+	if v != nil {
+		_u.SetPreviewStatus(*v)
+	}
+	return _u
+}
+
+// SetPreviewType sets the "preview_type" field.
+func (_u *FileDataUpdate) SetPreviewType(v string) *FileDataUpdate {
+	_u.mutation.SetPreviewType(v)
+	return _u
+}
+
+// SetNillablePreviewType sets the "preview_type" field if the given value is not nil.
+func (_u *FileDataUpdate) SetNillablePreviewType(v *string) *FileDataUpdate {
+	if v != nil {
+		_u.SetPreviewType(*v)
+	}
+	return _u
+}
+
+// ClearPreviewType clears the value of the "preview_type" field.
+func (_u *FileDataUpdate) ClearPreviewType() *FileDataUpdate {
+	_u.mutation.ClearPreviewType()
+	return _u
+}
+
 // AddFileIDs adds the "files" edge to the File entity by IDs.
 func (_u *FileDataUpdate) AddFileIDs(ids ...uuid.UUID) *FileDataUpdate {
 	_u.mutation.AddFileIDs(ids...)
@@ -133,6 +307,60 @@ func (_u *FileDataUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if value, ok := _u.mutation.AddedSize(); ok {
 		_spec.AddField(filedata.FieldSize, field.TypeUint64, value)
 	}
+	if value, ok := _u.mutation.Sha256(); ok {
+		_spec.SetField(filedata.FieldSha256, field.TypeString, value)
+	}
+	if _u.mutation.Sha256Cleared() {
+		_spec.ClearField(filedata.FieldSha256, field.TypeString)
+	}
+	if value, ok := _u.mutation.KeyID(); ok {
+		_spec.SetField(filedata.FieldKeyID, field.TypeString, value)
+	}
+	if _u.mutation.KeyIDCleared() {
+		_spec.ClearField(filedata.FieldKeyID, field.TypeString)
+	}
+	if value, ok := _u.mutation.EncryptedKey(); ok {
+		_spec.SetField(filedata.FieldEncryptedKey, field.TypeBytes, value)
+	}
+	if _u.mutation.EncryptedKeyCleared() {
+		_spec.ClearField(filedata.FieldEncryptedKey, field.TypeBytes)
+	}
+	if value, ok := _u.mutation.Compression(); ok {
+		_spec.SetField(filedata.FieldCompression, field.TypeString, value)
+	}
+	if _u.mutation.CompressionCleared() {
+		_spec.ClearField(filedata.FieldCompression, field.TypeString)
+	}
+	if value, ok := _u.mutation.CompressedSize(); ok {
+		_spec.SetField(filedata.FieldCompressedSize, field.TypeUint64, value)
+	}
+	if value, ok := _u.mutation.AddedCompressedSize(); ok {
+		_spec.AddField(filedata.FieldCompressedSize, field.TypeUint64, value)
+	}
+	if _u.mutation.CompressedSizeCleared() {
+		_spec.ClearField(filedata.FieldCompressedSize, field.TypeUint64)
+	}
+	if value, ok := _u.mutation.StoredSize(); ok {
+		_spec.SetField(filedata.FieldStoredSize, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedStoredSize(); ok {
+		_spec.AddField(filedata.FieldStoredSize, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.MimeType(); ok {
+		_spec.SetField(filedata.FieldMimeType, field.TypeString, value)
+	}
+	if _u.mutation.MimeTypeCleared() {
+		_spec.ClearField(filedata.FieldMimeType, field.TypeString)
+	}
+	if value, ok := _u.mutation.PreviewStatus(); ok {
+		_spec.SetField(filedata.FieldPreviewStatus, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.PreviewType(); ok {
+		_spec.SetField(filedata.FieldPreviewType, field.TypeString, value)
+	}
+	if _u.mutation.PreviewTypeCleared() {
+		_spec.ClearField(filedata.FieldPreviewType, field.TypeString)
+	}
 	if _u.mutation.FilesCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -219,6 +447,180 @@ func (_u *FileDataUpdateOne) AddSize(v int64) *FileDataUpdateOne {
 	return _u
 }
 
+// SetSha256 sets the "sha256" field.
+func (_u *FileDataUpdateOne) SetSha256(v string) *FileDataUpdateOne {
+	_u.mutation.SetSha256(v)
+	return _u
+}
+
+// SetNillableSha256 sets the "sha256" field if the given value is not nil.
+func (_u *FileDataUpdateOne) SetNillableSha256(v *string) *FileDataUpdateOne {
+	if v != nil {
+		_u.SetSha256(*v)
+	}
+	return _u
+}
+
+// ClearSha256 clears the value of the "sha256" field.
+func (_u *FileDataUpdateOne) ClearSha256() *FileDataUpdateOne {
+	_u.mutation.ClearSha256()
+	return _u
+}
+
+// SetKeyID sets the "key_id" field.
+func (_u *FileDataUpdateOne) SetKeyID(v string) *FileDataUpdateOne {
+	_u.mutation.SetKeyID(v)
+	return _u
+}
+
+// SetNillableKeyID sets the "key_id" field if the given value is not nil.
+func (_u *FileDataUpdateOne) SetNillableKeyID(v *string) *FileDataUpdateOne {
+	if v != nil {
+		_u.SetKeyID(*v)
+	}
+	return _u
+}
+
+// ClearKeyID clears the value of the "key_id" field.
+func (_u *FileDataUpdateOne) ClearKeyID() *FileDataUpdateOne {
+	_u.mutation.ClearKeyID()
+	return _u
+}
+
+// SetEncryptedKey sets the "encrypted_key" field.
+func (_u *FileDataUpdateOne) SetEncryptedKey(v []byte) *FileDataUpdateOne {
+	_u.mutation.SetEncryptedKey(v)
+	return _u
+}
+
+// ClearEncryptedKey clears the value of the "encrypted_key" field.
+func (_u *FileDataUpdateOne) ClearEncryptedKey() *FileDataUpdateOne {
+	_u.mutation.ClearEncryptedKey()
+	return _u
+}
+
+// SetCompression sets the "compression" field.
+func (_u *FileDataUpdateOne) SetCompression(v string) *FileDataUpdateOne {
+	_u.mutation.SetCompression(v)
+	return _u
+}
+
+// SetNillableCompression sets the "compression" field if the given value is not nil.
+func (_u *FileDataUpdateOne) SetNillableCompression(v *string) *FileDataUpdateOne {
+	if v != nil {
+		_u.SetCompression(*v)
+	}
+	return _u
+}
+
+// ClearCompression clears the value of the "compression" field.
+func (_u *FileDataUpdateOne) ClearCompression() *FileDataUpdateOne {
+	_u.mutation.ClearCompression()
+	return _u
+}
+
+// SetCompressedSize sets the "compressed_size" field.
+func (_u *FileDataUpdateOne) SetCompressedSize(v uint64) *FileDataUpdateOne {
+	_u.mutation.ResetCompressedSize()
+	_u.mutation.SetCompressedSize(v)
+	return _u
+}
+
+// SetNillableCompressedSize sets the "compressed_size" field if the given value is not nil.
+func (_u *FileDataUpdateOne) SetNillableCompressedSize(v *uint64) *FileDataUpdateOne {
+	if v != nil {
+		_u.SetCompressedSize(*v)
+	}
+	return _u
+}
+
+// AddCompressedSize adds value to the "compressed_size" field.
+func (_u *FileDataUpdateOne) AddCompressedSize(v int64) *FileDataUpdateOne {
+	_u.mutation.AddCompressedSize(v)
+	return _u
+}
+
+// ClearCompressedSize clears the value of the "compressed_size" field.
+func (_u *FileDataUpdateOne) ClearCompressedSize() *FileDataUpdateOne {
+	_u.mutation.ClearCompressedSize()
+	return _u
+}
+
+// SetStoredSize sets the "stored_size" field.
+func (_u *FileDataUpdateOne) SetStoredSize(v int64) *FileDataUpdateOne {
+	_u.mutation.ResetStoredSize()
+	_u.mutation.SetStoredSize(v)
+	return _u
+}
+
+// SetNillableStoredSize sets the "stored_size" field if the given value is not nil.
+func (_u *FileDataUpdateOne) SetNillableStoredSize(v *int64) *FileDataUpdateOne {
+	if v != nil {
+		_u.SetStoredSize(*v)
+	}
+	return _u
+}
+
+// AddStoredSize adds value to the "stored_size" field.
+func (_u *FileDataUpdateOne) AddStoredSize(v int64) *FileDataUpdateOne {
+	_u.mutation.AddStoredSize(v)
+	return _u
+}
+
+// SetMimeType sets the "mime_type" field.
+func (_u *FileDataUpdateOne) SetMimeType(v string) *FileDataUpdateOne {
+	_u.mutation.SetMimeType(v)
+	return _u
+}
+
+// SetNillableMimeType sets the "mime_type" field if the given value is not nil.
+func (_u *FileDataUpdateOne) SetNillableMimeType(v *string) *FileDataUpdateOne {
+	if v != nil {
+		_u.SetMimeType(*v)
+	}
+	return _u
+}
+
+// ClearMimeType clears the value of the "mime_type" field.
+func (_u *FileDataUpdateOne) ClearMimeType() *FileDataUpdateOne {
+	_u.mutation.ClearMimeType()
+	return _u
+}
+
+// SetPreviewStatus sets the "preview_status" field.
+func (_u *FileDataUpdateOne) SetPreviewStatus(v string) *FileDataUpdateOne {
+	_u.mutation.SetPreviewStatus(v)
+	return _u
+}
+
+// SetNillablePreviewStatus sets the "preview_status" field if the given value is not nil.
+func (_u *FileDataUpdateOne) SetNillablePreviewStatus(v *string) *FileDataUpdateOne {
+	if v != nil {
+		_u.SetPreviewStatus(*v)
+	}
+	return _u
+}
+
+// SetPreviewType sets the "preview_type" field.
+func (_u *FileDataUpdateOne) SetPreviewType(v string) *FileDataUpdateOne {
+	_u.mutation.SetPreviewType(v)
+	return _u
+}
+
+// SetNillablePreviewType sets the "preview_type" field if the given value is not nil.
+func (_u *FileDataUpdateOne) SetNillablePreviewType(v *string) *FileDataUpdateOne {
+	if v != nil {
+		_u.SetPreviewType(*v)
+	}
+	return _u
+}
+
+// ClearPreviewType clears the value of the "preview_type" field.
+func (_u *FileDataUpdateOne) ClearPreviewType() *FileDataUpdateOne {
+	_u.mutation.ClearPreviewType()
+	return _u
+}
+
 // AddFileIDs adds the "files" edge to the File entity by IDs.
 func (_u *FileDataUpdateOne) AddFileIDs(ids ...uuid.UUID) *FileDataUpdateOne {
 	_u.mutation.AddFileIDs(ids...)
@@ -332,6 +734,60 @@ func (_u *FileDataUpdateOne) sqlSave(ctx context.Context) (_node *FileData, err
 	if value, ok := _u.mutation.AddedSize(); ok {
 		_spec.AddField(filedata.FieldSize, field.TypeUint64, value)
 	}
+	if value, ok := _u.mutation.Sha256(); ok {
+		_spec.SetField(filedata.FieldSha256, field.TypeString, value)
+	}
+	if _u.mutation.Sha256Cleared() {
+		_spec.ClearField(filedata.FieldSha256, field.TypeString)
+	}
+	if value, ok := _u.mutation.KeyID(); ok {
+		_spec.SetField(filedata.FieldKeyID, field.TypeString, value)
+	}
+	if _u.mutation.KeyIDCleared() {
+		_spec.ClearField(filedata.FieldKeyID, field.TypeString)
+	}
+	if value, ok := _u.mutation.EncryptedKey(); ok {
+		_spec.SetField(filedata.FieldEncryptedKey, field.TypeBytes, value)
+	}
+	if _u.mutation.EncryptedKeyCleared() {
+		_spec.ClearField(filedata.FieldEncryptedKey, field.TypeBytes)
+	}
+	if value, ok := _u.mutation.Compression(); ok {
+		_spec.SetField(filedata.FieldCompression, field.TypeString, value)
+	}
+	if _u.mutation.CompressionCleared() {
+		_spec.ClearField(filedata.FieldCompression, field.TypeString)
+	}
+	if value, ok := _u.mutation.CompressedSize(); ok {
+		_spec.SetField(filedata.FieldCompressedSize, field.TypeUint64, value)
+	}
+	if value, ok := _u.mutation.AddedCompressedSize(); ok {
+		_spec.AddField(filedata.FieldCompressedSize, field.TypeUint64, value)
+	}
+	if _u.mutation.CompressedSizeCleared() {
+		_spec.ClearField(filedata.FieldCompressedSize, field.TypeUint64)
+	}
+	if value, ok := _u.mutation.StoredSize(); ok {
+		_spec.SetField(filedata.FieldStoredSize, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedStoredSize(); ok {
+		_spec.AddField(filedata.FieldStoredSize, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.MimeType(); ok {
+		_spec.SetField(filedata.FieldMimeType, field.TypeString, value)
+	}
+	if _u.mutation.MimeTypeCleared() {
+		_spec.ClearField(filedata.FieldMimeType, field.TypeString)
+	}
+	if value, ok := _u.mutation.PreviewStatus(); ok {
+		_spec.SetField(filedata.FieldPreviewStatus, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.PreviewType(); ok {
+		_spec.SetField(filedata.FieldPreviewType, field.TypeString, value)
+	}
+	if _u.mutation.PreviewTypeCleared() {
+		_spec.ClearField(filedata.FieldPreviewType, field.TypeString)
+	}
 	if _u.mutation.FilesCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
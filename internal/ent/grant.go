@@ -67,9 +67,13 @@ type GrantEdges struct {
 	Owner *User `json:"owner,omitempty"`
 	// Shareaccesstokens holds the value of the shareaccesstokens edge.
 	Shareaccesstokens []*ShareAccessToken `json:"shareaccesstokens,omitempty"`
+	// Uploads holds the value of the uploads edge.
+	Uploads []*Upload `json:"uploads,omitempty"`
+	// AccessEvents holds the value of the access_events edge.
+	AccessEvents []*AccessEvent `json:"access_events,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [3]bool
+	loadedTypes [5]bool
 }
 
 // FilesOrErr returns the Files value or an error if the edge
@@ -101,6 +105,24 @@ func (e GrantEdges) ShareaccesstokensOrErr() ([]*ShareAccessToken, error) {
 	return nil, &NotLoadedError{edge: "shareaccesstokens"}
 }
 
+// UploadsOrErr returns the Uploads value or an error if the edge
+// was not loaded in eager-loading.
+func (e GrantEdges) UploadsOrErr() ([]*Upload, error) {
+	if e.loadedTypes[3] {
+		return e.Uploads, nil
+	}
+	return nil, &NotLoadedError{edge: "uploads"}
+}
+
+// AccessEventsOrErr returns the AccessEvents value or an error if the edge
+// was not loaded in eager-loading.
+func (e GrantEdges) AccessEventsOrErr() ([]*AccessEvent, error) {
+	if e.loadedTypes[4] {
+		return e.AccessEvents, nil
+	}
+	return nil, &NotLoadedError{edge: "access_events"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*Grant) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
@@ -274,6 +296,16 @@ func (_m *Grant) QueryShareaccesstokens() *ShareAccessTokenQuery {
 	return NewGrantClient(_m.config).QueryShareaccesstokens(_m)
 }
 
+// QueryUploads queries the "uploads" edge of the Grant entity.
+func (_m *Grant) QueryUploads() *UploadQuery {
+	return NewGrantClient(_m.config).QueryUploads(_m)
+}
+
+// QueryAccessEvents queries the "access_events" edge of the Grant entity.
+func (_m *Grant) QueryAccessEvents() *AccessEventQuery {
+	return NewGrantClient(_m.config).QueryAccessEvents(_m)
+}
+
 // Update returns a builder for updating this Grant.
 // Note that you need to call Grant.Unwrap() before calling this method if this Grant
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -52,6 +52,10 @@ const (
 	EdgeOwner = "owner"
 	// EdgeShareaccesstokens holds the string denoting the shareaccesstokens edge name in mutations.
 	EdgeShareaccesstokens = "shareaccesstokens"
+	// EdgeUploads holds the string denoting the uploads edge name in mutations.
+	EdgeUploads = "uploads"
+	// EdgeAccessEvents holds the string denoting the access_events edge name in mutations.
+	EdgeAccessEvents = "access_events"
 	// Table holds the table name of the grant in the database.
 	Table = "grants"
 	// FilesTable is the table that holds the files relation/edge.
@@ -75,6 +79,20 @@ const (
 	ShareaccesstokensInverseTable = "share_access_tokens"
 	// ShareaccesstokensColumn is the table column denoting the shareaccesstokens relation/edge.
 	ShareaccesstokensColumn = "grant_shareaccesstokens"
+	// UploadsTable is the table that holds the uploads relation/edge.
+	UploadsTable = "uploads"
+	// UploadsInverseTable is the table name for the Upload entity.
+	// It exists in this package in order to avoid circular dependency with the "upload" package.
+	UploadsInverseTable = "uploads"
+	// UploadsColumn is the table column denoting the uploads relation/edge.
+	UploadsColumn = "grant_uploads"
+	// AccessEventsTable is the table that holds the access_events relation/edge.
+	AccessEventsTable = "access_events"
+	// AccessEventsInverseTable is the table name for the AccessEvent entity.
+	// It exists in this package in order to avoid circular dependency with the "accessevent" package.
+	AccessEventsInverseTable = "access_events"
+	// AccessEventsColumn is the table column denoting the access_events relation/edge.
+	AccessEventsColumn = "grant_access_events"
 )
 
 // Columns holds all SQL columns for grant fields.
@@ -245,6 +263,34 @@ func ByShareaccesstokens(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption
 		sqlgraph.OrderByNeighborTerms(s, newShareaccesstokensStep(), append([]sql.OrderTerm{term}, terms...)...)
 	}
 }
+
+// ByUploadsCount orders the results by uploads count.
+func ByUploadsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newUploadsStep(), opts...)
+	}
+}
+
+// ByUploads orders the results by uploads terms.
+func ByUploads(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newUploadsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByAccessEventsCount orders the results by access_events count.
+func ByAccessEventsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newAccessEventsStep(), opts...)
+	}
+}
+
+// ByAccessEvents orders the results by access_events terms.
+func ByAccessEvents(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newAccessEventsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
 func newFilesStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -266,3 +312,17 @@ func newShareaccesstokensStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.O2M, false, ShareaccesstokensTable, ShareaccesstokensColumn),
 	)
 }
+func newUploadsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(UploadsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, UploadsTable, UploadsColumn),
+	)
+}
+func newAccessEventsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(AccessEventsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, AccessEventsTable, AccessEventsColumn),
+	)
+}
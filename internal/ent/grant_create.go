@@ -8,9 +8,11 @@ import (
 	"fmt"
 	"time"
 
+	"codeberg.org/jvllmr/frans/internal/ent/accessevent"
 	"codeberg.org/jvllmr/frans/internal/ent/file"
 	"codeberg.org/jvllmr/frans/internal/ent/grant"
 	"codeberg.org/jvllmr/frans/internal/ent/shareaccesstoken"
+	"codeberg.org/jvllmr/frans/internal/ent/upload"
 	"codeberg.org/jvllmr/frans/internal/ent/user"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
@@ -215,6 +217,36 @@ func (_c *GrantCreate) AddShareaccesstokens(v ...*ShareAccessToken) *GrantCreate
 	return _c.AddShareaccesstokenIDs(ids...)
 }
 
+// AddUploadIDs adds the "uploads" edge to the Upload entity by IDs.
+func (_c *GrantCreate) AddUploadIDs(ids ...uuid.UUID) *GrantCreate {
+	_c.mutation.AddUploadIDs(ids...)
+	return _c
+}
+
+// AddUploads adds the "uploads" edges to the Upload entity.
+func (_c *GrantCreate) AddUploads(v ...*Upload) *GrantCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddUploadIDs(ids...)
+}
+
+// AddAccessEventIDs adds the "access_events" edge to the AccessEvent entity by IDs.
+func (_c *GrantCreate) AddAccessEventIDs(ids ...int) *GrantCreate {
+	_c.mutation.AddAccessEventIDs(ids...)
+	return _c
+}
+
+// AddAccessEvents adds the "access_events" edges to the AccessEvent entity.
+func (_c *GrantCreate) AddAccessEvents(v ...*AccessEvent) *GrantCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddAccessEventIDs(ids...)
+}
+
 // Mutation returns the GrantMutation object of the builder.
 func (_c *GrantCreate) Mutation() *GrantMutation {
 	return _c.mutation
@@ -453,6 +485,38 @@ func (_c *GrantCreate) createSpec() (*Grant, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := _c.mutation.UploadsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   grant.UploadsTable,
+			Columns: []string{grant.UploadsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(upload.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.AccessEventsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   grant.AccessEventsTable,
+			Columns: []string{grant.AccessEventsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(accessevent.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
@@ -8,10 +8,12 @@ import (
 	"fmt"
 	"math"
 
+	"codeberg.org/jvllmr/frans/internal/ent/accessevent"
 	"codeberg.org/jvllmr/frans/internal/ent/file"
 	"codeberg.org/jvllmr/frans/internal/ent/grant"
 	"codeberg.org/jvllmr/frans/internal/ent/predicate"
 	"codeberg.org/jvllmr/frans/internal/ent/shareaccesstoken"
+	"codeberg.org/jvllmr/frans/internal/ent/upload"
 	"codeberg.org/jvllmr/frans/internal/ent/user"
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
@@ -30,6 +32,8 @@ type GrantQuery struct {
 	withFiles             *FileQuery
 	withOwner             *UserQuery
 	withShareaccesstokens *ShareAccessTokenQuery
+	withUploads           *UploadQuery
+	withAccessEvents      *AccessEventQuery
 	withFKs               bool
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
@@ -133,6 +137,50 @@ func (_q *GrantQuery) QueryShareaccesstokens() *ShareAccessTokenQuery {
 	return query
 }
 
+// QueryUploads chains the current query on the "uploads" edge.
+func (_q *GrantQuery) QueryUploads() *UploadQuery {
+	query := (&UploadClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(grant.Table, grant.FieldID, selector),
+			sqlgraph.To(upload.Table, upload.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, grant.UploadsTable, grant.UploadsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryAccessEvents chains the current query on the "access_events" edge.
+func (_q *GrantQuery) QueryAccessEvents() *AccessEventQuery {
+	query := (&AccessEventClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(grant.Table, grant.FieldID, selector),
+			sqlgraph.To(accessevent.Table, accessevent.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, grant.AccessEventsTable, grant.AccessEventsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first Grant entity from the query.
 // Returns a *NotFoundError when no Grant was found.
 func (_q *GrantQuery) First(ctx context.Context) (*Grant, error) {
@@ -328,6 +376,8 @@ func (_q *GrantQuery) Clone() *GrantQuery {
 		withFiles:             _q.withFiles.Clone(),
 		withOwner:             _q.withOwner.Clone(),
 		withShareaccesstokens: _q.withShareaccesstokens.Clone(),
+		withUploads:           _q.withUploads.Clone(),
+		withAccessEvents:      _q.withAccessEvents.Clone(),
 		// clone intermediate query.
 		sql:  _q.sql.Clone(),
 		path: _q.path,
@@ -367,6 +417,28 @@ func (_q *GrantQuery) WithShareaccesstokens(opts ...func(*ShareAccessTokenQuery)
 	return _q
 }
 
+// WithUploads tells the query-builder to eager-load the nodes that are connected to
+// the "uploads" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *GrantQuery) WithUploads(opts ...func(*UploadQuery)) *GrantQuery {
+	query := (&UploadClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withUploads = query
+	return _q
+}
+
+// WithAccessEvents tells the query-builder to eager-load the nodes that are connected to
+// the "access_events" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *GrantQuery) WithAccessEvents(opts ...func(*AccessEventQuery)) *GrantQuery {
+	query := (&AccessEventClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withAccessEvents = query
+	return _q
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -446,10 +518,12 @@ func (_q *GrantQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Grant,
 		nodes       = []*Grant{}
 		withFKs     = _q.withFKs
 		_spec       = _q.querySpec()
-		loadedTypes = [3]bool{
+		loadedTypes = [5]bool{
 			_q.withFiles != nil,
 			_q.withOwner != nil,
 			_q.withShareaccesstokens != nil,
+			_q.withUploads != nil,
+			_q.withAccessEvents != nil,
 		}
 	)
 	if _q.withOwner != nil {
@@ -496,6 +570,20 @@ func (_q *GrantQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Grant,
 			return nil, err
 		}
 	}
+	if query := _q.withUploads; query != nil {
+		if err := _q.loadUploads(ctx, query, nodes,
+			func(n *Grant) { n.Edges.Uploads = []*Upload{} },
+			func(n *Grant, e *Upload) { n.Edges.Uploads = append(n.Edges.Uploads, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withAccessEvents; query != nil {
+		if err := _q.loadAccessEvents(ctx, query, nodes,
+			func(n *Grant) { n.Edges.AccessEvents = []*AccessEvent{} },
+			func(n *Grant, e *AccessEvent) { n.Edges.AccessEvents = append(n.Edges.AccessEvents, e) }); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -593,6 +681,68 @@ func (_q *GrantQuery) loadShareaccesstokens(ctx context.Context, query *ShareAcc
 	}
 	return nil
 }
+func (_q *GrantQuery) loadUploads(ctx context.Context, query *UploadQuery, nodes []*Grant, init func(*Grant), assign func(*Grant, *Upload)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*Grant)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.Upload(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(grant.UploadsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.grant_uploads
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "grant_uploads" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "grant_uploads" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *GrantQuery) loadAccessEvents(ctx context.Context, query *AccessEventQuery, nodes []*Grant, init func(*Grant), assign func(*Grant, *AccessEvent)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*Grant)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.AccessEvent(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(grant.AccessEventsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.grant_access_events
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "grant_access_events" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "grant_access_events" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
 
 func (_q *GrantQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := _q.querySpec()
@@ -8,10 +8,12 @@ import (
 	"fmt"
 	"time"
 
+	"codeberg.org/jvllmr/frans/internal/ent/accessevent"
 	"codeberg.org/jvllmr/frans/internal/ent/file"
 	"codeberg.org/jvllmr/frans/internal/ent/grant"
 	"codeberg.org/jvllmr/frans/internal/ent/predicate"
 	"codeberg.org/jvllmr/frans/internal/ent/shareaccesstoken"
+	"codeberg.org/jvllmr/frans/internal/ent/upload"
 	"codeberg.org/jvllmr/frans/internal/ent/user"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
@@ -371,6 +373,36 @@ func (_u *GrantUpdate) AddShareaccesstokens(v ...*ShareAccessToken) *GrantUpdate
 	return _u.AddShareaccesstokenIDs(ids...)
 }
 
+// AddUploadIDs adds the "uploads" edge to the Upload entity by IDs.
+func (_u *GrantUpdate) AddUploadIDs(ids ...uuid.UUID) *GrantUpdate {
+	_u.mutation.AddUploadIDs(ids...)
+	return _u
+}
+
+// AddUploads adds the "uploads" edges to the Upload entity.
+func (_u *GrantUpdate) AddUploads(v ...*Upload) *GrantUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddUploadIDs(ids...)
+}
+
+// AddAccessEventIDs adds the "access_events" edge to the AccessEvent entity by IDs.
+func (_u *GrantUpdate) AddAccessEventIDs(ids ...int) *GrantUpdate {
+	_u.mutation.AddAccessEventIDs(ids...)
+	return _u
+}
+
+// AddAccessEvents adds the "access_events" edges to the AccessEvent entity.
+func (_u *GrantUpdate) AddAccessEvents(v ...*AccessEvent) *GrantUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddAccessEventIDs(ids...)
+}
+
 // Mutation returns the GrantMutation object of the builder.
 func (_u *GrantUpdate) Mutation() *GrantMutation {
 	return _u.mutation
@@ -424,6 +456,48 @@ func (_u *GrantUpdate) RemoveShareaccesstokens(v ...*ShareAccessToken) *GrantUpd
 	return _u.RemoveShareaccesstokenIDs(ids...)
 }
 
+// ClearUploads clears all "uploads" edges to the Upload entity.
+func (_u *GrantUpdate) ClearUploads() *GrantUpdate {
+	_u.mutation.ClearUploads()
+	return _u
+}
+
+// RemoveUploadIDs removes the "uploads" edge to Upload entities by IDs.
+func (_u *GrantUpdate) RemoveUploadIDs(ids ...uuid.UUID) *GrantUpdate {
+	_u.mutation.RemoveUploadIDs(ids...)
+	return _u
+}
+
+// RemoveUploads removes "uploads" edges to Upload entities.
+func (_u *GrantUpdate) RemoveUploads(v ...*Upload) *GrantUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveUploadIDs(ids...)
+}
+
+// ClearAccessEvents clears all "access_events" edges to the AccessEvent entity.
+func (_u *GrantUpdate) ClearAccessEvents() *GrantUpdate {
+	_u.mutation.ClearAccessEvents()
+	return _u
+}
+
+// RemoveAccessEventIDs removes the "access_events" edge to AccessEvent entities by IDs.
+func (_u *GrantUpdate) RemoveAccessEventIDs(ids ...int) *GrantUpdate {
+	_u.mutation.RemoveAccessEventIDs(ids...)
+	return _u
+}
+
+// RemoveAccessEvents removes "access_events" edges to AccessEvent entities.
+func (_u *GrantUpdate) RemoveAccessEvents(v ...*AccessEvent) *GrantUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveAccessEventIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (_u *GrantUpdate) Save(ctx context.Context) (int, error) {
 	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
@@ -662,6 +736,96 @@ func (_u *GrantUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.UploadsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   grant.UploadsTable,
+			Columns: []string{grant.UploadsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(upload.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedUploadsIDs(); len(nodes) > 0 && !_u.mutation.UploadsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   grant.UploadsTable,
+			Columns: []string{grant.UploadsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(upload.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.UploadsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   grant.UploadsTable,
+			Columns: []string{grant.UploadsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(upload.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.AccessEventsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   grant.AccessEventsTable,
+			Columns: []string{grant.AccessEventsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(accessevent.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedAccessEventsIDs(); len(nodes) > 0 && !_u.mutation.AccessEventsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   grant.AccessEventsTable,
+			Columns: []string{grant.AccessEventsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(accessevent.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.AccessEventsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   grant.AccessEventsTable,
+			Columns: []string{grant.AccessEventsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(accessevent.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{grant.Label}
@@ -1020,6 +1184,36 @@ func (_u *GrantUpdateOne) AddShareaccesstokens(v ...*ShareAccessToken) *GrantUpd
 	return _u.AddShareaccesstokenIDs(ids...)
 }
 
+// AddUploadIDs adds the "uploads" edge to the Upload entity by IDs.
+func (_u *GrantUpdateOne) AddUploadIDs(ids ...uuid.UUID) *GrantUpdateOne {
+	_u.mutation.AddUploadIDs(ids...)
+	return _u
+}
+
+// AddUploads adds the "uploads" edges to the Upload entity.
+func (_u *GrantUpdateOne) AddUploads(v ...*Upload) *GrantUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddUploadIDs(ids...)
+}
+
+// AddAccessEventIDs adds the "access_events" edge to the AccessEvent entity by IDs.
+func (_u *GrantUpdateOne) AddAccessEventIDs(ids ...int) *GrantUpdateOne {
+	_u.mutation.AddAccessEventIDs(ids...)
+	return _u
+}
+
+// AddAccessEvents adds the "access_events" edges to the AccessEvent entity.
+func (_u *GrantUpdateOne) AddAccessEvents(v ...*AccessEvent) *GrantUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddAccessEventIDs(ids...)
+}
+
 // Mutation returns the GrantMutation object of the builder.
 func (_u *GrantUpdateOne) Mutation() *GrantMutation {
 	return _u.mutation
@@ -1073,6 +1267,48 @@ func (_u *GrantUpdateOne) RemoveShareaccesstokens(v ...*ShareAccessToken) *Grant
 	return _u.RemoveShareaccesstokenIDs(ids...)
 }
 
+// ClearUploads clears all "uploads" edges to the Upload entity.
+func (_u *GrantUpdateOne) ClearUploads() *GrantUpdateOne {
+	_u.mutation.ClearUploads()
+	return _u
+}
+
+// RemoveUploadIDs removes the "uploads" edge to Upload entities by IDs.
+func (_u *GrantUpdateOne) RemoveUploadIDs(ids ...uuid.UUID) *GrantUpdateOne {
+	_u.mutation.RemoveUploadIDs(ids...)
+	return _u
+}
+
+// RemoveUploads removes "uploads" edges to Upload entities.
+func (_u *GrantUpdateOne) RemoveUploads(v ...*Upload) *GrantUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveUploadIDs(ids...)
+}
+
+// ClearAccessEvents clears all "access_events" edges to the AccessEvent entity.
+func (_u *GrantUpdateOne) ClearAccessEvents() *GrantUpdateOne {
+	_u.mutation.ClearAccessEvents()
+	return _u
+}
+
+// RemoveAccessEventIDs removes the "access_events" edge to AccessEvent entities by IDs.
+func (_u *GrantUpdateOne) RemoveAccessEventIDs(ids ...int) *GrantUpdateOne {
+	_u.mutation.RemoveAccessEventIDs(ids...)
+	return _u
+}
+
+// RemoveAccessEvents removes "access_events" edges to AccessEvent entities.
+func (_u *GrantUpdateOne) RemoveAccessEvents(v ...*AccessEvent) *GrantUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveAccessEventIDs(ids...)
+}
+
 // Where appends a list predicates to the GrantUpdate builder.
 func (_u *GrantUpdateOne) Where(ps ...predicate.Grant) *GrantUpdateOne {
 	_u.mutation.Where(ps...)
@@ -1341,6 +1577,96 @@ func (_u *GrantUpdateOne) sqlSave(ctx context.Context) (_node *Grant, err error)
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.UploadsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   grant.UploadsTable,
+			Columns: []string{grant.UploadsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(upload.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedUploadsIDs(); len(nodes) > 0 && !_u.mutation.UploadsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   grant.UploadsTable,
+			Columns: []string{grant.UploadsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(upload.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.UploadsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   grant.UploadsTable,
+			Columns: []string{grant.UploadsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(upload.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.AccessEventsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   grant.AccessEventsTable,
+			Columns: []string{grant.AccessEventsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(accessevent.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedAccessEventsIDs(); len(nodes) > 0 && !_u.mutation.AccessEventsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   grant.AccessEventsTable,
+			Columns: []string{grant.AccessEventsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(accessevent.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.AccessEventsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   grant.AccessEventsTable,
+			Columns: []string{grant.AccessEventsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(accessevent.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &Grant{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
@@ -8,6 +8,89 @@ import (
 )
 
 var (
+	// AccessEventsColumns holds the columns for the "access_events" table.
+	AccessEventsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "type", Type: field.TypeString},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "address", Type: field.TypeString},
+		{Name: "user_agent", Type: field.TypeString, Default: ""},
+		{Name: "bytes_served", Type: field.TypeInt64, Default: 0},
+		{Name: "file_name", Type: field.TypeString, Nullable: true},
+		{Name: "file_access_events", Type: field.TypeUUID, Nullable: true},
+		{Name: "grant_access_events", Type: field.TypeUUID, Nullable: true},
+		{Name: "recipient_access_events", Type: field.TypeUUID, Nullable: true},
+		{Name: "ticket_access_events", Type: field.TypeUUID, Nullable: true},
+	}
+	// AccessEventsTable holds the schema information for the "access_events" table.
+	AccessEventsTable = &schema.Table{
+		Name:       "access_events",
+		Columns:    AccessEventsColumns,
+		PrimaryKey: []*schema.Column{AccessEventsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "access_events_files_access_events",
+				Columns:    []*schema.Column{AccessEventsColumns[7]},
+				RefColumns: []*schema.Column{FilesColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+			{
+				Symbol:     "access_events_grants_access_events",
+				Columns:    []*schema.Column{AccessEventsColumns[8]},
+				RefColumns: []*schema.Column{GrantsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "access_events_recipients_access_events",
+				Columns:    []*schema.Column{AccessEventsColumns[9]},
+				RefColumns: []*schema.Column{RecipientsColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+			{
+				Symbol:     "access_events_tickets_access_events",
+				Columns:    []*schema.Column{AccessEventsColumns[10]},
+				RefColumns: []*schema.Column{TicketsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "accessevent_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{AccessEventsColumns[2]},
+			},
+		},
+	}
+	// DownloadProgressesColumns holds the columns for the "download_progresses" table.
+	DownloadProgressesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "token", Type: field.TypeString},
+		{Name: "ranges", Type: field.TypeJSON, Nullable: true},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "version", Type: field.TypeInt64, Default: 0},
+		{Name: "file_download_progresses", Type: field.TypeUUID},
+	}
+	// DownloadProgressesTable holds the schema information for the "download_progresses" table.
+	DownloadProgressesTable = &schema.Table{
+		Name:       "download_progresses",
+		Columns:    DownloadProgressesColumns,
+		PrimaryKey: []*schema.Column{DownloadProgressesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "download_progresses_files_download_progresses",
+				Columns:    []*schema.Column{DownloadProgressesColumns[5]},
+				RefColumns: []*schema.Column{FilesColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "downloadprogress_token_file_download_progresses",
+				Unique:  true,
+				Columns: []*schema.Column{DownloadProgressesColumns[1], DownloadProgressesColumns[5]},
+			},
+		},
+	}
 	// FilesColumns holds the columns for the "files" table.
 	FilesColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUUID, Unique: true},
@@ -15,10 +98,15 @@ var (
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "last_download", Type: field.TypeTime, Nullable: true},
 		{Name: "times_downloaded", Type: field.TypeUint64, Default: 0},
+		{Name: "last_view", Type: field.TypeTime, Nullable: true},
+		{Name: "times_viewed", Type: field.TypeUint64, Default: 0},
 		{Name: "expiry_type", Type: field.TypeString},
 		{Name: "expiry_total_days", Type: field.TypeUint8},
 		{Name: "expiry_days_since_last_download", Type: field.TypeUint8},
 		{Name: "expiry_total_downloads", Type: field.TypeUint8},
+		{Name: "scan_status", Type: field.TypeString, Default: "clean"},
+		{Name: "scan_signature", Type: field.TypeString, Nullable: true},
+		{Name: "mime_type", Type: field.TypeString, Nullable: true},
 		{Name: "file_data", Type: field.TypeString},
 		{Name: "grant_files", Type: field.TypeUUID, Nullable: true},
 		{Name: "ticket_files", Type: field.TypeUUID, Nullable: true},
@@ -32,25 +120,25 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "files_file_data_data",
-				Columns:    []*schema.Column{FilesColumns[9]},
+				Columns:    []*schema.Column{FilesColumns[14]},
 				RefColumns: []*schema.Column{FileDataColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
 			{
 				Symbol:     "files_grants_files",
-				Columns:    []*schema.Column{FilesColumns[10]},
+				Columns:    []*schema.Column{FilesColumns[15]},
 				RefColumns: []*schema.Column{GrantsColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
 			{
 				Symbol:     "files_tickets_files",
-				Columns:    []*schema.Column{FilesColumns[11]},
+				Columns:    []*schema.Column{FilesColumns[16]},
 				RefColumns: []*schema.Column{TicketsColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
 			{
 				Symbol:     "files_users_files",
-				Columns:    []*schema.Column{FilesColumns[12]},
+				Columns:    []*schema.Column{FilesColumns[17]},
 				RefColumns: []*schema.Column{UsersColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
@@ -60,6 +148,15 @@ var (
 	FileDataColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeString, Unique: true},
 		{Name: "size", Type: field.TypeUint64},
+		{Name: "sha256", Type: field.TypeString, Nullable: true},
+		{Name: "key_id", Type: field.TypeString, Nullable: true},
+		{Name: "encrypted_key", Type: field.TypeBytes, Nullable: true},
+		{Name: "compression", Type: field.TypeString, Nullable: true},
+		{Name: "compressed_size", Type: field.TypeUint64, Nullable: true},
+		{Name: "stored_size", Type: field.TypeInt64, Default: 0},
+		{Name: "mime_type", Type: field.TypeString, Nullable: true},
+		{Name: "preview_status", Type: field.TypeString, Default: "pending"},
+		{Name: "preview_type", Type: field.TypeString, Nullable: true},
 	}
 	// FileDataTable holds the schema information for the "file_data" table.
 	FileDataTable = &schema.Table{
@@ -102,6 +199,33 @@ var (
 			},
 		},
 	}
+	// RecipientsColumns holds the columns for the "recipients" table.
+	RecipientsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "name", Type: field.TypeString},
+		{Name: "email", Type: field.TypeString, Nullable: true},
+		{Name: "hashed_token", Type: field.TypeString, Unique: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "revoked_at", Type: field.TypeTime, Nullable: true},
+		{Name: "last_download", Type: field.TypeTime, Nullable: true},
+		{Name: "times_downloaded", Type: field.TypeUint64, Default: 0},
+		{Name: "downloaded_files", Type: field.TypeJSON, Nullable: true},
+		{Name: "ticket_recipients", Type: field.TypeUUID},
+	}
+	// RecipientsTable holds the schema information for the "recipients" table.
+	RecipientsTable = &schema.Table{
+		Name:       "recipients",
+		Columns:    RecipientsColumns,
+		PrimaryKey: []*schema.Column{RecipientsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "recipients_tickets_recipients",
+				Columns:    []*schema.Column{RecipientsColumns[9]},
+				RefColumns: []*schema.Column{TicketsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+	}
 	// SessionsColumns holds the columns for the "sessions" table.
 	SessionsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
@@ -129,6 +253,7 @@ var (
 		{Name: "id", Type: field.TypeString, Unique: true},
 		{Name: "expiry", Type: field.TypeTime},
 		{Name: "grant_shareaccesstokens", Type: field.TypeUUID, Nullable: true},
+		{Name: "recipient_shareaccesstokens", Type: field.TypeUUID, Nullable: true},
 		{Name: "ticket_shareaccesstokens", Type: field.TypeUUID, Nullable: true},
 	}
 	// ShareAccessTokensTable holds the schema information for the "share_access_tokens" table.
@@ -144,13 +269,33 @@ var (
 				OnDelete:   schema.SetNull,
 			},
 			{
-				Symbol:     "share_access_tokens_tickets_shareaccesstokens",
+				Symbol:     "share_access_tokens_recipients_shareaccesstokens",
 				Columns:    []*schema.Column{ShareAccessTokensColumns[3]},
+				RefColumns: []*schema.Column{RecipientsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "share_access_tokens_tickets_shareaccesstokens",
+				Columns:    []*schema.Column{ShareAccessTokensColumns[4]},
 				RefColumns: []*schema.Column{TicketsColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
 		},
 	}
+	// StorageVerificationsColumns holds the columns for the "storage_verifications" table.
+	StorageVerificationsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "started_at", Type: field.TypeTime},
+		{Name: "finished_at", Type: field.TypeTime, Nullable: true},
+		{Name: "checked_blobs", Type: field.TypeInt64, Default: 0},
+		{Name: "issues", Type: field.TypeJSON, Nullable: true},
+	}
+	// StorageVerificationsTable holds the schema information for the "storage_verifications" table.
+	StorageVerificationsTable = &schema.Table{
+		Name:       "storage_verifications",
+		Columns:    StorageVerificationsColumns,
+		PrimaryKey: []*schema.Column{StorageVerificationsColumns[0]},
+	}
 	// TicketsColumns holds the columns for the "tickets" table.
 	TicketsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUUID, Unique: true},
@@ -164,6 +309,7 @@ var (
 		{Name: "expiry_total_downloads", Type: field.TypeUint8},
 		{Name: "email_on_download", Type: field.TypeJSON, Nullable: true},
 		{Name: "creator_lang", Type: field.TypeString, Default: "en"},
+		{Name: "views_count_as_downloads", Type: field.TypeBool, Default: false},
 		{Name: "user_tickets", Type: field.TypeUUID, Nullable: true},
 	}
 	// TicketsTable holds the schema information for the "tickets" table.
@@ -174,7 +320,38 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "tickets_users_tickets",
-				Columns:    []*schema.Column{TicketsColumns[11]},
+				Columns:    []*schema.Column{TicketsColumns[12]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+	}
+	// UploadsColumns holds the columns for the "uploads" table.
+	UploadsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "name", Type: field.TypeString},
+		{Name: "size", Type: field.TypeInt64},
+		{Name: "offset", Type: field.TypeInt64, Default: 0},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "expiry", Type: field.TypeTime},
+		{Name: "grant_uploads", Type: field.TypeUUID, Nullable: true},
+		{Name: "user_uploads", Type: field.TypeUUID, Nullable: true},
+	}
+	// UploadsTable holds the schema information for the "uploads" table.
+	UploadsTable = &schema.Table{
+		Name:       "uploads",
+		Columns:    UploadsColumns,
+		PrimaryKey: []*schema.Column{UploadsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "uploads_grants_uploads",
+				Columns:    []*schema.Column{UploadsColumns[6]},
+				RefColumns: []*schema.Column{GrantsColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+			{
+				Symbol:     "uploads_users_uploads",
+				Columns:    []*schema.Column{UploadsColumns[7]},
 				RefColumns: []*schema.Column{UsersColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
@@ -192,6 +369,7 @@ var (
 		{Name: "submitted_tickets", Type: field.TypeInt, Default: 0},
 		{Name: "submitted_grants", Type: field.TypeInt, Default: 0},
 		{Name: "total_data_size", Type: field.TypeInt64, Default: 0},
+		{Name: "physical_data_size", Type: field.TypeInt64, Default: 0},
 	}
 	// UsersTable holds the schema information for the "users" table.
 	UsersTable = &schema.Table{
@@ -201,24 +379,38 @@ var (
 	}
 	// Tables holds all the tables in the schema.
 	Tables = []*schema.Table{
+		AccessEventsTable,
+		DownloadProgressesTable,
 		FilesTable,
 		FileDataTable,
 		GrantsTable,
+		RecipientsTable,
 		SessionsTable,
 		ShareAccessTokensTable,
+		StorageVerificationsTable,
 		TicketsTable,
+		UploadsTable,
 		UsersTable,
 	}
 )
 
 func init() {
+	AccessEventsTable.ForeignKeys[0].RefTable = FilesTable
+	AccessEventsTable.ForeignKeys[1].RefTable = GrantsTable
+	AccessEventsTable.ForeignKeys[2].RefTable = RecipientsTable
+	AccessEventsTable.ForeignKeys[3].RefTable = TicketsTable
+	DownloadProgressesTable.ForeignKeys[0].RefTable = FilesTable
 	FilesTable.ForeignKeys[0].RefTable = FileDataTable
 	FilesTable.ForeignKeys[1].RefTable = GrantsTable
 	FilesTable.ForeignKeys[2].RefTable = TicketsTable
 	FilesTable.ForeignKeys[3].RefTable = UsersTable
 	GrantsTable.ForeignKeys[0].RefTable = UsersTable
+	RecipientsTable.ForeignKeys[0].RefTable = TicketsTable
 	SessionsTable.ForeignKeys[0].RefTable = UsersTable
 	ShareAccessTokensTable.ForeignKeys[0].RefTable = GrantsTable
-	ShareAccessTokensTable.ForeignKeys[1].RefTable = TicketsTable
+	ShareAccessTokensTable.ForeignKeys[1].RefTable = RecipientsTable
+	ShareAccessTokensTable.ForeignKeys[2].RefTable = TicketsTable
 	TicketsTable.ForeignKeys[0].RefTable = UsersTable
+	UploadsTable.ForeignKeys[0].RefTable = GrantsTable
+	UploadsTable.ForeignKeys[1].RefTable = UsersTable
 }
@@ -9,14 +9,20 @@ import (
 	"sync"
 	"time"
 
+	"codeberg.org/jvllmr/frans/internal/ent/accessevent"
+	"codeberg.org/jvllmr/frans/internal/ent/downloadprogress"
 	"codeberg.org/jvllmr/frans/internal/ent/file"
 	"codeberg.org/jvllmr/frans/internal/ent/filedata"
 	"codeberg.org/jvllmr/frans/internal/ent/grant"
 	"codeberg.org/jvllmr/frans/internal/ent/predicate"
+	"codeberg.org/jvllmr/frans/internal/ent/recipient"
 	"codeberg.org/jvllmr/frans/internal/ent/session"
 	"codeberg.org/jvllmr/frans/internal/ent/shareaccesstoken"
+	"codeberg.org/jvllmr/frans/internal/ent/storageverification"
 	"codeberg.org/jvllmr/frans/internal/ent/ticket"
+	"codeberg.org/jvllmr/frans/internal/ent/upload"
 	"codeberg.org/jvllmr/frans/internal/ent/user"
+	"codeberg.org/jvllmr/frans/internal/storage"
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"github.com/google/uuid"
@@ -31,58 +37,58 @@ const (
 	OpUpdateOne = ent.OpUpdateOne
 
 	// Node types.
-	TypeFile             = "File"
-	TypeFileData         = "FileData"
-	TypeGrant            = "Grant"
-	TypeSession          = "Session"
-	TypeShareAccessToken = "ShareAccessToken"
-	TypeTicket           = "Ticket"
-	TypeUser             = "User"
+	TypeAccessEvent         = "AccessEvent"
+	TypeDownloadProgress    = "DownloadProgress"
+	TypeFile                = "File"
+	TypeFileData            = "FileData"
+	TypeGrant               = "Grant"
+	TypeRecipient           = "Recipient"
+	TypeSession             = "Session"
+	TypeShareAccessToken    = "ShareAccessToken"
+	TypeStorageVerification = "StorageVerification"
+	TypeTicket              = "Ticket"
+	TypeUpload              = "Upload"
+	TypeUser                = "User"
 )
 
-// FileMutation represents an operation that mutates the File nodes in the graph.
-type FileMutation struct {
+// AccessEventMutation represents an operation that mutates the AccessEvent nodes in the graph.
+type AccessEventMutation struct {
 	config
-	op                                 Op
-	typ                                string
-	id                                 *uuid.UUID
-	name                               *string
-	created_at                         *time.Time
-	last_download                      *time.Time
-	times_downloaded                   *uint64
-	addtimes_downloaded                *int64
-	expiry_type                        *string
-	expiry_total_days                  *uint8
-	addexpiry_total_days               *int8
-	expiry_days_since_last_download    *uint8
-	addexpiry_days_since_last_download *int8
-	expiry_total_downloads             *uint8
-	addexpiry_total_downloads          *int8
-	clearedFields                      map[string]struct{}
-	ticket                             *uuid.UUID
-	clearedticket                      bool
-	grant                              *uuid.UUID
-	clearedgrant                       bool
-	owner                              *uuid.UUID
-	clearedowner                       bool
-	data                               *string
-	cleareddata                        bool
-	done                               bool
-	oldValue                           func(context.Context) (*File, error)
-	predicates                         []predicate.File
-}
-
-var _ ent.Mutation = (*FileMutation)(nil)
-
-// fileOption allows management of the mutation configuration using functional options.
-type fileOption func(*FileMutation)
-
-// newFileMutation creates new mutation for the File entity.
-func newFileMutation(c config, op Op, opts ...fileOption) *FileMutation {
-	m := &FileMutation{
+	op               Op
+	typ              string
+	id               *int
+	_type            *string
+	created_at       *time.Time
+	address          *string
+	user_agent       *string
+	bytes_served     *int64
+	addbytes_served  *int64
+	file_name        *string
+	clearedFields    map[string]struct{}
+	ticket           *uuid.UUID
+	clearedticket    bool
+	grant            *uuid.UUID
+	clearedgrant     bool
+	recipient        *uuid.UUID
+	clearedrecipient bool
+	file             *uuid.UUID
+	clearedfile      bool
+	done             bool
+	oldValue         func(context.Context) (*AccessEvent, error)
+	predicates       []predicate.AccessEvent
+}
+
+var _ ent.Mutation = (*AccessEventMutation)(nil)
+
+// accesseventOption allows management of the mutation configuration using functional options.
+type accesseventOption func(*AccessEventMutation)
+
+// newAccessEventMutation creates new mutation for the AccessEvent entity.
+func newAccessEventMutation(c config, op Op, opts ...accesseventOption) *AccessEventMutation {
+	m := &AccessEventMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeFile,
+		typ:           TypeAccessEvent,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -91,20 +97,20 @@ func newFileMutation(c config, op Op, opts ...fileOption) *FileMutation {
 	return m
 }
 
-// withFileID sets the ID field of the mutation.
-func withFileID(id uuid.UUID) fileOption {
-	return func(m *FileMutation) {
+// withAccessEventID sets the ID field of the mutation.
+func withAccessEventID(id int) accesseventOption {
+	return func(m *AccessEventMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *File
+			value *AccessEvent
 		)
-		m.oldValue = func(ctx context.Context) (*File, error) {
+		m.oldValue = func(ctx context.Context) (*AccessEvent, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().File.Get(ctx, id)
+					value, err = m.Client().AccessEvent.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -113,10 +119,10 @@ func withFileID(id uuid.UUID) fileOption {
 	}
 }
 
-// withFile sets the old File of the mutation.
-func withFile(node *File) fileOption {
-	return func(m *FileMutation) {
-		m.oldValue = func(context.Context) (*File, error) {
+// withAccessEvent sets the old AccessEvent of the mutation.
+func withAccessEvent(node *AccessEvent) accesseventOption {
+	return func(m *AccessEventMutation) {
+		m.oldValue = func(context.Context) (*AccessEvent, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -125,7 +131,7 @@ func withFile(node *File) fileOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m FileMutation) Client() *Client {
+func (m AccessEventMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -133,7 +139,7 @@ func (m FileMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m FileMutation) Tx() (*Tx, error) {
+func (m AccessEventMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -142,15 +148,9 @@ func (m FileMutation) Tx() (*Tx, error) {
 	return tx, nil
 }
 
-// SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of File entities.
-func (m *FileMutation) SetID(id uuid.UUID) {
-	m.id = &id
-}
-
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *FileMutation) ID() (id uuid.UUID, exists bool) {
+func (m *AccessEventMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -161,64 +161,64 @@ func (m *FileMutation) ID() (id uuid.UUID, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *FileMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+func (m *AccessEventMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
 		if exists {
-			return []uuid.UUID{id}, nil
+			return []int{id}, nil
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().File.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().AccessEvent.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetName sets the "name" field.
-func (m *FileMutation) SetName(s string) {
-	m.name = &s
+// SetType sets the "type" field.
+func (m *AccessEventMutation) SetType(s string) {
+	m._type = &s
 }
 
-// Name returns the value of the "name" field in the mutation.
-func (m *FileMutation) Name() (r string, exists bool) {
-	v := m.name
+// GetType returns the value of the "type" field in the mutation.
+func (m *AccessEventMutation) GetType() (r string, exists bool) {
+	v := m._type
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldName returns the old "name" field's value of the File entity.
-// If the File object wasn't provided to the builder, the object is fetched from the database.
+// OldType returns the old "type" field's value of the AccessEvent entity.
+// If the AccessEvent object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *FileMutation) OldName(ctx context.Context) (v string, err error) {
+func (m *AccessEventMutation) OldType(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldName is only allowed on UpdateOne operations")
+		return v, errors.New("OldType is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldName requires an ID field in the mutation")
+		return v, errors.New("OldType requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldName: %w", err)
+		return v, fmt.Errorf("querying old value for OldType: %w", err)
 	}
-	return oldValue.Name, nil
+	return oldValue.Type, nil
 }
 
-// ResetName resets all changes to the "name" field.
-func (m *FileMutation) ResetName() {
-	m.name = nil
+// ResetType resets all changes to the "type" field.
+func (m *AccessEventMutation) ResetType() {
+	m._type = nil
 }
 
 // SetCreatedAt sets the "created_at" field.
-func (m *FileMutation) SetCreatedAt(t time.Time) {
+func (m *AccessEventMutation) SetCreatedAt(t time.Time) {
 	m.created_at = &t
 }
 
 // CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *FileMutation) CreatedAt() (r time.Time, exists bool) {
+func (m *AccessEventMutation) CreatedAt() (r time.Time, exists bool) {
 	v := m.created_at
 	if v == nil {
 		return
@@ -226,10 +226,10 @@ func (m *FileMutation) CreatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the File entity.
-// If the File object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the AccessEvent entity.
+// If the AccessEvent object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *FileMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *AccessEventMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
@@ -244,336 +244,204 @@ func (m *FileMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error
 }
 
 // ResetCreatedAt resets all changes to the "created_at" field.
-func (m *FileMutation) ResetCreatedAt() {
+func (m *AccessEventMutation) ResetCreatedAt() {
 	m.created_at = nil
 }
 
-// SetLastDownload sets the "last_download" field.
-func (m *FileMutation) SetLastDownload(t time.Time) {
-	m.last_download = &t
-}
-
-// LastDownload returns the value of the "last_download" field in the mutation.
-func (m *FileMutation) LastDownload() (r time.Time, exists bool) {
-	v := m.last_download
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldLastDownload returns the old "last_download" field's value of the File entity.
-// If the File object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *FileMutation) OldLastDownload(ctx context.Context) (v *time.Time, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldLastDownload is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldLastDownload requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldLastDownload: %w", err)
-	}
-	return oldValue.LastDownload, nil
-}
-
-// ClearLastDownload clears the value of the "last_download" field.
-func (m *FileMutation) ClearLastDownload() {
-	m.last_download = nil
-	m.clearedFields[file.FieldLastDownload] = struct{}{}
-}
-
-// LastDownloadCleared returns if the "last_download" field was cleared in this mutation.
-func (m *FileMutation) LastDownloadCleared() bool {
-	_, ok := m.clearedFields[file.FieldLastDownload]
-	return ok
-}
-
-// ResetLastDownload resets all changes to the "last_download" field.
-func (m *FileMutation) ResetLastDownload() {
-	m.last_download = nil
-	delete(m.clearedFields, file.FieldLastDownload)
-}
-
-// SetTimesDownloaded sets the "times_downloaded" field.
-func (m *FileMutation) SetTimesDownloaded(u uint64) {
-	m.times_downloaded = &u
-	m.addtimes_downloaded = nil
+// SetAddress sets the "address" field.
+func (m *AccessEventMutation) SetAddress(s string) {
+	m.address = &s
 }
 
-// TimesDownloaded returns the value of the "times_downloaded" field in the mutation.
-func (m *FileMutation) TimesDownloaded() (r uint64, exists bool) {
-	v := m.times_downloaded
+// Address returns the value of the "address" field in the mutation.
+func (m *AccessEventMutation) Address() (r string, exists bool) {
+	v := m.address
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldTimesDownloaded returns the old "times_downloaded" field's value of the File entity.
-// If the File object wasn't provided to the builder, the object is fetched from the database.
+// OldAddress returns the old "address" field's value of the AccessEvent entity.
+// If the AccessEvent object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *FileMutation) OldTimesDownloaded(ctx context.Context) (v uint64, err error) {
+func (m *AccessEventMutation) OldAddress(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldTimesDownloaded is only allowed on UpdateOne operations")
+		return v, errors.New("OldAddress is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldTimesDownloaded requires an ID field in the mutation")
+		return v, errors.New("OldAddress requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldTimesDownloaded: %w", err)
-	}
-	return oldValue.TimesDownloaded, nil
-}
-
-// AddTimesDownloaded adds u to the "times_downloaded" field.
-func (m *FileMutation) AddTimesDownloaded(u int64) {
-	if m.addtimes_downloaded != nil {
-		*m.addtimes_downloaded += u
-	} else {
-		m.addtimes_downloaded = &u
-	}
-}
-
-// AddedTimesDownloaded returns the value that was added to the "times_downloaded" field in this mutation.
-func (m *FileMutation) AddedTimesDownloaded() (r int64, exists bool) {
-	v := m.addtimes_downloaded
-	if v == nil {
-		return
+		return v, fmt.Errorf("querying old value for OldAddress: %w", err)
 	}
-	return *v, true
+	return oldValue.Address, nil
 }
 
-// ResetTimesDownloaded resets all changes to the "times_downloaded" field.
-func (m *FileMutation) ResetTimesDownloaded() {
-	m.times_downloaded = nil
-	m.addtimes_downloaded = nil
+// ResetAddress resets all changes to the "address" field.
+func (m *AccessEventMutation) ResetAddress() {
+	m.address = nil
 }
 
-// SetExpiryType sets the "expiry_type" field.
-func (m *FileMutation) SetExpiryType(s string) {
-	m.expiry_type = &s
+// SetUserAgent sets the "user_agent" field.
+func (m *AccessEventMutation) SetUserAgent(s string) {
+	m.user_agent = &s
 }
 
-// ExpiryType returns the value of the "expiry_type" field in the mutation.
-func (m *FileMutation) ExpiryType() (r string, exists bool) {
-	v := m.expiry_type
+// UserAgent returns the value of the "user_agent" field in the mutation.
+func (m *AccessEventMutation) UserAgent() (r string, exists bool) {
+	v := m.user_agent
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldExpiryType returns the old "expiry_type" field's value of the File entity.
-// If the File object wasn't provided to the builder, the object is fetched from the database.
+// OldUserAgent returns the old "user_agent" field's value of the AccessEvent entity.
+// If the AccessEvent object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *FileMutation) OldExpiryType(ctx context.Context) (v string, err error) {
+func (m *AccessEventMutation) OldUserAgent(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldExpiryType is only allowed on UpdateOne operations")
+		return v, errors.New("OldUserAgent is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldExpiryType requires an ID field in the mutation")
+		return v, errors.New("OldUserAgent requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldExpiryType: %w", err)
+		return v, fmt.Errorf("querying old value for OldUserAgent: %w", err)
 	}
-	return oldValue.ExpiryType, nil
+	return oldValue.UserAgent, nil
 }
 
-// ResetExpiryType resets all changes to the "expiry_type" field.
-func (m *FileMutation) ResetExpiryType() {
-	m.expiry_type = nil
+// ResetUserAgent resets all changes to the "user_agent" field.
+func (m *AccessEventMutation) ResetUserAgent() {
+	m.user_agent = nil
 }
 
-// SetExpiryTotalDays sets the "expiry_total_days" field.
-func (m *FileMutation) SetExpiryTotalDays(u uint8) {
-	m.expiry_total_days = &u
-	m.addexpiry_total_days = nil
+// SetBytesServed sets the "bytes_served" field.
+func (m *AccessEventMutation) SetBytesServed(i int64) {
+	m.bytes_served = &i
+	m.addbytes_served = nil
 }
 
-// ExpiryTotalDays returns the value of the "expiry_total_days" field in the mutation.
-func (m *FileMutation) ExpiryTotalDays() (r uint8, exists bool) {
-	v := m.expiry_total_days
+// BytesServed returns the value of the "bytes_served" field in the mutation.
+func (m *AccessEventMutation) BytesServed() (r int64, exists bool) {
+	v := m.bytes_served
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldExpiryTotalDays returns the old "expiry_total_days" field's value of the File entity.
-// If the File object wasn't provided to the builder, the object is fetched from the database.
+// OldBytesServed returns the old "bytes_served" field's value of the AccessEvent entity.
+// If the AccessEvent object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *FileMutation) OldExpiryTotalDays(ctx context.Context) (v uint8, err error) {
+func (m *AccessEventMutation) OldBytesServed(ctx context.Context) (v int64, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldExpiryTotalDays is only allowed on UpdateOne operations")
+		return v, errors.New("OldBytesServed is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldExpiryTotalDays requires an ID field in the mutation")
+		return v, errors.New("OldBytesServed requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldExpiryTotalDays: %w", err)
+		return v, fmt.Errorf("querying old value for OldBytesServed: %w", err)
 	}
-	return oldValue.ExpiryTotalDays, nil
+	return oldValue.BytesServed, nil
 }
 
-// AddExpiryTotalDays adds u to the "expiry_total_days" field.
-func (m *FileMutation) AddExpiryTotalDays(u int8) {
-	if m.addexpiry_total_days != nil {
-		*m.addexpiry_total_days += u
+// AddBytesServed adds i to the "bytes_served" field.
+func (m *AccessEventMutation) AddBytesServed(i int64) {
+	if m.addbytes_served != nil {
+		*m.addbytes_served += i
 	} else {
-		m.addexpiry_total_days = &u
+		m.addbytes_served = &i
 	}
 }
 
-// AddedExpiryTotalDays returns the value that was added to the "expiry_total_days" field in this mutation.
-func (m *FileMutation) AddedExpiryTotalDays() (r int8, exists bool) {
-	v := m.addexpiry_total_days
+// AddedBytesServed returns the value that was added to the "bytes_served" field in this mutation.
+func (m *AccessEventMutation) AddedBytesServed() (r int64, exists bool) {
+	v := m.addbytes_served
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ResetExpiryTotalDays resets all changes to the "expiry_total_days" field.
-func (m *FileMutation) ResetExpiryTotalDays() {
-	m.expiry_total_days = nil
-	m.addexpiry_total_days = nil
+// ResetBytesServed resets all changes to the "bytes_served" field.
+func (m *AccessEventMutation) ResetBytesServed() {
+	m.bytes_served = nil
+	m.addbytes_served = nil
 }
 
-// SetExpiryDaysSinceLastDownload sets the "expiry_days_since_last_download" field.
-func (m *FileMutation) SetExpiryDaysSinceLastDownload(u uint8) {
-	m.expiry_days_since_last_download = &u
-	m.addexpiry_days_since_last_download = nil
+// SetFileName sets the "file_name" field.
+func (m *AccessEventMutation) SetFileName(s string) {
+	m.file_name = &s
 }
 
-// ExpiryDaysSinceLastDownload returns the value of the "expiry_days_since_last_download" field in the mutation.
-func (m *FileMutation) ExpiryDaysSinceLastDownload() (r uint8, exists bool) {
-	v := m.expiry_days_since_last_download
+// FileName returns the value of the "file_name" field in the mutation.
+func (m *AccessEventMutation) FileName() (r string, exists bool) {
+	v := m.file_name
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldExpiryDaysSinceLastDownload returns the old "expiry_days_since_last_download" field's value of the File entity.
-// If the File object wasn't provided to the builder, the object is fetched from the database.
+// OldFileName returns the old "file_name" field's value of the AccessEvent entity.
+// If the AccessEvent object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *FileMutation) OldExpiryDaysSinceLastDownload(ctx context.Context) (v uint8, err error) {
+func (m *AccessEventMutation) OldFileName(ctx context.Context) (v *string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldExpiryDaysSinceLastDownload is only allowed on UpdateOne operations")
+		return v, errors.New("OldFileName is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldExpiryDaysSinceLastDownload requires an ID field in the mutation")
+		return v, errors.New("OldFileName requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldExpiryDaysSinceLastDownload: %w", err)
+		return v, fmt.Errorf("querying old value for OldFileName: %w", err)
 	}
-	return oldValue.ExpiryDaysSinceLastDownload, nil
+	return oldValue.FileName, nil
 }
 
-// AddExpiryDaysSinceLastDownload adds u to the "expiry_days_since_last_download" field.
-func (m *FileMutation) AddExpiryDaysSinceLastDownload(u int8) {
-	if m.addexpiry_days_since_last_download != nil {
-		*m.addexpiry_days_since_last_download += u
-	} else {
-		m.addexpiry_days_since_last_download = &u
-	}
+// ClearFileName clears the value of the "file_name" field.
+func (m *AccessEventMutation) ClearFileName() {
+	m.file_name = nil
+	m.clearedFields[accessevent.FieldFileName] = struct{}{}
 }
 
-// AddedExpiryDaysSinceLastDownload returns the value that was added to the "expiry_days_since_last_download" field in this mutation.
-func (m *FileMutation) AddedExpiryDaysSinceLastDownload() (r int8, exists bool) {
-	v := m.addexpiry_days_since_last_download
-	if v == nil {
-		return
-	}
-	return *v, true
+// FileNameCleared returns if the "file_name" field was cleared in this mutation.
+func (m *AccessEventMutation) FileNameCleared() bool {
+	_, ok := m.clearedFields[accessevent.FieldFileName]
+	return ok
 }
 
-// ResetExpiryDaysSinceLastDownload resets all changes to the "expiry_days_since_last_download" field.
-func (m *FileMutation) ResetExpiryDaysSinceLastDownload() {
-	m.expiry_days_since_last_download = nil
-	m.addexpiry_days_since_last_download = nil
+// ResetFileName resets all changes to the "file_name" field.
+func (m *AccessEventMutation) ResetFileName() {
+	m.file_name = nil
+	delete(m.clearedFields, accessevent.FieldFileName)
 }
 
-// SetExpiryTotalDownloads sets the "expiry_total_downloads" field.
-func (m *FileMutation) SetExpiryTotalDownloads(u uint8) {
-	m.expiry_total_downloads = &u
-	m.addexpiry_total_downloads = nil
-}
-
-// ExpiryTotalDownloads returns the value of the "expiry_total_downloads" field in the mutation.
-func (m *FileMutation) ExpiryTotalDownloads() (r uint8, exists bool) {
-	v := m.expiry_total_downloads
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldExpiryTotalDownloads returns the old "expiry_total_downloads" field's value of the File entity.
-// If the File object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *FileMutation) OldExpiryTotalDownloads(ctx context.Context) (v uint8, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldExpiryTotalDownloads is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldExpiryTotalDownloads requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldExpiryTotalDownloads: %w", err)
-	}
-	return oldValue.ExpiryTotalDownloads, nil
-}
-
-// AddExpiryTotalDownloads adds u to the "expiry_total_downloads" field.
-func (m *FileMutation) AddExpiryTotalDownloads(u int8) {
-	if m.addexpiry_total_downloads != nil {
-		*m.addexpiry_total_downloads += u
-	} else {
-		m.addexpiry_total_downloads = &u
-	}
-}
-
-// AddedExpiryTotalDownloads returns the value that was added to the "expiry_total_downloads" field in this mutation.
-func (m *FileMutation) AddedExpiryTotalDownloads() (r int8, exists bool) {
-	v := m.addexpiry_total_downloads
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// ResetExpiryTotalDownloads resets all changes to the "expiry_total_downloads" field.
-func (m *FileMutation) ResetExpiryTotalDownloads() {
-	m.expiry_total_downloads = nil
-	m.addexpiry_total_downloads = nil
-}
-
-// SetTicketID sets the "ticket" edge to the Ticket entity by id.
-func (m *FileMutation) SetTicketID(id uuid.UUID) {
-	m.ticket = &id
+// SetTicketID sets the "ticket" edge to the Ticket entity by id.
+func (m *AccessEventMutation) SetTicketID(id uuid.UUID) {
+	m.ticket = &id
 }
 
 // ClearTicket clears the "ticket" edge to the Ticket entity.
-func (m *FileMutation) ClearTicket() {
+func (m *AccessEventMutation) ClearTicket() {
 	m.clearedticket = true
 }
 
 // TicketCleared reports if the "ticket" edge to the Ticket entity was cleared.
-func (m *FileMutation) TicketCleared() bool {
+func (m *AccessEventMutation) TicketCleared() bool {
 	return m.clearedticket
 }
 
 // TicketID returns the "ticket" edge ID in the mutation.
-func (m *FileMutation) TicketID() (id uuid.UUID, exists bool) {
+func (m *AccessEventMutation) TicketID() (id uuid.UUID, exists bool) {
 	if m.ticket != nil {
 		return *m.ticket, true
 	}
@@ -583,7 +451,7 @@ func (m *FileMutation) TicketID() (id uuid.UUID, exists bool) {
 // TicketIDs returns the "ticket" edge IDs in the mutation.
 // Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
 // TicketID instead. It exists only for internal usage by the builders.
-func (m *FileMutation) TicketIDs() (ids []uuid.UUID) {
+func (m *AccessEventMutation) TicketIDs() (ids []uuid.UUID) {
 	if id := m.ticket; id != nil {
 		ids = append(ids, *id)
 	}
@@ -591,28 +459,28 @@ func (m *FileMutation) TicketIDs() (ids []uuid.UUID) {
 }
 
 // ResetTicket resets all changes to the "ticket" edge.
-func (m *FileMutation) ResetTicket() {
+func (m *AccessEventMutation) ResetTicket() {
 	m.ticket = nil
 	m.clearedticket = false
 }
 
 // SetGrantID sets the "grant" edge to the Grant entity by id.
-func (m *FileMutation) SetGrantID(id uuid.UUID) {
+func (m *AccessEventMutation) SetGrantID(id uuid.UUID) {
 	m.grant = &id
 }
 
 // ClearGrant clears the "grant" edge to the Grant entity.
-func (m *FileMutation) ClearGrant() {
+func (m *AccessEventMutation) ClearGrant() {
 	m.clearedgrant = true
 }
 
 // GrantCleared reports if the "grant" edge to the Grant entity was cleared.
-func (m *FileMutation) GrantCleared() bool {
+func (m *AccessEventMutation) GrantCleared() bool {
 	return m.clearedgrant
 }
 
 // GrantID returns the "grant" edge ID in the mutation.
-func (m *FileMutation) GrantID() (id uuid.UUID, exists bool) {
+func (m *AccessEventMutation) GrantID() (id uuid.UUID, exists bool) {
 	if m.grant != nil {
 		return *m.grant, true
 	}
@@ -622,7 +490,7 @@ func (m *FileMutation) GrantID() (id uuid.UUID, exists bool) {
 // GrantIDs returns the "grant" edge IDs in the mutation.
 // Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
 // GrantID instead. It exists only for internal usage by the builders.
-func (m *FileMutation) GrantIDs() (ids []uuid.UUID) {
+func (m *AccessEventMutation) GrantIDs() (ids []uuid.UUID) {
 	if id := m.grant; id != nil {
 		ids = append(ids, *id)
 	}
@@ -630,98 +498,98 @@ func (m *FileMutation) GrantIDs() (ids []uuid.UUID) {
 }
 
 // ResetGrant resets all changes to the "grant" edge.
-func (m *FileMutation) ResetGrant() {
+func (m *AccessEventMutation) ResetGrant() {
 	m.grant = nil
 	m.clearedgrant = false
 }
 
-// SetOwnerID sets the "owner" edge to the User entity by id.
-func (m *FileMutation) SetOwnerID(id uuid.UUID) {
-	m.owner = &id
+// SetRecipientID sets the "recipient" edge to the Recipient entity by id.
+func (m *AccessEventMutation) SetRecipientID(id uuid.UUID) {
+	m.recipient = &id
 }
 
-// ClearOwner clears the "owner" edge to the User entity.
-func (m *FileMutation) ClearOwner() {
-	m.clearedowner = true
+// ClearRecipient clears the "recipient" edge to the Recipient entity.
+func (m *AccessEventMutation) ClearRecipient() {
+	m.clearedrecipient = true
 }
 
-// OwnerCleared reports if the "owner" edge to the User entity was cleared.
-func (m *FileMutation) OwnerCleared() bool {
-	return m.clearedowner
+// RecipientCleared reports if the "recipient" edge to the Recipient entity was cleared.
+func (m *AccessEventMutation) RecipientCleared() bool {
+	return m.clearedrecipient
 }
 
-// OwnerID returns the "owner" edge ID in the mutation.
-func (m *FileMutation) OwnerID() (id uuid.UUID, exists bool) {
-	if m.owner != nil {
-		return *m.owner, true
+// RecipientID returns the "recipient" edge ID in the mutation.
+func (m *AccessEventMutation) RecipientID() (id uuid.UUID, exists bool) {
+	if m.recipient != nil {
+		return *m.recipient, true
 	}
 	return
 }
 
-// OwnerIDs returns the "owner" edge IDs in the mutation.
+// RecipientIDs returns the "recipient" edge IDs in the mutation.
 // Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// OwnerID instead. It exists only for internal usage by the builders.
-func (m *FileMutation) OwnerIDs() (ids []uuid.UUID) {
-	if id := m.owner; id != nil {
+// RecipientID instead. It exists only for internal usage by the builders.
+func (m *AccessEventMutation) RecipientIDs() (ids []uuid.UUID) {
+	if id := m.recipient; id != nil {
 		ids = append(ids, *id)
 	}
 	return
 }
 
-// ResetOwner resets all changes to the "owner" edge.
-func (m *FileMutation) ResetOwner() {
-	m.owner = nil
-	m.clearedowner = false
+// ResetRecipient resets all changes to the "recipient" edge.
+func (m *AccessEventMutation) ResetRecipient() {
+	m.recipient = nil
+	m.clearedrecipient = false
 }
 
-// SetDataID sets the "data" edge to the FileData entity by id.
-func (m *FileMutation) SetDataID(id string) {
-	m.data = &id
+// SetFileID sets the "file" edge to the File entity by id.
+func (m *AccessEventMutation) SetFileID(id uuid.UUID) {
+	m.file = &id
 }
 
-// ClearData clears the "data" edge to the FileData entity.
-func (m *FileMutation) ClearData() {
-	m.cleareddata = true
+// ClearFile clears the "file" edge to the File entity.
+func (m *AccessEventMutation) ClearFile() {
+	m.clearedfile = true
 }
 
-// DataCleared reports if the "data" edge to the FileData entity was cleared.
-func (m *FileMutation) DataCleared() bool {
-	return m.cleareddata
+// FileCleared reports if the "file" edge to the File entity was cleared.
+func (m *AccessEventMutation) FileCleared() bool {
+	return m.clearedfile
 }
 
-// DataID returns the "data" edge ID in the mutation.
-func (m *FileMutation) DataID() (id string, exists bool) {
-	if m.data != nil {
-		return *m.data, true
+// FileID returns the "file" edge ID in the mutation.
+func (m *AccessEventMutation) FileID() (id uuid.UUID, exists bool) {
+	if m.file != nil {
+		return *m.file, true
 	}
 	return
 }
 
-// DataIDs returns the "data" edge IDs in the mutation.
+// FileIDs returns the "file" edge IDs in the mutation.
 // Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// DataID instead. It exists only for internal usage by the builders.
-func (m *FileMutation) DataIDs() (ids []string) {
-	if id := m.data; id != nil {
+// FileID instead. It exists only for internal usage by the builders.
+func (m *AccessEventMutation) FileIDs() (ids []uuid.UUID) {
+	if id := m.file; id != nil {
 		ids = append(ids, *id)
 	}
 	return
 }
 
-// ResetData resets all changes to the "data" edge.
-func (m *FileMutation) ResetData() {
-	m.data = nil
-	m.cleareddata = false
+// ResetFile resets all changes to the "file" edge.
+func (m *AccessEventMutation) ResetFile() {
+	m.file = nil
+	m.clearedfile = false
 }
 
-// Where appends a list predicates to the FileMutation builder.
-func (m *FileMutation) Where(ps ...predicate.File) {
+// Where appends a list predicates to the AccessEventMutation builder.
+func (m *AccessEventMutation) Where(ps ...predicate.AccessEvent) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the FileMutation builder. Using this method,
+// WhereP appends storage-level predicates to the AccessEventMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *FileMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.File, len(ps))
+func (m *AccessEventMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.AccessEvent, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -729,48 +597,42 @@ func (m *FileMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *FileMutation) Op() Op {
+func (m *AccessEventMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *FileMutation) SetOp(op Op) {
+func (m *AccessEventMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (File).
-func (m *FileMutation) Type() string {
+// Type returns the node type of this mutation (AccessEvent).
+func (m *AccessEventMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *FileMutation) Fields() []string {
-	fields := make([]string, 0, 8)
-	if m.name != nil {
-		fields = append(fields, file.FieldName)
+func (m *AccessEventMutation) Fields() []string {
+	fields := make([]string, 0, 6)
+	if m._type != nil {
+		fields = append(fields, accessevent.FieldType)
 	}
 	if m.created_at != nil {
-		fields = append(fields, file.FieldCreatedAt)
-	}
-	if m.last_download != nil {
-		fields = append(fields, file.FieldLastDownload)
+		fields = append(fields, accessevent.FieldCreatedAt)
 	}
-	if m.times_downloaded != nil {
-		fields = append(fields, file.FieldTimesDownloaded)
-	}
-	if m.expiry_type != nil {
-		fields = append(fields, file.FieldExpiryType)
+	if m.address != nil {
+		fields = append(fields, accessevent.FieldAddress)
 	}
-	if m.expiry_total_days != nil {
-		fields = append(fields, file.FieldExpiryTotalDays)
+	if m.user_agent != nil {
+		fields = append(fields, accessevent.FieldUserAgent)
 	}
-	if m.expiry_days_since_last_download != nil {
-		fields = append(fields, file.FieldExpiryDaysSinceLastDownload)
+	if m.bytes_served != nil {
+		fields = append(fields, accessevent.FieldBytesServed)
 	}
-	if m.expiry_total_downloads != nil {
-		fields = append(fields, file.FieldExpiryTotalDownloads)
+	if m.file_name != nil {
+		fields = append(fields, accessevent.FieldFileName)
 	}
 	return fields
 }
@@ -778,24 +640,20 @@ func (m *FileMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *FileMutation) Field(name string) (ent.Value, bool) {
+func (m *AccessEventMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case file.FieldName:
-		return m.Name()
-	case file.FieldCreatedAt:
+	case accessevent.FieldType:
+		return m.GetType()
+	case accessevent.FieldCreatedAt:
 		return m.CreatedAt()
-	case file.FieldLastDownload:
-		return m.LastDownload()
-	case file.FieldTimesDownloaded:
-		return m.TimesDownloaded()
-	case file.FieldExpiryType:
-		return m.ExpiryType()
-	case file.FieldExpiryTotalDays:
-		return m.ExpiryTotalDays()
-	case file.FieldExpiryDaysSinceLastDownload:
-		return m.ExpiryDaysSinceLastDownload()
-	case file.FieldExpiryTotalDownloads:
-		return m.ExpiryTotalDownloads()
+	case accessevent.FieldAddress:
+		return m.Address()
+	case accessevent.FieldUserAgent:
+		return m.UserAgent()
+	case accessevent.FieldBytesServed:
+		return m.BytesServed()
+	case accessevent.FieldFileName:
+		return m.FileName()
 	}
 	return nil, false
 }
@@ -803,108 +661,81 @@ func (m *FileMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *FileMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *AccessEventMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case file.FieldName:
-		return m.OldName(ctx)
-	case file.FieldCreatedAt:
+	case accessevent.FieldType:
+		return m.OldType(ctx)
+	case accessevent.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
-	case file.FieldLastDownload:
-		return m.OldLastDownload(ctx)
-	case file.FieldTimesDownloaded:
-		return m.OldTimesDownloaded(ctx)
-	case file.FieldExpiryType:
-		return m.OldExpiryType(ctx)
-	case file.FieldExpiryTotalDays:
-		return m.OldExpiryTotalDays(ctx)
-	case file.FieldExpiryDaysSinceLastDownload:
-		return m.OldExpiryDaysSinceLastDownload(ctx)
-	case file.FieldExpiryTotalDownloads:
-		return m.OldExpiryTotalDownloads(ctx)
+	case accessevent.FieldAddress:
+		return m.OldAddress(ctx)
+	case accessevent.FieldUserAgent:
+		return m.OldUserAgent(ctx)
+	case accessevent.FieldBytesServed:
+		return m.OldBytesServed(ctx)
+	case accessevent.FieldFileName:
+		return m.OldFileName(ctx)
 	}
-	return nil, fmt.Errorf("unknown File field %s", name)
+	return nil, fmt.Errorf("unknown AccessEvent field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *FileMutation) SetField(name string, value ent.Value) error {
+func (m *AccessEventMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case file.FieldName:
+	case accessevent.FieldType:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetName(v)
+		m.SetType(v)
 		return nil
-	case file.FieldCreatedAt:
+	case accessevent.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetCreatedAt(v)
 		return nil
-	case file.FieldLastDownload:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetLastDownload(v)
-		return nil
-	case file.FieldTimesDownloaded:
-		v, ok := value.(uint64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetTimesDownloaded(v)
-		return nil
-	case file.FieldExpiryType:
+	case accessevent.FieldAddress:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetExpiryType(v)
+		m.SetAddress(v)
 		return nil
-	case file.FieldExpiryTotalDays:
-		v, ok := value.(uint8)
+	case accessevent.FieldUserAgent:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetExpiryTotalDays(v)
+		m.SetUserAgent(v)
 		return nil
-	case file.FieldExpiryDaysSinceLastDownload:
-		v, ok := value.(uint8)
+	case accessevent.FieldBytesServed:
+		v, ok := value.(int64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetExpiryDaysSinceLastDownload(v)
+		m.SetBytesServed(v)
 		return nil
-	case file.FieldExpiryTotalDownloads:
-		v, ok := value.(uint8)
+	case accessevent.FieldFileName:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetExpiryTotalDownloads(v)
+		m.SetFileName(v)
 		return nil
 	}
-	return fmt.Errorf("unknown File field %s", name)
+	return fmt.Errorf("unknown AccessEvent field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *FileMutation) AddedFields() []string {
+func (m *AccessEventMutation) AddedFields() []string {
 	var fields []string
-	if m.addtimes_downloaded != nil {
-		fields = append(fields, file.FieldTimesDownloaded)
-	}
-	if m.addexpiry_total_days != nil {
-		fields = append(fields, file.FieldExpiryTotalDays)
-	}
-	if m.addexpiry_days_since_last_download != nil {
-		fields = append(fields, file.FieldExpiryDaysSinceLastDownload)
-	}
-	if m.addexpiry_total_downloads != nil {
-		fields = append(fields, file.FieldExpiryTotalDownloads)
+	if m.addbytes_served != nil {
+		fields = append(fields, accessevent.FieldBytesServed)
 	}
 	return fields
 }
@@ -912,16 +743,10 @@ func (m *FileMutation) AddedFields() []string {
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *FileMutation) AddedField(name string) (ent.Value, bool) {
+func (m *AccessEventMutation) AddedField(name string) (ent.Value, bool) {
 	switch name {
-	case file.FieldTimesDownloaded:
-		return m.AddedTimesDownloaded()
-	case file.FieldExpiryTotalDays:
-		return m.AddedExpiryTotalDays()
-	case file.FieldExpiryDaysSinceLastDownload:
-		return m.AddedExpiryDaysSinceLastDownload()
-	case file.FieldExpiryTotalDownloads:
-		return m.AddedExpiryTotalDownloads()
+	case accessevent.FieldBytesServed:
+		return m.AddedBytesServed()
 	}
 	return nil, false
 }
@@ -929,136 +754,109 @@ func (m *FileMutation) AddedField(name string) (ent.Value, bool) {
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *FileMutation) AddField(name string, value ent.Value) error {
+func (m *AccessEventMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case file.FieldTimesDownloaded:
+	case accessevent.FieldBytesServed:
 		v, ok := value.(int64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddTimesDownloaded(v)
-		return nil
-	case file.FieldExpiryTotalDays:
-		v, ok := value.(int8)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddExpiryTotalDays(v)
-		return nil
-	case file.FieldExpiryDaysSinceLastDownload:
-		v, ok := value.(int8)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddExpiryDaysSinceLastDownload(v)
-		return nil
-	case file.FieldExpiryTotalDownloads:
-		v, ok := value.(int8)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddExpiryTotalDownloads(v)
+		m.AddBytesServed(v)
 		return nil
 	}
-	return fmt.Errorf("unknown File numeric field %s", name)
+	return fmt.Errorf("unknown AccessEvent numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *FileMutation) ClearedFields() []string {
+func (m *AccessEventMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(file.FieldLastDownload) {
-		fields = append(fields, file.FieldLastDownload)
+	if m.FieldCleared(accessevent.FieldFileName) {
+		fields = append(fields, accessevent.FieldFileName)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *FileMutation) FieldCleared(name string) bool {
+func (m *AccessEventMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *FileMutation) ClearField(name string) error {
+func (m *AccessEventMutation) ClearField(name string) error {
 	switch name {
-	case file.FieldLastDownload:
-		m.ClearLastDownload()
+	case accessevent.FieldFileName:
+		m.ClearFileName()
 		return nil
 	}
-	return fmt.Errorf("unknown File nullable field %s", name)
+	return fmt.Errorf("unknown AccessEvent nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *FileMutation) ResetField(name string) error {
+func (m *AccessEventMutation) ResetField(name string) error {
 	switch name {
-	case file.FieldName:
-		m.ResetName()
+	case accessevent.FieldType:
+		m.ResetType()
 		return nil
-	case file.FieldCreatedAt:
+	case accessevent.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
-	case file.FieldLastDownload:
-		m.ResetLastDownload()
-		return nil
-	case file.FieldTimesDownloaded:
-		m.ResetTimesDownloaded()
+	case accessevent.FieldAddress:
+		m.ResetAddress()
 		return nil
-	case file.FieldExpiryType:
-		m.ResetExpiryType()
-		return nil
-	case file.FieldExpiryTotalDays:
-		m.ResetExpiryTotalDays()
+	case accessevent.FieldUserAgent:
+		m.ResetUserAgent()
 		return nil
-	case file.FieldExpiryDaysSinceLastDownload:
-		m.ResetExpiryDaysSinceLastDownload()
+	case accessevent.FieldBytesServed:
+		m.ResetBytesServed()
 		return nil
-	case file.FieldExpiryTotalDownloads:
-		m.ResetExpiryTotalDownloads()
+	case accessevent.FieldFileName:
+		m.ResetFileName()
 		return nil
 	}
-	return fmt.Errorf("unknown File field %s", name)
+	return fmt.Errorf("unknown AccessEvent field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *FileMutation) AddedEdges() []string {
+func (m *AccessEventMutation) AddedEdges() []string {
 	edges := make([]string, 0, 4)
 	if m.ticket != nil {
-		edges = append(edges, file.EdgeTicket)
+		edges = append(edges, accessevent.EdgeTicket)
 	}
 	if m.grant != nil {
-		edges = append(edges, file.EdgeGrant)
+		edges = append(edges, accessevent.EdgeGrant)
 	}
-	if m.owner != nil {
-		edges = append(edges, file.EdgeOwner)
+	if m.recipient != nil {
+		edges = append(edges, accessevent.EdgeRecipient)
 	}
-	if m.data != nil {
-		edges = append(edges, file.EdgeData)
+	if m.file != nil {
+		edges = append(edges, accessevent.EdgeFile)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *FileMutation) AddedIDs(name string) []ent.Value {
+func (m *AccessEventMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case file.EdgeTicket:
+	case accessevent.EdgeTicket:
 		if id := m.ticket; id != nil {
 			return []ent.Value{*id}
 		}
-	case file.EdgeGrant:
+	case accessevent.EdgeGrant:
 		if id := m.grant; id != nil {
 			return []ent.Value{*id}
 		}
-	case file.EdgeOwner:
-		if id := m.owner; id != nil {
+	case accessevent.EdgeRecipient:
+		if id := m.recipient; id != nil {
 			return []ent.Value{*id}
 		}
-	case file.EdgeData:
-		if id := m.data; id != nil {
+	case accessevent.EdgeFile:
+		if id := m.file; id != nil {
 			return []ent.Value{*id}
 		}
 	}
@@ -1066,119 +864,122 @@ func (m *FileMutation) AddedIDs(name string) []ent.Value {
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *FileMutation) RemovedEdges() []string {
+func (m *AccessEventMutation) RemovedEdges() []string {
 	edges := make([]string, 0, 4)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *FileMutation) RemovedIDs(name string) []ent.Value {
+func (m *AccessEventMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *FileMutation) ClearedEdges() []string {
+func (m *AccessEventMutation) ClearedEdges() []string {
 	edges := make([]string, 0, 4)
 	if m.clearedticket {
-		edges = append(edges, file.EdgeTicket)
+		edges = append(edges, accessevent.EdgeTicket)
 	}
 	if m.clearedgrant {
-		edges = append(edges, file.EdgeGrant)
+		edges = append(edges, accessevent.EdgeGrant)
 	}
-	if m.clearedowner {
-		edges = append(edges, file.EdgeOwner)
+	if m.clearedrecipient {
+		edges = append(edges, accessevent.EdgeRecipient)
 	}
-	if m.cleareddata {
-		edges = append(edges, file.EdgeData)
+	if m.clearedfile {
+		edges = append(edges, accessevent.EdgeFile)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *FileMutation) EdgeCleared(name string) bool {
+func (m *AccessEventMutation) EdgeCleared(name string) bool {
 	switch name {
-	case file.EdgeTicket:
+	case accessevent.EdgeTicket:
 		return m.clearedticket
-	case file.EdgeGrant:
+	case accessevent.EdgeGrant:
 		return m.clearedgrant
-	case file.EdgeOwner:
-		return m.clearedowner
-	case file.EdgeData:
-		return m.cleareddata
+	case accessevent.EdgeRecipient:
+		return m.clearedrecipient
+	case accessevent.EdgeFile:
+		return m.clearedfile
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *FileMutation) ClearEdge(name string) error {
+func (m *AccessEventMutation) ClearEdge(name string) error {
 	switch name {
-	case file.EdgeTicket:
+	case accessevent.EdgeTicket:
 		m.ClearTicket()
 		return nil
-	case file.EdgeGrant:
+	case accessevent.EdgeGrant:
 		m.ClearGrant()
 		return nil
-	case file.EdgeOwner:
-		m.ClearOwner()
+	case accessevent.EdgeRecipient:
+		m.ClearRecipient()
 		return nil
-	case file.EdgeData:
-		m.ClearData()
+	case accessevent.EdgeFile:
+		m.ClearFile()
 		return nil
 	}
-	return fmt.Errorf("unknown File unique edge %s", name)
+	return fmt.Errorf("unknown AccessEvent unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *FileMutation) ResetEdge(name string) error {
+func (m *AccessEventMutation) ResetEdge(name string) error {
 	switch name {
-	case file.EdgeTicket:
+	case accessevent.EdgeTicket:
 		m.ResetTicket()
 		return nil
-	case file.EdgeGrant:
+	case accessevent.EdgeGrant:
 		m.ResetGrant()
 		return nil
-	case file.EdgeOwner:
-		m.ResetOwner()
+	case accessevent.EdgeRecipient:
+		m.ResetRecipient()
 		return nil
-	case file.EdgeData:
-		m.ResetData()
+	case accessevent.EdgeFile:
+		m.ResetFile()
 		return nil
 	}
-	return fmt.Errorf("unknown File edge %s", name)
+	return fmt.Errorf("unknown AccessEvent edge %s", name)
 }
 
-// FileDataMutation represents an operation that mutates the FileData nodes in the graph.
-type FileDataMutation struct {
+// DownloadProgressMutation represents an operation that mutates the DownloadProgress nodes in the graph.
+type DownloadProgressMutation struct {
 	config
 	op            Op
 	typ           string
-	id            *string
-	size          *uint64
-	addsize       *int64
+	id            *int
+	token         *string
+	ranges        *[][2]int64
+	appendranges  [][2]int64
+	updated_at    *time.Time
+	version       *int64
+	addversion    *int64
 	clearedFields map[string]struct{}
-	files         map[uuid.UUID]struct{}
-	removedfiles  map[uuid.UUID]struct{}
-	clearedfiles  bool
+	file          *uuid.UUID
+	clearedfile   bool
 	done          bool
-	oldValue      func(context.Context) (*FileData, error)
-	predicates    []predicate.FileData
+	oldValue      func(context.Context) (*DownloadProgress, error)
+	predicates    []predicate.DownloadProgress
 }
 
-var _ ent.Mutation = (*FileDataMutation)(nil)
+var _ ent.Mutation = (*DownloadProgressMutation)(nil)
 
-// filedataOption allows management of the mutation configuration using functional options.
-type filedataOption func(*FileDataMutation)
+// downloadprogressOption allows management of the mutation configuration using functional options.
+type downloadprogressOption func(*DownloadProgressMutation)
 
-// newFileDataMutation creates new mutation for the FileData entity.
-func newFileDataMutation(c config, op Op, opts ...filedataOption) *FileDataMutation {
-	m := &FileDataMutation{
+// newDownloadProgressMutation creates new mutation for the DownloadProgress entity.
+func newDownloadProgressMutation(c config, op Op, opts ...downloadprogressOption) *DownloadProgressMutation {
+	m := &DownloadProgressMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeFileData,
+		typ:           TypeDownloadProgress,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -1187,20 +988,20 @@ func newFileDataMutation(c config, op Op, opts ...filedataOption) *FileDataMutat
 	return m
 }
 
-// withFileDataID sets the ID field of the mutation.
-func withFileDataID(id string) filedataOption {
-	return func(m *FileDataMutation) {
+// withDownloadProgressID sets the ID field of the mutation.
+func withDownloadProgressID(id int) downloadprogressOption {
+	return func(m *DownloadProgressMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *FileData
+			value *DownloadProgress
 		)
-		m.oldValue = func(ctx context.Context) (*FileData, error) {
+		m.oldValue = func(ctx context.Context) (*DownloadProgress, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().FileData.Get(ctx, id)
+					value, err = m.Client().DownloadProgress.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -1209,10 +1010,10 @@ func withFileDataID(id string) filedataOption {
 	}
 }
 
-// withFileData sets the old FileData of the mutation.
-func withFileData(node *FileData) filedataOption {
-	return func(m *FileDataMutation) {
-		m.oldValue = func(context.Context) (*FileData, error) {
+// withDownloadProgress sets the old DownloadProgress of the mutation.
+func withDownloadProgress(node *DownloadProgress) downloadprogressOption {
+	return func(m *DownloadProgressMutation) {
+		m.oldValue = func(context.Context) (*DownloadProgress, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -1221,7 +1022,7 @@ func withFileData(node *FileData) filedataOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m FileDataMutation) Client() *Client {
+func (m DownloadProgressMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -1229,7 +1030,7 @@ func (m FileDataMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m FileDataMutation) Tx() (*Tx, error) {
+func (m DownloadProgressMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -1238,15 +1039,9 @@ func (m FileDataMutation) Tx() (*Tx, error) {
 	return tx, nil
 }
 
-// SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of FileData entities.
-func (m *FileDataMutation) SetID(id string) {
-	m.id = &id
-}
-
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *FileDataMutation) ID() (id string, exists bool) {
+func (m *DownloadProgressMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -1257,140 +1052,262 @@ func (m *FileDataMutation) ID() (id string, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *FileDataMutation) IDs(ctx context.Context) ([]string, error) {
+func (m *DownloadProgressMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
 		if exists {
-			return []string{id}, nil
+			return []int{id}, nil
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().FileData.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().DownloadProgress.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetSize sets the "size" field.
-func (m *FileDataMutation) SetSize(u uint64) {
-	m.size = &u
-	m.addsize = nil
+// SetToken sets the "token" field.
+func (m *DownloadProgressMutation) SetToken(s string) {
+	m.token = &s
 }
 
-// Size returns the value of the "size" field in the mutation.
-func (m *FileDataMutation) Size() (r uint64, exists bool) {
-	v := m.size
+// Token returns the value of the "token" field in the mutation.
+func (m *DownloadProgressMutation) Token() (r string, exists bool) {
+	v := m.token
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldSize returns the old "size" field's value of the FileData entity.
-// If the FileData object wasn't provided to the builder, the object is fetched from the database.
+// OldToken returns the old "token" field's value of the DownloadProgress entity.
+// If the DownloadProgress object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *FileDataMutation) OldSize(ctx context.Context) (v uint64, err error) {
+func (m *DownloadProgressMutation) OldToken(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldSize is only allowed on UpdateOne operations")
+		return v, errors.New("OldToken is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldSize requires an ID field in the mutation")
+		return v, errors.New("OldToken requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldSize: %w", err)
+		return v, fmt.Errorf("querying old value for OldToken: %w", err)
 	}
-	return oldValue.Size, nil
+	return oldValue.Token, nil
 }
 
-// AddSize adds u to the "size" field.
-func (m *FileDataMutation) AddSize(u int64) {
-	if m.addsize != nil {
-		*m.addsize += u
-	} else {
-		m.addsize = &u
-	}
+// ResetToken resets all changes to the "token" field.
+func (m *DownloadProgressMutation) ResetToken() {
+	m.token = nil
 }
 
-// AddedSize returns the value that was added to the "size" field in this mutation.
-func (m *FileDataMutation) AddedSize() (r int64, exists bool) {
-	v := m.addsize
+// SetRanges sets the "ranges" field.
+func (m *DownloadProgressMutation) SetRanges(i [][2]int64) {
+	m.ranges = &i
+	m.appendranges = nil
+}
+
+// Ranges returns the value of the "ranges" field in the mutation.
+func (m *DownloadProgressMutation) Ranges() (r [][2]int64, exists bool) {
+	v := m.ranges
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ResetSize resets all changes to the "size" field.
-func (m *FileDataMutation) ResetSize() {
-	m.size = nil
-	m.addsize = nil
+// OldRanges returns the old "ranges" field's value of the DownloadProgress entity.
+// If the DownloadProgress object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DownloadProgressMutation) OldRanges(ctx context.Context) (v [][2]int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRanges is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRanges requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRanges: %w", err)
+	}
+	return oldValue.Ranges, nil
 }
 
-// AddFileIDs adds the "files" edge to the File entity by ids.
-func (m *FileDataMutation) AddFileIDs(ids ...uuid.UUID) {
-	if m.files == nil {
-		m.files = make(map[uuid.UUID]struct{})
+// AppendRanges adds i to the "ranges" field.
+func (m *DownloadProgressMutation) AppendRanges(i [][2]int64) {
+	m.appendranges = append(m.appendranges, i...)
+}
+
+// AppendedRanges returns the list of values that were appended to the "ranges" field in this mutation.
+func (m *DownloadProgressMutation) AppendedRanges() ([][2]int64, bool) {
+	if len(m.appendranges) == 0 {
+		return nil, false
 	}
-	for i := range ids {
-		m.files[ids[i]] = struct{}{}
+	return m.appendranges, true
+}
+
+// ClearRanges clears the value of the "ranges" field.
+func (m *DownloadProgressMutation) ClearRanges() {
+	m.ranges = nil
+	m.appendranges = nil
+	m.clearedFields[downloadprogress.FieldRanges] = struct{}{}
+}
+
+// RangesCleared returns if the "ranges" field was cleared in this mutation.
+func (m *DownloadProgressMutation) RangesCleared() bool {
+	_, ok := m.clearedFields[downloadprogress.FieldRanges]
+	return ok
+}
+
+// ResetRanges resets all changes to the "ranges" field.
+func (m *DownloadProgressMutation) ResetRanges() {
+	m.ranges = nil
+	m.appendranges = nil
+	delete(m.clearedFields, downloadprogress.FieldRanges)
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *DownloadProgressMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *DownloadProgressMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
 	}
+	return *v, true
 }
 
-// ClearFiles clears the "files" edge to the File entity.
-func (m *FileDataMutation) ClearFiles() {
-	m.clearedfiles = true
+// OldUpdatedAt returns the old "updated_at" field's value of the DownloadProgress entity.
+// If the DownloadProgress object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DownloadProgressMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
 }
 
-// FilesCleared reports if the "files" edge to the File entity was cleared.
-func (m *FileDataMutation) FilesCleared() bool {
-	return m.clearedfiles
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *DownloadProgressMutation) ResetUpdatedAt() {
+	m.updated_at = nil
 }
 
-// RemoveFileIDs removes the "files" edge to the File entity by IDs.
-func (m *FileDataMutation) RemoveFileIDs(ids ...uuid.UUID) {
-	if m.removedfiles == nil {
-		m.removedfiles = make(map[uuid.UUID]struct{})
+// SetVersion sets the "version" field.
+func (m *DownloadProgressMutation) SetVersion(i int64) {
+	m.version = &i
+	m.addversion = nil
+}
+
+// Version returns the value of the "version" field in the mutation.
+func (m *DownloadProgressMutation) Version() (r int64, exists bool) {
+	v := m.version
+	if v == nil {
+		return
 	}
-	for i := range ids {
-		delete(m.files, ids[i])
-		m.removedfiles[ids[i]] = struct{}{}
+	return *v, true
+}
+
+// OldVersion returns the old "version" field's value of the DownloadProgress entity.
+// If the DownloadProgress object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DownloadProgressMutation) OldVersion(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldVersion is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldVersion requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldVersion: %w", err)
 	}
+	return oldValue.Version, nil
 }
 
-// RemovedFiles returns the removed IDs of the "files" edge to the File entity.
-func (m *FileDataMutation) RemovedFilesIDs() (ids []uuid.UUID) {
-	for id := range m.removedfiles {
-		ids = append(ids, id)
+// AddVersion adds i to the "version" field.
+func (m *DownloadProgressMutation) AddVersion(i int64) {
+	if m.addversion != nil {
+		*m.addversion += i
+	} else {
+		m.addversion = &i
+	}
+}
+
+// AddedVersion returns the value that was added to the "version" field in this mutation.
+func (m *DownloadProgressMutation) AddedVersion() (r int64, exists bool) {
+	v := m.addversion
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetVersion resets all changes to the "version" field.
+func (m *DownloadProgressMutation) ResetVersion() {
+	m.version = nil
+	m.addversion = nil
+}
+
+// SetFileID sets the "file" edge to the File entity by id.
+func (m *DownloadProgressMutation) SetFileID(id uuid.UUID) {
+	m.file = &id
+}
+
+// ClearFile clears the "file" edge to the File entity.
+func (m *DownloadProgressMutation) ClearFile() {
+	m.clearedfile = true
+}
+
+// FileCleared reports if the "file" edge to the File entity was cleared.
+func (m *DownloadProgressMutation) FileCleared() bool {
+	return m.clearedfile
+}
+
+// FileID returns the "file" edge ID in the mutation.
+func (m *DownloadProgressMutation) FileID() (id uuid.UUID, exists bool) {
+	if m.file != nil {
+		return *m.file, true
 	}
 	return
 }
 
-// FilesIDs returns the "files" edge IDs in the mutation.
-func (m *FileDataMutation) FilesIDs() (ids []uuid.UUID) {
-	for id := range m.files {
-		ids = append(ids, id)
+// FileIDs returns the "file" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// FileID instead. It exists only for internal usage by the builders.
+func (m *DownloadProgressMutation) FileIDs() (ids []uuid.UUID) {
+	if id := m.file; id != nil {
+		ids = append(ids, *id)
 	}
 	return
 }
 
-// ResetFiles resets all changes to the "files" edge.
-func (m *FileDataMutation) ResetFiles() {
-	m.files = nil
-	m.clearedfiles = false
-	m.removedfiles = nil
+// ResetFile resets all changes to the "file" edge.
+func (m *DownloadProgressMutation) ResetFile() {
+	m.file = nil
+	m.clearedfile = false
 }
 
-// Where appends a list predicates to the FileDataMutation builder.
-func (m *FileDataMutation) Where(ps ...predicate.FileData) {
+// Where appends a list predicates to the DownloadProgressMutation builder.
+func (m *DownloadProgressMutation) Where(ps ...predicate.DownloadProgress) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the FileDataMutation builder. Using this method,
+// WhereP appends storage-level predicates to the DownloadProgressMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *FileDataMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.FileData, len(ps))
+func (m *DownloadProgressMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.DownloadProgress, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -1398,27 +1315,36 @@ func (m *FileDataMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *FileDataMutation) Op() Op {
+func (m *DownloadProgressMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *FileDataMutation) SetOp(op Op) {
+func (m *DownloadProgressMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (FileData).
-func (m *FileDataMutation) Type() string {
+// Type returns the node type of this mutation (DownloadProgress).
+func (m *DownloadProgressMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *FileDataMutation) Fields() []string {
-	fields := make([]string, 0, 1)
-	if m.size != nil {
-		fields = append(fields, filedata.FieldSize)
+func (m *DownloadProgressMutation) Fields() []string {
+	fields := make([]string, 0, 4)
+	if m.token != nil {
+		fields = append(fields, downloadprogress.FieldToken)
+	}
+	if m.ranges != nil {
+		fields = append(fields, downloadprogress.FieldRanges)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, downloadprogress.FieldUpdatedAt)
+	}
+	if m.version != nil {
+		fields = append(fields, downloadprogress.FieldVersion)
 	}
 	return fields
 }
@@ -1426,10 +1352,16 @@ func (m *FileDataMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *FileDataMutation) Field(name string) (ent.Value, bool) {
+func (m *DownloadProgressMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case filedata.FieldSize:
-		return m.Size()
+	case downloadprogress.FieldToken:
+		return m.Token()
+	case downloadprogress.FieldRanges:
+		return m.Ranges()
+	case downloadprogress.FieldUpdatedAt:
+		return m.UpdatedAt()
+	case downloadprogress.FieldVersion:
+		return m.Version()
 	}
 	return nil, false
 }
@@ -1437,36 +1369,63 @@ func (m *FileDataMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *FileDataMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *DownloadProgressMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case filedata.FieldSize:
-		return m.OldSize(ctx)
+	case downloadprogress.FieldToken:
+		return m.OldToken(ctx)
+	case downloadprogress.FieldRanges:
+		return m.OldRanges(ctx)
+	case downloadprogress.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	case downloadprogress.FieldVersion:
+		return m.OldVersion(ctx)
 	}
-	return nil, fmt.Errorf("unknown FileData field %s", name)
+	return nil, fmt.Errorf("unknown DownloadProgress field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *FileDataMutation) SetField(name string, value ent.Value) error {
+func (m *DownloadProgressMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case filedata.FieldSize:
-		v, ok := value.(uint64)
+	case downloadprogress.FieldToken:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetSize(v)
+		m.SetToken(v)
 		return nil
-	}
-	return fmt.Errorf("unknown FileData field %s", name)
-}
-
-// AddedFields returns all numeric fields that were incremented/decremented during
+	case downloadprogress.FieldRanges:
+		v, ok := value.([][2]int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRanges(v)
+		return nil
+	case downloadprogress.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	case downloadprogress.FieldVersion:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetVersion(v)
+		return nil
+	}
+	return fmt.Errorf("unknown DownloadProgress field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *FileDataMutation) AddedFields() []string {
+func (m *DownloadProgressMutation) AddedFields() []string {
 	var fields []string
-	if m.addsize != nil {
-		fields = append(fields, filedata.FieldSize)
+	if m.addversion != nil {
+		fields = append(fields, downloadprogress.FieldVersion)
 	}
 	return fields
 }
@@ -1474,10 +1433,10 @@ func (m *FileDataMutation) AddedFields() []string {
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *FileDataMutation) AddedField(name string) (ent.Value, bool) {
+func (m *DownloadProgressMutation) AddedField(name string) (ent.Value, bool) {
 	switch name {
-	case filedata.FieldSize:
-		return m.AddedSize()
+	case downloadprogress.FieldVersion:
+		return m.AddedVersion()
 	}
 	return nil, false
 }
@@ -1485,188 +1444,196 @@ func (m *FileDataMutation) AddedField(name string) (ent.Value, bool) {
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *FileDataMutation) AddField(name string, value ent.Value) error {
+func (m *DownloadProgressMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case filedata.FieldSize:
+	case downloadprogress.FieldVersion:
 		v, ok := value.(int64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddSize(v)
+		m.AddVersion(v)
 		return nil
 	}
-	return fmt.Errorf("unknown FileData numeric field %s", name)
+	return fmt.Errorf("unknown DownloadProgress numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *FileDataMutation) ClearedFields() []string {
-	return nil
+func (m *DownloadProgressMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(downloadprogress.FieldRanges) {
+		fields = append(fields, downloadprogress.FieldRanges)
+	}
+	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *FileDataMutation) FieldCleared(name string) bool {
+func (m *DownloadProgressMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *FileDataMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown FileData nullable field %s", name)
+func (m *DownloadProgressMutation) ClearField(name string) error {
+	switch name {
+	case downloadprogress.FieldRanges:
+		m.ClearRanges()
+		return nil
+	}
+	return fmt.Errorf("unknown DownloadProgress nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *FileDataMutation) ResetField(name string) error {
+func (m *DownloadProgressMutation) ResetField(name string) error {
 	switch name {
-	case filedata.FieldSize:
-		m.ResetSize()
+	case downloadprogress.FieldToken:
+		m.ResetToken()
+		return nil
+	case downloadprogress.FieldRanges:
+		m.ResetRanges()
+		return nil
+	case downloadprogress.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	case downloadprogress.FieldVersion:
+		m.ResetVersion()
 		return nil
 	}
-	return fmt.Errorf("unknown FileData field %s", name)
+	return fmt.Errorf("unknown DownloadProgress field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *FileDataMutation) AddedEdges() []string {
+func (m *DownloadProgressMutation) AddedEdges() []string {
 	edges := make([]string, 0, 1)
-	if m.files != nil {
-		edges = append(edges, filedata.EdgeFiles)
+	if m.file != nil {
+		edges = append(edges, downloadprogress.EdgeFile)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *FileDataMutation) AddedIDs(name string) []ent.Value {
+func (m *DownloadProgressMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case filedata.EdgeFiles:
-		ids := make([]ent.Value, 0, len(m.files))
-		for id := range m.files {
-			ids = append(ids, id)
+	case downloadprogress.EdgeFile:
+		if id := m.file; id != nil {
+			return []ent.Value{*id}
 		}
-		return ids
 	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *FileDataMutation) RemovedEdges() []string {
+func (m *DownloadProgressMutation) RemovedEdges() []string {
 	edges := make([]string, 0, 1)
-	if m.removedfiles != nil {
-		edges = append(edges, filedata.EdgeFiles)
-	}
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *FileDataMutation) RemovedIDs(name string) []ent.Value {
-	switch name {
-	case filedata.EdgeFiles:
-		ids := make([]ent.Value, 0, len(m.removedfiles))
-		for id := range m.removedfiles {
-			ids = append(ids, id)
-		}
-		return ids
-	}
+func (m *DownloadProgressMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *FileDataMutation) ClearedEdges() []string {
+func (m *DownloadProgressMutation) ClearedEdges() []string {
 	edges := make([]string, 0, 1)
-	if m.clearedfiles {
-		edges = append(edges, filedata.EdgeFiles)
+	if m.clearedfile {
+		edges = append(edges, downloadprogress.EdgeFile)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *FileDataMutation) EdgeCleared(name string) bool {
+func (m *DownloadProgressMutation) EdgeCleared(name string) bool {
 	switch name {
-	case filedata.EdgeFiles:
-		return m.clearedfiles
+	case downloadprogress.EdgeFile:
+		return m.clearedfile
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *FileDataMutation) ClearEdge(name string) error {
+func (m *DownloadProgressMutation) ClearEdge(name string) error {
 	switch name {
+	case downloadprogress.EdgeFile:
+		m.ClearFile()
+		return nil
 	}
-	return fmt.Errorf("unknown FileData unique edge %s", name)
+	return fmt.Errorf("unknown DownloadProgress unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *FileDataMutation) ResetEdge(name string) error {
+func (m *DownloadProgressMutation) ResetEdge(name string) error {
 	switch name {
-	case filedata.EdgeFiles:
-		m.ResetFiles()
+	case downloadprogress.EdgeFile:
+		m.ResetFile()
 		return nil
 	}
-	return fmt.Errorf("unknown FileData edge %s", name)
+	return fmt.Errorf("unknown DownloadProgress edge %s", name)
 }
 
-// GrantMutation represents an operation that mutates the Grant nodes in the graph.
-type GrantMutation struct {
+// FileMutation represents an operation that mutates the File nodes in the graph.
+type FileMutation struct {
 	config
-	op                                      Op
-	typ                                     string
-	id                                      *uuid.UUID
-	comment                                 *string
-	expiry_type                             *string
-	hashed_password                         *string
-	salt                                    *string
-	created_at                              *time.Time
-	expiry_total_days                       *uint8
-	addexpiry_total_days                    *int8
-	expiry_days_since_last_upload           *uint8
-	addexpiry_days_since_last_upload        *int8
-	expiry_total_uploads                    *uint8
-	addexpiry_total_uploads                 *int8
-	file_expiry_type                        *string
-	file_expiry_total_days                  *uint8
-	addfile_expiry_total_days               *int8
-	file_expiry_days_since_last_download    *uint8
-	addfile_expiry_days_since_last_download *int8
-	file_expiry_total_downloads             *uint8
-	addfile_expiry_total_downloads          *int8
-	last_upload                             *time.Time
-	times_uploaded                          *uint64
-	addtimes_uploaded                       *int64
-	email_on_upload                         *[]string
-	appendemail_on_upload                   []string
-	creator_lang                            *string
-	clearedFields                           map[string]struct{}
-	files                                   map[uuid.UUID]struct{}
-	removedfiles                            map[uuid.UUID]struct{}
-	clearedfiles                            bool
-	owner                                   *uuid.UUID
-	clearedowner                            bool
-	shareaccesstokens                       map[string]struct{}
-	removedshareaccesstokens                map[string]struct{}
-	clearedshareaccesstokens                bool
-	done                                    bool
-	oldValue                                func(context.Context) (*Grant, error)
-	predicates                              []predicate.Grant
+	op                                 Op
+	typ                                string
+	id                                 *uuid.UUID
+	name                               *string
+	created_at                         *time.Time
+	last_download                      *time.Time
+	times_downloaded                   *uint64
+	addtimes_downloaded                *int64
+	last_view                          *time.Time
+	times_viewed                       *uint64
+	addtimes_viewed                    *int64
+	expiry_type                        *string
+	expiry_total_days                  *uint8
+	addexpiry_total_days               *int8
+	expiry_days_since_last_download    *uint8
+	addexpiry_days_since_last_download *int8
+	expiry_total_downloads             *uint8
+	addexpiry_total_downloads          *int8
+	scan_status                        *string
+	scan_signature                     *string
+	mime_type                          *string
+	clearedFields                      map[string]struct{}
+	ticket                             *uuid.UUID
+	clearedticket                      bool
+	grant                              *uuid.UUID
+	clearedgrant                       bool
+	owner                              *uuid.UUID
+	clearedowner                       bool
+	data                               *string
+	cleareddata                        bool
+	download_progresses                map[int]struct{}
+	removeddownload_progresses         map[int]struct{}
+	cleareddownload_progresses         bool
+	access_events                      map[int]struct{}
+	removedaccess_events               map[int]struct{}
+	clearedaccess_events               bool
+	done                               bool
+	oldValue                           func(context.Context) (*File, error)
+	predicates                         []predicate.File
 }
 
-var _ ent.Mutation = (*GrantMutation)(nil)
+var _ ent.Mutation = (*FileMutation)(nil)
 
-// grantOption allows management of the mutation configuration using functional options.
-type grantOption func(*GrantMutation)
+// fileOption allows management of the mutation configuration using functional options.
+type fileOption func(*FileMutation)
 
-// newGrantMutation creates new mutation for the Grant entity.
-func newGrantMutation(c config, op Op, opts ...grantOption) *GrantMutation {
-	m := &GrantMutation{
+// newFileMutation creates new mutation for the File entity.
+func newFileMutation(c config, op Op, opts ...fileOption) *FileMutation {
+	m := &FileMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeGrant,
+		typ:           TypeFile,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -1675,20 +1642,20 @@ func newGrantMutation(c config, op Op, opts ...grantOption) *GrantMutation {
 	return m
 }
 
-// withGrantID sets the ID field of the mutation.
-func withGrantID(id uuid.UUID) grantOption {
-	return func(m *GrantMutation) {
+// withFileID sets the ID field of the mutation.
+func withFileID(id uuid.UUID) fileOption {
+	return func(m *FileMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Grant
+			value *File
 		)
-		m.oldValue = func(ctx context.Context) (*Grant, error) {
+		m.oldValue = func(ctx context.Context) (*File, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Grant.Get(ctx, id)
+					value, err = m.Client().File.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -1697,10 +1664,10 @@ func withGrantID(id uuid.UUID) grantOption {
 	}
 }
 
-// withGrant sets the old Grant of the mutation.
-func withGrant(node *Grant) grantOption {
-	return func(m *GrantMutation) {
-		m.oldValue = func(context.Context) (*Grant, error) {
+// withFile sets the old File of the mutation.
+func withFile(node *File) fileOption {
+	return func(m *FileMutation) {
+		m.oldValue = func(context.Context) (*File, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -1709,7 +1676,7 @@ func withGrant(node *Grant) grantOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m GrantMutation) Client() *Client {
+func (m FileMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -1717,7 +1684,7 @@ func (m GrantMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m GrantMutation) Tx() (*Tx, error) {
+func (m FileMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -1727,14 +1694,14 @@ func (m GrantMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of Grant entities.
-func (m *GrantMutation) SetID(id uuid.UUID) {
+// operation is only accepted on creation of File entities.
+func (m *FileMutation) SetID(id uuid.UUID) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *GrantMutation) ID() (id uuid.UUID, exists bool) {
+func (m *FileMutation) ID() (id uuid.UUID, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -1745,7 +1712,7 @@ func (m *GrantMutation) ID() (id uuid.UUID, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *GrantMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+func (m *FileMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -1754,213 +1721,338 @@ func (m *GrantMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Grant.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().File.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetComment sets the "comment" field.
-func (m *GrantMutation) SetComment(s string) {
-	m.comment = &s
+// SetName sets the "name" field.
+func (m *FileMutation) SetName(s string) {
+	m.name = &s
 }
 
-// Comment returns the value of the "comment" field in the mutation.
-func (m *GrantMutation) Comment() (r string, exists bool) {
-	v := m.comment
+// Name returns the value of the "name" field in the mutation.
+func (m *FileMutation) Name() (r string, exists bool) {
+	v := m.name
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldComment returns the old "comment" field's value of the Grant entity.
-// If the Grant object wasn't provided to the builder, the object is fetched from the database.
+// OldName returns the old "name" field's value of the File entity.
+// If the File object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *GrantMutation) OldComment(ctx context.Context) (v *string, err error) {
+func (m *FileMutation) OldName(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldComment is only allowed on UpdateOne operations")
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldComment requires an ID field in the mutation")
+		return v, errors.New("OldName requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldComment: %w", err)
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
 	}
-	return oldValue.Comment, nil
-}
-
-// ClearComment clears the value of the "comment" field.
-func (m *GrantMutation) ClearComment() {
-	m.comment = nil
-	m.clearedFields[grant.FieldComment] = struct{}{}
+	return oldValue.Name, nil
 }
 
-// CommentCleared returns if the "comment" field was cleared in this mutation.
-func (m *GrantMutation) CommentCleared() bool {
-	_, ok := m.clearedFields[grant.FieldComment]
-	return ok
+// ResetName resets all changes to the "name" field.
+func (m *FileMutation) ResetName() {
+	m.name = nil
 }
 
-// ResetComment resets all changes to the "comment" field.
-func (m *GrantMutation) ResetComment() {
-	m.comment = nil
-	delete(m.clearedFields, grant.FieldComment)
+// SetCreatedAt sets the "created_at" field.
+func (m *FileMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
 }
 
-// SetExpiryType sets the "expiry_type" field.
-func (m *GrantMutation) SetExpiryType(s string) {
-	m.expiry_type = &s
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *FileMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// ExpiryType returns the value of the "expiry_type" field in the mutation.
-func (m *GrantMutation) ExpiryType() (r string, exists bool) {
-	v := m.expiry_type
+// OldCreatedAt returns the old "created_at" field's value of the File entity.
+// If the File object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FileMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *FileMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetLastDownload sets the "last_download" field.
+func (m *FileMutation) SetLastDownload(t time.Time) {
+	m.last_download = &t
+}
+
+// LastDownload returns the value of the "last_download" field in the mutation.
+func (m *FileMutation) LastDownload() (r time.Time, exists bool) {
+	v := m.last_download
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldExpiryType returns the old "expiry_type" field's value of the Grant entity.
-// If the Grant object wasn't provided to the builder, the object is fetched from the database.
+// OldLastDownload returns the old "last_download" field's value of the File entity.
+// If the File object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *GrantMutation) OldExpiryType(ctx context.Context) (v string, err error) {
+func (m *FileMutation) OldLastDownload(ctx context.Context) (v *time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldExpiryType is only allowed on UpdateOne operations")
+		return v, errors.New("OldLastDownload is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldExpiryType requires an ID field in the mutation")
+		return v, errors.New("OldLastDownload requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldExpiryType: %w", err)
+		return v, fmt.Errorf("querying old value for OldLastDownload: %w", err)
 	}
-	return oldValue.ExpiryType, nil
+	return oldValue.LastDownload, nil
 }
 
-// ResetExpiryType resets all changes to the "expiry_type" field.
-func (m *GrantMutation) ResetExpiryType() {
-	m.expiry_type = nil
+// ClearLastDownload clears the value of the "last_download" field.
+func (m *FileMutation) ClearLastDownload() {
+	m.last_download = nil
+	m.clearedFields[file.FieldLastDownload] = struct{}{}
 }
 
-// SetHashedPassword sets the "hashed_password" field.
-func (m *GrantMutation) SetHashedPassword(s string) {
-	m.hashed_password = &s
+// LastDownloadCleared returns if the "last_download" field was cleared in this mutation.
+func (m *FileMutation) LastDownloadCleared() bool {
+	_, ok := m.clearedFields[file.FieldLastDownload]
+	return ok
 }
 
-// HashedPassword returns the value of the "hashed_password" field in the mutation.
-func (m *GrantMutation) HashedPassword() (r string, exists bool) {
-	v := m.hashed_password
+// ResetLastDownload resets all changes to the "last_download" field.
+func (m *FileMutation) ResetLastDownload() {
+	m.last_download = nil
+	delete(m.clearedFields, file.FieldLastDownload)
+}
+
+// SetTimesDownloaded sets the "times_downloaded" field.
+func (m *FileMutation) SetTimesDownloaded(u uint64) {
+	m.times_downloaded = &u
+	m.addtimes_downloaded = nil
+}
+
+// TimesDownloaded returns the value of the "times_downloaded" field in the mutation.
+func (m *FileMutation) TimesDownloaded() (r uint64, exists bool) {
+	v := m.times_downloaded
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldHashedPassword returns the old "hashed_password" field's value of the Grant entity.
-// If the Grant object wasn't provided to the builder, the object is fetched from the database.
+// OldTimesDownloaded returns the old "times_downloaded" field's value of the File entity.
+// If the File object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *GrantMutation) OldHashedPassword(ctx context.Context) (v string, err error) {
+func (m *FileMutation) OldTimesDownloaded(ctx context.Context) (v uint64, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldHashedPassword is only allowed on UpdateOne operations")
+		return v, errors.New("OldTimesDownloaded is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldHashedPassword requires an ID field in the mutation")
+		return v, errors.New("OldTimesDownloaded requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldHashedPassword: %w", err)
+		return v, fmt.Errorf("querying old value for OldTimesDownloaded: %w", err)
 	}
-	return oldValue.HashedPassword, nil
+	return oldValue.TimesDownloaded, nil
 }
 
-// ResetHashedPassword resets all changes to the "hashed_password" field.
-func (m *GrantMutation) ResetHashedPassword() {
-	m.hashed_password = nil
+// AddTimesDownloaded adds u to the "times_downloaded" field.
+func (m *FileMutation) AddTimesDownloaded(u int64) {
+	if m.addtimes_downloaded != nil {
+		*m.addtimes_downloaded += u
+	} else {
+		m.addtimes_downloaded = &u
+	}
 }
 
-// SetSalt sets the "salt" field.
-func (m *GrantMutation) SetSalt(s string) {
-	m.salt = &s
+// AddedTimesDownloaded returns the value that was added to the "times_downloaded" field in this mutation.
+func (m *FileMutation) AddedTimesDownloaded() (r int64, exists bool) {
+	v := m.addtimes_downloaded
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// Salt returns the value of the "salt" field in the mutation.
-func (m *GrantMutation) Salt() (r string, exists bool) {
-	v := m.salt
+// ResetTimesDownloaded resets all changes to the "times_downloaded" field.
+func (m *FileMutation) ResetTimesDownloaded() {
+	m.times_downloaded = nil
+	m.addtimes_downloaded = nil
+}
+
+// SetLastView sets the "last_view" field.
+func (m *FileMutation) SetLastView(t time.Time) {
+	m.last_view = &t
+}
+
+// LastView returns the value of the "last_view" field in the mutation.
+func (m *FileMutation) LastView() (r time.Time, exists bool) {
+	v := m.last_view
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldSalt returns the old "salt" field's value of the Grant entity.
-// If the Grant object wasn't provided to the builder, the object is fetched from the database.
+// OldLastView returns the old "last_view" field's value of the File entity.
+// If the File object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *GrantMutation) OldSalt(ctx context.Context) (v string, err error) {
+func (m *FileMutation) OldLastView(ctx context.Context) (v *time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldSalt is only allowed on UpdateOne operations")
+		return v, errors.New("OldLastView is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldSalt requires an ID field in the mutation")
+		return v, errors.New("OldLastView requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldSalt: %w", err)
+		return v, fmt.Errorf("querying old value for OldLastView: %w", err)
 	}
-	return oldValue.Salt, nil
+	return oldValue.LastView, nil
 }
 
-// ResetSalt resets all changes to the "salt" field.
-func (m *GrantMutation) ResetSalt() {
-	m.salt = nil
+// ClearLastView clears the value of the "last_view" field.
+func (m *FileMutation) ClearLastView() {
+	m.last_view = nil
+	m.clearedFields[file.FieldLastView] = struct{}{}
 }
 
-// SetCreatedAt sets the "created_at" field.
-func (m *GrantMutation) SetCreatedAt(t time.Time) {
-	m.created_at = &t
+// LastViewCleared returns if the "last_view" field was cleared in this mutation.
+func (m *FileMutation) LastViewCleared() bool {
+	_, ok := m.clearedFields[file.FieldLastView]
+	return ok
 }
 
-// CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *GrantMutation) CreatedAt() (r time.Time, exists bool) {
-	v := m.created_at
+// ResetLastView resets all changes to the "last_view" field.
+func (m *FileMutation) ResetLastView() {
+	m.last_view = nil
+	delete(m.clearedFields, file.FieldLastView)
+}
+
+// SetTimesViewed sets the "times_viewed" field.
+func (m *FileMutation) SetTimesViewed(u uint64) {
+	m.times_viewed = &u
+	m.addtimes_viewed = nil
+}
+
+// TimesViewed returns the value of the "times_viewed" field in the mutation.
+func (m *FileMutation) TimesViewed() (r uint64, exists bool) {
+	v := m.times_viewed
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the Grant entity.
-// If the Grant object wasn't provided to the builder, the object is fetched from the database.
+// OldTimesViewed returns the old "times_viewed" field's value of the File entity.
+// If the File object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *GrantMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *FileMutation) OldTimesViewed(ctx context.Context) (v uint64, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldTimesViewed is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+		return v, errors.New("OldTimesViewed requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldTimesViewed: %w", err)
 	}
-	return oldValue.CreatedAt, nil
+	return oldValue.TimesViewed, nil
 }
 
-// ResetCreatedAt resets all changes to the "created_at" field.
-func (m *GrantMutation) ResetCreatedAt() {
-	m.created_at = nil
+// AddTimesViewed adds u to the "times_viewed" field.
+func (m *FileMutation) AddTimesViewed(u int64) {
+	if m.addtimes_viewed != nil {
+		*m.addtimes_viewed += u
+	} else {
+		m.addtimes_viewed = &u
+	}
+}
+
+// AddedTimesViewed returns the value that was added to the "times_viewed" field in this mutation.
+func (m *FileMutation) AddedTimesViewed() (r int64, exists bool) {
+	v := m.addtimes_viewed
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetTimesViewed resets all changes to the "times_viewed" field.
+func (m *FileMutation) ResetTimesViewed() {
+	m.times_viewed = nil
+	m.addtimes_viewed = nil
+}
+
+// SetExpiryType sets the "expiry_type" field.
+func (m *FileMutation) SetExpiryType(s string) {
+	m.expiry_type = &s
+}
+
+// ExpiryType returns the value of the "expiry_type" field in the mutation.
+func (m *FileMutation) ExpiryType() (r string, exists bool) {
+	v := m.expiry_type
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldExpiryType returns the old "expiry_type" field's value of the File entity.
+// If the File object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FileMutation) OldExpiryType(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldExpiryType is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldExpiryType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldExpiryType: %w", err)
+	}
+	return oldValue.ExpiryType, nil
+}
+
+// ResetExpiryType resets all changes to the "expiry_type" field.
+func (m *FileMutation) ResetExpiryType() {
+	m.expiry_type = nil
 }
 
 // SetExpiryTotalDays sets the "expiry_total_days" field.
-func (m *GrantMutation) SetExpiryTotalDays(u uint8) {
+func (m *FileMutation) SetExpiryTotalDays(u uint8) {
 	m.expiry_total_days = &u
 	m.addexpiry_total_days = nil
 }
 
 // ExpiryTotalDays returns the value of the "expiry_total_days" field in the mutation.
-func (m *GrantMutation) ExpiryTotalDays() (r uint8, exists bool) {
+func (m *FileMutation) ExpiryTotalDays() (r uint8, exists bool) {
 	v := m.expiry_total_days
 	if v == nil {
 		return
@@ -1968,10 +2060,10 @@ func (m *GrantMutation) ExpiryTotalDays() (r uint8, exists bool) {
 	return *v, true
 }
 
-// OldExpiryTotalDays returns the old "expiry_total_days" field's value of the Grant entity.
-// If the Grant object wasn't provided to the builder, the object is fetched from the database.
+// OldExpiryTotalDays returns the old "expiry_total_days" field's value of the File entity.
+// If the File object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *GrantMutation) OldExpiryTotalDays(ctx context.Context) (v uint8, err error) {
+func (m *FileMutation) OldExpiryTotalDays(ctx context.Context) (v uint8, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldExpiryTotalDays is only allowed on UpdateOne operations")
 	}
@@ -1986,7 +2078,7 @@ func (m *GrantMutation) OldExpiryTotalDays(ctx context.Context) (v uint8, err er
 }
 
 // AddExpiryTotalDays adds u to the "expiry_total_days" field.
-func (m *GrantMutation) AddExpiryTotalDays(u int8) {
+func (m *FileMutation) AddExpiryTotalDays(u int8) {
 	if m.addexpiry_total_days != nil {
 		*m.addexpiry_total_days += u
 	} else {
@@ -1995,7 +2087,7 @@ func (m *GrantMutation) AddExpiryTotalDays(u int8) {
 }
 
 // AddedExpiryTotalDays returns the value that was added to the "expiry_total_days" field in this mutation.
-func (m *GrantMutation) AddedExpiryTotalDays() (r int8, exists bool) {
+func (m *FileMutation) AddedExpiryTotalDays() (r int8, exists bool) {
 	v := m.addexpiry_total_days
 	if v == nil {
 		return
@@ -2004,762 +2096,594 @@ func (m *GrantMutation) AddedExpiryTotalDays() (r int8, exists bool) {
 }
 
 // ResetExpiryTotalDays resets all changes to the "expiry_total_days" field.
-func (m *GrantMutation) ResetExpiryTotalDays() {
+func (m *FileMutation) ResetExpiryTotalDays() {
 	m.expiry_total_days = nil
 	m.addexpiry_total_days = nil
 }
 
-// SetExpiryDaysSinceLastUpload sets the "expiry_days_since_last_upload" field.
-func (m *GrantMutation) SetExpiryDaysSinceLastUpload(u uint8) {
-	m.expiry_days_since_last_upload = &u
-	m.addexpiry_days_since_last_upload = nil
+// SetExpiryDaysSinceLastDownload sets the "expiry_days_since_last_download" field.
+func (m *FileMutation) SetExpiryDaysSinceLastDownload(u uint8) {
+	m.expiry_days_since_last_download = &u
+	m.addexpiry_days_since_last_download = nil
 }
 
-// ExpiryDaysSinceLastUpload returns the value of the "expiry_days_since_last_upload" field in the mutation.
-func (m *GrantMutation) ExpiryDaysSinceLastUpload() (r uint8, exists bool) {
-	v := m.expiry_days_since_last_upload
+// ExpiryDaysSinceLastDownload returns the value of the "expiry_days_since_last_download" field in the mutation.
+func (m *FileMutation) ExpiryDaysSinceLastDownload() (r uint8, exists bool) {
+	v := m.expiry_days_since_last_download
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldExpiryDaysSinceLastUpload returns the old "expiry_days_since_last_upload" field's value of the Grant entity.
-// If the Grant object wasn't provided to the builder, the object is fetched from the database.
+// OldExpiryDaysSinceLastDownload returns the old "expiry_days_since_last_download" field's value of the File entity.
+// If the File object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *GrantMutation) OldExpiryDaysSinceLastUpload(ctx context.Context) (v uint8, err error) {
+func (m *FileMutation) OldExpiryDaysSinceLastDownload(ctx context.Context) (v uint8, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldExpiryDaysSinceLastUpload is only allowed on UpdateOne operations")
+		return v, errors.New("OldExpiryDaysSinceLastDownload is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldExpiryDaysSinceLastUpload requires an ID field in the mutation")
+		return v, errors.New("OldExpiryDaysSinceLastDownload requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldExpiryDaysSinceLastUpload: %w", err)
+		return v, fmt.Errorf("querying old value for OldExpiryDaysSinceLastDownload: %w", err)
 	}
-	return oldValue.ExpiryDaysSinceLastUpload, nil
+	return oldValue.ExpiryDaysSinceLastDownload, nil
 }
 
-// AddExpiryDaysSinceLastUpload adds u to the "expiry_days_since_last_upload" field.
-func (m *GrantMutation) AddExpiryDaysSinceLastUpload(u int8) {
-	if m.addexpiry_days_since_last_upload != nil {
-		*m.addexpiry_days_since_last_upload += u
+// AddExpiryDaysSinceLastDownload adds u to the "expiry_days_since_last_download" field.
+func (m *FileMutation) AddExpiryDaysSinceLastDownload(u int8) {
+	if m.addexpiry_days_since_last_download != nil {
+		*m.addexpiry_days_since_last_download += u
 	} else {
-		m.addexpiry_days_since_last_upload = &u
+		m.addexpiry_days_since_last_download = &u
 	}
 }
 
-// AddedExpiryDaysSinceLastUpload returns the value that was added to the "expiry_days_since_last_upload" field in this mutation.
-func (m *GrantMutation) AddedExpiryDaysSinceLastUpload() (r int8, exists bool) {
-	v := m.addexpiry_days_since_last_upload
+// AddedExpiryDaysSinceLastDownload returns the value that was added to the "expiry_days_since_last_download" field in this mutation.
+func (m *FileMutation) AddedExpiryDaysSinceLastDownload() (r int8, exists bool) {
+	v := m.addexpiry_days_since_last_download
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ResetExpiryDaysSinceLastUpload resets all changes to the "expiry_days_since_last_upload" field.
-func (m *GrantMutation) ResetExpiryDaysSinceLastUpload() {
-	m.expiry_days_since_last_upload = nil
-	m.addexpiry_days_since_last_upload = nil
+// ResetExpiryDaysSinceLastDownload resets all changes to the "expiry_days_since_last_download" field.
+func (m *FileMutation) ResetExpiryDaysSinceLastDownload() {
+	m.expiry_days_since_last_download = nil
+	m.addexpiry_days_since_last_download = nil
 }
 
-// SetExpiryTotalUploads sets the "expiry_total_uploads" field.
-func (m *GrantMutation) SetExpiryTotalUploads(u uint8) {
-	m.expiry_total_uploads = &u
-	m.addexpiry_total_uploads = nil
+// SetExpiryTotalDownloads sets the "expiry_total_downloads" field.
+func (m *FileMutation) SetExpiryTotalDownloads(u uint8) {
+	m.expiry_total_downloads = &u
+	m.addexpiry_total_downloads = nil
 }
 
-// ExpiryTotalUploads returns the value of the "expiry_total_uploads" field in the mutation.
-func (m *GrantMutation) ExpiryTotalUploads() (r uint8, exists bool) {
-	v := m.expiry_total_uploads
+// ExpiryTotalDownloads returns the value of the "expiry_total_downloads" field in the mutation.
+func (m *FileMutation) ExpiryTotalDownloads() (r uint8, exists bool) {
+	v := m.expiry_total_downloads
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldExpiryTotalUploads returns the old "expiry_total_uploads" field's value of the Grant entity.
-// If the Grant object wasn't provided to the builder, the object is fetched from the database.
+// OldExpiryTotalDownloads returns the old "expiry_total_downloads" field's value of the File entity.
+// If the File object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *GrantMutation) OldExpiryTotalUploads(ctx context.Context) (v uint8, err error) {
+func (m *FileMutation) OldExpiryTotalDownloads(ctx context.Context) (v uint8, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldExpiryTotalUploads is only allowed on UpdateOne operations")
+		return v, errors.New("OldExpiryTotalDownloads is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldExpiryTotalUploads requires an ID field in the mutation")
+		return v, errors.New("OldExpiryTotalDownloads requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldExpiryTotalUploads: %w", err)
+		return v, fmt.Errorf("querying old value for OldExpiryTotalDownloads: %w", err)
 	}
-	return oldValue.ExpiryTotalUploads, nil
+	return oldValue.ExpiryTotalDownloads, nil
 }
 
-// AddExpiryTotalUploads adds u to the "expiry_total_uploads" field.
-func (m *GrantMutation) AddExpiryTotalUploads(u int8) {
-	if m.addexpiry_total_uploads != nil {
-		*m.addexpiry_total_uploads += u
+// AddExpiryTotalDownloads adds u to the "expiry_total_downloads" field.
+func (m *FileMutation) AddExpiryTotalDownloads(u int8) {
+	if m.addexpiry_total_downloads != nil {
+		*m.addexpiry_total_downloads += u
 	} else {
-		m.addexpiry_total_uploads = &u
+		m.addexpiry_total_downloads = &u
 	}
 }
 
-// AddedExpiryTotalUploads returns the value that was added to the "expiry_total_uploads" field in this mutation.
-func (m *GrantMutation) AddedExpiryTotalUploads() (r int8, exists bool) {
-	v := m.addexpiry_total_uploads
+// AddedExpiryTotalDownloads returns the value that was added to the "expiry_total_downloads" field in this mutation.
+func (m *FileMutation) AddedExpiryTotalDownloads() (r int8, exists bool) {
+	v := m.addexpiry_total_downloads
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ResetExpiryTotalUploads resets all changes to the "expiry_total_uploads" field.
-func (m *GrantMutation) ResetExpiryTotalUploads() {
-	m.expiry_total_uploads = nil
-	m.addexpiry_total_uploads = nil
+// ResetExpiryTotalDownloads resets all changes to the "expiry_total_downloads" field.
+func (m *FileMutation) ResetExpiryTotalDownloads() {
+	m.expiry_total_downloads = nil
+	m.addexpiry_total_downloads = nil
 }
 
-// SetFileExpiryType sets the "file_expiry_type" field.
-func (m *GrantMutation) SetFileExpiryType(s string) {
-	m.file_expiry_type = &s
+// SetScanStatus sets the "scan_status" field.
+func (m *FileMutation) SetScanStatus(s string) {
+	m.scan_status = &s
 }
 
-// FileExpiryType returns the value of the "file_expiry_type" field in the mutation.
-func (m *GrantMutation) FileExpiryType() (r string, exists bool) {
-	v := m.file_expiry_type
+// ScanStatus returns the value of the "scan_status" field in the mutation.
+func (m *FileMutation) ScanStatus() (r string, exists bool) {
+	v := m.scan_status
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldFileExpiryType returns the old "file_expiry_type" field's value of the Grant entity.
-// If the Grant object wasn't provided to the builder, the object is fetched from the database.
+// OldScanStatus returns the old "scan_status" field's value of the File entity.
+// If the File object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *GrantMutation) OldFileExpiryType(ctx context.Context) (v string, err error) {
+func (m *FileMutation) OldScanStatus(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldFileExpiryType is only allowed on UpdateOne operations")
+		return v, errors.New("OldScanStatus is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldFileExpiryType requires an ID field in the mutation")
+		return v, errors.New("OldScanStatus requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldFileExpiryType: %w", err)
+		return v, fmt.Errorf("querying old value for OldScanStatus: %w", err)
 	}
-	return oldValue.FileExpiryType, nil
+	return oldValue.ScanStatus, nil
 }
 
-// ResetFileExpiryType resets all changes to the "file_expiry_type" field.
-func (m *GrantMutation) ResetFileExpiryType() {
-	m.file_expiry_type = nil
+// ResetScanStatus resets all changes to the "scan_status" field.
+func (m *FileMutation) ResetScanStatus() {
+	m.scan_status = nil
 }
 
-// SetFileExpiryTotalDays sets the "file_expiry_total_days" field.
-func (m *GrantMutation) SetFileExpiryTotalDays(u uint8) {
-	m.file_expiry_total_days = &u
-	m.addfile_expiry_total_days = nil
+// SetScanSignature sets the "scan_signature" field.
+func (m *FileMutation) SetScanSignature(s string) {
+	m.scan_signature = &s
 }
 
-// FileExpiryTotalDays returns the value of the "file_expiry_total_days" field in the mutation.
-func (m *GrantMutation) FileExpiryTotalDays() (r uint8, exists bool) {
-	v := m.file_expiry_total_days
+// ScanSignature returns the value of the "scan_signature" field in the mutation.
+func (m *FileMutation) ScanSignature() (r string, exists bool) {
+	v := m.scan_signature
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldFileExpiryTotalDays returns the old "file_expiry_total_days" field's value of the Grant entity.
-// If the Grant object wasn't provided to the builder, the object is fetched from the database.
+// OldScanSignature returns the old "scan_signature" field's value of the File entity.
+// If the File object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *GrantMutation) OldFileExpiryTotalDays(ctx context.Context) (v uint8, err error) {
+func (m *FileMutation) OldScanSignature(ctx context.Context) (v *string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldFileExpiryTotalDays is only allowed on UpdateOne operations")
+		return v, errors.New("OldScanSignature is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldFileExpiryTotalDays requires an ID field in the mutation")
+		return v, errors.New("OldScanSignature requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldFileExpiryTotalDays: %w", err)
+		return v, fmt.Errorf("querying old value for OldScanSignature: %w", err)
 	}
-	return oldValue.FileExpiryTotalDays, nil
+	return oldValue.ScanSignature, nil
 }
 
-// AddFileExpiryTotalDays adds u to the "file_expiry_total_days" field.
-func (m *GrantMutation) AddFileExpiryTotalDays(u int8) {
-	if m.addfile_expiry_total_days != nil {
-		*m.addfile_expiry_total_days += u
-	} else {
-		m.addfile_expiry_total_days = &u
-	}
+// ClearScanSignature clears the value of the "scan_signature" field.
+func (m *FileMutation) ClearScanSignature() {
+	m.scan_signature = nil
+	m.clearedFields[file.FieldScanSignature] = struct{}{}
 }
 
-// AddedFileExpiryTotalDays returns the value that was added to the "file_expiry_total_days" field in this mutation.
-func (m *GrantMutation) AddedFileExpiryTotalDays() (r int8, exists bool) {
-	v := m.addfile_expiry_total_days
-	if v == nil {
-		return
-	}
-	return *v, true
+// ScanSignatureCleared returns if the "scan_signature" field was cleared in this mutation.
+func (m *FileMutation) ScanSignatureCleared() bool {
+	_, ok := m.clearedFields[file.FieldScanSignature]
+	return ok
 }
 
-// ResetFileExpiryTotalDays resets all changes to the "file_expiry_total_days" field.
-func (m *GrantMutation) ResetFileExpiryTotalDays() {
-	m.file_expiry_total_days = nil
-	m.addfile_expiry_total_days = nil
+// ResetScanSignature resets all changes to the "scan_signature" field.
+func (m *FileMutation) ResetScanSignature() {
+	m.scan_signature = nil
+	delete(m.clearedFields, file.FieldScanSignature)
 }
 
-// SetFileExpiryDaysSinceLastDownload sets the "file_expiry_days_since_last_download" field.
-func (m *GrantMutation) SetFileExpiryDaysSinceLastDownload(u uint8) {
-	m.file_expiry_days_since_last_download = &u
-	m.addfile_expiry_days_since_last_download = nil
+// SetMimeType sets the "mime_type" field.
+func (m *FileMutation) SetMimeType(s string) {
+	m.mime_type = &s
 }
 
-// FileExpiryDaysSinceLastDownload returns the value of the "file_expiry_days_since_last_download" field in the mutation.
-func (m *GrantMutation) FileExpiryDaysSinceLastDownload() (r uint8, exists bool) {
-	v := m.file_expiry_days_since_last_download
+// MimeType returns the value of the "mime_type" field in the mutation.
+func (m *FileMutation) MimeType() (r string, exists bool) {
+	v := m.mime_type
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldFileExpiryDaysSinceLastDownload returns the old "file_expiry_days_since_last_download" field's value of the Grant entity.
-// If the Grant object wasn't provided to the builder, the object is fetched from the database.
+// OldMimeType returns the old "mime_type" field's value of the File entity.
+// If the File object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *GrantMutation) OldFileExpiryDaysSinceLastDownload(ctx context.Context) (v uint8, err error) {
+func (m *FileMutation) OldMimeType(ctx context.Context) (v *string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldFileExpiryDaysSinceLastDownload is only allowed on UpdateOne operations")
+		return v, errors.New("OldMimeType is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldFileExpiryDaysSinceLastDownload requires an ID field in the mutation")
+		return v, errors.New("OldMimeType requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldFileExpiryDaysSinceLastDownload: %w", err)
+		return v, fmt.Errorf("querying old value for OldMimeType: %w", err)
 	}
-	return oldValue.FileExpiryDaysSinceLastDownload, nil
+	return oldValue.MimeType, nil
 }
 
-// AddFileExpiryDaysSinceLastDownload adds u to the "file_expiry_days_since_last_download" field.
-func (m *GrantMutation) AddFileExpiryDaysSinceLastDownload(u int8) {
-	if m.addfile_expiry_days_since_last_download != nil {
-		*m.addfile_expiry_days_since_last_download += u
-	} else {
-		m.addfile_expiry_days_since_last_download = &u
-	}
+// ClearMimeType clears the value of the "mime_type" field.
+func (m *FileMutation) ClearMimeType() {
+	m.mime_type = nil
+	m.clearedFields[file.FieldMimeType] = struct{}{}
 }
 
-// AddedFileExpiryDaysSinceLastDownload returns the value that was added to the "file_expiry_days_since_last_download" field in this mutation.
-func (m *GrantMutation) AddedFileExpiryDaysSinceLastDownload() (r int8, exists bool) {
-	v := m.addfile_expiry_days_since_last_download
-	if v == nil {
-		return
-	}
-	return *v, true
+// MimeTypeCleared returns if the "mime_type" field was cleared in this mutation.
+func (m *FileMutation) MimeTypeCleared() bool {
+	_, ok := m.clearedFields[file.FieldMimeType]
+	return ok
 }
 
-// ResetFileExpiryDaysSinceLastDownload resets all changes to the "file_expiry_days_since_last_download" field.
-func (m *GrantMutation) ResetFileExpiryDaysSinceLastDownload() {
-	m.file_expiry_days_since_last_download = nil
-	m.addfile_expiry_days_since_last_download = nil
+// ResetMimeType resets all changes to the "mime_type" field.
+func (m *FileMutation) ResetMimeType() {
+	m.mime_type = nil
+	delete(m.clearedFields, file.FieldMimeType)
 }
 
-// SetFileExpiryTotalDownloads sets the "file_expiry_total_downloads" field.
-func (m *GrantMutation) SetFileExpiryTotalDownloads(u uint8) {
-	m.file_expiry_total_downloads = &u
-	m.addfile_expiry_total_downloads = nil
+// SetTicketID sets the "ticket" edge to the Ticket entity by id.
+func (m *FileMutation) SetTicketID(id uuid.UUID) {
+	m.ticket = &id
 }
 
-// FileExpiryTotalDownloads returns the value of the "file_expiry_total_downloads" field in the mutation.
-func (m *GrantMutation) FileExpiryTotalDownloads() (r uint8, exists bool) {
-	v := m.file_expiry_total_downloads
-	if v == nil {
-		return
-	}
-	return *v, true
+// ClearTicket clears the "ticket" edge to the Ticket entity.
+func (m *FileMutation) ClearTicket() {
+	m.clearedticket = true
 }
 
-// OldFileExpiryTotalDownloads returns the old "file_expiry_total_downloads" field's value of the Grant entity.
-// If the Grant object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *GrantMutation) OldFileExpiryTotalDownloads(ctx context.Context) (v uint8, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldFileExpiryTotalDownloads is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldFileExpiryTotalDownloads requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldFileExpiryTotalDownloads: %w", err)
-	}
-	return oldValue.FileExpiryTotalDownloads, nil
+// TicketCleared reports if the "ticket" edge to the Ticket entity was cleared.
+func (m *FileMutation) TicketCleared() bool {
+	return m.clearedticket
 }
 
-// AddFileExpiryTotalDownloads adds u to the "file_expiry_total_downloads" field.
-func (m *GrantMutation) AddFileExpiryTotalDownloads(u int8) {
-	if m.addfile_expiry_total_downloads != nil {
-		*m.addfile_expiry_total_downloads += u
-	} else {
-		m.addfile_expiry_total_downloads = &u
+// TicketID returns the "ticket" edge ID in the mutation.
+func (m *FileMutation) TicketID() (id uuid.UUID, exists bool) {
+	if m.ticket != nil {
+		return *m.ticket, true
 	}
+	return
 }
 
-// AddedFileExpiryTotalDownloads returns the value that was added to the "file_expiry_total_downloads" field in this mutation.
-func (m *GrantMutation) AddedFileExpiryTotalDownloads() (r int8, exists bool) {
-	v := m.addfile_expiry_total_downloads
-	if v == nil {
-		return
+// TicketIDs returns the "ticket" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// TicketID instead. It exists only for internal usage by the builders.
+func (m *FileMutation) TicketIDs() (ids []uuid.UUID) {
+	if id := m.ticket; id != nil {
+		ids = append(ids, *id)
 	}
-	return *v, true
+	return
 }
 
-// ResetFileExpiryTotalDownloads resets all changes to the "file_expiry_total_downloads" field.
-func (m *GrantMutation) ResetFileExpiryTotalDownloads() {
-	m.file_expiry_total_downloads = nil
-	m.addfile_expiry_total_downloads = nil
+// ResetTicket resets all changes to the "ticket" edge.
+func (m *FileMutation) ResetTicket() {
+	m.ticket = nil
+	m.clearedticket = false
 }
 
-// SetLastUpload sets the "last_upload" field.
-func (m *GrantMutation) SetLastUpload(t time.Time) {
-	m.last_upload = &t
+// SetGrantID sets the "grant" edge to the Grant entity by id.
+func (m *FileMutation) SetGrantID(id uuid.UUID) {
+	m.grant = &id
 }
 
-// LastUpload returns the value of the "last_upload" field in the mutation.
-func (m *GrantMutation) LastUpload() (r time.Time, exists bool) {
-	v := m.last_upload
-	if v == nil {
-		return
-	}
-	return *v, true
+// ClearGrant clears the "grant" edge to the Grant entity.
+func (m *FileMutation) ClearGrant() {
+	m.clearedgrant = true
 }
 
-// OldLastUpload returns the old "last_upload" field's value of the Grant entity.
-// If the Grant object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *GrantMutation) OldLastUpload(ctx context.Context) (v *time.Time, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldLastUpload is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldLastUpload requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldLastUpload: %w", err)
-	}
-	return oldValue.LastUpload, nil
+// GrantCleared reports if the "grant" edge to the Grant entity was cleared.
+func (m *FileMutation) GrantCleared() bool {
+	return m.clearedgrant
 }
 
-// ClearLastUpload clears the value of the "last_upload" field.
-func (m *GrantMutation) ClearLastUpload() {
-	m.last_upload = nil
-	m.clearedFields[grant.FieldLastUpload] = struct{}{}
+// GrantID returns the "grant" edge ID in the mutation.
+func (m *FileMutation) GrantID() (id uuid.UUID, exists bool) {
+	if m.grant != nil {
+		return *m.grant, true
+	}
+	return
 }
 
-// LastUploadCleared returns if the "last_upload" field was cleared in this mutation.
-func (m *GrantMutation) LastUploadCleared() bool {
-	_, ok := m.clearedFields[grant.FieldLastUpload]
-	return ok
+// GrantIDs returns the "grant" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// GrantID instead. It exists only for internal usage by the builders.
+func (m *FileMutation) GrantIDs() (ids []uuid.UUID) {
+	if id := m.grant; id != nil {
+		ids = append(ids, *id)
+	}
+	return
 }
 
-// ResetLastUpload resets all changes to the "last_upload" field.
-func (m *GrantMutation) ResetLastUpload() {
-	m.last_upload = nil
-	delete(m.clearedFields, grant.FieldLastUpload)
+// ResetGrant resets all changes to the "grant" edge.
+func (m *FileMutation) ResetGrant() {
+	m.grant = nil
+	m.clearedgrant = false
 }
 
-// SetTimesUploaded sets the "times_uploaded" field.
-func (m *GrantMutation) SetTimesUploaded(u uint64) {
-	m.times_uploaded = &u
-	m.addtimes_uploaded = nil
+// SetOwnerID sets the "owner" edge to the User entity by id.
+func (m *FileMutation) SetOwnerID(id uuid.UUID) {
+	m.owner = &id
 }
 
-// TimesUploaded returns the value of the "times_uploaded" field in the mutation.
-func (m *GrantMutation) TimesUploaded() (r uint64, exists bool) {
-	v := m.times_uploaded
-	if v == nil {
-		return
-	}
-	return *v, true
+// ClearOwner clears the "owner" edge to the User entity.
+func (m *FileMutation) ClearOwner() {
+	m.clearedowner = true
 }
 
-// OldTimesUploaded returns the old "times_uploaded" field's value of the Grant entity.
-// If the Grant object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *GrantMutation) OldTimesUploaded(ctx context.Context) (v uint64, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldTimesUploaded is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldTimesUploaded requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldTimesUploaded: %w", err)
-	}
-	return oldValue.TimesUploaded, nil
+// OwnerCleared reports if the "owner" edge to the User entity was cleared.
+func (m *FileMutation) OwnerCleared() bool {
+	return m.clearedowner
 }
 
-// AddTimesUploaded adds u to the "times_uploaded" field.
-func (m *GrantMutation) AddTimesUploaded(u int64) {
-	if m.addtimes_uploaded != nil {
-		*m.addtimes_uploaded += u
-	} else {
-		m.addtimes_uploaded = &u
+// OwnerID returns the "owner" edge ID in the mutation.
+func (m *FileMutation) OwnerID() (id uuid.UUID, exists bool) {
+	if m.owner != nil {
+		return *m.owner, true
 	}
+	return
 }
 
-// AddedTimesUploaded returns the value that was added to the "times_uploaded" field in this mutation.
-func (m *GrantMutation) AddedTimesUploaded() (r int64, exists bool) {
-	v := m.addtimes_uploaded
-	if v == nil {
-		return
+// OwnerIDs returns the "owner" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// OwnerID instead. It exists only for internal usage by the builders.
+func (m *FileMutation) OwnerIDs() (ids []uuid.UUID) {
+	if id := m.owner; id != nil {
+		ids = append(ids, *id)
 	}
-	return *v, true
+	return
 }
 
-// ResetTimesUploaded resets all changes to the "times_uploaded" field.
-func (m *GrantMutation) ResetTimesUploaded() {
-	m.times_uploaded = nil
-	m.addtimes_uploaded = nil
+// ResetOwner resets all changes to the "owner" edge.
+func (m *FileMutation) ResetOwner() {
+	m.owner = nil
+	m.clearedowner = false
 }
 
-// SetEmailOnUpload sets the "email_on_upload" field.
-func (m *GrantMutation) SetEmailOnUpload(s []string) {
-	m.email_on_upload = &s
-	m.appendemail_on_upload = nil
+// SetDataID sets the "data" edge to the FileData entity by id.
+func (m *FileMutation) SetDataID(id string) {
+	m.data = &id
 }
 
-// EmailOnUpload returns the value of the "email_on_upload" field in the mutation.
-func (m *GrantMutation) EmailOnUpload() (r []string, exists bool) {
-	v := m.email_on_upload
-	if v == nil {
-		return
-	}
-	return *v, true
+// ClearData clears the "data" edge to the FileData entity.
+func (m *FileMutation) ClearData() {
+	m.cleareddata = true
 }
 
-// OldEmailOnUpload returns the old "email_on_upload" field's value of the Grant entity.
-// If the Grant object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *GrantMutation) OldEmailOnUpload(ctx context.Context) (v []string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldEmailOnUpload is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldEmailOnUpload requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldEmailOnUpload: %w", err)
-	}
-	return oldValue.EmailOnUpload, nil
+// DataCleared reports if the "data" edge to the FileData entity was cleared.
+func (m *FileMutation) DataCleared() bool {
+	return m.cleareddata
 }
 
-// AppendEmailOnUpload adds s to the "email_on_upload" field.
-func (m *GrantMutation) AppendEmailOnUpload(s []string) {
-	m.appendemail_on_upload = append(m.appendemail_on_upload, s...)
+// DataID returns the "data" edge ID in the mutation.
+func (m *FileMutation) DataID() (id string, exists bool) {
+	if m.data != nil {
+		return *m.data, true
+	}
+	return
 }
 
-// AppendedEmailOnUpload returns the list of values that were appended to the "email_on_upload" field in this mutation.
-func (m *GrantMutation) AppendedEmailOnUpload() ([]string, bool) {
-	if len(m.appendemail_on_upload) == 0 {
-		return nil, false
+// DataIDs returns the "data" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// DataID instead. It exists only for internal usage by the builders.
+func (m *FileMutation) DataIDs() (ids []string) {
+	if id := m.data; id != nil {
+		ids = append(ids, *id)
 	}
-	return m.appendemail_on_upload, true
+	return
 }
 
-// ClearEmailOnUpload clears the value of the "email_on_upload" field.
-func (m *GrantMutation) ClearEmailOnUpload() {
-	m.email_on_upload = nil
-	m.appendemail_on_upload = nil
-	m.clearedFields[grant.FieldEmailOnUpload] = struct{}{}
+// ResetData resets all changes to the "data" edge.
+func (m *FileMutation) ResetData() {
+	m.data = nil
+	m.cleareddata = false
 }
 
-// EmailOnUploadCleared returns if the "email_on_upload" field was cleared in this mutation.
-func (m *GrantMutation) EmailOnUploadCleared() bool {
-	_, ok := m.clearedFields[grant.FieldEmailOnUpload]
-	return ok
+// AddDownloadProgressIDs adds the "download_progresses" edge to the DownloadProgress entity by ids.
+func (m *FileMutation) AddDownloadProgressIDs(ids ...int) {
+	if m.download_progresses == nil {
+		m.download_progresses = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.download_progresses[ids[i]] = struct{}{}
+	}
 }
 
-// ResetEmailOnUpload resets all changes to the "email_on_upload" field.
-func (m *GrantMutation) ResetEmailOnUpload() {
-	m.email_on_upload = nil
-	m.appendemail_on_upload = nil
-	delete(m.clearedFields, grant.FieldEmailOnUpload)
+// ClearDownloadProgresses clears the "download_progresses" edge to the DownloadProgress entity.
+func (m *FileMutation) ClearDownloadProgresses() {
+	m.cleareddownload_progresses = true
 }
 
-// SetCreatorLang sets the "creator_lang" field.
-func (m *GrantMutation) SetCreatorLang(s string) {
-	m.creator_lang = &s
+// DownloadProgressesCleared reports if the "download_progresses" edge to the DownloadProgress entity was cleared.
+func (m *FileMutation) DownloadProgressesCleared() bool {
+	return m.cleareddownload_progresses
 }
 
-// CreatorLang returns the value of the "creator_lang" field in the mutation.
-func (m *GrantMutation) CreatorLang() (r string, exists bool) {
-	v := m.creator_lang
-	if v == nil {
-		return
+// RemoveDownloadProgressIDs removes the "download_progresses" edge to the DownloadProgress entity by IDs.
+func (m *FileMutation) RemoveDownloadProgressIDs(ids ...int) {
+	if m.removeddownload_progresses == nil {
+		m.removeddownload_progresses = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.download_progresses, ids[i])
+		m.removeddownload_progresses[ids[i]] = struct{}{}
 	}
-	return *v, true
 }
 
-// OldCreatorLang returns the old "creator_lang" field's value of the Grant entity.
-// If the Grant object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *GrantMutation) OldCreatorLang(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreatorLang is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreatorLang requires an ID field in the mutation")
+// RemovedDownloadProgresses returns the removed IDs of the "download_progresses" edge to the DownloadProgress entity.
+func (m *FileMutation) RemovedDownloadProgressesIDs() (ids []int) {
+	for id := range m.removeddownload_progresses {
+		ids = append(ids, id)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreatorLang: %w", err)
+	return
+}
+
+// DownloadProgressesIDs returns the "download_progresses" edge IDs in the mutation.
+func (m *FileMutation) DownloadProgressesIDs() (ids []int) {
+	for id := range m.download_progresses {
+		ids = append(ids, id)
 	}
-	return oldValue.CreatorLang, nil
+	return
 }
 
-// ResetCreatorLang resets all changes to the "creator_lang" field.
-func (m *GrantMutation) ResetCreatorLang() {
-	m.creator_lang = nil
+// ResetDownloadProgresses resets all changes to the "download_progresses" edge.
+func (m *FileMutation) ResetDownloadProgresses() {
+	m.download_progresses = nil
+	m.cleareddownload_progresses = false
+	m.removeddownload_progresses = nil
 }
 
-// AddFileIDs adds the "files" edge to the File entity by ids.
-func (m *GrantMutation) AddFileIDs(ids ...uuid.UUID) {
-	if m.files == nil {
-		m.files = make(map[uuid.UUID]struct{})
+// AddAccessEventIDs adds the "access_events" edge to the AccessEvent entity by ids.
+func (m *FileMutation) AddAccessEventIDs(ids ...int) {
+	if m.access_events == nil {
+		m.access_events = make(map[int]struct{})
 	}
 	for i := range ids {
-		m.files[ids[i]] = struct{}{}
+		m.access_events[ids[i]] = struct{}{}
 	}
 }
 
-// ClearFiles clears the "files" edge to the File entity.
-func (m *GrantMutation) ClearFiles() {
-	m.clearedfiles = true
+// ClearAccessEvents clears the "access_events" edge to the AccessEvent entity.
+func (m *FileMutation) ClearAccessEvents() {
+	m.clearedaccess_events = true
 }
 
-// FilesCleared reports if the "files" edge to the File entity was cleared.
-func (m *GrantMutation) FilesCleared() bool {
-	return m.clearedfiles
+// AccessEventsCleared reports if the "access_events" edge to the AccessEvent entity was cleared.
+func (m *FileMutation) AccessEventsCleared() bool {
+	return m.clearedaccess_events
 }
 
-// RemoveFileIDs removes the "files" edge to the File entity by IDs.
-func (m *GrantMutation) RemoveFileIDs(ids ...uuid.UUID) {
-	if m.removedfiles == nil {
-		m.removedfiles = make(map[uuid.UUID]struct{})
+// RemoveAccessEventIDs removes the "access_events" edge to the AccessEvent entity by IDs.
+func (m *FileMutation) RemoveAccessEventIDs(ids ...int) {
+	if m.removedaccess_events == nil {
+		m.removedaccess_events = make(map[int]struct{})
 	}
 	for i := range ids {
-		delete(m.files, ids[i])
-		m.removedfiles[ids[i]] = struct{}{}
+		delete(m.access_events, ids[i])
+		m.removedaccess_events[ids[i]] = struct{}{}
 	}
 }
 
-// RemovedFiles returns the removed IDs of the "files" edge to the File entity.
-func (m *GrantMutation) RemovedFilesIDs() (ids []uuid.UUID) {
-	for id := range m.removedfiles {
+// RemovedAccessEvents returns the removed IDs of the "access_events" edge to the AccessEvent entity.
+func (m *FileMutation) RemovedAccessEventsIDs() (ids []int) {
+	for id := range m.removedaccess_events {
 		ids = append(ids, id)
 	}
 	return
 }
 
-// FilesIDs returns the "files" edge IDs in the mutation.
-func (m *GrantMutation) FilesIDs() (ids []uuid.UUID) {
-	for id := range m.files {
+// AccessEventsIDs returns the "access_events" edge IDs in the mutation.
+func (m *FileMutation) AccessEventsIDs() (ids []int) {
+	for id := range m.access_events {
 		ids = append(ids, id)
 	}
 	return
 }
 
-// ResetFiles resets all changes to the "files" edge.
-func (m *GrantMutation) ResetFiles() {
-	m.files = nil
-	m.clearedfiles = false
-	m.removedfiles = nil
+// ResetAccessEvents resets all changes to the "access_events" edge.
+func (m *FileMutation) ResetAccessEvents() {
+	m.access_events = nil
+	m.clearedaccess_events = false
+	m.removedaccess_events = nil
 }
 
-// SetOwnerID sets the "owner" edge to the User entity by id.
-func (m *GrantMutation) SetOwnerID(id uuid.UUID) {
-	m.owner = &id
+// Where appends a list predicates to the FileMutation builder.
+func (m *FileMutation) Where(ps ...predicate.File) {
+	m.predicates = append(m.predicates, ps...)
 }
 
-// ClearOwner clears the "owner" edge to the User entity.
-func (m *GrantMutation) ClearOwner() {
-	m.clearedowner = true
+// WhereP appends storage-level predicates to the FileMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *FileMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.File, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
 }
 
-// OwnerCleared reports if the "owner" edge to the User entity was cleared.
-func (m *GrantMutation) OwnerCleared() bool {
-	return m.clearedowner
-}
-
-// OwnerID returns the "owner" edge ID in the mutation.
-func (m *GrantMutation) OwnerID() (id uuid.UUID, exists bool) {
-	if m.owner != nil {
-		return *m.owner, true
-	}
-	return
-}
-
-// OwnerIDs returns the "owner" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// OwnerID instead. It exists only for internal usage by the builders.
-func (m *GrantMutation) OwnerIDs() (ids []uuid.UUID) {
-	if id := m.owner; id != nil {
-		ids = append(ids, *id)
-	}
-	return
-}
-
-// ResetOwner resets all changes to the "owner" edge.
-func (m *GrantMutation) ResetOwner() {
-	m.owner = nil
-	m.clearedowner = false
-}
-
-// AddShareaccesstokenIDs adds the "shareaccesstokens" edge to the ShareAccessToken entity by ids.
-func (m *GrantMutation) AddShareaccesstokenIDs(ids ...string) {
-	if m.shareaccesstokens == nil {
-		m.shareaccesstokens = make(map[string]struct{})
-	}
-	for i := range ids {
-		m.shareaccesstokens[ids[i]] = struct{}{}
-	}
-}
-
-// ClearShareaccesstokens clears the "shareaccesstokens" edge to the ShareAccessToken entity.
-func (m *GrantMutation) ClearShareaccesstokens() {
-	m.clearedshareaccesstokens = true
-}
-
-// ShareaccesstokensCleared reports if the "shareaccesstokens" edge to the ShareAccessToken entity was cleared.
-func (m *GrantMutation) ShareaccesstokensCleared() bool {
-	return m.clearedshareaccesstokens
-}
-
-// RemoveShareaccesstokenIDs removes the "shareaccesstokens" edge to the ShareAccessToken entity by IDs.
-func (m *GrantMutation) RemoveShareaccesstokenIDs(ids ...string) {
-	if m.removedshareaccesstokens == nil {
-		m.removedshareaccesstokens = make(map[string]struct{})
-	}
-	for i := range ids {
-		delete(m.shareaccesstokens, ids[i])
-		m.removedshareaccesstokens[ids[i]] = struct{}{}
-	}
-}
-
-// RemovedShareaccesstokens returns the removed IDs of the "shareaccesstokens" edge to the ShareAccessToken entity.
-func (m *GrantMutation) RemovedShareaccesstokensIDs() (ids []string) {
-	for id := range m.removedshareaccesstokens {
-		ids = append(ids, id)
-	}
-	return
-}
-
-// ShareaccesstokensIDs returns the "shareaccesstokens" edge IDs in the mutation.
-func (m *GrantMutation) ShareaccesstokensIDs() (ids []string) {
-	for id := range m.shareaccesstokens {
-		ids = append(ids, id)
-	}
-	return
-}
-
-// ResetShareaccesstokens resets all changes to the "shareaccesstokens" edge.
-func (m *GrantMutation) ResetShareaccesstokens() {
-	m.shareaccesstokens = nil
-	m.clearedshareaccesstokens = false
-	m.removedshareaccesstokens = nil
-}
-
-// Where appends a list predicates to the GrantMutation builder.
-func (m *GrantMutation) Where(ps ...predicate.Grant) {
-	m.predicates = append(m.predicates, ps...)
-}
-
-// WhereP appends storage-level predicates to the GrantMutation builder. Using this method,
-// users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *GrantMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Grant, len(ps))
-	for i := range ps {
-		p[i] = ps[i]
-	}
-	m.Where(p...)
-}
-
-// Op returns the operation name.
-func (m *GrantMutation) Op() Op {
-	return m.op
+// Op returns the operation name.
+func (m *FileMutation) Op() Op {
+	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *GrantMutation) SetOp(op Op) {
+func (m *FileMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (Grant).
-func (m *GrantMutation) Type() string {
+// Type returns the node type of this mutation (File).
+func (m *FileMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *GrantMutation) Fields() []string {
-	fields := make([]string, 0, 16)
-	if m.comment != nil {
-		fields = append(fields, grant.FieldComment)
-	}
-	if m.expiry_type != nil {
-		fields = append(fields, grant.FieldExpiryType)
-	}
-	if m.hashed_password != nil {
-		fields = append(fields, grant.FieldHashedPassword)
-	}
-	if m.salt != nil {
-		fields = append(fields, grant.FieldSalt)
+func (m *FileMutation) Fields() []string {
+	fields := make([]string, 0, 13)
+	if m.name != nil {
+		fields = append(fields, file.FieldName)
 	}
 	if m.created_at != nil {
-		fields = append(fields, grant.FieldCreatedAt)
+		fields = append(fields, file.FieldCreatedAt)
 	}
-	if m.expiry_total_days != nil {
-		fields = append(fields, grant.FieldExpiryTotalDays)
+	if m.last_download != nil {
+		fields = append(fields, file.FieldLastDownload)
 	}
-	if m.expiry_days_since_last_upload != nil {
-		fields = append(fields, grant.FieldExpiryDaysSinceLastUpload)
+	if m.times_downloaded != nil {
+		fields = append(fields, file.FieldTimesDownloaded)
 	}
-	if m.expiry_total_uploads != nil {
-		fields = append(fields, grant.FieldExpiryTotalUploads)
+	if m.last_view != nil {
+		fields = append(fields, file.FieldLastView)
 	}
-	if m.file_expiry_type != nil {
-		fields = append(fields, grant.FieldFileExpiryType)
+	if m.times_viewed != nil {
+		fields = append(fields, file.FieldTimesViewed)
 	}
-	if m.file_expiry_total_days != nil {
-		fields = append(fields, grant.FieldFileExpiryTotalDays)
+	if m.expiry_type != nil {
+		fields = append(fields, file.FieldExpiryType)
 	}
-	if m.file_expiry_days_since_last_download != nil {
-		fields = append(fields, grant.FieldFileExpiryDaysSinceLastDownload)
+	if m.expiry_total_days != nil {
+		fields = append(fields, file.FieldExpiryTotalDays)
 	}
-	if m.file_expiry_total_downloads != nil {
-		fields = append(fields, grant.FieldFileExpiryTotalDownloads)
+	if m.expiry_days_since_last_download != nil {
+		fields = append(fields, file.FieldExpiryDaysSinceLastDownload)
 	}
-	if m.last_upload != nil {
-		fields = append(fields, grant.FieldLastUpload)
+	if m.expiry_total_downloads != nil {
+		fields = append(fields, file.FieldExpiryTotalDownloads)
 	}
-	if m.times_uploaded != nil {
-		fields = append(fields, grant.FieldTimesUploaded)
+	if m.scan_status != nil {
+		fields = append(fields, file.FieldScanStatus)
 	}
-	if m.email_on_upload != nil {
-		fields = append(fields, grant.FieldEmailOnUpload)
+	if m.scan_signature != nil {
+		fields = append(fields, file.FieldScanSignature)
 	}
-	if m.creator_lang != nil {
-		fields = append(fields, grant.FieldCreatorLang)
+	if m.mime_type != nil {
+		fields = append(fields, file.FieldMimeType)
 	}
 	return fields
 }
@@ -2767,40 +2691,34 @@ func (m *GrantMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *GrantMutation) Field(name string) (ent.Value, bool) {
+func (m *FileMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case grant.FieldComment:
-		return m.Comment()
-	case grant.FieldExpiryType:
-		return m.ExpiryType()
-	case grant.FieldHashedPassword:
-		return m.HashedPassword()
-	case grant.FieldSalt:
-		return m.Salt()
-	case grant.FieldCreatedAt:
+	case file.FieldName:
+		return m.Name()
+	case file.FieldCreatedAt:
 		return m.CreatedAt()
-	case grant.FieldExpiryTotalDays:
+	case file.FieldLastDownload:
+		return m.LastDownload()
+	case file.FieldTimesDownloaded:
+		return m.TimesDownloaded()
+	case file.FieldLastView:
+		return m.LastView()
+	case file.FieldTimesViewed:
+		return m.TimesViewed()
+	case file.FieldExpiryType:
+		return m.ExpiryType()
+	case file.FieldExpiryTotalDays:
 		return m.ExpiryTotalDays()
-	case grant.FieldExpiryDaysSinceLastUpload:
-		return m.ExpiryDaysSinceLastUpload()
-	case grant.FieldExpiryTotalUploads:
-		return m.ExpiryTotalUploads()
-	case grant.FieldFileExpiryType:
-		return m.FileExpiryType()
-	case grant.FieldFileExpiryTotalDays:
-		return m.FileExpiryTotalDays()
-	case grant.FieldFileExpiryDaysSinceLastDownload:
-		return m.FileExpiryDaysSinceLastDownload()
-	case grant.FieldFileExpiryTotalDownloads:
-		return m.FileExpiryTotalDownloads()
-	case grant.FieldLastUpload:
-		return m.LastUpload()
-	case grant.FieldTimesUploaded:
-		return m.TimesUploaded()
-	case grant.FieldEmailOnUpload:
-		return m.EmailOnUpload()
-	case grant.FieldCreatorLang:
-		return m.CreatorLang()
+	case file.FieldExpiryDaysSinceLastDownload:
+		return m.ExpiryDaysSinceLastDownload()
+	case file.FieldExpiryTotalDownloads:
+		return m.ExpiryTotalDownloads()
+	case file.FieldScanStatus:
+		return m.ScanStatus()
+	case file.FieldScanSignature:
+		return m.ScanSignature()
+	case file.FieldMimeType:
+		return m.MimeType()
 	}
 	return nil, false
 }
@@ -2808,189 +2726,156 @@ func (m *GrantMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *GrantMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *FileMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case grant.FieldComment:
-		return m.OldComment(ctx)
-	case grant.FieldExpiryType:
-		return m.OldExpiryType(ctx)
-	case grant.FieldHashedPassword:
-		return m.OldHashedPassword(ctx)
-	case grant.FieldSalt:
-		return m.OldSalt(ctx)
-	case grant.FieldCreatedAt:
+	case file.FieldName:
+		return m.OldName(ctx)
+	case file.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
-	case grant.FieldExpiryTotalDays:
+	case file.FieldLastDownload:
+		return m.OldLastDownload(ctx)
+	case file.FieldTimesDownloaded:
+		return m.OldTimesDownloaded(ctx)
+	case file.FieldLastView:
+		return m.OldLastView(ctx)
+	case file.FieldTimesViewed:
+		return m.OldTimesViewed(ctx)
+	case file.FieldExpiryType:
+		return m.OldExpiryType(ctx)
+	case file.FieldExpiryTotalDays:
 		return m.OldExpiryTotalDays(ctx)
-	case grant.FieldExpiryDaysSinceLastUpload:
-		return m.OldExpiryDaysSinceLastUpload(ctx)
-	case grant.FieldExpiryTotalUploads:
-		return m.OldExpiryTotalUploads(ctx)
-	case grant.FieldFileExpiryType:
-		return m.OldFileExpiryType(ctx)
-	case grant.FieldFileExpiryTotalDays:
-		return m.OldFileExpiryTotalDays(ctx)
-	case grant.FieldFileExpiryDaysSinceLastDownload:
-		return m.OldFileExpiryDaysSinceLastDownload(ctx)
-	case grant.FieldFileExpiryTotalDownloads:
-		return m.OldFileExpiryTotalDownloads(ctx)
-	case grant.FieldLastUpload:
-		return m.OldLastUpload(ctx)
-	case grant.FieldTimesUploaded:
-		return m.OldTimesUploaded(ctx)
-	case grant.FieldEmailOnUpload:
-		return m.OldEmailOnUpload(ctx)
-	case grant.FieldCreatorLang:
-		return m.OldCreatorLang(ctx)
+	case file.FieldExpiryDaysSinceLastDownload:
+		return m.OldExpiryDaysSinceLastDownload(ctx)
+	case file.FieldExpiryTotalDownloads:
+		return m.OldExpiryTotalDownloads(ctx)
+	case file.FieldScanStatus:
+		return m.OldScanStatus(ctx)
+	case file.FieldScanSignature:
+		return m.OldScanSignature(ctx)
+	case file.FieldMimeType:
+		return m.OldMimeType(ctx)
 	}
-	return nil, fmt.Errorf("unknown Grant field %s", name)
+	return nil, fmt.Errorf("unknown File field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *GrantMutation) SetField(name string, value ent.Value) error {
+func (m *FileMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case grant.FieldComment:
+	case file.FieldName:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetComment(v)
+		m.SetName(v)
 		return nil
-	case grant.FieldExpiryType:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetExpiryType(v)
-		return nil
-	case grant.FieldHashedPassword:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetHashedPassword(v)
-		return nil
-	case grant.FieldSalt:
-		v, ok := value.(string)
+	case file.FieldCreatedAt:
+		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetSalt(v)
+		m.SetCreatedAt(v)
 		return nil
-	case grant.FieldCreatedAt:
+	case file.FieldLastDownload:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetCreatedAt(v)
+		m.SetLastDownload(v)
 		return nil
-	case grant.FieldExpiryTotalDays:
-		v, ok := value.(uint8)
+	case file.FieldTimesDownloaded:
+		v, ok := value.(uint64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetExpiryTotalDays(v)
+		m.SetTimesDownloaded(v)
 		return nil
-	case grant.FieldExpiryDaysSinceLastUpload:
-		v, ok := value.(uint8)
+	case file.FieldLastView:
+		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetExpiryDaysSinceLastUpload(v)
+		m.SetLastView(v)
 		return nil
-	case grant.FieldExpiryTotalUploads:
-		v, ok := value.(uint8)
+	case file.FieldTimesViewed:
+		v, ok := value.(uint64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetExpiryTotalUploads(v)
+		m.SetTimesViewed(v)
 		return nil
-	case grant.FieldFileExpiryType:
+	case file.FieldExpiryType:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetFileExpiryType(v)
+		m.SetExpiryType(v)
 		return nil
-	case grant.FieldFileExpiryTotalDays:
+	case file.FieldExpiryTotalDays:
 		v, ok := value.(uint8)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetFileExpiryTotalDays(v)
+		m.SetExpiryTotalDays(v)
 		return nil
-	case grant.FieldFileExpiryDaysSinceLastDownload:
+	case file.FieldExpiryDaysSinceLastDownload:
 		v, ok := value.(uint8)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetFileExpiryDaysSinceLastDownload(v)
+		m.SetExpiryDaysSinceLastDownload(v)
 		return nil
-	case grant.FieldFileExpiryTotalDownloads:
+	case file.FieldExpiryTotalDownloads:
 		v, ok := value.(uint8)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetFileExpiryTotalDownloads(v)
-		return nil
-	case grant.FieldLastUpload:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetLastUpload(v)
+		m.SetExpiryTotalDownloads(v)
 		return nil
-	case grant.FieldTimesUploaded:
-		v, ok := value.(uint64)
+	case file.FieldScanStatus:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetTimesUploaded(v)
+		m.SetScanStatus(v)
 		return nil
-	case grant.FieldEmailOnUpload:
-		v, ok := value.([]string)
+	case file.FieldScanSignature:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetEmailOnUpload(v)
+		m.SetScanSignature(v)
 		return nil
-	case grant.FieldCreatorLang:
+	case file.FieldMimeType:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetCreatorLang(v)
+		m.SetMimeType(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Grant field %s", name)
+	return fmt.Errorf("unknown File field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *GrantMutation) AddedFields() []string {
+func (m *FileMutation) AddedFields() []string {
 	var fields []string
-	if m.addexpiry_total_days != nil {
-		fields = append(fields, grant.FieldExpiryTotalDays)
-	}
-	if m.addexpiry_days_since_last_upload != nil {
-		fields = append(fields, grant.FieldExpiryDaysSinceLastUpload)
-	}
-	if m.addexpiry_total_uploads != nil {
-		fields = append(fields, grant.FieldExpiryTotalUploads)
+	if m.addtimes_downloaded != nil {
+		fields = append(fields, file.FieldTimesDownloaded)
 	}
-	if m.addfile_expiry_total_days != nil {
-		fields = append(fields, grant.FieldFileExpiryTotalDays)
+	if m.addtimes_viewed != nil {
+		fields = append(fields, file.FieldTimesViewed)
 	}
-	if m.addfile_expiry_days_since_last_download != nil {
-		fields = append(fields, grant.FieldFileExpiryDaysSinceLastDownload)
+	if m.addexpiry_total_days != nil {
+		fields = append(fields, file.FieldExpiryTotalDays)
 	}
-	if m.addfile_expiry_total_downloads != nil {
-		fields = append(fields, grant.FieldFileExpiryTotalDownloads)
+	if m.addexpiry_days_since_last_download != nil {
+		fields = append(fields, file.FieldExpiryDaysSinceLastDownload)
 	}
-	if m.addtimes_uploaded != nil {
-		fields = append(fields, grant.FieldTimesUploaded)
+	if m.addexpiry_total_downloads != nil {
+		fields = append(fields, file.FieldExpiryTotalDownloads)
 	}
 	return fields
 }
@@ -2998,22 +2883,18 @@ func (m *GrantMutation) AddedFields() []string {
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *GrantMutation) AddedField(name string) (ent.Value, bool) {
+func (m *FileMutation) AddedField(name string) (ent.Value, bool) {
 	switch name {
-	case grant.FieldExpiryTotalDays:
+	case file.FieldTimesDownloaded:
+		return m.AddedTimesDownloaded()
+	case file.FieldTimesViewed:
+		return m.AddedTimesViewed()
+	case file.FieldExpiryTotalDays:
 		return m.AddedExpiryTotalDays()
-	case grant.FieldExpiryDaysSinceLastUpload:
-		return m.AddedExpiryDaysSinceLastUpload()
-	case grant.FieldExpiryTotalUploads:
-		return m.AddedExpiryTotalUploads()
-	case grant.FieldFileExpiryTotalDays:
-		return m.AddedFileExpiryTotalDays()
-	case grant.FieldFileExpiryDaysSinceLastDownload:
-		return m.AddedFileExpiryDaysSinceLastDownload()
-	case grant.FieldFileExpiryTotalDownloads:
-		return m.AddedFileExpiryTotalDownloads()
-	case grant.FieldTimesUploaded:
-		return m.AddedTimesUploaded()
+	case file.FieldExpiryDaysSinceLastDownload:
+		return m.AddedExpiryDaysSinceLastDownload()
+	case file.FieldExpiryTotalDownloads:
+		return m.AddedExpiryTotalDownloads()
 	}
 	return nil, false
 }
@@ -3021,189 +2902,193 @@ func (m *GrantMutation) AddedField(name string) (ent.Value, bool) {
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *GrantMutation) AddField(name string, value ent.Value) error {
+func (m *FileMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case grant.FieldExpiryTotalDays:
-		v, ok := value.(int8)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddExpiryTotalDays(v)
-		return nil
-	case grant.FieldExpiryDaysSinceLastUpload:
-		v, ok := value.(int8)
+	case file.FieldTimesDownloaded:
+		v, ok := value.(int64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddExpiryDaysSinceLastUpload(v)
+		m.AddTimesDownloaded(v)
 		return nil
-	case grant.FieldExpiryTotalUploads:
-		v, ok := value.(int8)
+	case file.FieldTimesViewed:
+		v, ok := value.(int64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddExpiryTotalUploads(v)
+		m.AddTimesViewed(v)
 		return nil
-	case grant.FieldFileExpiryTotalDays:
+	case file.FieldExpiryTotalDays:
 		v, ok := value.(int8)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddFileExpiryTotalDays(v)
+		m.AddExpiryTotalDays(v)
 		return nil
-	case grant.FieldFileExpiryDaysSinceLastDownload:
+	case file.FieldExpiryDaysSinceLastDownload:
 		v, ok := value.(int8)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddFileExpiryDaysSinceLastDownload(v)
+		m.AddExpiryDaysSinceLastDownload(v)
 		return nil
-	case grant.FieldFileExpiryTotalDownloads:
+	case file.FieldExpiryTotalDownloads:
 		v, ok := value.(int8)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddFileExpiryTotalDownloads(v)
-		return nil
-	case grant.FieldTimesUploaded:
-		v, ok := value.(int64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddTimesUploaded(v)
+		m.AddExpiryTotalDownloads(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Grant numeric field %s", name)
+	return fmt.Errorf("unknown File numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *GrantMutation) ClearedFields() []string {
+func (m *FileMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(grant.FieldComment) {
-		fields = append(fields, grant.FieldComment)
+	if m.FieldCleared(file.FieldLastDownload) {
+		fields = append(fields, file.FieldLastDownload)
 	}
-	if m.FieldCleared(grant.FieldLastUpload) {
-		fields = append(fields, grant.FieldLastUpload)
+	if m.FieldCleared(file.FieldLastView) {
+		fields = append(fields, file.FieldLastView)
 	}
-	if m.FieldCleared(grant.FieldEmailOnUpload) {
-		fields = append(fields, grant.FieldEmailOnUpload)
+	if m.FieldCleared(file.FieldScanSignature) {
+		fields = append(fields, file.FieldScanSignature)
+	}
+	if m.FieldCleared(file.FieldMimeType) {
+		fields = append(fields, file.FieldMimeType)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *GrantMutation) FieldCleared(name string) bool {
+func (m *FileMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *GrantMutation) ClearField(name string) error {
+func (m *FileMutation) ClearField(name string) error {
 	switch name {
-	case grant.FieldComment:
-		m.ClearComment()
+	case file.FieldLastDownload:
+		m.ClearLastDownload()
 		return nil
-	case grant.FieldLastUpload:
-		m.ClearLastUpload()
+	case file.FieldLastView:
+		m.ClearLastView()
 		return nil
-	case grant.FieldEmailOnUpload:
-		m.ClearEmailOnUpload()
+	case file.FieldScanSignature:
+		m.ClearScanSignature()
+		return nil
+	case file.FieldMimeType:
+		m.ClearMimeType()
 		return nil
 	}
-	return fmt.Errorf("unknown Grant nullable field %s", name)
+	return fmt.Errorf("unknown File nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *GrantMutation) ResetField(name string) error {
+func (m *FileMutation) ResetField(name string) error {
 	switch name {
-	case grant.FieldComment:
-		m.ResetComment()
-		return nil
-	case grant.FieldExpiryType:
-		m.ResetExpiryType()
-		return nil
-	case grant.FieldHashedPassword:
-		m.ResetHashedPassword()
-		return nil
-	case grant.FieldSalt:
-		m.ResetSalt()
+	case file.FieldName:
+		m.ResetName()
 		return nil
-	case grant.FieldCreatedAt:
+	case file.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
-	case grant.FieldExpiryTotalDays:
-		m.ResetExpiryTotalDays()
+	case file.FieldLastDownload:
+		m.ResetLastDownload()
 		return nil
-	case grant.FieldExpiryDaysSinceLastUpload:
-		m.ResetExpiryDaysSinceLastUpload()
+	case file.FieldTimesDownloaded:
+		m.ResetTimesDownloaded()
 		return nil
-	case grant.FieldExpiryTotalUploads:
-		m.ResetExpiryTotalUploads()
+	case file.FieldLastView:
+		m.ResetLastView()
 		return nil
-	case grant.FieldFileExpiryType:
-		m.ResetFileExpiryType()
+	case file.FieldTimesViewed:
+		m.ResetTimesViewed()
 		return nil
-	case grant.FieldFileExpiryTotalDays:
-		m.ResetFileExpiryTotalDays()
+	case file.FieldExpiryType:
+		m.ResetExpiryType()
 		return nil
-	case grant.FieldFileExpiryDaysSinceLastDownload:
-		m.ResetFileExpiryDaysSinceLastDownload()
+	case file.FieldExpiryTotalDays:
+		m.ResetExpiryTotalDays()
 		return nil
-	case grant.FieldFileExpiryTotalDownloads:
-		m.ResetFileExpiryTotalDownloads()
+	case file.FieldExpiryDaysSinceLastDownload:
+		m.ResetExpiryDaysSinceLastDownload()
 		return nil
-	case grant.FieldLastUpload:
-		m.ResetLastUpload()
+	case file.FieldExpiryTotalDownloads:
+		m.ResetExpiryTotalDownloads()
 		return nil
-	case grant.FieldTimesUploaded:
-		m.ResetTimesUploaded()
+	case file.FieldScanStatus:
+		m.ResetScanStatus()
 		return nil
-	case grant.FieldEmailOnUpload:
-		m.ResetEmailOnUpload()
+	case file.FieldScanSignature:
+		m.ResetScanSignature()
 		return nil
-	case grant.FieldCreatorLang:
-		m.ResetCreatorLang()
+	case file.FieldMimeType:
+		m.ResetMimeType()
 		return nil
 	}
-	return fmt.Errorf("unknown Grant field %s", name)
+	return fmt.Errorf("unknown File field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *GrantMutation) AddedEdges() []string {
-	edges := make([]string, 0, 3)
-	if m.files != nil {
-		edges = append(edges, grant.EdgeFiles)
+func (m *FileMutation) AddedEdges() []string {
+	edges := make([]string, 0, 6)
+	if m.ticket != nil {
+		edges = append(edges, file.EdgeTicket)
+	}
+	if m.grant != nil {
+		edges = append(edges, file.EdgeGrant)
 	}
 	if m.owner != nil {
-		edges = append(edges, grant.EdgeOwner)
+		edges = append(edges, file.EdgeOwner)
 	}
-	if m.shareaccesstokens != nil {
-		edges = append(edges, grant.EdgeShareaccesstokens)
+	if m.data != nil {
+		edges = append(edges, file.EdgeData)
 	}
-	return edges
+	if m.download_progresses != nil {
+		edges = append(edges, file.EdgeDownloadProgresses)
+	}
+	if m.access_events != nil {
+		edges = append(edges, file.EdgeAccessEvents)
+	}
+	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *GrantMutation) AddedIDs(name string) []ent.Value {
+func (m *FileMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case grant.EdgeFiles:
-		ids := make([]ent.Value, 0, len(m.files))
-		for id := range m.files {
-			ids = append(ids, id)
+	case file.EdgeTicket:
+		if id := m.ticket; id != nil {
+			return []ent.Value{*id}
 		}
-		return ids
-	case grant.EdgeOwner:
+	case file.EdgeGrant:
+		if id := m.grant; id != nil {
+			return []ent.Value{*id}
+		}
+	case file.EdgeOwner:
 		if id := m.owner; id != nil {
 			return []ent.Value{*id}
 		}
-	case grant.EdgeShareaccesstokens:
-		ids := make([]ent.Value, 0, len(m.shareaccesstokens))
-		for id := range m.shareaccesstokens {
+	case file.EdgeData:
+		if id := m.data; id != nil {
+			return []ent.Value{*id}
+		}
+	case file.EdgeDownloadProgresses:
+		ids := make([]ent.Value, 0, len(m.download_progresses))
+		for id := range m.download_progresses {
+			ids = append(ids, id)
+		}
+		return ids
+	case file.EdgeAccessEvents:
+		ids := make([]ent.Value, 0, len(m.access_events))
+		for id := range m.access_events {
 			ids = append(ids, id)
 		}
 		return ids
@@ -3212,30 +3097,30 @@ func (m *GrantMutation) AddedIDs(name string) []ent.Value {
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *GrantMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 3)
-	if m.removedfiles != nil {
-		edges = append(edges, grant.EdgeFiles)
+func (m *FileMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 6)
+	if m.removeddownload_progresses != nil {
+		edges = append(edges, file.EdgeDownloadProgresses)
 	}
-	if m.removedshareaccesstokens != nil {
-		edges = append(edges, grant.EdgeShareaccesstokens)
+	if m.removedaccess_events != nil {
+		edges = append(edges, file.EdgeAccessEvents)
 	}
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *GrantMutation) RemovedIDs(name string) []ent.Value {
+func (m *FileMutation) RemovedIDs(name string) []ent.Value {
 	switch name {
-	case grant.EdgeFiles:
-		ids := make([]ent.Value, 0, len(m.removedfiles))
-		for id := range m.removedfiles {
+	case file.EdgeDownloadProgresses:
+		ids := make([]ent.Value, 0, len(m.removeddownload_progresses))
+		for id := range m.removeddownload_progresses {
 			ids = append(ids, id)
 		}
 		return ids
-	case grant.EdgeShareaccesstokens:
-		ids := make([]ent.Value, 0, len(m.removedshareaccesstokens))
-		for id := range m.removedshareaccesstokens {
+	case file.EdgeAccessEvents:
+		ids := make([]ent.Value, 0, len(m.removedaccess_events))
+		for id := range m.removedaccess_events {
 			ids = append(ids, id)
 		}
 		return ids
@@ -3244,90 +3129,134 @@ func (m *GrantMutation) RemovedIDs(name string) []ent.Value {
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *GrantMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 3)
-	if m.clearedfiles {
-		edges = append(edges, grant.EdgeFiles)
+func (m *FileMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 6)
+	if m.clearedticket {
+		edges = append(edges, file.EdgeTicket)
+	}
+	if m.clearedgrant {
+		edges = append(edges, file.EdgeGrant)
 	}
 	if m.clearedowner {
-		edges = append(edges, grant.EdgeOwner)
+		edges = append(edges, file.EdgeOwner)
 	}
-	if m.clearedshareaccesstokens {
-		edges = append(edges, grant.EdgeShareaccesstokens)
+	if m.cleareddata {
+		edges = append(edges, file.EdgeData)
+	}
+	if m.cleareddownload_progresses {
+		edges = append(edges, file.EdgeDownloadProgresses)
+	}
+	if m.clearedaccess_events {
+		edges = append(edges, file.EdgeAccessEvents)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *GrantMutation) EdgeCleared(name string) bool {
+func (m *FileMutation) EdgeCleared(name string) bool {
 	switch name {
-	case grant.EdgeFiles:
-		return m.clearedfiles
-	case grant.EdgeOwner:
+	case file.EdgeTicket:
+		return m.clearedticket
+	case file.EdgeGrant:
+		return m.clearedgrant
+	case file.EdgeOwner:
 		return m.clearedowner
-	case grant.EdgeShareaccesstokens:
-		return m.clearedshareaccesstokens
+	case file.EdgeData:
+		return m.cleareddata
+	case file.EdgeDownloadProgresses:
+		return m.cleareddownload_progresses
+	case file.EdgeAccessEvents:
+		return m.clearedaccess_events
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *GrantMutation) ClearEdge(name string) error {
+func (m *FileMutation) ClearEdge(name string) error {
 	switch name {
-	case grant.EdgeOwner:
+	case file.EdgeTicket:
+		m.ClearTicket()
+		return nil
+	case file.EdgeGrant:
+		m.ClearGrant()
+		return nil
+	case file.EdgeOwner:
 		m.ClearOwner()
 		return nil
+	case file.EdgeData:
+		m.ClearData()
+		return nil
 	}
-	return fmt.Errorf("unknown Grant unique edge %s", name)
+	return fmt.Errorf("unknown File unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *GrantMutation) ResetEdge(name string) error {
+func (m *FileMutation) ResetEdge(name string) error {
 	switch name {
-	case grant.EdgeFiles:
-		m.ResetFiles()
+	case file.EdgeTicket:
+		m.ResetTicket()
 		return nil
-	case grant.EdgeOwner:
+	case file.EdgeGrant:
+		m.ResetGrant()
+		return nil
+	case file.EdgeOwner:
 		m.ResetOwner()
 		return nil
-	case grant.EdgeShareaccesstokens:
-		m.ResetShareaccesstokens()
+	case file.EdgeData:
+		m.ResetData()
+		return nil
+	case file.EdgeDownloadProgresses:
+		m.ResetDownloadProgresses()
+		return nil
+	case file.EdgeAccessEvents:
+		m.ResetAccessEvents()
 		return nil
 	}
-	return fmt.Errorf("unknown Grant edge %s", name)
+	return fmt.Errorf("unknown File edge %s", name)
 }
 
-// SessionMutation represents an operation that mutates the Session nodes in the graph.
-type SessionMutation struct {
+// FileDataMutation represents an operation that mutates the FileData nodes in the graph.
+type FileDataMutation struct {
 	config
-	op            Op
-	typ           string
-	id            *int
-	id_token      *string
-	expire        *time.Time
-	refresh_token *string
-	clearedFields map[string]struct{}
-	user          *uuid.UUID
-	cleareduser   bool
-	done          bool
-	oldValue      func(context.Context) (*Session, error)
-	predicates    []predicate.Session
+	op                 Op
+	typ                string
+	id                 *string
+	size               *uint64
+	addsize            *int64
+	sha256             *string
+	key_id             *string
+	encrypted_key      *[]byte
+	compression        *string
+	compressed_size    *uint64
+	addcompressed_size *int64
+	stored_size        *int64
+	addstored_size     *int64
+	mime_type          *string
+	preview_status     *string
+	preview_type       *string
+	clearedFields      map[string]struct{}
+	files              map[uuid.UUID]struct{}
+	removedfiles       map[uuid.UUID]struct{}
+	clearedfiles       bool
+	done               bool
+	oldValue           func(context.Context) (*FileData, error)
+	predicates         []predicate.FileData
 }
 
-var _ ent.Mutation = (*SessionMutation)(nil)
+var _ ent.Mutation = (*FileDataMutation)(nil)
 
-// sessionOption allows management of the mutation configuration using functional options.
-type sessionOption func(*SessionMutation)
+// filedataOption allows management of the mutation configuration using functional options.
+type filedataOption func(*FileDataMutation)
 
-// newSessionMutation creates new mutation for the Session entity.
-func newSessionMutation(c config, op Op, opts ...sessionOption) *SessionMutation {
-	m := &SessionMutation{
+// newFileDataMutation creates new mutation for the FileData entity.
+func newFileDataMutation(c config, op Op, opts ...filedataOption) *FileDataMutation {
+	m := &FileDataMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeSession,
+		typ:           TypeFileData,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -3336,20 +3265,20 @@ func newSessionMutation(c config, op Op, opts ...sessionOption) *SessionMutation
 	return m
 }
 
-// withSessionID sets the ID field of the mutation.
-func withSessionID(id int) sessionOption {
-	return func(m *SessionMutation) {
+// withFileDataID sets the ID field of the mutation.
+func withFileDataID(id string) filedataOption {
+	return func(m *FileDataMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Session
+			value *FileData
 		)
-		m.oldValue = func(ctx context.Context) (*Session, error) {
+		m.oldValue = func(ctx context.Context) (*FileData, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Session.Get(ctx, id)
+					value, err = m.Client().FileData.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -3358,10 +3287,10 @@ func withSessionID(id int) sessionOption {
 	}
 }
 
-// withSession sets the old Session of the mutation.
-func withSession(node *Session) sessionOption {
-	return func(m *SessionMutation) {
-		m.oldValue = func(context.Context) (*Session, error) {
+// withFileData sets the old FileData of the mutation.
+func withFileData(node *FileData) filedataOption {
+	return func(m *FileDataMutation) {
+		m.oldValue = func(context.Context) (*FileData, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -3370,7 +3299,7 @@ func withSession(node *Session) sessionOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m SessionMutation) Client() *Client {
+func (m FileDataMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -3378,7 +3307,7 @@ func (m SessionMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m SessionMutation) Tx() (*Tx, error) {
+func (m FileDataMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -3387,9 +3316,15 @@ func (m SessionMutation) Tx() (*Tx, error) {
 	return tx, nil
 }
 
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of FileData entities.
+func (m *FileDataMutation) SetID(id string) {
+	m.id = &id
+}
+
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *SessionMutation) ID() (id int, exists bool) {
+func (m *FileDataMutation) ID() (id string, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -3400,651 +3335,596 @@ func (m *SessionMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *SessionMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *FileDataMutation) IDs(ctx context.Context) ([]string, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
 		if exists {
-			return []int{id}, nil
+			return []string{id}, nil
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Session.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().FileData.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetIDToken sets the "id_token" field.
-func (m *SessionMutation) SetIDToken(s string) {
-	m.id_token = &s
+// SetSize sets the "size" field.
+func (m *FileDataMutation) SetSize(u uint64) {
+	m.size = &u
+	m.addsize = nil
 }
 
-// IDToken returns the value of the "id_token" field in the mutation.
-func (m *SessionMutation) IDToken() (r string, exists bool) {
-	v := m.id_token
+// Size returns the value of the "size" field in the mutation.
+func (m *FileDataMutation) Size() (r uint64, exists bool) {
+	v := m.size
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldIDToken returns the old "id_token" field's value of the Session entity.
-// If the Session object wasn't provided to the builder, the object is fetched from the database.
+// OldSize returns the old "size" field's value of the FileData entity.
+// If the FileData object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SessionMutation) OldIDToken(ctx context.Context) (v string, err error) {
+func (m *FileDataMutation) OldSize(ctx context.Context) (v uint64, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldIDToken is only allowed on UpdateOne operations")
+		return v, errors.New("OldSize is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldIDToken requires an ID field in the mutation")
+		return v, errors.New("OldSize requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldIDToken: %w", err)
+		return v, fmt.Errorf("querying old value for OldSize: %w", err)
 	}
-	return oldValue.IDToken, nil
+	return oldValue.Size, nil
 }
 
-// ResetIDToken resets all changes to the "id_token" field.
-func (m *SessionMutation) ResetIDToken() {
-	m.id_token = nil
+// AddSize adds u to the "size" field.
+func (m *FileDataMutation) AddSize(u int64) {
+	if m.addsize != nil {
+		*m.addsize += u
+	} else {
+		m.addsize = &u
+	}
 }
 
-// SetExpire sets the "expire" field.
-func (m *SessionMutation) SetExpire(t time.Time) {
-	m.expire = &t
+// AddedSize returns the value that was added to the "size" field in this mutation.
+func (m *FileDataMutation) AddedSize() (r int64, exists bool) {
+	v := m.addsize
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// Expire returns the value of the "expire" field in the mutation.
-func (m *SessionMutation) Expire() (r time.Time, exists bool) {
-	v := m.expire
+// ResetSize resets all changes to the "size" field.
+func (m *FileDataMutation) ResetSize() {
+	m.size = nil
+	m.addsize = nil
+}
+
+// SetSha256 sets the "sha256" field.
+func (m *FileDataMutation) SetSha256(s string) {
+	m.sha256 = &s
+}
+
+// Sha256 returns the value of the "sha256" field in the mutation.
+func (m *FileDataMutation) Sha256() (r string, exists bool) {
+	v := m.sha256
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldExpire returns the old "expire" field's value of the Session entity.
-// If the Session object wasn't provided to the builder, the object is fetched from the database.
+// OldSha256 returns the old "sha256" field's value of the FileData entity.
+// If the FileData object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SessionMutation) OldExpire(ctx context.Context) (v time.Time, err error) {
+func (m *FileDataMutation) OldSha256(ctx context.Context) (v *string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldExpire is only allowed on UpdateOne operations")
+		return v, errors.New("OldSha256 is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldExpire requires an ID field in the mutation")
+		return v, errors.New("OldSha256 requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldExpire: %w", err)
+		return v, fmt.Errorf("querying old value for OldSha256: %w", err)
 	}
-	return oldValue.Expire, nil
+	return oldValue.Sha256, nil
 }
 
-// ResetExpire resets all changes to the "expire" field.
-func (m *SessionMutation) ResetExpire() {
-	m.expire = nil
+// ClearSha256 clears the value of the "sha256" field.
+func (m *FileDataMutation) ClearSha256() {
+	m.sha256 = nil
+	m.clearedFields[filedata.FieldSha256] = struct{}{}
 }
 
-// SetRefreshToken sets the "refresh_token" field.
-func (m *SessionMutation) SetRefreshToken(s string) {
-	m.refresh_token = &s
+// Sha256Cleared returns if the "sha256" field was cleared in this mutation.
+func (m *FileDataMutation) Sha256Cleared() bool {
+	_, ok := m.clearedFields[filedata.FieldSha256]
+	return ok
 }
 
-// RefreshToken returns the value of the "refresh_token" field in the mutation.
-func (m *SessionMutation) RefreshToken() (r string, exists bool) {
-	v := m.refresh_token
-	if v == nil {
-		return
+// ResetSha256 resets all changes to the "sha256" field.
+func (m *FileDataMutation) ResetSha256() {
+	m.sha256 = nil
+	delete(m.clearedFields, filedata.FieldSha256)
+}
+
+// SetKeyID sets the "key_id" field.
+func (m *FileDataMutation) SetKeyID(s string) {
+	m.key_id = &s
+}
+
+// KeyID returns the value of the "key_id" field in the mutation.
+func (m *FileDataMutation) KeyID() (r string, exists bool) {
+	v := m.key_id
+	if v == nil {
+		return
 	}
 	return *v, true
 }
 
-// OldRefreshToken returns the old "refresh_token" field's value of the Session entity.
-// If the Session object wasn't provided to the builder, the object is fetched from the database.
+// OldKeyID returns the old "key_id" field's value of the FileData entity.
+// If the FileData object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SessionMutation) OldRefreshToken(ctx context.Context) (v string, err error) {
+func (m *FileDataMutation) OldKeyID(ctx context.Context) (v *string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldRefreshToken is only allowed on UpdateOne operations")
+		return v, errors.New("OldKeyID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldRefreshToken requires an ID field in the mutation")
+		return v, errors.New("OldKeyID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldRefreshToken: %w", err)
+		return v, fmt.Errorf("querying old value for OldKeyID: %w", err)
 	}
-	return oldValue.RefreshToken, nil
+	return oldValue.KeyID, nil
 }
 
-// ResetRefreshToken resets all changes to the "refresh_token" field.
-func (m *SessionMutation) ResetRefreshToken() {
-	m.refresh_token = nil
+// ClearKeyID clears the value of the "key_id" field.
+func (m *FileDataMutation) ClearKeyID() {
+	m.key_id = nil
+	m.clearedFields[filedata.FieldKeyID] = struct{}{}
 }
 
-// SetUserID sets the "user" edge to the User entity by id.
-func (m *SessionMutation) SetUserID(id uuid.UUID) {
-	m.user = &id
+// KeyIDCleared returns if the "key_id" field was cleared in this mutation.
+func (m *FileDataMutation) KeyIDCleared() bool {
+	_, ok := m.clearedFields[filedata.FieldKeyID]
+	return ok
 }
 
-// ClearUser clears the "user" edge to the User entity.
-func (m *SessionMutation) ClearUser() {
-	m.cleareduser = true
+// ResetKeyID resets all changes to the "key_id" field.
+func (m *FileDataMutation) ResetKeyID() {
+	m.key_id = nil
+	delete(m.clearedFields, filedata.FieldKeyID)
 }
 
-// UserCleared reports if the "user" edge to the User entity was cleared.
-func (m *SessionMutation) UserCleared() bool {
-	return m.cleareduser
+// SetEncryptedKey sets the "encrypted_key" field.
+func (m *FileDataMutation) SetEncryptedKey(b []byte) {
+	m.encrypted_key = &b
 }
 
-// UserID returns the "user" edge ID in the mutation.
-func (m *SessionMutation) UserID() (id uuid.UUID, exists bool) {
-	if m.user != nil {
-		return *m.user, true
+// EncryptedKey returns the value of the "encrypted_key" field in the mutation.
+func (m *FileDataMutation) EncryptedKey() (r []byte, exists bool) {
+	v := m.encrypted_key
+	if v == nil {
+		return
 	}
-	return
+	return *v, true
 }
 
-// UserIDs returns the "user" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// UserID instead. It exists only for internal usage by the builders.
-func (m *SessionMutation) UserIDs() (ids []uuid.UUID) {
-	if id := m.user; id != nil {
-		ids = append(ids, *id)
+// OldEncryptedKey returns the old "encrypted_key" field's value of the FileData entity.
+// If the FileData object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FileDataMutation) OldEncryptedKey(ctx context.Context) (v []byte, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEncryptedKey is only allowed on UpdateOne operations")
 	}
-	return
-}
-
-// ResetUser resets all changes to the "user" edge.
-func (m *SessionMutation) ResetUser() {
-	m.user = nil
-	m.cleareduser = false
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEncryptedKey requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEncryptedKey: %w", err)
+	}
+	return oldValue.EncryptedKey, nil
 }
 
-// Where appends a list predicates to the SessionMutation builder.
-func (m *SessionMutation) Where(ps ...predicate.Session) {
-	m.predicates = append(m.predicates, ps...)
+// ClearEncryptedKey clears the value of the "encrypted_key" field.
+func (m *FileDataMutation) ClearEncryptedKey() {
+	m.encrypted_key = nil
+	m.clearedFields[filedata.FieldEncryptedKey] = struct{}{}
 }
 
-// WhereP appends storage-level predicates to the SessionMutation builder. Using this method,
-// users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *SessionMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Session, len(ps))
-	for i := range ps {
-		p[i] = ps[i]
-	}
-	m.Where(p...)
+// EncryptedKeyCleared returns if the "encrypted_key" field was cleared in this mutation.
+func (m *FileDataMutation) EncryptedKeyCleared() bool {
+	_, ok := m.clearedFields[filedata.FieldEncryptedKey]
+	return ok
 }
 
-// Op returns the operation name.
-func (m *SessionMutation) Op() Op {
-	return m.op
+// ResetEncryptedKey resets all changes to the "encrypted_key" field.
+func (m *FileDataMutation) ResetEncryptedKey() {
+	m.encrypted_key = nil
+	delete(m.clearedFields, filedata.FieldEncryptedKey)
 }
 
-// SetOp allows setting the mutation operation.
-func (m *SessionMutation) SetOp(op Op) {
-	m.op = op
+// SetCompression sets the "compression" field.
+func (m *FileDataMutation) SetCompression(s string) {
+	m.compression = &s
 }
 
-// Type returns the node type of this mutation (Session).
-func (m *SessionMutation) Type() string {
-	return m.typ
+// Compression returns the value of the "compression" field in the mutation.
+func (m *FileDataMutation) Compression() (r string, exists bool) {
+	v := m.compression
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// Fields returns all fields that were changed during this mutation. Note that in
-// order to get all numeric fields that were incremented/decremented, call
-// AddedFields().
-func (m *SessionMutation) Fields() []string {
-	fields := make([]string, 0, 3)
-	if m.id_token != nil {
-		fields = append(fields, session.FieldIDToken)
+// OldCompression returns the old "compression" field's value of the FileData entity.
+// If the FileData object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FileDataMutation) OldCompression(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCompression is only allowed on UpdateOne operations")
 	}
-	if m.expire != nil {
-		fields = append(fields, session.FieldExpire)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCompression requires an ID field in the mutation")
 	}
-	if m.refresh_token != nil {
-		fields = append(fields, session.FieldRefreshToken)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCompression: %w", err)
 	}
-	return fields
+	return oldValue.Compression, nil
 }
 
-// Field returns the value of a field with the given name. The second boolean
-// return value indicates that this field was not set, or was not defined in the
-// schema.
-func (m *SessionMutation) Field(name string) (ent.Value, bool) {
-	switch name {
-	case session.FieldIDToken:
-		return m.IDToken()
-	case session.FieldExpire:
-		return m.Expire()
-	case session.FieldRefreshToken:
-		return m.RefreshToken()
-	}
-	return nil, false
+// ClearCompression clears the value of the "compression" field.
+func (m *FileDataMutation) ClearCompression() {
+	m.compression = nil
+	m.clearedFields[filedata.FieldCompression] = struct{}{}
 }
 
-// OldField returns the old value of the field from the database. An error is
-// returned if the mutation operation is not UpdateOne, or the query to the
-// database failed.
-func (m *SessionMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
-	switch name {
-	case session.FieldIDToken:
-		return m.OldIDToken(ctx)
-	case session.FieldExpire:
-		return m.OldExpire(ctx)
-	case session.FieldRefreshToken:
-		return m.OldRefreshToken(ctx)
-	}
-	return nil, fmt.Errorf("unknown Session field %s", name)
+// CompressionCleared returns if the "compression" field was cleared in this mutation.
+func (m *FileDataMutation) CompressionCleared() bool {
+	_, ok := m.clearedFields[filedata.FieldCompression]
+	return ok
 }
 
-// SetField sets the value of a field with the given name. It returns an error if
-// the field is not defined in the schema, or if the type mismatched the field
-// type.
-func (m *SessionMutation) SetField(name string, value ent.Value) error {
-	switch name {
-	case session.FieldIDToken:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetIDToken(v)
-		return nil
-	case session.FieldExpire:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetExpire(v)
-		return nil
-	case session.FieldRefreshToken:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetRefreshToken(v)
-		return nil
+// ResetCompression resets all changes to the "compression" field.
+func (m *FileDataMutation) ResetCompression() {
+	m.compression = nil
+	delete(m.clearedFields, filedata.FieldCompression)
+}
+
+// SetCompressedSize sets the "compressed_size" field.
+func (m *FileDataMutation) SetCompressedSize(u uint64) {
+	m.compressed_size = &u
+	m.addcompressed_size = nil
+}
+
+// CompressedSize returns the value of the "compressed_size" field in the mutation.
+func (m *FileDataMutation) CompressedSize() (r uint64, exists bool) {
+	v := m.compressed_size
+	if v == nil {
+		return
 	}
-	return fmt.Errorf("unknown Session field %s", name)
+	return *v, true
 }
 
-// AddedFields returns all numeric fields that were incremented/decremented during
-// this mutation.
-func (m *SessionMutation) AddedFields() []string {
-	return nil
+// OldCompressedSize returns the old "compressed_size" field's value of the FileData entity.
+// If the FileData object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FileDataMutation) OldCompressedSize(ctx context.Context) (v *uint64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCompressedSize is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCompressedSize requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCompressedSize: %w", err)
+	}
+	return oldValue.CompressedSize, nil
 }
 
-// AddedField returns the numeric value that was incremented/decremented on a field
-// with the given name. The second boolean return value indicates that this field
-// was not set, or was not defined in the schema.
-func (m *SessionMutation) AddedField(name string) (ent.Value, bool) {
-	return nil, false
+// AddCompressedSize adds u to the "compressed_size" field.
+func (m *FileDataMutation) AddCompressedSize(u int64) {
+	if m.addcompressed_size != nil {
+		*m.addcompressed_size += u
+	} else {
+		m.addcompressed_size = &u
+	}
 }
 
-// AddField adds the value to the field with the given name. It returns an error if
-// the field is not defined in the schema, or if the type mismatched the field
-// type.
-func (m *SessionMutation) AddField(name string, value ent.Value) error {
-	switch name {
+// AddedCompressedSize returns the value that was added to the "compressed_size" field in this mutation.
+func (m *FileDataMutation) AddedCompressedSize() (r int64, exists bool) {
+	v := m.addcompressed_size
+	if v == nil {
+		return
 	}
-	return fmt.Errorf("unknown Session numeric field %s", name)
+	return *v, true
 }
 
-// ClearedFields returns all nullable fields that were cleared during this
-// mutation.
-func (m *SessionMutation) ClearedFields() []string {
-	return nil
+// ClearCompressedSize clears the value of the "compressed_size" field.
+func (m *FileDataMutation) ClearCompressedSize() {
+	m.compressed_size = nil
+	m.addcompressed_size = nil
+	m.clearedFields[filedata.FieldCompressedSize] = struct{}{}
 }
 
-// FieldCleared returns a boolean indicating if a field with the given name was
-// cleared in this mutation.
-func (m *SessionMutation) FieldCleared(name string) bool {
-	_, ok := m.clearedFields[name]
+// CompressedSizeCleared returns if the "compressed_size" field was cleared in this mutation.
+func (m *FileDataMutation) CompressedSizeCleared() bool {
+	_, ok := m.clearedFields[filedata.FieldCompressedSize]
 	return ok
 }
 
-// ClearField clears the value of the field with the given name. It returns an
-// error if the field is not defined in the schema.
-func (m *SessionMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown Session nullable field %s", name)
+// ResetCompressedSize resets all changes to the "compressed_size" field.
+func (m *FileDataMutation) ResetCompressedSize() {
+	m.compressed_size = nil
+	m.addcompressed_size = nil
+	delete(m.clearedFields, filedata.FieldCompressedSize)
 }
 
-// ResetField resets all changes in the mutation for the field with the given name.
-// It returns an error if the field is not defined in the schema.
-func (m *SessionMutation) ResetField(name string) error {
-	switch name {
-	case session.FieldIDToken:
-		m.ResetIDToken()
-		return nil
-	case session.FieldExpire:
-		m.ResetExpire()
-		return nil
-	case session.FieldRefreshToken:
-		m.ResetRefreshToken()
-		return nil
+// SetStoredSize sets the "stored_size" field.
+func (m *FileDataMutation) SetStoredSize(i int64) {
+	m.stored_size = &i
+	m.addstored_size = nil
+}
+
+// StoredSize returns the value of the "stored_size" field in the mutation.
+func (m *FileDataMutation) StoredSize() (r int64, exists bool) {
+	v := m.stored_size
+	if v == nil {
+		return
 	}
-	return fmt.Errorf("unknown Session field %s", name)
+	return *v, true
 }
 
-// AddedEdges returns all edge names that were set/added in this mutation.
-func (m *SessionMutation) AddedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.user != nil {
-		edges = append(edges, session.EdgeUser)
+// OldStoredSize returns the old "stored_size" field's value of the FileData entity.
+// If the FileData object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FileDataMutation) OldStoredSize(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStoredSize is only allowed on UpdateOne operations")
 	}
-	return edges
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStoredSize requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStoredSize: %w", err)
+	}
+	return oldValue.StoredSize, nil
 }
 
-// AddedIDs returns all IDs (to other nodes) that were added for the given edge
-// name in this mutation.
-func (m *SessionMutation) AddedIDs(name string) []ent.Value {
-	switch name {
-	case session.EdgeUser:
-		if id := m.user; id != nil {
-			return []ent.Value{*id}
-		}
+// AddStoredSize adds i to the "stored_size" field.
+func (m *FileDataMutation) AddStoredSize(i int64) {
+	if m.addstored_size != nil {
+		*m.addstored_size += i
+	} else {
+		m.addstored_size = &i
 	}
-	return nil
 }
 
-// RemovedEdges returns all edge names that were removed in this mutation.
-func (m *SessionMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 1)
-	return edges
+// AddedStoredSize returns the value that was added to the "stored_size" field in this mutation.
+func (m *FileDataMutation) AddedStoredSize() (r int64, exists bool) {
+	v := m.addstored_size
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
-// the given name in this mutation.
-func (m *SessionMutation) RemovedIDs(name string) []ent.Value {
-	return nil
+// ResetStoredSize resets all changes to the "stored_size" field.
+func (m *FileDataMutation) ResetStoredSize() {
+	m.stored_size = nil
+	m.addstored_size = nil
 }
 
-// ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *SessionMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.cleareduser {
-		edges = append(edges, session.EdgeUser)
-	}
-	return edges
-}
-
-// EdgeCleared returns a boolean which indicates if the edge with the given name
-// was cleared in this mutation.
-func (m *SessionMutation) EdgeCleared(name string) bool {
-	switch name {
-	case session.EdgeUser:
-		return m.cleareduser
-	}
-	return false
-}
-
-// ClearEdge clears the value of the edge with the given name. It returns an error
-// if that edge is not defined in the schema.
-func (m *SessionMutation) ClearEdge(name string) error {
-	switch name {
-	case session.EdgeUser:
-		m.ClearUser()
-		return nil
-	}
-	return fmt.Errorf("unknown Session unique edge %s", name)
+// SetMimeType sets the "mime_type" field.
+func (m *FileDataMutation) SetMimeType(s string) {
+	m.mime_type = &s
 }
 
-// ResetEdge resets all changes to the edge with the given name in this mutation.
-// It returns an error if the edge is not defined in the schema.
-func (m *SessionMutation) ResetEdge(name string) error {
-	switch name {
-	case session.EdgeUser:
-		m.ResetUser()
-		return nil
+// MimeType returns the value of the "mime_type" field in the mutation.
+func (m *FileDataMutation) MimeType() (r string, exists bool) {
+	v := m.mime_type
+	if v == nil {
+		return
 	}
-	return fmt.Errorf("unknown Session edge %s", name)
-}
-
-// ShareAccessTokenMutation represents an operation that mutates the ShareAccessToken nodes in the graph.
-type ShareAccessTokenMutation struct {
-	config
-	op            Op
-	typ           string
-	id            *string
-	expiry        *time.Time
-	clearedFields map[string]struct{}
-	ticket        *uuid.UUID
-	clearedticket bool
-	grant         *uuid.UUID
-	clearedgrant  bool
-	done          bool
-	oldValue      func(context.Context) (*ShareAccessToken, error)
-	predicates    []predicate.ShareAccessToken
+	return *v, true
 }
 
-var _ ent.Mutation = (*ShareAccessTokenMutation)(nil)
-
-// shareaccesstokenOption allows management of the mutation configuration using functional options.
-type shareaccesstokenOption func(*ShareAccessTokenMutation)
-
-// newShareAccessTokenMutation creates new mutation for the ShareAccessToken entity.
-func newShareAccessTokenMutation(c config, op Op, opts ...shareaccesstokenOption) *ShareAccessTokenMutation {
-	m := &ShareAccessTokenMutation{
-		config:        c,
-		op:            op,
-		typ:           TypeShareAccessToken,
-		clearedFields: make(map[string]struct{}),
+// OldMimeType returns the old "mime_type" field's value of the FileData entity.
+// If the FileData object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FileDataMutation) OldMimeType(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMimeType is only allowed on UpdateOne operations")
 	}
-	for _, opt := range opts {
-		opt(m)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMimeType requires an ID field in the mutation")
 	}
-	return m
-}
-
-// withShareAccessTokenID sets the ID field of the mutation.
-func withShareAccessTokenID(id string) shareaccesstokenOption {
-	return func(m *ShareAccessTokenMutation) {
-		var (
-			err   error
-			once  sync.Once
-			value *ShareAccessToken
-		)
-		m.oldValue = func(ctx context.Context) (*ShareAccessToken, error) {
-			once.Do(func() {
-				if m.done {
-					err = errors.New("querying old values post mutation is not allowed")
-				} else {
-					value, err = m.Client().ShareAccessToken.Get(ctx, id)
-				}
-			})
-			return value, err
-		}
-		m.id = &id
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMimeType: %w", err)
 	}
+	return oldValue.MimeType, nil
 }
 
-// withShareAccessToken sets the old ShareAccessToken of the mutation.
-func withShareAccessToken(node *ShareAccessToken) shareaccesstokenOption {
-	return func(m *ShareAccessTokenMutation) {
-		m.oldValue = func(context.Context) (*ShareAccessToken, error) {
-			return node, nil
-		}
-		m.id = &node.ID
-	}
+// ClearMimeType clears the value of the "mime_type" field.
+func (m *FileDataMutation) ClearMimeType() {
+	m.mime_type = nil
+	m.clearedFields[filedata.FieldMimeType] = struct{}{}
 }
 
-// Client returns a new `ent.Client` from the mutation. If the mutation was
-// executed in a transaction (ent.Tx), a transactional client is returned.
-func (m ShareAccessTokenMutation) Client() *Client {
-	client := &Client{config: m.config}
-	client.init()
-	return client
+// MimeTypeCleared returns if the "mime_type" field was cleared in this mutation.
+func (m *FileDataMutation) MimeTypeCleared() bool {
+	_, ok := m.clearedFields[filedata.FieldMimeType]
+	return ok
 }
 
-// Tx returns an `ent.Tx` for mutations that were executed in transactions;
-// it returns an error otherwise.
-func (m ShareAccessTokenMutation) Tx() (*Tx, error) {
-	if _, ok := m.driver.(*txDriver); !ok {
-		return nil, errors.New("ent: mutation is not running in a transaction")
-	}
-	tx := &Tx{config: m.config}
-	tx.init()
-	return tx, nil
+// ResetMimeType resets all changes to the "mime_type" field.
+func (m *FileDataMutation) ResetMimeType() {
+	m.mime_type = nil
+	delete(m.clearedFields, filedata.FieldMimeType)
 }
 
-// SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of ShareAccessToken entities.
-func (m *ShareAccessTokenMutation) SetID(id string) {
-	m.id = &id
+// SetPreviewStatus sets the "preview_status" field.
+func (m *FileDataMutation) SetPreviewStatus(s string) {
+	m.preview_status = &s
 }
 
-// ID returns the ID value in the mutation. Note that the ID is only available
-// if it was provided to the builder or after it was returned from the database.
-func (m *ShareAccessTokenMutation) ID() (id string, exists bool) {
-	if m.id == nil {
+// PreviewStatus returns the value of the "preview_status" field in the mutation.
+func (m *FileDataMutation) PreviewStatus() (r string, exists bool) {
+	v := m.preview_status
+	if v == nil {
 		return
 	}
-	return *m.id, true
+	return *v, true
 }
 
-// IDs queries the database and returns the entity ids that match the mutation's predicate.
-// That means, if the mutation is applied within a transaction with an isolation level such
-// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
-// or updated by the mutation.
-func (m *ShareAccessTokenMutation) IDs(ctx context.Context) ([]string, error) {
-	switch {
-	case m.op.Is(OpUpdateOne | OpDeleteOne):
-		id, exists := m.ID()
-		if exists {
-			return []string{id}, nil
-		}
-		fallthrough
-	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().ShareAccessToken.Query().Where(m.predicates...).IDs(ctx)
-	default:
-		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+// OldPreviewStatus returns the old "preview_status" field's value of the FileData entity.
+// If the FileData object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FileDataMutation) OldPreviewStatus(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPreviewStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPreviewStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPreviewStatus: %w", err)
 	}
+	return oldValue.PreviewStatus, nil
 }
 
-// SetExpiry sets the "expiry" field.
-func (m *ShareAccessTokenMutation) SetExpiry(t time.Time) {
-	m.expiry = &t
+// ResetPreviewStatus resets all changes to the "preview_status" field.
+func (m *FileDataMutation) ResetPreviewStatus() {
+	m.preview_status = nil
 }
 
-// Expiry returns the value of the "expiry" field in the mutation.
-func (m *ShareAccessTokenMutation) Expiry() (r time.Time, exists bool) {
-	v := m.expiry
+// SetPreviewType sets the "preview_type" field.
+func (m *FileDataMutation) SetPreviewType(s string) {
+	m.preview_type = &s
+}
+
+// PreviewType returns the value of the "preview_type" field in the mutation.
+func (m *FileDataMutation) PreviewType() (r string, exists bool) {
+	v := m.preview_type
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldExpiry returns the old "expiry" field's value of the ShareAccessToken entity.
-// If the ShareAccessToken object wasn't provided to the builder, the object is fetched from the database.
+// OldPreviewType returns the old "preview_type" field's value of the FileData entity.
+// If the FileData object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ShareAccessTokenMutation) OldExpiry(ctx context.Context) (v time.Time, err error) {
+func (m *FileDataMutation) OldPreviewType(ctx context.Context) (v *string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldExpiry is only allowed on UpdateOne operations")
+		return v, errors.New("OldPreviewType is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldExpiry requires an ID field in the mutation")
+		return v, errors.New("OldPreviewType requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldExpiry: %w", err)
+		return v, fmt.Errorf("querying old value for OldPreviewType: %w", err)
 	}
-	return oldValue.Expiry, nil
+	return oldValue.PreviewType, nil
 }
 
-// ResetExpiry resets all changes to the "expiry" field.
-func (m *ShareAccessTokenMutation) ResetExpiry() {
-	m.expiry = nil
-}
-
-// SetTicketID sets the "ticket" edge to the Ticket entity by id.
-func (m *ShareAccessTokenMutation) SetTicketID(id uuid.UUID) {
-	m.ticket = &id
+// ClearPreviewType clears the value of the "preview_type" field.
+func (m *FileDataMutation) ClearPreviewType() {
+	m.preview_type = nil
+	m.clearedFields[filedata.FieldPreviewType] = struct{}{}
 }
 
-// ClearTicket clears the "ticket" edge to the Ticket entity.
-func (m *ShareAccessTokenMutation) ClearTicket() {
-	m.clearedticket = true
+// PreviewTypeCleared returns if the "preview_type" field was cleared in this mutation.
+func (m *FileDataMutation) PreviewTypeCleared() bool {
+	_, ok := m.clearedFields[filedata.FieldPreviewType]
+	return ok
 }
 
-// TicketCleared reports if the "ticket" edge to the Ticket entity was cleared.
-func (m *ShareAccessTokenMutation) TicketCleared() bool {
-	return m.clearedticket
+// ResetPreviewType resets all changes to the "preview_type" field.
+func (m *FileDataMutation) ResetPreviewType() {
+	m.preview_type = nil
+	delete(m.clearedFields, filedata.FieldPreviewType)
 }
 
-// TicketID returns the "ticket" edge ID in the mutation.
-func (m *ShareAccessTokenMutation) TicketID() (id uuid.UUID, exists bool) {
-	if m.ticket != nil {
-		return *m.ticket, true
+// AddFileIDs adds the "files" edge to the File entity by ids.
+func (m *FileDataMutation) AddFileIDs(ids ...uuid.UUID) {
+	if m.files == nil {
+		m.files = make(map[uuid.UUID]struct{})
 	}
-	return
-}
-
-// TicketIDs returns the "ticket" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// TicketID instead. It exists only for internal usage by the builders.
-func (m *ShareAccessTokenMutation) TicketIDs() (ids []uuid.UUID) {
-	if id := m.ticket; id != nil {
-		ids = append(ids, *id)
+	for i := range ids {
+		m.files[ids[i]] = struct{}{}
 	}
-	return
-}
-
-// ResetTicket resets all changes to the "ticket" edge.
-func (m *ShareAccessTokenMutation) ResetTicket() {
-	m.ticket = nil
-	m.clearedticket = false
 }
 
-// SetGrantID sets the "grant" edge to the Grant entity by id.
-func (m *ShareAccessTokenMutation) SetGrantID(id uuid.UUID) {
-	m.grant = &id
+// ClearFiles clears the "files" edge to the File entity.
+func (m *FileDataMutation) ClearFiles() {
+	m.clearedfiles = true
 }
 
-// ClearGrant clears the "grant" edge to the Grant entity.
-func (m *ShareAccessTokenMutation) ClearGrant() {
-	m.clearedgrant = true
+// FilesCleared reports if the "files" edge to the File entity was cleared.
+func (m *FileDataMutation) FilesCleared() bool {
+	return m.clearedfiles
 }
 
-// GrantCleared reports if the "grant" edge to the Grant entity was cleared.
-func (m *ShareAccessTokenMutation) GrantCleared() bool {
-	return m.clearedgrant
+// RemoveFileIDs removes the "files" edge to the File entity by IDs.
+func (m *FileDataMutation) RemoveFileIDs(ids ...uuid.UUID) {
+	if m.removedfiles == nil {
+		m.removedfiles = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.files, ids[i])
+		m.removedfiles[ids[i]] = struct{}{}
+	}
 }
 
-// GrantID returns the "grant" edge ID in the mutation.
-func (m *ShareAccessTokenMutation) GrantID() (id uuid.UUID, exists bool) {
-	if m.grant != nil {
-		return *m.grant, true
+// RemovedFiles returns the removed IDs of the "files" edge to the File entity.
+func (m *FileDataMutation) RemovedFilesIDs() (ids []uuid.UUID) {
+	for id := range m.removedfiles {
+		ids = append(ids, id)
 	}
 	return
 }
 
-// GrantIDs returns the "grant" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// GrantID instead. It exists only for internal usage by the builders.
-func (m *ShareAccessTokenMutation) GrantIDs() (ids []uuid.UUID) {
-	if id := m.grant; id != nil {
-		ids = append(ids, *id)
+// FilesIDs returns the "files" edge IDs in the mutation.
+func (m *FileDataMutation) FilesIDs() (ids []uuid.UUID) {
+	for id := range m.files {
+		ids = append(ids, id)
 	}
 	return
 }
 
-// ResetGrant resets all changes to the "grant" edge.
-func (m *ShareAccessTokenMutation) ResetGrant() {
-	m.grant = nil
-	m.clearedgrant = false
-}
+// ResetFiles resets all changes to the "files" edge.
+func (m *FileDataMutation) ResetFiles() {
+	m.files = nil
+	m.clearedfiles = false
+	m.removedfiles = nil
+}
 
-// Where appends a list predicates to the ShareAccessTokenMutation builder.
-func (m *ShareAccessTokenMutation) Where(ps ...predicate.ShareAccessToken) {
+// Where appends a list predicates to the FileDataMutation builder.
+func (m *FileDataMutation) Where(ps ...predicate.FileData) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the ShareAccessTokenMutation builder. Using this method,
+// WhereP appends storage-level predicates to the FileDataMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *ShareAccessTokenMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.ShareAccessToken, len(ps))
+func (m *FileDataMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.FileData, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -4052,27 +3932,54 @@ func (m *ShareAccessTokenMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *ShareAccessTokenMutation) Op() Op {
+func (m *FileDataMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *ShareAccessTokenMutation) SetOp(op Op) {
+func (m *FileDataMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (ShareAccessToken).
-func (m *ShareAccessTokenMutation) Type() string {
+// Type returns the node type of this mutation (FileData).
+func (m *FileDataMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *ShareAccessTokenMutation) Fields() []string {
-	fields := make([]string, 0, 1)
-	if m.expiry != nil {
-		fields = append(fields, shareaccesstoken.FieldExpiry)
+func (m *FileDataMutation) Fields() []string {
+	fields := make([]string, 0, 10)
+	if m.size != nil {
+		fields = append(fields, filedata.FieldSize)
+	}
+	if m.sha256 != nil {
+		fields = append(fields, filedata.FieldSha256)
+	}
+	if m.key_id != nil {
+		fields = append(fields, filedata.FieldKeyID)
+	}
+	if m.encrypted_key != nil {
+		fields = append(fields, filedata.FieldEncryptedKey)
+	}
+	if m.compression != nil {
+		fields = append(fields, filedata.FieldCompression)
+	}
+	if m.compressed_size != nil {
+		fields = append(fields, filedata.FieldCompressedSize)
+	}
+	if m.stored_size != nil {
+		fields = append(fields, filedata.FieldStoredSize)
+	}
+	if m.mime_type != nil {
+		fields = append(fields, filedata.FieldMimeType)
+	}
+	if m.preview_status != nil {
+		fields = append(fields, filedata.FieldPreviewStatus)
+	}
+	if m.preview_type != nil {
+		fields = append(fields, filedata.FieldPreviewType)
 	}
 	return fields
 }
@@ -4080,10 +3987,28 @@ func (m *ShareAccessTokenMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *ShareAccessTokenMutation) Field(name string) (ent.Value, bool) {
+func (m *FileDataMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case shareaccesstoken.FieldExpiry:
-		return m.Expiry()
+	case filedata.FieldSize:
+		return m.Size()
+	case filedata.FieldSha256:
+		return m.Sha256()
+	case filedata.FieldKeyID:
+		return m.KeyID()
+	case filedata.FieldEncryptedKey:
+		return m.EncryptedKey()
+	case filedata.FieldCompression:
+		return m.Compression()
+	case filedata.FieldCompressedSize:
+		return m.CompressedSize()
+	case filedata.FieldStoredSize:
+		return m.StoredSize()
+	case filedata.FieldMimeType:
+		return m.MimeType()
+	case filedata.FieldPreviewStatus:
+		return m.PreviewStatus()
+	case filedata.FieldPreviewType:
+		return m.PreviewType()
 	}
 	return nil, false
 }
@@ -4091,219 +4016,419 @@ func (m *ShareAccessTokenMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *ShareAccessTokenMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *FileDataMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case shareaccesstoken.FieldExpiry:
-		return m.OldExpiry(ctx)
+	case filedata.FieldSize:
+		return m.OldSize(ctx)
+	case filedata.FieldSha256:
+		return m.OldSha256(ctx)
+	case filedata.FieldKeyID:
+		return m.OldKeyID(ctx)
+	case filedata.FieldEncryptedKey:
+		return m.OldEncryptedKey(ctx)
+	case filedata.FieldCompression:
+		return m.OldCompression(ctx)
+	case filedata.FieldCompressedSize:
+		return m.OldCompressedSize(ctx)
+	case filedata.FieldStoredSize:
+		return m.OldStoredSize(ctx)
+	case filedata.FieldMimeType:
+		return m.OldMimeType(ctx)
+	case filedata.FieldPreviewStatus:
+		return m.OldPreviewStatus(ctx)
+	case filedata.FieldPreviewType:
+		return m.OldPreviewType(ctx)
 	}
-	return nil, fmt.Errorf("unknown ShareAccessToken field %s", name)
+	return nil, fmt.Errorf("unknown FileData field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *ShareAccessTokenMutation) SetField(name string, value ent.Value) error {
+func (m *FileDataMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case shareaccesstoken.FieldExpiry:
-		v, ok := value.(time.Time)
+	case filedata.FieldSize:
+		v, ok := value.(uint64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetExpiry(v)
+		m.SetSize(v)
+		return nil
+	case filedata.FieldSha256:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSha256(v)
+		return nil
+	case filedata.FieldKeyID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetKeyID(v)
+		return nil
+	case filedata.FieldEncryptedKey:
+		v, ok := value.([]byte)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEncryptedKey(v)
+		return nil
+	case filedata.FieldCompression:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCompression(v)
+		return nil
+	case filedata.FieldCompressedSize:
+		v, ok := value.(uint64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCompressedSize(v)
+		return nil
+	case filedata.FieldStoredSize:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStoredSize(v)
+		return nil
+	case filedata.FieldMimeType:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMimeType(v)
+		return nil
+	case filedata.FieldPreviewStatus:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPreviewStatus(v)
+		return nil
+	case filedata.FieldPreviewType:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPreviewType(v)
 		return nil
 	}
-	return fmt.Errorf("unknown ShareAccessToken field %s", name)
+	return fmt.Errorf("unknown FileData field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *ShareAccessTokenMutation) AddedFields() []string {
-	return nil
+func (m *FileDataMutation) AddedFields() []string {
+	var fields []string
+	if m.addsize != nil {
+		fields = append(fields, filedata.FieldSize)
+	}
+	if m.addcompressed_size != nil {
+		fields = append(fields, filedata.FieldCompressedSize)
+	}
+	if m.addstored_size != nil {
+		fields = append(fields, filedata.FieldStoredSize)
+	}
+	return fields
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *ShareAccessTokenMutation) AddedField(name string) (ent.Value, bool) {
+func (m *FileDataMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case filedata.FieldSize:
+		return m.AddedSize()
+	case filedata.FieldCompressedSize:
+		return m.AddedCompressedSize()
+	case filedata.FieldStoredSize:
+		return m.AddedStoredSize()
+	}
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *ShareAccessTokenMutation) AddField(name string, value ent.Value) error {
+func (m *FileDataMutation) AddField(name string, value ent.Value) error {
 	switch name {
+	case filedata.FieldSize:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddSize(v)
+		return nil
+	case filedata.FieldCompressedSize:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddCompressedSize(v)
+		return nil
+	case filedata.FieldStoredSize:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddStoredSize(v)
+		return nil
 	}
-	return fmt.Errorf("unknown ShareAccessToken numeric field %s", name)
+	return fmt.Errorf("unknown FileData numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *ShareAccessTokenMutation) ClearedFields() []string {
-	return nil
+func (m *FileDataMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(filedata.FieldSha256) {
+		fields = append(fields, filedata.FieldSha256)
+	}
+	if m.FieldCleared(filedata.FieldKeyID) {
+		fields = append(fields, filedata.FieldKeyID)
+	}
+	if m.FieldCleared(filedata.FieldEncryptedKey) {
+		fields = append(fields, filedata.FieldEncryptedKey)
+	}
+	if m.FieldCleared(filedata.FieldCompression) {
+		fields = append(fields, filedata.FieldCompression)
+	}
+	if m.FieldCleared(filedata.FieldCompressedSize) {
+		fields = append(fields, filedata.FieldCompressedSize)
+	}
+	if m.FieldCleared(filedata.FieldMimeType) {
+		fields = append(fields, filedata.FieldMimeType)
+	}
+	if m.FieldCleared(filedata.FieldPreviewType) {
+		fields = append(fields, filedata.FieldPreviewType)
+	}
+	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *ShareAccessTokenMutation) FieldCleared(name string) bool {
+func (m *FileDataMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *ShareAccessTokenMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown ShareAccessToken nullable field %s", name)
+func (m *FileDataMutation) ClearField(name string) error {
+	switch name {
+	case filedata.FieldSha256:
+		m.ClearSha256()
+		return nil
+	case filedata.FieldKeyID:
+		m.ClearKeyID()
+		return nil
+	case filedata.FieldEncryptedKey:
+		m.ClearEncryptedKey()
+		return nil
+	case filedata.FieldCompression:
+		m.ClearCompression()
+		return nil
+	case filedata.FieldCompressedSize:
+		m.ClearCompressedSize()
+		return nil
+	case filedata.FieldMimeType:
+		m.ClearMimeType()
+		return nil
+	case filedata.FieldPreviewType:
+		m.ClearPreviewType()
+		return nil
+	}
+	return fmt.Errorf("unknown FileData nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *ShareAccessTokenMutation) ResetField(name string) error {
+func (m *FileDataMutation) ResetField(name string) error {
 	switch name {
-	case shareaccesstoken.FieldExpiry:
-		m.ResetExpiry()
+	case filedata.FieldSize:
+		m.ResetSize()
+		return nil
+	case filedata.FieldSha256:
+		m.ResetSha256()
+		return nil
+	case filedata.FieldKeyID:
+		m.ResetKeyID()
+		return nil
+	case filedata.FieldEncryptedKey:
+		m.ResetEncryptedKey()
+		return nil
+	case filedata.FieldCompression:
+		m.ResetCompression()
+		return nil
+	case filedata.FieldCompressedSize:
+		m.ResetCompressedSize()
+		return nil
+	case filedata.FieldStoredSize:
+		m.ResetStoredSize()
+		return nil
+	case filedata.FieldMimeType:
+		m.ResetMimeType()
+		return nil
+	case filedata.FieldPreviewStatus:
+		m.ResetPreviewStatus()
+		return nil
+	case filedata.FieldPreviewType:
+		m.ResetPreviewType()
 		return nil
 	}
-	return fmt.Errorf("unknown ShareAccessToken field %s", name)
+	return fmt.Errorf("unknown FileData field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *ShareAccessTokenMutation) AddedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.ticket != nil {
-		edges = append(edges, shareaccesstoken.EdgeTicket)
-	}
-	if m.grant != nil {
-		edges = append(edges, shareaccesstoken.EdgeGrant)
+func (m *FileDataMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.files != nil {
+		edges = append(edges, filedata.EdgeFiles)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *ShareAccessTokenMutation) AddedIDs(name string) []ent.Value {
+func (m *FileDataMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case shareaccesstoken.EdgeTicket:
-		if id := m.ticket; id != nil {
-			return []ent.Value{*id}
-		}
-	case shareaccesstoken.EdgeGrant:
-		if id := m.grant; id != nil {
-			return []ent.Value{*id}
+	case filedata.EdgeFiles:
+		ids := make([]ent.Value, 0, len(m.files))
+		for id := range m.files {
+			ids = append(ids, id)
 		}
+		return ids
 	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *ShareAccessTokenMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 2)
+func (m *FileDataMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.removedfiles != nil {
+		edges = append(edges, filedata.EdgeFiles)
+	}
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *ShareAccessTokenMutation) RemovedIDs(name string) []ent.Value {
+func (m *FileDataMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case filedata.EdgeFiles:
+		ids := make([]ent.Value, 0, len(m.removedfiles))
+		for id := range m.removedfiles {
+			ids = append(ids, id)
+		}
+		return ids
+	}
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *ShareAccessTokenMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.clearedticket {
-		edges = append(edges, shareaccesstoken.EdgeTicket)
-	}
-	if m.clearedgrant {
-		edges = append(edges, shareaccesstoken.EdgeGrant)
+func (m *FileDataMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedfiles {
+		edges = append(edges, filedata.EdgeFiles)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *ShareAccessTokenMutation) EdgeCleared(name string) bool {
+func (m *FileDataMutation) EdgeCleared(name string) bool {
 	switch name {
-	case shareaccesstoken.EdgeTicket:
-		return m.clearedticket
-	case shareaccesstoken.EdgeGrant:
-		return m.clearedgrant
+	case filedata.EdgeFiles:
+		return m.clearedfiles
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *ShareAccessTokenMutation) ClearEdge(name string) error {
+func (m *FileDataMutation) ClearEdge(name string) error {
 	switch name {
-	case shareaccesstoken.EdgeTicket:
-		m.ClearTicket()
-		return nil
-	case shareaccesstoken.EdgeGrant:
-		m.ClearGrant()
-		return nil
 	}
-	return fmt.Errorf("unknown ShareAccessToken unique edge %s", name)
+	return fmt.Errorf("unknown FileData unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *ShareAccessTokenMutation) ResetEdge(name string) error {
+func (m *FileDataMutation) ResetEdge(name string) error {
 	switch name {
-	case shareaccesstoken.EdgeTicket:
-		m.ResetTicket()
-		return nil
-	case shareaccesstoken.EdgeGrant:
-		m.ResetGrant()
+	case filedata.EdgeFiles:
+		m.ResetFiles()
 		return nil
 	}
-	return fmt.Errorf("unknown ShareAccessToken edge %s", name)
+	return fmt.Errorf("unknown FileData edge %s", name)
 }
 
-// TicketMutation represents an operation that mutates the Ticket nodes in the graph.
-type TicketMutation struct {
+// GrantMutation represents an operation that mutates the Grant nodes in the graph.
+type GrantMutation struct {
 	config
-	op                                 Op
-	typ                                string
-	id                                 *uuid.UUID
-	comment                            *string
-	expiryType                         *string
-	hashed_password                    *string
-	salt                               *string
-	created_at                         *time.Time
-	expiry_total_days                  *uint8
-	addexpiry_total_days               *int8
-	expiry_days_since_last_download    *uint8
-	addexpiry_days_since_last_download *int8
-	expiry_total_downloads             *uint8
-	addexpiry_total_downloads          *int8
-	email_on_download                  *[]string
-	appendemail_on_download            []string
-	creator_lang                       *string
-	clearedFields                      map[string]struct{}
-	files                              map[uuid.UUID]struct{}
-	removedfiles                       map[uuid.UUID]struct{}
-	clearedfiles                       bool
-	owner                              *uuid.UUID
-	clearedowner                       bool
-	shareaccesstokens                  map[string]struct{}
-	removedshareaccesstokens           map[string]struct{}
-	clearedshareaccesstokens           bool
-	done                               bool
-	oldValue                           func(context.Context) (*Ticket, error)
-	predicates                         []predicate.Ticket
+	op                                      Op
+	typ                                     string
+	id                                      *uuid.UUID
+	comment                                 *string
+	expiry_type                             *string
+	hashed_password                         *string
+	salt                                    *string
+	created_at                              *time.Time
+	expiry_total_days                       *uint8
+	addexpiry_total_days                    *int8
+	expiry_days_since_last_upload           *uint8
+	addexpiry_days_since_last_upload        *int8
+	expiry_total_uploads                    *uint8
+	addexpiry_total_uploads                 *int8
+	file_expiry_type                        *string
+	file_expiry_total_days                  *uint8
+	addfile_expiry_total_days               *int8
+	file_expiry_days_since_last_download    *uint8
+	addfile_expiry_days_since_last_download *int8
+	file_expiry_total_downloads             *uint8
+	addfile_expiry_total_downloads          *int8
+	last_upload                             *time.Time
+	times_uploaded                          *uint64
+	addtimes_uploaded                       *int64
+	email_on_upload                         *[]string
+	appendemail_on_upload                   []string
+	creator_lang                            *string
+	clearedFields                           map[string]struct{}
+	files                                   map[uuid.UUID]struct{}
+	removedfiles                            map[uuid.UUID]struct{}
+	clearedfiles                            bool
+	owner                                   *uuid.UUID
+	clearedowner                            bool
+	shareaccesstokens                       map[string]struct{}
+	removedshareaccesstokens                map[string]struct{}
+	clearedshareaccesstokens                bool
+	uploads                                 map[uuid.UUID]struct{}
+	removeduploads                          map[uuid.UUID]struct{}
+	cleareduploads                          bool
+	access_events                           map[int]struct{}
+	removedaccess_events                    map[int]struct{}
+	clearedaccess_events                    bool
+	done                                    bool
+	oldValue                                func(context.Context) (*Grant, error)
+	predicates                              []predicate.Grant
 }
 
-var _ ent.Mutation = (*TicketMutation)(nil)
+var _ ent.Mutation = (*GrantMutation)(nil)
 
-// ticketOption allows management of the mutation configuration using functional options.
-type ticketOption func(*TicketMutation)
+// grantOption allows management of the mutation configuration using functional options.
+type grantOption func(*GrantMutation)
 
-// newTicketMutation creates new mutation for the Ticket entity.
-func newTicketMutation(c config, op Op, opts ...ticketOption) *TicketMutation {
-	m := &TicketMutation{
+// newGrantMutation creates new mutation for the Grant entity.
+func newGrantMutation(c config, op Op, opts ...grantOption) *GrantMutation {
+	m := &GrantMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeTicket,
+		typ:           TypeGrant,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -4312,20 +4437,20 @@ func newTicketMutation(c config, op Op, opts ...ticketOption) *TicketMutation {
 	return m
 }
 
-// withTicketID sets the ID field of the mutation.
-func withTicketID(id uuid.UUID) ticketOption {
-	return func(m *TicketMutation) {
+// withGrantID sets the ID field of the mutation.
+func withGrantID(id uuid.UUID) grantOption {
+	return func(m *GrantMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Ticket
+			value *Grant
 		)
-		m.oldValue = func(ctx context.Context) (*Ticket, error) {
+		m.oldValue = func(ctx context.Context) (*Grant, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Ticket.Get(ctx, id)
+					value, err = m.Client().Grant.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -4334,10 +4459,10 @@ func withTicketID(id uuid.UUID) ticketOption {
 	}
 }
 
-// withTicket sets the old Ticket of the mutation.
-func withTicket(node *Ticket) ticketOption {
-	return func(m *TicketMutation) {
-		m.oldValue = func(context.Context) (*Ticket, error) {
+// withGrant sets the old Grant of the mutation.
+func withGrant(node *Grant) grantOption {
+	return func(m *GrantMutation) {
+		m.oldValue = func(context.Context) (*Grant, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -4346,7 +4471,7 @@ func withTicket(node *Ticket) ticketOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m TicketMutation) Client() *Client {
+func (m GrantMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -4354,7 +4479,7 @@ func (m TicketMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m TicketMutation) Tx() (*Tx, error) {
+func (m GrantMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -4364,14 +4489,14 @@ func (m TicketMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of Ticket entities.
-func (m *TicketMutation) SetID(id uuid.UUID) {
+// operation is only accepted on creation of Grant entities.
+func (m *GrantMutation) SetID(id uuid.UUID) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *TicketMutation) ID() (id uuid.UUID, exists bool) {
+func (m *GrantMutation) ID() (id uuid.UUID, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -4382,7 +4507,7 @@ func (m *TicketMutation) ID() (id uuid.UUID, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *TicketMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+func (m *GrantMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -4391,19 +4516,19 @@ func (m *TicketMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Ticket.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().Grant.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
 // SetComment sets the "comment" field.
-func (m *TicketMutation) SetComment(s string) {
+func (m *GrantMutation) SetComment(s string) {
 	m.comment = &s
 }
 
 // Comment returns the value of the "comment" field in the mutation.
-func (m *TicketMutation) Comment() (r string, exists bool) {
+func (m *GrantMutation) Comment() (r string, exists bool) {
 	v := m.comment
 	if v == nil {
 		return
@@ -4411,10 +4536,10 @@ func (m *TicketMutation) Comment() (r string, exists bool) {
 	return *v, true
 }
 
-// OldComment returns the old "comment" field's value of the Ticket entity.
-// If the Ticket object wasn't provided to the builder, the object is fetched from the database.
+// OldComment returns the old "comment" field's value of the Grant entity.
+// If the Grant object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TicketMutation) OldComment(ctx context.Context) (v *string, err error) {
+func (m *GrantMutation) OldComment(ctx context.Context) (v *string, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldComment is only allowed on UpdateOne operations")
 	}
@@ -4429,41 +4554,41 @@ func (m *TicketMutation) OldComment(ctx context.Context) (v *string, err error)
 }
 
 // ClearComment clears the value of the "comment" field.
-func (m *TicketMutation) ClearComment() {
+func (m *GrantMutation) ClearComment() {
 	m.comment = nil
-	m.clearedFields[ticket.FieldComment] = struct{}{}
+	m.clearedFields[grant.FieldComment] = struct{}{}
 }
 
 // CommentCleared returns if the "comment" field was cleared in this mutation.
-func (m *TicketMutation) CommentCleared() bool {
-	_, ok := m.clearedFields[ticket.FieldComment]
+func (m *GrantMutation) CommentCleared() bool {
+	_, ok := m.clearedFields[grant.FieldComment]
 	return ok
 }
 
 // ResetComment resets all changes to the "comment" field.
-func (m *TicketMutation) ResetComment() {
+func (m *GrantMutation) ResetComment() {
 	m.comment = nil
-	delete(m.clearedFields, ticket.FieldComment)
+	delete(m.clearedFields, grant.FieldComment)
 }
 
-// SetExpiryType sets the "expiryType" field.
-func (m *TicketMutation) SetExpiryType(s string) {
-	m.expiryType = &s
+// SetExpiryType sets the "expiry_type" field.
+func (m *GrantMutation) SetExpiryType(s string) {
+	m.expiry_type = &s
 }
 
-// ExpiryType returns the value of the "expiryType" field in the mutation.
-func (m *TicketMutation) ExpiryType() (r string, exists bool) {
-	v := m.expiryType
+// ExpiryType returns the value of the "expiry_type" field in the mutation.
+func (m *GrantMutation) ExpiryType() (r string, exists bool) {
+	v := m.expiry_type
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldExpiryType returns the old "expiryType" field's value of the Ticket entity.
-// If the Ticket object wasn't provided to the builder, the object is fetched from the database.
+// OldExpiryType returns the old "expiry_type" field's value of the Grant entity.
+// If the Grant object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TicketMutation) OldExpiryType(ctx context.Context) (v string, err error) {
+func (m *GrantMutation) OldExpiryType(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldExpiryType is only allowed on UpdateOne operations")
 	}
@@ -4477,18 +4602,18 @@ func (m *TicketMutation) OldExpiryType(ctx context.Context) (v string, err error
 	return oldValue.ExpiryType, nil
 }
 
-// ResetExpiryType resets all changes to the "expiryType" field.
-func (m *TicketMutation) ResetExpiryType() {
-	m.expiryType = nil
+// ResetExpiryType resets all changes to the "expiry_type" field.
+func (m *GrantMutation) ResetExpiryType() {
+	m.expiry_type = nil
 }
 
 // SetHashedPassword sets the "hashed_password" field.
-func (m *TicketMutation) SetHashedPassword(s string) {
+func (m *GrantMutation) SetHashedPassword(s string) {
 	m.hashed_password = &s
 }
 
 // HashedPassword returns the value of the "hashed_password" field in the mutation.
-func (m *TicketMutation) HashedPassword() (r string, exists bool) {
+func (m *GrantMutation) HashedPassword() (r string, exists bool) {
 	v := m.hashed_password
 	if v == nil {
 		return
@@ -4496,10 +4621,10 @@ func (m *TicketMutation) HashedPassword() (r string, exists bool) {
 	return *v, true
 }
 
-// OldHashedPassword returns the old "hashed_password" field's value of the Ticket entity.
-// If the Ticket object wasn't provided to the builder, the object is fetched from the database.
+// OldHashedPassword returns the old "hashed_password" field's value of the Grant entity.
+// If the Grant object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TicketMutation) OldHashedPassword(ctx context.Context) (v string, err error) {
+func (m *GrantMutation) OldHashedPassword(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldHashedPassword is only allowed on UpdateOne operations")
 	}
@@ -4514,17 +4639,17 @@ func (m *TicketMutation) OldHashedPassword(ctx context.Context) (v string, err e
 }
 
 // ResetHashedPassword resets all changes to the "hashed_password" field.
-func (m *TicketMutation) ResetHashedPassword() {
+func (m *GrantMutation) ResetHashedPassword() {
 	m.hashed_password = nil
 }
 
 // SetSalt sets the "salt" field.
-func (m *TicketMutation) SetSalt(s string) {
+func (m *GrantMutation) SetSalt(s string) {
 	m.salt = &s
 }
 
 // Salt returns the value of the "salt" field in the mutation.
-func (m *TicketMutation) Salt() (r string, exists bool) {
+func (m *GrantMutation) Salt() (r string, exists bool) {
 	v := m.salt
 	if v == nil {
 		return
@@ -4532,10 +4657,10 @@ func (m *TicketMutation) Salt() (r string, exists bool) {
 	return *v, true
 }
 
-// OldSalt returns the old "salt" field's value of the Ticket entity.
-// If the Ticket object wasn't provided to the builder, the object is fetched from the database.
+// OldSalt returns the old "salt" field's value of the Grant entity.
+// If the Grant object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TicketMutation) OldSalt(ctx context.Context) (v string, err error) {
+func (m *GrantMutation) OldSalt(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldSalt is only allowed on UpdateOne operations")
 	}
@@ -4550,17 +4675,17 @@ func (m *TicketMutation) OldSalt(ctx context.Context) (v string, err error) {
 }
 
 // ResetSalt resets all changes to the "salt" field.
-func (m *TicketMutation) ResetSalt() {
+func (m *GrantMutation) ResetSalt() {
 	m.salt = nil
 }
 
 // SetCreatedAt sets the "created_at" field.
-func (m *TicketMutation) SetCreatedAt(t time.Time) {
+func (m *GrantMutation) SetCreatedAt(t time.Time) {
 	m.created_at = &t
 }
 
 // CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *TicketMutation) CreatedAt() (r time.Time, exists bool) {
+func (m *GrantMutation) CreatedAt() (r time.Time, exists bool) {
 	v := m.created_at
 	if v == nil {
 		return
@@ -4568,10 +4693,10 @@ func (m *TicketMutation) CreatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the Ticket entity.
-// If the Ticket object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the Grant entity.
+// If the Grant object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TicketMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *GrantMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
@@ -4582,354 +4707,5995 @@ func (m *TicketMutation) OldCreatedAt(ctx context.Context) (v time.Time, err err
 	if err != nil {
 		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
 	}
-	return oldValue.CreatedAt, nil
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *GrantMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetExpiryTotalDays sets the "expiry_total_days" field.
+func (m *GrantMutation) SetExpiryTotalDays(u uint8) {
+	m.expiry_total_days = &u
+	m.addexpiry_total_days = nil
+}
+
+// ExpiryTotalDays returns the value of the "expiry_total_days" field in the mutation.
+func (m *GrantMutation) ExpiryTotalDays() (r uint8, exists bool) {
+	v := m.expiry_total_days
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldExpiryTotalDays returns the old "expiry_total_days" field's value of the Grant entity.
+// If the Grant object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GrantMutation) OldExpiryTotalDays(ctx context.Context) (v uint8, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldExpiryTotalDays is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldExpiryTotalDays requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldExpiryTotalDays: %w", err)
+	}
+	return oldValue.ExpiryTotalDays, nil
+}
+
+// AddExpiryTotalDays adds u to the "expiry_total_days" field.
+func (m *GrantMutation) AddExpiryTotalDays(u int8) {
+	if m.addexpiry_total_days != nil {
+		*m.addexpiry_total_days += u
+	} else {
+		m.addexpiry_total_days = &u
+	}
+}
+
+// AddedExpiryTotalDays returns the value that was added to the "expiry_total_days" field in this mutation.
+func (m *GrantMutation) AddedExpiryTotalDays() (r int8, exists bool) {
+	v := m.addexpiry_total_days
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetExpiryTotalDays resets all changes to the "expiry_total_days" field.
+func (m *GrantMutation) ResetExpiryTotalDays() {
+	m.expiry_total_days = nil
+	m.addexpiry_total_days = nil
+}
+
+// SetExpiryDaysSinceLastUpload sets the "expiry_days_since_last_upload" field.
+func (m *GrantMutation) SetExpiryDaysSinceLastUpload(u uint8) {
+	m.expiry_days_since_last_upload = &u
+	m.addexpiry_days_since_last_upload = nil
+}
+
+// ExpiryDaysSinceLastUpload returns the value of the "expiry_days_since_last_upload" field in the mutation.
+func (m *GrantMutation) ExpiryDaysSinceLastUpload() (r uint8, exists bool) {
+	v := m.expiry_days_since_last_upload
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldExpiryDaysSinceLastUpload returns the old "expiry_days_since_last_upload" field's value of the Grant entity.
+// If the Grant object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GrantMutation) OldExpiryDaysSinceLastUpload(ctx context.Context) (v uint8, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldExpiryDaysSinceLastUpload is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldExpiryDaysSinceLastUpload requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldExpiryDaysSinceLastUpload: %w", err)
+	}
+	return oldValue.ExpiryDaysSinceLastUpload, nil
+}
+
+// AddExpiryDaysSinceLastUpload adds u to the "expiry_days_since_last_upload" field.
+func (m *GrantMutation) AddExpiryDaysSinceLastUpload(u int8) {
+	if m.addexpiry_days_since_last_upload != nil {
+		*m.addexpiry_days_since_last_upload += u
+	} else {
+		m.addexpiry_days_since_last_upload = &u
+	}
+}
+
+// AddedExpiryDaysSinceLastUpload returns the value that was added to the "expiry_days_since_last_upload" field in this mutation.
+func (m *GrantMutation) AddedExpiryDaysSinceLastUpload() (r int8, exists bool) {
+	v := m.addexpiry_days_since_last_upload
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetExpiryDaysSinceLastUpload resets all changes to the "expiry_days_since_last_upload" field.
+func (m *GrantMutation) ResetExpiryDaysSinceLastUpload() {
+	m.expiry_days_since_last_upload = nil
+	m.addexpiry_days_since_last_upload = nil
+}
+
+// SetExpiryTotalUploads sets the "expiry_total_uploads" field.
+func (m *GrantMutation) SetExpiryTotalUploads(u uint8) {
+	m.expiry_total_uploads = &u
+	m.addexpiry_total_uploads = nil
+}
+
+// ExpiryTotalUploads returns the value of the "expiry_total_uploads" field in the mutation.
+func (m *GrantMutation) ExpiryTotalUploads() (r uint8, exists bool) {
+	v := m.expiry_total_uploads
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldExpiryTotalUploads returns the old "expiry_total_uploads" field's value of the Grant entity.
+// If the Grant object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GrantMutation) OldExpiryTotalUploads(ctx context.Context) (v uint8, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldExpiryTotalUploads is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldExpiryTotalUploads requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldExpiryTotalUploads: %w", err)
+	}
+	return oldValue.ExpiryTotalUploads, nil
+}
+
+// AddExpiryTotalUploads adds u to the "expiry_total_uploads" field.
+func (m *GrantMutation) AddExpiryTotalUploads(u int8) {
+	if m.addexpiry_total_uploads != nil {
+		*m.addexpiry_total_uploads += u
+	} else {
+		m.addexpiry_total_uploads = &u
+	}
+}
+
+// AddedExpiryTotalUploads returns the value that was added to the "expiry_total_uploads" field in this mutation.
+func (m *GrantMutation) AddedExpiryTotalUploads() (r int8, exists bool) {
+	v := m.addexpiry_total_uploads
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetExpiryTotalUploads resets all changes to the "expiry_total_uploads" field.
+func (m *GrantMutation) ResetExpiryTotalUploads() {
+	m.expiry_total_uploads = nil
+	m.addexpiry_total_uploads = nil
+}
+
+// SetFileExpiryType sets the "file_expiry_type" field.
+func (m *GrantMutation) SetFileExpiryType(s string) {
+	m.file_expiry_type = &s
+}
+
+// FileExpiryType returns the value of the "file_expiry_type" field in the mutation.
+func (m *GrantMutation) FileExpiryType() (r string, exists bool) {
+	v := m.file_expiry_type
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFileExpiryType returns the old "file_expiry_type" field's value of the Grant entity.
+// If the Grant object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GrantMutation) OldFileExpiryType(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFileExpiryType is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFileExpiryType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFileExpiryType: %w", err)
+	}
+	return oldValue.FileExpiryType, nil
+}
+
+// ResetFileExpiryType resets all changes to the "file_expiry_type" field.
+func (m *GrantMutation) ResetFileExpiryType() {
+	m.file_expiry_type = nil
+}
+
+// SetFileExpiryTotalDays sets the "file_expiry_total_days" field.
+func (m *GrantMutation) SetFileExpiryTotalDays(u uint8) {
+	m.file_expiry_total_days = &u
+	m.addfile_expiry_total_days = nil
+}
+
+// FileExpiryTotalDays returns the value of the "file_expiry_total_days" field in the mutation.
+func (m *GrantMutation) FileExpiryTotalDays() (r uint8, exists bool) {
+	v := m.file_expiry_total_days
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFileExpiryTotalDays returns the old "file_expiry_total_days" field's value of the Grant entity.
+// If the Grant object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GrantMutation) OldFileExpiryTotalDays(ctx context.Context) (v uint8, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFileExpiryTotalDays is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFileExpiryTotalDays requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFileExpiryTotalDays: %w", err)
+	}
+	return oldValue.FileExpiryTotalDays, nil
+}
+
+// AddFileExpiryTotalDays adds u to the "file_expiry_total_days" field.
+func (m *GrantMutation) AddFileExpiryTotalDays(u int8) {
+	if m.addfile_expiry_total_days != nil {
+		*m.addfile_expiry_total_days += u
+	} else {
+		m.addfile_expiry_total_days = &u
+	}
+}
+
+// AddedFileExpiryTotalDays returns the value that was added to the "file_expiry_total_days" field in this mutation.
+func (m *GrantMutation) AddedFileExpiryTotalDays() (r int8, exists bool) {
+	v := m.addfile_expiry_total_days
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetFileExpiryTotalDays resets all changes to the "file_expiry_total_days" field.
+func (m *GrantMutation) ResetFileExpiryTotalDays() {
+	m.file_expiry_total_days = nil
+	m.addfile_expiry_total_days = nil
+}
+
+// SetFileExpiryDaysSinceLastDownload sets the "file_expiry_days_since_last_download" field.
+func (m *GrantMutation) SetFileExpiryDaysSinceLastDownload(u uint8) {
+	m.file_expiry_days_since_last_download = &u
+	m.addfile_expiry_days_since_last_download = nil
+}
+
+// FileExpiryDaysSinceLastDownload returns the value of the "file_expiry_days_since_last_download" field in the mutation.
+func (m *GrantMutation) FileExpiryDaysSinceLastDownload() (r uint8, exists bool) {
+	v := m.file_expiry_days_since_last_download
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFileExpiryDaysSinceLastDownload returns the old "file_expiry_days_since_last_download" field's value of the Grant entity.
+// If the Grant object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GrantMutation) OldFileExpiryDaysSinceLastDownload(ctx context.Context) (v uint8, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFileExpiryDaysSinceLastDownload is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFileExpiryDaysSinceLastDownload requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFileExpiryDaysSinceLastDownload: %w", err)
+	}
+	return oldValue.FileExpiryDaysSinceLastDownload, nil
+}
+
+// AddFileExpiryDaysSinceLastDownload adds u to the "file_expiry_days_since_last_download" field.
+func (m *GrantMutation) AddFileExpiryDaysSinceLastDownload(u int8) {
+	if m.addfile_expiry_days_since_last_download != nil {
+		*m.addfile_expiry_days_since_last_download += u
+	} else {
+		m.addfile_expiry_days_since_last_download = &u
+	}
+}
+
+// AddedFileExpiryDaysSinceLastDownload returns the value that was added to the "file_expiry_days_since_last_download" field in this mutation.
+func (m *GrantMutation) AddedFileExpiryDaysSinceLastDownload() (r int8, exists bool) {
+	v := m.addfile_expiry_days_since_last_download
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetFileExpiryDaysSinceLastDownload resets all changes to the "file_expiry_days_since_last_download" field.
+func (m *GrantMutation) ResetFileExpiryDaysSinceLastDownload() {
+	m.file_expiry_days_since_last_download = nil
+	m.addfile_expiry_days_since_last_download = nil
+}
+
+// SetFileExpiryTotalDownloads sets the "file_expiry_total_downloads" field.
+func (m *GrantMutation) SetFileExpiryTotalDownloads(u uint8) {
+	m.file_expiry_total_downloads = &u
+	m.addfile_expiry_total_downloads = nil
+}
+
+// FileExpiryTotalDownloads returns the value of the "file_expiry_total_downloads" field in the mutation.
+func (m *GrantMutation) FileExpiryTotalDownloads() (r uint8, exists bool) {
+	v := m.file_expiry_total_downloads
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFileExpiryTotalDownloads returns the old "file_expiry_total_downloads" field's value of the Grant entity.
+// If the Grant object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GrantMutation) OldFileExpiryTotalDownloads(ctx context.Context) (v uint8, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFileExpiryTotalDownloads is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFileExpiryTotalDownloads requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFileExpiryTotalDownloads: %w", err)
+	}
+	return oldValue.FileExpiryTotalDownloads, nil
+}
+
+// AddFileExpiryTotalDownloads adds u to the "file_expiry_total_downloads" field.
+func (m *GrantMutation) AddFileExpiryTotalDownloads(u int8) {
+	if m.addfile_expiry_total_downloads != nil {
+		*m.addfile_expiry_total_downloads += u
+	} else {
+		m.addfile_expiry_total_downloads = &u
+	}
+}
+
+// AddedFileExpiryTotalDownloads returns the value that was added to the "file_expiry_total_downloads" field in this mutation.
+func (m *GrantMutation) AddedFileExpiryTotalDownloads() (r int8, exists bool) {
+	v := m.addfile_expiry_total_downloads
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetFileExpiryTotalDownloads resets all changes to the "file_expiry_total_downloads" field.
+func (m *GrantMutation) ResetFileExpiryTotalDownloads() {
+	m.file_expiry_total_downloads = nil
+	m.addfile_expiry_total_downloads = nil
+}
+
+// SetLastUpload sets the "last_upload" field.
+func (m *GrantMutation) SetLastUpload(t time.Time) {
+	m.last_upload = &t
+}
+
+// LastUpload returns the value of the "last_upload" field in the mutation.
+func (m *GrantMutation) LastUpload() (r time.Time, exists bool) {
+	v := m.last_upload
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLastUpload returns the old "last_upload" field's value of the Grant entity.
+// If the Grant object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GrantMutation) OldLastUpload(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLastUpload is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLastUpload requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLastUpload: %w", err)
+	}
+	return oldValue.LastUpload, nil
+}
+
+// ClearLastUpload clears the value of the "last_upload" field.
+func (m *GrantMutation) ClearLastUpload() {
+	m.last_upload = nil
+	m.clearedFields[grant.FieldLastUpload] = struct{}{}
+}
+
+// LastUploadCleared returns if the "last_upload" field was cleared in this mutation.
+func (m *GrantMutation) LastUploadCleared() bool {
+	_, ok := m.clearedFields[grant.FieldLastUpload]
+	return ok
+}
+
+// ResetLastUpload resets all changes to the "last_upload" field.
+func (m *GrantMutation) ResetLastUpload() {
+	m.last_upload = nil
+	delete(m.clearedFields, grant.FieldLastUpload)
+}
+
+// SetTimesUploaded sets the "times_uploaded" field.
+func (m *GrantMutation) SetTimesUploaded(u uint64) {
+	m.times_uploaded = &u
+	m.addtimes_uploaded = nil
+}
+
+// TimesUploaded returns the value of the "times_uploaded" field in the mutation.
+func (m *GrantMutation) TimesUploaded() (r uint64, exists bool) {
+	v := m.times_uploaded
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTimesUploaded returns the old "times_uploaded" field's value of the Grant entity.
+// If the Grant object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GrantMutation) OldTimesUploaded(ctx context.Context) (v uint64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTimesUploaded is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTimesUploaded requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTimesUploaded: %w", err)
+	}
+	return oldValue.TimesUploaded, nil
+}
+
+// AddTimesUploaded adds u to the "times_uploaded" field.
+func (m *GrantMutation) AddTimesUploaded(u int64) {
+	if m.addtimes_uploaded != nil {
+		*m.addtimes_uploaded += u
+	} else {
+		m.addtimes_uploaded = &u
+	}
+}
+
+// AddedTimesUploaded returns the value that was added to the "times_uploaded" field in this mutation.
+func (m *GrantMutation) AddedTimesUploaded() (r int64, exists bool) {
+	v := m.addtimes_uploaded
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetTimesUploaded resets all changes to the "times_uploaded" field.
+func (m *GrantMutation) ResetTimesUploaded() {
+	m.times_uploaded = nil
+	m.addtimes_uploaded = nil
+}
+
+// SetEmailOnUpload sets the "email_on_upload" field.
+func (m *GrantMutation) SetEmailOnUpload(s []string) {
+	m.email_on_upload = &s
+	m.appendemail_on_upload = nil
+}
+
+// EmailOnUpload returns the value of the "email_on_upload" field in the mutation.
+func (m *GrantMutation) EmailOnUpload() (r []string, exists bool) {
+	v := m.email_on_upload
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEmailOnUpload returns the old "email_on_upload" field's value of the Grant entity.
+// If the Grant object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GrantMutation) OldEmailOnUpload(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEmailOnUpload is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEmailOnUpload requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEmailOnUpload: %w", err)
+	}
+	return oldValue.EmailOnUpload, nil
+}
+
+// AppendEmailOnUpload adds s to the "email_on_upload" field.
+func (m *GrantMutation) AppendEmailOnUpload(s []string) {
+	m.appendemail_on_upload = append(m.appendemail_on_upload, s...)
+}
+
+// AppendedEmailOnUpload returns the list of values that were appended to the "email_on_upload" field in this mutation.
+func (m *GrantMutation) AppendedEmailOnUpload() ([]string, bool) {
+	if len(m.appendemail_on_upload) == 0 {
+		return nil, false
+	}
+	return m.appendemail_on_upload, true
+}
+
+// ClearEmailOnUpload clears the value of the "email_on_upload" field.
+func (m *GrantMutation) ClearEmailOnUpload() {
+	m.email_on_upload = nil
+	m.appendemail_on_upload = nil
+	m.clearedFields[grant.FieldEmailOnUpload] = struct{}{}
+}
+
+// EmailOnUploadCleared returns if the "email_on_upload" field was cleared in this mutation.
+func (m *GrantMutation) EmailOnUploadCleared() bool {
+	_, ok := m.clearedFields[grant.FieldEmailOnUpload]
+	return ok
+}
+
+// ResetEmailOnUpload resets all changes to the "email_on_upload" field.
+func (m *GrantMutation) ResetEmailOnUpload() {
+	m.email_on_upload = nil
+	m.appendemail_on_upload = nil
+	delete(m.clearedFields, grant.FieldEmailOnUpload)
+}
+
+// SetCreatorLang sets the "creator_lang" field.
+func (m *GrantMutation) SetCreatorLang(s string) {
+	m.creator_lang = &s
+}
+
+// CreatorLang returns the value of the "creator_lang" field in the mutation.
+func (m *GrantMutation) CreatorLang() (r string, exists bool) {
+	v := m.creator_lang
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatorLang returns the old "creator_lang" field's value of the Grant entity.
+// If the Grant object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GrantMutation) OldCreatorLang(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatorLang is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatorLang requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatorLang: %w", err)
+	}
+	return oldValue.CreatorLang, nil
+}
+
+// ResetCreatorLang resets all changes to the "creator_lang" field.
+func (m *GrantMutation) ResetCreatorLang() {
+	m.creator_lang = nil
+}
+
+// AddFileIDs adds the "files" edge to the File entity by ids.
+func (m *GrantMutation) AddFileIDs(ids ...uuid.UUID) {
+	if m.files == nil {
+		m.files = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.files[ids[i]] = struct{}{}
+	}
+}
+
+// ClearFiles clears the "files" edge to the File entity.
+func (m *GrantMutation) ClearFiles() {
+	m.clearedfiles = true
+}
+
+// FilesCleared reports if the "files" edge to the File entity was cleared.
+func (m *GrantMutation) FilesCleared() bool {
+	return m.clearedfiles
+}
+
+// RemoveFileIDs removes the "files" edge to the File entity by IDs.
+func (m *GrantMutation) RemoveFileIDs(ids ...uuid.UUID) {
+	if m.removedfiles == nil {
+		m.removedfiles = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.files, ids[i])
+		m.removedfiles[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedFiles returns the removed IDs of the "files" edge to the File entity.
+func (m *GrantMutation) RemovedFilesIDs() (ids []uuid.UUID) {
+	for id := range m.removedfiles {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// FilesIDs returns the "files" edge IDs in the mutation.
+func (m *GrantMutation) FilesIDs() (ids []uuid.UUID) {
+	for id := range m.files {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetFiles resets all changes to the "files" edge.
+func (m *GrantMutation) ResetFiles() {
+	m.files = nil
+	m.clearedfiles = false
+	m.removedfiles = nil
+}
+
+// SetOwnerID sets the "owner" edge to the User entity by id.
+func (m *GrantMutation) SetOwnerID(id uuid.UUID) {
+	m.owner = &id
+}
+
+// ClearOwner clears the "owner" edge to the User entity.
+func (m *GrantMutation) ClearOwner() {
+	m.clearedowner = true
+}
+
+// OwnerCleared reports if the "owner" edge to the User entity was cleared.
+func (m *GrantMutation) OwnerCleared() bool {
+	return m.clearedowner
+}
+
+// OwnerID returns the "owner" edge ID in the mutation.
+func (m *GrantMutation) OwnerID() (id uuid.UUID, exists bool) {
+	if m.owner != nil {
+		return *m.owner, true
+	}
+	return
+}
+
+// OwnerIDs returns the "owner" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// OwnerID instead. It exists only for internal usage by the builders.
+func (m *GrantMutation) OwnerIDs() (ids []uuid.UUID) {
+	if id := m.owner; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetOwner resets all changes to the "owner" edge.
+func (m *GrantMutation) ResetOwner() {
+	m.owner = nil
+	m.clearedowner = false
+}
+
+// AddShareaccesstokenIDs adds the "shareaccesstokens" edge to the ShareAccessToken entity by ids.
+func (m *GrantMutation) AddShareaccesstokenIDs(ids ...string) {
+	if m.shareaccesstokens == nil {
+		m.shareaccesstokens = make(map[string]struct{})
+	}
+	for i := range ids {
+		m.shareaccesstokens[ids[i]] = struct{}{}
+	}
+}
+
+// ClearShareaccesstokens clears the "shareaccesstokens" edge to the ShareAccessToken entity.
+func (m *GrantMutation) ClearShareaccesstokens() {
+	m.clearedshareaccesstokens = true
+}
+
+// ShareaccesstokensCleared reports if the "shareaccesstokens" edge to the ShareAccessToken entity was cleared.
+func (m *GrantMutation) ShareaccesstokensCleared() bool {
+	return m.clearedshareaccesstokens
+}
+
+// RemoveShareaccesstokenIDs removes the "shareaccesstokens" edge to the ShareAccessToken entity by IDs.
+func (m *GrantMutation) RemoveShareaccesstokenIDs(ids ...string) {
+	if m.removedshareaccesstokens == nil {
+		m.removedshareaccesstokens = make(map[string]struct{})
+	}
+	for i := range ids {
+		delete(m.shareaccesstokens, ids[i])
+		m.removedshareaccesstokens[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedShareaccesstokens returns the removed IDs of the "shareaccesstokens" edge to the ShareAccessToken entity.
+func (m *GrantMutation) RemovedShareaccesstokensIDs() (ids []string) {
+	for id := range m.removedshareaccesstokens {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ShareaccesstokensIDs returns the "shareaccesstokens" edge IDs in the mutation.
+func (m *GrantMutation) ShareaccesstokensIDs() (ids []string) {
+	for id := range m.shareaccesstokens {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetShareaccesstokens resets all changes to the "shareaccesstokens" edge.
+func (m *GrantMutation) ResetShareaccesstokens() {
+	m.shareaccesstokens = nil
+	m.clearedshareaccesstokens = false
+	m.removedshareaccesstokens = nil
+}
+
+// AddUploadIDs adds the "uploads" edge to the Upload entity by ids.
+func (m *GrantMutation) AddUploadIDs(ids ...uuid.UUID) {
+	if m.uploads == nil {
+		m.uploads = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.uploads[ids[i]] = struct{}{}
+	}
+}
+
+// ClearUploads clears the "uploads" edge to the Upload entity.
+func (m *GrantMutation) ClearUploads() {
+	m.cleareduploads = true
+}
+
+// UploadsCleared reports if the "uploads" edge to the Upload entity was cleared.
+func (m *GrantMutation) UploadsCleared() bool {
+	return m.cleareduploads
+}
+
+// RemoveUploadIDs removes the "uploads" edge to the Upload entity by IDs.
+func (m *GrantMutation) RemoveUploadIDs(ids ...uuid.UUID) {
+	if m.removeduploads == nil {
+		m.removeduploads = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.uploads, ids[i])
+		m.removeduploads[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedUploads returns the removed IDs of the "uploads" edge to the Upload entity.
+func (m *GrantMutation) RemovedUploadsIDs() (ids []uuid.UUID) {
+	for id := range m.removeduploads {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// UploadsIDs returns the "uploads" edge IDs in the mutation.
+func (m *GrantMutation) UploadsIDs() (ids []uuid.UUID) {
+	for id := range m.uploads {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetUploads resets all changes to the "uploads" edge.
+func (m *GrantMutation) ResetUploads() {
+	m.uploads = nil
+	m.cleareduploads = false
+	m.removeduploads = nil
+}
+
+// AddAccessEventIDs adds the "access_events" edge to the AccessEvent entity by ids.
+func (m *GrantMutation) AddAccessEventIDs(ids ...int) {
+	if m.access_events == nil {
+		m.access_events = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.access_events[ids[i]] = struct{}{}
+	}
+}
+
+// ClearAccessEvents clears the "access_events" edge to the AccessEvent entity.
+func (m *GrantMutation) ClearAccessEvents() {
+	m.clearedaccess_events = true
+}
+
+// AccessEventsCleared reports if the "access_events" edge to the AccessEvent entity was cleared.
+func (m *GrantMutation) AccessEventsCleared() bool {
+	return m.clearedaccess_events
+}
+
+// RemoveAccessEventIDs removes the "access_events" edge to the AccessEvent entity by IDs.
+func (m *GrantMutation) RemoveAccessEventIDs(ids ...int) {
+	if m.removedaccess_events == nil {
+		m.removedaccess_events = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.access_events, ids[i])
+		m.removedaccess_events[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedAccessEvents returns the removed IDs of the "access_events" edge to the AccessEvent entity.
+func (m *GrantMutation) RemovedAccessEventsIDs() (ids []int) {
+	for id := range m.removedaccess_events {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// AccessEventsIDs returns the "access_events" edge IDs in the mutation.
+func (m *GrantMutation) AccessEventsIDs() (ids []int) {
+	for id := range m.access_events {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetAccessEvents resets all changes to the "access_events" edge.
+func (m *GrantMutation) ResetAccessEvents() {
+	m.access_events = nil
+	m.clearedaccess_events = false
+	m.removedaccess_events = nil
+}
+
+// Where appends a list predicates to the GrantMutation builder.
+func (m *GrantMutation) Where(ps ...predicate.Grant) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the GrantMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *GrantMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Grant, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *GrantMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *GrantMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Grant).
+func (m *GrantMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *GrantMutation) Fields() []string {
+	fields := make([]string, 0, 16)
+	if m.comment != nil {
+		fields = append(fields, grant.FieldComment)
+	}
+	if m.expiry_type != nil {
+		fields = append(fields, grant.FieldExpiryType)
+	}
+	if m.hashed_password != nil {
+		fields = append(fields, grant.FieldHashedPassword)
+	}
+	if m.salt != nil {
+		fields = append(fields, grant.FieldSalt)
+	}
+	if m.created_at != nil {
+		fields = append(fields, grant.FieldCreatedAt)
+	}
+	if m.expiry_total_days != nil {
+		fields = append(fields, grant.FieldExpiryTotalDays)
+	}
+	if m.expiry_days_since_last_upload != nil {
+		fields = append(fields, grant.FieldExpiryDaysSinceLastUpload)
+	}
+	if m.expiry_total_uploads != nil {
+		fields = append(fields, grant.FieldExpiryTotalUploads)
+	}
+	if m.file_expiry_type != nil {
+		fields = append(fields, grant.FieldFileExpiryType)
+	}
+	if m.file_expiry_total_days != nil {
+		fields = append(fields, grant.FieldFileExpiryTotalDays)
+	}
+	if m.file_expiry_days_since_last_download != nil {
+		fields = append(fields, grant.FieldFileExpiryDaysSinceLastDownload)
+	}
+	if m.file_expiry_total_downloads != nil {
+		fields = append(fields, grant.FieldFileExpiryTotalDownloads)
+	}
+	if m.last_upload != nil {
+		fields = append(fields, grant.FieldLastUpload)
+	}
+	if m.times_uploaded != nil {
+		fields = append(fields, grant.FieldTimesUploaded)
+	}
+	if m.email_on_upload != nil {
+		fields = append(fields, grant.FieldEmailOnUpload)
+	}
+	if m.creator_lang != nil {
+		fields = append(fields, grant.FieldCreatorLang)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *GrantMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case grant.FieldComment:
+		return m.Comment()
+	case grant.FieldExpiryType:
+		return m.ExpiryType()
+	case grant.FieldHashedPassword:
+		return m.HashedPassword()
+	case grant.FieldSalt:
+		return m.Salt()
+	case grant.FieldCreatedAt:
+		return m.CreatedAt()
+	case grant.FieldExpiryTotalDays:
+		return m.ExpiryTotalDays()
+	case grant.FieldExpiryDaysSinceLastUpload:
+		return m.ExpiryDaysSinceLastUpload()
+	case grant.FieldExpiryTotalUploads:
+		return m.ExpiryTotalUploads()
+	case grant.FieldFileExpiryType:
+		return m.FileExpiryType()
+	case grant.FieldFileExpiryTotalDays:
+		return m.FileExpiryTotalDays()
+	case grant.FieldFileExpiryDaysSinceLastDownload:
+		return m.FileExpiryDaysSinceLastDownload()
+	case grant.FieldFileExpiryTotalDownloads:
+		return m.FileExpiryTotalDownloads()
+	case grant.FieldLastUpload:
+		return m.LastUpload()
+	case grant.FieldTimesUploaded:
+		return m.TimesUploaded()
+	case grant.FieldEmailOnUpload:
+		return m.EmailOnUpload()
+	case grant.FieldCreatorLang:
+		return m.CreatorLang()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *GrantMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case grant.FieldComment:
+		return m.OldComment(ctx)
+	case grant.FieldExpiryType:
+		return m.OldExpiryType(ctx)
+	case grant.FieldHashedPassword:
+		return m.OldHashedPassword(ctx)
+	case grant.FieldSalt:
+		return m.OldSalt(ctx)
+	case grant.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case grant.FieldExpiryTotalDays:
+		return m.OldExpiryTotalDays(ctx)
+	case grant.FieldExpiryDaysSinceLastUpload:
+		return m.OldExpiryDaysSinceLastUpload(ctx)
+	case grant.FieldExpiryTotalUploads:
+		return m.OldExpiryTotalUploads(ctx)
+	case grant.FieldFileExpiryType:
+		return m.OldFileExpiryType(ctx)
+	case grant.FieldFileExpiryTotalDays:
+		return m.OldFileExpiryTotalDays(ctx)
+	case grant.FieldFileExpiryDaysSinceLastDownload:
+		return m.OldFileExpiryDaysSinceLastDownload(ctx)
+	case grant.FieldFileExpiryTotalDownloads:
+		return m.OldFileExpiryTotalDownloads(ctx)
+	case grant.FieldLastUpload:
+		return m.OldLastUpload(ctx)
+	case grant.FieldTimesUploaded:
+		return m.OldTimesUploaded(ctx)
+	case grant.FieldEmailOnUpload:
+		return m.OldEmailOnUpload(ctx)
+	case grant.FieldCreatorLang:
+		return m.OldCreatorLang(ctx)
+	}
+	return nil, fmt.Errorf("unknown Grant field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *GrantMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case grant.FieldComment:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetComment(v)
+		return nil
+	case grant.FieldExpiryType:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetExpiryType(v)
+		return nil
+	case grant.FieldHashedPassword:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetHashedPassword(v)
+		return nil
+	case grant.FieldSalt:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSalt(v)
+		return nil
+	case grant.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case grant.FieldExpiryTotalDays:
+		v, ok := value.(uint8)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetExpiryTotalDays(v)
+		return nil
+	case grant.FieldExpiryDaysSinceLastUpload:
+		v, ok := value.(uint8)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetExpiryDaysSinceLastUpload(v)
+		return nil
+	case grant.FieldExpiryTotalUploads:
+		v, ok := value.(uint8)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetExpiryTotalUploads(v)
+		return nil
+	case grant.FieldFileExpiryType:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFileExpiryType(v)
+		return nil
+	case grant.FieldFileExpiryTotalDays:
+		v, ok := value.(uint8)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFileExpiryTotalDays(v)
+		return nil
+	case grant.FieldFileExpiryDaysSinceLastDownload:
+		v, ok := value.(uint8)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFileExpiryDaysSinceLastDownload(v)
+		return nil
+	case grant.FieldFileExpiryTotalDownloads:
+		v, ok := value.(uint8)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFileExpiryTotalDownloads(v)
+		return nil
+	case grant.FieldLastUpload:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLastUpload(v)
+		return nil
+	case grant.FieldTimesUploaded:
+		v, ok := value.(uint64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTimesUploaded(v)
+		return nil
+	case grant.FieldEmailOnUpload:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEmailOnUpload(v)
+		return nil
+	case grant.FieldCreatorLang:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatorLang(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Grant field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *GrantMutation) AddedFields() []string {
+	var fields []string
+	if m.addexpiry_total_days != nil {
+		fields = append(fields, grant.FieldExpiryTotalDays)
+	}
+	if m.addexpiry_days_since_last_upload != nil {
+		fields = append(fields, grant.FieldExpiryDaysSinceLastUpload)
+	}
+	if m.addexpiry_total_uploads != nil {
+		fields = append(fields, grant.FieldExpiryTotalUploads)
+	}
+	if m.addfile_expiry_total_days != nil {
+		fields = append(fields, grant.FieldFileExpiryTotalDays)
+	}
+	if m.addfile_expiry_days_since_last_download != nil {
+		fields = append(fields, grant.FieldFileExpiryDaysSinceLastDownload)
+	}
+	if m.addfile_expiry_total_downloads != nil {
+		fields = append(fields, grant.FieldFileExpiryTotalDownloads)
+	}
+	if m.addtimes_uploaded != nil {
+		fields = append(fields, grant.FieldTimesUploaded)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *GrantMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case grant.FieldExpiryTotalDays:
+		return m.AddedExpiryTotalDays()
+	case grant.FieldExpiryDaysSinceLastUpload:
+		return m.AddedExpiryDaysSinceLastUpload()
+	case grant.FieldExpiryTotalUploads:
+		return m.AddedExpiryTotalUploads()
+	case grant.FieldFileExpiryTotalDays:
+		return m.AddedFileExpiryTotalDays()
+	case grant.FieldFileExpiryDaysSinceLastDownload:
+		return m.AddedFileExpiryDaysSinceLastDownload()
+	case grant.FieldFileExpiryTotalDownloads:
+		return m.AddedFileExpiryTotalDownloads()
+	case grant.FieldTimesUploaded:
+		return m.AddedTimesUploaded()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *GrantMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case grant.FieldExpiryTotalDays:
+		v, ok := value.(int8)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddExpiryTotalDays(v)
+		return nil
+	case grant.FieldExpiryDaysSinceLastUpload:
+		v, ok := value.(int8)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddExpiryDaysSinceLastUpload(v)
+		return nil
+	case grant.FieldExpiryTotalUploads:
+		v, ok := value.(int8)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddExpiryTotalUploads(v)
+		return nil
+	case grant.FieldFileExpiryTotalDays:
+		v, ok := value.(int8)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddFileExpiryTotalDays(v)
+		return nil
+	case grant.FieldFileExpiryDaysSinceLastDownload:
+		v, ok := value.(int8)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddFileExpiryDaysSinceLastDownload(v)
+		return nil
+	case grant.FieldFileExpiryTotalDownloads:
+		v, ok := value.(int8)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddFileExpiryTotalDownloads(v)
+		return nil
+	case grant.FieldTimesUploaded:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddTimesUploaded(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Grant numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *GrantMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(grant.FieldComment) {
+		fields = append(fields, grant.FieldComment)
+	}
+	if m.FieldCleared(grant.FieldLastUpload) {
+		fields = append(fields, grant.FieldLastUpload)
+	}
+	if m.FieldCleared(grant.FieldEmailOnUpload) {
+		fields = append(fields, grant.FieldEmailOnUpload)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *GrantMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *GrantMutation) ClearField(name string) error {
+	switch name {
+	case grant.FieldComment:
+		m.ClearComment()
+		return nil
+	case grant.FieldLastUpload:
+		m.ClearLastUpload()
+		return nil
+	case grant.FieldEmailOnUpload:
+		m.ClearEmailOnUpload()
+		return nil
+	}
+	return fmt.Errorf("unknown Grant nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *GrantMutation) ResetField(name string) error {
+	switch name {
+	case grant.FieldComment:
+		m.ResetComment()
+		return nil
+	case grant.FieldExpiryType:
+		m.ResetExpiryType()
+		return nil
+	case grant.FieldHashedPassword:
+		m.ResetHashedPassword()
+		return nil
+	case grant.FieldSalt:
+		m.ResetSalt()
+		return nil
+	case grant.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case grant.FieldExpiryTotalDays:
+		m.ResetExpiryTotalDays()
+		return nil
+	case grant.FieldExpiryDaysSinceLastUpload:
+		m.ResetExpiryDaysSinceLastUpload()
+		return nil
+	case grant.FieldExpiryTotalUploads:
+		m.ResetExpiryTotalUploads()
+		return nil
+	case grant.FieldFileExpiryType:
+		m.ResetFileExpiryType()
+		return nil
+	case grant.FieldFileExpiryTotalDays:
+		m.ResetFileExpiryTotalDays()
+		return nil
+	case grant.FieldFileExpiryDaysSinceLastDownload:
+		m.ResetFileExpiryDaysSinceLastDownload()
+		return nil
+	case grant.FieldFileExpiryTotalDownloads:
+		m.ResetFileExpiryTotalDownloads()
+		return nil
+	case grant.FieldLastUpload:
+		m.ResetLastUpload()
+		return nil
+	case grant.FieldTimesUploaded:
+		m.ResetTimesUploaded()
+		return nil
+	case grant.FieldEmailOnUpload:
+		m.ResetEmailOnUpload()
+		return nil
+	case grant.FieldCreatorLang:
+		m.ResetCreatorLang()
+		return nil
+	}
+	return fmt.Errorf("unknown Grant field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *GrantMutation) AddedEdges() []string {
+	edges := make([]string, 0, 5)
+	if m.files != nil {
+		edges = append(edges, grant.EdgeFiles)
+	}
+	if m.owner != nil {
+		edges = append(edges, grant.EdgeOwner)
+	}
+	if m.shareaccesstokens != nil {
+		edges = append(edges, grant.EdgeShareaccesstokens)
+	}
+	if m.uploads != nil {
+		edges = append(edges, grant.EdgeUploads)
+	}
+	if m.access_events != nil {
+		edges = append(edges, grant.EdgeAccessEvents)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *GrantMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case grant.EdgeFiles:
+		ids := make([]ent.Value, 0, len(m.files))
+		for id := range m.files {
+			ids = append(ids, id)
+		}
+		return ids
+	case grant.EdgeOwner:
+		if id := m.owner; id != nil {
+			return []ent.Value{*id}
+		}
+	case grant.EdgeShareaccesstokens:
+		ids := make([]ent.Value, 0, len(m.shareaccesstokens))
+		for id := range m.shareaccesstokens {
+			ids = append(ids, id)
+		}
+		return ids
+	case grant.EdgeUploads:
+		ids := make([]ent.Value, 0, len(m.uploads))
+		for id := range m.uploads {
+			ids = append(ids, id)
+		}
+		return ids
+	case grant.EdgeAccessEvents:
+		ids := make([]ent.Value, 0, len(m.access_events))
+		for id := range m.access_events {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *GrantMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 5)
+	if m.removedfiles != nil {
+		edges = append(edges, grant.EdgeFiles)
+	}
+	if m.removedshareaccesstokens != nil {
+		edges = append(edges, grant.EdgeShareaccesstokens)
+	}
+	if m.removeduploads != nil {
+		edges = append(edges, grant.EdgeUploads)
+	}
+	if m.removedaccess_events != nil {
+		edges = append(edges, grant.EdgeAccessEvents)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *GrantMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case grant.EdgeFiles:
+		ids := make([]ent.Value, 0, len(m.removedfiles))
+		for id := range m.removedfiles {
+			ids = append(ids, id)
+		}
+		return ids
+	case grant.EdgeShareaccesstokens:
+		ids := make([]ent.Value, 0, len(m.removedshareaccesstokens))
+		for id := range m.removedshareaccesstokens {
+			ids = append(ids, id)
+		}
+		return ids
+	case grant.EdgeUploads:
+		ids := make([]ent.Value, 0, len(m.removeduploads))
+		for id := range m.removeduploads {
+			ids = append(ids, id)
+		}
+		return ids
+	case grant.EdgeAccessEvents:
+		ids := make([]ent.Value, 0, len(m.removedaccess_events))
+		for id := range m.removedaccess_events {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *GrantMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 5)
+	if m.clearedfiles {
+		edges = append(edges, grant.EdgeFiles)
+	}
+	if m.clearedowner {
+		edges = append(edges, grant.EdgeOwner)
+	}
+	if m.clearedshareaccesstokens {
+		edges = append(edges, grant.EdgeShareaccesstokens)
+	}
+	if m.cleareduploads {
+		edges = append(edges, grant.EdgeUploads)
+	}
+	if m.clearedaccess_events {
+		edges = append(edges, grant.EdgeAccessEvents)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *GrantMutation) EdgeCleared(name string) bool {
+	switch name {
+	case grant.EdgeFiles:
+		return m.clearedfiles
+	case grant.EdgeOwner:
+		return m.clearedowner
+	case grant.EdgeShareaccesstokens:
+		return m.clearedshareaccesstokens
+	case grant.EdgeUploads:
+		return m.cleareduploads
+	case grant.EdgeAccessEvents:
+		return m.clearedaccess_events
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *GrantMutation) ClearEdge(name string) error {
+	switch name {
+	case grant.EdgeOwner:
+		m.ClearOwner()
+		return nil
+	}
+	return fmt.Errorf("unknown Grant unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *GrantMutation) ResetEdge(name string) error {
+	switch name {
+	case grant.EdgeFiles:
+		m.ResetFiles()
+		return nil
+	case grant.EdgeOwner:
+		m.ResetOwner()
+		return nil
+	case grant.EdgeShareaccesstokens:
+		m.ResetShareaccesstokens()
+		return nil
+	case grant.EdgeUploads:
+		m.ResetUploads()
+		return nil
+	case grant.EdgeAccessEvents:
+		m.ResetAccessEvents()
+		return nil
+	}
+	return fmt.Errorf("unknown Grant edge %s", name)
+}
+
+// RecipientMutation represents an operation that mutates the Recipient nodes in the graph.
+type RecipientMutation struct {
+	config
+	op                       Op
+	typ                      string
+	id                       *uuid.UUID
+	name                     *string
+	email                    *string
+	hashed_token             *string
+	created_at               *time.Time
+	revoked_at               *time.Time
+	last_download            *time.Time
+	times_downloaded         *uint64
+	addtimes_downloaded      *int64
+	downloaded_files         *[]string
+	appenddownloaded_files   []string
+	clearedFields            map[string]struct{}
+	ticket                   *uuid.UUID
+	clearedticket            bool
+	shareaccesstokens        map[string]struct{}
+	removedshareaccesstokens map[string]struct{}
+	clearedshareaccesstokens bool
+	access_events            map[int]struct{}
+	removedaccess_events     map[int]struct{}
+	clearedaccess_events     bool
+	done                     bool
+	oldValue                 func(context.Context) (*Recipient, error)
+	predicates               []predicate.Recipient
+}
+
+var _ ent.Mutation = (*RecipientMutation)(nil)
+
+// recipientOption allows management of the mutation configuration using functional options.
+type recipientOption func(*RecipientMutation)
+
+// newRecipientMutation creates new mutation for the Recipient entity.
+func newRecipientMutation(c config, op Op, opts ...recipientOption) *RecipientMutation {
+	m := &RecipientMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeRecipient,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withRecipientID sets the ID field of the mutation.
+func withRecipientID(id uuid.UUID) recipientOption {
+	return func(m *RecipientMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Recipient
+		)
+		m.oldValue = func(ctx context.Context) (*Recipient, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Recipient.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withRecipient sets the old Recipient of the mutation.
+func withRecipient(node *Recipient) recipientOption {
+	return func(m *RecipientMutation) {
+		m.oldValue = func(context.Context) (*Recipient, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m RecipientMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m RecipientMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Recipient entities.
+func (m *RecipientMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *RecipientMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *RecipientMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Recipient.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetName sets the "name" field.
+func (m *RecipientMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *RecipientMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the Recipient entity.
+// If the Recipient object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RecipientMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *RecipientMutation) ResetName() {
+	m.name = nil
+}
+
+// SetEmail sets the "email" field.
+func (m *RecipientMutation) SetEmail(s string) {
+	m.email = &s
+}
+
+// Email returns the value of the "email" field in the mutation.
+func (m *RecipientMutation) Email() (r string, exists bool) {
+	v := m.email
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEmail returns the old "email" field's value of the Recipient entity.
+// If the Recipient object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RecipientMutation) OldEmail(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEmail is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEmail requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEmail: %w", err)
+	}
+	return oldValue.Email, nil
+}
+
+// ClearEmail clears the value of the "email" field.
+func (m *RecipientMutation) ClearEmail() {
+	m.email = nil
+	m.clearedFields[recipient.FieldEmail] = struct{}{}
+}
+
+// EmailCleared returns if the "email" field was cleared in this mutation.
+func (m *RecipientMutation) EmailCleared() bool {
+	_, ok := m.clearedFields[recipient.FieldEmail]
+	return ok
+}
+
+// ResetEmail resets all changes to the "email" field.
+func (m *RecipientMutation) ResetEmail() {
+	m.email = nil
+	delete(m.clearedFields, recipient.FieldEmail)
+}
+
+// SetHashedToken sets the "hashed_token" field.
+func (m *RecipientMutation) SetHashedToken(s string) {
+	m.hashed_token = &s
+}
+
+// HashedToken returns the value of the "hashed_token" field in the mutation.
+func (m *RecipientMutation) HashedToken() (r string, exists bool) {
+	v := m.hashed_token
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldHashedToken returns the old "hashed_token" field's value of the Recipient entity.
+// If the Recipient object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RecipientMutation) OldHashedToken(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldHashedToken is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldHashedToken requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldHashedToken: %w", err)
+	}
+	return oldValue.HashedToken, nil
+}
+
+// ResetHashedToken resets all changes to the "hashed_token" field.
+func (m *RecipientMutation) ResetHashedToken() {
+	m.hashed_token = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *RecipientMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *RecipientMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Recipient entity.
+// If the Recipient object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RecipientMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *RecipientMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetRevokedAt sets the "revoked_at" field.
+func (m *RecipientMutation) SetRevokedAt(t time.Time) {
+	m.revoked_at = &t
+}
+
+// RevokedAt returns the value of the "revoked_at" field in the mutation.
+func (m *RecipientMutation) RevokedAt() (r time.Time, exists bool) {
+	v := m.revoked_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRevokedAt returns the old "revoked_at" field's value of the Recipient entity.
+// If the Recipient object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RecipientMutation) OldRevokedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRevokedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRevokedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRevokedAt: %w", err)
+	}
+	return oldValue.RevokedAt, nil
+}
+
+// ClearRevokedAt clears the value of the "revoked_at" field.
+func (m *RecipientMutation) ClearRevokedAt() {
+	m.revoked_at = nil
+	m.clearedFields[recipient.FieldRevokedAt] = struct{}{}
+}
+
+// RevokedAtCleared returns if the "revoked_at" field was cleared in this mutation.
+func (m *RecipientMutation) RevokedAtCleared() bool {
+	_, ok := m.clearedFields[recipient.FieldRevokedAt]
+	return ok
+}
+
+// ResetRevokedAt resets all changes to the "revoked_at" field.
+func (m *RecipientMutation) ResetRevokedAt() {
+	m.revoked_at = nil
+	delete(m.clearedFields, recipient.FieldRevokedAt)
+}
+
+// SetLastDownload sets the "last_download" field.
+func (m *RecipientMutation) SetLastDownload(t time.Time) {
+	m.last_download = &t
+}
+
+// LastDownload returns the value of the "last_download" field in the mutation.
+func (m *RecipientMutation) LastDownload() (r time.Time, exists bool) {
+	v := m.last_download
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLastDownload returns the old "last_download" field's value of the Recipient entity.
+// If the Recipient object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RecipientMutation) OldLastDownload(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLastDownload is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLastDownload requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLastDownload: %w", err)
+	}
+	return oldValue.LastDownload, nil
+}
+
+// ClearLastDownload clears the value of the "last_download" field.
+func (m *RecipientMutation) ClearLastDownload() {
+	m.last_download = nil
+	m.clearedFields[recipient.FieldLastDownload] = struct{}{}
+}
+
+// LastDownloadCleared returns if the "last_download" field was cleared in this mutation.
+func (m *RecipientMutation) LastDownloadCleared() bool {
+	_, ok := m.clearedFields[recipient.FieldLastDownload]
+	return ok
+}
+
+// ResetLastDownload resets all changes to the "last_download" field.
+func (m *RecipientMutation) ResetLastDownload() {
+	m.last_download = nil
+	delete(m.clearedFields, recipient.FieldLastDownload)
+}
+
+// SetTimesDownloaded sets the "times_downloaded" field.
+func (m *RecipientMutation) SetTimesDownloaded(u uint64) {
+	m.times_downloaded = &u
+	m.addtimes_downloaded = nil
+}
+
+// TimesDownloaded returns the value of the "times_downloaded" field in the mutation.
+func (m *RecipientMutation) TimesDownloaded() (r uint64, exists bool) {
+	v := m.times_downloaded
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTimesDownloaded returns the old "times_downloaded" field's value of the Recipient entity.
+// If the Recipient object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RecipientMutation) OldTimesDownloaded(ctx context.Context) (v uint64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTimesDownloaded is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTimesDownloaded requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTimesDownloaded: %w", err)
+	}
+	return oldValue.TimesDownloaded, nil
+}
+
+// AddTimesDownloaded adds u to the "times_downloaded" field.
+func (m *RecipientMutation) AddTimesDownloaded(u int64) {
+	if m.addtimes_downloaded != nil {
+		*m.addtimes_downloaded += u
+	} else {
+		m.addtimes_downloaded = &u
+	}
+}
+
+// AddedTimesDownloaded returns the value that was added to the "times_downloaded" field in this mutation.
+func (m *RecipientMutation) AddedTimesDownloaded() (r int64, exists bool) {
+	v := m.addtimes_downloaded
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetTimesDownloaded resets all changes to the "times_downloaded" field.
+func (m *RecipientMutation) ResetTimesDownloaded() {
+	m.times_downloaded = nil
+	m.addtimes_downloaded = nil
+}
+
+// SetDownloadedFiles sets the "downloaded_files" field.
+func (m *RecipientMutation) SetDownloadedFiles(s []string) {
+	m.downloaded_files = &s
+	m.appenddownloaded_files = nil
+}
+
+// DownloadedFiles returns the value of the "downloaded_files" field in the mutation.
+func (m *RecipientMutation) DownloadedFiles() (r []string, exists bool) {
+	v := m.downloaded_files
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDownloadedFiles returns the old "downloaded_files" field's value of the Recipient entity.
+// If the Recipient object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RecipientMutation) OldDownloadedFiles(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDownloadedFiles is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDownloadedFiles requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDownloadedFiles: %w", err)
+	}
+	return oldValue.DownloadedFiles, nil
+}
+
+// AppendDownloadedFiles adds s to the "downloaded_files" field.
+func (m *RecipientMutation) AppendDownloadedFiles(s []string) {
+	m.appenddownloaded_files = append(m.appenddownloaded_files, s...)
+}
+
+// AppendedDownloadedFiles returns the list of values that were appended to the "downloaded_files" field in this mutation.
+func (m *RecipientMutation) AppendedDownloadedFiles() ([]string, bool) {
+	if len(m.appenddownloaded_files) == 0 {
+		return nil, false
+	}
+	return m.appenddownloaded_files, true
+}
+
+// ClearDownloadedFiles clears the value of the "downloaded_files" field.
+func (m *RecipientMutation) ClearDownloadedFiles() {
+	m.downloaded_files = nil
+	m.appenddownloaded_files = nil
+	m.clearedFields[recipient.FieldDownloadedFiles] = struct{}{}
+}
+
+// DownloadedFilesCleared returns if the "downloaded_files" field was cleared in this mutation.
+func (m *RecipientMutation) DownloadedFilesCleared() bool {
+	_, ok := m.clearedFields[recipient.FieldDownloadedFiles]
+	return ok
+}
+
+// ResetDownloadedFiles resets all changes to the "downloaded_files" field.
+func (m *RecipientMutation) ResetDownloadedFiles() {
+	m.downloaded_files = nil
+	m.appenddownloaded_files = nil
+	delete(m.clearedFields, recipient.FieldDownloadedFiles)
+}
+
+// SetTicketID sets the "ticket" edge to the Ticket entity by id.
+func (m *RecipientMutation) SetTicketID(id uuid.UUID) {
+	m.ticket = &id
+}
+
+// ClearTicket clears the "ticket" edge to the Ticket entity.
+func (m *RecipientMutation) ClearTicket() {
+	m.clearedticket = true
+}
+
+// TicketCleared reports if the "ticket" edge to the Ticket entity was cleared.
+func (m *RecipientMutation) TicketCleared() bool {
+	return m.clearedticket
+}
+
+// TicketID returns the "ticket" edge ID in the mutation.
+func (m *RecipientMutation) TicketID() (id uuid.UUID, exists bool) {
+	if m.ticket != nil {
+		return *m.ticket, true
+	}
+	return
+}
+
+// TicketIDs returns the "ticket" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// TicketID instead. It exists only for internal usage by the builders.
+func (m *RecipientMutation) TicketIDs() (ids []uuid.UUID) {
+	if id := m.ticket; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetTicket resets all changes to the "ticket" edge.
+func (m *RecipientMutation) ResetTicket() {
+	m.ticket = nil
+	m.clearedticket = false
+}
+
+// AddShareaccesstokenIDs adds the "shareaccesstokens" edge to the ShareAccessToken entity by ids.
+func (m *RecipientMutation) AddShareaccesstokenIDs(ids ...string) {
+	if m.shareaccesstokens == nil {
+		m.shareaccesstokens = make(map[string]struct{})
+	}
+	for i := range ids {
+		m.shareaccesstokens[ids[i]] = struct{}{}
+	}
+}
+
+// ClearShareaccesstokens clears the "shareaccesstokens" edge to the ShareAccessToken entity.
+func (m *RecipientMutation) ClearShareaccesstokens() {
+	m.clearedshareaccesstokens = true
+}
+
+// ShareaccesstokensCleared reports if the "shareaccesstokens" edge to the ShareAccessToken entity was cleared.
+func (m *RecipientMutation) ShareaccesstokensCleared() bool {
+	return m.clearedshareaccesstokens
+}
+
+// RemoveShareaccesstokenIDs removes the "shareaccesstokens" edge to the ShareAccessToken entity by IDs.
+func (m *RecipientMutation) RemoveShareaccesstokenIDs(ids ...string) {
+	if m.removedshareaccesstokens == nil {
+		m.removedshareaccesstokens = make(map[string]struct{})
+	}
+	for i := range ids {
+		delete(m.shareaccesstokens, ids[i])
+		m.removedshareaccesstokens[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedShareaccesstokens returns the removed IDs of the "shareaccesstokens" edge to the ShareAccessToken entity.
+func (m *RecipientMutation) RemovedShareaccesstokensIDs() (ids []string) {
+	for id := range m.removedshareaccesstokens {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ShareaccesstokensIDs returns the "shareaccesstokens" edge IDs in the mutation.
+func (m *RecipientMutation) ShareaccesstokensIDs() (ids []string) {
+	for id := range m.shareaccesstokens {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetShareaccesstokens resets all changes to the "shareaccesstokens" edge.
+func (m *RecipientMutation) ResetShareaccesstokens() {
+	m.shareaccesstokens = nil
+	m.clearedshareaccesstokens = false
+	m.removedshareaccesstokens = nil
+}
+
+// AddAccessEventIDs adds the "access_events" edge to the AccessEvent entity by ids.
+func (m *RecipientMutation) AddAccessEventIDs(ids ...int) {
+	if m.access_events == nil {
+		m.access_events = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.access_events[ids[i]] = struct{}{}
+	}
+}
+
+// ClearAccessEvents clears the "access_events" edge to the AccessEvent entity.
+func (m *RecipientMutation) ClearAccessEvents() {
+	m.clearedaccess_events = true
+}
+
+// AccessEventsCleared reports if the "access_events" edge to the AccessEvent entity was cleared.
+func (m *RecipientMutation) AccessEventsCleared() bool {
+	return m.clearedaccess_events
+}
+
+// RemoveAccessEventIDs removes the "access_events" edge to the AccessEvent entity by IDs.
+func (m *RecipientMutation) RemoveAccessEventIDs(ids ...int) {
+	if m.removedaccess_events == nil {
+		m.removedaccess_events = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.access_events, ids[i])
+		m.removedaccess_events[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedAccessEvents returns the removed IDs of the "access_events" edge to the AccessEvent entity.
+func (m *RecipientMutation) RemovedAccessEventsIDs() (ids []int) {
+	for id := range m.removedaccess_events {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// AccessEventsIDs returns the "access_events" edge IDs in the mutation.
+func (m *RecipientMutation) AccessEventsIDs() (ids []int) {
+	for id := range m.access_events {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetAccessEvents resets all changes to the "access_events" edge.
+func (m *RecipientMutation) ResetAccessEvents() {
+	m.access_events = nil
+	m.clearedaccess_events = false
+	m.removedaccess_events = nil
+}
+
+// Where appends a list predicates to the RecipientMutation builder.
+func (m *RecipientMutation) Where(ps ...predicate.Recipient) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the RecipientMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *RecipientMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Recipient, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *RecipientMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *RecipientMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Recipient).
+func (m *RecipientMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *RecipientMutation) Fields() []string {
+	fields := make([]string, 0, 8)
+	if m.name != nil {
+		fields = append(fields, recipient.FieldName)
+	}
+	if m.email != nil {
+		fields = append(fields, recipient.FieldEmail)
+	}
+	if m.hashed_token != nil {
+		fields = append(fields, recipient.FieldHashedToken)
+	}
+	if m.created_at != nil {
+		fields = append(fields, recipient.FieldCreatedAt)
+	}
+	if m.revoked_at != nil {
+		fields = append(fields, recipient.FieldRevokedAt)
+	}
+	if m.last_download != nil {
+		fields = append(fields, recipient.FieldLastDownload)
+	}
+	if m.times_downloaded != nil {
+		fields = append(fields, recipient.FieldTimesDownloaded)
+	}
+	if m.downloaded_files != nil {
+		fields = append(fields, recipient.FieldDownloadedFiles)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *RecipientMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case recipient.FieldName:
+		return m.Name()
+	case recipient.FieldEmail:
+		return m.Email()
+	case recipient.FieldHashedToken:
+		return m.HashedToken()
+	case recipient.FieldCreatedAt:
+		return m.CreatedAt()
+	case recipient.FieldRevokedAt:
+		return m.RevokedAt()
+	case recipient.FieldLastDownload:
+		return m.LastDownload()
+	case recipient.FieldTimesDownloaded:
+		return m.TimesDownloaded()
+	case recipient.FieldDownloadedFiles:
+		return m.DownloadedFiles()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *RecipientMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case recipient.FieldName:
+		return m.OldName(ctx)
+	case recipient.FieldEmail:
+		return m.OldEmail(ctx)
+	case recipient.FieldHashedToken:
+		return m.OldHashedToken(ctx)
+	case recipient.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case recipient.FieldRevokedAt:
+		return m.OldRevokedAt(ctx)
+	case recipient.FieldLastDownload:
+		return m.OldLastDownload(ctx)
+	case recipient.FieldTimesDownloaded:
+		return m.OldTimesDownloaded(ctx)
+	case recipient.FieldDownloadedFiles:
+		return m.OldDownloadedFiles(ctx)
+	}
+	return nil, fmt.Errorf("unknown Recipient field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *RecipientMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case recipient.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case recipient.FieldEmail:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEmail(v)
+		return nil
+	case recipient.FieldHashedToken:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetHashedToken(v)
+		return nil
+	case recipient.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case recipient.FieldRevokedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRevokedAt(v)
+		return nil
+	case recipient.FieldLastDownload:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLastDownload(v)
+		return nil
+	case recipient.FieldTimesDownloaded:
+		v, ok := value.(uint64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTimesDownloaded(v)
+		return nil
+	case recipient.FieldDownloadedFiles:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDownloadedFiles(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Recipient field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *RecipientMutation) AddedFields() []string {
+	var fields []string
+	if m.addtimes_downloaded != nil {
+		fields = append(fields, recipient.FieldTimesDownloaded)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *RecipientMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case recipient.FieldTimesDownloaded:
+		return m.AddedTimesDownloaded()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *RecipientMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case recipient.FieldTimesDownloaded:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddTimesDownloaded(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Recipient numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *RecipientMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(recipient.FieldEmail) {
+		fields = append(fields, recipient.FieldEmail)
+	}
+	if m.FieldCleared(recipient.FieldRevokedAt) {
+		fields = append(fields, recipient.FieldRevokedAt)
+	}
+	if m.FieldCleared(recipient.FieldLastDownload) {
+		fields = append(fields, recipient.FieldLastDownload)
+	}
+	if m.FieldCleared(recipient.FieldDownloadedFiles) {
+		fields = append(fields, recipient.FieldDownloadedFiles)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *RecipientMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *RecipientMutation) ClearField(name string) error {
+	switch name {
+	case recipient.FieldEmail:
+		m.ClearEmail()
+		return nil
+	case recipient.FieldRevokedAt:
+		m.ClearRevokedAt()
+		return nil
+	case recipient.FieldLastDownload:
+		m.ClearLastDownload()
+		return nil
+	case recipient.FieldDownloadedFiles:
+		m.ClearDownloadedFiles()
+		return nil
+	}
+	return fmt.Errorf("unknown Recipient nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *RecipientMutation) ResetField(name string) error {
+	switch name {
+	case recipient.FieldName:
+		m.ResetName()
+		return nil
+	case recipient.FieldEmail:
+		m.ResetEmail()
+		return nil
+	case recipient.FieldHashedToken:
+		m.ResetHashedToken()
+		return nil
+	case recipient.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case recipient.FieldRevokedAt:
+		m.ResetRevokedAt()
+		return nil
+	case recipient.FieldLastDownload:
+		m.ResetLastDownload()
+		return nil
+	case recipient.FieldTimesDownloaded:
+		m.ResetTimesDownloaded()
+		return nil
+	case recipient.FieldDownloadedFiles:
+		m.ResetDownloadedFiles()
+		return nil
+	}
+	return fmt.Errorf("unknown Recipient field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *RecipientMutation) AddedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.ticket != nil {
+		edges = append(edges, recipient.EdgeTicket)
+	}
+	if m.shareaccesstokens != nil {
+		edges = append(edges, recipient.EdgeShareaccesstokens)
+	}
+	if m.access_events != nil {
+		edges = append(edges, recipient.EdgeAccessEvents)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *RecipientMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case recipient.EdgeTicket:
+		if id := m.ticket; id != nil {
+			return []ent.Value{*id}
+		}
+	case recipient.EdgeShareaccesstokens:
+		ids := make([]ent.Value, 0, len(m.shareaccesstokens))
+		for id := range m.shareaccesstokens {
+			ids = append(ids, id)
+		}
+		return ids
+	case recipient.EdgeAccessEvents:
+		ids := make([]ent.Value, 0, len(m.access_events))
+		for id := range m.access_events {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *RecipientMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.removedshareaccesstokens != nil {
+		edges = append(edges, recipient.EdgeShareaccesstokens)
+	}
+	if m.removedaccess_events != nil {
+		edges = append(edges, recipient.EdgeAccessEvents)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *RecipientMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case recipient.EdgeShareaccesstokens:
+		ids := make([]ent.Value, 0, len(m.removedshareaccesstokens))
+		for id := range m.removedshareaccesstokens {
+			ids = append(ids, id)
+		}
+		return ids
+	case recipient.EdgeAccessEvents:
+		ids := make([]ent.Value, 0, len(m.removedaccess_events))
+		for id := range m.removedaccess_events {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *RecipientMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.clearedticket {
+		edges = append(edges, recipient.EdgeTicket)
+	}
+	if m.clearedshareaccesstokens {
+		edges = append(edges, recipient.EdgeShareaccesstokens)
+	}
+	if m.clearedaccess_events {
+		edges = append(edges, recipient.EdgeAccessEvents)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *RecipientMutation) EdgeCleared(name string) bool {
+	switch name {
+	case recipient.EdgeTicket:
+		return m.clearedticket
+	case recipient.EdgeShareaccesstokens:
+		return m.clearedshareaccesstokens
+	case recipient.EdgeAccessEvents:
+		return m.clearedaccess_events
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *RecipientMutation) ClearEdge(name string) error {
+	switch name {
+	case recipient.EdgeTicket:
+		m.ClearTicket()
+		return nil
+	}
+	return fmt.Errorf("unknown Recipient unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *RecipientMutation) ResetEdge(name string) error {
+	switch name {
+	case recipient.EdgeTicket:
+		m.ResetTicket()
+		return nil
+	case recipient.EdgeShareaccesstokens:
+		m.ResetShareaccesstokens()
+		return nil
+	case recipient.EdgeAccessEvents:
+		m.ResetAccessEvents()
+		return nil
+	}
+	return fmt.Errorf("unknown Recipient edge %s", name)
+}
+
+// SessionMutation represents an operation that mutates the Session nodes in the graph.
+type SessionMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *int
+	id_token      *string
+	expire        *time.Time
+	refresh_token *string
+	clearedFields map[string]struct{}
+	user          *uuid.UUID
+	cleareduser   bool
+	done          bool
+	oldValue      func(context.Context) (*Session, error)
+	predicates    []predicate.Session
+}
+
+var _ ent.Mutation = (*SessionMutation)(nil)
+
+// sessionOption allows management of the mutation configuration using functional options.
+type sessionOption func(*SessionMutation)
+
+// newSessionMutation creates new mutation for the Session entity.
+func newSessionMutation(c config, op Op, opts ...sessionOption) *SessionMutation {
+	m := &SessionMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeSession,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withSessionID sets the ID field of the mutation.
+func withSessionID(id int) sessionOption {
+	return func(m *SessionMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Session
+		)
+		m.oldValue = func(ctx context.Context) (*Session, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Session.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withSession sets the old Session of the mutation.
+func withSession(node *Session) sessionOption {
+	return func(m *SessionMutation) {
+		m.oldValue = func(context.Context) (*Session, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m SessionMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m SessionMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *SessionMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *SessionMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Session.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetIDToken sets the "id_token" field.
+func (m *SessionMutation) SetIDToken(s string) {
+	m.id_token = &s
+}
+
+// IDToken returns the value of the "id_token" field in the mutation.
+func (m *SessionMutation) IDToken() (r string, exists bool) {
+	v := m.id_token
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldIDToken returns the old "id_token" field's value of the Session entity.
+// If the Session object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SessionMutation) OldIDToken(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIDToken is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIDToken requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIDToken: %w", err)
+	}
+	return oldValue.IDToken, nil
+}
+
+// ResetIDToken resets all changes to the "id_token" field.
+func (m *SessionMutation) ResetIDToken() {
+	m.id_token = nil
+}
+
+// SetExpire sets the "expire" field.
+func (m *SessionMutation) SetExpire(t time.Time) {
+	m.expire = &t
+}
+
+// Expire returns the value of the "expire" field in the mutation.
+func (m *SessionMutation) Expire() (r time.Time, exists bool) {
+	v := m.expire
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldExpire returns the old "expire" field's value of the Session entity.
+// If the Session object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SessionMutation) OldExpire(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldExpire is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldExpire requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldExpire: %w", err)
+	}
+	return oldValue.Expire, nil
+}
+
+// ResetExpire resets all changes to the "expire" field.
+func (m *SessionMutation) ResetExpire() {
+	m.expire = nil
+}
+
+// SetRefreshToken sets the "refresh_token" field.
+func (m *SessionMutation) SetRefreshToken(s string) {
+	m.refresh_token = &s
+}
+
+// RefreshToken returns the value of the "refresh_token" field in the mutation.
+func (m *SessionMutation) RefreshToken() (r string, exists bool) {
+	v := m.refresh_token
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRefreshToken returns the old "refresh_token" field's value of the Session entity.
+// If the Session object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SessionMutation) OldRefreshToken(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRefreshToken is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRefreshToken requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRefreshToken: %w", err)
+	}
+	return oldValue.RefreshToken, nil
+}
+
+// ResetRefreshToken resets all changes to the "refresh_token" field.
+func (m *SessionMutation) ResetRefreshToken() {
+	m.refresh_token = nil
+}
+
+// SetUserID sets the "user" edge to the User entity by id.
+func (m *SessionMutation) SetUserID(id uuid.UUID) {
+	m.user = &id
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (m *SessionMutation) ClearUser() {
+	m.cleareduser = true
+}
+
+// UserCleared reports if the "user" edge to the User entity was cleared.
+func (m *SessionMutation) UserCleared() bool {
+	return m.cleareduser
+}
+
+// UserID returns the "user" edge ID in the mutation.
+func (m *SessionMutation) UserID() (id uuid.UUID, exists bool) {
+	if m.user != nil {
+		return *m.user, true
+	}
+	return
+}
+
+// UserIDs returns the "user" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// UserID instead. It exists only for internal usage by the builders.
+func (m *SessionMutation) UserIDs() (ids []uuid.UUID) {
+	if id := m.user; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetUser resets all changes to the "user" edge.
+func (m *SessionMutation) ResetUser() {
+	m.user = nil
+	m.cleareduser = false
+}
+
+// Where appends a list predicates to the SessionMutation builder.
+func (m *SessionMutation) Where(ps ...predicate.Session) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the SessionMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *SessionMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Session, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *SessionMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *SessionMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Session).
+func (m *SessionMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *SessionMutation) Fields() []string {
+	fields := make([]string, 0, 3)
+	if m.id_token != nil {
+		fields = append(fields, session.FieldIDToken)
+	}
+	if m.expire != nil {
+		fields = append(fields, session.FieldExpire)
+	}
+	if m.refresh_token != nil {
+		fields = append(fields, session.FieldRefreshToken)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *SessionMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case session.FieldIDToken:
+		return m.IDToken()
+	case session.FieldExpire:
+		return m.Expire()
+	case session.FieldRefreshToken:
+		return m.RefreshToken()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *SessionMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case session.FieldIDToken:
+		return m.OldIDToken(ctx)
+	case session.FieldExpire:
+		return m.OldExpire(ctx)
+	case session.FieldRefreshToken:
+		return m.OldRefreshToken(ctx)
+	}
+	return nil, fmt.Errorf("unknown Session field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *SessionMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case session.FieldIDToken:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIDToken(v)
+		return nil
+	case session.FieldExpire:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetExpire(v)
+		return nil
+	case session.FieldRefreshToken:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRefreshToken(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Session field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *SessionMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *SessionMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *SessionMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Session numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *SessionMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *SessionMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *SessionMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown Session nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *SessionMutation) ResetField(name string) error {
+	switch name {
+	case session.FieldIDToken:
+		m.ResetIDToken()
+		return nil
+	case session.FieldExpire:
+		m.ResetExpire()
+		return nil
+	case session.FieldRefreshToken:
+		m.ResetRefreshToken()
+		return nil
+	}
+	return fmt.Errorf("unknown Session field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *SessionMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.user != nil {
+		edges = append(edges, session.EdgeUser)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *SessionMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case session.EdgeUser:
+		if id := m.user; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *SessionMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *SessionMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *SessionMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.cleareduser {
+		edges = append(edges, session.EdgeUser)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *SessionMutation) EdgeCleared(name string) bool {
+	switch name {
+	case session.EdgeUser:
+		return m.cleareduser
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *SessionMutation) ClearEdge(name string) error {
+	switch name {
+	case session.EdgeUser:
+		m.ClearUser()
+		return nil
+	}
+	return fmt.Errorf("unknown Session unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *SessionMutation) ResetEdge(name string) error {
+	switch name {
+	case session.EdgeUser:
+		m.ResetUser()
+		return nil
+	}
+	return fmt.Errorf("unknown Session edge %s", name)
+}
+
+// ShareAccessTokenMutation represents an operation that mutates the ShareAccessToken nodes in the graph.
+type ShareAccessTokenMutation struct {
+	config
+	op               Op
+	typ              string
+	id               *string
+	expiry           *time.Time
+	clearedFields    map[string]struct{}
+	ticket           *uuid.UUID
+	clearedticket    bool
+	grant            *uuid.UUID
+	clearedgrant     bool
+	recipient        *uuid.UUID
+	clearedrecipient bool
+	done             bool
+	oldValue         func(context.Context) (*ShareAccessToken, error)
+	predicates       []predicate.ShareAccessToken
+}
+
+var _ ent.Mutation = (*ShareAccessTokenMutation)(nil)
+
+// shareaccesstokenOption allows management of the mutation configuration using functional options.
+type shareaccesstokenOption func(*ShareAccessTokenMutation)
+
+// newShareAccessTokenMutation creates new mutation for the ShareAccessToken entity.
+func newShareAccessTokenMutation(c config, op Op, opts ...shareaccesstokenOption) *ShareAccessTokenMutation {
+	m := &ShareAccessTokenMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeShareAccessToken,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withShareAccessTokenID sets the ID field of the mutation.
+func withShareAccessTokenID(id string) shareaccesstokenOption {
+	return func(m *ShareAccessTokenMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *ShareAccessToken
+		)
+		m.oldValue = func(ctx context.Context) (*ShareAccessToken, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().ShareAccessToken.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withShareAccessToken sets the old ShareAccessToken of the mutation.
+func withShareAccessToken(node *ShareAccessToken) shareaccesstokenOption {
+	return func(m *ShareAccessTokenMutation) {
+		m.oldValue = func(context.Context) (*ShareAccessToken, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m ShareAccessTokenMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m ShareAccessTokenMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of ShareAccessToken entities.
+func (m *ShareAccessTokenMutation) SetID(id string) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *ShareAccessTokenMutation) ID() (id string, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *ShareAccessTokenMutation) IDs(ctx context.Context) ([]string, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []string{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().ShareAccessToken.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetExpiry sets the "expiry" field.
+func (m *ShareAccessTokenMutation) SetExpiry(t time.Time) {
+	m.expiry = &t
+}
+
+// Expiry returns the value of the "expiry" field in the mutation.
+func (m *ShareAccessTokenMutation) Expiry() (r time.Time, exists bool) {
+	v := m.expiry
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldExpiry returns the old "expiry" field's value of the ShareAccessToken entity.
+// If the ShareAccessToken object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ShareAccessTokenMutation) OldExpiry(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldExpiry is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldExpiry requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldExpiry: %w", err)
+	}
+	return oldValue.Expiry, nil
+}
+
+// ResetExpiry resets all changes to the "expiry" field.
+func (m *ShareAccessTokenMutation) ResetExpiry() {
+	m.expiry = nil
+}
+
+// SetTicketID sets the "ticket" edge to the Ticket entity by id.
+func (m *ShareAccessTokenMutation) SetTicketID(id uuid.UUID) {
+	m.ticket = &id
+}
+
+// ClearTicket clears the "ticket" edge to the Ticket entity.
+func (m *ShareAccessTokenMutation) ClearTicket() {
+	m.clearedticket = true
+}
+
+// TicketCleared reports if the "ticket" edge to the Ticket entity was cleared.
+func (m *ShareAccessTokenMutation) TicketCleared() bool {
+	return m.clearedticket
+}
+
+// TicketID returns the "ticket" edge ID in the mutation.
+func (m *ShareAccessTokenMutation) TicketID() (id uuid.UUID, exists bool) {
+	if m.ticket != nil {
+		return *m.ticket, true
+	}
+	return
+}
+
+// TicketIDs returns the "ticket" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// TicketID instead. It exists only for internal usage by the builders.
+func (m *ShareAccessTokenMutation) TicketIDs() (ids []uuid.UUID) {
+	if id := m.ticket; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetTicket resets all changes to the "ticket" edge.
+func (m *ShareAccessTokenMutation) ResetTicket() {
+	m.ticket = nil
+	m.clearedticket = false
+}
+
+// SetGrantID sets the "grant" edge to the Grant entity by id.
+func (m *ShareAccessTokenMutation) SetGrantID(id uuid.UUID) {
+	m.grant = &id
+}
+
+// ClearGrant clears the "grant" edge to the Grant entity.
+func (m *ShareAccessTokenMutation) ClearGrant() {
+	m.clearedgrant = true
+}
+
+// GrantCleared reports if the "grant" edge to the Grant entity was cleared.
+func (m *ShareAccessTokenMutation) GrantCleared() bool {
+	return m.clearedgrant
+}
+
+// GrantID returns the "grant" edge ID in the mutation.
+func (m *ShareAccessTokenMutation) GrantID() (id uuid.UUID, exists bool) {
+	if m.grant != nil {
+		return *m.grant, true
+	}
+	return
+}
+
+// GrantIDs returns the "grant" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// GrantID instead. It exists only for internal usage by the builders.
+func (m *ShareAccessTokenMutation) GrantIDs() (ids []uuid.UUID) {
+	if id := m.grant; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetGrant resets all changes to the "grant" edge.
+func (m *ShareAccessTokenMutation) ResetGrant() {
+	m.grant = nil
+	m.clearedgrant = false
+}
+
+// SetRecipientID sets the "recipient" edge to the Recipient entity by id.
+func (m *ShareAccessTokenMutation) SetRecipientID(id uuid.UUID) {
+	m.recipient = &id
+}
+
+// ClearRecipient clears the "recipient" edge to the Recipient entity.
+func (m *ShareAccessTokenMutation) ClearRecipient() {
+	m.clearedrecipient = true
+}
+
+// RecipientCleared reports if the "recipient" edge to the Recipient entity was cleared.
+func (m *ShareAccessTokenMutation) RecipientCleared() bool {
+	return m.clearedrecipient
+}
+
+// RecipientID returns the "recipient" edge ID in the mutation.
+func (m *ShareAccessTokenMutation) RecipientID() (id uuid.UUID, exists bool) {
+	if m.recipient != nil {
+		return *m.recipient, true
+	}
+	return
+}
+
+// RecipientIDs returns the "recipient" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// RecipientID instead. It exists only for internal usage by the builders.
+func (m *ShareAccessTokenMutation) RecipientIDs() (ids []uuid.UUID) {
+	if id := m.recipient; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetRecipient resets all changes to the "recipient" edge.
+func (m *ShareAccessTokenMutation) ResetRecipient() {
+	m.recipient = nil
+	m.clearedrecipient = false
+}
+
+// Where appends a list predicates to the ShareAccessTokenMutation builder.
+func (m *ShareAccessTokenMutation) Where(ps ...predicate.ShareAccessToken) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the ShareAccessTokenMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *ShareAccessTokenMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.ShareAccessToken, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *ShareAccessTokenMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *ShareAccessTokenMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (ShareAccessToken).
+func (m *ShareAccessTokenMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *ShareAccessTokenMutation) Fields() []string {
+	fields := make([]string, 0, 1)
+	if m.expiry != nil {
+		fields = append(fields, shareaccesstoken.FieldExpiry)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *ShareAccessTokenMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case shareaccesstoken.FieldExpiry:
+		return m.Expiry()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *ShareAccessTokenMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case shareaccesstoken.FieldExpiry:
+		return m.OldExpiry(ctx)
+	}
+	return nil, fmt.Errorf("unknown ShareAccessToken field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ShareAccessTokenMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case shareaccesstoken.FieldExpiry:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetExpiry(v)
+		return nil
+	}
+	return fmt.Errorf("unknown ShareAccessToken field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *ShareAccessTokenMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *ShareAccessTokenMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ShareAccessTokenMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown ShareAccessToken numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *ShareAccessTokenMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *ShareAccessTokenMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *ShareAccessTokenMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown ShareAccessToken nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *ShareAccessTokenMutation) ResetField(name string) error {
+	switch name {
+	case shareaccesstoken.FieldExpiry:
+		m.ResetExpiry()
+		return nil
+	}
+	return fmt.Errorf("unknown ShareAccessToken field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *ShareAccessTokenMutation) AddedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.ticket != nil {
+		edges = append(edges, shareaccesstoken.EdgeTicket)
+	}
+	if m.grant != nil {
+		edges = append(edges, shareaccesstoken.EdgeGrant)
+	}
+	if m.recipient != nil {
+		edges = append(edges, shareaccesstoken.EdgeRecipient)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *ShareAccessTokenMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case shareaccesstoken.EdgeTicket:
+		if id := m.ticket; id != nil {
+			return []ent.Value{*id}
+		}
+	case shareaccesstoken.EdgeGrant:
+		if id := m.grant; id != nil {
+			return []ent.Value{*id}
+		}
+	case shareaccesstoken.EdgeRecipient:
+		if id := m.recipient; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *ShareAccessTokenMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 3)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *ShareAccessTokenMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *ShareAccessTokenMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.clearedticket {
+		edges = append(edges, shareaccesstoken.EdgeTicket)
+	}
+	if m.clearedgrant {
+		edges = append(edges, shareaccesstoken.EdgeGrant)
+	}
+	if m.clearedrecipient {
+		edges = append(edges, shareaccesstoken.EdgeRecipient)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *ShareAccessTokenMutation) EdgeCleared(name string) bool {
+	switch name {
+	case shareaccesstoken.EdgeTicket:
+		return m.clearedticket
+	case shareaccesstoken.EdgeGrant:
+		return m.clearedgrant
+	case shareaccesstoken.EdgeRecipient:
+		return m.clearedrecipient
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *ShareAccessTokenMutation) ClearEdge(name string) error {
+	switch name {
+	case shareaccesstoken.EdgeTicket:
+		m.ClearTicket()
+		return nil
+	case shareaccesstoken.EdgeGrant:
+		m.ClearGrant()
+		return nil
+	case shareaccesstoken.EdgeRecipient:
+		m.ClearRecipient()
+		return nil
+	}
+	return fmt.Errorf("unknown ShareAccessToken unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *ShareAccessTokenMutation) ResetEdge(name string) error {
+	switch name {
+	case shareaccesstoken.EdgeTicket:
+		m.ResetTicket()
+		return nil
+	case shareaccesstoken.EdgeGrant:
+		m.ResetGrant()
+		return nil
+	case shareaccesstoken.EdgeRecipient:
+		m.ResetRecipient()
+		return nil
+	}
+	return fmt.Errorf("unknown ShareAccessToken edge %s", name)
+}
+
+// StorageVerificationMutation represents an operation that mutates the StorageVerification nodes in the graph.
+type StorageVerificationMutation struct {
+	config
+	op               Op
+	typ              string
+	id               *int
+	started_at       *time.Time
+	finished_at      *time.Time
+	checked_blobs    *int64
+	addchecked_blobs *int64
+	issues           *[]storage.Issue
+	appendissues     []storage.Issue
+	clearedFields    map[string]struct{}
+	done             bool
+	oldValue         func(context.Context) (*StorageVerification, error)
+	predicates       []predicate.StorageVerification
+}
+
+var _ ent.Mutation = (*StorageVerificationMutation)(nil)
+
+// storageverificationOption allows management of the mutation configuration using functional options.
+type storageverificationOption func(*StorageVerificationMutation)
+
+// newStorageVerificationMutation creates new mutation for the StorageVerification entity.
+func newStorageVerificationMutation(c config, op Op, opts ...storageverificationOption) *StorageVerificationMutation {
+	m := &StorageVerificationMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeStorageVerification,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withStorageVerificationID sets the ID field of the mutation.
+func withStorageVerificationID(id int) storageverificationOption {
+	return func(m *StorageVerificationMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *StorageVerification
+		)
+		m.oldValue = func(ctx context.Context) (*StorageVerification, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().StorageVerification.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withStorageVerification sets the old StorageVerification of the mutation.
+func withStorageVerification(node *StorageVerification) storageverificationOption {
+	return func(m *StorageVerificationMutation) {
+		m.oldValue = func(context.Context) (*StorageVerification, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m StorageVerificationMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m StorageVerificationMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *StorageVerificationMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *StorageVerificationMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().StorageVerification.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetStartedAt sets the "started_at" field.
+func (m *StorageVerificationMutation) SetStartedAt(t time.Time) {
+	m.started_at = &t
+}
+
+// StartedAt returns the value of the "started_at" field in the mutation.
+func (m *StorageVerificationMutation) StartedAt() (r time.Time, exists bool) {
+	v := m.started_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStartedAt returns the old "started_at" field's value of the StorageVerification entity.
+// If the StorageVerification object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StorageVerificationMutation) OldStartedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStartedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStartedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStartedAt: %w", err)
+	}
+	return oldValue.StartedAt, nil
+}
+
+// ResetStartedAt resets all changes to the "started_at" field.
+func (m *StorageVerificationMutation) ResetStartedAt() {
+	m.started_at = nil
+}
+
+// SetFinishedAt sets the "finished_at" field.
+func (m *StorageVerificationMutation) SetFinishedAt(t time.Time) {
+	m.finished_at = &t
+}
+
+// FinishedAt returns the value of the "finished_at" field in the mutation.
+func (m *StorageVerificationMutation) FinishedAt() (r time.Time, exists bool) {
+	v := m.finished_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFinishedAt returns the old "finished_at" field's value of the StorageVerification entity.
+// If the StorageVerification object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StorageVerificationMutation) OldFinishedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFinishedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFinishedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFinishedAt: %w", err)
+	}
+	return oldValue.FinishedAt, nil
+}
+
+// ClearFinishedAt clears the value of the "finished_at" field.
+func (m *StorageVerificationMutation) ClearFinishedAt() {
+	m.finished_at = nil
+	m.clearedFields[storageverification.FieldFinishedAt] = struct{}{}
+}
+
+// FinishedAtCleared returns if the "finished_at" field was cleared in this mutation.
+func (m *StorageVerificationMutation) FinishedAtCleared() bool {
+	_, ok := m.clearedFields[storageverification.FieldFinishedAt]
+	return ok
+}
+
+// ResetFinishedAt resets all changes to the "finished_at" field.
+func (m *StorageVerificationMutation) ResetFinishedAt() {
+	m.finished_at = nil
+	delete(m.clearedFields, storageverification.FieldFinishedAt)
+}
+
+// SetCheckedBlobs sets the "checked_blobs" field.
+func (m *StorageVerificationMutation) SetCheckedBlobs(i int64) {
+	m.checked_blobs = &i
+	m.addchecked_blobs = nil
+}
+
+// CheckedBlobs returns the value of the "checked_blobs" field in the mutation.
+func (m *StorageVerificationMutation) CheckedBlobs() (r int64, exists bool) {
+	v := m.checked_blobs
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCheckedBlobs returns the old "checked_blobs" field's value of the StorageVerification entity.
+// If the StorageVerification object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StorageVerificationMutation) OldCheckedBlobs(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCheckedBlobs is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCheckedBlobs requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCheckedBlobs: %w", err)
+	}
+	return oldValue.CheckedBlobs, nil
+}
+
+// AddCheckedBlobs adds i to the "checked_blobs" field.
+func (m *StorageVerificationMutation) AddCheckedBlobs(i int64) {
+	if m.addchecked_blobs != nil {
+		*m.addchecked_blobs += i
+	} else {
+		m.addchecked_blobs = &i
+	}
+}
+
+// AddedCheckedBlobs returns the value that was added to the "checked_blobs" field in this mutation.
+func (m *StorageVerificationMutation) AddedCheckedBlobs() (r int64, exists bool) {
+	v := m.addchecked_blobs
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetCheckedBlobs resets all changes to the "checked_blobs" field.
+func (m *StorageVerificationMutation) ResetCheckedBlobs() {
+	m.checked_blobs = nil
+	m.addchecked_blobs = nil
+}
+
+// SetIssues sets the "issues" field.
+func (m *StorageVerificationMutation) SetIssues(s []storage.Issue) {
+	m.issues = &s
+	m.appendissues = nil
+}
+
+// Issues returns the value of the "issues" field in the mutation.
+func (m *StorageVerificationMutation) Issues() (r []storage.Issue, exists bool) {
+	v := m.issues
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldIssues returns the old "issues" field's value of the StorageVerification entity.
+// If the StorageVerification object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StorageVerificationMutation) OldIssues(ctx context.Context) (v []storage.Issue, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIssues is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIssues requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIssues: %w", err)
+	}
+	return oldValue.Issues, nil
+}
+
+// AppendIssues adds s to the "issues" field.
+func (m *StorageVerificationMutation) AppendIssues(s []storage.Issue) {
+	m.appendissues = append(m.appendissues, s...)
+}
+
+// AppendedIssues returns the list of values that were appended to the "issues" field in this mutation.
+func (m *StorageVerificationMutation) AppendedIssues() ([]storage.Issue, bool) {
+	if len(m.appendissues) == 0 {
+		return nil, false
+	}
+	return m.appendissues, true
+}
+
+// ClearIssues clears the value of the "issues" field.
+func (m *StorageVerificationMutation) ClearIssues() {
+	m.issues = nil
+	m.appendissues = nil
+	m.clearedFields[storageverification.FieldIssues] = struct{}{}
+}
+
+// IssuesCleared returns if the "issues" field was cleared in this mutation.
+func (m *StorageVerificationMutation) IssuesCleared() bool {
+	_, ok := m.clearedFields[storageverification.FieldIssues]
+	return ok
+}
+
+// ResetIssues resets all changes to the "issues" field.
+func (m *StorageVerificationMutation) ResetIssues() {
+	m.issues = nil
+	m.appendissues = nil
+	delete(m.clearedFields, storageverification.FieldIssues)
+}
+
+// Where appends a list predicates to the StorageVerificationMutation builder.
+func (m *StorageVerificationMutation) Where(ps ...predicate.StorageVerification) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the StorageVerificationMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *StorageVerificationMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.StorageVerification, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *StorageVerificationMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *StorageVerificationMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (StorageVerification).
+func (m *StorageVerificationMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *StorageVerificationMutation) Fields() []string {
+	fields := make([]string, 0, 4)
+	if m.started_at != nil {
+		fields = append(fields, storageverification.FieldStartedAt)
+	}
+	if m.finished_at != nil {
+		fields = append(fields, storageverification.FieldFinishedAt)
+	}
+	if m.checked_blobs != nil {
+		fields = append(fields, storageverification.FieldCheckedBlobs)
+	}
+	if m.issues != nil {
+		fields = append(fields, storageverification.FieldIssues)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *StorageVerificationMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case storageverification.FieldStartedAt:
+		return m.StartedAt()
+	case storageverification.FieldFinishedAt:
+		return m.FinishedAt()
+	case storageverification.FieldCheckedBlobs:
+		return m.CheckedBlobs()
+	case storageverification.FieldIssues:
+		return m.Issues()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *StorageVerificationMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case storageverification.FieldStartedAt:
+		return m.OldStartedAt(ctx)
+	case storageverification.FieldFinishedAt:
+		return m.OldFinishedAt(ctx)
+	case storageverification.FieldCheckedBlobs:
+		return m.OldCheckedBlobs(ctx)
+	case storageverification.FieldIssues:
+		return m.OldIssues(ctx)
+	}
+	return nil, fmt.Errorf("unknown StorageVerification field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *StorageVerificationMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case storageverification.FieldStartedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStartedAt(v)
+		return nil
+	case storageverification.FieldFinishedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFinishedAt(v)
+		return nil
+	case storageverification.FieldCheckedBlobs:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCheckedBlobs(v)
+		return nil
+	case storageverification.FieldIssues:
+		v, ok := value.([]storage.Issue)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIssues(v)
+		return nil
+	}
+	return fmt.Errorf("unknown StorageVerification field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *StorageVerificationMutation) AddedFields() []string {
+	var fields []string
+	if m.addchecked_blobs != nil {
+		fields = append(fields, storageverification.FieldCheckedBlobs)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *StorageVerificationMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case storageverification.FieldCheckedBlobs:
+		return m.AddedCheckedBlobs()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *StorageVerificationMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case storageverification.FieldCheckedBlobs:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddCheckedBlobs(v)
+		return nil
+	}
+	return fmt.Errorf("unknown StorageVerification numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *StorageVerificationMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(storageverification.FieldFinishedAt) {
+		fields = append(fields, storageverification.FieldFinishedAt)
+	}
+	if m.FieldCleared(storageverification.FieldIssues) {
+		fields = append(fields, storageverification.FieldIssues)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *StorageVerificationMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *StorageVerificationMutation) ClearField(name string) error {
+	switch name {
+	case storageverification.FieldFinishedAt:
+		m.ClearFinishedAt()
+		return nil
+	case storageverification.FieldIssues:
+		m.ClearIssues()
+		return nil
+	}
+	return fmt.Errorf("unknown StorageVerification nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *StorageVerificationMutation) ResetField(name string) error {
+	switch name {
+	case storageverification.FieldStartedAt:
+		m.ResetStartedAt()
+		return nil
+	case storageverification.FieldFinishedAt:
+		m.ResetFinishedAt()
+		return nil
+	case storageverification.FieldCheckedBlobs:
+		m.ResetCheckedBlobs()
+		return nil
+	case storageverification.FieldIssues:
+		m.ResetIssues()
+		return nil
+	}
+	return fmt.Errorf("unknown StorageVerification field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *StorageVerificationMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *StorageVerificationMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *StorageVerificationMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *StorageVerificationMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *StorageVerificationMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *StorageVerificationMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *StorageVerificationMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown StorageVerification unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *StorageVerificationMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown StorageVerification edge %s", name)
+}
+
+// TicketMutation represents an operation that mutates the Ticket nodes in the graph.
+type TicketMutation struct {
+	config
+	op                                 Op
+	typ                                string
+	id                                 *uuid.UUID
+	comment                            *string
+	expiryType                         *string
+	hashed_password                    *string
+	salt                               *string
+	created_at                         *time.Time
+	expiry_total_days                  *uint8
+	addexpiry_total_days               *int8
+	expiry_days_since_last_download    *uint8
+	addexpiry_days_since_last_download *int8
+	expiry_total_downloads             *uint8
+	addexpiry_total_downloads          *int8
+	email_on_download                  *[]string
+	appendemail_on_download            []string
+	creator_lang                       *string
+	views_count_as_downloads           *bool
+	clearedFields                      map[string]struct{}
+	files                              map[uuid.UUID]struct{}
+	removedfiles                       map[uuid.UUID]struct{}
+	clearedfiles                       bool
+	owner                              *uuid.UUID
+	clearedowner                       bool
+	shareaccesstokens                  map[string]struct{}
+	removedshareaccesstokens           map[string]struct{}
+	clearedshareaccesstokens           bool
+	recipients                         map[uuid.UUID]struct{}
+	removedrecipients                  map[uuid.UUID]struct{}
+	clearedrecipients                  bool
+	access_events                      map[int]struct{}
+	removedaccess_events               map[int]struct{}
+	clearedaccess_events               bool
+	done                               bool
+	oldValue                           func(context.Context) (*Ticket, error)
+	predicates                         []predicate.Ticket
+}
+
+var _ ent.Mutation = (*TicketMutation)(nil)
+
+// ticketOption allows management of the mutation configuration using functional options.
+type ticketOption func(*TicketMutation)
+
+// newTicketMutation creates new mutation for the Ticket entity.
+func newTicketMutation(c config, op Op, opts ...ticketOption) *TicketMutation {
+	m := &TicketMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeTicket,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withTicketID sets the ID field of the mutation.
+func withTicketID(id uuid.UUID) ticketOption {
+	return func(m *TicketMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Ticket
+		)
+		m.oldValue = func(ctx context.Context) (*Ticket, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Ticket.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withTicket sets the old Ticket of the mutation.
+func withTicket(node *Ticket) ticketOption {
+	return func(m *TicketMutation) {
+		m.oldValue = func(context.Context) (*Ticket, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m TicketMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m TicketMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Ticket entities.
+func (m *TicketMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *TicketMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *TicketMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Ticket.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetComment sets the "comment" field.
+func (m *TicketMutation) SetComment(s string) {
+	m.comment = &s
+}
+
+// Comment returns the value of the "comment" field in the mutation.
+func (m *TicketMutation) Comment() (r string, exists bool) {
+	v := m.comment
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldComment returns the old "comment" field's value of the Ticket entity.
+// If the Ticket object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TicketMutation) OldComment(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldComment is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldComment requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldComment: %w", err)
+	}
+	return oldValue.Comment, nil
+}
+
+// ClearComment clears the value of the "comment" field.
+func (m *TicketMutation) ClearComment() {
+	m.comment = nil
+	m.clearedFields[ticket.FieldComment] = struct{}{}
+}
+
+// CommentCleared returns if the "comment" field was cleared in this mutation.
+func (m *TicketMutation) CommentCleared() bool {
+	_, ok := m.clearedFields[ticket.FieldComment]
+	return ok
+}
+
+// ResetComment resets all changes to the "comment" field.
+func (m *TicketMutation) ResetComment() {
+	m.comment = nil
+	delete(m.clearedFields, ticket.FieldComment)
+}
+
+// SetExpiryType sets the "expiryType" field.
+func (m *TicketMutation) SetExpiryType(s string) {
+	m.expiryType = &s
+}
+
+// ExpiryType returns the value of the "expiryType" field in the mutation.
+func (m *TicketMutation) ExpiryType() (r string, exists bool) {
+	v := m.expiryType
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldExpiryType returns the old "expiryType" field's value of the Ticket entity.
+// If the Ticket object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TicketMutation) OldExpiryType(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldExpiryType is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldExpiryType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldExpiryType: %w", err)
+	}
+	return oldValue.ExpiryType, nil
+}
+
+// ResetExpiryType resets all changes to the "expiryType" field.
+func (m *TicketMutation) ResetExpiryType() {
+	m.expiryType = nil
+}
+
+// SetHashedPassword sets the "hashed_password" field.
+func (m *TicketMutation) SetHashedPassword(s string) {
+	m.hashed_password = &s
+}
+
+// HashedPassword returns the value of the "hashed_password" field in the mutation.
+func (m *TicketMutation) HashedPassword() (r string, exists bool) {
+	v := m.hashed_password
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldHashedPassword returns the old "hashed_password" field's value of the Ticket entity.
+// If the Ticket object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TicketMutation) OldHashedPassword(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldHashedPassword is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldHashedPassword requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldHashedPassword: %w", err)
+	}
+	return oldValue.HashedPassword, nil
+}
+
+// ResetHashedPassword resets all changes to the "hashed_password" field.
+func (m *TicketMutation) ResetHashedPassword() {
+	m.hashed_password = nil
+}
+
+// SetSalt sets the "salt" field.
+func (m *TicketMutation) SetSalt(s string) {
+	m.salt = &s
+}
+
+// Salt returns the value of the "salt" field in the mutation.
+func (m *TicketMutation) Salt() (r string, exists bool) {
+	v := m.salt
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSalt returns the old "salt" field's value of the Ticket entity.
+// If the Ticket object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TicketMutation) OldSalt(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSalt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSalt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSalt: %w", err)
+	}
+	return oldValue.Salt, nil
+}
+
+// ResetSalt resets all changes to the "salt" field.
+func (m *TicketMutation) ResetSalt() {
+	m.salt = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *TicketMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *TicketMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Ticket entity.
+// If the Ticket object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TicketMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *TicketMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetExpiryTotalDays sets the "expiry_total_days" field.
+func (m *TicketMutation) SetExpiryTotalDays(u uint8) {
+	m.expiry_total_days = &u
+	m.addexpiry_total_days = nil
+}
+
+// ExpiryTotalDays returns the value of the "expiry_total_days" field in the mutation.
+func (m *TicketMutation) ExpiryTotalDays() (r uint8, exists bool) {
+	v := m.expiry_total_days
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldExpiryTotalDays returns the old "expiry_total_days" field's value of the Ticket entity.
+// If the Ticket object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TicketMutation) OldExpiryTotalDays(ctx context.Context) (v uint8, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldExpiryTotalDays is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldExpiryTotalDays requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldExpiryTotalDays: %w", err)
+	}
+	return oldValue.ExpiryTotalDays, nil
+}
+
+// AddExpiryTotalDays adds u to the "expiry_total_days" field.
+func (m *TicketMutation) AddExpiryTotalDays(u int8) {
+	if m.addexpiry_total_days != nil {
+		*m.addexpiry_total_days += u
+	} else {
+		m.addexpiry_total_days = &u
+	}
+}
+
+// AddedExpiryTotalDays returns the value that was added to the "expiry_total_days" field in this mutation.
+func (m *TicketMutation) AddedExpiryTotalDays() (r int8, exists bool) {
+	v := m.addexpiry_total_days
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetExpiryTotalDays resets all changes to the "expiry_total_days" field.
+func (m *TicketMutation) ResetExpiryTotalDays() {
+	m.expiry_total_days = nil
+	m.addexpiry_total_days = nil
+}
+
+// SetExpiryDaysSinceLastDownload sets the "expiry_days_since_last_download" field.
+func (m *TicketMutation) SetExpiryDaysSinceLastDownload(u uint8) {
+	m.expiry_days_since_last_download = &u
+	m.addexpiry_days_since_last_download = nil
+}
+
+// ExpiryDaysSinceLastDownload returns the value of the "expiry_days_since_last_download" field in the mutation.
+func (m *TicketMutation) ExpiryDaysSinceLastDownload() (r uint8, exists bool) {
+	v := m.expiry_days_since_last_download
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldExpiryDaysSinceLastDownload returns the old "expiry_days_since_last_download" field's value of the Ticket entity.
+// If the Ticket object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TicketMutation) OldExpiryDaysSinceLastDownload(ctx context.Context) (v uint8, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldExpiryDaysSinceLastDownload is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldExpiryDaysSinceLastDownload requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldExpiryDaysSinceLastDownload: %w", err)
+	}
+	return oldValue.ExpiryDaysSinceLastDownload, nil
+}
+
+// AddExpiryDaysSinceLastDownload adds u to the "expiry_days_since_last_download" field.
+func (m *TicketMutation) AddExpiryDaysSinceLastDownload(u int8) {
+	if m.addexpiry_days_since_last_download != nil {
+		*m.addexpiry_days_since_last_download += u
+	} else {
+		m.addexpiry_days_since_last_download = &u
+	}
+}
+
+// AddedExpiryDaysSinceLastDownload returns the value that was added to the "expiry_days_since_last_download" field in this mutation.
+func (m *TicketMutation) AddedExpiryDaysSinceLastDownload() (r int8, exists bool) {
+	v := m.addexpiry_days_since_last_download
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetExpiryDaysSinceLastDownload resets all changes to the "expiry_days_since_last_download" field.
+func (m *TicketMutation) ResetExpiryDaysSinceLastDownload() {
+	m.expiry_days_since_last_download = nil
+	m.addexpiry_days_since_last_download = nil
+}
+
+// SetExpiryTotalDownloads sets the "expiry_total_downloads" field.
+func (m *TicketMutation) SetExpiryTotalDownloads(u uint8) {
+	m.expiry_total_downloads = &u
+	m.addexpiry_total_downloads = nil
+}
+
+// ExpiryTotalDownloads returns the value of the "expiry_total_downloads" field in the mutation.
+func (m *TicketMutation) ExpiryTotalDownloads() (r uint8, exists bool) {
+	v := m.expiry_total_downloads
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldExpiryTotalDownloads returns the old "expiry_total_downloads" field's value of the Ticket entity.
+// If the Ticket object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TicketMutation) OldExpiryTotalDownloads(ctx context.Context) (v uint8, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldExpiryTotalDownloads is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldExpiryTotalDownloads requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldExpiryTotalDownloads: %w", err)
+	}
+	return oldValue.ExpiryTotalDownloads, nil
+}
+
+// AddExpiryTotalDownloads adds u to the "expiry_total_downloads" field.
+func (m *TicketMutation) AddExpiryTotalDownloads(u int8) {
+	if m.addexpiry_total_downloads != nil {
+		*m.addexpiry_total_downloads += u
+	} else {
+		m.addexpiry_total_downloads = &u
+	}
+}
+
+// AddedExpiryTotalDownloads returns the value that was added to the "expiry_total_downloads" field in this mutation.
+func (m *TicketMutation) AddedExpiryTotalDownloads() (r int8, exists bool) {
+	v := m.addexpiry_total_downloads
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetExpiryTotalDownloads resets all changes to the "expiry_total_downloads" field.
+func (m *TicketMutation) ResetExpiryTotalDownloads() {
+	m.expiry_total_downloads = nil
+	m.addexpiry_total_downloads = nil
+}
+
+// SetEmailOnDownload sets the "email_on_download" field.
+func (m *TicketMutation) SetEmailOnDownload(s []string) {
+	m.email_on_download = &s
+	m.appendemail_on_download = nil
+}
+
+// EmailOnDownload returns the value of the "email_on_download" field in the mutation.
+func (m *TicketMutation) EmailOnDownload() (r []string, exists bool) {
+	v := m.email_on_download
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEmailOnDownload returns the old "email_on_download" field's value of the Ticket entity.
+// If the Ticket object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TicketMutation) OldEmailOnDownload(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEmailOnDownload is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEmailOnDownload requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEmailOnDownload: %w", err)
+	}
+	return oldValue.EmailOnDownload, nil
+}
+
+// AppendEmailOnDownload adds s to the "email_on_download" field.
+func (m *TicketMutation) AppendEmailOnDownload(s []string) {
+	m.appendemail_on_download = append(m.appendemail_on_download, s...)
+}
+
+// AppendedEmailOnDownload returns the list of values that were appended to the "email_on_download" field in this mutation.
+func (m *TicketMutation) AppendedEmailOnDownload() ([]string, bool) {
+	if len(m.appendemail_on_download) == 0 {
+		return nil, false
+	}
+	return m.appendemail_on_download, true
+}
+
+// ClearEmailOnDownload clears the value of the "email_on_download" field.
+func (m *TicketMutation) ClearEmailOnDownload() {
+	m.email_on_download = nil
+	m.appendemail_on_download = nil
+	m.clearedFields[ticket.FieldEmailOnDownload] = struct{}{}
+}
+
+// EmailOnDownloadCleared returns if the "email_on_download" field was cleared in this mutation.
+func (m *TicketMutation) EmailOnDownloadCleared() bool {
+	_, ok := m.clearedFields[ticket.FieldEmailOnDownload]
+	return ok
+}
+
+// ResetEmailOnDownload resets all changes to the "email_on_download" field.
+func (m *TicketMutation) ResetEmailOnDownload() {
+	m.email_on_download = nil
+	m.appendemail_on_download = nil
+	delete(m.clearedFields, ticket.FieldEmailOnDownload)
+}
+
+// SetCreatorLang sets the "creator_lang" field.
+func (m *TicketMutation) SetCreatorLang(s string) {
+	m.creator_lang = &s
+}
+
+// CreatorLang returns the value of the "creator_lang" field in the mutation.
+func (m *TicketMutation) CreatorLang() (r string, exists bool) {
+	v := m.creator_lang
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatorLang returns the old "creator_lang" field's value of the Ticket entity.
+// If the Ticket object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TicketMutation) OldCreatorLang(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatorLang is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatorLang requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatorLang: %w", err)
+	}
+	return oldValue.CreatorLang, nil
+}
+
+// ResetCreatorLang resets all changes to the "creator_lang" field.
+func (m *TicketMutation) ResetCreatorLang() {
+	m.creator_lang = nil
+}
+
+// SetViewsCountAsDownloads sets the "views_count_as_downloads" field.
+func (m *TicketMutation) SetViewsCountAsDownloads(b bool) {
+	m.views_count_as_downloads = &b
+}
+
+// ViewsCountAsDownloads returns the value of the "views_count_as_downloads" field in the mutation.
+func (m *TicketMutation) ViewsCountAsDownloads() (r bool, exists bool) {
+	v := m.views_count_as_downloads
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldViewsCountAsDownloads returns the old "views_count_as_downloads" field's value of the Ticket entity.
+// If the Ticket object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TicketMutation) OldViewsCountAsDownloads(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldViewsCountAsDownloads is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldViewsCountAsDownloads requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldViewsCountAsDownloads: %w", err)
+	}
+	return oldValue.ViewsCountAsDownloads, nil
+}
+
+// ResetViewsCountAsDownloads resets all changes to the "views_count_as_downloads" field.
+func (m *TicketMutation) ResetViewsCountAsDownloads() {
+	m.views_count_as_downloads = nil
+}
+
+// AddFileIDs adds the "files" edge to the File entity by ids.
+func (m *TicketMutation) AddFileIDs(ids ...uuid.UUID) {
+	if m.files == nil {
+		m.files = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.files[ids[i]] = struct{}{}
+	}
+}
+
+// ClearFiles clears the "files" edge to the File entity.
+func (m *TicketMutation) ClearFiles() {
+	m.clearedfiles = true
+}
+
+// FilesCleared reports if the "files" edge to the File entity was cleared.
+func (m *TicketMutation) FilesCleared() bool {
+	return m.clearedfiles
+}
+
+// RemoveFileIDs removes the "files" edge to the File entity by IDs.
+func (m *TicketMutation) RemoveFileIDs(ids ...uuid.UUID) {
+	if m.removedfiles == nil {
+		m.removedfiles = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.files, ids[i])
+		m.removedfiles[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedFiles returns the removed IDs of the "files" edge to the File entity.
+func (m *TicketMutation) RemovedFilesIDs() (ids []uuid.UUID) {
+	for id := range m.removedfiles {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// FilesIDs returns the "files" edge IDs in the mutation.
+func (m *TicketMutation) FilesIDs() (ids []uuid.UUID) {
+	for id := range m.files {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetFiles resets all changes to the "files" edge.
+func (m *TicketMutation) ResetFiles() {
+	m.files = nil
+	m.clearedfiles = false
+	m.removedfiles = nil
+}
+
+// SetOwnerID sets the "owner" edge to the User entity by id.
+func (m *TicketMutation) SetOwnerID(id uuid.UUID) {
+	m.owner = &id
+}
+
+// ClearOwner clears the "owner" edge to the User entity.
+func (m *TicketMutation) ClearOwner() {
+	m.clearedowner = true
+}
+
+// OwnerCleared reports if the "owner" edge to the User entity was cleared.
+func (m *TicketMutation) OwnerCleared() bool {
+	return m.clearedowner
+}
+
+// OwnerID returns the "owner" edge ID in the mutation.
+func (m *TicketMutation) OwnerID() (id uuid.UUID, exists bool) {
+	if m.owner != nil {
+		return *m.owner, true
+	}
+	return
+}
+
+// OwnerIDs returns the "owner" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// OwnerID instead. It exists only for internal usage by the builders.
+func (m *TicketMutation) OwnerIDs() (ids []uuid.UUID) {
+	if id := m.owner; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetOwner resets all changes to the "owner" edge.
+func (m *TicketMutation) ResetOwner() {
+	m.owner = nil
+	m.clearedowner = false
+}
+
+// AddShareaccesstokenIDs adds the "shareaccesstokens" edge to the ShareAccessToken entity by ids.
+func (m *TicketMutation) AddShareaccesstokenIDs(ids ...string) {
+	if m.shareaccesstokens == nil {
+		m.shareaccesstokens = make(map[string]struct{})
+	}
+	for i := range ids {
+		m.shareaccesstokens[ids[i]] = struct{}{}
+	}
+}
+
+// ClearShareaccesstokens clears the "shareaccesstokens" edge to the ShareAccessToken entity.
+func (m *TicketMutation) ClearShareaccesstokens() {
+	m.clearedshareaccesstokens = true
+}
+
+// ShareaccesstokensCleared reports if the "shareaccesstokens" edge to the ShareAccessToken entity was cleared.
+func (m *TicketMutation) ShareaccesstokensCleared() bool {
+	return m.clearedshareaccesstokens
+}
+
+// RemoveShareaccesstokenIDs removes the "shareaccesstokens" edge to the ShareAccessToken entity by IDs.
+func (m *TicketMutation) RemoveShareaccesstokenIDs(ids ...string) {
+	if m.removedshareaccesstokens == nil {
+		m.removedshareaccesstokens = make(map[string]struct{})
+	}
+	for i := range ids {
+		delete(m.shareaccesstokens, ids[i])
+		m.removedshareaccesstokens[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedShareaccesstokens returns the removed IDs of the "shareaccesstokens" edge to the ShareAccessToken entity.
+func (m *TicketMutation) RemovedShareaccesstokensIDs() (ids []string) {
+	for id := range m.removedshareaccesstokens {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ShareaccesstokensIDs returns the "shareaccesstokens" edge IDs in the mutation.
+func (m *TicketMutation) ShareaccesstokensIDs() (ids []string) {
+	for id := range m.shareaccesstokens {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetShareaccesstokens resets all changes to the "shareaccesstokens" edge.
+func (m *TicketMutation) ResetShareaccesstokens() {
+	m.shareaccesstokens = nil
+	m.clearedshareaccesstokens = false
+	m.removedshareaccesstokens = nil
+}
+
+// AddRecipientIDs adds the "recipients" edge to the Recipient entity by ids.
+func (m *TicketMutation) AddRecipientIDs(ids ...uuid.UUID) {
+	if m.recipients == nil {
+		m.recipients = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.recipients[ids[i]] = struct{}{}
+	}
+}
+
+// ClearRecipients clears the "recipients" edge to the Recipient entity.
+func (m *TicketMutation) ClearRecipients() {
+	m.clearedrecipients = true
+}
+
+// RecipientsCleared reports if the "recipients" edge to the Recipient entity was cleared.
+func (m *TicketMutation) RecipientsCleared() bool {
+	return m.clearedrecipients
+}
+
+// RemoveRecipientIDs removes the "recipients" edge to the Recipient entity by IDs.
+func (m *TicketMutation) RemoveRecipientIDs(ids ...uuid.UUID) {
+	if m.removedrecipients == nil {
+		m.removedrecipients = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.recipients, ids[i])
+		m.removedrecipients[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedRecipients returns the removed IDs of the "recipients" edge to the Recipient entity.
+func (m *TicketMutation) RemovedRecipientsIDs() (ids []uuid.UUID) {
+	for id := range m.removedrecipients {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// RecipientsIDs returns the "recipients" edge IDs in the mutation.
+func (m *TicketMutation) RecipientsIDs() (ids []uuid.UUID) {
+	for id := range m.recipients {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetRecipients resets all changes to the "recipients" edge.
+func (m *TicketMutation) ResetRecipients() {
+	m.recipients = nil
+	m.clearedrecipients = false
+	m.removedrecipients = nil
+}
+
+// AddAccessEventIDs adds the "access_events" edge to the AccessEvent entity by ids.
+func (m *TicketMutation) AddAccessEventIDs(ids ...int) {
+	if m.access_events == nil {
+		m.access_events = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.access_events[ids[i]] = struct{}{}
+	}
+}
+
+// ClearAccessEvents clears the "access_events" edge to the AccessEvent entity.
+func (m *TicketMutation) ClearAccessEvents() {
+	m.clearedaccess_events = true
+}
+
+// AccessEventsCleared reports if the "access_events" edge to the AccessEvent entity was cleared.
+func (m *TicketMutation) AccessEventsCleared() bool {
+	return m.clearedaccess_events
+}
+
+// RemoveAccessEventIDs removes the "access_events" edge to the AccessEvent entity by IDs.
+func (m *TicketMutation) RemoveAccessEventIDs(ids ...int) {
+	if m.removedaccess_events == nil {
+		m.removedaccess_events = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.access_events, ids[i])
+		m.removedaccess_events[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedAccessEvents returns the removed IDs of the "access_events" edge to the AccessEvent entity.
+func (m *TicketMutation) RemovedAccessEventsIDs() (ids []int) {
+	for id := range m.removedaccess_events {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// AccessEventsIDs returns the "access_events" edge IDs in the mutation.
+func (m *TicketMutation) AccessEventsIDs() (ids []int) {
+	for id := range m.access_events {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetAccessEvents resets all changes to the "access_events" edge.
+func (m *TicketMutation) ResetAccessEvents() {
+	m.access_events = nil
+	m.clearedaccess_events = false
+	m.removedaccess_events = nil
+}
+
+// Where appends a list predicates to the TicketMutation builder.
+func (m *TicketMutation) Where(ps ...predicate.Ticket) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the TicketMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *TicketMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Ticket, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *TicketMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *TicketMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Ticket).
+func (m *TicketMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *TicketMutation) Fields() []string {
+	fields := make([]string, 0, 11)
+	if m.comment != nil {
+		fields = append(fields, ticket.FieldComment)
+	}
+	if m.expiryType != nil {
+		fields = append(fields, ticket.FieldExpiryType)
+	}
+	if m.hashed_password != nil {
+		fields = append(fields, ticket.FieldHashedPassword)
+	}
+	if m.salt != nil {
+		fields = append(fields, ticket.FieldSalt)
+	}
+	if m.created_at != nil {
+		fields = append(fields, ticket.FieldCreatedAt)
+	}
+	if m.expiry_total_days != nil {
+		fields = append(fields, ticket.FieldExpiryTotalDays)
+	}
+	if m.expiry_days_since_last_download != nil {
+		fields = append(fields, ticket.FieldExpiryDaysSinceLastDownload)
+	}
+	if m.expiry_total_downloads != nil {
+		fields = append(fields, ticket.FieldExpiryTotalDownloads)
+	}
+	if m.email_on_download != nil {
+		fields = append(fields, ticket.FieldEmailOnDownload)
+	}
+	if m.creator_lang != nil {
+		fields = append(fields, ticket.FieldCreatorLang)
+	}
+	if m.views_count_as_downloads != nil {
+		fields = append(fields, ticket.FieldViewsCountAsDownloads)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *TicketMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case ticket.FieldComment:
+		return m.Comment()
+	case ticket.FieldExpiryType:
+		return m.ExpiryType()
+	case ticket.FieldHashedPassword:
+		return m.HashedPassword()
+	case ticket.FieldSalt:
+		return m.Salt()
+	case ticket.FieldCreatedAt:
+		return m.CreatedAt()
+	case ticket.FieldExpiryTotalDays:
+		return m.ExpiryTotalDays()
+	case ticket.FieldExpiryDaysSinceLastDownload:
+		return m.ExpiryDaysSinceLastDownload()
+	case ticket.FieldExpiryTotalDownloads:
+		return m.ExpiryTotalDownloads()
+	case ticket.FieldEmailOnDownload:
+		return m.EmailOnDownload()
+	case ticket.FieldCreatorLang:
+		return m.CreatorLang()
+	case ticket.FieldViewsCountAsDownloads:
+		return m.ViewsCountAsDownloads()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *TicketMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case ticket.FieldComment:
+		return m.OldComment(ctx)
+	case ticket.FieldExpiryType:
+		return m.OldExpiryType(ctx)
+	case ticket.FieldHashedPassword:
+		return m.OldHashedPassword(ctx)
+	case ticket.FieldSalt:
+		return m.OldSalt(ctx)
+	case ticket.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case ticket.FieldExpiryTotalDays:
+		return m.OldExpiryTotalDays(ctx)
+	case ticket.FieldExpiryDaysSinceLastDownload:
+		return m.OldExpiryDaysSinceLastDownload(ctx)
+	case ticket.FieldExpiryTotalDownloads:
+		return m.OldExpiryTotalDownloads(ctx)
+	case ticket.FieldEmailOnDownload:
+		return m.OldEmailOnDownload(ctx)
+	case ticket.FieldCreatorLang:
+		return m.OldCreatorLang(ctx)
+	case ticket.FieldViewsCountAsDownloads:
+		return m.OldViewsCountAsDownloads(ctx)
+	}
+	return nil, fmt.Errorf("unknown Ticket field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *TicketMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case ticket.FieldComment:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetComment(v)
+		return nil
+	case ticket.FieldExpiryType:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetExpiryType(v)
+		return nil
+	case ticket.FieldHashedPassword:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetHashedPassword(v)
+		return nil
+	case ticket.FieldSalt:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSalt(v)
+		return nil
+	case ticket.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case ticket.FieldExpiryTotalDays:
+		v, ok := value.(uint8)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetExpiryTotalDays(v)
+		return nil
+	case ticket.FieldExpiryDaysSinceLastDownload:
+		v, ok := value.(uint8)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetExpiryDaysSinceLastDownload(v)
+		return nil
+	case ticket.FieldExpiryTotalDownloads:
+		v, ok := value.(uint8)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetExpiryTotalDownloads(v)
+		return nil
+	case ticket.FieldEmailOnDownload:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEmailOnDownload(v)
+		return nil
+	case ticket.FieldCreatorLang:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatorLang(v)
+		return nil
+	case ticket.FieldViewsCountAsDownloads:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetViewsCountAsDownloads(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Ticket field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *TicketMutation) AddedFields() []string {
+	var fields []string
+	if m.addexpiry_total_days != nil {
+		fields = append(fields, ticket.FieldExpiryTotalDays)
+	}
+	if m.addexpiry_days_since_last_download != nil {
+		fields = append(fields, ticket.FieldExpiryDaysSinceLastDownload)
+	}
+	if m.addexpiry_total_downloads != nil {
+		fields = append(fields, ticket.FieldExpiryTotalDownloads)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *TicketMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case ticket.FieldExpiryTotalDays:
+		return m.AddedExpiryTotalDays()
+	case ticket.FieldExpiryDaysSinceLastDownload:
+		return m.AddedExpiryDaysSinceLastDownload()
+	case ticket.FieldExpiryTotalDownloads:
+		return m.AddedExpiryTotalDownloads()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *TicketMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case ticket.FieldExpiryTotalDays:
+		v, ok := value.(int8)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddExpiryTotalDays(v)
+		return nil
+	case ticket.FieldExpiryDaysSinceLastDownload:
+		v, ok := value.(int8)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddExpiryDaysSinceLastDownload(v)
+		return nil
+	case ticket.FieldExpiryTotalDownloads:
+		v, ok := value.(int8)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddExpiryTotalDownloads(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Ticket numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *TicketMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(ticket.FieldComment) {
+		fields = append(fields, ticket.FieldComment)
+	}
+	if m.FieldCleared(ticket.FieldEmailOnDownload) {
+		fields = append(fields, ticket.FieldEmailOnDownload)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *TicketMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *TicketMutation) ClearField(name string) error {
+	switch name {
+	case ticket.FieldComment:
+		m.ClearComment()
+		return nil
+	case ticket.FieldEmailOnDownload:
+		m.ClearEmailOnDownload()
+		return nil
+	}
+	return fmt.Errorf("unknown Ticket nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *TicketMutation) ResetField(name string) error {
+	switch name {
+	case ticket.FieldComment:
+		m.ResetComment()
+		return nil
+	case ticket.FieldExpiryType:
+		m.ResetExpiryType()
+		return nil
+	case ticket.FieldHashedPassword:
+		m.ResetHashedPassword()
+		return nil
+	case ticket.FieldSalt:
+		m.ResetSalt()
+		return nil
+	case ticket.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case ticket.FieldExpiryTotalDays:
+		m.ResetExpiryTotalDays()
+		return nil
+	case ticket.FieldExpiryDaysSinceLastDownload:
+		m.ResetExpiryDaysSinceLastDownload()
+		return nil
+	case ticket.FieldExpiryTotalDownloads:
+		m.ResetExpiryTotalDownloads()
+		return nil
+	case ticket.FieldEmailOnDownload:
+		m.ResetEmailOnDownload()
+		return nil
+	case ticket.FieldCreatorLang:
+		m.ResetCreatorLang()
+		return nil
+	case ticket.FieldViewsCountAsDownloads:
+		m.ResetViewsCountAsDownloads()
+		return nil
+	}
+	return fmt.Errorf("unknown Ticket field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *TicketMutation) AddedEdges() []string {
+	edges := make([]string, 0, 5)
+	if m.files != nil {
+		edges = append(edges, ticket.EdgeFiles)
+	}
+	if m.owner != nil {
+		edges = append(edges, ticket.EdgeOwner)
+	}
+	if m.shareaccesstokens != nil {
+		edges = append(edges, ticket.EdgeShareaccesstokens)
+	}
+	if m.recipients != nil {
+		edges = append(edges, ticket.EdgeRecipients)
+	}
+	if m.access_events != nil {
+		edges = append(edges, ticket.EdgeAccessEvents)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *TicketMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case ticket.EdgeFiles:
+		ids := make([]ent.Value, 0, len(m.files))
+		for id := range m.files {
+			ids = append(ids, id)
+		}
+		return ids
+	case ticket.EdgeOwner:
+		if id := m.owner; id != nil {
+			return []ent.Value{*id}
+		}
+	case ticket.EdgeShareaccesstokens:
+		ids := make([]ent.Value, 0, len(m.shareaccesstokens))
+		for id := range m.shareaccesstokens {
+			ids = append(ids, id)
+		}
+		return ids
+	case ticket.EdgeRecipients:
+		ids := make([]ent.Value, 0, len(m.recipients))
+		for id := range m.recipients {
+			ids = append(ids, id)
+		}
+		return ids
+	case ticket.EdgeAccessEvents:
+		ids := make([]ent.Value, 0, len(m.access_events))
+		for id := range m.access_events {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *TicketMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 5)
+	if m.removedfiles != nil {
+		edges = append(edges, ticket.EdgeFiles)
+	}
+	if m.removedshareaccesstokens != nil {
+		edges = append(edges, ticket.EdgeShareaccesstokens)
+	}
+	if m.removedrecipients != nil {
+		edges = append(edges, ticket.EdgeRecipients)
+	}
+	if m.removedaccess_events != nil {
+		edges = append(edges, ticket.EdgeAccessEvents)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *TicketMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case ticket.EdgeFiles:
+		ids := make([]ent.Value, 0, len(m.removedfiles))
+		for id := range m.removedfiles {
+			ids = append(ids, id)
+		}
+		return ids
+	case ticket.EdgeShareaccesstokens:
+		ids := make([]ent.Value, 0, len(m.removedshareaccesstokens))
+		for id := range m.removedshareaccesstokens {
+			ids = append(ids, id)
+		}
+		return ids
+	case ticket.EdgeRecipients:
+		ids := make([]ent.Value, 0, len(m.removedrecipients))
+		for id := range m.removedrecipients {
+			ids = append(ids, id)
+		}
+		return ids
+	case ticket.EdgeAccessEvents:
+		ids := make([]ent.Value, 0, len(m.removedaccess_events))
+		for id := range m.removedaccess_events {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *TicketMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 5)
+	if m.clearedfiles {
+		edges = append(edges, ticket.EdgeFiles)
+	}
+	if m.clearedowner {
+		edges = append(edges, ticket.EdgeOwner)
+	}
+	if m.clearedshareaccesstokens {
+		edges = append(edges, ticket.EdgeShareaccesstokens)
+	}
+	if m.clearedrecipients {
+		edges = append(edges, ticket.EdgeRecipients)
+	}
+	if m.clearedaccess_events {
+		edges = append(edges, ticket.EdgeAccessEvents)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *TicketMutation) EdgeCleared(name string) bool {
+	switch name {
+	case ticket.EdgeFiles:
+		return m.clearedfiles
+	case ticket.EdgeOwner:
+		return m.clearedowner
+	case ticket.EdgeShareaccesstokens:
+		return m.clearedshareaccesstokens
+	case ticket.EdgeRecipients:
+		return m.clearedrecipients
+	case ticket.EdgeAccessEvents:
+		return m.clearedaccess_events
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *TicketMutation) ClearEdge(name string) error {
+	switch name {
+	case ticket.EdgeOwner:
+		m.ClearOwner()
+		return nil
+	}
+	return fmt.Errorf("unknown Ticket unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *TicketMutation) ResetEdge(name string) error {
+	switch name {
+	case ticket.EdgeFiles:
+		m.ResetFiles()
+		return nil
+	case ticket.EdgeOwner:
+		m.ResetOwner()
+		return nil
+	case ticket.EdgeShareaccesstokens:
+		m.ResetShareaccesstokens()
+		return nil
+	case ticket.EdgeRecipients:
+		m.ResetRecipients()
+		return nil
+	case ticket.EdgeAccessEvents:
+		m.ResetAccessEvents()
+		return nil
+	}
+	return fmt.Errorf("unknown Ticket edge %s", name)
+}
+
+// UploadMutation represents an operation that mutates the Upload nodes in the graph.
+type UploadMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *uuid.UUID
+	name          *string
+	size          *int64
+	addsize       *int64
+	_offset       *int64
+	add_offset    *int64
+	created_at    *time.Time
+	expiry        *time.Time
+	clearedFields map[string]struct{}
+	owner         *uuid.UUID
+	clearedowner  bool
+	grant         *uuid.UUID
+	clearedgrant  bool
+	done          bool
+	oldValue      func(context.Context) (*Upload, error)
+	predicates    []predicate.Upload
+}
+
+var _ ent.Mutation = (*UploadMutation)(nil)
+
+// uploadOption allows management of the mutation configuration using functional options.
+type uploadOption func(*UploadMutation)
+
+// newUploadMutation creates new mutation for the Upload entity.
+func newUploadMutation(c config, op Op, opts ...uploadOption) *UploadMutation {
+	m := &UploadMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeUpload,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withUploadID sets the ID field of the mutation.
+func withUploadID(id uuid.UUID) uploadOption {
+	return func(m *UploadMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Upload
+		)
+		m.oldValue = func(ctx context.Context) (*Upload, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Upload.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
 }
 
-// ResetCreatedAt resets all changes to the "created_at" field.
-func (m *TicketMutation) ResetCreatedAt() {
-	m.created_at = nil
-}
-
-// SetExpiryTotalDays sets the "expiry_total_days" field.
-func (m *TicketMutation) SetExpiryTotalDays(u uint8) {
-	m.expiry_total_days = &u
-	m.addexpiry_total_days = nil
+// withUpload sets the old Upload of the mutation.
+func withUpload(node *Upload) uploadOption {
+	return func(m *UploadMutation) {
+		m.oldValue = func(context.Context) (*Upload, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
 }
 
-// ExpiryTotalDays returns the value of the "expiry_total_days" field in the mutation.
-func (m *TicketMutation) ExpiryTotalDays() (r uint8, exists bool) {
-	v := m.expiry_total_days
-	if v == nil {
-		return
-	}
-	return *v, true
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m UploadMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
 }
 
-// OldExpiryTotalDays returns the old "expiry_total_days" field's value of the Ticket entity.
-// If the Ticket object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TicketMutation) OldExpiryTotalDays(ctx context.Context) (v uint8, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldExpiryTotalDays is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldExpiryTotalDays requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldExpiryTotalDays: %w", err)
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m UploadMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
-	return oldValue.ExpiryTotalDays, nil
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
 }
 
-// AddExpiryTotalDays adds u to the "expiry_total_days" field.
-func (m *TicketMutation) AddExpiryTotalDays(u int8) {
-	if m.addexpiry_total_days != nil {
-		*m.addexpiry_total_days += u
-	} else {
-		m.addexpiry_total_days = &u
-	}
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Upload entities.
+func (m *UploadMutation) SetID(id uuid.UUID) {
+	m.id = &id
 }
 
-// AddedExpiryTotalDays returns the value that was added to the "expiry_total_days" field in this mutation.
-func (m *TicketMutation) AddedExpiryTotalDays() (r int8, exists bool) {
-	v := m.addexpiry_total_days
-	if v == nil {
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *UploadMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
 		return
 	}
-	return *v, true
+	return *m.id, true
 }
 
-// ResetExpiryTotalDays resets all changes to the "expiry_total_days" field.
-func (m *TicketMutation) ResetExpiryTotalDays() {
-	m.expiry_total_days = nil
-	m.addexpiry_total_days = nil
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *UploadMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Upload.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
 }
 
-// SetExpiryDaysSinceLastDownload sets the "expiry_days_since_last_download" field.
-func (m *TicketMutation) SetExpiryDaysSinceLastDownload(u uint8) {
-	m.expiry_days_since_last_download = &u
-	m.addexpiry_days_since_last_download = nil
+// SetName sets the "name" field.
+func (m *UploadMutation) SetName(s string) {
+	m.name = &s
 }
 
-// ExpiryDaysSinceLastDownload returns the value of the "expiry_days_since_last_download" field in the mutation.
-func (m *TicketMutation) ExpiryDaysSinceLastDownload() (r uint8, exists bool) {
-	v := m.expiry_days_since_last_download
+// Name returns the value of the "name" field in the mutation.
+func (m *UploadMutation) Name() (r string, exists bool) {
+	v := m.name
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldExpiryDaysSinceLastDownload returns the old "expiry_days_since_last_download" field's value of the Ticket entity.
-// If the Ticket object wasn't provided to the builder, the object is fetched from the database.
+// OldName returns the old "name" field's value of the Upload entity.
+// If the Upload object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TicketMutation) OldExpiryDaysSinceLastDownload(ctx context.Context) (v uint8, err error) {
+func (m *UploadMutation) OldName(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldExpiryDaysSinceLastDownload is only allowed on UpdateOne operations")
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldExpiryDaysSinceLastDownload requires an ID field in the mutation")
+		return v, errors.New("OldName requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldExpiryDaysSinceLastDownload: %w", err)
-	}
-	return oldValue.ExpiryDaysSinceLastDownload, nil
-}
-
-// AddExpiryDaysSinceLastDownload adds u to the "expiry_days_since_last_download" field.
-func (m *TicketMutation) AddExpiryDaysSinceLastDownload(u int8) {
-	if m.addexpiry_days_since_last_download != nil {
-		*m.addexpiry_days_since_last_download += u
-	} else {
-		m.addexpiry_days_since_last_download = &u
-	}
-}
-
-// AddedExpiryDaysSinceLastDownload returns the value that was added to the "expiry_days_since_last_download" field in this mutation.
-func (m *TicketMutation) AddedExpiryDaysSinceLastDownload() (r int8, exists bool) {
-	v := m.addexpiry_days_since_last_download
-	if v == nil {
-		return
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
 	}
-	return *v, true
+	return oldValue.Name, nil
 }
 
-// ResetExpiryDaysSinceLastDownload resets all changes to the "expiry_days_since_last_download" field.
-func (m *TicketMutation) ResetExpiryDaysSinceLastDownload() {
-	m.expiry_days_since_last_download = nil
-	m.addexpiry_days_since_last_download = nil
+// ResetName resets all changes to the "name" field.
+func (m *UploadMutation) ResetName() {
+	m.name = nil
 }
 
-// SetExpiryTotalDownloads sets the "expiry_total_downloads" field.
-func (m *TicketMutation) SetExpiryTotalDownloads(u uint8) {
-	m.expiry_total_downloads = &u
-	m.addexpiry_total_downloads = nil
+// SetSize sets the "size" field.
+func (m *UploadMutation) SetSize(i int64) {
+	m.size = &i
+	m.addsize = nil
 }
 
-// ExpiryTotalDownloads returns the value of the "expiry_total_downloads" field in the mutation.
-func (m *TicketMutation) ExpiryTotalDownloads() (r uint8, exists bool) {
-	v := m.expiry_total_downloads
+// Size returns the value of the "size" field in the mutation.
+func (m *UploadMutation) Size() (r int64, exists bool) {
+	v := m.size
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldExpiryTotalDownloads returns the old "expiry_total_downloads" field's value of the Ticket entity.
-// If the Ticket object wasn't provided to the builder, the object is fetched from the database.
+// OldSize returns the old "size" field's value of the Upload entity.
+// If the Upload object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TicketMutation) OldExpiryTotalDownloads(ctx context.Context) (v uint8, err error) {
+func (m *UploadMutation) OldSize(ctx context.Context) (v int64, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldExpiryTotalDownloads is only allowed on UpdateOne operations")
+		return v, errors.New("OldSize is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldExpiryTotalDownloads requires an ID field in the mutation")
+		return v, errors.New("OldSize requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldExpiryTotalDownloads: %w", err)
+		return v, fmt.Errorf("querying old value for OldSize: %w", err)
 	}
-	return oldValue.ExpiryTotalDownloads, nil
+	return oldValue.Size, nil
 }
 
-// AddExpiryTotalDownloads adds u to the "expiry_total_downloads" field.
-func (m *TicketMutation) AddExpiryTotalDownloads(u int8) {
-	if m.addexpiry_total_downloads != nil {
-		*m.addexpiry_total_downloads += u
+// AddSize adds i to the "size" field.
+func (m *UploadMutation) AddSize(i int64) {
+	if m.addsize != nil {
+		*m.addsize += i
 	} else {
-		m.addexpiry_total_downloads = &u
+		m.addsize = &i
 	}
 }
 
-// AddedExpiryTotalDownloads returns the value that was added to the "expiry_total_downloads" field in this mutation.
-func (m *TicketMutation) AddedExpiryTotalDownloads() (r int8, exists bool) {
-	v := m.addexpiry_total_downloads
+// AddedSize returns the value that was added to the "size" field in this mutation.
+func (m *UploadMutation) AddedSize() (r int64, exists bool) {
+	v := m.addsize
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ResetExpiryTotalDownloads resets all changes to the "expiry_total_downloads" field.
-func (m *TicketMutation) ResetExpiryTotalDownloads() {
-	m.expiry_total_downloads = nil
-	m.addexpiry_total_downloads = nil
+// ResetSize resets all changes to the "size" field.
+func (m *UploadMutation) ResetSize() {
+	m.size = nil
+	m.addsize = nil
 }
 
-// SetEmailOnDownload sets the "email_on_download" field.
-func (m *TicketMutation) SetEmailOnDownload(s []string) {
-	m.email_on_download = &s
-	m.appendemail_on_download = nil
+// SetOffset sets the "offset" field.
+func (m *UploadMutation) SetOffset(i int64) {
+	m._offset = &i
+	m.add_offset = nil
 }
 
-// EmailOnDownload returns the value of the "email_on_download" field in the mutation.
-func (m *TicketMutation) EmailOnDownload() (r []string, exists bool) {
-	v := m.email_on_download
+// Offset returns the value of the "offset" field in the mutation.
+func (m *UploadMutation) Offset() (r int64, exists bool) {
+	v := m._offset
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldEmailOnDownload returns the old "email_on_download" field's value of the Ticket entity.
-// If the Ticket object wasn't provided to the builder, the object is fetched from the database.
+// OldOffset returns the old "offset" field's value of the Upload entity.
+// If the Upload object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TicketMutation) OldEmailOnDownload(ctx context.Context) (v []string, err error) {
+func (m *UploadMutation) OldOffset(ctx context.Context) (v int64, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldEmailOnDownload is only allowed on UpdateOne operations")
+		return v, errors.New("OldOffset is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldEmailOnDownload requires an ID field in the mutation")
+		return v, errors.New("OldOffset requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldEmailOnDownload: %w", err)
+		return v, fmt.Errorf("querying old value for OldOffset: %w", err)
 	}
-	return oldValue.EmailOnDownload, nil
-}
-
-// AppendEmailOnDownload adds s to the "email_on_download" field.
-func (m *TicketMutation) AppendEmailOnDownload(s []string) {
-	m.appendemail_on_download = append(m.appendemail_on_download, s...)
+	return oldValue.Offset, nil
 }
 
-// AppendedEmailOnDownload returns the list of values that were appended to the "email_on_download" field in this mutation.
-func (m *TicketMutation) AppendedEmailOnDownload() ([]string, bool) {
-	if len(m.appendemail_on_download) == 0 {
-		return nil, false
+// AddOffset adds i to the "offset" field.
+func (m *UploadMutation) AddOffset(i int64) {
+	if m.add_offset != nil {
+		*m.add_offset += i
+	} else {
+		m.add_offset = &i
 	}
-	return m.appendemail_on_download, true
-}
-
-// ClearEmailOnDownload clears the value of the "email_on_download" field.
-func (m *TicketMutation) ClearEmailOnDownload() {
-	m.email_on_download = nil
-	m.appendemail_on_download = nil
-	m.clearedFields[ticket.FieldEmailOnDownload] = struct{}{}
 }
 
-// EmailOnDownloadCleared returns if the "email_on_download" field was cleared in this mutation.
-func (m *TicketMutation) EmailOnDownloadCleared() bool {
-	_, ok := m.clearedFields[ticket.FieldEmailOnDownload]
-	return ok
+// AddedOffset returns the value that was added to the "offset" field in this mutation.
+func (m *UploadMutation) AddedOffset() (r int64, exists bool) {
+	v := m.add_offset
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// ResetEmailOnDownload resets all changes to the "email_on_download" field.
-func (m *TicketMutation) ResetEmailOnDownload() {
-	m.email_on_download = nil
-	m.appendemail_on_download = nil
-	delete(m.clearedFields, ticket.FieldEmailOnDownload)
+// ResetOffset resets all changes to the "offset" field.
+func (m *UploadMutation) ResetOffset() {
+	m._offset = nil
+	m.add_offset = nil
 }
 
-// SetCreatorLang sets the "creator_lang" field.
-func (m *TicketMutation) SetCreatorLang(s string) {
-	m.creator_lang = &s
+// SetCreatedAt sets the "created_at" field.
+func (m *UploadMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
 }
 
-// CreatorLang returns the value of the "creator_lang" field in the mutation.
-func (m *TicketMutation) CreatorLang() (r string, exists bool) {
-	v := m.creator_lang
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *UploadMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCreatorLang returns the old "creator_lang" field's value of the Ticket entity.
-// If the Ticket object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the Upload entity.
+// If the Upload object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TicketMutation) OldCreatorLang(ctx context.Context) (v string, err error) {
+func (m *UploadMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreatorLang is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreatorLang requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreatorLang: %w", err)
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
-	return oldValue.CreatorLang, nil
-}
-
-// ResetCreatorLang resets all changes to the "creator_lang" field.
-func (m *TicketMutation) ResetCreatorLang() {
-	m.creator_lang = nil
-}
-
-// AddFileIDs adds the "files" edge to the File entity by ids.
-func (m *TicketMutation) AddFileIDs(ids ...uuid.UUID) {
-	if m.files == nil {
-		m.files = make(map[uuid.UUID]struct{})
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
 	}
-	for i := range ids {
-		m.files[ids[i]] = struct{}{}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
 	}
+	return oldValue.CreatedAt, nil
 }
 
-// ClearFiles clears the "files" edge to the File entity.
-func (m *TicketMutation) ClearFiles() {
-	m.clearedfiles = true
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *UploadMutation) ResetCreatedAt() {
+	m.created_at = nil
 }
 
-// FilesCleared reports if the "files" edge to the File entity was cleared.
-func (m *TicketMutation) FilesCleared() bool {
-	return m.clearedfiles
+// SetExpiry sets the "expiry" field.
+func (m *UploadMutation) SetExpiry(t time.Time) {
+	m.expiry = &t
 }
 
-// RemoveFileIDs removes the "files" edge to the File entity by IDs.
-func (m *TicketMutation) RemoveFileIDs(ids ...uuid.UUID) {
-	if m.removedfiles == nil {
-		m.removedfiles = make(map[uuid.UUID]struct{})
-	}
-	for i := range ids {
-		delete(m.files, ids[i])
-		m.removedfiles[ids[i]] = struct{}{}
+// Expiry returns the value of the "expiry" field in the mutation.
+func (m *UploadMutation) Expiry() (r time.Time, exists bool) {
+	v := m.expiry
+	if v == nil {
+		return
 	}
+	return *v, true
 }
 
-// RemovedFiles returns the removed IDs of the "files" edge to the File entity.
-func (m *TicketMutation) RemovedFilesIDs() (ids []uuid.UUID) {
-	for id := range m.removedfiles {
-		ids = append(ids, id)
+// OldExpiry returns the old "expiry" field's value of the Upload entity.
+// If the Upload object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UploadMutation) OldExpiry(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldExpiry is only allowed on UpdateOne operations")
 	}
-	return
-}
-
-// FilesIDs returns the "files" edge IDs in the mutation.
-func (m *TicketMutation) FilesIDs() (ids []uuid.UUID) {
-	for id := range m.files {
-		ids = append(ids, id)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldExpiry requires an ID field in the mutation")
 	}
-	return
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldExpiry: %w", err)
+	}
+	return oldValue.Expiry, nil
 }
 
-// ResetFiles resets all changes to the "files" edge.
-func (m *TicketMutation) ResetFiles() {
-	m.files = nil
-	m.clearedfiles = false
-	m.removedfiles = nil
+// ResetExpiry resets all changes to the "expiry" field.
+func (m *UploadMutation) ResetExpiry() {
+	m.expiry = nil
 }
 
 // SetOwnerID sets the "owner" edge to the User entity by id.
-func (m *TicketMutation) SetOwnerID(id uuid.UUID) {
+func (m *UploadMutation) SetOwnerID(id uuid.UUID) {
 	m.owner = &id
 }
 
 // ClearOwner clears the "owner" edge to the User entity.
-func (m *TicketMutation) ClearOwner() {
+func (m *UploadMutation) ClearOwner() {
 	m.clearedowner = true
 }
 
 // OwnerCleared reports if the "owner" edge to the User entity was cleared.
-func (m *TicketMutation) OwnerCleared() bool {
+func (m *UploadMutation) OwnerCleared() bool {
 	return m.clearedowner
 }
 
 // OwnerID returns the "owner" edge ID in the mutation.
-func (m *TicketMutation) OwnerID() (id uuid.UUID, exists bool) {
+func (m *UploadMutation) OwnerID() (id uuid.UUID, exists bool) {
 	if m.owner != nil {
 		return *m.owner, true
 	}
@@ -4939,7 +10705,7 @@ func (m *TicketMutation) OwnerID() (id uuid.UUID, exists bool) {
 // OwnerIDs returns the "owner" edge IDs in the mutation.
 // Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
 // OwnerID instead. It exists only for internal usage by the builders.
-func (m *TicketMutation) OwnerIDs() (ids []uuid.UUID) {
+func (m *UploadMutation) OwnerIDs() (ids []uuid.UUID) {
 	if id := m.owner; id != nil {
 		ids = append(ids, *id)
 	}
@@ -4947,74 +10713,59 @@ func (m *TicketMutation) OwnerIDs() (ids []uuid.UUID) {
 }
 
 // ResetOwner resets all changes to the "owner" edge.
-func (m *TicketMutation) ResetOwner() {
+func (m *UploadMutation) ResetOwner() {
 	m.owner = nil
 	m.clearedowner = false
 }
 
-// AddShareaccesstokenIDs adds the "shareaccesstokens" edge to the ShareAccessToken entity by ids.
-func (m *TicketMutation) AddShareaccesstokenIDs(ids ...string) {
-	if m.shareaccesstokens == nil {
-		m.shareaccesstokens = make(map[string]struct{})
-	}
-	for i := range ids {
-		m.shareaccesstokens[ids[i]] = struct{}{}
-	}
-}
-
-// ClearShareaccesstokens clears the "shareaccesstokens" edge to the ShareAccessToken entity.
-func (m *TicketMutation) ClearShareaccesstokens() {
-	m.clearedshareaccesstokens = true
+// SetGrantID sets the "grant" edge to the Grant entity by id.
+func (m *UploadMutation) SetGrantID(id uuid.UUID) {
+	m.grant = &id
 }
 
-// ShareaccesstokensCleared reports if the "shareaccesstokens" edge to the ShareAccessToken entity was cleared.
-func (m *TicketMutation) ShareaccesstokensCleared() bool {
-	return m.clearedshareaccesstokens
+// ClearGrant clears the "grant" edge to the Grant entity.
+func (m *UploadMutation) ClearGrant() {
+	m.clearedgrant = true
 }
 
-// RemoveShareaccesstokenIDs removes the "shareaccesstokens" edge to the ShareAccessToken entity by IDs.
-func (m *TicketMutation) RemoveShareaccesstokenIDs(ids ...string) {
-	if m.removedshareaccesstokens == nil {
-		m.removedshareaccesstokens = make(map[string]struct{})
-	}
-	for i := range ids {
-		delete(m.shareaccesstokens, ids[i])
-		m.removedshareaccesstokens[ids[i]] = struct{}{}
-	}
+// GrantCleared reports if the "grant" edge to the Grant entity was cleared.
+func (m *UploadMutation) GrantCleared() bool {
+	return m.clearedgrant
 }
 
-// RemovedShareaccesstokens returns the removed IDs of the "shareaccesstokens" edge to the ShareAccessToken entity.
-func (m *TicketMutation) RemovedShareaccesstokensIDs() (ids []string) {
-	for id := range m.removedshareaccesstokens {
-		ids = append(ids, id)
+// GrantID returns the "grant" edge ID in the mutation.
+func (m *UploadMutation) GrantID() (id uuid.UUID, exists bool) {
+	if m.grant != nil {
+		return *m.grant, true
 	}
 	return
 }
 
-// ShareaccesstokensIDs returns the "shareaccesstokens" edge IDs in the mutation.
-func (m *TicketMutation) ShareaccesstokensIDs() (ids []string) {
-	for id := range m.shareaccesstokens {
-		ids = append(ids, id)
+// GrantIDs returns the "grant" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// GrantID instead. It exists only for internal usage by the builders.
+func (m *UploadMutation) GrantIDs() (ids []uuid.UUID) {
+	if id := m.grant; id != nil {
+		ids = append(ids, *id)
 	}
 	return
 }
 
-// ResetShareaccesstokens resets all changes to the "shareaccesstokens" edge.
-func (m *TicketMutation) ResetShareaccesstokens() {
-	m.shareaccesstokens = nil
-	m.clearedshareaccesstokens = false
-	m.removedshareaccesstokens = nil
+// ResetGrant resets all changes to the "grant" edge.
+func (m *UploadMutation) ResetGrant() {
+	m.grant = nil
+	m.clearedgrant = false
 }
 
-// Where appends a list predicates to the TicketMutation builder.
-func (m *TicketMutation) Where(ps ...predicate.Ticket) {
+// Where appends a list predicates to the UploadMutation builder.
+func (m *UploadMutation) Where(ps ...predicate.Upload) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the TicketMutation builder. Using this method,
+// WhereP appends storage-level predicates to the UploadMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *TicketMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Ticket, len(ps))
+func (m *UploadMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Upload, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -5022,54 +10773,39 @@ func (m *TicketMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *TicketMutation) Op() Op {
+func (m *UploadMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *TicketMutation) SetOp(op Op) {
+func (m *UploadMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (Ticket).
-func (m *TicketMutation) Type() string {
+// Type returns the node type of this mutation (Upload).
+func (m *UploadMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *TicketMutation) Fields() []string {
-	fields := make([]string, 0, 10)
-	if m.comment != nil {
-		fields = append(fields, ticket.FieldComment)
-	}
-	if m.expiryType != nil {
-		fields = append(fields, ticket.FieldExpiryType)
+func (m *UploadMutation) Fields() []string {
+	fields := make([]string, 0, 5)
+	if m.name != nil {
+		fields = append(fields, upload.FieldName)
 	}
-	if m.hashed_password != nil {
-		fields = append(fields, ticket.FieldHashedPassword)
+	if m.size != nil {
+		fields = append(fields, upload.FieldSize)
 	}
-	if m.salt != nil {
-		fields = append(fields, ticket.FieldSalt)
+	if m._offset != nil {
+		fields = append(fields, upload.FieldOffset)
 	}
 	if m.created_at != nil {
-		fields = append(fields, ticket.FieldCreatedAt)
+		fields = append(fields, upload.FieldCreatedAt)
 	}
-	if m.expiry_total_days != nil {
-		fields = append(fields, ticket.FieldExpiryTotalDays)
-	}
-	if m.expiry_days_since_last_download != nil {
-		fields = append(fields, ticket.FieldExpiryDaysSinceLastDownload)
-	}
-	if m.expiry_total_downloads != nil {
-		fields = append(fields, ticket.FieldExpiryTotalDownloads)
-	}
-	if m.email_on_download != nil {
-		fields = append(fields, ticket.FieldEmailOnDownload)
-	}
-	if m.creator_lang != nil {
-		fields = append(fields, ticket.FieldCreatorLang)
+	if m.expiry != nil {
+		fields = append(fields, upload.FieldExpiry)
 	}
 	return fields
 }
@@ -5077,28 +10813,18 @@ func (m *TicketMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *TicketMutation) Field(name string) (ent.Value, bool) {
+func (m *UploadMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case ticket.FieldComment:
-		return m.Comment()
-	case ticket.FieldExpiryType:
-		return m.ExpiryType()
-	case ticket.FieldHashedPassword:
-		return m.HashedPassword()
-	case ticket.FieldSalt:
-		return m.Salt()
-	case ticket.FieldCreatedAt:
+	case upload.FieldName:
+		return m.Name()
+	case upload.FieldSize:
+		return m.Size()
+	case upload.FieldOffset:
+		return m.Offset()
+	case upload.FieldCreatedAt:
 		return m.CreatedAt()
-	case ticket.FieldExpiryTotalDays:
-		return m.ExpiryTotalDays()
-	case ticket.FieldExpiryDaysSinceLastDownload:
-		return m.ExpiryDaysSinceLastDownload()
-	case ticket.FieldExpiryTotalDownloads:
-		return m.ExpiryTotalDownloads()
-	case ticket.FieldEmailOnDownload:
-		return m.EmailOnDownload()
-	case ticket.FieldCreatorLang:
-		return m.CreatorLang()
+	case upload.FieldExpiry:
+		return m.Expiry()
 	}
 	return nil, false
 }
@@ -5106,123 +10832,75 @@ func (m *TicketMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *TicketMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *UploadMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case ticket.FieldComment:
-		return m.OldComment(ctx)
-	case ticket.FieldExpiryType:
-		return m.OldExpiryType(ctx)
-	case ticket.FieldHashedPassword:
-		return m.OldHashedPassword(ctx)
-	case ticket.FieldSalt:
-		return m.OldSalt(ctx)
-	case ticket.FieldCreatedAt:
+	case upload.FieldName:
+		return m.OldName(ctx)
+	case upload.FieldSize:
+		return m.OldSize(ctx)
+	case upload.FieldOffset:
+		return m.OldOffset(ctx)
+	case upload.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
-	case ticket.FieldExpiryTotalDays:
-		return m.OldExpiryTotalDays(ctx)
-	case ticket.FieldExpiryDaysSinceLastDownload:
-		return m.OldExpiryDaysSinceLastDownload(ctx)
-	case ticket.FieldExpiryTotalDownloads:
-		return m.OldExpiryTotalDownloads(ctx)
-	case ticket.FieldEmailOnDownload:
-		return m.OldEmailOnDownload(ctx)
-	case ticket.FieldCreatorLang:
-		return m.OldCreatorLang(ctx)
+	case upload.FieldExpiry:
+		return m.OldExpiry(ctx)
 	}
-	return nil, fmt.Errorf("unknown Ticket field %s", name)
+	return nil, fmt.Errorf("unknown Upload field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *TicketMutation) SetField(name string, value ent.Value) error {
+func (m *UploadMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case ticket.FieldComment:
+	case upload.FieldName:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetComment(v)
-		return nil
-	case ticket.FieldExpiryType:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetExpiryType(v)
+		m.SetName(v)
 		return nil
-	case ticket.FieldHashedPassword:
-		v, ok := value.(string)
+	case upload.FieldSize:
+		v, ok := value.(int64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetHashedPassword(v)
+		m.SetSize(v)
 		return nil
-	case ticket.FieldSalt:
-		v, ok := value.(string)
+	case upload.FieldOffset:
+		v, ok := value.(int64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetSalt(v)
+		m.SetOffset(v)
 		return nil
-	case ticket.FieldCreatedAt:
+	case upload.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetCreatedAt(v)
-		return nil
-	case ticket.FieldExpiryTotalDays:
-		v, ok := value.(uint8)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetExpiryTotalDays(v)
-		return nil
-	case ticket.FieldExpiryDaysSinceLastDownload:
-		v, ok := value.(uint8)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetExpiryDaysSinceLastDownload(v)
-		return nil
-	case ticket.FieldExpiryTotalDownloads:
-		v, ok := value.(uint8)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetExpiryTotalDownloads(v)
-		return nil
-	case ticket.FieldEmailOnDownload:
-		v, ok := value.([]string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetEmailOnDownload(v)
+		m.SetCreatedAt(v)
 		return nil
-	case ticket.FieldCreatorLang:
-		v, ok := value.(string)
+	case upload.FieldExpiry:
+		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetCreatorLang(v)
+		m.SetExpiry(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Ticket field %s", name)
+	return fmt.Errorf("unknown Upload field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *TicketMutation) AddedFields() []string {
+func (m *UploadMutation) AddedFields() []string {
 	var fields []string
-	if m.addexpiry_total_days != nil {
-		fields = append(fields, ticket.FieldExpiryTotalDays)
-	}
-	if m.addexpiry_days_since_last_download != nil {
-		fields = append(fields, ticket.FieldExpiryDaysSinceLastDownload)
+	if m.addsize != nil {
+		fields = append(fields, upload.FieldSize)
 	}
-	if m.addexpiry_total_downloads != nil {
-		fields = append(fields, ticket.FieldExpiryTotalDownloads)
+	if m.add_offset != nil {
+		fields = append(fields, upload.FieldOffset)
 	}
 	return fields
 }
@@ -5230,14 +10908,12 @@ func (m *TicketMutation) AddedFields() []string {
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *TicketMutation) AddedField(name string) (ent.Value, bool) {
+func (m *UploadMutation) AddedField(name string) (ent.Value, bool) {
 	switch name {
-	case ticket.FieldExpiryTotalDays:
-		return m.AddedExpiryTotalDays()
-	case ticket.FieldExpiryDaysSinceLastDownload:
-		return m.AddedExpiryDaysSinceLastDownload()
-	case ticket.FieldExpiryTotalDownloads:
-		return m.AddedExpiryTotalDownloads()
+	case upload.FieldSize:
+		return m.AddedSize()
+	case upload.FieldOffset:
+		return m.AddedOffset()
 	}
 	return nil, false
 }
@@ -5245,268 +10921,200 @@ func (m *TicketMutation) AddedField(name string) (ent.Value, bool) {
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *TicketMutation) AddField(name string, value ent.Value) error {
+func (m *UploadMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case ticket.FieldExpiryTotalDays:
-		v, ok := value.(int8)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddExpiryTotalDays(v)
-		return nil
-	case ticket.FieldExpiryDaysSinceLastDownload:
-		v, ok := value.(int8)
+	case upload.FieldSize:
+		v, ok := value.(int64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddExpiryDaysSinceLastDownload(v)
+		m.AddSize(v)
 		return nil
-	case ticket.FieldExpiryTotalDownloads:
-		v, ok := value.(int8)
+	case upload.FieldOffset:
+		v, ok := value.(int64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddExpiryTotalDownloads(v)
+		m.AddOffset(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Ticket numeric field %s", name)
+	return fmt.Errorf("unknown Upload numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *TicketMutation) ClearedFields() []string {
-	var fields []string
-	if m.FieldCleared(ticket.FieldComment) {
-		fields = append(fields, ticket.FieldComment)
-	}
-	if m.FieldCleared(ticket.FieldEmailOnDownload) {
-		fields = append(fields, ticket.FieldEmailOnDownload)
-	}
-	return fields
+func (m *UploadMutation) ClearedFields() []string {
+	return nil
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *TicketMutation) FieldCleared(name string) bool {
+func (m *UploadMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *TicketMutation) ClearField(name string) error {
-	switch name {
-	case ticket.FieldComment:
-		m.ClearComment()
-		return nil
-	case ticket.FieldEmailOnDownload:
-		m.ClearEmailOnDownload()
-		return nil
-	}
-	return fmt.Errorf("unknown Ticket nullable field %s", name)
+func (m *UploadMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown Upload nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *TicketMutation) ResetField(name string) error {
+func (m *UploadMutation) ResetField(name string) error {
 	switch name {
-	case ticket.FieldComment:
-		m.ResetComment()
-		return nil
-	case ticket.FieldExpiryType:
-		m.ResetExpiryType()
+	case upload.FieldName:
+		m.ResetName()
 		return nil
-	case ticket.FieldHashedPassword:
-		m.ResetHashedPassword()
+	case upload.FieldSize:
+		m.ResetSize()
 		return nil
-	case ticket.FieldSalt:
-		m.ResetSalt()
+	case upload.FieldOffset:
+		m.ResetOffset()
 		return nil
-	case ticket.FieldCreatedAt:
+	case upload.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
-	case ticket.FieldExpiryTotalDays:
-		m.ResetExpiryTotalDays()
-		return nil
-	case ticket.FieldExpiryDaysSinceLastDownload:
-		m.ResetExpiryDaysSinceLastDownload()
-		return nil
-	case ticket.FieldExpiryTotalDownloads:
-		m.ResetExpiryTotalDownloads()
-		return nil
-	case ticket.FieldEmailOnDownload:
-		m.ResetEmailOnDownload()
-		return nil
-	case ticket.FieldCreatorLang:
-		m.ResetCreatorLang()
+	case upload.FieldExpiry:
+		m.ResetExpiry()
 		return nil
 	}
-	return fmt.Errorf("unknown Ticket field %s", name)
+	return fmt.Errorf("unknown Upload field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *TicketMutation) AddedEdges() []string {
-	edges := make([]string, 0, 3)
-	if m.files != nil {
-		edges = append(edges, ticket.EdgeFiles)
-	}
+func (m *UploadMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
 	if m.owner != nil {
-		edges = append(edges, ticket.EdgeOwner)
+		edges = append(edges, upload.EdgeOwner)
 	}
-	if m.shareaccesstokens != nil {
-		edges = append(edges, ticket.EdgeShareaccesstokens)
+	if m.grant != nil {
+		edges = append(edges, upload.EdgeGrant)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *TicketMutation) AddedIDs(name string) []ent.Value {
+func (m *UploadMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case ticket.EdgeFiles:
-		ids := make([]ent.Value, 0, len(m.files))
-		for id := range m.files {
-			ids = append(ids, id)
-		}
-		return ids
-	case ticket.EdgeOwner:
+	case upload.EdgeOwner:
 		if id := m.owner; id != nil {
 			return []ent.Value{*id}
 		}
-	case ticket.EdgeShareaccesstokens:
-		ids := make([]ent.Value, 0, len(m.shareaccesstokens))
-		for id := range m.shareaccesstokens {
-			ids = append(ids, id)
+	case upload.EdgeGrant:
+		if id := m.grant; id != nil {
+			return []ent.Value{*id}
 		}
-		return ids
 	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *TicketMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 3)
-	if m.removedfiles != nil {
-		edges = append(edges, ticket.EdgeFiles)
-	}
-	if m.removedshareaccesstokens != nil {
-		edges = append(edges, ticket.EdgeShareaccesstokens)
-	}
+func (m *UploadMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *TicketMutation) RemovedIDs(name string) []ent.Value {
-	switch name {
-	case ticket.EdgeFiles:
-		ids := make([]ent.Value, 0, len(m.removedfiles))
-		for id := range m.removedfiles {
-			ids = append(ids, id)
-		}
-		return ids
-	case ticket.EdgeShareaccesstokens:
-		ids := make([]ent.Value, 0, len(m.removedshareaccesstokens))
-		for id := range m.removedshareaccesstokens {
-			ids = append(ids, id)
-		}
-		return ids
-	}
+func (m *UploadMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *TicketMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 3)
-	if m.clearedfiles {
-		edges = append(edges, ticket.EdgeFiles)
-	}
+func (m *UploadMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
 	if m.clearedowner {
-		edges = append(edges, ticket.EdgeOwner)
+		edges = append(edges, upload.EdgeOwner)
 	}
-	if m.clearedshareaccesstokens {
-		edges = append(edges, ticket.EdgeShareaccesstokens)
+	if m.clearedgrant {
+		edges = append(edges, upload.EdgeGrant)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *TicketMutation) EdgeCleared(name string) bool {
+func (m *UploadMutation) EdgeCleared(name string) bool {
 	switch name {
-	case ticket.EdgeFiles:
-		return m.clearedfiles
-	case ticket.EdgeOwner:
+	case upload.EdgeOwner:
 		return m.clearedowner
-	case ticket.EdgeShareaccesstokens:
-		return m.clearedshareaccesstokens
+	case upload.EdgeGrant:
+		return m.clearedgrant
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *TicketMutation) ClearEdge(name string) error {
+func (m *UploadMutation) ClearEdge(name string) error {
 	switch name {
-	case ticket.EdgeOwner:
+	case upload.EdgeOwner:
 		m.ClearOwner()
 		return nil
+	case upload.EdgeGrant:
+		m.ClearGrant()
+		return nil
 	}
-	return fmt.Errorf("unknown Ticket unique edge %s", name)
+	return fmt.Errorf("unknown Upload unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *TicketMutation) ResetEdge(name string) error {
+func (m *UploadMutation) ResetEdge(name string) error {
 	switch name {
-	case ticket.EdgeFiles:
-		m.ResetFiles()
-		return nil
-	case ticket.EdgeOwner:
+	case upload.EdgeOwner:
 		m.ResetOwner()
 		return nil
-	case ticket.EdgeShareaccesstokens:
-		m.ResetShareaccesstokens()
+	case upload.EdgeGrant:
+		m.ResetGrant()
 		return nil
 	}
-	return fmt.Errorf("unknown Ticket edge %s", name)
+	return fmt.Errorf("unknown Upload edge %s", name)
 }
 
 // UserMutation represents an operation that mutates the User nodes in the graph.
 type UserMutation struct {
 	config
-	op                   Op
-	typ                  string
-	id                   *uuid.UUID
-	username             *string
-	full_name            *string
-	email                *string
-	groups               *[]string
-	appendgroups         []string
-	is_admin             *bool
-	created_at           *time.Time
-	submitted_tickets    *int
-	addsubmitted_tickets *int
-	submitted_grants     *int
-	addsubmitted_grants  *int
-	totalDataSize        *int64
-	addtotalDataSize     *int64
-	clearedFields        map[string]struct{}
-	sessions             map[int]struct{}
-	removedsessions      map[int]struct{}
-	clearedsessions      bool
-	tickets              map[uuid.UUID]struct{}
-	removedtickets       map[uuid.UUID]struct{}
-	clearedtickets       bool
-	grants               map[uuid.UUID]struct{}
-	removedgrants        map[uuid.UUID]struct{}
-	clearedgrants        bool
-	files                map[uuid.UUID]struct{}
-	removedfiles         map[uuid.UUID]struct{}
-	clearedfiles         bool
-	done                 bool
-	oldValue             func(context.Context) (*User, error)
-	predicates           []predicate.User
+	op                    Op
+	typ                   string
+	id                    *uuid.UUID
+	username              *string
+	full_name             *string
+	email                 *string
+	groups                *[]string
+	appendgroups          []string
+	is_admin              *bool
+	created_at            *time.Time
+	submitted_tickets     *int
+	addsubmitted_tickets  *int
+	submitted_grants      *int
+	addsubmitted_grants   *int
+	totalDataSize         *int64
+	addtotalDataSize      *int64
+	physical_data_size    *int64
+	addphysical_data_size *int64
+	clearedFields         map[string]struct{}
+	sessions              map[int]struct{}
+	removedsessions       map[int]struct{}
+	clearedsessions       bool
+	tickets               map[uuid.UUID]struct{}
+	removedtickets        map[uuid.UUID]struct{}
+	clearedtickets        bool
+	grants                map[uuid.UUID]struct{}
+	removedgrants         map[uuid.UUID]struct{}
+	clearedgrants         bool
+	files                 map[uuid.UUID]struct{}
+	removedfiles          map[uuid.UUID]struct{}
+	clearedfiles          bool
+	uploads               map[uuid.UUID]struct{}
+	removeduploads        map[uuid.UUID]struct{}
+	cleareduploads        bool
+	done                  bool
+	oldValue              func(context.Context) (*User, error)
+	predicates            []predicate.User
 }
 
 var _ ent.Mutation = (*UserMutation)(nil)
@@ -6012,6 +11620,62 @@ func (m *UserMutation) ResetTotalDataSize() {
 	m.addtotalDataSize = nil
 }
 
+// SetPhysicalDataSize sets the "physical_data_size" field.
+func (m *UserMutation) SetPhysicalDataSize(i int64) {
+	m.physical_data_size = &i
+	m.addphysical_data_size = nil
+}
+
+// PhysicalDataSize returns the value of the "physical_data_size" field in the mutation.
+func (m *UserMutation) PhysicalDataSize() (r int64, exists bool) {
+	v := m.physical_data_size
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPhysicalDataSize returns the old "physical_data_size" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldPhysicalDataSize(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPhysicalDataSize is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPhysicalDataSize requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPhysicalDataSize: %w", err)
+	}
+	return oldValue.PhysicalDataSize, nil
+}
+
+// AddPhysicalDataSize adds i to the "physical_data_size" field.
+func (m *UserMutation) AddPhysicalDataSize(i int64) {
+	if m.addphysical_data_size != nil {
+		*m.addphysical_data_size += i
+	} else {
+		m.addphysical_data_size = &i
+	}
+}
+
+// AddedPhysicalDataSize returns the value that was added to the "physical_data_size" field in this mutation.
+func (m *UserMutation) AddedPhysicalDataSize() (r int64, exists bool) {
+	v := m.addphysical_data_size
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetPhysicalDataSize resets all changes to the "physical_data_size" field.
+func (m *UserMutation) ResetPhysicalDataSize() {
+	m.physical_data_size = nil
+	m.addphysical_data_size = nil
+}
+
 // AddSessionIDs adds the "sessions" edge to the Session entity by ids.
 func (m *UserMutation) AddSessionIDs(ids ...int) {
 	if m.sessions == nil {
@@ -6228,6 +11892,60 @@ func (m *UserMutation) ResetFiles() {
 	m.removedfiles = nil
 }
 
+// AddUploadIDs adds the "uploads" edge to the Upload entity by ids.
+func (m *UserMutation) AddUploadIDs(ids ...uuid.UUID) {
+	if m.uploads == nil {
+		m.uploads = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.uploads[ids[i]] = struct{}{}
+	}
+}
+
+// ClearUploads clears the "uploads" edge to the Upload entity.
+func (m *UserMutation) ClearUploads() {
+	m.cleareduploads = true
+}
+
+// UploadsCleared reports if the "uploads" edge to the Upload entity was cleared.
+func (m *UserMutation) UploadsCleared() bool {
+	return m.cleareduploads
+}
+
+// RemoveUploadIDs removes the "uploads" edge to the Upload entity by IDs.
+func (m *UserMutation) RemoveUploadIDs(ids ...uuid.UUID) {
+	if m.removeduploads == nil {
+		m.removeduploads = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.uploads, ids[i])
+		m.removeduploads[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedUploads returns the removed IDs of the "uploads" edge to the Upload entity.
+func (m *UserMutation) RemovedUploadsIDs() (ids []uuid.UUID) {
+	for id := range m.removeduploads {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// UploadsIDs returns the "uploads" edge IDs in the mutation.
+func (m *UserMutation) UploadsIDs() (ids []uuid.UUID) {
+	for id := range m.uploads {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetUploads resets all changes to the "uploads" edge.
+func (m *UserMutation) ResetUploads() {
+	m.uploads = nil
+	m.cleareduploads = false
+	m.removeduploads = nil
+}
+
 // Where appends a list predicates to the UserMutation builder.
 func (m *UserMutation) Where(ps ...predicate.User) {
 	m.predicates = append(m.predicates, ps...)
@@ -6262,7 +11980,7 @@ func (m *UserMutation) Type() string {
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
 func (m *UserMutation) Fields() []string {
-	fields := make([]string, 0, 9)
+	fields := make([]string, 0, 10)
 	if m.username != nil {
 		fields = append(fields, user.FieldUsername)
 	}
@@ -6290,6 +12008,9 @@ func (m *UserMutation) Fields() []string {
 	if m.totalDataSize != nil {
 		fields = append(fields, user.FieldTotalDataSize)
 	}
+	if m.physical_data_size != nil {
+		fields = append(fields, user.FieldPhysicalDataSize)
+	}
 	return fields
 }
 
@@ -6316,6 +12037,8 @@ func (m *UserMutation) Field(name string) (ent.Value, bool) {
 		return m.SubmittedGrants()
 	case user.FieldTotalDataSize:
 		return m.TotalDataSize()
+	case user.FieldPhysicalDataSize:
+		return m.PhysicalDataSize()
 	}
 	return nil, false
 }
@@ -6343,6 +12066,8 @@ func (m *UserMutation) OldField(ctx context.Context, name string) (ent.Value, er
 		return m.OldSubmittedGrants(ctx)
 	case user.FieldTotalDataSize:
 		return m.OldTotalDataSize(ctx)
+	case user.FieldPhysicalDataSize:
+		return m.OldPhysicalDataSize(ctx)
 	}
 	return nil, fmt.Errorf("unknown User field %s", name)
 }
@@ -6415,6 +12140,13 @@ func (m *UserMutation) SetField(name string, value ent.Value) error {
 		}
 		m.SetTotalDataSize(v)
 		return nil
+	case user.FieldPhysicalDataSize:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPhysicalDataSize(v)
+		return nil
 	}
 	return fmt.Errorf("unknown User field %s", name)
 }
@@ -6432,6 +12164,9 @@ func (m *UserMutation) AddedFields() []string {
 	if m.addtotalDataSize != nil {
 		fields = append(fields, user.FieldTotalDataSize)
 	}
+	if m.addphysical_data_size != nil {
+		fields = append(fields, user.FieldPhysicalDataSize)
+	}
 	return fields
 }
 
@@ -6446,6 +12181,8 @@ func (m *UserMutation) AddedField(name string) (ent.Value, bool) {
 		return m.AddedSubmittedGrants()
 	case user.FieldTotalDataSize:
 		return m.AddedTotalDataSize()
+	case user.FieldPhysicalDataSize:
+		return m.AddedPhysicalDataSize()
 	}
 	return nil, false
 }
@@ -6476,6 +12213,13 @@ func (m *UserMutation) AddField(name string, value ent.Value) error {
 		}
 		m.AddTotalDataSize(v)
 		return nil
+	case user.FieldPhysicalDataSize:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddPhysicalDataSize(v)
+		return nil
 	}
 	return fmt.Errorf("unknown User numeric field %s", name)
 }
@@ -6530,13 +12274,16 @@ func (m *UserMutation) ResetField(name string) error {
 	case user.FieldTotalDataSize:
 		m.ResetTotalDataSize()
 		return nil
+	case user.FieldPhysicalDataSize:
+		m.ResetPhysicalDataSize()
+		return nil
 	}
 	return fmt.Errorf("unknown User field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
 func (m *UserMutation) AddedEdges() []string {
-	edges := make([]string, 0, 4)
+	edges := make([]string, 0, 5)
 	if m.sessions != nil {
 		edges = append(edges, user.EdgeSessions)
 	}
@@ -6549,6 +12296,9 @@ func (m *UserMutation) AddedEdges() []string {
 	if m.files != nil {
 		edges = append(edges, user.EdgeFiles)
 	}
+	if m.uploads != nil {
+		edges = append(edges, user.EdgeUploads)
+	}
 	return edges
 }
 
@@ -6580,13 +12330,19 @@ func (m *UserMutation) AddedIDs(name string) []ent.Value {
 			ids = append(ids, id)
 		}
 		return ids
+	case user.EdgeUploads:
+		ids := make([]ent.Value, 0, len(m.uploads))
+		for id := range m.uploads {
+			ids = append(ids, id)
+		}
+		return ids
 	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
 func (m *UserMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 4)
+	edges := make([]string, 0, 5)
 	if m.removedsessions != nil {
 		edges = append(edges, user.EdgeSessions)
 	}
@@ -6599,6 +12355,9 @@ func (m *UserMutation) RemovedEdges() []string {
 	if m.removedfiles != nil {
 		edges = append(edges, user.EdgeFiles)
 	}
+	if m.removeduploads != nil {
+		edges = append(edges, user.EdgeUploads)
+	}
 	return edges
 }
 
@@ -6630,13 +12389,19 @@ func (m *UserMutation) RemovedIDs(name string) []ent.Value {
 			ids = append(ids, id)
 		}
 		return ids
+	case user.EdgeUploads:
+		ids := make([]ent.Value, 0, len(m.removeduploads))
+		for id := range m.removeduploads {
+			ids = append(ids, id)
+		}
+		return ids
 	}
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
 func (m *UserMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 4)
+	edges := make([]string, 0, 5)
 	if m.clearedsessions {
 		edges = append(edges, user.EdgeSessions)
 	}
@@ -6649,6 +12414,9 @@ func (m *UserMutation) ClearedEdges() []string {
 	if m.clearedfiles {
 		edges = append(edges, user.EdgeFiles)
 	}
+	if m.cleareduploads {
+		edges = append(edges, user.EdgeUploads)
+	}
 	return edges
 }
 
@@ -6664,6 +12432,8 @@ func (m *UserMutation) EdgeCleared(name string) bool {
 		return m.clearedgrants
 	case user.EdgeFiles:
 		return m.clearedfiles
+	case user.EdgeUploads:
+		return m.cleareduploads
 	}
 	return false
 }
@@ -6692,6 +12462,9 @@ func (m *UserMutation) ResetEdge(name string) error {
 	case user.EdgeFiles:
 		m.ResetFiles()
 		return nil
+	case user.EdgeUploads:
+		m.ResetUploads()
+		return nil
 	}
 	return fmt.Errorf("unknown User edge %s", name)
 }
@@ -6,6 +6,12 @@ import (
 	"entgo.io/ent/dialect/sql"
 )
 
+// AccessEvent is the predicate function for accessevent builders.
+type AccessEvent func(*sql.Selector)
+
+// DownloadProgress is the predicate function for downloadprogress builders.
+type DownloadProgress func(*sql.Selector)
+
 // File is the predicate function for file builders.
 type File func(*sql.Selector)
 
@@ -15,14 +21,23 @@ type FileData func(*sql.Selector)
 // Grant is the predicate function for grant builders.
 type Grant func(*sql.Selector)
 
+// Recipient is the predicate function for recipient builders.
+type Recipient func(*sql.Selector)
+
 // Session is the predicate function for session builders.
 type Session func(*sql.Selector)
 
 // ShareAccessToken is the predicate function for shareaccesstoken builders.
 type ShareAccessToken func(*sql.Selector)
 
+// StorageVerification is the predicate function for storageverification builders.
+type StorageVerification func(*sql.Selector)
+
 // Ticket is the predicate function for ticket builders.
 type Ticket func(*sql.Selector)
 
+// Upload is the predicate function for upload builders.
+type Upload func(*sql.Selector)
+
 // User is the predicate function for user builders.
 type User func(*sql.Selector)
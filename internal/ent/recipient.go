@@ -0,0 +1,270 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"codeberg.org/jvllmr/frans/internal/ent/recipient"
+	"codeberg.org/jvllmr/frans/internal/ent/ticket"
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+)
+
+// Recipient is the model entity for the Recipient schema.
+type Recipient struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// Name holds the value of the "name" field.
+	Name string `json:"name,omitempty"`
+	// Email holds the value of the "email" field.
+	Email *string `json:"email,omitempty"`
+	// HashedToken holds the value of the "hashed_token" field.
+	HashedToken string `json:"-"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// RevokedAt holds the value of the "revoked_at" field.
+	RevokedAt *time.Time `json:"revoked_at,omitempty"`
+	// LastDownload holds the value of the "last_download" field.
+	LastDownload *time.Time `json:"last_download,omitempty"`
+	// TimesDownloaded holds the value of the "times_downloaded" field.
+	TimesDownloaded uint64 `json:"times_downloaded,omitempty"`
+	// DownloadedFiles holds the value of the "downloaded_files" field.
+	DownloadedFiles []string `json:"downloaded_files,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the RecipientQuery when eager-loading is set.
+	Edges             RecipientEdges `json:"edges"`
+	ticket_recipients *uuid.UUID
+	selectValues      sql.SelectValues
+}
+
+// RecipientEdges holds the relations/edges for other nodes in the graph.
+type RecipientEdges struct {
+	// Ticket holds the value of the ticket edge.
+	Ticket *Ticket `json:"ticket,omitempty"`
+	// Shareaccesstokens holds the value of the shareaccesstokens edge.
+	Shareaccesstokens []*ShareAccessToken `json:"shareaccesstokens,omitempty"`
+	// AccessEvents holds the value of the access_events edge.
+	AccessEvents []*AccessEvent `json:"access_events,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [3]bool
+}
+
+// TicketOrErr returns the Ticket value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e RecipientEdges) TicketOrErr() (*Ticket, error) {
+	if e.Ticket != nil {
+		return e.Ticket, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: ticket.Label}
+	}
+	return nil, &NotLoadedError{edge: "ticket"}
+}
+
+// ShareaccesstokensOrErr returns the Shareaccesstokens value or an error if the edge
+// was not loaded in eager-loading.
+func (e RecipientEdges) ShareaccesstokensOrErr() ([]*ShareAccessToken, error) {
+	if e.loadedTypes[1] {
+		return e.Shareaccesstokens, nil
+	}
+	return nil, &NotLoadedError{edge: "shareaccesstokens"}
+}
+
+// AccessEventsOrErr returns the AccessEvents value or an error if the edge
+// was not loaded in eager-loading.
+func (e RecipientEdges) AccessEventsOrErr() ([]*AccessEvent, error) {
+	if e.loadedTypes[2] {
+		return e.AccessEvents, nil
+	}
+	return nil, &NotLoadedError{edge: "access_events"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Recipient) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case recipient.FieldDownloadedFiles:
+			values[i] = new([]byte)
+		case recipient.FieldTimesDownloaded:
+			values[i] = new(sql.NullInt64)
+		case recipient.FieldName, recipient.FieldEmail, recipient.FieldHashedToken:
+			values[i] = new(sql.NullString)
+		case recipient.FieldCreatedAt, recipient.FieldRevokedAt, recipient.FieldLastDownload:
+			values[i] = new(sql.NullTime)
+		case recipient.FieldID:
+			values[i] = new(uuid.UUID)
+		case recipient.ForeignKeys[0]: // ticket_recipients
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Recipient fields.
+func (_m *Recipient) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case recipient.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case recipient.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				_m.Name = value.String
+			}
+		case recipient.FieldEmail:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field email", values[i])
+			} else if value.Valid {
+				_m.Email = new(string)
+				*_m.Email = value.String
+			}
+		case recipient.FieldHashedToken:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field hashed_token", values[i])
+			} else if value.Valid {
+				_m.HashedToken = value.String
+			}
+		case recipient.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case recipient.FieldRevokedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field revoked_at", values[i])
+			} else if value.Valid {
+				_m.RevokedAt = new(time.Time)
+				*_m.RevokedAt = value.Time
+			}
+		case recipient.FieldLastDownload:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field last_download", values[i])
+			} else if value.Valid {
+				_m.LastDownload = new(time.Time)
+				*_m.LastDownload = value.Time
+			}
+		case recipient.FieldTimesDownloaded:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field times_downloaded", values[i])
+			} else if value.Valid {
+				_m.TimesDownloaded = uint64(value.Int64)
+			}
+		case recipient.FieldDownloadedFiles:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field downloaded_files", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.DownloadedFiles); err != nil {
+					return fmt.Errorf("unmarshal field downloaded_files: %w", err)
+				}
+			}
+		case recipient.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field ticket_recipients", values[i])
+			} else if value.Valid {
+				_m.ticket_recipients = new(uuid.UUID)
+				*_m.ticket_recipients = *value.S.(*uuid.UUID)
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Recipient.
+// This includes values selected through modifiers, order, etc.
+func (_m *Recipient) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryTicket queries the "ticket" edge of the Recipient entity.
+func (_m *Recipient) QueryTicket() *TicketQuery {
+	return NewRecipientClient(_m.config).QueryTicket(_m)
+}
+
+// QueryShareaccesstokens queries the "shareaccesstokens" edge of the Recipient entity.
+func (_m *Recipient) QueryShareaccesstokens() *ShareAccessTokenQuery {
+	return NewRecipientClient(_m.config).QueryShareaccesstokens(_m)
+}
+
+// QueryAccessEvents queries the "access_events" edge of the Recipient entity.
+func (_m *Recipient) QueryAccessEvents() *AccessEventQuery {
+	return NewRecipientClient(_m.config).QueryAccessEvents(_m)
+}
+
+// Update returns a builder for updating this Recipient.
+// Note that you need to call Recipient.Unwrap() before calling this method if this Recipient
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *Recipient) Update() *RecipientUpdateOne {
+	return NewRecipientClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the Recipient entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *Recipient) Unwrap() *Recipient {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Recipient is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *Recipient) String() string {
+	var builder strings.Builder
+	builder.WriteString("Recipient(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("name=")
+	builder.WriteString(_m.Name)
+	builder.WriteString(", ")
+	if v := _m.Email; v != nil {
+		builder.WriteString("email=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	builder.WriteString("hashed_token=<sensitive>")
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := _m.RevokedAt; v != nil {
+		builder.WriteString("revoked_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.LastDownload; v != nil {
+		builder.WriteString("last_download=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("times_downloaded=")
+	builder.WriteString(fmt.Sprintf("%v", _m.TimesDownloaded))
+	builder.WriteString(", ")
+	builder.WriteString("downloaded_files=")
+	builder.WriteString(fmt.Sprintf("%v", _m.DownloadedFiles))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Recipients is a parsable slice of Recipient.
+type Recipients []*Recipient
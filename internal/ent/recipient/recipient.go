@@ -0,0 +1,202 @@
+// Code generated by ent, DO NOT EDIT.
+
+package recipient
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the recipient type in the database.
+	Label = "recipient"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldName holds the string denoting the name field in the database.
+	FieldName = "name"
+	// FieldEmail holds the string denoting the email field in the database.
+	FieldEmail = "email"
+	// FieldHashedToken holds the string denoting the hashed_token field in the database.
+	FieldHashedToken = "hashed_token"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldRevokedAt holds the string denoting the revoked_at field in the database.
+	FieldRevokedAt = "revoked_at"
+	// FieldLastDownload holds the string denoting the last_download field in the database.
+	FieldLastDownload = "last_download"
+	// FieldTimesDownloaded holds the string denoting the times_downloaded field in the database.
+	FieldTimesDownloaded = "times_downloaded"
+	// FieldDownloadedFiles holds the string denoting the downloaded_files field in the database.
+	FieldDownloadedFiles = "downloaded_files"
+	// EdgeTicket holds the string denoting the ticket edge name in mutations.
+	EdgeTicket = "ticket"
+	// EdgeShareaccesstokens holds the string denoting the shareaccesstokens edge name in mutations.
+	EdgeShareaccesstokens = "shareaccesstokens"
+	// EdgeAccessEvents holds the string denoting the access_events edge name in mutations.
+	EdgeAccessEvents = "access_events"
+	// Table holds the table name of the recipient in the database.
+	Table = "recipients"
+	// TicketTable is the table that holds the ticket relation/edge.
+	TicketTable = "recipients"
+	// TicketInverseTable is the table name for the Ticket entity.
+	// It exists in this package in order to avoid circular dependency with the "ticket" package.
+	TicketInverseTable = "tickets"
+	// TicketColumn is the table column denoting the ticket relation/edge.
+	TicketColumn = "ticket_recipients"
+	// ShareaccesstokensTable is the table that holds the shareaccesstokens relation/edge.
+	ShareaccesstokensTable = "share_access_tokens"
+	// ShareaccesstokensInverseTable is the table name for the ShareAccessToken entity.
+	// It exists in this package in order to avoid circular dependency with the "shareaccesstoken" package.
+	ShareaccesstokensInverseTable = "share_access_tokens"
+	// ShareaccesstokensColumn is the table column denoting the shareaccesstokens relation/edge.
+	ShareaccesstokensColumn = "recipient_shareaccesstokens"
+	// AccessEventsTable is the table that holds the access_events relation/edge.
+	AccessEventsTable = "access_events"
+	// AccessEventsInverseTable is the table name for the AccessEvent entity.
+	// It exists in this package in order to avoid circular dependency with the "accessevent" package.
+	AccessEventsInverseTable = "access_events"
+	// AccessEventsColumn is the table column denoting the access_events relation/edge.
+	AccessEventsColumn = "recipient_access_events"
+)
+
+// Columns holds all SQL columns for recipient fields.
+var Columns = []string{
+	FieldID,
+	FieldName,
+	FieldEmail,
+	FieldHashedToken,
+	FieldCreatedAt,
+	FieldRevokedAt,
+	FieldLastDownload,
+	FieldTimesDownloaded,
+	FieldDownloadedFiles,
+}
+
+// ForeignKeys holds the SQL foreign-keys that are owned by the "recipients"
+// table and are not defined as standalone fields in the schema.
+var ForeignKeys = []string{
+	"ticket_recipients",
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	for i := range ForeignKeys {
+		if column == ForeignKeys[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultTimesDownloaded holds the default value on creation for the "times_downloaded" field.
+	DefaultTimesDownloaded uint64
+)
+
+// OrderOption defines the ordering options for the Recipient queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByName orders the results by the name field.
+func ByName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldName, opts...).ToFunc()
+}
+
+// ByEmail orders the results by the email field.
+func ByEmail(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEmail, opts...).ToFunc()
+}
+
+// ByHashedToken orders the results by the hashed_token field.
+func ByHashedToken(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldHashedToken, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByRevokedAt orders the results by the revoked_at field.
+func ByRevokedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRevokedAt, opts...).ToFunc()
+}
+
+// ByLastDownload orders the results by the last_download field.
+func ByLastDownload(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLastDownload, opts...).ToFunc()
+}
+
+// ByTimesDownloaded orders the results by the times_downloaded field.
+func ByTimesDownloaded(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTimesDownloaded, opts...).ToFunc()
+}
+
+// ByTicketField orders the results by ticket field.
+func ByTicketField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newTicketStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByShareaccesstokensCount orders the results by shareaccesstokens count.
+func ByShareaccesstokensCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newShareaccesstokensStep(), opts...)
+	}
+}
+
+// ByShareaccesstokens orders the results by shareaccesstokens terms.
+func ByShareaccesstokens(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newShareaccesstokensStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByAccessEventsCount orders the results by access_events count.
+func ByAccessEventsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newAccessEventsStep(), opts...)
+	}
+}
+
+// ByAccessEvents orders the results by access_events terms.
+func ByAccessEvents(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newAccessEventsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+func newTicketStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(TicketInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, TicketTable, TicketColumn),
+	)
+}
+func newShareaccesstokensStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ShareaccesstokensInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, ShareaccesstokensTable, ShareaccesstokensColumn),
+	)
+}
+func newAccessEventsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(AccessEventsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, AccessEventsTable, AccessEventsColumn),
+	)
+}
@@ -0,0 +1,571 @@
+// Code generated by ent, DO NOT EDIT.
+
+package recipient
+
+import (
+	"time"
+
+	"codeberg.org/jvllmr/frans/internal/ent/predicate"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.Recipient {
+	return predicate.Recipient(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.Recipient {
+	return predicate.Recipient(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.Recipient {
+	return predicate.Recipient(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.Recipient {
+	return predicate.Recipient(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.Recipient {
+	return predicate.Recipient(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.Recipient {
+	return predicate.Recipient(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.Recipient {
+	return predicate.Recipient(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.Recipient {
+	return predicate.Recipient(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.Recipient {
+	return predicate.Recipient(sql.FieldLTE(FieldID, id))
+}
+
+// Name applies equality check predicate on the "name" field. It's identical to NameEQ.
+func Name(v string) predicate.Recipient {
+	return predicate.Recipient(sql.FieldEQ(FieldName, v))
+}
+
+// Email applies equality check predicate on the "email" field. It's identical to EmailEQ.
+func Email(v string) predicate.Recipient {
+	return predicate.Recipient(sql.FieldEQ(FieldEmail, v))
+}
+
+// HashedToken applies equality check predicate on the "hashed_token" field. It's identical to HashedTokenEQ.
+func HashedToken(v string) predicate.Recipient {
+	return predicate.Recipient(sql.FieldEQ(FieldHashedToken, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.Recipient {
+	return predicate.Recipient(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// RevokedAt applies equality check predicate on the "revoked_at" field. It's identical to RevokedAtEQ.
+func RevokedAt(v time.Time) predicate.Recipient {
+	return predicate.Recipient(sql.FieldEQ(FieldRevokedAt, v))
+}
+
+// LastDownload applies equality check predicate on the "last_download" field. It's identical to LastDownloadEQ.
+func LastDownload(v time.Time) predicate.Recipient {
+	return predicate.Recipient(sql.FieldEQ(FieldLastDownload, v))
+}
+
+// TimesDownloaded applies equality check predicate on the "times_downloaded" field. It's identical to TimesDownloadedEQ.
+func TimesDownloaded(v uint64) predicate.Recipient {
+	return predicate.Recipient(sql.FieldEQ(FieldTimesDownloaded, v))
+}
+
+// NameEQ applies the EQ predicate on the "name" field.
+func NameEQ(v string) predicate.Recipient {
+	return predicate.Recipient(sql.FieldEQ(FieldName, v))
+}
+
+// NameNEQ applies the NEQ predicate on the "name" field.
+func NameNEQ(v string) predicate.Recipient {
+	return predicate.Recipient(sql.FieldNEQ(FieldName, v))
+}
+
+// NameIn applies the In predicate on the "name" field.
+func NameIn(vs ...string) predicate.Recipient {
+	return predicate.Recipient(sql.FieldIn(FieldName, vs...))
+}
+
+// NameNotIn applies the NotIn predicate on the "name" field.
+func NameNotIn(vs ...string) predicate.Recipient {
+	return predicate.Recipient(sql.FieldNotIn(FieldName, vs...))
+}
+
+// NameGT applies the GT predicate on the "name" field.
+func NameGT(v string) predicate.Recipient {
+	return predicate.Recipient(sql.FieldGT(FieldName, v))
+}
+
+// NameGTE applies the GTE predicate on the "name" field.
+func NameGTE(v string) predicate.Recipient {
+	return predicate.Recipient(sql.FieldGTE(FieldName, v))
+}
+
+// NameLT applies the LT predicate on the "name" field.
+func NameLT(v string) predicate.Recipient {
+	return predicate.Recipient(sql.FieldLT(FieldName, v))
+}
+
+// NameLTE applies the LTE predicate on the "name" field.
+func NameLTE(v string) predicate.Recipient {
+	return predicate.Recipient(sql.FieldLTE(FieldName, v))
+}
+
+// NameContains applies the Contains predicate on the "name" field.
+func NameContains(v string) predicate.Recipient {
+	return predicate.Recipient(sql.FieldContains(FieldName, v))
+}
+
+// NameHasPrefix applies the HasPrefix predicate on the "name" field.
+func NameHasPrefix(v string) predicate.Recipient {
+	return predicate.Recipient(sql.FieldHasPrefix(FieldName, v))
+}
+
+// NameHasSuffix applies the HasSuffix predicate on the "name" field.
+func NameHasSuffix(v string) predicate.Recipient {
+	return predicate.Recipient(sql.FieldHasSuffix(FieldName, v))
+}
+
+// NameEqualFold applies the EqualFold predicate on the "name" field.
+func NameEqualFold(v string) predicate.Recipient {
+	return predicate.Recipient(sql.FieldEqualFold(FieldName, v))
+}
+
+// NameContainsFold applies the ContainsFold predicate on the "name" field.
+func NameContainsFold(v string) predicate.Recipient {
+	return predicate.Recipient(sql.FieldContainsFold(FieldName, v))
+}
+
+// EmailEQ applies the EQ predicate on the "email" field.
+func EmailEQ(v string) predicate.Recipient {
+	return predicate.Recipient(sql.FieldEQ(FieldEmail, v))
+}
+
+// EmailNEQ applies the NEQ predicate on the "email" field.
+func EmailNEQ(v string) predicate.Recipient {
+	return predicate.Recipient(sql.FieldNEQ(FieldEmail, v))
+}
+
+// EmailIn applies the In predicate on the "email" field.
+func EmailIn(vs ...string) predicate.Recipient {
+	return predicate.Recipient(sql.FieldIn(FieldEmail, vs...))
+}
+
+// EmailNotIn applies the NotIn predicate on the "email" field.
+func EmailNotIn(vs ...string) predicate.Recipient {
+	return predicate.Recipient(sql.FieldNotIn(FieldEmail, vs...))
+}
+
+// EmailGT applies the GT predicate on the "email" field.
+func EmailGT(v string) predicate.Recipient {
+	return predicate.Recipient(sql.FieldGT(FieldEmail, v))
+}
+
+// EmailGTE applies the GTE predicate on the "email" field.
+func EmailGTE(v string) predicate.Recipient {
+	return predicate.Recipient(sql.FieldGTE(FieldEmail, v))
+}
+
+// EmailLT applies the LT predicate on the "email" field.
+func EmailLT(v string) predicate.Recipient {
+	return predicate.Recipient(sql.FieldLT(FieldEmail, v))
+}
+
+// EmailLTE applies the LTE predicate on the "email" field.
+func EmailLTE(v string) predicate.Recipient {
+	return predicate.Recipient(sql.FieldLTE(FieldEmail, v))
+}
+
+// EmailContains applies the Contains predicate on the "email" field.
+func EmailContains(v string) predicate.Recipient {
+	return predicate.Recipient(sql.FieldContains(FieldEmail, v))
+}
+
+// EmailHasPrefix applies the HasPrefix predicate on the "email" field.
+func EmailHasPrefix(v string) predicate.Recipient {
+	return predicate.Recipient(sql.FieldHasPrefix(FieldEmail, v))
+}
+
+// EmailHasSuffix applies the HasSuffix predicate on the "email" field.
+func EmailHasSuffix(v string) predicate.Recipient {
+	return predicate.Recipient(sql.FieldHasSuffix(FieldEmail, v))
+}
+
+// EmailIsNil applies the IsNil predicate on the "email" field.
+func EmailIsNil() predicate.Recipient {
+	return predicate.Recipient(sql.FieldIsNull(FieldEmail))
+}
+
+// EmailNotNil applies the NotNil predicate on the "email" field.
+func EmailNotNil() predicate.Recipient {
+	return predicate.Recipient(sql.FieldNotNull(FieldEmail))
+}
+
+// EmailEqualFold applies the EqualFold predicate on the "email" field.
+func EmailEqualFold(v string) predicate.Recipient {
+	return predicate.Recipient(sql.FieldEqualFold(FieldEmail, v))
+}
+
+// EmailContainsFold applies the ContainsFold predicate on the "email" field.
+func EmailContainsFold(v string) predicate.Recipient {
+	return predicate.Recipient(sql.FieldContainsFold(FieldEmail, v))
+}
+
+// HashedTokenEQ applies the EQ predicate on the "hashed_token" field.
+func HashedTokenEQ(v string) predicate.Recipient {
+	return predicate.Recipient(sql.FieldEQ(FieldHashedToken, v))
+}
+
+// HashedTokenNEQ applies the NEQ predicate on the "hashed_token" field.
+func HashedTokenNEQ(v string) predicate.Recipient {
+	return predicate.Recipient(sql.FieldNEQ(FieldHashedToken, v))
+}
+
+// HashedTokenIn applies the In predicate on the "hashed_token" field.
+func HashedTokenIn(vs ...string) predicate.Recipient {
+	return predicate.Recipient(sql.FieldIn(FieldHashedToken, vs...))
+}
+
+// HashedTokenNotIn applies the NotIn predicate on the "hashed_token" field.
+func HashedTokenNotIn(vs ...string) predicate.Recipient {
+	return predicate.Recipient(sql.FieldNotIn(FieldHashedToken, vs...))
+}
+
+// HashedTokenGT applies the GT predicate on the "hashed_token" field.
+func HashedTokenGT(v string) predicate.Recipient {
+	return predicate.Recipient(sql.FieldGT(FieldHashedToken, v))
+}
+
+// HashedTokenGTE applies the GTE predicate on the "hashed_token" field.
+func HashedTokenGTE(v string) predicate.Recipient {
+	return predicate.Recipient(sql.FieldGTE(FieldHashedToken, v))
+}
+
+// HashedTokenLT applies the LT predicate on the "hashed_token" field.
+func HashedTokenLT(v string) predicate.Recipient {
+	return predicate.Recipient(sql.FieldLT(FieldHashedToken, v))
+}
+
+// HashedTokenLTE applies the LTE predicate on the "hashed_token" field.
+func HashedTokenLTE(v string) predicate.Recipient {
+	return predicate.Recipient(sql.FieldLTE(FieldHashedToken, v))
+}
+
+// HashedTokenContains applies the Contains predicate on the "hashed_token" field.
+func HashedTokenContains(v string) predicate.Recipient {
+	return predicate.Recipient(sql.FieldContains(FieldHashedToken, v))
+}
+
+// HashedTokenHasPrefix applies the HasPrefix predicate on the "hashed_token" field.
+func HashedTokenHasPrefix(v string) predicate.Recipient {
+	return predicate.Recipient(sql.FieldHasPrefix(FieldHashedToken, v))
+}
+
+// HashedTokenHasSuffix applies the HasSuffix predicate on the "hashed_token" field.
+func HashedTokenHasSuffix(v string) predicate.Recipient {
+	return predicate.Recipient(sql.FieldHasSuffix(FieldHashedToken, v))
+}
+
+// HashedTokenEqualFold applies the EqualFold predicate on the "hashed_token" field.
+func HashedTokenEqualFold(v string) predicate.Recipient {
+	return predicate.Recipient(sql.FieldEqualFold(FieldHashedToken, v))
+}
+
+// HashedTokenContainsFold applies the ContainsFold predicate on the "hashed_token" field.
+func HashedTokenContainsFold(v string) predicate.Recipient {
+	return predicate.Recipient(sql.FieldContainsFold(FieldHashedToken, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.Recipient {
+	return predicate.Recipient(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.Recipient {
+	return predicate.Recipient(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.Recipient {
+	return predicate.Recipient(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.Recipient {
+	return predicate.Recipient(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.Recipient {
+	return predicate.Recipient(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.Recipient {
+	return predicate.Recipient(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.Recipient {
+	return predicate.Recipient(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.Recipient {
+	return predicate.Recipient(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// RevokedAtEQ applies the EQ predicate on the "revoked_at" field.
+func RevokedAtEQ(v time.Time) predicate.Recipient {
+	return predicate.Recipient(sql.FieldEQ(FieldRevokedAt, v))
+}
+
+// RevokedAtNEQ applies the NEQ predicate on the "revoked_at" field.
+func RevokedAtNEQ(v time.Time) predicate.Recipient {
+	return predicate.Recipient(sql.FieldNEQ(FieldRevokedAt, v))
+}
+
+// RevokedAtIn applies the In predicate on the "revoked_at" field.
+func RevokedAtIn(vs ...time.Time) predicate.Recipient {
+	return predicate.Recipient(sql.FieldIn(FieldRevokedAt, vs...))
+}
+
+// RevokedAtNotIn applies the NotIn predicate on the "revoked_at" field.
+func RevokedAtNotIn(vs ...time.Time) predicate.Recipient {
+	return predicate.Recipient(sql.FieldNotIn(FieldRevokedAt, vs...))
+}
+
+// RevokedAtGT applies the GT predicate on the "revoked_at" field.
+func RevokedAtGT(v time.Time) predicate.Recipient {
+	return predicate.Recipient(sql.FieldGT(FieldRevokedAt, v))
+}
+
+// RevokedAtGTE applies the GTE predicate on the "revoked_at" field.
+func RevokedAtGTE(v time.Time) predicate.Recipient {
+	return predicate.Recipient(sql.FieldGTE(FieldRevokedAt, v))
+}
+
+// RevokedAtLT applies the LT predicate on the "revoked_at" field.
+func RevokedAtLT(v time.Time) predicate.Recipient {
+	return predicate.Recipient(sql.FieldLT(FieldRevokedAt, v))
+}
+
+// RevokedAtLTE applies the LTE predicate on the "revoked_at" field.
+func RevokedAtLTE(v time.Time) predicate.Recipient {
+	return predicate.Recipient(sql.FieldLTE(FieldRevokedAt, v))
+}
+
+// RevokedAtIsNil applies the IsNil predicate on the "revoked_at" field.
+func RevokedAtIsNil() predicate.Recipient {
+	return predicate.Recipient(sql.FieldIsNull(FieldRevokedAt))
+}
+
+// RevokedAtNotNil applies the NotNil predicate on the "revoked_at" field.
+func RevokedAtNotNil() predicate.Recipient {
+	return predicate.Recipient(sql.FieldNotNull(FieldRevokedAt))
+}
+
+// LastDownloadEQ applies the EQ predicate on the "last_download" field.
+func LastDownloadEQ(v time.Time) predicate.Recipient {
+	return predicate.Recipient(sql.FieldEQ(FieldLastDownload, v))
+}
+
+// LastDownloadNEQ applies the NEQ predicate on the "last_download" field.
+func LastDownloadNEQ(v time.Time) predicate.Recipient {
+	return predicate.Recipient(sql.FieldNEQ(FieldLastDownload, v))
+}
+
+// LastDownloadIn applies the In predicate on the "last_download" field.
+func LastDownloadIn(vs ...time.Time) predicate.Recipient {
+	return predicate.Recipient(sql.FieldIn(FieldLastDownload, vs...))
+}
+
+// LastDownloadNotIn applies the NotIn predicate on the "last_download" field.
+func LastDownloadNotIn(vs ...time.Time) predicate.Recipient {
+	return predicate.Recipient(sql.FieldNotIn(FieldLastDownload, vs...))
+}
+
+// LastDownloadGT applies the GT predicate on the "last_download" field.
+func LastDownloadGT(v time.Time) predicate.Recipient {
+	return predicate.Recipient(sql.FieldGT(FieldLastDownload, v))
+}
+
+// LastDownloadGTE applies the GTE predicate on the "last_download" field.
+func LastDownloadGTE(v time.Time) predicate.Recipient {
+	return predicate.Recipient(sql.FieldGTE(FieldLastDownload, v))
+}
+
+// LastDownloadLT applies the LT predicate on the "last_download" field.
+func LastDownloadLT(v time.Time) predicate.Recipient {
+	return predicate.Recipient(sql.FieldLT(FieldLastDownload, v))
+}
+
+// LastDownloadLTE applies the LTE predicate on the "last_download" field.
+func LastDownloadLTE(v time.Time) predicate.Recipient {
+	return predicate.Recipient(sql.FieldLTE(FieldLastDownload, v))
+}
+
+// LastDownloadIsNil applies the IsNil predicate on the "last_download" field.
+func LastDownloadIsNil() predicate.Recipient {
+	return predicate.Recipient(sql.FieldIsNull(FieldLastDownload))
+}
+
+// LastDownloadNotNil applies the NotNil predicate on the "last_download" field.
+func LastDownloadNotNil() predicate.Recipient {
+	return predicate.Recipient(sql.FieldNotNull(FieldLastDownload))
+}
+
+// TimesDownloadedEQ applies the EQ predicate on the "times_downloaded" field.
+func TimesDownloadedEQ(v uint64) predicate.Recipient {
+	return predicate.Recipient(sql.FieldEQ(FieldTimesDownloaded, v))
+}
+
+// TimesDownloadedNEQ applies the NEQ predicate on the "times_downloaded" field.
+func TimesDownloadedNEQ(v uint64) predicate.Recipient {
+	return predicate.Recipient(sql.FieldNEQ(FieldTimesDownloaded, v))
+}
+
+// TimesDownloadedIn applies the In predicate on the "times_downloaded" field.
+func TimesDownloadedIn(vs ...uint64) predicate.Recipient {
+	return predicate.Recipient(sql.FieldIn(FieldTimesDownloaded, vs...))
+}
+
+// TimesDownloadedNotIn applies the NotIn predicate on the "times_downloaded" field.
+func TimesDownloadedNotIn(vs ...uint64) predicate.Recipient {
+	return predicate.Recipient(sql.FieldNotIn(FieldTimesDownloaded, vs...))
+}
+
+// TimesDownloadedGT applies the GT predicate on the "times_downloaded" field.
+func TimesDownloadedGT(v uint64) predicate.Recipient {
+	return predicate.Recipient(sql.FieldGT(FieldTimesDownloaded, v))
+}
+
+// TimesDownloadedGTE applies the GTE predicate on the "times_downloaded" field.
+func TimesDownloadedGTE(v uint64) predicate.Recipient {
+	return predicate.Recipient(sql.FieldGTE(FieldTimesDownloaded, v))
+}
+
+// TimesDownloadedLT applies the LT predicate on the "times_downloaded" field.
+func TimesDownloadedLT(v uint64) predicate.Recipient {
+	return predicate.Recipient(sql.FieldLT(FieldTimesDownloaded, v))
+}
+
+// TimesDownloadedLTE applies the LTE predicate on the "times_downloaded" field.
+func TimesDownloadedLTE(v uint64) predicate.Recipient {
+	return predicate.Recipient(sql.FieldLTE(FieldTimesDownloaded, v))
+}
+
+// DownloadedFilesIsNil applies the IsNil predicate on the "downloaded_files" field.
+func DownloadedFilesIsNil() predicate.Recipient {
+	return predicate.Recipient(sql.FieldIsNull(FieldDownloadedFiles))
+}
+
+// DownloadedFilesNotNil applies the NotNil predicate on the "downloaded_files" field.
+func DownloadedFilesNotNil() predicate.Recipient {
+	return predicate.Recipient(sql.FieldNotNull(FieldDownloadedFiles))
+}
+
+// HasTicket applies the HasEdge predicate on the "ticket" edge.
+func HasTicket() predicate.Recipient {
+	return predicate.Recipient(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, TicketTable, TicketColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasTicketWith applies the HasEdge predicate on the "ticket" edge with a given conditions (other predicates).
+func HasTicketWith(preds ...predicate.Ticket) predicate.Recipient {
+	return predicate.Recipient(func(s *sql.Selector) {
+		step := newTicketStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasShareaccesstokens applies the HasEdge predicate on the "shareaccesstokens" edge.
+func HasShareaccesstokens() predicate.Recipient {
+	return predicate.Recipient(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, ShareaccesstokensTable, ShareaccesstokensColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasShareaccesstokensWith applies the HasEdge predicate on the "shareaccesstokens" edge with a given conditions (other predicates).
+func HasShareaccesstokensWith(preds ...predicate.ShareAccessToken) predicate.Recipient {
+	return predicate.Recipient(func(s *sql.Selector) {
+		step := newShareaccesstokensStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasAccessEvents applies the HasEdge predicate on the "access_events" edge.
+func HasAccessEvents() predicate.Recipient {
+	return predicate.Recipient(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, AccessEventsTable, AccessEventsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasAccessEventsWith applies the HasEdge predicate on the "access_events" edge with a given conditions (other predicates).
+func HasAccessEventsWith(preds ...predicate.AccessEvent) predicate.Recipient {
+	return predicate.Recipient(func(s *sql.Selector) {
+		step := newAccessEventsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.Recipient) predicate.Recipient {
+	return predicate.Recipient(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.Recipient) predicate.Recipient {
+	return predicate.Recipient(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.Recipient) predicate.Recipient {
+	return predicate.Recipient(sql.NotPredicates(p))
+}
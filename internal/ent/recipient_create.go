@@ -0,0 +1,425 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"codeberg.org/jvllmr/frans/internal/ent/accessevent"
+	"codeberg.org/jvllmr/frans/internal/ent/recipient"
+	"codeberg.org/jvllmr/frans/internal/ent/shareaccesstoken"
+	"codeberg.org/jvllmr/frans/internal/ent/ticket"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// RecipientCreate is the builder for creating a Recipient entity.
+type RecipientCreate struct {
+	config
+	mutation *RecipientMutation
+	hooks    []Hook
+}
+
+// SetName sets the "name" field.
+func (_c *RecipientCreate) SetName(v string) *RecipientCreate {
+	_c.mutation.SetName(v)
+	return _c
+}
+
+// SetEmail sets the "email" field.
+func (_c *RecipientCreate) SetEmail(v string) *RecipientCreate {
+	_c.mutation.SetEmail(v)
+	return _c
+}
+
+// SetNillableEmail sets the "email" field if the given value is not nil.
+func (_c *RecipientCreate) SetNillableEmail(v *string) *RecipientCreate {
+	if v != nil {
+		_c.SetEmail(*v)
+	}
+	return _c
+}
+
+// SetHashedToken sets the "hashed_token" field.
+func (_c *RecipientCreate) SetHashedToken(v string) *RecipientCreate {
+	_c.mutation.SetHashedToken(v)
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *RecipientCreate) SetCreatedAt(v time.Time) *RecipientCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *RecipientCreate) SetNillableCreatedAt(v *time.Time) *RecipientCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetRevokedAt sets the "revoked_at" field.
+func (_c *RecipientCreate) SetRevokedAt(v time.Time) *RecipientCreate {
+	_c.mutation.SetRevokedAt(v)
+	return _c
+}
+
+// SetNillableRevokedAt sets the "revoked_at" field if the given value is not nil.
+func (_c *RecipientCreate) SetNillableRevokedAt(v *time.Time) *RecipientCreate {
+	if v != nil {
+		_c.SetRevokedAt(*v)
+	}
+	return _c
+}
+
+// SetLastDownload sets the "last_download" field.
+func (_c *RecipientCreate) SetLastDownload(v time.Time) *RecipientCreate {
+	_c.mutation.SetLastDownload(v)
+	return _c
+}
+
+// SetNillableLastDownload sets the "last_download" field if the given value is not nil.
+func (_c *RecipientCreate) SetNillableLastDownload(v *time.Time) *RecipientCreate {
+	if v != nil {
+		_c.SetLastDownload(*v)
+	}
+	return _c
+}
+
+// SetTimesDownloaded sets the "times_downloaded" field.
+func (_c *RecipientCreate) SetTimesDownloaded(v uint64) *RecipientCreate {
+	_c.mutation.SetTimesDownloaded(v)
+	return _c
+}
+
+// SetNillableTimesDownloaded sets the "times_downloaded" field if the given value is not nil.
+func (_c *RecipientCreate) SetNillableTimesDownloaded(v *uint64) *RecipientCreate {
+	if v != nil {
+		_c.SetTimesDownloaded(*v)
+	}
+	return _c
+}
+
+// SetDownloadedFiles sets the "downloaded_files" field.
+func (_c *RecipientCreate) SetDownloadedFiles(v []string) *RecipientCreate {
+	_c.mutation.SetDownloadedFiles(v)
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *RecipientCreate) SetID(v uuid.UUID) *RecipientCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetTicketID sets the "ticket" edge to the Ticket entity by ID.
+func (_c *RecipientCreate) SetTicketID(id uuid.UUID) *RecipientCreate {
+	_c.mutation.SetTicketID(id)
+	return _c
+}
+
+// SetTicket sets the "ticket" edge to the Ticket entity.
+func (_c *RecipientCreate) SetTicket(v *Ticket) *RecipientCreate {
+	return _c.SetTicketID(v.ID)
+}
+
+// AddShareaccesstokenIDs adds the "shareaccesstokens" edge to the ShareAccessToken entity by IDs.
+func (_c *RecipientCreate) AddShareaccesstokenIDs(ids ...string) *RecipientCreate {
+	_c.mutation.AddShareaccesstokenIDs(ids...)
+	return _c
+}
+
+// AddShareaccesstokens adds the "shareaccesstokens" edges to the ShareAccessToken entity.
+func (_c *RecipientCreate) AddShareaccesstokens(v ...*ShareAccessToken) *RecipientCreate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddShareaccesstokenIDs(ids...)
+}
+
+// AddAccessEventIDs adds the "access_events" edge to the AccessEvent entity by IDs.
+func (_c *RecipientCreate) AddAccessEventIDs(ids ...int) *RecipientCreate {
+	_c.mutation.AddAccessEventIDs(ids...)
+	return _c
+}
+
+// AddAccessEvents adds the "access_events" edges to the AccessEvent entity.
+func (_c *RecipientCreate) AddAccessEvents(v ...*AccessEvent) *RecipientCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddAccessEventIDs(ids...)
+}
+
+// Mutation returns the RecipientMutation object of the builder.
+func (_c *RecipientCreate) Mutation() *RecipientMutation {
+	return _c.mutation
+}
+
+// Save creates the Recipient in the database.
+func (_c *RecipientCreate) Save(ctx context.Context) (*Recipient, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *RecipientCreate) SaveX(ctx context.Context) *Recipient {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *RecipientCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *RecipientCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *RecipientCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := recipient.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.TimesDownloaded(); !ok {
+		v := recipient.DefaultTimesDownloaded
+		_c.mutation.SetTimesDownloaded(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *RecipientCreate) check() error {
+	if _, ok := _c.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "Recipient.name"`)}
+	}
+	if _, ok := _c.mutation.HashedToken(); !ok {
+		return &ValidationError{Name: "hashed_token", err: errors.New(`ent: missing required field "Recipient.hashed_token"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Recipient.created_at"`)}
+	}
+	if _, ok := _c.mutation.TimesDownloaded(); !ok {
+		return &ValidationError{Name: "times_downloaded", err: errors.New(`ent: missing required field "Recipient.times_downloaded"`)}
+	}
+	if len(_c.mutation.TicketIDs()) == 0 {
+		return &ValidationError{Name: "ticket", err: errors.New(`ent: missing required edge "Recipient.ticket"`)}
+	}
+	return nil
+}
+
+func (_c *RecipientCreate) sqlSave(ctx context.Context) (*Recipient, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *RecipientCreate) createSpec() (*Recipient, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Recipient{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(recipient.Table, sqlgraph.NewFieldSpec(recipient.FieldID, field.TypeUUID))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.Name(); ok {
+		_spec.SetField(recipient.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := _c.mutation.Email(); ok {
+		_spec.SetField(recipient.FieldEmail, field.TypeString, value)
+		_node.Email = &value
+	}
+	if value, ok := _c.mutation.HashedToken(); ok {
+		_spec.SetField(recipient.FieldHashedToken, field.TypeString, value)
+		_node.HashedToken = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(recipient.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.RevokedAt(); ok {
+		_spec.SetField(recipient.FieldRevokedAt, field.TypeTime, value)
+		_node.RevokedAt = &value
+	}
+	if value, ok := _c.mutation.LastDownload(); ok {
+		_spec.SetField(recipient.FieldLastDownload, field.TypeTime, value)
+		_node.LastDownload = &value
+	}
+	if value, ok := _c.mutation.TimesDownloaded(); ok {
+		_spec.SetField(recipient.FieldTimesDownloaded, field.TypeUint64, value)
+		_node.TimesDownloaded = value
+	}
+	if value, ok := _c.mutation.DownloadedFiles(); ok {
+		_spec.SetField(recipient.FieldDownloadedFiles, field.TypeJSON, value)
+		_node.DownloadedFiles = value
+	}
+	if nodes := _c.mutation.TicketIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   recipient.TicketTable,
+			Columns: []string{recipient.TicketColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ticket.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.ticket_recipients = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.ShareaccesstokensIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   recipient.ShareaccesstokensTable,
+			Columns: []string{recipient.ShareaccesstokensColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(shareaccesstoken.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.AccessEventsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   recipient.AccessEventsTable,
+			Columns: []string{recipient.AccessEventsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(accessevent.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// RecipientCreateBulk is the builder for creating many Recipient entities in bulk.
+type RecipientCreateBulk struct {
+	config
+	err      error
+	builders []*RecipientCreate
+}
+
+// Save creates the Recipient entities in the database.
+func (_c *RecipientCreateBulk) Save(ctx context.Context) ([]*Recipient, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*Recipient, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*RecipientMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *RecipientCreateBulk) SaveX(ctx context.Context) []*Recipient {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *RecipientCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *RecipientCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
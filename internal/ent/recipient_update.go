@@ -0,0 +1,989 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"codeberg.org/jvllmr/frans/internal/ent/accessevent"
+	"codeberg.org/jvllmr/frans/internal/ent/predicate"
+	"codeberg.org/jvllmr/frans/internal/ent/recipient"
+	"codeberg.org/jvllmr/frans/internal/ent/shareaccesstoken"
+	"codeberg.org/jvllmr/frans/internal/ent/ticket"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// RecipientUpdate is the builder for updating Recipient entities.
+type RecipientUpdate struct {
+	config
+	hooks    []Hook
+	mutation *RecipientMutation
+}
+
+// Where appends a list predicates to the RecipientUpdate builder.
+func (_u *RecipientUpdate) Where(ps ...predicate.Recipient) *RecipientUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *RecipientUpdate) SetName(v string) *RecipientUpdate {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *RecipientUpdate) SetNillableName(v *string) *RecipientUpdate {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetEmail sets the "email" field.
+func (_u *RecipientUpdate) SetEmail(v string) *RecipientUpdate {
+	_u.mutation.SetEmail(v)
+	return _u
+}
+
+// SetNillableEmail sets the "email" field if the given value is not nil.
+func (_u *RecipientUpdate) SetNillableEmail(v *string) *RecipientUpdate {
+	if v != nil {
+		_u.SetEmail(*v)
+	}
+	return _u
+}
+
+// ClearEmail clears the value of the "email" field.
+func (_u *RecipientUpdate) ClearEmail() *RecipientUpdate {
+	_u.mutation.ClearEmail()
+	return _u
+}
+
+// SetHashedToken sets the "hashed_token" field.
+func (_u *RecipientUpdate) SetHashedToken(v string) *RecipientUpdate {
+	_u.mutation.SetHashedToken(v)
+	return _u
+}
+
+// SetNillableHashedToken sets the "hashed_token" field if the given value is not nil.
+func (_u *RecipientUpdate) SetNillableHashedToken(v *string) *RecipientUpdate {
+	if v != nil {
+		_u.SetHashedToken(*v)
+	}
+	return _u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_u *RecipientUpdate) SetCreatedAt(v time.Time) *RecipientUpdate {
+	_u.mutation.SetCreatedAt(v)
+	return _u
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_u *RecipientUpdate) SetNillableCreatedAt(v *time.Time) *RecipientUpdate {
+	if v != nil {
+		_u.SetCreatedAt(*v)
+	}
+	return _u
+}
+
+// SetRevokedAt sets the "revoked_at" field.
+func (_u *RecipientUpdate) SetRevokedAt(v time.Time) *RecipientUpdate {
+	_u.mutation.SetRevokedAt(v)
+	return _u
+}
+
+// SetNillableRevokedAt sets the "revoked_at" field if the given value is not nil.
+func (_u *RecipientUpdate) SetNillableRevokedAt(v *time.Time) *RecipientUpdate {
+	if v != nil {
+		_u.SetRevokedAt(*v)
+	}
+	return _u
+}
+
+// ClearRevokedAt clears the value of the "revoked_at" field.
+func (_u *RecipientUpdate) ClearRevokedAt() *RecipientUpdate {
+	_u.mutation.ClearRevokedAt()
+	return _u
+}
+
+// SetLastDownload sets the "last_download" field.
+func (_u *RecipientUpdate) SetLastDownload(v time.Time) *RecipientUpdate {
+	_u.mutation.SetLastDownload(v)
+	return _u
+}
+
+// SetNillableLastDownload sets the "last_download" field if the given value is not nil.
+func (_u *RecipientUpdate) SetNillableLastDownload(v *time.Time) *RecipientUpdate {
+	if v != nil {
+		_u.SetLastDownload(*v)
+	}
+	return _u
+}
+
+// ClearLastDownload clears the value of the "last_download" field.
+func (_u *RecipientUpdate) ClearLastDownload() *RecipientUpdate {
+	_u.mutation.ClearLastDownload()
+	return _u
+}
+
+// SetTimesDownloaded sets the "times_downloaded" field.
+func (_u *RecipientUpdate) SetTimesDownloaded(v uint64) *RecipientUpdate {
+	_u.mutation.ResetTimesDownloaded()
+	_u.mutation.SetTimesDownloaded(v)
+	return _u
+}
+
+// SetNillableTimesDownloaded sets the "times_downloaded" field if the given value is not nil.
+func (_u *RecipientUpdate) SetNillableTimesDownloaded(v *uint64) *RecipientUpdate {
+	if v != nil {
+		_u.SetTimesDownloaded(*v)
+	}
+	return _u
+}
+
+// AddTimesDownloaded adds value to the "times_downloaded" field.
+func (_u *RecipientUpdate) AddTimesDownloaded(v int64) *RecipientUpdate {
+	_u.mutation.AddTimesDownloaded(v)
+	return _u
+}
+
+// SetDownloadedFiles sets the "downloaded_files" field.
+func (_u *RecipientUpdate) SetDownloadedFiles(v []string) *RecipientUpdate {
+	_u.mutation.SetDownloadedFiles(v)
+	return _u
+}
+
+// AppendDownloadedFiles appends value to the "downloaded_files" field.
+func (_u *RecipientUpdate) AppendDownloadedFiles(v []string) *RecipientUpdate {
+	_u.mutation.AppendDownloadedFiles(v)
+	return _u
+}
+
+// ClearDownloadedFiles clears the value of the "downloaded_files" field.
+func (_u *RecipientUpdate) ClearDownloadedFiles() *RecipientUpdate {
+	_u.mutation.ClearDownloadedFiles()
+	return _u
+}
+
+// SetTicketID sets the "ticket" edge to the Ticket entity by ID.
+func (_u *RecipientUpdate) SetTicketID(id uuid.UUID) *RecipientUpdate {
+	_u.mutation.SetTicketID(id)
+	return _u
+}
+
+// SetTicket sets the "ticket" edge to the Ticket entity.
+func (_u *RecipientUpdate) SetTicket(v *Ticket) *RecipientUpdate {
+	return _u.SetTicketID(v.ID)
+}
+
+// AddShareaccesstokenIDs adds the "shareaccesstokens" edge to the ShareAccessToken entity by IDs.
+func (_u *RecipientUpdate) AddShareaccesstokenIDs(ids ...string) *RecipientUpdate {
+	_u.mutation.AddShareaccesstokenIDs(ids...)
+	return _u
+}
+
+// AddShareaccesstokens adds the "shareaccesstokens" edges to the ShareAccessToken entity.
+func (_u *RecipientUpdate) AddShareaccesstokens(v ...*ShareAccessToken) *RecipientUpdate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddShareaccesstokenIDs(ids...)
+}
+
+// AddAccessEventIDs adds the "access_events" edge to the AccessEvent entity by IDs.
+func (_u *RecipientUpdate) AddAccessEventIDs(ids ...int) *RecipientUpdate {
+	_u.mutation.AddAccessEventIDs(ids...)
+	return _u
+}
+
+// AddAccessEvents adds the "access_events" edges to the AccessEvent entity.
+func (_u *RecipientUpdate) AddAccessEvents(v ...*AccessEvent) *RecipientUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddAccessEventIDs(ids...)
+}
+
+// Mutation returns the RecipientMutation object of the builder.
+func (_u *RecipientUpdate) Mutation() *RecipientMutation {
+	return _u.mutation
+}
+
+// ClearTicket clears the "ticket" edge to the Ticket entity.
+func (_u *RecipientUpdate) ClearTicket() *RecipientUpdate {
+	_u.mutation.ClearTicket()
+	return _u
+}
+
+// ClearShareaccesstokens clears all "shareaccesstokens" edges to the ShareAccessToken entity.
+func (_u *RecipientUpdate) ClearShareaccesstokens() *RecipientUpdate {
+	_u.mutation.ClearShareaccesstokens()
+	return _u
+}
+
+// RemoveShareaccesstokenIDs removes the "shareaccesstokens" edge to ShareAccessToken entities by IDs.
+func (_u *RecipientUpdate) RemoveShareaccesstokenIDs(ids ...string) *RecipientUpdate {
+	_u.mutation.RemoveShareaccesstokenIDs(ids...)
+	return _u
+}
+
+// RemoveShareaccesstokens removes "shareaccesstokens" edges to ShareAccessToken entities.
+func (_u *RecipientUpdate) RemoveShareaccesstokens(v ...*ShareAccessToken) *RecipientUpdate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveShareaccesstokenIDs(ids...)
+}
+
+// ClearAccessEvents clears all "access_events" edges to the AccessEvent entity.
+func (_u *RecipientUpdate) ClearAccessEvents() *RecipientUpdate {
+	_u.mutation.ClearAccessEvents()
+	return _u
+}
+
+// RemoveAccessEventIDs removes the "access_events" edge to AccessEvent entities by IDs.
+func (_u *RecipientUpdate) RemoveAccessEventIDs(ids ...int) *RecipientUpdate {
+	_u.mutation.RemoveAccessEventIDs(ids...)
+	return _u
+}
+
+// RemoveAccessEvents removes "access_events" edges to AccessEvent entities.
+func (_u *RecipientUpdate) RemoveAccessEvents(v ...*AccessEvent) *RecipientUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveAccessEventIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *RecipientUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *RecipientUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *RecipientUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *RecipientUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *RecipientUpdate) check() error {
+	if _u.mutation.TicketCleared() && len(_u.mutation.TicketIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Recipient.ticket"`)
+	}
+	return nil
+}
+
+func (_u *RecipientUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(recipient.Table, recipient.Columns, sqlgraph.NewFieldSpec(recipient.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(recipient.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Email(); ok {
+		_spec.SetField(recipient.FieldEmail, field.TypeString, value)
+	}
+	if _u.mutation.EmailCleared() {
+		_spec.ClearField(recipient.FieldEmail, field.TypeString)
+	}
+	if value, ok := _u.mutation.HashedToken(); ok {
+		_spec.SetField(recipient.FieldHashedToken, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.CreatedAt(); ok {
+		_spec.SetField(recipient.FieldCreatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.RevokedAt(); ok {
+		_spec.SetField(recipient.FieldRevokedAt, field.TypeTime, value)
+	}
+	if _u.mutation.RevokedAtCleared() {
+		_spec.ClearField(recipient.FieldRevokedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.LastDownload(); ok {
+		_spec.SetField(recipient.FieldLastDownload, field.TypeTime, value)
+	}
+	if _u.mutation.LastDownloadCleared() {
+		_spec.ClearField(recipient.FieldLastDownload, field.TypeTime)
+	}
+	if value, ok := _u.mutation.TimesDownloaded(); ok {
+		_spec.SetField(recipient.FieldTimesDownloaded, field.TypeUint64, value)
+	}
+	if value, ok := _u.mutation.AddedTimesDownloaded(); ok {
+		_spec.AddField(recipient.FieldTimesDownloaded, field.TypeUint64, value)
+	}
+	if value, ok := _u.mutation.DownloadedFiles(); ok {
+		_spec.SetField(recipient.FieldDownloadedFiles, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedDownloadedFiles(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, recipient.FieldDownloadedFiles, value)
+		})
+	}
+	if _u.mutation.DownloadedFilesCleared() {
+		_spec.ClearField(recipient.FieldDownloadedFiles, field.TypeJSON)
+	}
+	if _u.mutation.TicketCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   recipient.TicketTable,
+			Columns: []string{recipient.TicketColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ticket.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.TicketIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   recipient.TicketTable,
+			Columns: []string{recipient.TicketColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ticket.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ShareaccesstokensCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   recipient.ShareaccesstokensTable,
+			Columns: []string{recipient.ShareaccesstokensColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(shareaccesstoken.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedShareaccesstokensIDs(); len(nodes) > 0 && !_u.mutation.ShareaccesstokensCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   recipient.ShareaccesstokensTable,
+			Columns: []string{recipient.ShareaccesstokensColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(shareaccesstoken.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ShareaccesstokensIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   recipient.ShareaccesstokensTable,
+			Columns: []string{recipient.ShareaccesstokensColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(shareaccesstoken.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.AccessEventsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   recipient.AccessEventsTable,
+			Columns: []string{recipient.AccessEventsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(accessevent.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedAccessEventsIDs(); len(nodes) > 0 && !_u.mutation.AccessEventsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   recipient.AccessEventsTable,
+			Columns: []string{recipient.AccessEventsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(accessevent.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.AccessEventsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   recipient.AccessEventsTable,
+			Columns: []string{recipient.AccessEventsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(accessevent.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{recipient.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// RecipientUpdateOne is the builder for updating a single Recipient entity.
+type RecipientUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *RecipientMutation
+}
+
+// SetName sets the "name" field.
+func (_u *RecipientUpdateOne) SetName(v string) *RecipientUpdateOne {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *RecipientUpdateOne) SetNillableName(v *string) *RecipientUpdateOne {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetEmail sets the "email" field.
+func (_u *RecipientUpdateOne) SetEmail(v string) *RecipientUpdateOne {
+	_u.mutation.SetEmail(v)
+	return _u
+}
+
+// SetNillableEmail sets the "email" field if the given value is not nil.
+func (_u *RecipientUpdateOne) SetNillableEmail(v *string) *RecipientUpdateOne {
+	if v != nil {
+		_u.SetEmail(*v)
+	}
+	return _u
+}
+
+// ClearEmail clears the value of the "email" field.
+func (_u *RecipientUpdateOne) ClearEmail() *RecipientUpdateOne {
+	_u.mutation.ClearEmail()
+	return _u
+}
+
+// SetHashedToken sets the "hashed_token" field.
+func (_u *RecipientUpdateOne) SetHashedToken(v string) *RecipientUpdateOne {
+	_u.mutation.SetHashedToken(v)
+	return _u
+}
+
+// SetNillableHashedToken sets the "hashed_token" field if the given value is not nil.
+func (_u *RecipientUpdateOne) SetNillableHashedToken(v *string) *RecipientUpdateOne {
+	if v != nil {
+		_u.SetHashedToken(*v)
+	}
+	return _u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_u *RecipientUpdateOne) SetCreatedAt(v time.Time) *RecipientUpdateOne {
+	_u.mutation.SetCreatedAt(v)
+	return _u
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_u *RecipientUpdateOne) SetNillableCreatedAt(v *time.Time) *RecipientUpdateOne {
+	if v != nil {
+		_u.SetCreatedAt(*v)
+	}
+	return _u
+}
+
+// SetRevokedAt sets the "revoked_at" field.
+func (_u *RecipientUpdateOne) SetRevokedAt(v time.Time) *RecipientUpdateOne {
+	_u.mutation.SetRevokedAt(v)
+	return _u
+}
+
+// SetNillableRevokedAt sets the "revoked_at" field if the given value is not nil.
+func (_u *RecipientUpdateOne) SetNillableRevokedAt(v *time.Time) *RecipientUpdateOne {
+	if v != nil {
+		_u.SetRevokedAt(*v)
+	}
+	return _u
+}
+
+// ClearRevokedAt clears the value of the "revoked_at" field.
+func (_u *RecipientUpdateOne) ClearRevokedAt() *RecipientUpdateOne {
+	_u.mutation.ClearRevokedAt()
+	return _u
+}
+
+// SetLastDownload sets the "last_download" field.
+func (_u *RecipientUpdateOne) SetLastDownload(v time.Time) *RecipientUpdateOne {
+	_u.mutation.SetLastDownload(v)
+	return _u
+}
+
+// SetNillableLastDownload sets the "last_download" field if the given value is not nil.
+func (_u *RecipientUpdateOne) SetNillableLastDownload(v *time.Time) *RecipientUpdateOne {
+	if v != nil {
+		_u.SetLastDownload(*v)
+	}
+	return _u
+}
+
+// ClearLastDownload clears the value of the "last_download" field.
+func (_u *RecipientUpdateOne) ClearLastDownload() *RecipientUpdateOne {
+	_u.mutation.ClearLastDownload()
+	return _u
+}
+
+// SetTimesDownloaded sets the "times_downloaded" field.
+func (_u *RecipientUpdateOne) SetTimesDownloaded(v uint64) *RecipientUpdateOne {
+	_u.mutation.ResetTimesDownloaded()
+	_u.mutation.SetTimesDownloaded(v)
+	return _u
+}
+
+// SetNillableTimesDownloaded sets the "times_downloaded" field if the given value is not nil.
+func (_u *RecipientUpdateOne) SetNillableTimesDownloaded(v *uint64) *RecipientUpdateOne {
+	if v != nil {
+		_u.SetTimesDownloaded(*v)
+	}
+	return _u
+}
+
+// AddTimesDownloaded adds value to the "times_downloaded" field.
+func (_u *RecipientUpdateOne) AddTimesDownloaded(v int64) *RecipientUpdateOne {
+	_u.mutation.AddTimesDownloaded(v)
+	return _u
+}
+
+// SetDownloadedFiles sets the "downloaded_files" field.
+func (_u *RecipientUpdateOne) SetDownloadedFiles(v []string) *RecipientUpdateOne {
+	_u.mutation.SetDownloadedFiles(v)
+	return _u
+}
+
+// AppendDownloadedFiles appends value to the "downloaded_files" field.
+func (_u *RecipientUpdateOne) AppendDownloadedFiles(v []string) *RecipientUpdateOne {
+	_u.mutation.AppendDownloadedFiles(v)
+	return _u
+}
+
+// ClearDownloadedFiles clears the value of the "downloaded_files" field.
+func (_u *RecipientUpdateOne) ClearDownloadedFiles() *RecipientUpdateOne {
+	_u.mutation.ClearDownloadedFiles()
+	return _u
+}
+
+// SetTicketID sets the "ticket" edge to the Ticket entity by ID.
+func (_u *RecipientUpdateOne) SetTicketID(id uuid.UUID) *RecipientUpdateOne {
+	_u.mutation.SetTicketID(id)
+	return _u
+}
+
+// SetTicket sets the "ticket" edge to the Ticket entity.
+func (_u *RecipientUpdateOne) SetTicket(v *Ticket) *RecipientUpdateOne {
+	return _u.SetTicketID(v.ID)
+}
+
+// AddShareaccesstokenIDs adds the "shareaccesstokens" edge to the ShareAccessToken entity by IDs.
+func (_u *RecipientUpdateOne) AddShareaccesstokenIDs(ids ...string) *RecipientUpdateOne {
+	_u.mutation.AddShareaccesstokenIDs(ids...)
+	return _u
+}
+
+// AddShareaccesstokens adds the "shareaccesstokens" edges to the ShareAccessToken entity.
+func (_u *RecipientUpdateOne) AddShareaccesstokens(v ...*ShareAccessToken) *RecipientUpdateOne {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddShareaccesstokenIDs(ids...)
+}
+
+// AddAccessEventIDs adds the "access_events" edge to the AccessEvent entity by IDs.
+func (_u *RecipientUpdateOne) AddAccessEventIDs(ids ...int) *RecipientUpdateOne {
+	_u.mutation.AddAccessEventIDs(ids...)
+	return _u
+}
+
+// AddAccessEvents adds the "access_events" edges to the AccessEvent entity.
+func (_u *RecipientUpdateOne) AddAccessEvents(v ...*AccessEvent) *RecipientUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddAccessEventIDs(ids...)
+}
+
+// Mutation returns the RecipientMutation object of the builder.
+func (_u *RecipientUpdateOne) Mutation() *RecipientMutation {
+	return _u.mutation
+}
+
+// ClearTicket clears the "ticket" edge to the Ticket entity.
+func (_u *RecipientUpdateOne) ClearTicket() *RecipientUpdateOne {
+	_u.mutation.ClearTicket()
+	return _u
+}
+
+// ClearShareaccesstokens clears all "shareaccesstokens" edges to the ShareAccessToken entity.
+func (_u *RecipientUpdateOne) ClearShareaccesstokens() *RecipientUpdateOne {
+	_u.mutation.ClearShareaccesstokens()
+	return _u
+}
+
+// RemoveShareaccesstokenIDs removes the "shareaccesstokens" edge to ShareAccessToken entities by IDs.
+func (_u *RecipientUpdateOne) RemoveShareaccesstokenIDs(ids ...string) *RecipientUpdateOne {
+	_u.mutation.RemoveShareaccesstokenIDs(ids...)
+	return _u
+}
+
+// RemoveShareaccesstokens removes "shareaccesstokens" edges to ShareAccessToken entities.
+func (_u *RecipientUpdateOne) RemoveShareaccesstokens(v ...*ShareAccessToken) *RecipientUpdateOne {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveShareaccesstokenIDs(ids...)
+}
+
+// ClearAccessEvents clears all "access_events" edges to the AccessEvent entity.
+func (_u *RecipientUpdateOne) ClearAccessEvents() *RecipientUpdateOne {
+	_u.mutation.ClearAccessEvents()
+	return _u
+}
+
+// RemoveAccessEventIDs removes the "access_events" edge to AccessEvent entities by IDs.
+func (_u *RecipientUpdateOne) RemoveAccessEventIDs(ids ...int) *RecipientUpdateOne {
+	_u.mutation.RemoveAccessEventIDs(ids...)
+	return _u
+}
+
+// RemoveAccessEvents removes "access_events" edges to AccessEvent entities.
+func (_u *RecipientUpdateOne) RemoveAccessEvents(v ...*AccessEvent) *RecipientUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveAccessEventIDs(ids...)
+}
+
+// Where appends a list predicates to the RecipientUpdate builder.
+func (_u *RecipientUpdateOne) Where(ps ...predicate.Recipient) *RecipientUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *RecipientUpdateOne) Select(field string, fields ...string) *RecipientUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated Recipient entity.
+func (_u *RecipientUpdateOne) Save(ctx context.Context) (*Recipient, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *RecipientUpdateOne) SaveX(ctx context.Context) *Recipient {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *RecipientUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *RecipientUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *RecipientUpdateOne) check() error {
+	if _u.mutation.TicketCleared() && len(_u.mutation.TicketIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Recipient.ticket"`)
+	}
+	return nil
+}
+
+func (_u *RecipientUpdateOne) sqlSave(ctx context.Context) (_node *Recipient, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(recipient.Table, recipient.Columns, sqlgraph.NewFieldSpec(recipient.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Recipient.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, recipient.FieldID)
+		for _, f := range fields {
+			if !recipient.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != recipient.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(recipient.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Email(); ok {
+		_spec.SetField(recipient.FieldEmail, field.TypeString, value)
+	}
+	if _u.mutation.EmailCleared() {
+		_spec.ClearField(recipient.FieldEmail, field.TypeString)
+	}
+	if value, ok := _u.mutation.HashedToken(); ok {
+		_spec.SetField(recipient.FieldHashedToken, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.CreatedAt(); ok {
+		_spec.SetField(recipient.FieldCreatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.RevokedAt(); ok {
+		_spec.SetField(recipient.FieldRevokedAt, field.TypeTime, value)
+	}
+	if _u.mutation.RevokedAtCleared() {
+		_spec.ClearField(recipient.FieldRevokedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.LastDownload(); ok {
+		_spec.SetField(recipient.FieldLastDownload, field.TypeTime, value)
+	}
+	if _u.mutation.LastDownloadCleared() {
+		_spec.ClearField(recipient.FieldLastDownload, field.TypeTime)
+	}
+	if value, ok := _u.mutation.TimesDownloaded(); ok {
+		_spec.SetField(recipient.FieldTimesDownloaded, field.TypeUint64, value)
+	}
+	if value, ok := _u.mutation.AddedTimesDownloaded(); ok {
+		_spec.AddField(recipient.FieldTimesDownloaded, field.TypeUint64, value)
+	}
+	if value, ok := _u.mutation.DownloadedFiles(); ok {
+		_spec.SetField(recipient.FieldDownloadedFiles, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedDownloadedFiles(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, recipient.FieldDownloadedFiles, value)
+		})
+	}
+	if _u.mutation.DownloadedFilesCleared() {
+		_spec.ClearField(recipient.FieldDownloadedFiles, field.TypeJSON)
+	}
+	if _u.mutation.TicketCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   recipient.TicketTable,
+			Columns: []string{recipient.TicketColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ticket.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.TicketIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   recipient.TicketTable,
+			Columns: []string{recipient.TicketColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ticket.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ShareaccesstokensCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   recipient.ShareaccesstokensTable,
+			Columns: []string{recipient.ShareaccesstokensColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(shareaccesstoken.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedShareaccesstokensIDs(); len(nodes) > 0 && !_u.mutation.ShareaccesstokensCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   recipient.ShareaccesstokensTable,
+			Columns: []string{recipient.ShareaccesstokensColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(shareaccesstoken.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ShareaccesstokensIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   recipient.ShareaccesstokensTable,
+			Columns: []string{recipient.ShareaccesstokensColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(shareaccesstoken.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.AccessEventsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   recipient.AccessEventsTable,
+			Columns: []string{recipient.AccessEventsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(accessevent.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedAccessEventsIDs(); len(nodes) > 0 && !_u.mutation.AccessEventsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   recipient.AccessEventsTable,
+			Columns: []string{recipient.AccessEventsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(accessevent.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.AccessEventsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   recipient.AccessEventsTable,
+			Columns: []string{recipient.AccessEventsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(accessevent.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &Recipient{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{recipient.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -5,10 +5,16 @@ package ent
 import (
 	"time"
 
+	"codeberg.org/jvllmr/frans/internal/ent/accessevent"
+	"codeberg.org/jvllmr/frans/internal/ent/downloadprogress"
 	"codeberg.org/jvllmr/frans/internal/ent/file"
+	"codeberg.org/jvllmr/frans/internal/ent/filedata"
 	"codeberg.org/jvllmr/frans/internal/ent/grant"
+	"codeberg.org/jvllmr/frans/internal/ent/recipient"
 	"codeberg.org/jvllmr/frans/internal/ent/schema"
+	"codeberg.org/jvllmr/frans/internal/ent/storageverification"
 	"codeberg.org/jvllmr/frans/internal/ent/ticket"
+	"codeberg.org/jvllmr/frans/internal/ent/upload"
 	"codeberg.org/jvllmr/frans/internal/ent/user"
 )
 
@@ -16,6 +22,32 @@ import (
 // (default values, validators, hooks and policies) and stitches it
 // to their package variables.
 func init() {
+	accesseventFields := schema.AccessEvent{}.Fields()
+	_ = accesseventFields
+	// accesseventDescCreatedAt is the schema descriptor for created_at field.
+	accesseventDescCreatedAt := accesseventFields[1].Descriptor()
+	// accessevent.DefaultCreatedAt holds the default value on creation for the created_at field.
+	accessevent.DefaultCreatedAt = accesseventDescCreatedAt.Default.(func() time.Time)
+	// accesseventDescUserAgent is the schema descriptor for user_agent field.
+	accesseventDescUserAgent := accesseventFields[3].Descriptor()
+	// accessevent.DefaultUserAgent holds the default value on creation for the user_agent field.
+	accessevent.DefaultUserAgent = accesseventDescUserAgent.Default.(string)
+	// accesseventDescBytesServed is the schema descriptor for bytes_served field.
+	accesseventDescBytesServed := accesseventFields[4].Descriptor()
+	// accessevent.DefaultBytesServed holds the default value on creation for the bytes_served field.
+	accessevent.DefaultBytesServed = accesseventDescBytesServed.Default.(int64)
+	downloadprogressFields := schema.DownloadProgress{}.Fields()
+	_ = downloadprogressFields
+	// downloadprogressDescUpdatedAt is the schema descriptor for updated_at field.
+	downloadprogressDescUpdatedAt := downloadprogressFields[2].Descriptor()
+	// downloadprogress.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	downloadprogress.DefaultUpdatedAt = downloadprogressDescUpdatedAt.Default.(func() time.Time)
+	// downloadprogress.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	downloadprogress.UpdateDefaultUpdatedAt = downloadprogressDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// downloadprogressDescVersion is the schema descriptor for version field.
+	downloadprogressDescVersion := downloadprogressFields[3].Descriptor()
+	// downloadprogress.DefaultVersion holds the default value on creation for the version field.
+	downloadprogress.DefaultVersion = downloadprogressDescVersion.Default.(int64)
 	fileFields := schema.File{}.Fields()
 	_ = fileFields
 	// fileDescCreatedAt is the schema descriptor for created_at field.
@@ -26,6 +58,24 @@ func init() {
 	fileDescTimesDownloaded := fileFields[4].Descriptor()
 	// file.DefaultTimesDownloaded holds the default value on creation for the times_downloaded field.
 	file.DefaultTimesDownloaded = fileDescTimesDownloaded.Default.(uint64)
+	// fileDescTimesViewed is the schema descriptor for times_viewed field.
+	fileDescTimesViewed := fileFields[6].Descriptor()
+	// file.DefaultTimesViewed holds the default value on creation for the times_viewed field.
+	file.DefaultTimesViewed = fileDescTimesViewed.Default.(uint64)
+	// fileDescScanStatus is the schema descriptor for scan_status field.
+	fileDescScanStatus := fileFields[11].Descriptor()
+	// file.DefaultScanStatus holds the default value on creation for the scan_status field.
+	file.DefaultScanStatus = fileDescScanStatus.Default.(string)
+	filedataFields := schema.FileData{}.Fields()
+	_ = filedataFields
+	// filedataDescStoredSize is the schema descriptor for stored_size field.
+	filedataDescStoredSize := filedataFields[7].Descriptor()
+	// filedata.DefaultStoredSize holds the default value on creation for the stored_size field.
+	filedata.DefaultStoredSize = filedataDescStoredSize.Default.(int64)
+	// filedataDescPreviewStatus is the schema descriptor for preview_status field.
+	filedataDescPreviewStatus := filedataFields[9].Descriptor()
+	// filedata.DefaultPreviewStatus holds the default value on creation for the preview_status field.
+	filedata.DefaultPreviewStatus = filedataDescPreviewStatus.Default.(string)
 	grantFields := schema.Grant{}.Fields()
 	_ = grantFields
 	// grantDescCreatedAt is the schema descriptor for created_at field.
@@ -40,6 +90,26 @@ func init() {
 	grantDescCreatorLang := grantFields[16].Descriptor()
 	// grant.DefaultCreatorLang holds the default value on creation for the creator_lang field.
 	grant.DefaultCreatorLang = grantDescCreatorLang.Default.(string)
+	recipientFields := schema.Recipient{}.Fields()
+	_ = recipientFields
+	// recipientDescCreatedAt is the schema descriptor for created_at field.
+	recipientDescCreatedAt := recipientFields[4].Descriptor()
+	// recipient.DefaultCreatedAt holds the default value on creation for the created_at field.
+	recipient.DefaultCreatedAt = recipientDescCreatedAt.Default.(func() time.Time)
+	// recipientDescTimesDownloaded is the schema descriptor for times_downloaded field.
+	recipientDescTimesDownloaded := recipientFields[7].Descriptor()
+	// recipient.DefaultTimesDownloaded holds the default value on creation for the times_downloaded field.
+	recipient.DefaultTimesDownloaded = recipientDescTimesDownloaded.Default.(uint64)
+	storageverificationFields := schema.StorageVerification{}.Fields()
+	_ = storageverificationFields
+	// storageverificationDescStartedAt is the schema descriptor for started_at field.
+	storageverificationDescStartedAt := storageverificationFields[0].Descriptor()
+	// storageverification.DefaultStartedAt holds the default value on creation for the started_at field.
+	storageverification.DefaultStartedAt = storageverificationDescStartedAt.Default.(func() time.Time)
+	// storageverificationDescCheckedBlobs is the schema descriptor for checked_blobs field.
+	storageverificationDescCheckedBlobs := storageverificationFields[2].Descriptor()
+	// storageverification.DefaultCheckedBlobs holds the default value on creation for the checked_blobs field.
+	storageverification.DefaultCheckedBlobs = storageverificationDescCheckedBlobs.Default.(int64)
 	ticketFields := schema.Ticket{}.Fields()
 	_ = ticketFields
 	// ticketDescCreatedAt is the schema descriptor for created_at field.
@@ -50,6 +120,20 @@ func init() {
 	ticketDescCreatorLang := ticketFields[10].Descriptor()
 	// ticket.DefaultCreatorLang holds the default value on creation for the creator_lang field.
 	ticket.DefaultCreatorLang = ticketDescCreatorLang.Default.(string)
+	// ticketDescViewsCountAsDownloads is the schema descriptor for views_count_as_downloads field.
+	ticketDescViewsCountAsDownloads := ticketFields[11].Descriptor()
+	// ticket.DefaultViewsCountAsDownloads holds the default value on creation for the views_count_as_downloads field.
+	ticket.DefaultViewsCountAsDownloads = ticketDescViewsCountAsDownloads.Default.(bool)
+	uploadFields := schema.Upload{}.Fields()
+	_ = uploadFields
+	// uploadDescOffset is the schema descriptor for offset field.
+	uploadDescOffset := uploadFields[3].Descriptor()
+	// upload.DefaultOffset holds the default value on creation for the offset field.
+	upload.DefaultOffset = uploadDescOffset.Default.(int64)
+	// uploadDescCreatedAt is the schema descriptor for created_at field.
+	uploadDescCreatedAt := uploadFields[4].Descriptor()
+	// upload.DefaultCreatedAt holds the default value on creation for the created_at field.
+	upload.DefaultCreatedAt = uploadDescCreatedAt.Default.(func() time.Time)
 	userFields := schema.User{}.Fields()
 	_ = userFields
 	// userDescCreatedAt is the schema descriptor for created_at field.
@@ -68,4 +152,8 @@ func init() {
 	userDescTotalDataSize := userFields[9].Descriptor()
 	// user.DefaultTotalDataSize holds the default value on creation for the totalDataSize field.
 	user.DefaultTotalDataSize = userDescTotalDataSize.Default.(int64)
+	// userDescPhysicalDataSize is the schema descriptor for physical_data_size field.
+	userDescPhysicalDataSize := userFields[10].Descriptor()
+	// user.DefaultPhysicalDataSize holds the default value on creation for the physical_data_size field.
+	user.DefaultPhysicalDataSize = userDescPhysicalDataSize.Default.(int64)
 }
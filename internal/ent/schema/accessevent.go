@@ -0,0 +1,47 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// AccessEvent holds the schema definition for the AccessEvent entity.
+// It records how a shared ticket or grant was accessed.
+type AccessEvent struct {
+	ent.Schema
+}
+
+// Fields of the AccessEvent.
+func (AccessEvent) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("type"),
+		field.Time("created_at").
+			Default(time.Now),
+		field.String("address"),
+		field.String("user_agent").Default(""),
+		field.Int64("bytes_served").Default(0),
+		// name of the downloaded file, which is kept after the file is deleted
+		field.String("file_name").Optional().Nillable(),
+	}
+}
+
+// Edges of the AccessEvent.
+func (AccessEvent) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("ticket", Ticket.Type).Ref("access_events").Unique(),
+		edge.From("grant", Grant.Type).Ref("access_events").Unique(),
+		edge.From("recipient", Recipient.Type).Ref("access_events").Unique(),
+		edge.From("file", File.Type).Ref("access_events").Unique(),
+	}
+}
+
+// Indexes of the AccessEvent.
+func (AccessEvent) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("created_at"),
+	}
+}
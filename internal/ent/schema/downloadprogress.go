@@ -0,0 +1,43 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// DownloadProgress holds the schema definition for the DownloadProgress entity.
+// It records which byte ranges of a file were delivered to a download token.
+type DownloadProgress struct {
+	ent.Schema
+}
+
+// Fields of the DownloadProgress.
+func (DownloadProgress) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("token"),
+		field.JSON("ranges", [][2]int64{}).Optional(),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now),
+		// incremented on every change, so concurrent updates can detect each other
+		field.Int64("version").Default(0),
+	}
+}
+
+// Edges of the DownloadProgress.
+func (DownloadProgress) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("file", File.Type).Ref("download_progresses").Unique().Required(),
+	}
+}
+
+// Indexes of the DownloadProgress.
+func (DownloadProgress) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("token").Edges("file").Unique(),
+	}
+}
@@ -24,10 +24,16 @@ func (File) Fields() []ent.Field {
 			Default(time.Now),
 		field.Time("last_download").Nillable().Optional(),
 		field.Uint64("times_downloaded").Default(0),
+		field.Time("last_view").Nillable().Optional(),
+		field.Uint64("times_viewed").Default(0),
 		field.String("expiry_type"),
 		field.Uint8("expiry_total_days"),
 		field.Uint8("expiry_days_since_last_download"),
 		field.Uint8("expiry_total_downloads"),
+		field.String("scan_status").Default("clean"),
+		field.String("scan_signature").Optional().Nillable(),
+		// MIME type the file is served with
+		field.String("mime_type").Optional().Nillable(),
 	}
 }
 
@@ -42,5 +48,8 @@ func (File) Edges() []ent.Edge {
 			Required().
 			Annotations(entsql.OnDelete(entsql.Restrict)),
 		edge.To("data", FileData.Type).Unique().Required(),
+		edge.To("download_progresses", DownloadProgress.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("access_events", AccessEvent.Type),
 	}
 }
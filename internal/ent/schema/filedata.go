@@ -16,6 +16,22 @@ func (FileData) Fields() []ent.Field {
 	return []ent.Field{
 		field.String("id").Unique(),
 		field.Uint64("size"),
+		// SHA-256 of the content. Unset for files stored before it was computed.
+		field.String("sha256").Optional().Nillable(),
+		// ID of the master key the data key is wrapped with. Unset for unencrypted blobs.
+		field.String("key_id").Optional().Nillable(),
+		field.Bytes("encrypted_key").Optional(),
+		// Algorithm the blob is compressed with. Unset for uncompressed blobs.
+		field.String("compression").Optional().Nillable(),
+		// Size of the compressed content. Only set for compressed blobs.
+		field.Uint64("compressed_size").Optional().Nillable(),
+		// Size of the blob in storage. Maintained by the storage accounting hooks.
+		field.Int64("stored_size").Default(0),
+		// MIME type detected from the content. Unset for files stored before types were detected.
+		field.String("mime_type").Optional().Nillable(),
+		field.String("preview_status").Default("pending"),
+		// MIME type of the stored preview. Only set if a preview was generated.
+		field.String("preview_type").Optional().Nillable(),
 	}
 }
 
@@ -4,6 +4,7 @@ import (
 	"time"
 
 	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
 	"github.com/google/uuid"
@@ -44,5 +45,8 @@ func (Grant) Edges() []ent.Edge {
 		edge.To("files", File.Type),
 		edge.From("owner", User.Type).Ref("grants").Unique(),
 		edge.To("shareaccesstokens", ShareAccessToken.Type),
+		edge.To("uploads", Upload.Type),
+		edge.To("access_events", AccessEvent.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
 	}
 }
@@ -0,0 +1,47 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// Recipient holds the schema definition for the Recipient entity.
+// A recipient opens a ticket with a personal link, so downloads can be
+// tracked and access can be revoked for every recipient on their own.
+type Recipient struct {
+	ent.Schema
+}
+
+// Fields of the Recipient.
+func (Recipient) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).Unique(),
+		field.String("name"),
+		field.String("email").Optional().Nillable(),
+		// hash of the secret part of the personal link, used in place of the ticket password.
+		// The token itself is only sent to the recipient.
+		field.String("hashed_token").Unique().Sensitive(),
+		field.Time("created_at").
+			Default(time.Now),
+		field.Time("revoked_at").Optional().Nillable(),
+		field.Time("last_download").Optional().Nillable(),
+		field.Uint64("times_downloaded").Default(0),
+		// files the recipient downloaded completely at least once
+		field.Strings("downloaded_files").Optional(),
+	}
+}
+
+// Edges of the Recipient.
+func (Recipient) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("ticket", Ticket.Type).Ref("recipients").Unique().Required(),
+		edge.To("shareaccesstokens", ShareAccessToken.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("access_events", AccessEvent.Type),
+	}
+}
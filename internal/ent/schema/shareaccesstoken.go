@@ -24,5 +24,6 @@ func (ShareAccessToken) Edges() []ent.Edge {
 	return []ent.Edge{
 		edge.From("ticket", Ticket.Type).Ref("shareaccesstokens").Unique(),
 		edge.From("grant", Grant.Type).Ref("shareaccesstokens").Unique(),
+		edge.From("recipient", Recipient.Type).Ref("shareaccesstokens").Unique(),
 	}
 }
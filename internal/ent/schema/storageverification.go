@@ -0,0 +1,31 @@
+package schema
+
+import (
+	"time"
+
+	"codeberg.org/jvllmr/frans/internal/storage"
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+)
+
+// StorageVerification holds the schema definition for the StorageVerification entity.
+// Every run of the storage verification task is recorded with the issues it found.
+type StorageVerification struct {
+	ent.Schema
+}
+
+// Fields of the StorageVerification.
+func (StorageVerification) Fields() []ent.Field {
+	return []ent.Field{
+		field.Time("started_at").
+			Default(time.Now),
+		field.Time("finished_at").Nillable().Optional(),
+		field.Int64("checked_blobs").Default(0),
+		field.JSON("issues", []storage.Issue{}).Optional(),
+	}
+}
+
+// Edges of the StorageVerification.
+func (StorageVerification) Edges() []ent.Edge {
+	return nil
+}
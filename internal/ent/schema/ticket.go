@@ -4,6 +4,7 @@ import (
 	"time"
 
 	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
 	"github.com/google/uuid"
@@ -29,6 +30,8 @@ func (Ticket) Fields() []ent.Field {
 		field.Uint8("expiry_total_downloads"),
 		field.Strings("email_on_download").Optional(),
 		field.String("creator_lang").Default("en"),
+		// whether viewing a file inline counts towards its download limit
+		field.Bool("views_count_as_downloads").Default(false),
 	}
 }
 
@@ -38,5 +41,9 @@ func (Ticket) Edges() []ent.Edge {
 		edge.To("files", File.Type),
 		edge.From("owner", User.Type).Ref("tickets").Unique(),
 		edge.To("shareaccesstokens", ShareAccessToken.Type),
+		edge.To("recipients", Recipient.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("access_events", AccessEvent.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
 	}
 }
@@ -0,0 +1,36 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// Upload holds the schema definition for the Upload entity.
+type Upload struct {
+	ent.Schema
+}
+
+// Fields of the Upload.
+func (Upload) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).Unique(),
+		field.String("name"),
+		field.Int64("size"),
+		field.Int64("offset").Default(0),
+		field.Time("created_at").
+			Default(time.Now),
+		field.Time("expiry"),
+	}
+}
+
+// Edges of the Upload.
+func (Upload) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("owner", User.Type).Ref("uploads").Unique(),
+		edge.From("grant", Grant.Type).Ref("uploads").Unique(),
+	}
+}
@@ -27,7 +27,10 @@ func (User) Fields() []ent.Field {
 			Default(time.Now),
 		field.Int("submitted_tickets").Default(0),
 		field.Int("submitted_grants").Default(0),
+		// Bytes of all files of the user, counting every file on its own
 		field.Int64("totalDataSize").Default(0),
+		// Bytes the blobs of the files of the user take up in storage, counting shared blobs once
+		field.Int64("physical_data_size").Default(0),
 	}
 }
 
@@ -38,5 +41,6 @@ func (User) Edges() []ent.Edge {
 		edge.To("tickets", Ticket.Type),
 		edge.To("grants", Grant.Type),
 		edge.To("files", File.Type),
+		edge.To("uploads", Upload.Type),
 	}
 }
@@ -8,6 +8,7 @@ import (
 	"time"
 
 	"codeberg.org/jvllmr/frans/internal/ent/grant"
+	"codeberg.org/jvllmr/frans/internal/ent/recipient"
 	"codeberg.org/jvllmr/frans/internal/ent/shareaccesstoken"
 	"codeberg.org/jvllmr/frans/internal/ent/ticket"
 	"entgo.io/ent"
@@ -24,10 +25,11 @@ type ShareAccessToken struct {
 	Expiry time.Time `json:"expiry,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the ShareAccessTokenQuery when eager-loading is set.
-	Edges                    ShareAccessTokenEdges `json:"edges"`
-	grant_shareaccesstokens  *uuid.UUID
-	ticket_shareaccesstokens *uuid.UUID
-	selectValues             sql.SelectValues
+	Edges                       ShareAccessTokenEdges `json:"edges"`
+	grant_shareaccesstokens     *uuid.UUID
+	recipient_shareaccesstokens *uuid.UUID
+	ticket_shareaccesstokens    *uuid.UUID
+	selectValues                sql.SelectValues
 }
 
 // ShareAccessTokenEdges holds the relations/edges for other nodes in the graph.
@@ -36,9 +38,11 @@ type ShareAccessTokenEdges struct {
 	Ticket *Ticket `json:"ticket,omitempty"`
 	// Grant holds the value of the grant edge.
 	Grant *Grant `json:"grant,omitempty"`
+	// Recipient holds the value of the recipient edge.
+	Recipient *Recipient `json:"recipient,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [2]bool
+	loadedTypes [3]bool
 }
 
 // TicketOrErr returns the Ticket value or an error if the edge
@@ -63,6 +67,17 @@ func (e ShareAccessTokenEdges) GrantOrErr() (*Grant, error) {
 	return nil, &NotLoadedError{edge: "grant"}
 }
 
+// RecipientOrErr returns the Recipient value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ShareAccessTokenEdges) RecipientOrErr() (*Recipient, error) {
+	if e.Recipient != nil {
+		return e.Recipient, nil
+	} else if e.loadedTypes[2] {
+		return nil, &NotFoundError{label: recipient.Label}
+	}
+	return nil, &NotLoadedError{edge: "recipient"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*ShareAccessToken) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
@@ -74,7 +89,9 @@ func (*ShareAccessToken) scanValues(columns []string) ([]any, error) {
 			values[i] = new(sql.NullTime)
 		case shareaccesstoken.ForeignKeys[0]: // grant_shareaccesstokens
 			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
-		case shareaccesstoken.ForeignKeys[1]: // ticket_shareaccesstokens
+		case shareaccesstoken.ForeignKeys[1]: // recipient_shareaccesstokens
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		case shareaccesstoken.ForeignKeys[2]: // ticket_shareaccesstokens
 			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
 		default:
 			values[i] = new(sql.UnknownType)
@@ -111,6 +128,13 @@ func (_m *ShareAccessToken) assignValues(columns []string, values []any) error {
 				*_m.grant_shareaccesstokens = *value.S.(*uuid.UUID)
 			}
 		case shareaccesstoken.ForeignKeys[1]:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field recipient_shareaccesstokens", values[i])
+			} else if value.Valid {
+				_m.recipient_shareaccesstokens = new(uuid.UUID)
+				*_m.recipient_shareaccesstokens = *value.S.(*uuid.UUID)
+			}
+		case shareaccesstoken.ForeignKeys[2]:
 			if value, ok := values[i].(*sql.NullScanner); !ok {
 				return fmt.Errorf("unexpected type %T for field ticket_shareaccesstokens", values[i])
 			} else if value.Valid {
@@ -140,6 +164,11 @@ func (_m *ShareAccessToken) QueryGrant() *GrantQuery {
 	return NewShareAccessTokenClient(_m.config).QueryGrant(_m)
 }
 
+// QueryRecipient queries the "recipient" edge of the ShareAccessToken entity.
+func (_m *ShareAccessToken) QueryRecipient() *RecipientQuery {
+	return NewShareAccessTokenClient(_m.config).QueryRecipient(_m)
+}
+
 // Update returns a builder for updating this ShareAccessToken.
 // Note that you need to call ShareAccessToken.Unwrap() before calling this method if this ShareAccessToken
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -18,6 +18,8 @@ const (
 	EdgeTicket = "ticket"
 	// EdgeGrant holds the string denoting the grant edge name in mutations.
 	EdgeGrant = "grant"
+	// EdgeRecipient holds the string denoting the recipient edge name in mutations.
+	EdgeRecipient = "recipient"
 	// Table holds the table name of the shareaccesstoken in the database.
 	Table = "share_access_tokens"
 	// TicketTable is the table that holds the ticket relation/edge.
@@ -34,6 +36,13 @@ const (
 	GrantInverseTable = "grants"
 	// GrantColumn is the table column denoting the grant relation/edge.
 	GrantColumn = "grant_shareaccesstokens"
+	// RecipientTable is the table that holds the recipient relation/edge.
+	RecipientTable = "share_access_tokens"
+	// RecipientInverseTable is the table name for the Recipient entity.
+	// It exists in this package in order to avoid circular dependency with the "recipient" package.
+	RecipientInverseTable = "recipients"
+	// RecipientColumn is the table column denoting the recipient relation/edge.
+	RecipientColumn = "recipient_shareaccesstokens"
 )
 
 // Columns holds all SQL columns for shareaccesstoken fields.
@@ -46,6 +55,7 @@ var Columns = []string{
 // table and are not defined as standalone fields in the schema.
 var ForeignKeys = []string{
 	"grant_shareaccesstokens",
+	"recipient_shareaccesstokens",
 	"ticket_shareaccesstokens",
 }
 
@@ -90,6 +100,13 @@ func ByGrantField(field string, opts ...sql.OrderTermOption) OrderOption {
 		sqlgraph.OrderByNeighborTerms(s, newGrantStep(), sql.OrderByField(field, opts...))
 	}
 }
+
+// ByRecipientField orders the results by recipient field.
+func ByRecipientField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newRecipientStep(), sql.OrderByField(field, opts...))
+	}
+}
 func newTicketStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -104,3 +121,10 @@ func newGrantStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.M2O, true, GrantTable, GrantColumn),
 	)
 }
+func newRecipientStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(RecipientInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, RecipientTable, RecipientColumn),
+	)
+}
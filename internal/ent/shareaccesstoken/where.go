@@ -156,6 +156,29 @@ func HasGrantWith(preds ...predicate.Grant) predicate.ShareAccessToken {
 	})
 }
 
+// HasRecipient applies the HasEdge predicate on the "recipient" edge.
+func HasRecipient() predicate.ShareAccessToken {
+	return predicate.ShareAccessToken(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, RecipientTable, RecipientColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasRecipientWith applies the HasEdge predicate on the "recipient" edge with a given conditions (other predicates).
+func HasRecipientWith(preds ...predicate.Recipient) predicate.ShareAccessToken {
+	return predicate.ShareAccessToken(func(s *sql.Selector) {
+		step := newRecipientStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.ShareAccessToken) predicate.ShareAccessToken {
 	return predicate.ShareAccessToken(sql.AndPredicates(predicates...))
@@ -9,6 +9,7 @@ import (
 	"time"
 
 	"codeberg.org/jvllmr/frans/internal/ent/grant"
+	"codeberg.org/jvllmr/frans/internal/ent/recipient"
 	"codeberg.org/jvllmr/frans/internal/ent/shareaccesstoken"
 	"codeberg.org/jvllmr/frans/internal/ent/ticket"
 	"entgo.io/ent/dialect/sql/sqlgraph"
@@ -73,6 +74,25 @@ func (_c *ShareAccessTokenCreate) SetGrant(v *Grant) *ShareAccessTokenCreate {
 	return _c.SetGrantID(v.ID)
 }
 
+// SetRecipientID sets the "recipient" edge to the Recipient entity by ID.
+func (_c *ShareAccessTokenCreate) SetRecipientID(id uuid.UUID) *ShareAccessTokenCreate {
+	_c.mutation.SetRecipientID(id)
+	return _c
+}
+
+// SetNillableRecipientID sets the "recipient" edge to the Recipient entity by ID if the given value is not nil.
+func (_c *ShareAccessTokenCreate) SetNillableRecipientID(id *uuid.UUID) *ShareAccessTokenCreate {
+	if id != nil {
+		_c = _c.SetRecipientID(*id)
+	}
+	return _c
+}
+
+// SetRecipient sets the "recipient" edge to the Recipient entity.
+func (_c *ShareAccessTokenCreate) SetRecipient(v *Recipient) *ShareAccessTokenCreate {
+	return _c.SetRecipientID(v.ID)
+}
+
 // Mutation returns the ShareAccessTokenMutation object of the builder.
 func (_c *ShareAccessTokenCreate) Mutation() *ShareAccessTokenMutation {
 	return _c.mutation
@@ -183,6 +203,23 @@ func (_c *ShareAccessTokenCreate) createSpec() (*ShareAccessToken, *sqlgraph.Cre
 		_node.grant_shareaccesstokens = &nodes[0]
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := _c.mutation.RecipientIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   shareaccesstoken.RecipientTable,
+			Columns: []string{shareaccesstoken.RecipientColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(recipient.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.recipient_shareaccesstokens = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
@@ -9,6 +9,7 @@ import (
 
 	"codeberg.org/jvllmr/frans/internal/ent/grant"
 	"codeberg.org/jvllmr/frans/internal/ent/predicate"
+	"codeberg.org/jvllmr/frans/internal/ent/recipient"
 	"codeberg.org/jvllmr/frans/internal/ent/shareaccesstoken"
 	"codeberg.org/jvllmr/frans/internal/ent/ticket"
 	"entgo.io/ent"
@@ -21,13 +22,14 @@ import (
 // ShareAccessTokenQuery is the builder for querying ShareAccessToken entities.
 type ShareAccessTokenQuery struct {
 	config
-	ctx        *QueryContext
-	order      []shareaccesstoken.OrderOption
-	inters     []Interceptor
-	predicates []predicate.ShareAccessToken
-	withTicket *TicketQuery
-	withGrant  *GrantQuery
-	withFKs    bool
+	ctx           *QueryContext
+	order         []shareaccesstoken.OrderOption
+	inters        []Interceptor
+	predicates    []predicate.ShareAccessToken
+	withTicket    *TicketQuery
+	withGrant     *GrantQuery
+	withRecipient *RecipientQuery
+	withFKs       bool
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -108,6 +110,28 @@ func (_q *ShareAccessTokenQuery) QueryGrant() *GrantQuery {
 	return query
 }
 
+// QueryRecipient chains the current query on the "recipient" edge.
+func (_q *ShareAccessTokenQuery) QueryRecipient() *RecipientQuery {
+	query := (&RecipientClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(shareaccesstoken.Table, shareaccesstoken.FieldID, selector),
+			sqlgraph.To(recipient.Table, recipient.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, shareaccesstoken.RecipientTable, shareaccesstoken.RecipientColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first ShareAccessToken entity from the query.
 // Returns a *NotFoundError when no ShareAccessToken was found.
 func (_q *ShareAccessTokenQuery) First(ctx context.Context) (*ShareAccessToken, error) {
@@ -295,13 +319,14 @@ func (_q *ShareAccessTokenQuery) Clone() *ShareAccessTokenQuery {
 		return nil
 	}
 	return &ShareAccessTokenQuery{
-		config:     _q.config,
-		ctx:        _q.ctx.Clone(),
-		order:      append([]shareaccesstoken.OrderOption{}, _q.order...),
-		inters:     append([]Interceptor{}, _q.inters...),
-		predicates: append([]predicate.ShareAccessToken{}, _q.predicates...),
-		withTicket: _q.withTicket.Clone(),
-		withGrant:  _q.withGrant.Clone(),
+		config:        _q.config,
+		ctx:           _q.ctx.Clone(),
+		order:         append([]shareaccesstoken.OrderOption{}, _q.order...),
+		inters:        append([]Interceptor{}, _q.inters...),
+		predicates:    append([]predicate.ShareAccessToken{}, _q.predicates...),
+		withTicket:    _q.withTicket.Clone(),
+		withGrant:     _q.withGrant.Clone(),
+		withRecipient: _q.withRecipient.Clone(),
 		// clone intermediate query.
 		sql:  _q.sql.Clone(),
 		path: _q.path,
@@ -330,6 +355,17 @@ func (_q *ShareAccessTokenQuery) WithGrant(opts ...func(*GrantQuery)) *ShareAcce
 	return _q
 }
 
+// WithRecipient tells the query-builder to eager-load the nodes that are connected to
+// the "recipient" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *ShareAccessTokenQuery) WithRecipient(opts ...func(*RecipientQuery)) *ShareAccessTokenQuery {
+	query := (&RecipientClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withRecipient = query
+	return _q
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -409,12 +445,13 @@ func (_q *ShareAccessTokenQuery) sqlAll(ctx context.Context, hooks ...queryHook)
 		nodes       = []*ShareAccessToken{}
 		withFKs     = _q.withFKs
 		_spec       = _q.querySpec()
-		loadedTypes = [2]bool{
+		loadedTypes = [3]bool{
 			_q.withTicket != nil,
 			_q.withGrant != nil,
+			_q.withRecipient != nil,
 		}
 	)
-	if _q.withTicket != nil || _q.withGrant != nil {
+	if _q.withTicket != nil || _q.withGrant != nil || _q.withRecipient != nil {
 		withFKs = true
 	}
 	if withFKs {
@@ -450,6 +487,12 @@ func (_q *ShareAccessTokenQuery) sqlAll(ctx context.Context, hooks ...queryHook)
 			return nil, err
 		}
 	}
+	if query := _q.withRecipient; query != nil {
+		if err := _q.loadRecipient(ctx, query, nodes, nil,
+			func(n *ShareAccessToken, e *Recipient) { n.Edges.Recipient = e }); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -517,6 +560,38 @@ func (_q *ShareAccessTokenQuery) loadGrant(ctx context.Context, query *GrantQuer
 	}
 	return nil
 }
+func (_q *ShareAccessTokenQuery) loadRecipient(ctx context.Context, query *RecipientQuery, nodes []*ShareAccessToken, init func(*ShareAccessToken), assign func(*ShareAccessToken, *Recipient)) error {
+	ids := make([]uuid.UUID, 0, len(nodes))
+	nodeids := make(map[uuid.UUID][]*ShareAccessToken)
+	for i := range nodes {
+		if nodes[i].recipient_shareaccesstokens == nil {
+			continue
+		}
+		fk := *nodes[i].recipient_shareaccesstokens
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(recipient.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "recipient_shareaccesstokens" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
 
 func (_q *ShareAccessTokenQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := _q.querySpec()
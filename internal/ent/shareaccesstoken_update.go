@@ -10,6 +10,7 @@ import (
 
 	"codeberg.org/jvllmr/frans/internal/ent/grant"
 	"codeberg.org/jvllmr/frans/internal/ent/predicate"
+	"codeberg.org/jvllmr/frans/internal/ent/recipient"
 	"codeberg.org/jvllmr/frans/internal/ent/shareaccesstoken"
 	"codeberg.org/jvllmr/frans/internal/ent/ticket"
 	"entgo.io/ent/dialect/sql"
@@ -83,6 +84,25 @@ func (_u *ShareAccessTokenUpdate) SetGrant(v *Grant) *ShareAccessTokenUpdate {
 	return _u.SetGrantID(v.ID)
 }
 
+// SetRecipientID sets the "recipient" edge to the Recipient entity by ID.
+func (_u *ShareAccessTokenUpdate) SetRecipientID(id uuid.UUID) *ShareAccessTokenUpdate {
+	_u.mutation.SetRecipientID(id)
+	return _u
+}
+
+// SetNillableRecipientID sets the "recipient" edge to the Recipient entity by ID if the given value is not nil.
+func (_u *ShareAccessTokenUpdate) SetNillableRecipientID(id *uuid.UUID) *ShareAccessTokenUpdate {
+	if id != nil {
+		_u = _u.SetRecipientID(*id)
+	}
+	return _u
+}
+
+// SetRecipient sets the "recipient" edge to the Recipient entity.
+func (_u *ShareAccessTokenUpdate) SetRecipient(v *Recipient) *ShareAccessTokenUpdate {
+	return _u.SetRecipientID(v.ID)
+}
+
 // Mutation returns the ShareAccessTokenMutation object of the builder.
 func (_u *ShareAccessTokenUpdate) Mutation() *ShareAccessTokenMutation {
 	return _u.mutation
@@ -100,6 +120,12 @@ func (_u *ShareAccessTokenUpdate) ClearGrant() *ShareAccessTokenUpdate {
 	return _u
 }
 
+// ClearRecipient clears the "recipient" edge to the Recipient entity.
+func (_u *ShareAccessTokenUpdate) ClearRecipient() *ShareAccessTokenUpdate {
+	_u.mutation.ClearRecipient()
+	return _u
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (_u *ShareAccessTokenUpdate) Save(ctx context.Context) (int, error) {
 	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
@@ -197,6 +223,35 @@ func (_u *ShareAccessTokenUpdate) sqlSave(ctx context.Context) (_node int, err e
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.RecipientCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   shareaccesstoken.RecipientTable,
+			Columns: []string{shareaccesstoken.RecipientColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(recipient.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RecipientIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   shareaccesstoken.RecipientTable,
+			Columns: []string{shareaccesstoken.RecipientColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(recipient.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{shareaccesstoken.Label}
@@ -269,6 +324,25 @@ func (_u *ShareAccessTokenUpdateOne) SetGrant(v *Grant) *ShareAccessTokenUpdateO
 	return _u.SetGrantID(v.ID)
 }
 
+// SetRecipientID sets the "recipient" edge to the Recipient entity by ID.
+func (_u *ShareAccessTokenUpdateOne) SetRecipientID(id uuid.UUID) *ShareAccessTokenUpdateOne {
+	_u.mutation.SetRecipientID(id)
+	return _u
+}
+
+// SetNillableRecipientID sets the "recipient" edge to the Recipient entity by ID if the given value is not nil.
+func (_u *ShareAccessTokenUpdateOne) SetNillableRecipientID(id *uuid.UUID) *ShareAccessTokenUpdateOne {
+	if id != nil {
+		_u = _u.SetRecipientID(*id)
+	}
+	return _u
+}
+
+// SetRecipient sets the "recipient" edge to the Recipient entity.
+func (_u *ShareAccessTokenUpdateOne) SetRecipient(v *Recipient) *ShareAccessTokenUpdateOne {
+	return _u.SetRecipientID(v.ID)
+}
+
 // Mutation returns the ShareAccessTokenMutation object of the builder.
 func (_u *ShareAccessTokenUpdateOne) Mutation() *ShareAccessTokenMutation {
 	return _u.mutation
@@ -286,6 +360,12 @@ func (_u *ShareAccessTokenUpdateOne) ClearGrant() *ShareAccessTokenUpdateOne {
 	return _u
 }
 
+// ClearRecipient clears the "recipient" edge to the Recipient entity.
+func (_u *ShareAccessTokenUpdateOne) ClearRecipient() *ShareAccessTokenUpdateOne {
+	_u.mutation.ClearRecipient()
+	return _u
+}
+
 // Where appends a list predicates to the ShareAccessTokenUpdate builder.
 func (_u *ShareAccessTokenUpdateOne) Where(ps ...predicate.ShareAccessToken) *ShareAccessTokenUpdateOne {
 	_u.mutation.Where(ps...)
@@ -413,6 +493,35 @@ func (_u *ShareAccessTokenUpdateOne) sqlSave(ctx context.Context) (_node *ShareA
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.RecipientCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   shareaccesstoken.RecipientTable,
+			Columns: []string{shareaccesstoken.RecipientColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(recipient.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RecipientIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   shareaccesstoken.RecipientTable,
+			Columns: []string{shareaccesstoken.RecipientColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(recipient.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &ShareAccessToken{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
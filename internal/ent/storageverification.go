@@ -0,0 +1,146 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"codeberg.org/jvllmr/frans/internal/ent/storageverification"
+	"codeberg.org/jvllmr/frans/internal/storage"
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// StorageVerification is the model entity for the StorageVerification schema.
+type StorageVerification struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// StartedAt holds the value of the "started_at" field.
+	StartedAt time.Time `json:"started_at,omitempty"`
+	// FinishedAt holds the value of the "finished_at" field.
+	FinishedAt *time.Time `json:"finished_at,omitempty"`
+	// CheckedBlobs holds the value of the "checked_blobs" field.
+	CheckedBlobs int64 `json:"checked_blobs,omitempty"`
+	// Issues holds the value of the "issues" field.
+	Issues       []storage.Issue `json:"issues,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*StorageVerification) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case storageverification.FieldIssues:
+			values[i] = new([]byte)
+		case storageverification.FieldID, storageverification.FieldCheckedBlobs:
+			values[i] = new(sql.NullInt64)
+		case storageverification.FieldStartedAt, storageverification.FieldFinishedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the StorageVerification fields.
+func (_m *StorageVerification) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case storageverification.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case storageverification.FieldStartedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field started_at", values[i])
+			} else if value.Valid {
+				_m.StartedAt = value.Time
+			}
+		case storageverification.FieldFinishedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field finished_at", values[i])
+			} else if value.Valid {
+				_m.FinishedAt = new(time.Time)
+				*_m.FinishedAt = value.Time
+			}
+		case storageverification.FieldCheckedBlobs:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field checked_blobs", values[i])
+			} else if value.Valid {
+				_m.CheckedBlobs = value.Int64
+			}
+		case storageverification.FieldIssues:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field issues", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.Issues); err != nil {
+					return fmt.Errorf("unmarshal field issues: %w", err)
+				}
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the StorageVerification.
+// This includes values selected through modifiers, order, etc.
+func (_m *StorageVerification) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this StorageVerification.
+// Note that you need to call StorageVerification.Unwrap() before calling this method if this StorageVerification
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *StorageVerification) Update() *StorageVerificationUpdateOne {
+	return NewStorageVerificationClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the StorageVerification entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *StorageVerification) Unwrap() *StorageVerification {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: StorageVerification is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *StorageVerification) String() string {
+	var builder strings.Builder
+	builder.WriteString("StorageVerification(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("started_at=")
+	builder.WriteString(_m.StartedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := _m.FinishedAt; v != nil {
+		builder.WriteString("finished_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("checked_blobs=")
+	builder.WriteString(fmt.Sprintf("%v", _m.CheckedBlobs))
+	builder.WriteString(", ")
+	builder.WriteString("issues=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Issues))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// StorageVerifications is a parsable slice of StorageVerification.
+type StorageVerifications []*StorageVerification
@@ -0,0 +1,75 @@
+// Code generated by ent, DO NOT EDIT.
+
+package storageverification
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the storageverification type in the database.
+	Label = "storage_verification"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldStartedAt holds the string denoting the started_at field in the database.
+	FieldStartedAt = "started_at"
+	// FieldFinishedAt holds the string denoting the finished_at field in the database.
+	FieldFinishedAt = "finished_at"
+	// FieldCheckedBlobs holds the string denoting the checked_blobs field in the database.
+	FieldCheckedBlobs = "checked_blobs"
+	// FieldIssues holds the string denoting the issues field in the database.
+	FieldIssues = "issues"
+	// Table holds the table name of the storageverification in the database.
+	Table = "storage_verifications"
+)
+
+// Columns holds all SQL columns for storageverification fields.
+var Columns = []string{
+	FieldID,
+	FieldStartedAt,
+	FieldFinishedAt,
+	FieldCheckedBlobs,
+	FieldIssues,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultStartedAt holds the default value on creation for the "started_at" field.
+	DefaultStartedAt func() time.Time
+	// DefaultCheckedBlobs holds the default value on creation for the "checked_blobs" field.
+	DefaultCheckedBlobs int64
+)
+
+// OrderOption defines the ordering options for the StorageVerification queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByStartedAt orders the results by the started_at field.
+func ByStartedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStartedAt, opts...).ToFunc()
+}
+
+// ByFinishedAt orders the results by the finished_at field.
+func ByFinishedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFinishedAt, opts...).ToFunc()
+}
+
+// ByCheckedBlobs orders the results by the checked_blobs field.
+func ByCheckedBlobs(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCheckedBlobs, opts...).ToFunc()
+}
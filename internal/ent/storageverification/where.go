@@ -0,0 +1,225 @@
+// Code generated by ent, DO NOT EDIT.
+
+package storageverification
+
+import (
+	"time"
+
+	"codeberg.org/jvllmr/frans/internal/ent/predicate"
+	"entgo.io/ent/dialect/sql"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.StorageVerification {
+	return predicate.StorageVerification(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.StorageVerification {
+	return predicate.StorageVerification(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.StorageVerification {
+	return predicate.StorageVerification(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.StorageVerification {
+	return predicate.StorageVerification(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.StorageVerification {
+	return predicate.StorageVerification(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.StorageVerification {
+	return predicate.StorageVerification(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.StorageVerification {
+	return predicate.StorageVerification(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.StorageVerification {
+	return predicate.StorageVerification(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.StorageVerification {
+	return predicate.StorageVerification(sql.FieldLTE(FieldID, id))
+}
+
+// StartedAt applies equality check predicate on the "started_at" field. It's identical to StartedAtEQ.
+func StartedAt(v time.Time) predicate.StorageVerification {
+	return predicate.StorageVerification(sql.FieldEQ(FieldStartedAt, v))
+}
+
+// FinishedAt applies equality check predicate on the "finished_at" field. It's identical to FinishedAtEQ.
+func FinishedAt(v time.Time) predicate.StorageVerification {
+	return predicate.StorageVerification(sql.FieldEQ(FieldFinishedAt, v))
+}
+
+// CheckedBlobs applies equality check predicate on the "checked_blobs" field. It's identical to CheckedBlobsEQ.
+func CheckedBlobs(v int64) predicate.StorageVerification {
+	return predicate.StorageVerification(sql.FieldEQ(FieldCheckedBlobs, v))
+}
+
+// StartedAtEQ applies the EQ predicate on the "started_at" field.
+func StartedAtEQ(v time.Time) predicate.StorageVerification {
+	return predicate.StorageVerification(sql.FieldEQ(FieldStartedAt, v))
+}
+
+// StartedAtNEQ applies the NEQ predicate on the "started_at" field.
+func StartedAtNEQ(v time.Time) predicate.StorageVerification {
+	return predicate.StorageVerification(sql.FieldNEQ(FieldStartedAt, v))
+}
+
+// StartedAtIn applies the In predicate on the "started_at" field.
+func StartedAtIn(vs ...time.Time) predicate.StorageVerification {
+	return predicate.StorageVerification(sql.FieldIn(FieldStartedAt, vs...))
+}
+
+// StartedAtNotIn applies the NotIn predicate on the "started_at" field.
+func StartedAtNotIn(vs ...time.Time) predicate.StorageVerification {
+	return predicate.StorageVerification(sql.FieldNotIn(FieldStartedAt, vs...))
+}
+
+// StartedAtGT applies the GT predicate on the "started_at" field.
+func StartedAtGT(v time.Time) predicate.StorageVerification {
+	return predicate.StorageVerification(sql.FieldGT(FieldStartedAt, v))
+}
+
+// StartedAtGTE applies the GTE predicate on the "started_at" field.
+func StartedAtGTE(v time.Time) predicate.StorageVerification {
+	return predicate.StorageVerification(sql.FieldGTE(FieldStartedAt, v))
+}
+
+// StartedAtLT applies the LT predicate on the "started_at" field.
+func StartedAtLT(v time.Time) predicate.StorageVerification {
+	return predicate.StorageVerification(sql.FieldLT(FieldStartedAt, v))
+}
+
+// StartedAtLTE applies the LTE predicate on the "started_at" field.
+func StartedAtLTE(v time.Time) predicate.StorageVerification {
+	return predicate.StorageVerification(sql.FieldLTE(FieldStartedAt, v))
+}
+
+// FinishedAtEQ applies the EQ predicate on the "finished_at" field.
+func FinishedAtEQ(v time.Time) predicate.StorageVerification {
+	return predicate.StorageVerification(sql.FieldEQ(FieldFinishedAt, v))
+}
+
+// FinishedAtNEQ applies the NEQ predicate on the "finished_at" field.
+func FinishedAtNEQ(v time.Time) predicate.StorageVerification {
+	return predicate.StorageVerification(sql.FieldNEQ(FieldFinishedAt, v))
+}
+
+// FinishedAtIn applies the In predicate on the "finished_at" field.
+func FinishedAtIn(vs ...time.Time) predicate.StorageVerification {
+	return predicate.StorageVerification(sql.FieldIn(FieldFinishedAt, vs...))
+}
+
+// FinishedAtNotIn applies the NotIn predicate on the "finished_at" field.
+func FinishedAtNotIn(vs ...time.Time) predicate.StorageVerification {
+	return predicate.StorageVerification(sql.FieldNotIn(FieldFinishedAt, vs...))
+}
+
+// FinishedAtGT applies the GT predicate on the "finished_at" field.
+func FinishedAtGT(v time.Time) predicate.StorageVerification {
+	return predicate.StorageVerification(sql.FieldGT(FieldFinishedAt, v))
+}
+
+// FinishedAtGTE applies the GTE predicate on the "finished_at" field.
+func FinishedAtGTE(v time.Time) predicate.StorageVerification {
+	return predicate.StorageVerification(sql.FieldGTE(FieldFinishedAt, v))
+}
+
+// FinishedAtLT applies the LT predicate on the "finished_at" field.
+func FinishedAtLT(v time.Time) predicate.StorageVerification {
+	return predicate.StorageVerification(sql.FieldLT(FieldFinishedAt, v))
+}
+
+// FinishedAtLTE applies the LTE predicate on the "finished_at" field.
+func FinishedAtLTE(v time.Time) predicate.StorageVerification {
+	return predicate.StorageVerification(sql.FieldLTE(FieldFinishedAt, v))
+}
+
+// FinishedAtIsNil applies the IsNil predicate on the "finished_at" field.
+func FinishedAtIsNil() predicate.StorageVerification {
+	return predicate.StorageVerification(sql.FieldIsNull(FieldFinishedAt))
+}
+
+// FinishedAtNotNil applies the NotNil predicate on the "finished_at" field.
+func FinishedAtNotNil() predicate.StorageVerification {
+	return predicate.StorageVerification(sql.FieldNotNull(FieldFinishedAt))
+}
+
+// CheckedBlobsEQ applies the EQ predicate on the "checked_blobs" field.
+func CheckedBlobsEQ(v int64) predicate.StorageVerification {
+	return predicate.StorageVerification(sql.FieldEQ(FieldCheckedBlobs, v))
+}
+
+// CheckedBlobsNEQ applies the NEQ predicate on the "checked_blobs" field.
+func CheckedBlobsNEQ(v int64) predicate.StorageVerification {
+	return predicate.StorageVerification(sql.FieldNEQ(FieldCheckedBlobs, v))
+}
+
+// CheckedBlobsIn applies the In predicate on the "checked_blobs" field.
+func CheckedBlobsIn(vs ...int64) predicate.StorageVerification {
+	return predicate.StorageVerification(sql.FieldIn(FieldCheckedBlobs, vs...))
+}
+
+// CheckedBlobsNotIn applies the NotIn predicate on the "checked_blobs" field.
+func CheckedBlobsNotIn(vs ...int64) predicate.StorageVerification {
+	return predicate.StorageVerification(sql.FieldNotIn(FieldCheckedBlobs, vs...))
+}
+
+// CheckedBlobsGT applies the GT predicate on the "checked_blobs" field.
+func CheckedBlobsGT(v int64) predicate.StorageVerification {
+	return predicate.StorageVerification(sql.FieldGT(FieldCheckedBlobs, v))
+}
+
+// CheckedBlobsGTE applies the GTE predicate on the "checked_blobs" field.
+func CheckedBlobsGTE(v int64) predicate.StorageVerification {
+	return predicate.StorageVerification(sql.FieldGTE(FieldCheckedBlobs, v))
+}
+
+// CheckedBlobsLT applies the LT predicate on the "checked_blobs" field.
+func CheckedBlobsLT(v int64) predicate.StorageVerification {
+	return predicate.StorageVerification(sql.FieldLT(FieldCheckedBlobs, v))
+}
+
+// CheckedBlobsLTE applies the LTE predicate on the "checked_blobs" field.
+func CheckedBlobsLTE(v int64) predicate.StorageVerification {
+	return predicate.StorageVerification(sql.FieldLTE(FieldCheckedBlobs, v))
+}
+
+// IssuesIsNil applies the IsNil predicate on the "issues" field.
+func IssuesIsNil() predicate.StorageVerification {
+	return predicate.StorageVerification(sql.FieldIsNull(FieldIssues))
+}
+
+// IssuesNotNil applies the NotNil predicate on the "issues" field.
+func IssuesNotNil() predicate.StorageVerification {
+	return predicate.StorageVerification(sql.FieldNotNull(FieldIssues))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.StorageVerification) predicate.StorageVerification {
+	return predicate.StorageVerification(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.StorageVerification) predicate.StorageVerification {
+	return predicate.StorageVerification(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.StorageVerification) predicate.StorageVerification {
+	return predicate.StorageVerification(sql.NotPredicates(p))
+}
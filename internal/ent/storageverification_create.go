@@ -0,0 +1,256 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"codeberg.org/jvllmr/frans/internal/ent/storageverification"
+	"codeberg.org/jvllmr/frans/internal/storage"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// StorageVerificationCreate is the builder for creating a StorageVerification entity.
+type StorageVerificationCreate struct {
+	config
+	mutation *StorageVerificationMutation
+	hooks    []Hook
+}
+
+// SetStartedAt sets the "started_at" field.
+func (_c *StorageVerificationCreate) SetStartedAt(v time.Time) *StorageVerificationCreate {
+	_c.mutation.SetStartedAt(v)
+	return _c
+}
+
+// SetNillableStartedAt sets the "started_at" field if the given value is not nil.
+func (_c *StorageVerificationCreate) SetNillableStartedAt(v *time.Time) *StorageVerificationCreate {
+	if v != nil {
+		_c.SetStartedAt(*v)
+	}
+	return _c
+}
+
+// SetFinishedAt sets the "finished_at" field.
+func (_c *StorageVerificationCreate) SetFinishedAt(v time.Time) *StorageVerificationCreate {
+	_c.mutation.SetFinishedAt(v)
+	return _c
+}
+
+// SetNillableFinishedAt sets the "finished_at" field if the given value is not nil.
+func (_c *StorageVerificationCreate) SetNillableFinishedAt(v *time.Time) *StorageVerificationCreate {
+	if v != nil {
+		_c.SetFinishedAt(*v)
+	}
+	return _c
+}
+
+// SetCheckedBlobs sets the "checked_blobs" field.
+func (_c *StorageVerificationCreate) SetCheckedBlobs(v int64) *StorageVerificationCreate {
+	_c.mutation.SetCheckedBlobs(v)
+	return _c
+}
+
+// SetNillableCheckedBlobs sets the "checked_blobs" field if the given value is not nil.
+func (_c *StorageVerificationCreate) SetNillableCheckedBlobs(v *int64) *StorageVerificationCreate {
+	if v != nil {
+		_c.SetCheckedBlobs(*v)
+	}
+	return _c
+}
+
+// SetIssues sets the "issues" field.
+func (_c *StorageVerificationCreate) SetIssues(v []storage.Issue) *StorageVerificationCreate {
+	_c.mutation.SetIssues(v)
+	return _c
+}
+
+// Mutation returns the StorageVerificationMutation object of the builder.
+func (_c *StorageVerificationCreate) Mutation() *StorageVerificationMutation {
+	return _c.mutation
+}
+
+// Save creates the StorageVerification in the database.
+func (_c *StorageVerificationCreate) Save(ctx context.Context) (*StorageVerification, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *StorageVerificationCreate) SaveX(ctx context.Context) *StorageVerification {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *StorageVerificationCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *StorageVerificationCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *StorageVerificationCreate) defaults() {
+	if _, ok := _c.mutation.StartedAt(); !ok {
+		v := storageverification.DefaultStartedAt()
+		_c.mutation.SetStartedAt(v)
+	}
+	if _, ok := _c.mutation.CheckedBlobs(); !ok {
+		v := storageverification.DefaultCheckedBlobs
+		_c.mutation.SetCheckedBlobs(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *StorageVerificationCreate) check() error {
+	if _, ok := _c.mutation.StartedAt(); !ok {
+		return &ValidationError{Name: "started_at", err: errors.New(`ent: missing required field "StorageVerification.started_at"`)}
+	}
+	if _, ok := _c.mutation.CheckedBlobs(); !ok {
+		return &ValidationError{Name: "checked_blobs", err: errors.New(`ent: missing required field "StorageVerification.checked_blobs"`)}
+	}
+	return nil
+}
+
+func (_c *StorageVerificationCreate) sqlSave(ctx context.Context) (*StorageVerification, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *StorageVerificationCreate) createSpec() (*StorageVerification, *sqlgraph.CreateSpec) {
+	var (
+		_node = &StorageVerification{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(storageverification.Table, sqlgraph.NewFieldSpec(storageverification.FieldID, field.TypeInt))
+	)
+	if value, ok := _c.mutation.StartedAt(); ok {
+		_spec.SetField(storageverification.FieldStartedAt, field.TypeTime, value)
+		_node.StartedAt = value
+	}
+	if value, ok := _c.mutation.FinishedAt(); ok {
+		_spec.SetField(storageverification.FieldFinishedAt, field.TypeTime, value)
+		_node.FinishedAt = &value
+	}
+	if value, ok := _c.mutation.CheckedBlobs(); ok {
+		_spec.SetField(storageverification.FieldCheckedBlobs, field.TypeInt64, value)
+		_node.CheckedBlobs = value
+	}
+	if value, ok := _c.mutation.Issues(); ok {
+		_spec.SetField(storageverification.FieldIssues, field.TypeJSON, value)
+		_node.Issues = value
+	}
+	return _node, _spec
+}
+
+// StorageVerificationCreateBulk is the builder for creating many StorageVerification entities in bulk.
+type StorageVerificationCreateBulk struct {
+	config
+	err      error
+	builders []*StorageVerificationCreate
+}
+
+// Save creates the StorageVerification entities in the database.
+func (_c *StorageVerificationCreateBulk) Save(ctx context.Context) ([]*StorageVerification, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*StorageVerification, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*StorageVerificationMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *StorageVerificationCreateBulk) SaveX(ctx context.Context) []*StorageVerification {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *StorageVerificationCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *StorageVerificationCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,376 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"codeberg.org/jvllmr/frans/internal/ent/predicate"
+	"codeberg.org/jvllmr/frans/internal/ent/storageverification"
+	"codeberg.org/jvllmr/frans/internal/storage"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+)
+
+// StorageVerificationUpdate is the builder for updating StorageVerification entities.
+type StorageVerificationUpdate struct {
+	config
+	hooks    []Hook
+	mutation *StorageVerificationMutation
+}
+
+// Where appends a list predicates to the StorageVerificationUpdate builder.
+func (_u *StorageVerificationUpdate) Where(ps ...predicate.StorageVerification) *StorageVerificationUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetStartedAt sets the "started_at" field.
+func (_u *StorageVerificationUpdate) SetStartedAt(v time.Time) *StorageVerificationUpdate {
+	_u.mutation.SetStartedAt(v)
+	return _u
+}
+
+// SetNillableStartedAt sets the "started_at" field if the given value is not nil.
+func (_u *StorageVerificationUpdate) SetNillableStartedAt(v *time.Time) *StorageVerificationUpdate {
+	if v != nil {
+		_u.SetStartedAt(*v)
+	}
+	return _u
+}
+
+// SetFinishedAt sets the "finished_at" field.
+func (_u *StorageVerificationUpdate) SetFinishedAt(v time.Time) *StorageVerificationUpdate {
+	_u.mutation.SetFinishedAt(v)
+	return _u
+}
+
+// SetNillableFinishedAt sets the "finished_at" field if the given value is not nil.
+func (_u *StorageVerificationUpdate) SetNillableFinishedAt(v *time.Time) *StorageVerificationUpdate {
+	if v != nil {
+		_u.SetFinishedAt(*v)
+	}
+	return _u
+}
+
+// ClearFinishedAt clears the value of the "finished_at" field.
+func (_u *StorageVerificationUpdate) ClearFinishedAt() *StorageVerificationUpdate {
+	_u.mutation.ClearFinishedAt()
+	return _u
+}
+
+// SetCheckedBlobs sets the "checked_blobs" field.
+func (_u *StorageVerificationUpdate) SetCheckedBlobs(v int64) *StorageVerificationUpdate {
+	_u.mutation.ResetCheckedBlobs()
+	_u.mutation.SetCheckedBlobs(v)
+	return _u
+}
+
+// SetNillableCheckedBlobs sets the "checked_blobs" field if the given value is not nil.
+func (_u *StorageVerificationUpdate) SetNillableCheckedBlobs(v *int64) *StorageVerificationUpdate {
+	if v != nil {
+		_u.SetCheckedBlobs(*v)
+	}
+	return _u
+}
+
+// AddCheckedBlobs adds value to the "checked_blobs" field.
+func (_u *StorageVerificationUpdate) AddCheckedBlobs(v int64) *StorageVerificationUpdate {
+	_u.mutation.AddCheckedBlobs(v)
+	return _u
+}
+
+// SetIssues sets the "issues" field.
+func (_u *StorageVerificationUpdate) SetIssues(v []storage.Issue) *StorageVerificationUpdate {
+	_u.mutation.SetIssues(v)
+	return _u
+}
+
+// AppendIssues appends value to the "issues" field.
+func (_u *StorageVerificationUpdate) AppendIssues(v []storage.Issue) *StorageVerificationUpdate {
+	_u.mutation.AppendIssues(v)
+	return _u
+}
+
+// ClearIssues clears the value of the "issues" field.
+func (_u *StorageVerificationUpdate) ClearIssues() *StorageVerificationUpdate {
+	_u.mutation.ClearIssues()
+	return _u
+}
+
+// Mutation returns the StorageVerificationMutation object of the builder.
+func (_u *StorageVerificationUpdate) Mutation() *StorageVerificationMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *StorageVerificationUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *StorageVerificationUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *StorageVerificationUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *StorageVerificationUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (_u *StorageVerificationUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(storageverification.Table, storageverification.Columns, sqlgraph.NewFieldSpec(storageverification.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.StartedAt(); ok {
+		_spec.SetField(storageverification.FieldStartedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.FinishedAt(); ok {
+		_spec.SetField(storageverification.FieldFinishedAt, field.TypeTime, value)
+	}
+	if _u.mutation.FinishedAtCleared() {
+		_spec.ClearField(storageverification.FieldFinishedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.CheckedBlobs(); ok {
+		_spec.SetField(storageverification.FieldCheckedBlobs, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedCheckedBlobs(); ok {
+		_spec.AddField(storageverification.FieldCheckedBlobs, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.Issues(); ok {
+		_spec.SetField(storageverification.FieldIssues, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedIssues(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, storageverification.FieldIssues, value)
+		})
+	}
+	if _u.mutation.IssuesCleared() {
+		_spec.ClearField(storageverification.FieldIssues, field.TypeJSON)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{storageverification.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// StorageVerificationUpdateOne is the builder for updating a single StorageVerification entity.
+type StorageVerificationUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *StorageVerificationMutation
+}
+
+// SetStartedAt sets the "started_at" field.
+func (_u *StorageVerificationUpdateOne) SetStartedAt(v time.Time) *StorageVerificationUpdateOne {
+	_u.mutation.SetStartedAt(v)
+	return _u
+}
+
+// SetNillableStartedAt sets the "started_at" field if the given value is not nil.
+func (_u *StorageVerificationUpdateOne) SetNillableStartedAt(v *time.Time) *StorageVerificationUpdateOne {
+	if v != nil {
+		_u.SetStartedAt(*v)
+	}
+	return _u
+}
+
+// SetFinishedAt sets the "finished_at" field.
+func (_u *StorageVerificationUpdateOne) SetFinishedAt(v time.Time) *StorageVerificationUpdateOne {
+	_u.mutation.SetFinishedAt(v)
+	return _u
+}
+
+// SetNillableFinishedAt sets the "finished_at" field if the given value is not nil.
+func (_u *StorageVerificationUpdateOne) SetNillableFinishedAt(v *time.Time) *StorageVerificationUpdateOne {
+	if v != nil {
+		_u.SetFinishedAt(*v)
+	}
+	return _u
+}
+
+// ClearFinishedAt clears the value of the "finished_at" field.
+func (_u *StorageVerificationUpdateOne) ClearFinishedAt() *StorageVerificationUpdateOne {
+	_u.mutation.ClearFinishedAt()
+	return _u
+}
+
+// SetCheckedBlobs sets the "checked_blobs" field.
+func (_u *StorageVerificationUpdateOne) SetCheckedBlobs(v int64) *StorageVerificationUpdateOne {
+	_u.mutation.ResetCheckedBlobs()
+	_u.mutation.SetCheckedBlobs(v)
+	return _u
+}
+
+// SetNillableCheckedBlobs sets the "checked_blobs" field if the given value is not nil.
+func (_u *StorageVerificationUpdateOne) SetNillableCheckedBlobs(v *int64) *StorageVerificationUpdateOne {
+	if v != nil {
+		_u.SetCheckedBlobs(*v)
+	}
+	return _u
+}
+
+// AddCheckedBlobs adds value to the "checked_blobs" field.
+func (_u *StorageVerificationUpdateOne) AddCheckedBlobs(v int64) *StorageVerificationUpdateOne {
+	_u.mutation.AddCheckedBlobs(v)
+	return _u
+}
+
+// SetIssues sets the "issues" field.
+func (_u *StorageVerificationUpdateOne) SetIssues(v []storage.Issue) *StorageVerificationUpdateOne {
+	_u.mutation.SetIssues(v)
+	return _u
+}
+
+// AppendIssues appends value to the "issues" field.
+func (_u *StorageVerificationUpdateOne) AppendIssues(v []storage.Issue) *StorageVerificationUpdateOne {
+	_u.mutation.AppendIssues(v)
+	return _u
+}
+
+// ClearIssues clears the value of the "issues" field.
+func (_u *StorageVerificationUpdateOne) ClearIssues() *StorageVerificationUpdateOne {
+	_u.mutation.ClearIssues()
+	return _u
+}
+
+// Mutation returns the StorageVerificationMutation object of the builder.
+func (_u *StorageVerificationUpdateOne) Mutation() *StorageVerificationMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the StorageVerificationUpdate builder.
+func (_u *StorageVerificationUpdateOne) Where(ps ...predicate.StorageVerification) *StorageVerificationUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *StorageVerificationUpdateOne) Select(field string, fields ...string) *StorageVerificationUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated StorageVerification entity.
+func (_u *StorageVerificationUpdateOne) Save(ctx context.Context) (*StorageVerification, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *StorageVerificationUpdateOne) SaveX(ctx context.Context) *StorageVerification {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *StorageVerificationUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *StorageVerificationUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (_u *StorageVerificationUpdateOne) sqlSave(ctx context.Context) (_node *StorageVerification, err error) {
+	_spec := sqlgraph.NewUpdateSpec(storageverification.Table, storageverification.Columns, sqlgraph.NewFieldSpec(storageverification.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "StorageVerification.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, storageverification.FieldID)
+		for _, f := range fields {
+			if !storageverification.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != storageverification.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.StartedAt(); ok {
+		_spec.SetField(storageverification.FieldStartedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.FinishedAt(); ok {
+		_spec.SetField(storageverification.FieldFinishedAt, field.TypeTime, value)
+	}
+	if _u.mutation.FinishedAtCleared() {
+		_spec.ClearField(storageverification.FieldFinishedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.CheckedBlobs(); ok {
+		_spec.SetField(storageverification.FieldCheckedBlobs, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedCheckedBlobs(); ok {
+		_spec.AddField(storageverification.FieldCheckedBlobs, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.Issues(); ok {
+		_spec.SetField(storageverification.FieldIssues, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedIssues(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, storageverification.FieldIssues, value)
+		})
+	}
+	if _u.mutation.IssuesCleared() {
+		_spec.ClearField(storageverification.FieldIssues, field.TypeJSON)
+	}
+	_node = &StorageVerification{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{storageverification.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
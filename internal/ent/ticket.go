@@ -40,6 +40,8 @@ type Ticket struct {
 	EmailOnDownload []string `json:"email_on_download,omitempty"`
 	// CreatorLang holds the value of the "creator_lang" field.
 	CreatorLang string `json:"creator_lang,omitempty"`
+	// ViewsCountAsDownloads holds the value of the "views_count_as_downloads" field.
+	ViewsCountAsDownloads bool `json:"views_count_as_downloads,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the TicketQuery when eager-loading is set.
 	Edges        TicketEdges `json:"edges"`
@@ -55,9 +57,13 @@ type TicketEdges struct {
 	Owner *User `json:"owner,omitempty"`
 	// Shareaccesstokens holds the value of the shareaccesstokens edge.
 	Shareaccesstokens []*ShareAccessToken `json:"shareaccesstokens,omitempty"`
+	// Recipients holds the value of the recipients edge.
+	Recipients []*Recipient `json:"recipients,omitempty"`
+	// AccessEvents holds the value of the access_events edge.
+	AccessEvents []*AccessEvent `json:"access_events,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [3]bool
+	loadedTypes [5]bool
 }
 
 // FilesOrErr returns the Files value or an error if the edge
@@ -89,6 +95,24 @@ func (e TicketEdges) ShareaccesstokensOrErr() ([]*ShareAccessToken, error) {
 	return nil, &NotLoadedError{edge: "shareaccesstokens"}
 }
 
+// RecipientsOrErr returns the Recipients value or an error if the edge
+// was not loaded in eager-loading.
+func (e TicketEdges) RecipientsOrErr() ([]*Recipient, error) {
+	if e.loadedTypes[3] {
+		return e.Recipients, nil
+	}
+	return nil, &NotLoadedError{edge: "recipients"}
+}
+
+// AccessEventsOrErr returns the AccessEvents value or an error if the edge
+// was not loaded in eager-loading.
+func (e TicketEdges) AccessEventsOrErr() ([]*AccessEvent, error) {
+	if e.loadedTypes[4] {
+		return e.AccessEvents, nil
+	}
+	return nil, &NotLoadedError{edge: "access_events"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*Ticket) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
@@ -96,6 +120,8 @@ func (*Ticket) scanValues(columns []string) ([]any, error) {
 		switch columns[i] {
 		case ticket.FieldEmailOnDownload:
 			values[i] = new([]byte)
+		case ticket.FieldViewsCountAsDownloads:
+			values[i] = new(sql.NullBool)
 		case ticket.FieldExpiryTotalDays, ticket.FieldExpiryDaysSinceLastDownload, ticket.FieldExpiryTotalDownloads:
 			values[i] = new(sql.NullInt64)
 		case ticket.FieldComment, ticket.FieldExpiryType, ticket.FieldHashedPassword, ticket.FieldSalt, ticket.FieldCreatorLang:
@@ -190,6 +216,12 @@ func (_m *Ticket) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.CreatorLang = value.String
 			}
+		case ticket.FieldViewsCountAsDownloads:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field views_count_as_downloads", values[i])
+			} else if value.Valid {
+				_m.ViewsCountAsDownloads = value.Bool
+			}
 		case ticket.ForeignKeys[0]:
 			if value, ok := values[i].(*sql.NullScanner); !ok {
 				return fmt.Errorf("unexpected type %T for field user_tickets", values[i])
@@ -225,6 +257,16 @@ func (_m *Ticket) QueryShareaccesstokens() *ShareAccessTokenQuery {
 	return NewTicketClient(_m.config).QueryShareaccesstokens(_m)
 }
 
+// QueryRecipients queries the "recipients" edge of the Ticket entity.
+func (_m *Ticket) QueryRecipients() *RecipientQuery {
+	return NewTicketClient(_m.config).QueryRecipients(_m)
+}
+
+// QueryAccessEvents queries the "access_events" edge of the Ticket entity.
+func (_m *Ticket) QueryAccessEvents() *AccessEventQuery {
+	return NewTicketClient(_m.config).QueryAccessEvents(_m)
+}
+
 // Update returns a builder for updating this Ticket.
 // Note that you need to call Ticket.Unwrap() before calling this method if this Ticket
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -279,6 +321,9 @@ func (_m *Ticket) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("creator_lang=")
 	builder.WriteString(_m.CreatorLang)
+	builder.WriteString(", ")
+	builder.WriteString("views_count_as_downloads=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ViewsCountAsDownloads))
 	builder.WriteByte(')')
 	return builder.String()
 }
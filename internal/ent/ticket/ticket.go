@@ -34,12 +34,18 @@ const (
 	FieldEmailOnDownload = "email_on_download"
 	// FieldCreatorLang holds the string denoting the creator_lang field in the database.
 	FieldCreatorLang = "creator_lang"
+	// FieldViewsCountAsDownloads holds the string denoting the views_count_as_downloads field in the database.
+	FieldViewsCountAsDownloads = "views_count_as_downloads"
 	// EdgeFiles holds the string denoting the files edge name in mutations.
 	EdgeFiles = "files"
 	// EdgeOwner holds the string denoting the owner edge name in mutations.
 	EdgeOwner = "owner"
 	// EdgeShareaccesstokens holds the string denoting the shareaccesstokens edge name in mutations.
 	EdgeShareaccesstokens = "shareaccesstokens"
+	// EdgeRecipients holds the string denoting the recipients edge name in mutations.
+	EdgeRecipients = "recipients"
+	// EdgeAccessEvents holds the string denoting the access_events edge name in mutations.
+	EdgeAccessEvents = "access_events"
 	// Table holds the table name of the ticket in the database.
 	Table = "tickets"
 	// FilesTable is the table that holds the files relation/edge.
@@ -63,6 +69,20 @@ const (
 	ShareaccesstokensInverseTable = "share_access_tokens"
 	// ShareaccesstokensColumn is the table column denoting the shareaccesstokens relation/edge.
 	ShareaccesstokensColumn = "ticket_shareaccesstokens"
+	// RecipientsTable is the table that holds the recipients relation/edge.
+	RecipientsTable = "recipients"
+	// RecipientsInverseTable is the table name for the Recipient entity.
+	// It exists in this package in order to avoid circular dependency with the "recipient" package.
+	RecipientsInverseTable = "recipients"
+	// RecipientsColumn is the table column denoting the recipients relation/edge.
+	RecipientsColumn = "ticket_recipients"
+	// AccessEventsTable is the table that holds the access_events relation/edge.
+	AccessEventsTable = "access_events"
+	// AccessEventsInverseTable is the table name for the AccessEvent entity.
+	// It exists in this package in order to avoid circular dependency with the "accessevent" package.
+	AccessEventsInverseTable = "access_events"
+	// AccessEventsColumn is the table column denoting the access_events relation/edge.
+	AccessEventsColumn = "ticket_access_events"
 )
 
 // Columns holds all SQL columns for ticket fields.
@@ -78,6 +98,7 @@ var Columns = []string{
 	FieldExpiryTotalDownloads,
 	FieldEmailOnDownload,
 	FieldCreatorLang,
+	FieldViewsCountAsDownloads,
 }
 
 // ForeignKeys holds the SQL foreign-keys that are owned by the "tickets"
@@ -106,6 +127,8 @@ var (
 	DefaultCreatedAt func() time.Time
 	// DefaultCreatorLang holds the default value on creation for the "creator_lang" field.
 	DefaultCreatorLang string
+	// DefaultViewsCountAsDownloads holds the default value on creation for the "views_count_as_downloads" field.
+	DefaultViewsCountAsDownloads bool
 )
 
 // OrderOption defines the ordering options for the Ticket queries.
@@ -161,6 +184,11 @@ func ByCreatorLang(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldCreatorLang, opts...).ToFunc()
 }
 
+// ByViewsCountAsDownloads orders the results by the views_count_as_downloads field.
+func ByViewsCountAsDownloads(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldViewsCountAsDownloads, opts...).ToFunc()
+}
+
 // ByFilesCount orders the results by files count.
 func ByFilesCount(opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -195,6 +223,34 @@ func ByShareaccesstokens(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption
 		sqlgraph.OrderByNeighborTerms(s, newShareaccesstokensStep(), append([]sql.OrderTerm{term}, terms...)...)
 	}
 }
+
+// ByRecipientsCount orders the results by recipients count.
+func ByRecipientsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newRecipientsStep(), opts...)
+	}
+}
+
+// ByRecipients orders the results by recipients terms.
+func ByRecipients(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newRecipientsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByAccessEventsCount orders the results by access_events count.
+func ByAccessEventsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newAccessEventsStep(), opts...)
+	}
+}
+
+// ByAccessEvents orders the results by access_events terms.
+func ByAccessEvents(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newAccessEventsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
 func newFilesStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -216,3 +272,17 @@ func newShareaccesstokensStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.O2M, false, ShareaccesstokensTable, ShareaccesstokensColumn),
 	)
 }
+func newRecipientsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(RecipientsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, RecipientsTable, RecipientsColumn),
+	)
+}
+func newAccessEventsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(AccessEventsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, AccessEventsTable, AccessEventsColumn),
+	)
+}
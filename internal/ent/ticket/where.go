@@ -101,6 +101,11 @@ func CreatorLang(v string) predicate.Ticket {
 	return predicate.Ticket(sql.FieldEQ(FieldCreatorLang, v))
 }
 
+// ViewsCountAsDownloads applies equality check predicate on the "views_count_as_downloads" field. It's identical to ViewsCountAsDownloadsEQ.
+func ViewsCountAsDownloads(v bool) predicate.Ticket {
+	return predicate.Ticket(sql.FieldEQ(FieldViewsCountAsDownloads, v))
+}
+
 // CommentEQ applies the EQ predicate on the "comment" field.
 func CommentEQ(v string) predicate.Ticket {
 	return predicate.Ticket(sql.FieldEQ(FieldComment, v))
@@ -606,6 +611,16 @@ func CreatorLangContainsFold(v string) predicate.Ticket {
 	return predicate.Ticket(sql.FieldContainsFold(FieldCreatorLang, v))
 }
 
+// ViewsCountAsDownloadsEQ applies the EQ predicate on the "views_count_as_downloads" field.
+func ViewsCountAsDownloadsEQ(v bool) predicate.Ticket {
+	return predicate.Ticket(sql.FieldEQ(FieldViewsCountAsDownloads, v))
+}
+
+// ViewsCountAsDownloadsNEQ applies the NEQ predicate on the "views_count_as_downloads" field.
+func ViewsCountAsDownloadsNEQ(v bool) predicate.Ticket {
+	return predicate.Ticket(sql.FieldNEQ(FieldViewsCountAsDownloads, v))
+}
+
 // HasFiles applies the HasEdge predicate on the "files" edge.
 func HasFiles() predicate.Ticket {
 	return predicate.Ticket(func(s *sql.Selector) {
@@ -675,6 +690,52 @@ func HasShareaccesstokensWith(preds ...predicate.ShareAccessToken) predicate.Tic
 	})
 }
 
+// HasRecipients applies the HasEdge predicate on the "recipients" edge.
+func HasRecipients() predicate.Ticket {
+	return predicate.Ticket(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, RecipientsTable, RecipientsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasRecipientsWith applies the HasEdge predicate on the "recipients" edge with a given conditions (other predicates).
+func HasRecipientsWith(preds ...predicate.Recipient) predicate.Ticket {
+	return predicate.Ticket(func(s *sql.Selector) {
+		step := newRecipientsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasAccessEvents applies the HasEdge predicate on the "access_events" edge.
+func HasAccessEvents() predicate.Ticket {
+	return predicate.Ticket(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, AccessEventsTable, AccessEventsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasAccessEventsWith applies the HasEdge predicate on the "access_events" edge with a given conditions (other predicates).
+func HasAccessEventsWith(preds ...predicate.AccessEvent) predicate.Ticket {
+	return predicate.Ticket(func(s *sql.Selector) {
+		step := newAccessEventsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.Ticket) predicate.Ticket {
 	return predicate.Ticket(sql.AndPredicates(predicates...))
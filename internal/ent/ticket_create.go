@@ -8,7 +8,9 @@ import (
 	"fmt"
 	"time"
 
+	"codeberg.org/jvllmr/frans/internal/ent/accessevent"
 	"codeberg.org/jvllmr/frans/internal/ent/file"
+	"codeberg.org/jvllmr/frans/internal/ent/recipient"
 	"codeberg.org/jvllmr/frans/internal/ent/shareaccesstoken"
 	"codeberg.org/jvllmr/frans/internal/ent/ticket"
 	"codeberg.org/jvllmr/frans/internal/ent/user"
@@ -108,6 +110,20 @@ func (_c *TicketCreate) SetNillableCreatorLang(v *string) *TicketCreate {
 	return _c
 }
 
+// SetViewsCountAsDownloads sets the "views_count_as_downloads" field.
+func (_c *TicketCreate) SetViewsCountAsDownloads(v bool) *TicketCreate {
+	_c.mutation.SetViewsCountAsDownloads(v)
+	return _c
+}
+
+// SetNillableViewsCountAsDownloads sets the "views_count_as_downloads" field if the given value is not nil.
+func (_c *TicketCreate) SetNillableViewsCountAsDownloads(v *bool) *TicketCreate {
+	if v != nil {
+		_c.SetViewsCountAsDownloads(*v)
+	}
+	return _c
+}
+
 // SetID sets the "id" field.
 func (_c *TicketCreate) SetID(v uuid.UUID) *TicketCreate {
 	_c.mutation.SetID(v)
@@ -163,6 +179,36 @@ func (_c *TicketCreate) AddShareaccesstokens(v ...*ShareAccessToken) *TicketCrea
 	return _c.AddShareaccesstokenIDs(ids...)
 }
 
+// AddRecipientIDs adds the "recipients" edge to the Recipient entity by IDs.
+func (_c *TicketCreate) AddRecipientIDs(ids ...uuid.UUID) *TicketCreate {
+	_c.mutation.AddRecipientIDs(ids...)
+	return _c
+}
+
+// AddRecipients adds the "recipients" edges to the Recipient entity.
+func (_c *TicketCreate) AddRecipients(v ...*Recipient) *TicketCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddRecipientIDs(ids...)
+}
+
+// AddAccessEventIDs adds the "access_events" edge to the AccessEvent entity by IDs.
+func (_c *TicketCreate) AddAccessEventIDs(ids ...int) *TicketCreate {
+	_c.mutation.AddAccessEventIDs(ids...)
+	return _c
+}
+
+// AddAccessEvents adds the "access_events" edges to the AccessEvent entity.
+func (_c *TicketCreate) AddAccessEvents(v ...*AccessEvent) *TicketCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddAccessEventIDs(ids...)
+}
+
 // Mutation returns the TicketMutation object of the builder.
 func (_c *TicketCreate) Mutation() *TicketMutation {
 	return _c.mutation
@@ -206,6 +252,10 @@ func (_c *TicketCreate) defaults() {
 		v := ticket.DefaultCreatorLang
 		_c.mutation.SetCreatorLang(v)
 	}
+	if _, ok := _c.mutation.ViewsCountAsDownloads(); !ok {
+		v := ticket.DefaultViewsCountAsDownloads
+		_c.mutation.SetViewsCountAsDownloads(v)
+	}
 }
 
 // check runs all checks and user-defined validators on the builder.
@@ -234,6 +284,9 @@ func (_c *TicketCreate) check() error {
 	if _, ok := _c.mutation.CreatorLang(); !ok {
 		return &ValidationError{Name: "creator_lang", err: errors.New(`ent: missing required field "Ticket.creator_lang"`)}
 	}
+	if _, ok := _c.mutation.ViewsCountAsDownloads(); !ok {
+		return &ValidationError{Name: "views_count_as_downloads", err: errors.New(`ent: missing required field "Ticket.views_count_as_downloads"`)}
+	}
 	return nil
 }
 
@@ -309,6 +362,10 @@ func (_c *TicketCreate) createSpec() (*Ticket, *sqlgraph.CreateSpec) {
 		_spec.SetField(ticket.FieldCreatorLang, field.TypeString, value)
 		_node.CreatorLang = value
 	}
+	if value, ok := _c.mutation.ViewsCountAsDownloads(); ok {
+		_spec.SetField(ticket.FieldViewsCountAsDownloads, field.TypeBool, value)
+		_node.ViewsCountAsDownloads = value
+	}
 	if nodes := _c.mutation.FilesIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -358,6 +415,38 @@ func (_c *TicketCreate) createSpec() (*Ticket, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := _c.mutation.RecipientsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   ticket.RecipientsTable,
+			Columns: []string{ticket.RecipientsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(recipient.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.AccessEventsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   ticket.AccessEventsTable,
+			Columns: []string{ticket.AccessEventsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(accessevent.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
@@ -8,8 +8,10 @@ import (
 	"fmt"
 	"math"
 
+	"codeberg.org/jvllmr/frans/internal/ent/accessevent"
 	"codeberg.org/jvllmr/frans/internal/ent/file"
 	"codeberg.org/jvllmr/frans/internal/ent/predicate"
+	"codeberg.org/jvllmr/frans/internal/ent/recipient"
 	"codeberg.org/jvllmr/frans/internal/ent/shareaccesstoken"
 	"codeberg.org/jvllmr/frans/internal/ent/ticket"
 	"codeberg.org/jvllmr/frans/internal/ent/user"
@@ -30,6 +32,8 @@ type TicketQuery struct {
 	withFiles             *FileQuery
 	withOwner             *UserQuery
 	withShareaccesstokens *ShareAccessTokenQuery
+	withRecipients        *RecipientQuery
+	withAccessEvents      *AccessEventQuery
 	withFKs               bool
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
@@ -133,6 +137,50 @@ func (_q *TicketQuery) QueryShareaccesstokens() *ShareAccessTokenQuery {
 	return query
 }
 
+// QueryRecipients chains the current query on the "recipients" edge.
+func (_q *TicketQuery) QueryRecipients() *RecipientQuery {
+	query := (&RecipientClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(ticket.Table, ticket.FieldID, selector),
+			sqlgraph.To(recipient.Table, recipient.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, ticket.RecipientsTable, ticket.RecipientsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryAccessEvents chains the current query on the "access_events" edge.
+func (_q *TicketQuery) QueryAccessEvents() *AccessEventQuery {
+	query := (&AccessEventClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(ticket.Table, ticket.FieldID, selector),
+			sqlgraph.To(accessevent.Table, accessevent.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, ticket.AccessEventsTable, ticket.AccessEventsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first Ticket entity from the query.
 // Returns a *NotFoundError when no Ticket was found.
 func (_q *TicketQuery) First(ctx context.Context) (*Ticket, error) {
@@ -328,6 +376,8 @@ func (_q *TicketQuery) Clone() *TicketQuery {
 		withFiles:             _q.withFiles.Clone(),
 		withOwner:             _q.withOwner.Clone(),
 		withShareaccesstokens: _q.withShareaccesstokens.Clone(),
+		withRecipients:        _q.withRecipients.Clone(),
+		withAccessEvents:      _q.withAccessEvents.Clone(),
 		// clone intermediate query.
 		sql:  _q.sql.Clone(),
 		path: _q.path,
@@ -367,6 +417,28 @@ func (_q *TicketQuery) WithShareaccesstokens(opts ...func(*ShareAccessTokenQuery
 	return _q
 }
 
+// WithRecipients tells the query-builder to eager-load the nodes that are connected to
+// the "recipients" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *TicketQuery) WithRecipients(opts ...func(*RecipientQuery)) *TicketQuery {
+	query := (&RecipientClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withRecipients = query
+	return _q
+}
+
+// WithAccessEvents tells the query-builder to eager-load the nodes that are connected to
+// the "access_events" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *TicketQuery) WithAccessEvents(opts ...func(*AccessEventQuery)) *TicketQuery {
+	query := (&AccessEventClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withAccessEvents = query
+	return _q
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -446,10 +518,12 @@ func (_q *TicketQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Ticke
 		nodes       = []*Ticket{}
 		withFKs     = _q.withFKs
 		_spec       = _q.querySpec()
-		loadedTypes = [3]bool{
+		loadedTypes = [5]bool{
 			_q.withFiles != nil,
 			_q.withOwner != nil,
 			_q.withShareaccesstokens != nil,
+			_q.withRecipients != nil,
+			_q.withAccessEvents != nil,
 		}
 	)
 	if _q.withOwner != nil {
@@ -496,6 +570,20 @@ func (_q *TicketQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Ticke
 			return nil, err
 		}
 	}
+	if query := _q.withRecipients; query != nil {
+		if err := _q.loadRecipients(ctx, query, nodes,
+			func(n *Ticket) { n.Edges.Recipients = []*Recipient{} },
+			func(n *Ticket, e *Recipient) { n.Edges.Recipients = append(n.Edges.Recipients, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withAccessEvents; query != nil {
+		if err := _q.loadAccessEvents(ctx, query, nodes,
+			func(n *Ticket) { n.Edges.AccessEvents = []*AccessEvent{} },
+			func(n *Ticket, e *AccessEvent) { n.Edges.AccessEvents = append(n.Edges.AccessEvents, e) }); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -593,6 +681,68 @@ func (_q *TicketQuery) loadShareaccesstokens(ctx context.Context, query *ShareAc
 	}
 	return nil
 }
+func (_q *TicketQuery) loadRecipients(ctx context.Context, query *RecipientQuery, nodes []*Ticket, init func(*Ticket), assign func(*Ticket, *Recipient)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*Ticket)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.Recipient(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(ticket.RecipientsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.ticket_recipients
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "ticket_recipients" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "ticket_recipients" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *TicketQuery) loadAccessEvents(ctx context.Context, query *AccessEventQuery, nodes []*Ticket, init func(*Ticket), assign func(*Ticket, *AccessEvent)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*Ticket)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.AccessEvent(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(ticket.AccessEventsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.ticket_access_events
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "ticket_access_events" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "ticket_access_events" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
 
 func (_q *TicketQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := _q.querySpec()
@@ -8,8 +8,10 @@ import (
 	"fmt"
 	"time"
 
+	"codeberg.org/jvllmr/frans/internal/ent/accessevent"
 	"codeberg.org/jvllmr/frans/internal/ent/file"
 	"codeberg.org/jvllmr/frans/internal/ent/predicate"
+	"codeberg.org/jvllmr/frans/internal/ent/recipient"
 	"codeberg.org/jvllmr/frans/internal/ent/shareaccesstoken"
 	"codeberg.org/jvllmr/frans/internal/ent/ticket"
 	"codeberg.org/jvllmr/frans/internal/ent/user"
@@ -204,6 +206,20 @@ func (_u *TicketUpdate) SetNillableCreatorLang(v *string) *TicketUpdate {
 	return _u
 }
 
+// SetViewsCountAsDownloads sets the "views_count_as_downloads" field.
+func (_u *TicketUpdate) SetViewsCountAsDownloads(v bool) *TicketUpdate {
+	_u.mutation.SetViewsCountAsDownloads(v)
+	return _u
+}
+
+// SetNillableViewsCountAsDownloads sets the "views_count_as_downloads" field if the given value is not nil.
+func (_u *TicketUpdate) SetNillableViewsCountAsDownloads(v *bool) *TicketUpdate {
+	if v != nil {
+		_u.SetViewsCountAsDownloads(*v)
+	}
+	return _u
+}
+
 // AddFileIDs adds the "files" edge to the File entity by IDs.
 func (_u *TicketUpdate) AddFileIDs(ids ...uuid.UUID) *TicketUpdate {
 	_u.mutation.AddFileIDs(ids...)
@@ -253,6 +269,36 @@ func (_u *TicketUpdate) AddShareaccesstokens(v ...*ShareAccessToken) *TicketUpda
 	return _u.AddShareaccesstokenIDs(ids...)
 }
 
+// AddRecipientIDs adds the "recipients" edge to the Recipient entity by IDs.
+func (_u *TicketUpdate) AddRecipientIDs(ids ...uuid.UUID) *TicketUpdate {
+	_u.mutation.AddRecipientIDs(ids...)
+	return _u
+}
+
+// AddRecipients adds the "recipients" edges to the Recipient entity.
+func (_u *TicketUpdate) AddRecipients(v ...*Recipient) *TicketUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddRecipientIDs(ids...)
+}
+
+// AddAccessEventIDs adds the "access_events" edge to the AccessEvent entity by IDs.
+func (_u *TicketUpdate) AddAccessEventIDs(ids ...int) *TicketUpdate {
+	_u.mutation.AddAccessEventIDs(ids...)
+	return _u
+}
+
+// AddAccessEvents adds the "access_events" edges to the AccessEvent entity.
+func (_u *TicketUpdate) AddAccessEvents(v ...*AccessEvent) *TicketUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddAccessEventIDs(ids...)
+}
+
 // Mutation returns the TicketMutation object of the builder.
 func (_u *TicketUpdate) Mutation() *TicketMutation {
 	return _u.mutation
@@ -306,6 +352,48 @@ func (_u *TicketUpdate) RemoveShareaccesstokens(v ...*ShareAccessToken) *TicketU
 	return _u.RemoveShareaccesstokenIDs(ids...)
 }
 
+// ClearRecipients clears all "recipients" edges to the Recipient entity.
+func (_u *TicketUpdate) ClearRecipients() *TicketUpdate {
+	_u.mutation.ClearRecipients()
+	return _u
+}
+
+// RemoveRecipientIDs removes the "recipients" edge to Recipient entities by IDs.
+func (_u *TicketUpdate) RemoveRecipientIDs(ids ...uuid.UUID) *TicketUpdate {
+	_u.mutation.RemoveRecipientIDs(ids...)
+	return _u
+}
+
+// RemoveRecipients removes "recipients" edges to Recipient entities.
+func (_u *TicketUpdate) RemoveRecipients(v ...*Recipient) *TicketUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveRecipientIDs(ids...)
+}
+
+// ClearAccessEvents clears all "access_events" edges to the AccessEvent entity.
+func (_u *TicketUpdate) ClearAccessEvents() *TicketUpdate {
+	_u.mutation.ClearAccessEvents()
+	return _u
+}
+
+// RemoveAccessEventIDs removes the "access_events" edge to AccessEvent entities by IDs.
+func (_u *TicketUpdate) RemoveAccessEventIDs(ids ...int) *TicketUpdate {
+	_u.mutation.RemoveAccessEventIDs(ids...)
+	return _u
+}
+
+// RemoveAccessEvents removes "access_events" edges to AccessEvent entities.
+func (_u *TicketUpdate) RemoveAccessEvents(v ...*AccessEvent) *TicketUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveAccessEventIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (_u *TicketUpdate) Save(ctx context.Context) (int, error) {
 	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
@@ -392,6 +480,9 @@ func (_u *TicketUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if value, ok := _u.mutation.CreatorLang(); ok {
 		_spec.SetField(ticket.FieldCreatorLang, field.TypeString, value)
 	}
+	if value, ok := _u.mutation.ViewsCountAsDownloads(); ok {
+		_spec.SetField(ticket.FieldViewsCountAsDownloads, field.TypeBool, value)
+	}
 	if _u.mutation.FilesCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -511,6 +602,96 @@ func (_u *TicketUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.RecipientsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   ticket.RecipientsTable,
+			Columns: []string{ticket.RecipientsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(recipient.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedRecipientsIDs(); len(nodes) > 0 && !_u.mutation.RecipientsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   ticket.RecipientsTable,
+			Columns: []string{ticket.RecipientsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(recipient.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RecipientsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   ticket.RecipientsTable,
+			Columns: []string{ticket.RecipientsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(recipient.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.AccessEventsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   ticket.AccessEventsTable,
+			Columns: []string{ticket.AccessEventsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(accessevent.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedAccessEventsIDs(); len(nodes) > 0 && !_u.mutation.AccessEventsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   ticket.AccessEventsTable,
+			Columns: []string{ticket.AccessEventsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(accessevent.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.AccessEventsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   ticket.AccessEventsTable,
+			Columns: []string{ticket.AccessEventsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(accessevent.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{ticket.Label}
@@ -702,6 +883,20 @@ func (_u *TicketUpdateOne) SetNillableCreatorLang(v *string) *TicketUpdateOne {
 	return _u
 }
 
+// SetViewsCountAsDownloads sets the "views_count_as_downloads" field.
+func (_u *TicketUpdateOne) SetViewsCountAsDownloads(v bool) *TicketUpdateOne {
+	_u.mutation.SetViewsCountAsDownloads(v)
+	return _u
+}
+
+// SetNillableViewsCountAsDownloads sets the "views_count_as_downloads" field if the given value is not nil.
+func (_u *TicketUpdateOne) SetNillableViewsCountAsDownloads(v *bool) *TicketUpdateOne {
+	if v != nil {
+		_u.SetViewsCountAsDownloads(*v)
+	}
+	return _u
+}
+
 // AddFileIDs adds the "files" edge to the File entity by IDs.
 func (_u *TicketUpdateOne) AddFileIDs(ids ...uuid.UUID) *TicketUpdateOne {
 	_u.mutation.AddFileIDs(ids...)
@@ -751,6 +946,36 @@ func (_u *TicketUpdateOne) AddShareaccesstokens(v ...*ShareAccessToken) *TicketU
 	return _u.AddShareaccesstokenIDs(ids...)
 }
 
+// AddRecipientIDs adds the "recipients" edge to the Recipient entity by IDs.
+func (_u *TicketUpdateOne) AddRecipientIDs(ids ...uuid.UUID) *TicketUpdateOne {
+	_u.mutation.AddRecipientIDs(ids...)
+	return _u
+}
+
+// AddRecipients adds the "recipients" edges to the Recipient entity.
+func (_u *TicketUpdateOne) AddRecipients(v ...*Recipient) *TicketUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddRecipientIDs(ids...)
+}
+
+// AddAccessEventIDs adds the "access_events" edge to the AccessEvent entity by IDs.
+func (_u *TicketUpdateOne) AddAccessEventIDs(ids ...int) *TicketUpdateOne {
+	_u.mutation.AddAccessEventIDs(ids...)
+	return _u
+}
+
+// AddAccessEvents adds the "access_events" edges to the AccessEvent entity.
+func (_u *TicketUpdateOne) AddAccessEvents(v ...*AccessEvent) *TicketUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddAccessEventIDs(ids...)
+}
+
 // Mutation returns the TicketMutation object of the builder.
 func (_u *TicketUpdateOne) Mutation() *TicketMutation {
 	return _u.mutation
@@ -804,6 +1029,48 @@ func (_u *TicketUpdateOne) RemoveShareaccesstokens(v ...*ShareAccessToken) *Tick
 	return _u.RemoveShareaccesstokenIDs(ids...)
 }
 
+// ClearRecipients clears all "recipients" edges to the Recipient entity.
+func (_u *TicketUpdateOne) ClearRecipients() *TicketUpdateOne {
+	_u.mutation.ClearRecipients()
+	return _u
+}
+
+// RemoveRecipientIDs removes the "recipients" edge to Recipient entities by IDs.
+func (_u *TicketUpdateOne) RemoveRecipientIDs(ids ...uuid.UUID) *TicketUpdateOne {
+	_u.mutation.RemoveRecipientIDs(ids...)
+	return _u
+}
+
+// RemoveRecipients removes "recipients" edges to Recipient entities.
+func (_u *TicketUpdateOne) RemoveRecipients(v ...*Recipient) *TicketUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveRecipientIDs(ids...)
+}
+
+// ClearAccessEvents clears all "access_events" edges to the AccessEvent entity.
+func (_u *TicketUpdateOne) ClearAccessEvents() *TicketUpdateOne {
+	_u.mutation.ClearAccessEvents()
+	return _u
+}
+
+// RemoveAccessEventIDs removes the "access_events" edge to AccessEvent entities by IDs.
+func (_u *TicketUpdateOne) RemoveAccessEventIDs(ids ...int) *TicketUpdateOne {
+	_u.mutation.RemoveAccessEventIDs(ids...)
+	return _u
+}
+
+// RemoveAccessEvents removes "access_events" edges to AccessEvent entities.
+func (_u *TicketUpdateOne) RemoveAccessEvents(v ...*AccessEvent) *TicketUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveAccessEventIDs(ids...)
+}
+
 // Where appends a list predicates to the TicketUpdate builder.
 func (_u *TicketUpdateOne) Where(ps ...predicate.Ticket) *TicketUpdateOne {
 	_u.mutation.Where(ps...)
@@ -920,6 +1187,9 @@ func (_u *TicketUpdateOne) sqlSave(ctx context.Context) (_node *Ticket, err erro
 	if value, ok := _u.mutation.CreatorLang(); ok {
 		_spec.SetField(ticket.FieldCreatorLang, field.TypeString, value)
 	}
+	if value, ok := _u.mutation.ViewsCountAsDownloads(); ok {
+		_spec.SetField(ticket.FieldViewsCountAsDownloads, field.TypeBool, value)
+	}
 	if _u.mutation.FilesCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -1039,6 +1309,96 @@ func (_u *TicketUpdateOne) sqlSave(ctx context.Context) (_node *Ticket, err erro
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.RecipientsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   ticket.RecipientsTable,
+			Columns: []string{ticket.RecipientsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(recipient.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedRecipientsIDs(); len(nodes) > 0 && !_u.mutation.RecipientsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   ticket.RecipientsTable,
+			Columns: []string{ticket.RecipientsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(recipient.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RecipientsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   ticket.RecipientsTable,
+			Columns: []string{ticket.RecipientsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(recipient.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.AccessEventsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   ticket.AccessEventsTable,
+			Columns: []string{ticket.AccessEventsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(accessevent.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedAccessEventsIDs(); len(nodes) > 0 && !_u.mutation.AccessEventsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   ticket.AccessEventsTable,
+			Columns: []string{ticket.AccessEventsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(accessevent.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.AccessEventsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   ticket.AccessEventsTable,
+			Columns: []string{ticket.AccessEventsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(accessevent.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &Ticket{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
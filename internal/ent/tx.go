@@ -12,18 +12,28 @@ import (
 // Tx is a transactional client that is created by calling Client.Tx().
 type Tx struct {
 	config
+	// AccessEvent is the client for interacting with the AccessEvent builders.
+	AccessEvent *AccessEventClient
+	// DownloadProgress is the client for interacting with the DownloadProgress builders.
+	DownloadProgress *DownloadProgressClient
 	// File is the client for interacting with the File builders.
 	File *FileClient
 	// FileData is the client for interacting with the FileData builders.
 	FileData *FileDataClient
 	// Grant is the client for interacting with the Grant builders.
 	Grant *GrantClient
+	// Recipient is the client for interacting with the Recipient builders.
+	Recipient *RecipientClient
 	// Session is the client for interacting with the Session builders.
 	Session *SessionClient
 	// ShareAccessToken is the client for interacting with the ShareAccessToken builders.
 	ShareAccessToken *ShareAccessTokenClient
+	// StorageVerification is the client for interacting with the StorageVerification builders.
+	StorageVerification *StorageVerificationClient
 	// Ticket is the client for interacting with the Ticket builders.
 	Ticket *TicketClient
+	// Upload is the client for interacting with the Upload builders.
+	Upload *UploadClient
 	// User is the client for interacting with the User builders.
 	User *UserClient
 
@@ -157,12 +167,17 @@ func (tx *Tx) Client() *Client {
 }
 
 func (tx *Tx) init() {
+	tx.AccessEvent = NewAccessEventClient(tx.config)
+	tx.DownloadProgress = NewDownloadProgressClient(tx.config)
 	tx.File = NewFileClient(tx.config)
 	tx.FileData = NewFileDataClient(tx.config)
 	tx.Grant = NewGrantClient(tx.config)
+	tx.Recipient = NewRecipientClient(tx.config)
 	tx.Session = NewSessionClient(tx.config)
 	tx.ShareAccessToken = NewShareAccessTokenClient(tx.config)
+	tx.StorageVerification = NewStorageVerificationClient(tx.config)
 	tx.Ticket = NewTicketClient(tx.config)
+	tx.Upload = NewUploadClient(tx.config)
 	tx.User = NewUserClient(tx.config)
 }
 
@@ -173,7 +188,7 @@ func (tx *Tx) init() {
 // of them in order to commit or rollback the transaction.
 //
 // If a closed transaction is embedded in one of the generated entities, and the entity
-// applies a query, for example: File.QueryXXX(), the query will be executed
+// applies a query, for example: AccessEvent.QueryXXX(), the query will be executed
 // through the driver which created this transaction.
 //
 // Note that txDriver is not goroutine safe.
@@ -0,0 +1,221 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"codeberg.org/jvllmr/frans/internal/ent/grant"
+	"codeberg.org/jvllmr/frans/internal/ent/upload"
+	"codeberg.org/jvllmr/frans/internal/ent/user"
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+)
+
+// Upload is the model entity for the Upload schema.
+type Upload struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// Name holds the value of the "name" field.
+	Name string `json:"name,omitempty"`
+	// Size holds the value of the "size" field.
+	Size int64 `json:"size,omitempty"`
+	// Offset holds the value of the "offset" field.
+	Offset int64 `json:"offset,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Expiry holds the value of the "expiry" field.
+	Expiry time.Time `json:"expiry,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the UploadQuery when eager-loading is set.
+	Edges         UploadEdges `json:"edges"`
+	grant_uploads *uuid.UUID
+	user_uploads  *uuid.UUID
+	selectValues  sql.SelectValues
+}
+
+// UploadEdges holds the relations/edges for other nodes in the graph.
+type UploadEdges struct {
+	// Owner holds the value of the owner edge.
+	Owner *User `json:"owner,omitempty"`
+	// Grant holds the value of the grant edge.
+	Grant *Grant `json:"grant,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// OwnerOrErr returns the Owner value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e UploadEdges) OwnerOrErr() (*User, error) {
+	if e.Owner != nil {
+		return e.Owner, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "owner"}
+}
+
+// GrantOrErr returns the Grant value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e UploadEdges) GrantOrErr() (*Grant, error) {
+	if e.Grant != nil {
+		return e.Grant, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: grant.Label}
+	}
+	return nil, &NotLoadedError{edge: "grant"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Upload) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case upload.FieldSize, upload.FieldOffset:
+			values[i] = new(sql.NullInt64)
+		case upload.FieldName:
+			values[i] = new(sql.NullString)
+		case upload.FieldCreatedAt, upload.FieldExpiry:
+			values[i] = new(sql.NullTime)
+		case upload.FieldID:
+			values[i] = new(uuid.UUID)
+		case upload.ForeignKeys[0]: // grant_uploads
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		case upload.ForeignKeys[1]: // user_uploads
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Upload fields.
+func (_m *Upload) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case upload.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case upload.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				_m.Name = value.String
+			}
+		case upload.FieldSize:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field size", values[i])
+			} else if value.Valid {
+				_m.Size = value.Int64
+			}
+		case upload.FieldOffset:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field offset", values[i])
+			} else if value.Valid {
+				_m.Offset = value.Int64
+			}
+		case upload.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case upload.FieldExpiry:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field expiry", values[i])
+			} else if value.Valid {
+				_m.Expiry = value.Time
+			}
+		case upload.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field grant_uploads", values[i])
+			} else if value.Valid {
+				_m.grant_uploads = new(uuid.UUID)
+				*_m.grant_uploads = *value.S.(*uuid.UUID)
+			}
+		case upload.ForeignKeys[1]:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field user_uploads", values[i])
+			} else if value.Valid {
+				_m.user_uploads = new(uuid.UUID)
+				*_m.user_uploads = *value.S.(*uuid.UUID)
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Upload.
+// This includes values selected through modifiers, order, etc.
+func (_m *Upload) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryOwner queries the "owner" edge of the Upload entity.
+func (_m *Upload) QueryOwner() *UserQuery {
+	return NewUploadClient(_m.config).QueryOwner(_m)
+}
+
+// QueryGrant queries the "grant" edge of the Upload entity.
+func (_m *Upload) QueryGrant() *GrantQuery {
+	return NewUploadClient(_m.config).QueryGrant(_m)
+}
+
+// Update returns a builder for updating this Upload.
+// Note that you need to call Upload.Unwrap() before calling this method if this Upload
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *Upload) Update() *UploadUpdateOne {
+	return NewUploadClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the Upload entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *Upload) Unwrap() *Upload {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Upload is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *Upload) String() string {
+	var builder strings.Builder
+	builder.WriteString("Upload(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("name=")
+	builder.WriteString(_m.Name)
+	builder.WriteString(", ")
+	builder.WriteString("size=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Size))
+	builder.WriteString(", ")
+	builder.WriteString("offset=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Offset))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("expiry=")
+	builder.WriteString(_m.Expiry.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Uploads is a parsable slice of Upload.
+type Uploads []*Upload
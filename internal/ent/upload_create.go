@@ -0,0 +1,352 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"codeberg.org/jvllmr/frans/internal/ent/grant"
+	"codeberg.org/jvllmr/frans/internal/ent/upload"
+	"codeberg.org/jvllmr/frans/internal/ent/user"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// UploadCreate is the builder for creating a Upload entity.
+type UploadCreate struct {
+	config
+	mutation *UploadMutation
+	hooks    []Hook
+}
+
+// SetName sets the "name" field.
+func (_c *UploadCreate) SetName(v string) *UploadCreate {
+	_c.mutation.SetName(v)
+	return _c
+}
+
+// SetSize sets the "size" field.
+func (_c *UploadCreate) SetSize(v int64) *UploadCreate {
+	_c.mutation.SetSize(v)
+	return _c
+}
+
+// SetOffset sets the "offset" field.
+func (_c *UploadCreate) SetOffset(v int64) *UploadCreate {
+	_c.mutation.SetOffset(v)
+	return _c
+}
+
+// SetNillableOffset sets the "offset" field if the given value is not nil.
+func (_c *UploadCreate) SetNillableOffset(v *int64) *UploadCreate {
+	if v != nil {
+		_c.SetOffset(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *UploadCreate) SetCreatedAt(v time.Time) *UploadCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *UploadCreate) SetNillableCreatedAt(v *time.Time) *UploadCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetExpiry sets the "expiry" field.
+func (_c *UploadCreate) SetExpiry(v time.Time) *UploadCreate {
+	_c.mutation.SetExpiry(v)
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *UploadCreate) SetID(v uuid.UUID) *UploadCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetOwnerID sets the "owner" edge to the User entity by ID.
+func (_c *UploadCreate) SetOwnerID(id uuid.UUID) *UploadCreate {
+	_c.mutation.SetOwnerID(id)
+	return _c
+}
+
+// SetNillableOwnerID sets the "owner" edge to the User entity by ID if the given value is not nil.
+func (_c *UploadCreate) SetNillableOwnerID(id *uuid.UUID) *UploadCreate {
+	if id != nil {
+		_c = _c.SetOwnerID(*id)
+	}
+	return _c
+}
+
+// SetOwner sets the "owner" edge to the User entity.
+func (_c *UploadCreate) SetOwner(v *User) *UploadCreate {
+	return _c.SetOwnerID(v.ID)
+}
+
+// SetGrantID sets the "grant" edge to the Grant entity by ID.
+func (_c *UploadCreate) SetGrantID(id uuid.UUID) *UploadCreate {
+	_c.mutation.SetGrantID(id)
+	return _c
+}
+
+// SetNillableGrantID sets the "grant" edge to the Grant entity by ID if the given value is not nil.
+func (_c *UploadCreate) SetNillableGrantID(id *uuid.UUID) *UploadCreate {
+	if id != nil {
+		_c = _c.SetGrantID(*id)
+	}
+	return _c
+}
+
+// SetGrant sets the "grant" edge to the Grant entity.
+func (_c *UploadCreate) SetGrant(v *Grant) *UploadCreate {
+	return _c.SetGrantID(v.ID)
+}
+
+// Mutation returns the UploadMutation object of the builder.
+func (_c *UploadCreate) Mutation() *UploadMutation {
+	return _c.mutation
+}
+
+// Save creates the Upload in the database.
+func (_c *UploadCreate) Save(ctx context.Context) (*Upload, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *UploadCreate) SaveX(ctx context.Context) *Upload {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *UploadCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *UploadCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *UploadCreate) defaults() {
+	if _, ok := _c.mutation.Offset(); !ok {
+		v := upload.DefaultOffset
+		_c.mutation.SetOffset(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := upload.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *UploadCreate) check() error {
+	if _, ok := _c.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "Upload.name"`)}
+	}
+	if _, ok := _c.mutation.Size(); !ok {
+		return &ValidationError{Name: "size", err: errors.New(`ent: missing required field "Upload.size"`)}
+	}
+	if _, ok := _c.mutation.Offset(); !ok {
+		return &ValidationError{Name: "offset", err: errors.New(`ent: missing required field "Upload.offset"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Upload.created_at"`)}
+	}
+	if _, ok := _c.mutation.Expiry(); !ok {
+		return &ValidationError{Name: "expiry", err: errors.New(`ent: missing required field "Upload.expiry"`)}
+	}
+	return nil
+}
+
+func (_c *UploadCreate) sqlSave(ctx context.Context) (*Upload, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *UploadCreate) createSpec() (*Upload, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Upload{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(upload.Table, sqlgraph.NewFieldSpec(upload.FieldID, field.TypeUUID))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.Name(); ok {
+		_spec.SetField(upload.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := _c.mutation.Size(); ok {
+		_spec.SetField(upload.FieldSize, field.TypeInt64, value)
+		_node.Size = value
+	}
+	if value, ok := _c.mutation.Offset(); ok {
+		_spec.SetField(upload.FieldOffset, field.TypeInt64, value)
+		_node.Offset = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(upload.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.Expiry(); ok {
+		_spec.SetField(upload.FieldExpiry, field.TypeTime, value)
+		_node.Expiry = value
+	}
+	if nodes := _c.mutation.OwnerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   upload.OwnerTable,
+			Columns: []string{upload.OwnerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.user_uploads = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.GrantIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   upload.GrantTable,
+			Columns: []string{upload.GrantColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(grant.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.grant_uploads = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// UploadCreateBulk is the builder for creating many Upload entities in bulk.
+type UploadCreateBulk struct {
+	config
+	err      error
+	builders []*UploadCreate
+}
+
+// Save creates the Upload entities in the database.
+func (_c *UploadCreateBulk) Save(ctx context.Context) ([]*Upload, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*Upload, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*UploadMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *UploadCreateBulk) SaveX(ctx context.Context) []*Upload {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *UploadCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *UploadCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
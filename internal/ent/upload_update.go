@@ -0,0 +1,605 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"codeberg.org/jvllmr/frans/internal/ent/grant"
+	"codeberg.org/jvllmr/frans/internal/ent/predicate"
+	"codeberg.org/jvllmr/frans/internal/ent/upload"
+	"codeberg.org/jvllmr/frans/internal/ent/user"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// UploadUpdate is the builder for updating Upload entities.
+type UploadUpdate struct {
+	config
+	hooks    []Hook
+	mutation *UploadMutation
+}
+
+// Where appends a list predicates to the UploadUpdate builder.
+func (_u *UploadUpdate) Where(ps ...predicate.Upload) *UploadUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *UploadUpdate) SetName(v string) *UploadUpdate {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *UploadUpdate) SetNillableName(v *string) *UploadUpdate {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetSize sets the "size" field.
+func (_u *UploadUpdate) SetSize(v int64) *UploadUpdate {
+	_u.mutation.ResetSize()
+	_u.mutation.SetSize(v)
+	return _u
+}
+
+// SetNillableSize sets the "size" field if the given value is not nil.
+func (_u *UploadUpdate) SetNillableSize(v *int64) *UploadUpdate {
+	if v != nil {
+		_u.SetSize(*v)
+	}
+	return _u
+}
+
+// AddSize adds value to the "size" field.
+func (_u *UploadUpdate) AddSize(v int64) *UploadUpdate {
+	_u.mutation.AddSize(v)
+	return _u
+}
+
+// SetOffset sets the "offset" field.
+func (_u *UploadUpdate) SetOffset(v int64) *UploadUpdate {
+	_u.mutation.ResetOffset()
+	_u.mutation.SetOffset(v)
+	return _u
+}
+
+// SetNillableOffset sets the "offset" field if the given value is not nil.
+func (_u *UploadUpdate) SetNillableOffset(v *int64) *UploadUpdate {
+	if v != nil {
+		_u.SetOffset(*v)
+	}
+	return _u
+}
+
+// AddOffset adds value to the "offset" field.
+func (_u *UploadUpdate) AddOffset(v int64) *UploadUpdate {
+	_u.mutation.AddOffset(v)
+	return _u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_u *UploadUpdate) SetCreatedAt(v time.Time) *UploadUpdate {
+	_u.mutation.SetCreatedAt(v)
+	return _u
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_u *UploadUpdate) SetNillableCreatedAt(v *time.Time) *UploadUpdate {
+	if v != nil {
+		_u.SetCreatedAt(*v)
+	}
+	return _u
+}
+
+// SetExpiry sets the "expiry" field.
+func (_u *UploadUpdate) SetExpiry(v time.Time) *UploadUpdate {
+	_u.mutation.SetExpiry(v)
+	return _u
+}
+
+// SetNillableExpiry sets the "expiry" field if the given value is not nil.
+func (_u *UploadUpdate) SetNillableExpiry(v *time.Time) *UploadUpdate {
+	if v != nil {
+		_u.SetExpiry(*v)
+	}
+	return _u
+}
+
+// SetOwnerID sets the "owner" edge to the User entity by ID.
+func (_u *UploadUpdate) SetOwnerID(id uuid.UUID) *UploadUpdate {
+	_u.mutation.SetOwnerID(id)
+	return _u
+}
+
+// SetNillableOwnerID sets the "owner" edge to the User entity by ID if the given value is not nil.
+func (_u *UploadUpdate) SetNillableOwnerID(id *uuid.UUID) *UploadUpdate {
+	if id != nil {
+		_u = _u.SetOwnerID(*id)
+	}
+	return _u
+}
+
+// SetOwner sets the "owner" edge to the User entity.
+func (_u *UploadUpdate) SetOwner(v *User) *UploadUpdate {
+	return _u.SetOwnerID(v.ID)
+}
+
+// SetGrantID sets the "grant" edge to the Grant entity by ID.
+func (_u *UploadUpdate) SetGrantID(id uuid.UUID) *UploadUpdate {
+	_u.mutation.SetGrantID(id)
+	return _u
+}
+
+// SetNillableGrantID sets the "grant" edge to the Grant entity by ID if the given value is not nil.
+func (_u *UploadUpdate) SetNillableGrantID(id *uuid.UUID) *UploadUpdate {
+	if id != nil {
+		_u = _u.SetGrantID(*id)
+	}
+	return _u
+}
+
+// SetGrant sets the "grant" edge to the Grant entity.
+func (_u *UploadUpdate) SetGrant(v *Grant) *UploadUpdate {
+	return _u.SetGrantID(v.ID)
+}
+
+// Mutation returns the UploadMutation object of the builder.
+func (_u *UploadUpdate) Mutation() *UploadMutation {
+	return _u.mutation
+}
+
+// ClearOwner clears the "owner" edge to the User entity.
+func (_u *UploadUpdate) ClearOwner() *UploadUpdate {
+	_u.mutation.ClearOwner()
+	return _u
+}
+
+// ClearGrant clears the "grant" edge to the Grant entity.
+func (_u *UploadUpdate) ClearGrant() *UploadUpdate {
+	_u.mutation.ClearGrant()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *UploadUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *UploadUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *UploadUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *UploadUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (_u *UploadUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(upload.Table, upload.Columns, sqlgraph.NewFieldSpec(upload.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(upload.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Size(); ok {
+		_spec.SetField(upload.FieldSize, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedSize(); ok {
+		_spec.AddField(upload.FieldSize, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.Offset(); ok {
+		_spec.SetField(upload.FieldOffset, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedOffset(); ok {
+		_spec.AddField(upload.FieldOffset, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.CreatedAt(); ok {
+		_spec.SetField(upload.FieldCreatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Expiry(); ok {
+		_spec.SetField(upload.FieldExpiry, field.TypeTime, value)
+	}
+	if _u.mutation.OwnerCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   upload.OwnerTable,
+			Columns: []string{upload.OwnerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.OwnerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   upload.OwnerTable,
+			Columns: []string{upload.OwnerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.GrantCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   upload.GrantTable,
+			Columns: []string{upload.GrantColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(grant.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.GrantIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   upload.GrantTable,
+			Columns: []string{upload.GrantColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(grant.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{upload.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// UploadUpdateOne is the builder for updating a single Upload entity.
+type UploadUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *UploadMutation
+}
+
+// SetName sets the "name" field.
+func (_u *UploadUpdateOne) SetName(v string) *UploadUpdateOne {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *UploadUpdateOne) SetNillableName(v *string) *UploadUpdateOne {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetSize sets the "size" field.
+func (_u *UploadUpdateOne) SetSize(v int64) *UploadUpdateOne {
+	_u.mutation.ResetSize()
+	_u.mutation.SetSize(v)
+	return _u
+}
+
+// SetNillableSize sets the "size" field if the given value is not nil.
+func (_u *UploadUpdateOne) SetNillableSize(v *int64) *UploadUpdateOne {
+	if v != nil {
+		_u.SetSize(*v)
+	}
+	return _u
+}
+
+// AddSize adds value to the "size" field.
+func (_u *UploadUpdateOne) AddSize(v int64) *UploadUpdateOne {
+	_u.mutation.AddSize(v)
+	return _u
+}
+
+// SetOffset sets the "offset" field.
+func (_u *UploadUpdateOne) SetOffset(v int64) *UploadUpdateOne {
+	_u.mutation.ResetOffset()
+	_u.mutation.SetOffset(v)
+	return _u
+}
+
+// SetNillableOffset sets the "offset" field if the given value is not nil.
+func (_u *UploadUpdateOne) SetNillableOffset(v *int64) *UploadUpdateOne {
+	if v != nil {
+		_u.SetOffset(*v)
+	}
+	return _u
+}
+
+// AddOffset adds value to the "offset" field.
+func (_u *UploadUpdateOne) AddOffset(v int64) *UploadUpdateOne {
+	_u.mutation.AddOffset(v)
+	return _u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_u *UploadUpdateOne) SetCreatedAt(v time.Time) *UploadUpdateOne {
+	_u.mutation.SetCreatedAt(v)
+	return _u
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_u *UploadUpdateOne) SetNillableCreatedAt(v *time.Time) *UploadUpdateOne {
+	if v != nil {
+		_u.SetCreatedAt(*v)
+	}
+	return _u
+}
+
+// SetExpiry sets the "expiry" field.
+func (_u *UploadUpdateOne) SetExpiry(v time.Time) *UploadUpdateOne {
+	_u.mutation.SetExpiry(v)
+	return _u
+}
+
+// SetNillableExpiry sets the "expiry" field if the given value is not nil.
+func (_u *UploadUpdateOne) SetNillableExpiry(v *time.Time) *UploadUpdateOne {
+	if v != nil {
+		_u.SetExpiry(*v)
+	}
+	return _u
+}
+
+// SetOwnerID sets the "owner" edge to the User entity by ID.
+func (_u *UploadUpdateOne) SetOwnerID(id uuid.UUID) *UploadUpdateOne {
+	_u.mutation.SetOwnerID(id)
+	return _u
+}
+
+// SetNillableOwnerID sets the "owner" edge to the User entity by ID if the given value is not nil.
+func (_u *UploadUpdateOne) SetNillableOwnerID(id *uuid.UUID) *UploadUpdateOne {
+	if id != nil {
+		_u = _u.SetOwnerID(*id)
+	}
+	return _u
+}
+
+// SetOwner sets the "owner" edge to the User entity.
+func (_u *UploadUpdateOne) SetOwner(v *User) *UploadUpdateOne {
+	return _u.SetOwnerID(v.ID)
+}
+
+// SetGrantID sets the "grant" edge to the Grant entity by ID.
+func (_u *UploadUpdateOne) SetGrantID(id uuid.UUID) *UploadUpdateOne {
+	_u.mutation.SetGrantID(id)
+	return _u
+}
+
+// SetNillableGrantID sets the "grant" edge to the Grant entity by ID if the given value is not nil.
+func (_u *UploadUpdateOne) SetNillableGrantID(id *uuid.UUID) *UploadUpdateOne {
+	if id != nil {
+		_u = _u.SetGrantID(*id)
+	}
+	return _u
+}
+
+// SetGrant sets the "grant" edge to the Grant entity.
+func (_u *UploadUpdateOne) SetGrant(v *Grant) *UploadUpdateOne {
+	return _u.SetGrantID(v.ID)
+}
+
+// Mutation returns the UploadMutation object of the builder.
+func (_u *UploadUpdateOne) Mutation() *UploadMutation {
+	return _u.mutation
+}
+
+// ClearOwner clears the "owner" edge to the User entity.
+func (_u *UploadUpdateOne) ClearOwner() *UploadUpdateOne {
+	_u.mutation.ClearOwner()
+	return _u
+}
+
+// ClearGrant clears the "grant" edge to the Grant entity.
+func (_u *UploadUpdateOne) ClearGrant() *UploadUpdateOne {
+	_u.mutation.ClearGrant()
+	return _u
+}
+
+// Where appends a list predicates to the UploadUpdate builder.
+func (_u *UploadUpdateOne) Where(ps ...predicate.Upload) *UploadUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *UploadUpdateOne) Select(field string, fields ...string) *UploadUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated Upload entity.
+func (_u *UploadUpdateOne) Save(ctx context.Context) (*Upload, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *UploadUpdateOne) SaveX(ctx context.Context) *Upload {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *UploadUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *UploadUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (_u *UploadUpdateOne) sqlSave(ctx context.Context) (_node *Upload, err error) {
+	_spec := sqlgraph.NewUpdateSpec(upload.Table, upload.Columns, sqlgraph.NewFieldSpec(upload.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Upload.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, upload.FieldID)
+		for _, f := range fields {
+			if !upload.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != upload.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(upload.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Size(); ok {
+		_spec.SetField(upload.FieldSize, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedSize(); ok {
+		_spec.AddField(upload.FieldSize, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.Offset(); ok {
+		_spec.SetField(upload.FieldOffset, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedOffset(); ok {
+		_spec.AddField(upload.FieldOffset, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.CreatedAt(); ok {
+		_spec.SetField(upload.FieldCreatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Expiry(); ok {
+		_spec.SetField(upload.FieldExpiry, field.TypeTime, value)
+	}
+	if _u.mutation.OwnerCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   upload.OwnerTable,
+			Columns: []string{upload.OwnerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.OwnerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   upload.OwnerTable,
+			Columns: []string{upload.OwnerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.GrantCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   upload.GrantTable,
+			Columns: []string{upload.GrantColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(grant.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.GrantIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   upload.GrantTable,
+			Columns: []string{upload.GrantColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(grant.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &Upload{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{upload.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -37,6 +37,8 @@ type User struct {
 	SubmittedGrants int `json:"submitted_grants,omitempty"`
 	// TotalDataSize holds the value of the "totalDataSize" field.
 	TotalDataSize int64 `json:"totalDataSize,omitempty"`
+	// PhysicalDataSize holds the value of the "physical_data_size" field.
+	PhysicalDataSize int64 `json:"physical_data_size,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the UserQuery when eager-loading is set.
 	Edges        UserEdges `json:"edges"`
@@ -53,9 +55,11 @@ type UserEdges struct {
 	Grants []*Grant `json:"grants,omitempty"`
 	// Files holds the value of the files edge.
 	Files []*File `json:"files,omitempty"`
+	// Uploads holds the value of the uploads edge.
+	Uploads []*Upload `json:"uploads,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [4]bool
+	loadedTypes [5]bool
 }
 
 // SessionsOrErr returns the Sessions value or an error if the edge
@@ -94,6 +98,15 @@ func (e UserEdges) FilesOrErr() ([]*File, error) {
 	return nil, &NotLoadedError{edge: "files"}
 }
 
+// UploadsOrErr returns the Uploads value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) UploadsOrErr() ([]*Upload, error) {
+	if e.loadedTypes[4] {
+		return e.Uploads, nil
+	}
+	return nil, &NotLoadedError{edge: "uploads"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*User) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
@@ -103,7 +116,7 @@ func (*User) scanValues(columns []string) ([]any, error) {
 			values[i] = new([]byte)
 		case user.FieldIsAdmin:
 			values[i] = new(sql.NullBool)
-		case user.FieldSubmittedTickets, user.FieldSubmittedGrants, user.FieldTotalDataSize:
+		case user.FieldSubmittedTickets, user.FieldSubmittedGrants, user.FieldTotalDataSize, user.FieldPhysicalDataSize:
 			values[i] = new(sql.NullInt64)
 		case user.FieldUsername, user.FieldFullName, user.FieldEmail:
 			values[i] = new(sql.NullString)
@@ -188,6 +201,12 @@ func (_m *User) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.TotalDataSize = value.Int64
 			}
+		case user.FieldPhysicalDataSize:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field physical_data_size", values[i])
+			} else if value.Valid {
+				_m.PhysicalDataSize = value.Int64
+			}
 		default:
 			_m.selectValues.Set(columns[i], values[i])
 		}
@@ -221,6 +240,11 @@ func (_m *User) QueryFiles() *FileQuery {
 	return NewUserClient(_m.config).QueryFiles(_m)
 }
 
+// QueryUploads queries the "uploads" edge of the User entity.
+func (_m *User) QueryUploads() *UploadQuery {
+	return NewUserClient(_m.config).QueryUploads(_m)
+}
+
 // Update returns a builder for updating this User.
 // Note that you need to call User.Unwrap() before calling this method if this User
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -270,6 +294,9 @@ func (_m *User) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("totalDataSize=")
 	builder.WriteString(fmt.Sprintf("%v", _m.TotalDataSize))
+	builder.WriteString(", ")
+	builder.WriteString("physical_data_size=")
+	builder.WriteString(fmt.Sprintf("%v", _m.PhysicalDataSize))
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -32,6 +32,8 @@ const (
 	FieldSubmittedGrants = "submitted_grants"
 	// FieldTotalDataSize holds the string denoting the totaldatasize field in the database.
 	FieldTotalDataSize = "total_data_size"
+	// FieldPhysicalDataSize holds the string denoting the physical_data_size field in the database.
+	FieldPhysicalDataSize = "physical_data_size"
 	// EdgeSessions holds the string denoting the sessions edge name in mutations.
 	EdgeSessions = "sessions"
 	// EdgeTickets holds the string denoting the tickets edge name in mutations.
@@ -40,6 +42,8 @@ const (
 	EdgeGrants = "grants"
 	// EdgeFiles holds the string denoting the files edge name in mutations.
 	EdgeFiles = "files"
+	// EdgeUploads holds the string denoting the uploads edge name in mutations.
+	EdgeUploads = "uploads"
 	// Table holds the table name of the user in the database.
 	Table = "users"
 	// SessionsTable is the table that holds the sessions relation/edge.
@@ -70,6 +74,13 @@ const (
 	FilesInverseTable = "files"
 	// FilesColumn is the table column denoting the files relation/edge.
 	FilesColumn = "user_files"
+	// UploadsTable is the table that holds the uploads relation/edge.
+	UploadsTable = "uploads"
+	// UploadsInverseTable is the table name for the Upload entity.
+	// It exists in this package in order to avoid circular dependency with the "upload" package.
+	UploadsInverseTable = "uploads"
+	// UploadsColumn is the table column denoting the uploads relation/edge.
+	UploadsColumn = "user_uploads"
 )
 
 // Columns holds all SQL columns for user fields.
@@ -84,6 +95,7 @@ var Columns = []string{
 	FieldSubmittedTickets,
 	FieldSubmittedGrants,
 	FieldTotalDataSize,
+	FieldPhysicalDataSize,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -105,6 +117,8 @@ var (
 	DefaultSubmittedGrants int
 	// DefaultTotalDataSize holds the default value on creation for the "totalDataSize" field.
 	DefaultTotalDataSize int64
+	// DefaultPhysicalDataSize holds the default value on creation for the "physical_data_size" field.
+	DefaultPhysicalDataSize int64
 )
 
 // OrderOption defines the ordering options for the User queries.
@@ -155,6 +169,11 @@ func ByTotalDataSize(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldTotalDataSize, opts...).ToFunc()
 }
 
+// ByPhysicalDataSize orders the results by the physical_data_size field.
+func ByPhysicalDataSize(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPhysicalDataSize, opts...).ToFunc()
+}
+
 // BySessionsCount orders the results by sessions count.
 func BySessionsCount(opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -210,6 +229,20 @@ func ByFiles(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
 		sqlgraph.OrderByNeighborTerms(s, newFilesStep(), append([]sql.OrderTerm{term}, terms...)...)
 	}
 }
+
+// ByUploadsCount orders the results by uploads count.
+func ByUploadsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newUploadsStep(), opts...)
+	}
+}
+
+// ByUploads orders the results by uploads terms.
+func ByUploads(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newUploadsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
 func newSessionsStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -238,3 +271,10 @@ func newFilesStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.O2M, false, FilesTable, FilesColumn),
 	)
 }
+func newUploadsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(UploadsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, UploadsTable, UploadsColumn),
+	)
+}
@@ -96,6 +96,11 @@ func TotalDataSize(v int64) predicate.User {
 	return predicate.User(sql.FieldEQ(FieldTotalDataSize, v))
 }
 
+// PhysicalDataSize applies equality check predicate on the "physical_data_size" field. It's identical to PhysicalDataSizeEQ.
+func PhysicalDataSize(v int64) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldPhysicalDataSize, v))
+}
+
 // UsernameEQ applies the EQ predicate on the "username" field.
 func UsernameEQ(v string) predicate.User {
 	return predicate.User(sql.FieldEQ(FieldUsername, v))
@@ -461,6 +466,46 @@ func TotalDataSizeLTE(v int64) predicate.User {
 	return predicate.User(sql.FieldLTE(FieldTotalDataSize, v))
 }
 
+// PhysicalDataSizeEQ applies the EQ predicate on the "physical_data_size" field.
+func PhysicalDataSizeEQ(v int64) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldPhysicalDataSize, v))
+}
+
+// PhysicalDataSizeNEQ applies the NEQ predicate on the "physical_data_size" field.
+func PhysicalDataSizeNEQ(v int64) predicate.User {
+	return predicate.User(sql.FieldNEQ(FieldPhysicalDataSize, v))
+}
+
+// PhysicalDataSizeIn applies the In predicate on the "physical_data_size" field.
+func PhysicalDataSizeIn(vs ...int64) predicate.User {
+	return predicate.User(sql.FieldIn(FieldPhysicalDataSize, vs...))
+}
+
+// PhysicalDataSizeNotIn applies the NotIn predicate on the "physical_data_size" field.
+func PhysicalDataSizeNotIn(vs ...int64) predicate.User {
+	return predicate.User(sql.FieldNotIn(FieldPhysicalDataSize, vs...))
+}
+
+// PhysicalDataSizeGT applies the GT predicate on the "physical_data_size" field.
+func PhysicalDataSizeGT(v int64) predicate.User {
+	return predicate.User(sql.FieldGT(FieldPhysicalDataSize, v))
+}
+
+// PhysicalDataSizeGTE applies the GTE predicate on the "physical_data_size" field.
+func PhysicalDataSizeGTE(v int64) predicate.User {
+	return predicate.User(sql.FieldGTE(FieldPhysicalDataSize, v))
+}
+
+// PhysicalDataSizeLT applies the LT predicate on the "physical_data_size" field.
+func PhysicalDataSizeLT(v int64) predicate.User {
+	return predicate.User(sql.FieldLT(FieldPhysicalDataSize, v))
+}
+
+// PhysicalDataSizeLTE applies the LTE predicate on the "physical_data_size" field.
+func PhysicalDataSizeLTE(v int64) predicate.User {
+	return predicate.User(sql.FieldLTE(FieldPhysicalDataSize, v))
+}
+
 // HasSessions applies the HasEdge predicate on the "sessions" edge.
 func HasSessions() predicate.User {
 	return predicate.User(func(s *sql.Selector) {
@@ -553,6 +598,29 @@ func HasFilesWith(preds ...predicate.File) predicate.User {
 	})
 }
 
+// HasUploads applies the HasEdge predicate on the "uploads" edge.
+func HasUploads() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, UploadsTable, UploadsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasUploadsWith applies the HasEdge predicate on the "uploads" edge with a given conditions (other predicates).
+func HasUploadsWith(preds ...predicate.Upload) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := newUploadsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.User) predicate.User {
 	return predicate.User(sql.AndPredicates(predicates...))
@@ -12,6 +12,7 @@ import (
 	"codeberg.org/jvllmr/frans/internal/ent/grant"
 	"codeberg.org/jvllmr/frans/internal/ent/session"
 	"codeberg.org/jvllmr/frans/internal/ent/ticket"
+	"codeberg.org/jvllmr/frans/internal/ent/upload"
 	"codeberg.org/jvllmr/frans/internal/ent/user"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
@@ -111,6 +112,20 @@ func (_c *UserCreate) SetNillableTotalDataSize(v *int64) *UserCreate {
 	return _c
 }
 
+// SetPhysicalDataSize sets the "physical_data_size" field.
+func (_c *UserCreate) SetPhysicalDataSize(v int64) *UserCreate {
+	_c.mutation.SetPhysicalDataSize(v)
+	return _c
+}
+
+// SetNillablePhysicalDataSize sets the "physical_data_size" field if the given value is not nil.
+func (_c *UserCreate) SetNillablePhysicalDataSize(v *int64) *UserCreate {
+	if v != nil {
+		_c.SetPhysicalDataSize(*v)
+	}
+	return _c
+}
+
 // SetID sets the "id" field.
 func (_c *UserCreate) SetID(v uuid.UUID) *UserCreate {
 	_c.mutation.SetID(v)
@@ -177,6 +192,21 @@ func (_c *UserCreate) AddFiles(v ...*File) *UserCreate {
 	return _c.AddFileIDs(ids...)
 }
 
+// AddUploadIDs adds the "uploads" edge to the Upload entity by IDs.
+func (_c *UserCreate) AddUploadIDs(ids ...uuid.UUID) *UserCreate {
+	_c.mutation.AddUploadIDs(ids...)
+	return _c
+}
+
+// AddUploads adds the "uploads" edges to the Upload entity.
+func (_c *UserCreate) AddUploads(v ...*Upload) *UserCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddUploadIDs(ids...)
+}
+
 // Mutation returns the UserMutation object of the builder.
 func (_c *UserCreate) Mutation() *UserMutation {
 	return _c.mutation
@@ -228,6 +258,10 @@ func (_c *UserCreate) defaults() {
 		v := user.DefaultTotalDataSize
 		_c.mutation.SetTotalDataSize(v)
 	}
+	if _, ok := _c.mutation.PhysicalDataSize(); !ok {
+		v := user.DefaultPhysicalDataSize
+		_c.mutation.SetPhysicalDataSize(v)
+	}
 }
 
 // check runs all checks and user-defined validators on the builder.
@@ -259,6 +293,9 @@ func (_c *UserCreate) check() error {
 	if _, ok := _c.mutation.TotalDataSize(); !ok {
 		return &ValidationError{Name: "totalDataSize", err: errors.New(`ent: missing required field "User.totalDataSize"`)}
 	}
+	if _, ok := _c.mutation.PhysicalDataSize(); !ok {
+		return &ValidationError{Name: "physical_data_size", err: errors.New(`ent: missing required field "User.physical_data_size"`)}
+	}
 	return nil
 }
 
@@ -330,6 +367,10 @@ func (_c *UserCreate) createSpec() (*User, *sqlgraph.CreateSpec) {
 		_spec.SetField(user.FieldTotalDataSize, field.TypeInt64, value)
 		_node.TotalDataSize = value
 	}
+	if value, ok := _c.mutation.PhysicalDataSize(); ok {
+		_spec.SetField(user.FieldPhysicalDataSize, field.TypeInt64, value)
+		_node.PhysicalDataSize = value
+	}
 	if nodes := _c.mutation.SessionsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -394,6 +435,22 @@ func (_c *UserCreate) createSpec() (*User, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := _c.mutation.UploadsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.UploadsTable,
+			Columns: []string{user.UploadsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(upload.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
@@ -13,6 +13,7 @@ import (
 	"codeberg.org/jvllmr/frans/internal/ent/predicate"
 	"codeberg.org/jvllmr/frans/internal/ent/session"
 	"codeberg.org/jvllmr/frans/internal/ent/ticket"
+	"codeberg.org/jvllmr/frans/internal/ent/upload"
 	"codeberg.org/jvllmr/frans/internal/ent/user"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
@@ -179,6 +180,27 @@ func (_u *UserUpdate) AddTotalDataSize(v int64) *UserUpdate {
 	return _u
 }
 
+// SetPhysicalDataSize sets the "physical_data_size" field.
+func (_u *UserUpdate) SetPhysicalDataSize(v int64) *UserUpdate {
+	_u.mutation.ResetPhysicalDataSize()
+	_u.mutation.SetPhysicalDataSize(v)
+	return _u
+}
+
+// SetNillablePhysicalDataSize sets the "physical_data_size" field if the given value is not nil.
+func (_u *UserUpdate) SetNillablePhysicalDataSize(v *int64) *UserUpdate {
+	if v != nil {
+		_u.SetPhysicalDataSize(*v)
+	}
+	return _u
+}
+
+// AddPhysicalDataSize adds value to the "physical_data_size" field.
+func (_u *UserUpdate) AddPhysicalDataSize(v int64) *UserUpdate {
+	_u.mutation.AddPhysicalDataSize(v)
+	return _u
+}
+
 // AddSessionIDs adds the "sessions" edge to the Session entity by IDs.
 func (_u *UserUpdate) AddSessionIDs(ids ...int) *UserUpdate {
 	_u.mutation.AddSessionIDs(ids...)
@@ -239,6 +261,21 @@ func (_u *UserUpdate) AddFiles(v ...*File) *UserUpdate {
 	return _u.AddFileIDs(ids...)
 }
 
+// AddUploadIDs adds the "uploads" edge to the Upload entity by IDs.
+func (_u *UserUpdate) AddUploadIDs(ids ...uuid.UUID) *UserUpdate {
+	_u.mutation.AddUploadIDs(ids...)
+	return _u
+}
+
+// AddUploads adds the "uploads" edges to the Upload entity.
+func (_u *UserUpdate) AddUploads(v ...*Upload) *UserUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddUploadIDs(ids...)
+}
+
 // Mutation returns the UserMutation object of the builder.
 func (_u *UserUpdate) Mutation() *UserMutation {
 	return _u.mutation
@@ -328,6 +365,27 @@ func (_u *UserUpdate) RemoveFiles(v ...*File) *UserUpdate {
 	return _u.RemoveFileIDs(ids...)
 }
 
+// ClearUploads clears all "uploads" edges to the Upload entity.
+func (_u *UserUpdate) ClearUploads() *UserUpdate {
+	_u.mutation.ClearUploads()
+	return _u
+}
+
+// RemoveUploadIDs removes the "uploads" edge to Upload entities by IDs.
+func (_u *UserUpdate) RemoveUploadIDs(ids ...uuid.UUID) *UserUpdate {
+	_u.mutation.RemoveUploadIDs(ids...)
+	return _u
+}
+
+// RemoveUploads removes "uploads" edges to Upload entities.
+func (_u *UserUpdate) RemoveUploads(v ...*Upload) *UserUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveUploadIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (_u *UserUpdate) Save(ctx context.Context) (int, error) {
 	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
@@ -405,6 +463,12 @@ func (_u *UserUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if value, ok := _u.mutation.AddedTotalDataSize(); ok {
 		_spec.AddField(user.FieldTotalDataSize, field.TypeInt64, value)
 	}
+	if value, ok := _u.mutation.PhysicalDataSize(); ok {
+		_spec.SetField(user.FieldPhysicalDataSize, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedPhysicalDataSize(); ok {
+		_spec.AddField(user.FieldPhysicalDataSize, field.TypeInt64, value)
+	}
 	if _u.mutation.SessionsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -585,6 +649,51 @@ func (_u *UserUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.UploadsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.UploadsTable,
+			Columns: []string{user.UploadsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(upload.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedUploadsIDs(); len(nodes) > 0 && !_u.mutation.UploadsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.UploadsTable,
+			Columns: []string{user.UploadsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(upload.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.UploadsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.UploadsTable,
+			Columns: []string{user.UploadsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(upload.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{user.Label}
@@ -750,6 +859,27 @@ func (_u *UserUpdateOne) AddTotalDataSize(v int64) *UserUpdateOne {
 	return _u
 }
 
+// SetPhysicalDataSize sets the "physical_data_size" field.
+func (_u *UserUpdateOne) SetPhysicalDataSize(v int64) *UserUpdateOne {
+	_u.mutation.ResetPhysicalDataSize()
+	_u.mutation.SetPhysicalDataSize(v)
+	return _u
+}
+
+// SetNillablePhysicalDataSize sets the "physical_data_size" field if the given value is not nil.
+func (_u *UserUpdateOne) SetNillablePhysicalDataSize(v *int64) *UserUpdateOne {
+	if v != nil {
+		_u.SetPhysicalDataSize(*v)
+	}
+	return _u
+}
+
+// AddPhysicalDataSize adds value to the "physical_data_size" field.
+func (_u *UserUpdateOne) AddPhysicalDataSize(v int64) *UserUpdateOne {
+	_u.mutation.AddPhysicalDataSize(v)
+	return _u
+}
+
 // AddSessionIDs adds the "sessions" edge to the Session entity by IDs.
 func (_u *UserUpdateOne) AddSessionIDs(ids ...int) *UserUpdateOne {
 	_u.mutation.AddSessionIDs(ids...)
@@ -810,6 +940,21 @@ func (_u *UserUpdateOne) AddFiles(v ...*File) *UserUpdateOne {
 	return _u.AddFileIDs(ids...)
 }
 
+// AddUploadIDs adds the "uploads" edge to the Upload entity by IDs.
+func (_u *UserUpdateOne) AddUploadIDs(ids ...uuid.UUID) *UserUpdateOne {
+	_u.mutation.AddUploadIDs(ids...)
+	return _u
+}
+
+// AddUploads adds the "uploads" edges to the Upload entity.
+func (_u *UserUpdateOne) AddUploads(v ...*Upload) *UserUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddUploadIDs(ids...)
+}
+
 // Mutation returns the UserMutation object of the builder.
 func (_u *UserUpdateOne) Mutation() *UserMutation {
 	return _u.mutation
@@ -899,6 +1044,27 @@ func (_u *UserUpdateOne) RemoveFiles(v ...*File) *UserUpdateOne {
 	return _u.RemoveFileIDs(ids...)
 }
 
+// ClearUploads clears all "uploads" edges to the Upload entity.
+func (_u *UserUpdateOne) ClearUploads() *UserUpdateOne {
+	_u.mutation.ClearUploads()
+	return _u
+}
+
+// RemoveUploadIDs removes the "uploads" edge to Upload entities by IDs.
+func (_u *UserUpdateOne) RemoveUploadIDs(ids ...uuid.UUID) *UserUpdateOne {
+	_u.mutation.RemoveUploadIDs(ids...)
+	return _u
+}
+
+// RemoveUploads removes "uploads" edges to Upload entities.
+func (_u *UserUpdateOne) RemoveUploads(v ...*Upload) *UserUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveUploadIDs(ids...)
+}
+
 // Where appends a list predicates to the UserUpdate builder.
 func (_u *UserUpdateOne) Where(ps ...predicate.User) *UserUpdateOne {
 	_u.mutation.Where(ps...)
@@ -1006,6 +1172,12 @@ func (_u *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error) {
 	if value, ok := _u.mutation.AddedTotalDataSize(); ok {
 		_spec.AddField(user.FieldTotalDataSize, field.TypeInt64, value)
 	}
+	if value, ok := _u.mutation.PhysicalDataSize(); ok {
+		_spec.SetField(user.FieldPhysicalDataSize, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedPhysicalDataSize(); ok {
+		_spec.AddField(user.FieldPhysicalDataSize, field.TypeInt64, value)
+	}
 	if _u.mutation.SessionsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -1186,6 +1358,51 @@ func (_u *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.UploadsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.UploadsTable,
+			Columns: []string{user.UploadsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(upload.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedUploadsIDs(); len(nodes) > 0 && !_u.mutation.UploadsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.UploadsTable,
+			Columns: []string{user.UploadsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(upload.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.UploadsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.UploadsTable,
+			Columns: []string{user.UploadsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(upload.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &User{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
@@ -0,0 +1,49 @@
+package mail
+
+import (
+	"bytes"
+	"fmt"
+	"text/template"
+
+	"codeberg.org/jvllmr/frans/internal/ent"
+	"github.com/wneessen/go-mail"
+)
+
+func (m *Mailer) SendInfectedFileNotification(
+	to string,
+	fileValue *ent.File,
+	signature string,
+) error {
+	lang := "en"
+
+	if fileValue.Edges.Grant != nil {
+		lang = fileValue.Edges.Grant.CreatorLang
+	}
+
+	if fileValue.Edges.Ticket != nil {
+		lang = fileValue.Edges.Ticket.CreatorLang
+	}
+
+	getTranslation := getTranslationFactory(lang)
+	message := mail.NewMsg()
+
+	if err := message.To(to); err != nil {
+		return err
+	}
+
+	subject := fmt.Sprintf("%s %s", getTranslation("subject_infected"), fileValue.Name)
+	message.Subject(subject)
+
+	bodyTmpl := getTranslation("notification_infected")
+	bodyData := map[string]string{
+		"FileName":  fileValue.Name,
+		"ID":        fileValue.ID.String(),
+		"Signature": signature,
+	}
+	var body bytes.Buffer
+	if err := template.Must(template.New("").Parse(bodyTmpl)).Execute(&body, bodyData); err != nil {
+		panic(err)
+	}
+	message.SetBodyString(mail.TypeTextPlain, body.String())
+	return m.sendMail(message)
+}
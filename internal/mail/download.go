@@ -10,11 +10,14 @@ import (
 	"github.com/wneessen/go-mail"
 )
 
+// SendFileDownloadNotification notifies about the download of a file of a ticket.
+// recipientValue is the recipient who downloaded the file with their personal link, if any.
 func (m *Mailer) SendFileDownloadNotification(
 	ctx *gin.Context,
 	to string,
 	ticketValue *ent.Ticket,
 	fileValue *ent.File,
+	recipientValue *ent.Recipient,
 ) error {
 	getTranslation := getTranslationFactory(ticketValue.CreatorLang)
 	message := mail.NewMsg()
@@ -37,6 +40,10 @@ func (m *Mailer) SendFileDownloadNotification(
 		"TicketName": ticketValue.ID.String(),
 		"Address":    ctx.ClientIP(),
 	}
+	if recipientValue != nil {
+		bodyTmpl = getTranslation("notification_download_recipient")
+		bodyData["RecipientName"] = recipientValue.Name
+	}
 	var body bytes.Buffer
 	if err := template.Must(template.New("").Parse(bodyTmpl)).Execute(&body, bodyData); err != nil {
 		panic(err)
@@ -18,6 +18,40 @@ func (m *Mailer) SendTicketSharedNotification(
 	locale string,
 	ticketValue *ent.Ticket,
 	password *string,
+) error {
+	return m.sendTicketLink(
+		to,
+		locale,
+		ticketValue,
+		ticketService.TicketShareLink(ctx, ticketValue),
+		password,
+	)
+}
+
+// SendRecipientSharedNotification sends the personal link with token to a recipient of a ticket
+func (m *Mailer) SendRecipientSharedNotification(
+	ctx *gin.Context,
+	recipientService services.RecipientService,
+	locale string,
+	ticketValue *ent.Ticket,
+	recipientValue *ent.Recipient,
+	token string,
+) error {
+	return m.sendTicketLink(
+		*recipientValue.Email,
+		locale,
+		ticketValue,
+		recipientService.RecipientShareLink(ctx, ticketValue, token),
+		nil,
+	)
+}
+
+func (m *Mailer) sendTicketLink(
+	to string,
+	locale string,
+	ticketValue *ent.Ticket,
+	link string,
+	password *string,
 ) error {
 	getTranslation := getTranslationFactory(locale)
 	message := mail.NewMsg()
@@ -47,7 +81,7 @@ func (m *Mailer) SendTicketSharedNotification(
 	body := fmt.Sprintf(
 		"%s: %s",
 		getTranslation("url"),
-		ticketService.TicketShareLink(ctx, ticketValue),
+		link,
 	)
 
 	if password != nil {
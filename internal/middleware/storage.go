@@ -0,0 +1,22 @@
+package middleware
+
+import (
+	"net/http"
+
+	"codeberg.org/jvllmr/frans/internal/otel"
+	"codeberg.org/jvllmr/frans/internal/services"
+	"codeberg.org/jvllmr/frans/internal/util"
+	"github.com/gin-gonic/gin"
+)
+
+// WritableStorageRequired refuses requests while uploads are disabled by the disk watermarks
+func WritableStorageRequired(diskService services.DiskService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx, span := otel.NewSpan(c.Request.Context(), "writableStorageRequired")
+		defer span.End()
+		if err := diskService.CheckWritable(ctx); err != nil {
+			util.GinAbortWithPublicError(ctx, c, http.StatusInsufficientStorage, err)
+			return
+		}
+	}
+}
@@ -0,0 +1,85 @@
+package apiRoutes
+
+import (
+	"context"
+	"net/http"
+
+	"codeberg.org/jvllmr/frans/internal/ent/accessevent"
+	"codeberg.org/jvllmr/frans/internal/ent/grant"
+	"codeberg.org/jvllmr/frans/internal/ent/predicate"
+	"codeberg.org/jvllmr/frans/internal/ent/ticket"
+	"codeberg.org/jvllmr/frans/internal/middleware"
+	"codeberg.org/jvllmr/frans/internal/otel"
+	apiTypes "codeberg.org/jvllmr/frans/internal/routes/api/types"
+	"codeberg.org/jvllmr/frans/internal/services"
+	"codeberg.org/jvllmr/frans/internal/util"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+func respondAccessEvents(
+	ctx context.Context,
+	c *gin.Context,
+	accessEventService services.AccessEventService,
+	predicates ...predicate.AccessEvent,
+) {
+	events, err := accessEventService.Events(ctx, predicates...)
+	if err != nil {
+		util.GinAbortWithError(ctx, c, http.StatusInternalServerError, err)
+		return
+	}
+	publicEvents := make([]services.PublicAccessEvent, len(events))
+	for i, event := range events {
+		publicEvents[i] = services.ToPublicAccessEvent(event)
+	}
+	c.JSON(http.StatusOK, publicEvents)
+}
+
+func (tc *ticketController) fetchTicketEventsHandler(c *gin.Context) {
+	ctx, span := otel.NewSpan(c.Request.Context(), "fetchTicketAccessEvents")
+	defer span.End()
+	var requestedTicket apiTypes.RequestedTicketParam
+	if err := c.ShouldBindUri(&requestedTicket); err != nil {
+		util.GinAbortWithError(ctx, c, http.StatusBadRequest, err)
+		return
+	}
+	ticketValue, ok := tc.fetchManagedTicket(ctx, c, requestedTicket.ID)
+	if !ok {
+		return
+	}
+	respondAccessEvents(
+		ctx,
+		c,
+		tc.accessEventService,
+		accessevent.HasTicketWith(ticket.ID(ticketValue.ID)),
+	)
+}
+
+func (gc *grantController) fetchGrantEventsHandler(c *gin.Context) {
+	ctx, span := otel.NewSpan(c.Request.Context(), "fetchGrantAccessEvents")
+	defer span.End()
+	var requestedGrant apiTypes.RequestedGrantParam
+	if err := c.ShouldBindUri(&requestedGrant); err != nil {
+		util.GinAbortWithError(ctx, c, http.StatusBadRequest, err)
+		return
+	}
+	grantValue, err := gc.db.Grant.Query().
+		Where(grant.ID(uuid.MustParse(requestedGrant.ID))).
+		WithOwner().
+		Only(ctx)
+	if err != nil {
+		util.GinAbortWithError(ctx, c, http.StatusNotFound, err)
+		return
+	}
+	currentUser := middleware.GetCurrentUser(c)
+	if !currentUser.IsAdmin && grantValue.Edges.Owner.ID != currentUser.ID {
+		c.AbortWithStatus(http.StatusForbidden)
+		return
+	}
+	respondAccessEvents(
+		ctx,
+		c,
+		gc.accessEventService,
+		accessevent.HasGrantWith(grant.ID(grantValue.ID)),
+	)
+}
@@ -0,0 +1,112 @@
+package apiRoutes
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"codeberg.org/jvllmr/frans/internal/config"
+	"codeberg.org/jvllmr/frans/internal/services"
+	"codeberg.org/jvllmr/frans/internal/testutil"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func fetchTestAccessEvents(r *gin.Engine, path string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(http.MethodGet, path, nil)
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+	return w
+}
+
+func TestFetchTicketAccessEvents(t *testing.T) {
+	cfg := testutil.SetupTestConfig()
+	db := testutil.SetupTestDBClient(t)
+	testUser := testutil.SetupTestUser(t, db, nil)
+	testOwner := testutil.SetupTestUser(t, db, nil)
+	rUser := setupTestTicketRouter(cfg, db, testutil.NewTestAuthMiddleware(testUser))
+	rOwner := setupTestTicketRouter(cfg, db, testutil.NewTestAuthMiddleware(testOwner))
+
+	testTicket := createTestTicket(t, rOwner, func(writer *multipart.Writer) int {
+		partWriter, _ := writer.CreateFormFile("files[]", "a.txt")
+		io.Copy(partWriter, strings.NewReader("Content of a.txt"))
+		return http.StatusCreated
+	})
+	otherTicket := createTestTicket(t, rOwner, func(writer *multipart.Writer) int {
+		partWriter, _ := writer.CreateFormFile("files[]", "b.txt")
+		io.Copy(partWriter, strings.NewReader("Content of b.txt"))
+		return http.StatusCreated
+	})
+	fileValue := db.File.GetX(t.Context(), testTicket.Files[0].Id)
+	db.AccessEvent.Create().
+		SetType(config.AccessEventTypeView).
+		SetAddress("192.0.2.1").
+		SetTicketID(testTicket.ID).
+		ExecX(t.Context())
+	db.AccessEvent.Create().
+		SetType(config.AccessEventTypeDownload).
+		SetAddress("192.0.2.1").
+		SetBytesServed(16).
+		SetFile(fileValue).
+		SetFileName(fileValue.Name).
+		SetTicketID(testTicket.ID).
+		ExecX(t.Context())
+	db.AccessEvent.Create().
+		SetType(config.AccessEventTypeView).
+		SetAddress("192.0.2.2").
+		SetTicketID(otherTicket.ID).
+		ExecX(t.Context())
+
+	eventsPath := fmt.Sprintf("/%s/event", testTicket.ID)
+	w := fetchTestAccessEvents(rUser, eventsPath)
+	assert.Equal(t, http.StatusForbidden, w.Code)
+	w = fetchTestAccessEvents(rOwner, eventsPath)
+	require.Equal(t, http.StatusOK, w.Code)
+	var events []services.PublicAccessEvent
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &events))
+	require.Len(t, events, 2)
+	assert.Equal(t, config.AccessEventTypeDownload, events[0].Type)
+	assert.Equal(t, int64(16), events[0].BytesServed)
+	assert.Equal(t, fileValue.ID, *events[0].FileID)
+	assert.Equal(t, "a.txt", *events[0].FileName)
+	assert.Equal(t, config.AccessEventTypeView, events[1].Type)
+	assert.Nil(t, events[1].FileID)
+}
+
+func TestFetchGrantAccessEvents(t *testing.T) {
+	cfg := testutil.SetupTestConfig()
+	db := testutil.SetupTestDBClient(t)
+	testUser := testutil.SetupTestUser(t, db, nil)
+	testOwner := testutil.SetupTestUser(t, db, nil)
+	rUser := setupTestGrantRouter(cfg, db, testutil.NewTestAuthMiddleware(testUser))
+	rOwner := setupTestGrantRouter(cfg, db, testutil.NewTestAuthMiddleware(testOwner))
+	testGrant := createTestGrant(t, db, testOwner, nil)
+	db.AccessEvent.Create().
+		SetType(config.AccessEventTypeAuthFailed).
+		SetAddress("192.0.2.1").
+		SetUserAgent("frans-test").
+		SetGrant(testGrant).
+		ExecX(t.Context())
+
+	eventsPath := fmt.Sprintf("/%s/event", testGrant.ID)
+	w := fetchTestAccessEvents(rUser, eventsPath)
+	assert.Equal(t, http.StatusForbidden, w.Code)
+	w = fetchTestAccessEvents(rOwner, eventsPath)
+	require.Equal(t, http.StatusOK, w.Code)
+	var events []services.PublicAccessEvent
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &events))
+	require.Len(t, events, 1)
+	assert.Equal(t, config.AccessEventTypeAuthFailed, events[0].Type)
+	assert.Equal(t, "192.0.2.1", events[0].Address)
+	assert.Equal(t, "frans-test", events[0].UserAgent)
+
+	// events are deleted with their grant
+	db.Grant.DeleteOne(testGrant).ExecX(t.Context())
+	assert.Equal(t, 0, db.AccessEvent.Query().CountX(t.Context()))
+}
@@ -25,7 +25,7 @@ func SetupAPIRoutes(
 	v1Group := apiGroup.Group("/v1")
 
 	userGroup := v1Group.Group("/user", auth)
-	setupUserGroup(userGroup, db)
+	setupUserGroup(userGroup, configValue, db)
 
 	ticketGroup := v1Group.Group("/ticket", auth)
 	setupTicketGroup(ticketGroup, configValue, db)
@@ -36,6 +36,15 @@ func SetupAPIRoutes(
 	fileGroup := v1Group.Group("/file", auth)
 	setupFileGroup(fileGroup, configValue, db)
 
+	uploadGroup := v1Group.Group("/upload", auth)
+	setupUploadGroup(uploadGroup, configValue, db)
+
+	storageGroup := v1Group.Group("/storage", auth)
+	setupStorageGroup(storageGroup, configValue, db)
+
+	healthGroup := v1Group.Group("/health")
+	setupHealthGroup(healthGroup, configValue)
+
 	shareGroup := v1Group.Group("/share")
 	shareRoutes.SetupShareRoutes(shareGroup, configValue, db)
 }
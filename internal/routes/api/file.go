@@ -1,10 +1,10 @@
 package apiRoutes
 
 import (
+	"errors"
 	"fmt"
 	"log/slog"
 	"net/http"
-	"time"
 
 	"codeberg.org/jvllmr/frans/internal/config"
 	"codeberg.org/jvllmr/frans/internal/ent"
@@ -22,10 +22,11 @@ import (
 )
 
 type fileController struct {
-	cfg         config.Config
-	db          *ent.Client
-	fileService services.FileService
-	mailer      mail.Mailer
+	cfg             config.Config
+	db              *ent.Client
+	fileService     services.FileService
+	downloadService services.DownloadService
+	mailer          mail.Mailer
 }
 
 func (fc *fileController) fetchReceivedFilesHandler(c *gin.Context) {
@@ -80,16 +81,66 @@ func (fc *fileController) fetchFileHandler(c *gin.Context) {
 		return
 	}
 
+	if err := services.CheckScanStatus(fileValue); err != nil {
+		util.GinAbortWithPublicError(ctx, c, http.StatusForbidden, err)
+		return
+	}
+
 	addDownload := c.Query("addDownload")
 	if len(addDownload) > 0 {
-		fc.db.File.UpdateOne(fileValue).
-			AddTimesDownloaded(1).
-			SetLastDownload(time.Now()).
-			ExecX(ctx)
+		downloadToken := fmt.Sprintf("user:%s", currentUser.ID)
+		if _, err := fc.downloadService.ServeDownload(c, fileValue, downloadToken); err != nil {
+			util.GinAbortWithError(ctx, c, http.StatusInternalServerError, err)
+		}
+		return
+	}
+
+	if _, err := fc.fileService.ServeFileAttachment(c, fileValue); err != nil {
+		util.GinAbortWithError(ctx, c, http.StatusInternalServerError, err)
+		return
+	}
+}
+
+func (fc *fileController) fetchFilePreviewHandler(c *gin.Context) {
+	ctx, span := otel.NewSpan(c.Request.Context(), "fetchFilePreview")
+	defer span.End()
+	var requestedFile apiTypes.RequestedFileParam
+	if err := c.ShouldBindUri(&requestedFile); err != nil {
+		util.GinAbortWithError(ctx, c, http.StatusBadRequest, err)
+		return
+	}
+	fileValue, err := fc.db.File.Query().
+		WithData().WithOwner().
+		Where(file.ID(uuid.MustParse(requestedFile.ID))).
+		Only(ctx)
+	if err != nil {
+		util.GinAbortWithError(ctx, c, http.StatusNotFound, err)
+		return
+	}
+
+	currentUser := middleware.GetCurrentUser(c)
+
+	if !util.UserHasFileAccess(ctx, currentUser, fileValue) {
+		util.GinAbortWithError(ctx,
+			c,
+			http.StatusForbidden,
+			fmt.Errorf("user %s does not have access to file", currentUser.Username),
+		)
+		return
 	}
 
-	filePath := fc.fileService.FilesFilePath(fileValue.Edges.Data.ID)
-	c.FileAttachment(filePath, fileValue.Name)
+	if err := services.CheckScanStatus(fileValue); err != nil {
+		util.GinAbortWithPublicError(ctx, c, http.StatusForbidden, err)
+		return
+	}
+
+	if err := fc.fileService.ServePreview(c, fileValue); err != nil {
+		if errors.Is(err, services.ErrPreviewNotFound) {
+			util.GinAbortWithError(ctx, c, http.StatusNotFound, err)
+			return
+		}
+		util.GinAbortWithError(ctx, c, http.StatusInternalServerError, err)
+	}
 }
 
 func (fc *fileController) deleteFileHandler(c *gin.Context) {
@@ -118,7 +169,7 @@ func (fc *fileController) deleteFileHandler(c *gin.Context) {
 		return
 	}
 
-	if err := fc.fileService.DeleteFile(ctx, f); err != nil {
+	if err := fc.fileService.DeleteSingleFile(ctx, f); err != nil {
 		util.GinAbortWithError(ctx, c, http.StatusInternalServerError, err)
 		return
 	}
@@ -144,12 +195,14 @@ func (fc *fileController) deleteFileHandler(c *gin.Context) {
 
 func setupFileGroup(r *gin.RouterGroup, cfg config.Config, db *ent.Client) {
 	controller := fileController{
-		cfg:         cfg,
-		db:          db,
-		fileService: services.NewFileService(cfg, db),
-		mailer:      mail.NewMailer(cfg),
+		cfg:             cfg,
+		db:              db,
+		fileService:     services.NewFileService(cfg, db),
+		downloadService: services.NewDownloadService(cfg, db),
+		mailer:          mail.NewMailer(cfg),
 	}
 	r.GET("/received", controller.fetchReceivedFilesHandler)
 	r.GET("/:fileId", controller.fetchFileHandler)
+	r.GET("/:fileId/preview", controller.fetchFilePreviewHandler)
 	r.DELETE("/:fileId", controller.deleteFileHandler)
 }
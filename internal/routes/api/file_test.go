@@ -1,20 +1,28 @@
 package apiRoutes
 
 import (
+	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"log"
 	"net/http"
 	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
 	"testing"
 
 	"codeberg.org/jvllmr/frans/internal/config"
 	"codeberg.org/jvllmr/frans/internal/ent"
+	"codeberg.org/jvllmr/frans/internal/ent/file"
 	"codeberg.org/jvllmr/frans/internal/services"
 	"codeberg.org/jvllmr/frans/internal/testutil"
 	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func setupTestFileRouter(
@@ -53,6 +61,8 @@ func TestFetchFile(t *testing.T) {
 	w := httptest.NewRecorder()
 	r.ServeHTTP(w, req)
 	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "Hello there!", w.Body.String())
+	assert.Equal(t, `attachment; filename=test.txt`, w.Header().Get("Content-Disposition"))
 	testFile = db.File.GetX(t.Context(), testFile.ID)
 	assert.True(t, nil == testFile.LastDownload)
 	assert.Equal(t, uint64(0), testFile.TimesDownloaded)
@@ -83,6 +93,295 @@ func TestFetchFile(t *testing.T) {
 
 }
 
+func TestFetchFileScanStatus(t *testing.T) {
+	cfg := testutil.SetupTestConfig()
+	cfg.FilesAntivirus.Enabled = true
+	db := testutil.SetupTestDBClient(t)
+
+	testUser := testutil.SetupTestUser(t, db, nil)
+	testFile := testutil.SetupTestFile(
+		t,
+		cfg,
+		db,
+		"test.txt",
+		"Hello there!",
+		testUser,
+		"single",
+		0,
+		0,
+		1,
+	)
+	assert.Equal(t, config.FileScanStatusPending, testFile.ScanStatus)
+	r := setupTestFileRouter(cfg, db, testutil.NewTestAuthMiddleware(testUser))
+	req := httptest.NewRequest(http.MethodGet, fmt.Sprintf("/%s", testFile.ID), nil)
+
+	for _, scanStatus := range []string{
+		config.FileScanStatusPending,
+		config.FileScanStatusInfected,
+		config.FileScanStatusClean,
+	} {
+		db.File.UpdateOne(testFile).SetScanStatus(scanStatus).ExecX(t.Context())
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+		if scanStatus == config.FileScanStatusClean {
+			assert.Equal(t, http.StatusOK, w.Code)
+			assert.Equal(t, "Hello there!", w.Body.String())
+		} else {
+			assert.Equal(t, http.StatusForbidden, w.Code, scanStatus)
+			assert.Contains(t, w.Body.String(), "error", scanStatus)
+		}
+	}
+}
+
+func TestFetchEncryptedFile(t *testing.T) {
+	cfg := testutil.SetupTestConfig()
+	// base64 of "0123456789abcdef0123456789abcdef"
+	cfg.FilesEncryption.Key = "MDEyMzQ1Njc4OWFiY2RlZjAxMjM0NTY3ODlhYmNkZWY="
+	db := testutil.SetupTestDBClient(t)
+
+	testUser := testutil.SetupTestUser(t, db, nil)
+	testFile := testutil.SetupTestFile(
+		t,
+		cfg,
+		db,
+		"test.txt",
+		"Hello there!",
+		testUser,
+		"single",
+		0,
+		0,
+		1,
+	)
+	fileData := db.File.QueryData(testFile).OnlyX(t.Context())
+	assert.NotNil(t, fileData.KeyID)
+	blobKey := services.NewFileService(cfg, db).BlobKey(fileData.ID)
+	storedContent, err := os.ReadFile(filepath.Join(cfg.FilesDir, blobKey))
+	assert.NoError(t, err)
+	assert.NotContains(t, string(storedContent), "Hello there!")
+
+	r := setupTestFileRouter(cfg, db, testutil.NewTestAuthMiddleware(testUser))
+
+	req := httptest.NewRequest(http.MethodGet, fmt.Sprintf("/%s", testFile.ID), nil)
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "Hello there!", w.Body.String())
+
+	req.Header.Set("Range", "bytes=6-10")
+	w = httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+	assert.Equal(t, http.StatusPartialContent, w.Code)
+	assert.Equal(t, "there", w.Body.String())
+}
+
+func TestFetchCompressedFile(t *testing.T) {
+	content := strings.Repeat("Hello there! ", 200)
+	cases := map[string]string{
+		"plain": "",
+		// base64 of "0123456789abcdef0123456789abcdef"
+		"encrypted": "MDEyMzQ1Njc4OWFiY2RlZjAxMjM0NTY3ODlhYmNkZWY=",
+	}
+	for name, encryptionKey := range cases {
+		t.Run(name, func(t *testing.T) {
+			cfg := testutil.SetupTestConfig()
+			cfg.FilesDir = t.TempDir()
+			cfg.FilesEncryption.Key = encryptionKey
+			cfg.FilesCompression.Enabled = true
+			db := testutil.SetupTestDBClient(t)
+
+			testUser := testutil.SetupTestUser(t, db, nil)
+			testFile := testutil.SetupTestFile(
+				t,
+				cfg,
+				db,
+				"test.log",
+				content,
+				testUser,
+				"single",
+				0,
+				0,
+				1,
+			)
+			fileData := db.File.QueryData(testFile).OnlyX(t.Context())
+			assert.Equal(t, services.CompressionZstd, *fileData.Compression)
+			assert.Less(t, *fileData.CompressedSize, fileData.Size)
+			assert.Equal(t, uint64(len(content)), fileData.Size)
+			blobKey := services.NewFileService(cfg, db).BlobKey(fileData.ID)
+			blobInfo, err := os.Stat(filepath.Join(cfg.FilesDir, blobKey))
+			assert.NoError(t, err)
+			assert.Less(t, blobInfo.Size(), int64(len(content)))
+			assert.Equal(t, blobInfo.Size(), fileData.StoredSize)
+			userValue := db.User.GetX(t.Context(), testUser.ID)
+			assert.Equal(t, blobInfo.Size(), userValue.PhysicalDataSize)
+			assert.Equal(t, int64(len(content)), userValue.TotalDataSize)
+
+			r := setupTestFileRouter(cfg, db, testutil.NewTestAuthMiddleware(testUser))
+			req := httptest.NewRequest(http.MethodGet, fmt.Sprintf("/%s", testFile.ID), nil)
+			w := httptest.NewRecorder()
+			r.ServeHTTP(w, req)
+			assert.Equal(t, http.StatusOK, w.Code)
+			assert.Equal(t, content, w.Body.String())
+
+			req.Header.Set("Range", "bytes=1306-1310")
+			w = httptest.NewRecorder()
+			r.ServeHTTP(w, req)
+			assert.Equal(t, http.StatusPartialContent, w.Code)
+			assert.Equal(t, "there", w.Body.String())
+		})
+	}
+}
+
+func TestCompressionSkipsCompressedTypes(t *testing.T) {
+	cfg := testutil.SetupTestConfig()
+	cfg.FilesDir = t.TempDir()
+	cfg.FilesCompression.Enabled = true
+	db := testutil.SetupTestDBClient(t)
+
+	testUser := testutil.SetupTestUser(t, db, nil)
+	testFile := testutil.SetupTestFile(
+		t,
+		cfg,
+		db,
+		"test.zip",
+		strings.Repeat("PK", 200),
+		testUser,
+		"single",
+		0,
+		0,
+		1,
+	)
+	fileData := db.File.QueryData(testFile).OnlyX(t.Context())
+	assert.Nil(t, fileData.Compression)
+	assert.Nil(t, fileData.CompressedSize)
+	blobKey := services.NewFileService(cfg, db).BlobKey(fileData.ID)
+	storedContent, err := os.ReadFile(filepath.Join(cfg.FilesDir, blobKey))
+	assert.NoError(t, err)
+	assert.Equal(t, strings.Repeat("PK", 200), string(storedContent))
+}
+
+func TestFetchFileRangeDownloadAccounting(t *testing.T) {
+	cfg := testutil.SetupTestConfig()
+	db := testutil.SetupTestDBClient(t)
+
+	testUser := testutil.SetupTestUser(t, db, nil)
+	testFile := testutil.SetupTestFile(
+		t,
+		cfg,
+		db,
+		"test.txt",
+		"Hello there!",
+		testUser,
+		"single",
+		0,
+		0,
+		1,
+	)
+	r := setupTestFileRouter(cfg, db, testutil.NewTestAuthMiddleware(testUser))
+	fetchRange := func(rangeHeader string, ifRange string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(
+			http.MethodGet,
+			fmt.Sprintf("/%s?addDownload=1", testFile.ID),
+			nil,
+		)
+		req.Header.Set("Range", rangeHeader)
+		if ifRange != "" {
+			req.Header.Set("If-Range", ifRange)
+		}
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+		return w
+	}
+	timesDownloaded := func() uint64 {
+		return db.File.GetX(t.Context(), testFile.ID).TimesDownloaded
+	}
+
+	w := fetchRange("bytes=0-0", "")
+	assert.Equal(t, http.StatusPartialContent, w.Code)
+	assert.Equal(t, "H", w.Body.String())
+	assert.Equal(t, "bytes", w.Header().Get("Accept-Ranges"))
+	assert.Equal(t, uint64(0), timesDownloaded())
+
+	etag := w.Header().Get("ETag")
+	w = fetchRange("bytes=0-5", etag)
+	assert.Equal(t, http.StatusPartialContent, w.Code)
+	assert.Equal(t, "Hello ", w.Body.String())
+	assert.Equal(t, uint64(0), timesDownloaded())
+
+	w = fetchRange("bytes=6-", etag)
+	assert.Equal(t, http.StatusPartialContent, w.Code)
+	assert.Equal(t, "there!", w.Body.String())
+	assert.Equal(t, uint64(1), timesDownloaded())
+	assert.Equal(t, 0, db.DownloadProgress.Query().CountX(t.Context()))
+
+	w = fetchRange("bytes=6-", `"outdated"`)
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "Hello there!", w.Body.String())
+	assert.Equal(t, uint64(2), timesDownloaded())
+}
+
+func TestFetchFileConcurrentRanges(t *testing.T) {
+	cfg := testutil.SetupTestConfig()
+	db := testutil.SetupTestDBClient(t)
+	testUser := testutil.SetupTestUser(t, db, nil)
+	content := "Hello there!"
+	testFile := testutil.SetupTestFile(t, cfg, db, "test.txt", content, testUser, "none", 0, 0, 0)
+	r := setupTestFileRouter(cfg, db, testutil.NewTestAuthMiddleware(testUser))
+
+	var wg sync.WaitGroup
+	codes := make([]int, len(content))
+	for i := range len(content) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			req := httptest.NewRequest(
+				http.MethodGet,
+				fmt.Sprintf("/%s?addDownload=1", testFile.ID),
+				nil,
+			)
+			req.Header.Set("Range", fmt.Sprintf("bytes=%d-%d", i, i))
+			w := httptest.NewRecorder()
+			r.ServeHTTP(w, req)
+			codes[i] = w.Code
+		}()
+	}
+	wg.Wait()
+	for _, code := range codes {
+		assert.Equal(t, http.StatusPartialContent, code)
+	}
+	assert.Equal(t, uint64(1), db.File.GetX(t.Context(), testFile.ID).TimesDownloaded)
+	assert.Equal(t, 0, db.DownloadProgress.Query().CountX(t.Context()))
+}
+
+func TestRecordDeliveryKeepsProgressIfNotCounted(t *testing.T) {
+	cfg := testutil.SetupTestConfig()
+	db := testutil.SetupTestDBClient(t)
+	testUser := testutil.SetupTestUser(t, db, nil)
+	content := "Hello there!"
+	testFile := testutil.SetupTestFile(t, cfg, db, "test.txt", content, testUser, "none", 0, 0, 0)
+	fileValue := db.File.Query().Where(file.ID(testFile.ID)).WithData().OnlyX(t.Context())
+	ds := services.NewDownloadService(cfg, db)
+
+	delivered := []services.ByteRange{{0, 6}}
+	completed, err := ds.RecordDelivery(t.Context(), fileValue, "token", delivered)
+	require.NoError(t, err)
+	assert.False(t, completed)
+
+	db.File.Use(func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if m.Op().Is(ent.OpUpdateOne) {
+				return nil, errors.New("counting failed")
+			}
+			return next.Mutate(ctx, m)
+		})
+	})
+	delivered = []services.ByteRange{{6, int64(len(content))}}
+	_, err = ds.RecordDelivery(t.Context(), fileValue, "token", delivered)
+	assert.Error(t, err)
+	// the delivery can still complete the download later
+	assert.Equal(t, 1, db.DownloadProgress.Query().CountX(t.Context()))
+	assert.Equal(t, uint64(0), db.File.GetX(t.Context(), testFile.ID).TimesDownloaded)
+}
+
 func TestFetchFileNotFound(t *testing.T) {
 	cfg := testutil.SetupTestConfig()
 	db := testutil.SetupTestDBClient(t)
@@ -218,3 +517,32 @@ func TestDeleteFileManually(t *testing.T) {
 	assert.Equal(t, http.StatusNotFound, w.Code)
 
 }
+
+func TestDeleteFileWithMissingBlob(t *testing.T) {
+	cfg := testutil.SetupTestConfig()
+	cfg.FilesDir = t.TempDir()
+	db := testutil.SetupTestDBClient(t)
+	testUser := testutil.SetupTestUser(t, db, nil)
+	testFile := testutil.SetupTestFile(
+		t,
+		cfg,
+		db,
+		"test.txt",
+		"Hello there!",
+		testUser,
+		"single",
+		0,
+		0,
+		1,
+	)
+	fileData := db.File.QueryData(testFile).OnlyX(t.Context())
+	blobKey := services.NewFileService(cfg, db).BlobKey(fileData.ID)
+	require.NoError(t, os.Remove(filepath.Join(cfg.FilesDir, blobKey)))
+
+	r := setupTestFileRouter(cfg, db, testutil.NewTestAuthMiddleware(testUser))
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, httptest.NewRequest(http.MethodDelete, "/"+testFile.ID.String(), nil))
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, 0, db.File.Query().CountX(t.Context()))
+	assert.Equal(t, 0, db.FileData.Query().CountX(t.Context()))
+}
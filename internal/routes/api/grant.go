@@ -20,11 +20,13 @@ import (
 )
 
 type grantController struct {
-	config       config.Config
-	db           *ent.Client
-	grantService services.GrantService
-	fileService  services.FileService
-	mailer       mail.Mailer
+	config             config.Config
+	db                 *ent.Client
+	grantService       services.GrantService
+	fileService        services.FileService
+	quotaService       services.QuotaService
+	accessEventService services.AccessEventService
+	mailer             mail.Mailer
 }
 
 type grantForm struct {
@@ -50,6 +52,14 @@ func (gc *grantController) createGrantHandler(c *gin.Context) {
 	defer span.End()
 	currentUser := middleware.GetCurrentUser(c)
 	var form grantForm
+	if err := gc.quotaService.CheckNewGrant(ctx, currentUser); err != nil {
+		if services.IsQuotaExceeded(err) {
+			util.GinAbortWithPublicError(ctx, c, http.StatusForbidden, err)
+		} else {
+			util.GinAbortWithError(ctx, c, http.StatusInternalServerError, err)
+		}
+		return
+	}
 	tx, err := gc.db.Tx(ctx)
 	if err != nil {
 		util.GinAbortWithError(ctx, c, http.StatusInternalServerError, err)
@@ -214,13 +224,16 @@ func (gc *grantController) deleteGrantHandler(c *gin.Context) {
 
 func setupGrantGroup(r *gin.RouterGroup, configValue config.Config, db *ent.Client) {
 	controller := grantController{
-		config:       configValue,
-		db:           db,
-		grantService: services.NewGrantService(configValue),
-		fileService:  services.NewFileService(configValue, db),
-		mailer:       mail.NewMailer(configValue),
+		config:             configValue,
+		db:                 db,
+		grantService:       services.NewGrantService(configValue),
+		fileService:        services.NewFileService(configValue, db),
+		quotaService:       services.NewQuotaService(configValue, db),
+		accessEventService: services.NewAccessEventService(db),
+		mailer:             mail.NewMailer(configValue),
 	}
 	r.POST("", controller.createGrantHandler)
 	r.GET("", controller.fetchGrantsHandler)
 	r.DELETE("/:grantId", controller.deleteGrantHandler)
+	r.GET("/:grantId/event", controller.fetchGrantEventsHandler)
 }
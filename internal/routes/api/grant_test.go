@@ -96,3 +96,18 @@ func TestDeleteGrantManually(t *testing.T) {
 	rAdmin.ServeHTTP(w, reqTestGrant2)
 	assert.Equal(t, http.StatusNotFound, w.Code)
 }
+
+func TestCreateGrantQuota(t *testing.T) {
+	cfg := testutil.SetupTestConfig()
+	cfg.QuotaConfig.MaxActiveGrants = 1
+	db := testutil.SetupTestDBClient(t)
+	testUser := testutil.SetupTestUser(t, db, nil)
+	createTestGrant(t, db, testUser, nil)
+	r := setupTestGrantRouter(cfg, db, testutil.NewTestAuthMiddleware(testUser))
+
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, httptest.NewRequest(http.MethodPost, "/", nil))
+	assert.Equal(t, http.StatusForbidden, w.Code)
+	assert.Contains(t, w.Body.String(), "quota exceeded")
+	assert.Equal(t, 1, db.Grant.Query().CountX(t.Context()))
+}
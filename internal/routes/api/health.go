@@ -0,0 +1,37 @@
+package apiRoutes
+
+import (
+	"log/slog"
+	"net/http"
+
+	"codeberg.org/jvllmr/frans/internal/config"
+	"codeberg.org/jvllmr/frans/internal/otel"
+	apiTypes "codeberg.org/jvllmr/frans/internal/routes/api/types"
+	"codeberg.org/jvllmr/frans/internal/services"
+	"github.com/gin-gonic/gin"
+)
+
+type healthController struct {
+	diskService services.DiskService
+}
+
+// fetchHealth reports whether frans accepts uploads. Downloads keep working while read-only.
+func (hc *healthController) fetchHealth(c *gin.Context) {
+	ctx, span := otel.NewSpan(c.Request.Context(), "fetchHealth")
+	defer span.End()
+	health := apiTypes.HealthStatus{Status: apiTypes.HealthStatusOK}
+	diskStatus, err := hc.diskService.Status(ctx)
+	if err != nil {
+		slog.WarnContext(ctx, "Could not check disk watermarks", "err", err)
+	}
+	if diskStatus.ReadOnly {
+		health.Status = apiTypes.HealthStatusReadOnly
+		health.ReadOnly = true
+	}
+	c.JSON(http.StatusOK, health)
+}
+
+func setupHealthGroup(r *gin.RouterGroup, configValue config.Config) {
+	controller := healthController{diskService: services.NewDiskService(configValue)}
+	r.GET("", controller.fetchHealth)
+}
@@ -0,0 +1,108 @@
+package apiRoutes
+
+import (
+	"encoding/json"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"codeberg.org/jvllmr/frans/internal/config"
+	apiTypes "codeberg.org/jvllmr/frans/internal/routes/api/types"
+	"codeberg.org/jvllmr/frans/internal/services"
+	"codeberg.org/jvllmr/frans/internal/testutil"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func setupTestHealthRouter(testConfig config.Config) *gin.Engine {
+	r := gin.Default()
+	setupHealthGroup(r.Group(""), testConfig)
+	return r
+}
+
+func fetchTestHealth(t *testing.T, r *gin.Engine) apiTypes.HealthStatus {
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/", nil))
+	assert.Equal(t, http.StatusOK, w.Code)
+	// the health check is public, so it must not reveal the disk usage
+	assert.NotContains(t, w.Body.String(), "Bytes")
+	var health apiTypes.HealthStatus
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &health))
+	return health
+}
+
+func TestFetchHealth(t *testing.T) {
+	testConfig := testutil.SetupTestConfig()
+	testConfig.FilesDir = t.TempDir()
+	testConfig.HighWatermark = 0
+
+	health := fetchTestHealth(t, setupTestHealthRouter(testConfig))
+	assert.Equal(t, apiTypes.HealthStatusOK, health.Status)
+	assert.False(t, health.ReadOnly)
+}
+
+func TestFetchDiskStatus(t *testing.T) {
+	testConfig := testutil.SetupTestConfig()
+	testConfig.FilesDir = t.TempDir()
+	db := testutil.SetupTestDBClient(t)
+	adminUser := testutil.SetupTestAdminUser(t, db, nil)
+	testUser := testutil.SetupTestUser(t, db, nil)
+
+	r := setupTestStorageRouter(testConfig, db, testutil.NewTestAuthMiddleware(adminUser))
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/disk", nil))
+	require.Equal(t, http.StatusOK, w.Code)
+	var diskStatus services.DiskStatus
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &diskStatus))
+	assert.NotZero(t, diskStatus.TotalBytes)
+
+	r = setupTestStorageRouter(testConfig, db, testutil.NewTestAuthMiddleware(testUser))
+	w = httptest.NewRecorder()
+	r.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/disk", nil))
+	assert.Equal(t, http.StatusForbidden, w.Code)
+}
+
+func TestDiskHighWatermark(t *testing.T) {
+	db := testutil.SetupTestDBClient(t)
+	testUser := testutil.SetupTestUser(t, db, nil)
+	testConfig := testutil.SetupTestConfig()
+	testConfig.FilesDir = t.TempDir()
+	// every volume with content is above these watermarks
+	testConfig.HighWatermark = 0.000_001
+	testConfig.LowWatermark = 0.000_000_1
+
+	health := fetchTestHealth(t, setupTestHealthRouter(testConfig))
+	assert.Equal(t, apiTypes.HealthStatusReadOnly, health.Status)
+	assert.True(t, health.ReadOnly)
+
+	router := setupTestTicketRouter(testConfig, db, testutil.NewTestAuthMiddleware(testUser))
+	req := httptest.NewRequest(
+		http.MethodPost,
+		"/preflight",
+		strings.NewReader(`{"files":[{"name":"a.txt","size":1}]}`),
+	)
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+	assert.Equal(t, http.StatusInsufficientStorage, w.Code)
+	assert.Contains(t, w.Body.String(), "insufficient storage")
+	createTestTicket(t, router, func(writer *multipart.Writer) int {
+		return http.StatusInsufficientStorage
+	})
+}
+
+func TestDiskWatermarksWithS3Backend(t *testing.T) {
+	testConfig := testutil.SetupTestConfig()
+	testConfig.FilesDir = t.TempDir()
+	testConfig.FilesBackend.Type = config.FilesBackendS3
+	testConfig.HighWatermark = 0.000_001
+	testConfig.LowWatermark = 0.000_000_1
+
+	// the local volume does not hold the blobs
+	health := fetchTestHealth(t, setupTestHealthRouter(testConfig))
+	assert.Equal(t, apiTypes.HealthStatusOK, health.Status)
+	assert.NoError(t, services.NewDiskService(testConfig).CheckWritable(t.Context()))
+}
@@ -0,0 +1,143 @@
+package apiRoutes
+
+import (
+	"context"
+	"net/http"
+
+	"codeberg.org/jvllmr/frans/internal/ent"
+	"codeberg.org/jvllmr/frans/internal/ent/recipient"
+	"codeberg.org/jvllmr/frans/internal/ent/ticket"
+	"codeberg.org/jvllmr/frans/internal/middleware"
+	"codeberg.org/jvllmr/frans/internal/otel"
+	apiTypes "codeberg.org/jvllmr/frans/internal/routes/api/types"
+	"codeberg.org/jvllmr/frans/internal/services"
+	"codeberg.org/jvllmr/frans/internal/util"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// fetchManagedTicket returns the ticket with the given ID if the current user
+// is allowed to manage it, otherwise the request is aborted
+func (tc *ticketController) fetchManagedTicket(
+	ctx context.Context,
+	c *gin.Context,
+	ticketID string,
+) (*ent.Ticket, bool) {
+	ticketValue, err := tc.db.Ticket.Query().
+		Where(ticket.ID(uuid.MustParse(ticketID))).
+		WithOwner().
+		WithFiles().
+		Only(ctx)
+	if err != nil {
+		util.GinAbortWithError(ctx, c, http.StatusNotFound, err)
+		return nil, false
+	}
+	currentUser := middleware.GetCurrentUser(c)
+	if !currentUser.IsAdmin && ticketValue.Edges.Owner.ID != currentUser.ID {
+		c.AbortWithStatus(http.StatusForbidden)
+		return nil, false
+	}
+	return ticketValue, true
+}
+
+func (tc *ticketController) createRecipientHandler(c *gin.Context) {
+	ctx, span := otel.NewSpan(c.Request.Context(), "createTicketRecipient")
+	defer span.End()
+	var requestedTicket apiTypes.RequestedTicketParam
+	if err := c.ShouldBindUri(&requestedTicket); err != nil {
+		util.GinAbortWithError(ctx, c, http.StatusBadRequest, err)
+		return
+	}
+	var params services.RecipientParams
+	if err := c.ShouldBindJSON(&params); err != nil {
+		util.GinAbortWithError(ctx, c, http.StatusUnprocessableEntity, err)
+		return
+	}
+	ticketValue, ok := tc.fetchManagedTicket(ctx, c, requestedTicket.ID)
+	if !ok {
+		return
+	}
+	recipientValue, token, err := tc.recipientService.CreateRecipient(ctx, ticketValue, params)
+	if err != nil {
+		util.GinAbortWithError(ctx, c, http.StatusInternalServerError, err)
+		return
+	}
+	if recipientValue.Email != nil {
+		receiverLang := params.ReceiverLang
+		if receiverLang == "" {
+			receiverLang = ticketValue.CreatorLang
+		}
+		if err := tc.mailer.SendRecipientSharedNotification(
+			c,
+			tc.recipientService,
+			receiverLang,
+			ticketValue,
+			recipientValue,
+			token,
+		); err != nil {
+			util.GinAbortWithError(ctx, c, http.StatusInternalServerError, err)
+			return
+		}
+	}
+	publicRecipient := tc.recipientService.ToPublicRecipient(recipientValue)
+	link := tc.recipientService.RecipientShareLink(c, ticketValue, token)
+	publicRecipient.Link = &link
+	c.JSON(http.StatusCreated, publicRecipient)
+}
+
+func (tc *ticketController) fetchRecipientsHandler(c *gin.Context) {
+	ctx, span := otel.NewSpan(c.Request.Context(), "fetchTicketRecipients")
+	defer span.End()
+	var requestedTicket apiTypes.RequestedTicketParam
+	if err := c.ShouldBindUri(&requestedTicket); err != nil {
+		util.GinAbortWithError(ctx, c, http.StatusBadRequest, err)
+		return
+	}
+	ticketValue, ok := tc.fetchManagedTicket(ctx, c, requestedTicket.ID)
+	if !ok {
+		return
+	}
+	recipients, err := ticketValue.QueryRecipients().
+		Order(ent.Asc(recipient.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		util.GinAbortWithError(ctx, c, http.StatusInternalServerError, err)
+		return
+	}
+	publicRecipients := make([]services.PublicRecipient, len(recipients))
+	for i, recipientValue := range recipients {
+		publicRecipients[i] = tc.recipientService.ToPublicRecipient(recipientValue)
+	}
+	c.JSON(http.StatusOK, publicRecipients)
+}
+
+// revokeRecipientHandler disables the personal link of a single recipient,
+// other recipients and the ticket password keep working
+func (tc *ticketController) revokeRecipientHandler(c *gin.Context) {
+	ctx, span := otel.NewSpan(c.Request.Context(), "revokeTicketRecipient")
+	defer span.End()
+	var requestedRecipient apiTypes.RequestedTicketRecipientParam
+	if err := c.ShouldBindUri(&requestedRecipient); err != nil {
+		util.GinAbortWithError(ctx, c, http.StatusBadRequest, err)
+		return
+	}
+	ticketValue, ok := tc.fetchManagedTicket(ctx, c, requestedRecipient.TicketID)
+	if !ok {
+		return
+	}
+	recipientValue, err := ticketValue.QueryRecipients().
+		Where(recipient.ID(uuid.MustParse(requestedRecipient.RecipientID))).
+		Only(ctx)
+	if err != nil {
+		util.GinAbortWithError(ctx, c, http.StatusNotFound, err)
+		return
+	}
+	if recipientValue.RevokedAt == nil {
+		recipientValue, err = tc.recipientService.RevokeRecipient(ctx, recipientValue)
+		if err != nil {
+			util.GinAbortWithError(ctx, c, http.StatusInternalServerError, err)
+			return
+		}
+	}
+	c.JSON(http.StatusOK, tc.recipientService.ToPublicRecipient(recipientValue))
+}
@@ -0,0 +1,163 @@
+package apiRoutes
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"codeberg.org/jvllmr/frans/internal/ent/recipient"
+	"codeberg.org/jvllmr/frans/internal/services"
+	"codeberg.org/jvllmr/frans/internal/testutil"
+	"codeberg.org/jvllmr/frans/internal/util"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTicketRecipients(t *testing.T) {
+	cfg := testutil.SetupTestConfig()
+	db := testutil.SetupTestDBClient(t)
+	testUser := testutil.SetupTestUser(t, db, nil)
+	testOwner := testutil.SetupTestUser(t, db, nil)
+	rUser := setupTestTicketRouter(cfg, db, testutil.NewTestAuthMiddleware(testUser))
+	rOwner := setupTestTicketRouter(cfg, db, testutil.NewTestAuthMiddleware(testOwner))
+
+	testTicket := createTestTicket(t, rOwner, func(writer *multipart.Writer) int {
+		partWriter, _ := writer.CreateFormFile("files[]", "a.txt")
+		io.Copy(partWriter, strings.NewReader("Content of a.txt"))
+		return http.StatusCreated
+	})
+	recipientsPath := fmt.Sprintf("/%s/recipient", testTicket.ID)
+
+	sendRequest := func(
+		r *gin.Engine,
+		method string,
+		path string,
+		body string,
+	) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(method, path, strings.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+		return w
+	}
+
+	w := sendRequest(rUser, http.MethodPost, recipientsPath, `{"name":"Alice"}`)
+	assert.Equal(t, http.StatusForbidden, w.Code)
+	w = sendRequest(rOwner, http.MethodPost, recipientsPath, `{"email":"alice@vllmr.dev"}`)
+	assert.Equal(t, http.StatusUnprocessableEntity, w.Code)
+
+	createdRecipients := make([]services.PublicRecipient, 2)
+	for i, body := range []string{
+		`{"name":"Alice","email":"test_receiver@vllmr.dev"}`,
+		`{"name":"Bob"}`,
+	} {
+		w = sendRequest(rOwner, http.MethodPost, recipientsPath, body)
+		require.Equal(t, http.StatusCreated, w.Code)
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &createdRecipients[i]))
+	}
+	alice, bob := createdRecipients[0], createdRecipients[1]
+	assert.Equal(t, "Alice", alice.Name)
+	assert.Equal(t, "test_receiver@vllmr.dev", *alice.Email)
+	assert.Nil(t, bob.Email)
+	require.NotNil(t, alice.Link)
+	require.NotNil(t, bob.Link)
+	assert.NotEqual(t, *alice.Link, *bob.Link)
+	// only the hash of the token in the link is stored
+	aliceLinkPrefix := fmt.Sprintf("/s/%s#", testTicket.ID)
+	assert.Contains(t, *alice.Link, aliceLinkPrefix)
+	aliceToken := (*alice.Link)[strings.Index(*alice.Link, aliceLinkPrefix)+len(aliceLinkPrefix):]
+	aliceValue := db.Recipient.Query().Where(recipient.ID(alice.ID)).OnlyX(t.Context())
+	assert.NotEqual(t, aliceToken, aliceValue.HashedToken)
+	assert.Equal(t, util.HashToken(aliceToken), aliceValue.HashedToken)
+
+	w = sendRequest(rUser, http.MethodGet, recipientsPath, "")
+	assert.Equal(t, http.StatusForbidden, w.Code)
+	w = sendRequest(rOwner, http.MethodGet, recipientsPath, "")
+	require.Equal(t, http.StatusOK, w.Code)
+	var publicRecipients []services.PublicRecipient
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &publicRecipients))
+	assert.Len(t, publicRecipients, 2)
+	for _, publicRecipient := range publicRecipients {
+		assert.Nil(t, publicRecipient.Link)
+	}
+
+	db.ShareAccessToken.Create().
+		SetID("alice-token").
+		SetExpiry(time.Now().Add(time.Hour)).
+		SetTicketID(testTicket.ID).
+		SetRecipientID(alice.ID).
+		ExecX(t.Context())
+
+	alicePath := fmt.Sprintf("%s/%s", recipientsPath, alice.ID)
+	w = sendRequest(rOwner, http.MethodDelete, fmt.Sprintf("%s/%s", recipientsPath, uuid.New()), "")
+	assert.Equal(t, http.StatusNotFound, w.Code)
+	w = sendRequest(rUser, http.MethodDelete, alicePath, "")
+	assert.Equal(t, http.StatusForbidden, w.Code)
+	w = sendRequest(rOwner, http.MethodDelete, alicePath, "")
+	require.Equal(t, http.StatusOK, w.Code)
+	var revokedRecipient services.PublicRecipient
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &revokedRecipient))
+	assert.NotNil(t, revokedRecipient.RevokedAt)
+
+	assert.Equal(t, 0, db.ShareAccessToken.Query().CountX(t.Context()))
+	bobValue := db.Recipient.Query().Where(recipient.ID(bob.ID)).OnlyX(t.Context())
+	assert.Nil(t, bobValue.RevokedAt)
+}
+
+func TestRecordRecipientDownloadConcurrently(t *testing.T) {
+	cfg := testutil.SetupTestConfig()
+	db := testutil.SetupTestDBClient(t)
+	testUser := testutil.SetupTestUser(t, db, nil)
+	r := setupTestTicketRouter(cfg, db, testutil.NewTestAuthMiddleware(testUser))
+	testTicket := createTestTicket(t, r, func(writer *multipart.Writer) int {
+		partWriter, _ := writer.CreateFormFile("files[]", "a.txt")
+		io.Copy(partWriter, strings.NewReader("Content of a.txt"))
+		return http.StatusCreated
+	})
+	ticketValue := db.Ticket.GetX(t.Context(), testTicket.ID)
+	fileValue := db.File.GetX(t.Context(), testTicket.Files[0].Id)
+	rs := services.NewRecipientService(cfg, db)
+	aliceValue, _, err := rs.CreateRecipient(
+		t.Context(),
+		ticketValue,
+		services.RecipientParams{Name: "Alice"},
+	)
+	require.NoError(t, err)
+
+	var wg sync.WaitGroup
+	firstDownloads := make([]bool, 10)
+	for i := range firstDownloads {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			firstDownload, err := rs.RecordDownload(t.Context(), aliceValue, fileValue)
+			assert.NoError(t, err)
+			firstDownloads[i] = firstDownload
+		}()
+	}
+	wg.Wait()
+	// only one of the downloads is notified as the first one
+	firstDownloadCount := 0
+	for _, firstDownload := range firstDownloads {
+		if firstDownload {
+			firstDownloadCount++
+		}
+	}
+	assert.Equal(t, 1, firstDownloadCount)
+	aliceValue = db.Recipient.GetX(t.Context(), aliceValue.ID)
+	assert.Equal(t, []string{fileValue.ID.String()}, aliceValue.DownloadedFiles)
+	assert.Equal(t, uint64(len(firstDownloads)), aliceValue.TimesDownloaded)
+
+	firstDownload, err := rs.RecordDownload(t.Context(), aliceValue, fileValue)
+	require.NoError(t, err)
+	assert.False(t, firstDownload)
+}
@@ -2,9 +2,7 @@ package shareRoutes
 
 import (
 	"encoding/hex"
-	"errors"
 	"fmt"
-	"log/slog"
 	"net/http"
 	"strings"
 	"time"
@@ -13,7 +11,9 @@ import (
 	"codeberg.org/jvllmr/frans/internal/ent"
 	"codeberg.org/jvllmr/frans/internal/ent/grant"
 	"codeberg.org/jvllmr/frans/internal/mail"
+	"codeberg.org/jvllmr/frans/internal/middleware"
 	"codeberg.org/jvllmr/frans/internal/otel"
+	tusRoutes "codeberg.org/jvllmr/frans/internal/routes/api/tus"
 	apiTypes "codeberg.org/jvllmr/frans/internal/routes/api/types"
 	"codeberg.org/jvllmr/frans/internal/services"
 	"codeberg.org/jvllmr/frans/internal/util"
@@ -22,17 +22,26 @@ import (
 )
 
 type grantShareController struct {
-	config       config.Config
-	db           *ent.Client
-	grantService services.GrantService
-	fileService  services.FileService
-	mailer       mail.Mailer
+	config             config.Config
+	db                 *ent.Client
+	grantService       services.GrantService
+	fileService        services.FileService
+	uploadService      services.UploadService
+	quotaService       services.QuotaService
+	scanService        services.ScanService
+	previewService     services.PreviewService
+	accessEventService services.AccessEventService
+	mailer             mail.Mailer
 }
 
 func (gsc *grantShareController) fetchGrant(c *gin.Context) {
 	_, span := otel.NewSpan(c.Request.Context(), "fetchGrantShare")
 	defer span.End()
 	grantValue := c.MustGet(config.ShareGrantContext).(*ent.Grant)
+	recordAccessEvent(c, gsc.accessEventService, services.AccessEventParams{
+		Type:  config.AccessEventTypeView,
+		Grant: grantValue,
+	})
 	c.JSON(
 		http.StatusOK,
 		gsc.grantService.ToPublicGrant(gsc.fileService, grantValue, grantValue.Edges.Files),
@@ -45,10 +54,16 @@ func (gsc *grantShareController) fetchGrantAccessToken(c *gin.Context) {
 	tokenValueBytes := util.GenerateSalt()
 
 	tokenValue := hex.EncodeToString(tokenValueBytes)
+	grantValue := c.MustGet(config.ShareGrantContext).(*ent.Grant)
 	token := gsc.db.ShareAccessToken.Create().
 		SetID(tokenValue).
 		SetExpiry(time.Now().Add(10 * time.Second)).
+		SetGrant(grantValue).
 		SaveX(ctx)
+	recordAccessEvent(c, gsc.accessEventService, services.AccessEventParams{
+		Type:  config.AccessEventTypeToken,
+		Grant: grantValue,
+	})
 	c.SetCookie(
 		config.ShareAccessTokenCookieName,
 		token.ID,
@@ -64,11 +79,27 @@ func (gsc *grantShareController) fetchGrantAccessToken(c *gin.Context) {
 func (gsc *grantShareController) postGrantFiles(c *gin.Context) {
 	ctx, span := otel.NewSpan(c.Request.Context(), "postGrantShareFiles")
 	defer span.End()
-	multipartForm, _ := c.MultipartForm()
-	files := multipartForm.File["files[]"]
+	gsc.fileService.EnsureFilesTmpPath()
+	multipartUpload, err := gsc.fileService.ReadMultipartUpload(ctx, c.Request)
+	if err != nil {
+		if services.IsDigestMismatch(err) {
+			// clients need the computed digests to find out what went wrong
+			util.GinAbortWithPublicError(ctx, c, http.StatusBadRequest, err)
+		} else if services.IsUploadRejected(err) {
+			util.GinAbortWithError(ctx, c, http.StatusBadRequest, err)
+		} else if services.IsInsufficientStorage(err) {
+			util.GinAbortWithError(ctx, c, http.StatusInsufficientStorage, err)
+		} else {
+			util.GinAbortWithError(ctx, c, http.StatusInternalServerError, err)
+		}
+		return
+	}
+	defer multipartUpload.Cleanup()
+	files := multipartUpload.Files
 	grantValue := c.MustGet(config.ShareGrantContext).(*ent.Grant)
+	uploadIDs := multipartUpload.Values["uploads[]"]
 
-	if len(files) > int(gsc.config.MaxFiles) {
+	if len(files)+len(uploadIDs) > int(gsc.config.MaxFiles) {
 		util.GinAbortWithError(
 			ctx,
 			c,
@@ -76,56 +107,101 @@ func (gsc *grantShareController) postGrantFiles(c *gin.Context) {
 			fmt.Errorf(
 				"maximum of %d files allowed per upload. %d uploaded",
 				gsc.config.MaxFiles,
-				len(files),
+				len(files)+len(uploadIDs),
 			),
 		)
 		return
 	}
-	gsc.fileService.EnsureFilesTmpPath()
+	uploads, err := gsc.uploadService.GetFinishedUploads(ctx, uploadIDs, nil, grantValue)
+	if err != nil {
+		if services.IsUploadRejected(err) {
+			util.GinAbortWithError(ctx, c, http.StatusBadRequest, err)
+		} else {
+			util.GinAbortWithError(ctx, c, http.StatusInternalServerError, err)
+		}
+		return
+	}
+	err = gsc.quotaService.CheckBytes(
+		ctx,
+		grantValue.Edges.Owner,
+		services.TotalUploadSize(files, uploads),
+	)
+	if err != nil {
+		if services.IsQuotaExceeded(err) {
+			util.GinAbortWithPublicError(ctx, c, http.StatusForbidden, err)
+		} else {
+			util.GinAbortWithError(ctx, c, http.StatusInternalServerError, err)
+		}
+		return
+	}
 	tx, err := gsc.db.Tx(ctx)
 	if err != nil {
 		util.GinAbortWithError(ctx, c, http.StatusInternalServerError, err)
+		return
 	}
 
-	dbFiles := make([]*ent.File, len(files))
-	for i, fileHeader := range files {
-		dbFile, err := gsc.fileService.CreateFile(
+	dbFiles := make([]*ent.File, 0, len(files)+len(uploads))
+	for _, stagedFile := range files {
+		dbFile, err := gsc.fileService.CreateFileFromStaged(
 			ctx,
-			tx, fileHeader, grantValue.Edges.Owner,
+			tx, stagedFile, grantValue.Edges.Owner,
 			grantValue.ExpiryType,
 			grantValue.FileExpiryDaysSinceLastDownload,
 			grantValue.FileExpiryTotalDays,
 			grantValue.FileExpiryTotalDownloads,
 		)
 		if err != nil {
-			var errFileTooBig *services.ErrFileTooBig
-			if errors.As(err, &errFileTooBig) {
+			_ = tx.Rollback()
+			if services.IsFileTypeRejected(err) {
+				util.GinAbortWithPublicError(ctx, c, http.StatusUnsupportedMediaType, err)
+			} else if services.IsUploadRejected(err) {
 				util.GinAbortWithError(ctx, c, http.StatusBadRequest, err)
+			} else if services.IsInsufficientStorage(err) {
+				util.GinAbortWithError(ctx, c, http.StatusInsufficientStorage, err)
 			} else {
 				util.GinAbortWithError(ctx, c, http.StatusInternalServerError, err)
 			}
+			return
 		}
 
 		tx.Grant.UpdateOne(grantValue).
 			AddFiles(dbFile).
 			SaveX(ctx)
-		dbFiles[i] = dbFile
+		dbFiles = append(dbFiles, dbFile)
+	}
+	for _, uploadValue := range uploads {
+		dbFile, err := gsc.fileService.CreateFileFromUpload(
+			ctx,
+			tx, uploadValue, grantValue.Edges.Owner,
+			grantValue.ExpiryType,
+			grantValue.FileExpiryDaysSinceLastDownload,
+			grantValue.FileExpiryTotalDays,
+			grantValue.FileExpiryTotalDownloads,
+		)
+		if err != nil {
+			_ = tx.Rollback()
+			if services.IsFileTypeRejected(err) {
+				util.GinAbortWithPublicError(ctx, c, http.StatusUnsupportedMediaType, err)
+			} else if services.IsUploadRejected(err) {
+				util.GinAbortWithError(ctx, c, http.StatusBadRequest, err)
+			} else if services.IsInsufficientStorage(err) {
+				util.GinAbortWithError(ctx, c, http.StatusInsufficientStorage, err)
+			} else {
+				util.GinAbortWithError(ctx, c, http.StatusInternalServerError, err)
+			}
+			return
+		}
+
+		tx.Grant.UpdateOne(grantValue).
+			AddFiles(dbFile).
+			SaveX(ctx)
+		dbFiles = append(dbFiles, dbFile)
 	}
 	tx.Grant.UpdateOne(grantValue).
 		SetLastUpload(time.Now()).
 		AddTimesUploaded(1).
 		SaveX(ctx)
 
-	err = util.RefreshUserTotalDataSize(ctx, grantValue.Edges.Owner, tx)
-	if err != nil {
-		slog.Error(
-			"Could not refresh total data size of user",
-			"err",
-			err,
-			"username",
-			grantValue.Edges.Owner.Username,
-		)
-	}
 	// we have an outdated reference to the grant; therefore we check if TimesUploaded is 0
 	if grantValue.EmailOnUpload != nil && grantValue.TimesUploaded == 0 {
 		for _, email := range grantValue.EmailOnUpload {
@@ -144,6 +220,8 @@ func (gsc *grantShareController) postGrantFiles(c *gin.Context) {
 		util.GinAbortWithError(ctx, c, http.StatusInternalServerError, err)
 		return
 	}
+	gsc.scanService.ScanPendingFilesInBackground()
+	gsc.previewService.GeneratePendingPreviewsInBackground()
 	grantValue = gsc.db.Grant.Query().
 		Where(grant.ID(grantValue.ID)).
 		WithFiles(func(fq *ent.FileQuery) { fq.WithData().WithOwner() }).
@@ -156,7 +234,38 @@ func (gsc *grantShareController) postGrantFiles(c *gin.Context) {
 	)
 }
 
+// preflightGrantFiles lets clients validate an upload before sending any file content
+func (gsc *grantShareController) preflightGrantFiles(c *gin.Context) {
+	ctx, span := otel.NewSpan(c.Request.Context(), "preflightGrantFiles")
+	defer span.End()
+	var preflight apiTypes.UploadPreflightRequest
+	if err := c.ShouldBindJSON(&preflight); err != nil {
+		util.GinAbortWithError(ctx, c, http.StatusUnprocessableEntity, err)
+		return
+	}
+	if err := gsc.fileService.CheckUploadLimits(preflight.Sizes()); err != nil {
+		util.GinAbortWithError(ctx, c, http.StatusBadRequest, err)
+		return
+	}
+	if err := gsc.fileService.CheckFileNames(preflight.Names()); err != nil {
+		util.GinAbortWithPublicError(ctx, c, http.StatusUnsupportedMediaType, err)
+		return
+	}
+	grantValue := c.MustGet(config.ShareGrantContext).(*ent.Grant)
+	err := gsc.quotaService.CheckBytes(ctx, grantValue.Edges.Owner, preflight.TotalSize())
+	if err != nil {
+		if services.IsQuotaExceeded(err) {
+			util.GinAbortWithPublicError(ctx, c, http.StatusForbidden, err)
+		} else {
+			util.GinAbortWithError(ctx, c, http.StatusInternalServerError, err)
+		}
+		return
+	}
+	c.Status(http.StatusNoContent)
+}
+
 func setupGrantShareRoutes(r *gin.RouterGroup, configValue config.Config, db *ent.Client) {
+	accessEventService := services.NewAccessEventService(db)
 	getGrantMiddleware := func(c *gin.Context) {
 		ctx, span := otel.NewSpan(c.Request.Context(), "checkGrantShareAuth")
 		defer span.End()
@@ -198,6 +307,10 @@ func setupGrantShareRoutes(r *gin.RouterGroup, configValue config.Config, db *en
 		}
 
 		if ok && !util.VerifyPassword(password, grantValue.HashedPassword, grantValue.Salt) {
+			recordAccessEvent(c, accessEventService, services.AccessEventParams{
+				Type:  config.AccessEventTypeAuthFailed,
+				Grant: grantValue,
+			})
 			util.GinAbortWithError(
 				ctx,
 				c,
@@ -212,17 +325,34 @@ func setupGrantShareRoutes(r *gin.RouterGroup, configValue config.Config, db *en
 
 	singleGrantShareGroup := r.Group("/:grantId", getGrantMiddleware)
 
+	mailer := mail.NewMailer(configValue)
 	controller := grantShareController{
-		config:       configValue,
-		db:           db,
-		grantService: services.NewGrantService(configValue),
-		fileService:  services.NewFileService(configValue, db),
-		mailer:       mail.NewMailer(configValue),
+		config:             configValue,
+		db:                 db,
+		grantService:       services.NewGrantService(configValue),
+		fileService:        services.NewFileService(configValue, db),
+		uploadService:      services.NewUploadService(configValue, db),
+		quotaService:       services.NewQuotaService(configValue, db),
+		scanService:        services.NewScanService(configValue, db, &mailer),
+		previewService:     services.NewPreviewService(configValue, db),
+		accessEventService: accessEventService,
+		mailer:             mailer,
 	}
 
 	singleGrantShareGroup.GET("", controller.fetchGrant)
 
 	singleGrantShareGroup.GET("/token", controller.fetchGrantAccessToken)
 
-	singleGrantShareGroup.POST("", controller.postGrantFiles)
+	writableStorage := middleware.WritableStorageRequired(services.NewDiskService(configValue))
+	singleGrantShareGroup.POST("", writableStorage, controller.postGrantFiles)
+	singleGrantShareGroup.POST("/preflight", writableStorage, controller.preflightGrantFiles)
+
+	tusRoutes.SetupTusRoutes(
+		singleGrantShareGroup.Group("/upload"),
+		configValue,
+		db,
+		func(c *gin.Context) (*ent.User, *ent.Grant) {
+			return nil, c.MustGet(config.ShareGrantContext).(*ent.Grant)
+		},
+	)
 }
@@ -1,11 +1,33 @@
 package shareRoutes
 
 import (
+	"log/slog"
+
 	"codeberg.org/jvllmr/frans/internal/config"
 	"codeberg.org/jvllmr/frans/internal/ent"
+	"codeberg.org/jvllmr/frans/internal/services"
 	"github.com/gin-gonic/gin"
 )
 
+// recordAccessEvent stores an access event of a share.
+// Requests do not fail because of the event log, so errors are only logged.
+func recordAccessEvent(
+	c *gin.Context,
+	accessEventService services.AccessEventService,
+	params services.AccessEventParams,
+) {
+	if err := accessEventService.Record(c, params); err != nil {
+		slog.ErrorContext(
+			c.Request.Context(),
+			"Could not record access event",
+			"type",
+			params.Type,
+			"err",
+			err,
+		)
+	}
+}
+
 func SetupShareRoutes(r *gin.RouterGroup, configValue config.Config, db *ent.Client) {
 	ticketShareGroup := r.Group("/ticket")
 	grantShareGroup := r.Group("/grant")
@@ -1,15 +1,20 @@
 package shareRoutes
 
 import (
+	"context"
 	"encoding/hex"
+	"errors"
 	"fmt"
+	"log/slog"
+	"mime"
 	"net/http"
+	"slices"
 	"strings"
 	"time"
 
 	"codeberg.org/jvllmr/frans/internal/config"
 	"codeberg.org/jvllmr/frans/internal/ent"
-	"codeberg.org/jvllmr/frans/internal/ent/file"
+	"codeberg.org/jvllmr/frans/internal/ent/shareaccesstoken"
 	"codeberg.org/jvllmr/frans/internal/ent/ticket"
 	"codeberg.org/jvllmr/frans/internal/mail"
 	"codeberg.org/jvllmr/frans/internal/otel"
@@ -22,17 +27,25 @@ import (
 )
 
 type ticketShareController struct {
-	config        config.Config
-	db            *ent.Client
-	ticketService services.TicketService
-	fileService   services.FileService
-	mailer        mail.Mailer
+	config             config.Config
+	db                 *ent.Client
+	ticketService      services.TicketService
+	fileService        services.FileService
+	downloadService    services.DownloadService
+	recipientService   services.RecipientService
+	accessEventService services.AccessEventService
+	mailer             mail.Mailer
 }
 
 func (tsc *ticketShareController) fetchTicket(c *gin.Context) {
 	_, span := otel.NewSpan(c.Request.Context(), "fetchTicketShare")
 	defer span.End()
 	ticketValue := c.MustGet(config.ShareTicketContext).(*ent.Ticket)
+	recordAccessEvent(c, tsc.accessEventService, services.AccessEventParams{
+		Type:      config.AccessEventTypeView,
+		Ticket:    ticketValue,
+		Recipient: shareRecipient(c),
+	})
 	c.JSON(http.StatusOK, tsc.ticketService.ToPublicTicket(ticketValue))
 }
 
@@ -42,10 +55,20 @@ func (tsc *ticketShareController) fetchTicketAccessToken(c *gin.Context) {
 	tokenValueBytes := util.GenerateSalt()
 
 	tokenValue := hex.EncodeToString(tokenValueBytes)
-	token := tsc.db.ShareAccessToken.Create().
+	ticketValue := c.MustGet(config.ShareTicketContext).(*ent.Ticket)
+	tokenCreate := tsc.db.ShareAccessToken.Create().
 		SetID(tokenValue).
 		SetExpiry(time.Now().Add(10 * time.Second)).
-		SaveX(ctx)
+		SetTicket(ticketValue)
+	if recipientValue := shareRecipient(c); recipientValue != nil {
+		tokenCreate.SetRecipient(recipientValue)
+	}
+	token := tokenCreate.SaveX(ctx)
+	recordAccessEvent(c, tsc.accessEventService, services.AccessEventParams{
+		Type:      config.AccessEventTypeToken,
+		Ticket:    ticketValue,
+		Recipient: shareRecipient(c),
+	})
 	c.SetCookie(
 		config.ShareAccessTokenCookieName,
 		token.ID,
@@ -66,47 +89,378 @@ func (tsc *ticketShareController) fetchTicketFile(c *gin.Context) {
 		util.GinAbortWithError(ctx, c, http.StatusBadRequest, err)
 		return
 	}
-	fileValue, err := tsc.db.File.Query().
-		WithData().
-		WithOwner().
-		Where(file.ID(uuid.MustParse(requestedFile.ID))).
-		Only(ctx)
+	ticketValue := c.MustGet(config.ShareTicketContext).(*ent.Ticket)
+	fileValue, err := findTicketFile(ticketValue, requestedFile.ID)
 	if err != nil {
 		util.GinAbortWithError(ctx, c, http.StatusNotFound, err)
+		return
+	}
+	if err := services.CheckScanStatus(fileValue); err != nil {
+		util.GinAbortWithPublicError(ctx, c, http.StatusForbidden, err)
+		return
 	}
-	_, err = tsc.db.File.UpdateOne(fileValue).
-		SetLastDownload(time.Now()).
-		AddTimesDownloaded(1).
-		Save(ctx)
+	downloadToken := c.GetString(config.ShareDownloadTokenContext)
+	completed, err := tsc.downloadService.ServeDownload(c, fileValue, downloadToken)
 	if err != nil {
 		util.GinAbortWithError(ctx, c, http.StatusInternalServerError, err)
 		return
 	}
-	filePath := tsc.fileService.FilesFilePath(fileValue.Edges.Data.ID)
-	c.FileAttachment(filePath, fileValue.Name)
+	tsc.recordFileAccess(
+		c,
+		config.AccessEventTypeDownload,
+		ticketValue,
+		fileValue,
+		int64(max(c.Writer.Size(), 0)),
+	)
+	if !completed {
+		return
+	}
+	if err := tsc.recordDownload(c, ticketValue, fileValue); err != nil {
+		util.GinAbortWithError(ctx, c, http.StatusInternalServerError, err)
+		return
+	}
+}
+
+// findTicketFile returns the file of a ticket with the given ID
+func findTicketFile(ticketValue *ent.Ticket, fileID string) (*ent.File, error) {
+	fileIndex := slices.IndexFunc(ticketValue.Edges.Files, func(fileValue *ent.File) bool {
+		return fileValue.ID.String() == fileID
+	})
+	if fileIndex < 0 {
+		return nil, fmt.Errorf("file %s is not part of ticket", fileID)
+	}
+	return ticketValue.Edges.Files[fileIndex], nil
+}
+
+// fetchTicketFileView serves a file for viewing in the browser.
+// Views only count as downloads if the owner of the ticket chose so.
+func (tsc *ticketShareController) fetchTicketFileView(c *gin.Context) {
+	ctx, span := otel.NewSpan(c.Request.Context(), "fetchTicketShareFileView")
+	defer span.End()
+	var requestedFile apiTypes.RequestedFileParam
+	if err := c.ShouldBindUri(&requestedFile); err != nil {
+		util.GinAbortWithError(ctx, c, http.StatusBadRequest, err)
+		return
+	}
+	ticketValue := c.MustGet(config.ShareTicketContext).(*ent.Ticket)
+	fileValue, err := findTicketFile(ticketValue, requestedFile.ID)
+	if err != nil {
+		util.GinAbortWithError(ctx, c, http.StatusNotFound, err)
+		return
+	}
+	if err := services.CheckScanStatus(fileValue); err != nil {
+		util.GinAbortWithPublicError(ctx, c, http.StatusForbidden, err)
+		return
+	}
+	if !tsc.fileService.CanViewInline(fileValue) {
+		util.GinAbortWithPublicError(
+			ctx,
+			c,
+			http.StatusUnsupportedMediaType,
+			services.ErrInlineViewNotAllowed,
+		)
+		return
+	}
+	downloadToken := c.GetString(config.ShareDownloadTokenContext)
+	completed, err := tsc.downloadService.ServeView(
+		c,
+		fileValue,
+		downloadToken,
+		ticketValue.ViewsCountAsDownloads,
+	)
+	if err != nil {
+		util.GinAbortWithError(ctx, c, http.StatusInternalServerError, err)
+		return
+	}
+	tsc.recordFileAccess(
+		c,
+		config.AccessEventTypeFileView,
+		ticketValue,
+		fileValue,
+		int64(max(c.Writer.Size(), 0)),
+	)
+	if !completed {
+		return
+	}
+	if err := tsc.recordDownload(c, ticketValue, fileValue); err != nil {
+		util.GinAbortWithError(ctx, c, http.StatusInternalServerError, err)
+		return
+	}
+}
+
+func (tsc *ticketShareController) fetchTicketFilePreview(c *gin.Context) {
+	ctx, span := otel.NewSpan(c.Request.Context(), "fetchTicketShareFilePreview")
+	defer span.End()
+	var requestedFile apiTypes.RequestedFileParam
+	if err := c.ShouldBindUri(&requestedFile); err != nil {
+		util.GinAbortWithError(ctx, c, http.StatusBadRequest, err)
+		return
+	}
 	ticketValue := c.MustGet(config.ShareTicketContext).(*ent.Ticket)
-	if ticketValue.EmailOnDownload != nil &&
-		(fileValue.LastDownload == nil || fileValue.LastDownload.Before(ticketValue.CreatedAt)) {
-		for _, email := range ticketValue.EmailOnDownload {
-			if err := tsc.mailer.SendFileDownloadNotification(
+	fileValue, err := findTicketFile(ticketValue, requestedFile.ID)
+	if err != nil {
+		util.GinAbortWithError(ctx, c, http.StatusNotFound, err)
+		return
+	}
+	if err := services.CheckScanStatus(fileValue); err != nil {
+		util.GinAbortWithPublicError(ctx, c, http.StatusForbidden, err)
+		return
+	}
+	if err := tsc.fileService.ServePreview(c, fileValue); err != nil {
+		if errors.Is(err, services.ErrPreviewNotFound) {
+			util.GinAbortWithError(ctx, c, http.StatusNotFound, err)
+			return
+		}
+		util.GinAbortWithError(ctx, c, http.StatusInternalServerError, err)
+	}
+}
+
+// shareRecipient returns the recipient who opened the ticket with their personal link, if any
+func shareRecipient(c *gin.Context) *ent.Recipient {
+	recipientValue, ok := c.Get(config.ShareRecipientContext)
+	if !ok {
+		return nil
+	}
+	return recipientValue.(*ent.Recipient)
+}
+
+// recordFileAccess logs that bytesServed bytes of fileValue were sent to the client
+// for a download or a view
+func (tsc *ticketShareController) recordFileAccess(
+	c *gin.Context,
+	eventType string,
+	ticketValue *ent.Ticket,
+	fileValue *ent.File,
+	bytesServed int64,
+) {
+	recordAccessEvent(c, tsc.accessEventService, services.AccessEventParams{
+		Type:        eventType,
+		Ticket:      ticketValue,
+		Recipient:   shareRecipient(c),
+		File:        fileValue,
+		BytesServed: bytesServed,
+	})
+}
+
+// recordDownload counts a completed download for the recipient of the request
+// and notifies the configured addresses about the first download of the file.
+// Downloads of recipients are notified once per recipient and file.
+func (tsc *ticketShareController) recordDownload(
+	c *gin.Context,
+	ticketValue *ent.Ticket,
+	fileValue *ent.File,
+) error {
+	firstDownload := fileValue.LastDownload == nil ||
+		fileValue.LastDownload.Before(ticketValue.CreatedAt)
+	recipientValue := shareRecipient(c)
+	if recipientValue != nil {
+		var err error
+		firstDownload, err = tsc.recipientService.RecordDownload(c, recipientValue, fileValue)
+		if err != nil {
+			return err
+		}
+	}
+	if !firstDownload {
+		return nil
+	}
+	for _, email := range ticketValue.EmailOnDownload {
+		if err := tsc.mailer.SendFileDownloadNotification(
+			c,
+			email,
+			ticketValue,
+			fileValue,
+			recipientValue,
+		); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// fetchTicketArchive streams all or the selected files of a ticket as a single archive
+func (tsc *ticketShareController) fetchTicketArchive(c *gin.Context) {
+	ctx, span := otel.NewSpan(c.Request.Context(), "fetchTicketShareArchive")
+	defer span.End()
+	var archiveQuery apiTypes.TicketArchiveQuery
+	if err := c.ShouldBindQuery(&archiveQuery); err != nil {
+		util.GinAbortWithError(ctx, c, http.StatusBadRequest, err)
+		return
+	}
+	ticketValue := c.MustGet(config.ShareTicketContext).(*ent.Ticket)
+	files := ticketValue.Edges.Files
+	if len(archiveQuery.Files) > 0 {
+		ticketFiles := make(map[string]*ent.File, len(files))
+		for _, fileValue := range files {
+			ticketFiles[fileValue.ID.String()] = fileValue
+		}
+		files = make([]*ent.File, 0, len(archiveQuery.Files))
+		for _, fileID := range archiveQuery.Files {
+			fileValue, ok := ticketFiles[fileID]
+			if !ok {
+				util.GinAbortWithError(
+					ctx,
+					c,
+					http.StatusNotFound,
+					fmt.Errorf("file %s is not part of ticket", fileID),
+				)
+				return
+			}
+			delete(ticketFiles, fileID)
+			files = append(files, fileValue)
+		}
+	}
+	if len(files) == 0 {
+		util.GinAbortWithError(ctx, c, http.StatusNotFound, fmt.Errorf("ticket has no files"))
+		return
+	}
+	if !checkFilesScanStatus(ctx, c, files) {
+		return
+	}
+	format := archiveQuery.Format
+	if format == "" {
+		format = services.ArchiveFormatZip
+	}
+
+	c.Header(
+		"Content-Disposition",
+		mime.FormatMediaType(
+			"attachment",
+			map[string]string{"filename": fmt.Sprintf("%s.%s", ticketValue.ID, format)},
+		),
+	)
+	c.Header("Content-Type", services.ArchiveContentType(format))
+	c.Status(http.StatusOK)
+	downloadToken := c.GetString(config.ShareDownloadTokenContext)
+	err := tsc.fileService.WriteArchive(
+		ctx,
+		c.Writer,
+		format,
+		files,
+		func(fileValue *ent.File) {
+			tsc.recordFileAccess(
 				c,
-				email,
+				config.AccessEventTypeDownload,
 				ticketValue,
 				fileValue,
-			); err != nil {
-				util.GinAbortWithError(ctx, c, http.StatusInternalServerError, err)
+				int64(fileValue.Edges.Data.Size),
+			)
+			completed, err := tsc.downloadService.RecordDelivery(
+				ctx,
+				fileValue,
+				downloadToken,
+				[]services.ByteRange{{0, int64(fileValue.Edges.Data.Size)}},
+			)
+			if err != nil {
+				slog.ErrorContext(ctx, "Could not record download", "file", fileValue.ID, "err", err)
 				return
 			}
+			if !completed {
+				return
+			}
+			if err := tsc.recordDownload(c, ticketValue, fileValue); err != nil {
+				slog.ErrorContext(
+					ctx,
+					"Could not record download of recipient",
+					"file",
+					fileValue.ID,
+					"err",
+					err,
+				)
+			}
+		},
+	)
+	if err != nil {
+		// the response is already being sent, so the error can only be logged
+		slog.ErrorContext(ctx, "Could not stream ticket archive", "ticketId", ticketValue.ID, "err", err)
+		c.Abort()
+	}
+}
+
+// checkFilesScanStatus aborts with the first file which must not be downloaded
+func checkFilesScanStatus(ctx context.Context, c *gin.Context, files []*ent.File) bool {
+	for _, fileValue := range files {
+		if err := services.CheckScanStatus(fileValue); err != nil {
+			util.GinAbortWithPublicError(
+				ctx,
+				c,
+				http.StatusForbidden,
+				fmt.Errorf("%s: %w", fileValue.Name, err),
+			)
+			return false
 		}
+	}
+	return true
+}
 
+// fetchTicketChecksums serves a manifest with the checksums of all files of a ticket
+// in the format of sha256sum or sha512sum
+func (tsc *ticketShareController) fetchTicketChecksums(algorithm string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx, span := otel.NewSpan(c.Request.Context(), "fetchTicketShareChecksums")
+		defer span.End()
+		ticketValue := c.MustGet(config.ShareTicketContext).(*ent.Ticket)
+		if !checkFilesScanStatus(ctx, c, ticketValue.Edges.Files) {
+			return
+		}
+		manifest, err := tsc.fileService.ChecksumManifest(ctx, algorithm, ticketValue.Edges.Files)
+		if err != nil {
+			util.GinAbortWithError(ctx, c, http.StatusInternalServerError, err)
+			return
+		}
+		c.Header(
+			"Content-Disposition",
+			mime.FormatMediaType(
+				"attachment",
+				map[string]string{"filename": services.ChecksumManifestName(algorithm)},
+			),
+		)
+		c.Data(http.StatusOK, "text/plain; charset=utf-8", manifest)
 	}
 }
 
+// fetchTicketMetalink serves a Metalink document so download managers
+// can fetch and verify all files of a ticket
+func (tsc *ticketShareController) fetchTicketMetalink(c *gin.Context) {
+	ctx, span := otel.NewSpan(c.Request.Context(), "fetchTicketShareMetalink")
+	defer span.End()
+	ticketValue := c.MustGet(config.ShareTicketContext).(*ent.Ticket)
+	if !checkFilesScanStatus(ctx, c, ticketValue.Edges.Files) {
+		return
+	}
+	metalink, err := tsc.fileService.Metalink(
+		ctx,
+		ticketValue.Edges.Files,
+		ticketValue.CreatedAt,
+		func(fileValue *ent.File) string {
+			return tsc.ticketService.TicketShareFileURL(c, ticketValue, fileValue)
+		},
+	)
+	if err != nil {
+		util.GinAbortWithError(ctx, c, http.StatusInternalServerError, err)
+		return
+	}
+	c.Header(
+		"Content-Disposition",
+		mime.FormatMediaType(
+			"attachment",
+			map[string]string{"filename": fmt.Sprintf("%s.meta4", ticketValue.ID)},
+		),
+	)
+	c.Data(http.StatusOK, services.MetalinkContentType, metalink)
+}
+
 func setupTicketShareRoutes(r *gin.RouterGroup, cfg config.Config, db *ent.Client) {
+	recipientService := services.NewRecipientService(cfg, db)
+	accessEventService := services.NewAccessEventService(db)
 	getTicketMiddleware := func(c *gin.Context) {
 		ctx, span := otel.NewSpan(c.Request.Context(), "checkTicketShareAuth")
 		defer span.End()
 		ticketId := c.Param("ticketId")
+		uuidValue, err := uuid.Parse(ticketId)
+		if err != nil {
+			util.GinAbortWithError(ctx, c, http.StatusBadRequest, err)
+			return
+		}
 		username, password, ok := c.Request.BasicAuth()
 
 		if !ok {
@@ -115,21 +469,43 @@ func setupTicketShareRoutes(r *gin.RouterGroup, cfg config.Config, db *ent.Clien
 				util.GinAbortWithError(ctx, c, http.StatusUnauthorized, err)
 				return
 			}
-			token, err := db.ShareAccessToken.Get(ctx, tokenCookie)
+			token, err := db.ShareAccessToken.Query().
+				Where(shareaccesstoken.ID(tokenCookie)).
+				WithTicket().
+				WithRecipient().
+				Only(ctx)
 			if err != nil {
 				util.GinAbortWithError(ctx, c, http.StatusUnauthorized, err)
+				return
 			} else if token.Expiry.Before(time.Now()) {
 				util.GinAbortWithError(ctx, c, http.StatusUnauthorized, fmt.Errorf("token expired"))
 				return
+			} else if token.Edges.Ticket == nil || token.Edges.Ticket.ID != uuidValue {
+				util.GinAbortWithError(
+					ctx,
+					c,
+					http.StatusUnauthorized,
+					fmt.Errorf("token does not match share"),
+				)
+				return
 			}
+			if token.Edges.Recipient != nil {
+				if token.Edges.Recipient.RevokedAt != nil {
+					util.GinAbortWithError(
+						ctx,
+						c,
+						http.StatusUnauthorized,
+						fmt.Errorf("recipient revoked"),
+					)
+					return
+				}
+				c.Set(config.ShareRecipientContext, token.Edges.Recipient)
+			}
+			c.Set(config.ShareDownloadTokenContext, token.ID)
 		} else if username != ticketId {
 			util.GinAbortWithError(ctx, c, http.StatusUnauthorized, fmt.Errorf("token does not match share"))
 			return
 		}
-		uuidValue, err := uuid.Parse(ticketId)
-		if err != nil {
-			util.GinAbortWithError(ctx, c, http.StatusBadRequest, err)
-		}
 		ticketValue, err := db.Ticket.Query().
 			Where(ticket.ID(uuidValue)).
 			WithOwner().
@@ -140,13 +516,25 @@ func setupTicketShareRoutes(r *gin.RouterGroup, cfg config.Config, db *ent.Clien
 			return
 		}
 
+		// all clients using the ticket password share the same credentials,
+		// so their deliveries get no download token and are never merged
 		if ok && !util.VerifyPassword(password, ticketValue.HashedPassword, ticketValue.Salt) {
-			util.GinAbortWithError(
-				ctx,
-				c,
-				http.StatusUnauthorized,
-				fmt.Errorf("password incorrect"),
-			)
+			recipientValue, err := recipientService.FindRecipient(ctx, uuidValue, password)
+			if err != nil {
+				recordAccessEvent(c, accessEventService, services.AccessEventParams{
+					Type:   config.AccessEventTypeAuthFailed,
+					Ticket: ticketValue,
+				})
+				util.GinAbortWithError(
+					ctx,
+					c,
+					http.StatusUnauthorized,
+					fmt.Errorf("password incorrect"),
+				)
+				return
+			}
+			c.Set(config.ShareRecipientContext, recipientValue)
+			c.Set(config.ShareDownloadTokenContext, "recipient:"+recipientValue.ID.String())
 		}
 
 		c.Set(config.ShareTicketContext, ticketValue)
@@ -154,11 +542,14 @@ func setupTicketShareRoutes(r *gin.RouterGroup, cfg config.Config, db *ent.Clien
 
 	singleTicketShareGroup := r.Group("/:ticketId", getTicketMiddleware)
 	controller := ticketShareController{
-		config:        cfg,
-		db:            db,
-		ticketService: services.NewTicketService(cfg, db),
-		fileService:   services.NewFileService(cfg, db),
-		mailer:        mail.NewMailer(cfg),
+		config:             cfg,
+		db:                 db,
+		ticketService:      services.NewTicketService(cfg, db),
+		fileService:        services.NewFileService(cfg, db),
+		downloadService:    services.NewDownloadService(cfg, db),
+		recipientService:   recipientService,
+		accessEventService: accessEventService,
+		mailer:             mail.NewMailer(cfg),
 	}
 
 	singleTicketShareGroup.GET("", controller.fetchTicket)
@@ -167,4 +558,22 @@ func setupTicketShareRoutes(r *gin.RouterGroup, cfg config.Config, db *ent.Clien
 
 	singleTicketShareGroup.GET("/file/:fileId", controller.fetchTicketFile)
 
+	singleTicketShareGroup.GET("/file/:fileId/preview", controller.fetchTicketFilePreview)
+
+	singleTicketShareGroup.GET("/file/:fileId/view", controller.fetchTicketFileView)
+
+	singleTicketShareGroup.GET("/archive", controller.fetchTicketArchive)
+
+	singleTicketShareGroup.GET(
+		"/SHA256SUMS",
+		controller.fetchTicketChecksums(services.ChecksumAlgorithmSha256),
+	)
+
+	singleTicketShareGroup.GET(
+		"/SHA512SUMS",
+		controller.fetchTicketChecksums(services.ChecksumAlgorithmSha512),
+	)
+
+	singleTicketShareGroup.GET("/metalink", controller.fetchTicketMetalink)
+
 }
@@ -0,0 +1,572 @@
+package shareRoutes
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"bytes"
+	"compress/gzip"
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/hex"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"codeberg.org/jvllmr/frans/internal/config"
+	"codeberg.org/jvllmr/frans/internal/ent"
+	"codeberg.org/jvllmr/frans/internal/ent/accessevent"
+	"codeberg.org/jvllmr/frans/internal/services"
+	"codeberg.org/jvllmr/frans/internal/testutil"
+	"codeberg.org/jvllmr/frans/internal/util"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func setupTestTicketShareRouter(testConfig config.Config, db *ent.Client) *gin.Engine {
+	r := gin.Default()
+	setupTicketShareRoutes(r.Group(""), testConfig, db)
+	return r
+}
+
+func createTestShareTicket(
+	t *testing.T,
+	testConfig config.Config,
+	db *ent.Client,
+	contents map[string]string,
+) (*ent.Ticket, []*ent.File) {
+	testUser := testutil.SetupTestUser(t, db, nil)
+	salt := util.GenerateSalt()
+	ticketValue := db.Ticket.Create().
+		SetID(uuid.New()).
+		SetExpiryType("none").
+		SetExpiryDaysSinceLastDownload(0).
+		SetExpiryTotalDays(0).
+		SetExpiryTotalDownloads(0).
+		SetHashedPassword(util.HashPassword("abc123", salt)).
+		SetSalt(hex.EncodeToString(salt)).
+		SetOwner(testUser).
+		SetCreatorLang("en").
+		SetEmailOnDownload([]string{"test_creator@vllmr.dev"}).
+		SaveX(t.Context())
+	files := make([]*ent.File, 0, len(contents))
+	for name, content := range contents {
+		testFile := testutil.SetupTestFile(
+			t,
+			testConfig,
+			db,
+			name,
+			content,
+			testUser,
+			"none",
+			0,
+			0,
+			0,
+		)
+		files = append(files, testFile)
+	}
+	db.Ticket.UpdateOne(ticketValue).AddFiles(files...).ExecX(t.Context())
+	return ticketValue, files
+}
+
+func fetchTestTicketArchive(
+	r *gin.Engine,
+	ticketValue *ent.Ticket,
+	query string,
+) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(
+		http.MethodGet,
+		fmt.Sprintf("/%s/archive?%s", ticketValue.ID, query),
+		nil,
+	)
+	req.SetBasicAuth(ticketValue.ID.String(), "abc123")
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+	return w
+}
+
+func TestFetchTicketZipArchive(t *testing.T) {
+	testConfig := testutil.SetupTestConfig()
+	db := testutil.SetupTestDBClient(t)
+	contents := map[string]string{
+		"hello.txt":  "Hello there!",
+		"kenobi.txt": "General Kenobi!",
+	}
+	ticketValue, _ := createTestShareTicket(t, testConfig, db, contents)
+	r := setupTestTicketShareRouter(testConfig, db)
+
+	w := fetchTestTicketArchive(r, ticketValue, "")
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "application/zip", w.Header().Get("Content-Type"))
+	assert.Equal(
+		t,
+		fmt.Sprintf("attachment; filename=%s.zip", ticketValue.ID),
+		w.Header().Get("Content-Disposition"),
+	)
+
+	zipReader, err := zip.NewReader(bytes.NewReader(w.Body.Bytes()), int64(w.Body.Len()))
+	require.NoError(t, err)
+	assert.Equal(t, len(contents), len(zipReader.File))
+	for _, zipFile := range zipReader.File {
+		reader, err := zipFile.Open()
+		require.NoError(t, err)
+		content, err := io.ReadAll(reader)
+		require.NoError(t, err)
+		assert.Equal(t, contents[zipFile.Name], string(content))
+	}
+
+	for _, fileValue := range db.Ticket.QueryFiles(ticketValue).AllX(t.Context()) {
+		assert.Equal(t, uint64(1), fileValue.TimesDownloaded)
+		assert.NotNil(t, fileValue.LastDownload)
+	}
+}
+
+func TestFetchTicketTarGzArchiveSubset(t *testing.T) {
+	testConfig := testutil.SetupTestConfig()
+	db := testutil.SetupTestDBClient(t)
+	ticketValue, files := createTestShareTicket(t, testConfig, db, map[string]string{
+		"hello.txt":  "Hello there!",
+		"kenobi.txt": "General Kenobi!",
+	})
+	r := setupTestTicketShareRouter(testConfig, db)
+
+	w := fetchTestTicketArchive(
+		r,
+		ticketValue,
+		fmt.Sprintf("format=tar.gz&files=%s", files[0].ID),
+	)
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "application/gzip", w.Header().Get("Content-Type"))
+
+	gzipReader, err := gzip.NewReader(w.Body)
+	require.NoError(t, err)
+	tarReader := tar.NewReader(gzipReader)
+	header, err := tarReader.Next()
+	require.NoError(t, err)
+	assert.Equal(t, files[0].Name, header.Name)
+	_, err = tarReader.Next()
+	assert.ErrorIs(t, err, io.EOF)
+
+	assert.Equal(t, uint64(1), db.File.GetX(t.Context(), files[0].ID).TimesDownloaded)
+	assert.Equal(t, uint64(0), db.File.GetX(t.Context(), files[1].ID).TimesDownloaded)
+
+	w = fetchTestTicketArchive(r, ticketValue, fmt.Sprintf("files=%s", uuid.New()))
+	assert.Equal(t, http.StatusNotFound, w.Code)
+	w = fetchTestTicketArchive(r, ticketValue, "format=rar")
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+}
+
+func TestFetchTicketArchiveInfected(t *testing.T) {
+	testConfig := testutil.SetupTestConfig()
+	db := testutil.SetupTestDBClient(t)
+	ticketValue, files := createTestShareTicket(t, testConfig, db, map[string]string{
+		"hello.txt":  "Hello there!",
+		"kenobi.txt": "General Kenobi!",
+	})
+	db.File.UpdateOne(files[1]).
+		SetScanStatus(config.FileScanStatusInfected).
+		SetScanSignature("Test.Signature").
+		ExecX(t.Context())
+	r := setupTestTicketShareRouter(testConfig, db)
+
+	w := fetchTestTicketArchive(r, ticketValue, "")
+	assert.Equal(t, http.StatusForbidden, w.Code)
+	w = fetchTestTicketArchive(r, ticketValue, fmt.Sprintf("files=%s", files[0].ID))
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	req := httptest.NewRequest(
+		http.MethodGet,
+		fmt.Sprintf("/%s/file/%s", ticketValue.ID, files[1].ID),
+		nil,
+	)
+	req.SetBasicAuth(ticketValue.ID.String(), "abc123")
+	w = httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+	assert.Equal(t, http.StatusForbidden, w.Code)
+	assert.Equal(t, uint64(0), db.File.GetX(t.Context(), files[1].ID).TimesDownloaded)
+}
+
+func TestFetchTicketFilePreview(t *testing.T) {
+	testConfig := testutil.SetupTestConfig()
+	testConfig.FilesPreviews.Enabled = true
+	db := testutil.SetupTestDBClient(t)
+	ticketValue, files := createTestShareTicket(t, testConfig, db, map[string]string{
+		"hello.txt": "Hello there!",
+	})
+	r := setupTestTicketShareRouter(testConfig, db)
+	fetchPreview := func(fileID uuid.UUID) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(
+			http.MethodGet,
+			fmt.Sprintf("/%s/file/%s/preview", ticketValue.ID, fileID),
+			nil,
+		)
+		req.SetBasicAuth(ticketValue.ID.String(), "abc123")
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+		return w
+	}
+
+	w := fetchPreview(files[0].ID)
+	assert.Equal(t, http.StatusNotFound, w.Code)
+
+	_, err := services.NewPreviewService(testConfig, db).GeneratePendingPreviews(t.Context())
+	require.NoError(t, err)
+	w = fetchPreview(files[0].ID)
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "text/plain; charset=utf-8", w.Header().Get("Content-Type"))
+	assert.Equal(t, "nosniff", w.Header().Get("X-Content-Type-Options"))
+	assert.Equal(t, "Hello there!", w.Body.String())
+	assert.Equal(t, uint64(0), db.File.GetX(t.Context(), files[0].ID).TimesDownloaded)
+
+	w = fetchPreview(uuid.New())
+	assert.Equal(t, http.StatusNotFound, w.Code)
+}
+
+func TestFetchTicketFileView(t *testing.T) {
+	testConfig := testutil.SetupTestConfig()
+	db := testutil.SetupTestDBClient(t)
+	ticketValue, files := createTestShareTicket(t, testConfig, db, map[string]string{
+		"hello.txt": "Hello there!",
+	})
+	binaryTicket, binaryFiles := createTestShareTicket(t, testConfig, db, map[string]string{
+		"data.bin": "\x00\x01\x02\x03",
+	})
+	r := setupTestTicketShareRouter(testConfig, db)
+	fetchView := func(
+		ticketID uuid.UUID,
+		fileID uuid.UUID,
+		byteRange string,
+	) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(
+			http.MethodGet,
+			fmt.Sprintf("/%s/file/%s/view", ticketID, fileID),
+			nil,
+		)
+		req.SetBasicAuth(ticketID.String(), "abc123")
+		if byteRange != "" {
+			req.Header.Set("Range", byteRange)
+		}
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+		return w
+	}
+
+	w := fetchView(ticketValue.ID, files[0].ID, "")
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "Hello there!", w.Body.String())
+	assert.Equal(t, "inline; filename=hello.txt", w.Header().Get("Content-Disposition"))
+	assert.Contains(t, w.Header().Get("Content-Security-Policy"), "sandbox")
+	// a range request continues the same view
+	w = fetchView(ticketValue.ID, files[0].ID, "bytes=6-")
+	assert.Equal(t, http.StatusPartialContent, w.Code)
+	fileValue := db.File.GetX(t.Context(), files[0].ID)
+	assert.Equal(t, uint64(1), fileValue.TimesViewed)
+	assert.NotNil(t, fileValue.LastView)
+	assert.Equal(t, uint64(0), fileValue.TimesDownloaded)
+	assert.Equal(t, 2, db.AccessEvent.Query().
+		Where(accessevent.Type(config.AccessEventTypeFileView)).
+		CountX(t.Context()))
+	assert.Equal(t, 0, db.AccessEvent.Query().
+		Where(accessevent.Type(config.AccessEventTypeDownload)).
+		CountX(t.Context()))
+
+	db.Ticket.UpdateOne(ticketValue).SetViewsCountAsDownloads(true).ExecX(t.Context())
+	w = fetchView(ticketValue.ID, files[0].ID, "")
+	assert.Equal(t, http.StatusOK, w.Code)
+	fileValue = db.File.GetX(t.Context(), files[0].ID)
+	assert.Equal(t, uint64(2), fileValue.TimesViewed)
+	assert.Equal(t, uint64(1), fileValue.TimesDownloaded)
+
+	w = fetchView(binaryTicket.ID, binaryFiles[0].ID, "")
+	assert.Equal(t, http.StatusUnsupportedMediaType, w.Code)
+	w = fetchView(ticketValue.ID, binaryFiles[0].ID, "")
+	assert.Equal(t, http.StatusNotFound, w.Code)
+}
+
+func TestFetchTicketFileRangesWithPassword(t *testing.T) {
+	testConfig := testutil.SetupTestConfig()
+	db := testutil.SetupTestDBClient(t)
+	ticketValue, files := createTestShareTicket(t, testConfig, db, map[string]string{
+		"hello.txt": "Hello there!",
+	})
+	r := setupTestTicketShareRouter(testConfig, db)
+	fetchRange := func(byteRange string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(
+			http.MethodGet,
+			fmt.Sprintf("/%s/file/%s", ticketValue.ID, files[0].ID),
+			nil,
+		)
+		req.SetBasicAuth(ticketValue.ID.String(), "abc123")
+		if byteRange != "" {
+			req.Header.Set("Range", byteRange)
+		}
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+		return w
+	}
+	timesDownloaded := func() uint64 {
+		return db.File.GetX(t.Context(), files[0].ID).TimesDownloaded
+	}
+
+	// two clients with the ticket password cannot be told apart,
+	// so their halves must not add up to a download
+	assert.Equal(t, http.StatusPartialContent, fetchRange("bytes=0-5").Code)
+	assert.Equal(t, http.StatusPartialContent, fetchRange("bytes=6-").Code)
+	assert.Equal(t, uint64(0), timesDownloaded())
+	assert.Equal(t, 0, db.DownloadProgress.Query().CountX(t.Context()))
+
+	assert.Equal(t, http.StatusOK, fetchRange("").Code)
+	assert.Equal(t, uint64(1), timesDownloaded())
+}
+
+func TestFetchTicketFileOfOtherTicket(t *testing.T) {
+	testConfig := testutil.SetupTestConfig()
+	db := testutil.SetupTestDBClient(t)
+	ticketValue, _ := createTestShareTicket(t, testConfig, db, map[string]string{
+		"hello.txt": "Hello there!",
+	})
+	_, otherFiles := createTestShareTicket(t, testConfig, db, map[string]string{
+		"secret.txt": "Top secret",
+	})
+	r := setupTestTicketShareRouter(testConfig, db)
+
+	w := fetchTestTicketShare(r, ticketValue, fmt.Sprintf("/file/%s", otherFiles[0].ID))
+	assert.Equal(t, http.StatusNotFound, w.Code)
+	assert.Equal(t, uint64(0), db.File.GetX(t.Context(), otherFiles[0].ID).TimesDownloaded)
+}
+
+func fetchTestTicketShare(
+	r *gin.Engine,
+	ticketValue *ent.Ticket,
+	path string,
+) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(http.MethodGet, fmt.Sprintf("/%s%s", ticketValue.ID, path), nil)
+	req.SetBasicAuth(ticketValue.ID.String(), "abc123")
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+	return w
+}
+
+func TestFetchTicketChecksums(t *testing.T) {
+	testConfig := testutil.SetupTestConfig()
+	db := testutil.SetupTestDBClient(t)
+	ticketValue, files := createTestShareTicket(t, testConfig, db, map[string]string{
+		"hello.txt": "Hello there!",
+	})
+	helloSha256 := sha256.Sum256([]byte("Hello there!"))
+	helloSha512 := sha512.Sum512([]byte("Hello there!"))
+	fileData := db.File.QueryData(files[0]).OnlyX(t.Context())
+	assert.Equal(t, hex.EncodeToString(helloSha256[:]), *fileData.Sha256)
+	// files stored before sha256 sums were computed get hashed on demand
+	db.FileData.UpdateOne(fileData).ClearSha256().ExecX(t.Context())
+	r := setupTestTicketShareRouter(testConfig, db)
+
+	w := fetchTestTicketShare(r, ticketValue, "/SHA256SUMS")
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "attachment; filename=SHA256SUMS", w.Header().Get("Content-Disposition"))
+	assert.Equal(t, hex.EncodeToString(helloSha256[:])+"  hello.txt\n", w.Body.String())
+	fileData = db.File.QueryData(files[0]).OnlyX(t.Context())
+	assert.Equal(t, hex.EncodeToString(helloSha256[:]), *fileData.Sha256)
+
+	w = fetchTestTicketShare(r, ticketValue, "/SHA512SUMS")
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, hex.EncodeToString(helloSha512[:])+"  hello.txt\n", w.Body.String())
+	assert.Equal(t, uint64(0), db.File.GetX(t.Context(), files[0].ID).TimesDownloaded)
+
+	db.File.UpdateOne(files[0]).SetScanStatus(config.FileScanStatusPending).ExecX(t.Context())
+	w = fetchTestTicketShare(r, ticketValue, "/SHA256SUMS")
+	assert.Equal(t, http.StatusForbidden, w.Code)
+}
+
+func TestFetchTicketMetalink(t *testing.T) {
+	testConfig := testutil.SetupTestConfig()
+	db := testutil.SetupTestDBClient(t)
+	ticketValue, files := createTestShareTicket(t, testConfig, db, map[string]string{
+		"hello.txt": "Hello there!",
+	})
+	r := setupTestTicketShareRouter(testConfig, db)
+
+	w := fetchTestTicketShare(r, ticketValue, "/metalink")
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, services.MetalinkContentType, w.Header().Get("Content-Type"))
+	assert.Equal(
+		t,
+		fmt.Sprintf("attachment; filename=%s.meta4", ticketValue.ID),
+		w.Header().Get("Content-Disposition"),
+	)
+
+	var metalink struct {
+		XMLName xml.Name `xml:"urn:ietf:params:xml:ns:metalink metalink"`
+		Files   []struct {
+			Name   string `xml:"name,attr"`
+			Size   uint64 `xml:"size"`
+			Hashes []struct {
+				Type  string `xml:"type,attr"`
+				Value string `xml:",chardata"`
+			} `xml:"hash"`
+			URL string `xml:"url"`
+		} `xml:"file"`
+	}
+	require.NoError(t, xml.Unmarshal(w.Body.Bytes(), &metalink))
+	require.Len(t, metalink.Files, 1)
+	metalinkFile := metalink.Files[0]
+	assert.Equal(t, "hello.txt", metalinkFile.Name)
+	assert.Equal(t, uint64(len("Hello there!")), metalinkFile.Size)
+	helloSha256 := sha256.Sum256([]byte("Hello there!"))
+	require.Len(t, metalinkFile.Hashes, 2)
+	assert.Equal(t, "sha-256", metalinkFile.Hashes[0].Type)
+	assert.Equal(t, hex.EncodeToString(helloSha256[:]), metalinkFile.Hashes[0].Value)
+	assert.Equal(t, "sha-512", metalinkFile.Hashes[1].Type)
+	assert.Equal(t, db.File.QueryData(files[0]).OnlyX(t.Context()).ID, metalinkFile.Hashes[1].Value)
+	assert.Equal(
+		t,
+		fmt.Sprintf(
+			"http://example.com/api/v1/share/ticket/%s/file/%s",
+			ticketValue.ID,
+			files[0].ID,
+		),
+		metalinkFile.URL,
+	)
+}
+
+func TestFetchTicketFileAsRecipient(t *testing.T) {
+	testConfig := testutil.SetupTestConfig()
+	db := testutil.SetupTestDBClient(t)
+	ticketValue, files := createTestShareTicket(t, testConfig, db, map[string]string{
+		"hello.txt": "Hello there!",
+	})
+	otherTicket, _ := createTestShareTicket(t, testConfig, db, map[string]string{
+		"kenobi.txt": "General Kenobi!",
+	})
+	recipientService := services.NewRecipientService(testConfig, db)
+	alice, aliceToken, err := recipientService.CreateRecipient(
+		t.Context(),
+		ticketValue,
+		services.RecipientParams{Name: "Alice"},
+	)
+	require.NoError(t, err)
+	bob, bobToken, err := recipientService.CreateRecipient(
+		t.Context(),
+		ticketValue,
+		services.RecipientParams{Name: "Bob"},
+	)
+	require.NoError(t, err)
+	r := setupTestTicketShareRouter(testConfig, db)
+	fetchFile := func(ticketID uuid.UUID, password string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(
+			http.MethodGet,
+			fmt.Sprintf("/%s/file/%s", ticketID, files[0].ID),
+			nil,
+		)
+		req.SetBasicAuth(ticketID.String(), password)
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+		return w
+	}
+
+	for range 2 {
+		w := fetchFile(ticketValue.ID, aliceToken)
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, "Hello there!", w.Body.String())
+	}
+	alice = db.Recipient.GetX(t.Context(), alice.ID)
+	assert.Equal(t, uint64(2), alice.TimesDownloaded)
+	assert.NotNil(t, alice.LastDownload)
+	assert.Equal(t, []string{files[0].ID.String()}, alice.DownloadedFiles)
+	bob = db.Recipient.GetX(t.Context(), bob.ID)
+	assert.Equal(t, uint64(0), bob.TimesDownloaded)
+	assert.Nil(t, bob.LastDownload)
+
+	// a personal link only opens the ticket it belongs to
+	w := fetchFile(otherTicket.ID, aliceToken)
+	assert.Equal(t, http.StatusUnauthorized, w.Code)
+
+	// access tokens issued to a recipient stop working with the revocation
+	req := httptest.NewRequest(http.MethodGet, fmt.Sprintf("/%s/token", ticketValue.ID), nil)
+	req.SetBasicAuth(ticketValue.ID.String(), aliceToken)
+	w = httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+	require.Equal(t, http.StatusCreated, w.Code)
+	tokenCookie := w.Result().Cookies()[0]
+
+	fetchFileWithCookie := func(ticketID uuid.UUID) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(
+			http.MethodGet,
+			fmt.Sprintf("/%s/file/%s", ticketID, files[0].ID),
+			nil,
+		)
+		req.AddCookie(tokenCookie)
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+		return w
+	}
+	w = fetchFileWithCookie(otherTicket.ID)
+	assert.Equal(t, http.StatusUnauthorized, w.Code)
+	w = fetchFileWithCookie(ticketValue.ID)
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	_, err = recipientService.RevokeRecipient(t.Context(), alice)
+	require.NoError(t, err)
+	w = fetchFileWithCookie(ticketValue.ID)
+	assert.Equal(t, http.StatusUnauthorized, w.Code)
+	w = fetchFile(ticketValue.ID, aliceToken)
+	assert.Equal(t, http.StatusUnauthorized, w.Code)
+	w = fetchFile(ticketValue.ID, bobToken)
+	assert.Equal(t, http.StatusOK, w.Code)
+	w = fetchFile(ticketValue.ID, "abc123")
+	assert.Equal(t, http.StatusOK, w.Code)
+}
+
+func TestTicketShareAccessEvents(t *testing.T) {
+	testConfig := testutil.SetupTestConfig()
+	db := testutil.SetupTestDBClient(t)
+	ticketValue, files := createTestShareTicket(t, testConfig, db, map[string]string{
+		"hello.txt": "Hello there!",
+	})
+	r := setupTestTicketShareRouter(testConfig, db)
+	sendRequest := func(path string, password string, byteRange string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodGet, fmt.Sprintf("/%s%s", ticketValue.ID, path), nil)
+		req.SetBasicAuth(ticketValue.ID.String(), password)
+		req.Header.Set("User-Agent", "frans-test")
+		if byteRange != "" {
+			req.Header.Set("Range", byteRange)
+		}
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+		return w
+	}
+
+	assert.Equal(t, http.StatusUnauthorized, sendRequest("", "wrong", "").Code)
+	assert.Equal(t, http.StatusOK, sendRequest("", "abc123", "").Code)
+	assert.Equal(t, http.StatusCreated, sendRequest("/token", "abc123", "").Code)
+	filePath := fmt.Sprintf("/file/%s", files[0].ID)
+	assert.Equal(t, http.StatusPartialContent, sendRequest(filePath, "abc123", "bytes=6-").Code)
+
+	events, err := services.NewAccessEventService(db).Events(t.Context())
+	require.NoError(t, err)
+	require.Len(t, events, 4)
+	eventTypes := make([]string, len(events))
+	for i, event := range events {
+		eventTypes[i] = event.Type
+		assert.Equal(t, "frans-test", event.UserAgent)
+		assert.Equal(t, "192.0.2.1", event.Address)
+	}
+	assert.ElementsMatch(t, []string{
+		config.AccessEventTypeAuthFailed,
+		config.AccessEventTypeView,
+		config.AccessEventTypeToken,
+		config.AccessEventTypeDownload,
+	}, eventTypes)
+	for _, event := range events {
+		if event.Type != config.AccessEventTypeDownload {
+			continue
+		}
+		assert.Equal(t, int64(len("there!")), event.BytesServed)
+		assert.Equal(t, files[0].ID, event.Edges.File.ID)
+		assert.Equal(t, "hello.txt", *event.FileName)
+	}
+}
@@ -0,0 +1,68 @@
+package apiRoutes
+
+import (
+	"net/http"
+
+	"codeberg.org/jvllmr/frans/internal/config"
+	"codeberg.org/jvllmr/frans/internal/ent"
+	"codeberg.org/jvllmr/frans/internal/middleware"
+	"codeberg.org/jvllmr/frans/internal/otel"
+	"codeberg.org/jvllmr/frans/internal/services"
+	"codeberg.org/jvllmr/frans/internal/util"
+	"github.com/gin-gonic/gin"
+)
+
+type storageController struct {
+	fileService              services.FileService
+	storageAccountingService services.StorageAccountingService
+	diskService              services.DiskService
+}
+
+func (sc *storageController) fetchStorageVerification(c *gin.Context) {
+	ctx, span := otel.NewSpan(c.Request.Context(), "fetchStorageVerification")
+	defer span.End()
+	verification, err := sc.fileService.LatestStorageVerification(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			util.GinAbortWithError(ctx, c, http.StatusNotFound, err)
+		} else {
+			util.GinAbortWithError(ctx, c, http.StatusInternalServerError, err)
+		}
+		return
+	}
+	c.JSON(http.StatusOK, services.ToPublicStorageVerification(verification))
+}
+
+func (sc *storageController) fetchStorageUsage(c *gin.Context) {
+	ctx, span := otel.NewSpan(c.Request.Context(), "fetchStorageUsage")
+	defer span.End()
+	usage, err := sc.storageAccountingService.Usage(ctx)
+	if err != nil {
+		util.GinAbortWithError(ctx, c, http.StatusInternalServerError, err)
+		return
+	}
+	c.JSON(http.StatusOK, usage)
+}
+
+// fetchDiskStatus reports the usage of the volume behind the files directory
+func (sc *storageController) fetchDiskStatus(c *gin.Context) {
+	ctx, span := otel.NewSpan(c.Request.Context(), "fetchDiskStatus")
+	defer span.End()
+	diskStatus, err := sc.diskService.Status(ctx)
+	if err != nil {
+		util.GinAbortWithError(ctx, c, http.StatusInternalServerError, err)
+		return
+	}
+	c.JSON(http.StatusOK, diskStatus)
+}
+
+func setupStorageGroup(r *gin.RouterGroup, configValue config.Config, db *ent.Client) {
+	controller := storageController{
+		fileService:              services.NewFileService(configValue, db),
+		storageAccountingService: services.NewStorageAccountingService(db),
+		diskService:              services.NewDiskService(configValue),
+	}
+	r.GET("/verification", middleware.AdminRequired, controller.fetchStorageVerification)
+	r.GET("/usage", middleware.AdminRequired, controller.fetchStorageUsage)
+	r.GET("/disk", middleware.AdminRequired, controller.fetchDiskStatus)
+}
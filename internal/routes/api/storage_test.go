@@ -0,0 +1,125 @@
+package apiRoutes
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"codeberg.org/jvllmr/frans/internal/config"
+	"codeberg.org/jvllmr/frans/internal/ent"
+	"codeberg.org/jvllmr/frans/internal/ent/file"
+	"codeberg.org/jvllmr/frans/internal/services"
+	"codeberg.org/jvllmr/frans/internal/testutil"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func setupTestStorageRouter(
+	testConfig config.Config,
+	db *ent.Client,
+	middlewares ...gin.HandlerFunc,
+) *gin.Engine {
+	r := gin.Default()
+	group := r.Group("", middlewares...)
+	setupStorageGroup(group, testConfig, db)
+
+	return r
+}
+
+func TestFetchStorageVerification(t *testing.T) {
+	cfg := testutil.SetupTestConfig()
+	cfg.FilesDir = t.TempDir()
+	db := testutil.SetupTestDBClient(t)
+	adminUser := testutil.SetupTestAdminUser(t, db, nil)
+	r := setupTestStorageRouter(cfg, db, testutil.NewTestAuthMiddleware(adminUser))
+
+	req := httptest.NewRequest(http.MethodGet, "/verification", nil)
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+	assert.Equal(t, http.StatusNotFound, w.Code)
+
+	_, err := services.NewFileService(cfg, db).VerifyStorage(t.Context())
+	require.NoError(t, err)
+	w = httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+	assert.Equal(t, http.StatusOK, w.Code)
+	var verification services.PublicStorageVerification
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &verification))
+	assert.NotNil(t, verification.FinishedAt)
+	assert.Empty(t, verification.Issues)
+
+	testUser := testutil.SetupTestUser(t, db, nil)
+	r = setupTestStorageRouter(cfg, db, testutil.NewTestAuthMiddleware(testUser))
+	w = httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+	assert.Equal(t, http.StatusForbidden, w.Code)
+}
+
+func fetchTestStorageUsage(t *testing.T, r *gin.Engine) services.StorageUsage {
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/usage", nil))
+	require.Equal(t, http.StatusOK, w.Code)
+	var usage services.StorageUsage
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &usage))
+	return usage
+}
+
+func TestFetchStorageUsage(t *testing.T) {
+	cfg := testutil.SetupTestConfig()
+	cfg.FilesDir = t.TempDir()
+	db := testutil.SetupTestDBClient(t)
+	adminUser := testutil.SetupTestAdminUser(t, db, nil)
+	testUser := testutil.SetupTestUser(t, db, nil)
+	r := setupTestStorageRouter(cfg, db, testutil.NewTestAuthMiddleware(adminUser))
+
+	content := "Hello there!"
+	adminFiles := make([]*ent.File, 2)
+	for i := range adminFiles {
+		adminFiles[i] = testutil.SetupTestFile(
+			t, cfg, db, "hello.txt", content, adminUser, "none", 0, 0, 0,
+		)
+	}
+	userFile := testutil.SetupTestFile(
+		t, cfg, db, "hello.txt", content, testUser, "none", 0, 0, 0,
+	)
+	storedSize := db.FileData.Query().OnlyX(t.Context()).StoredSize
+	contentSize := int64(len(content))
+
+	userUsage := func(usage services.StorageUsage, u *ent.User) services.UserStorageUsage {
+		for _, userUsage := range usage.Users {
+			if userUsage.User.ID == u.ID.String() {
+				return userUsage
+			}
+		}
+		t.Fatalf("no usage of user %s", u.Username)
+		return services.UserStorageUsage{}
+	}
+	usage := fetchTestStorageUsage(t, r)
+	assert.Equal(t, 3*contentSize, usage.LogicalBytes)
+	assert.Equal(t, storedSize, usage.PhysicalBytes)
+	assert.Equal(t, float64(3*contentSize)/float64(storedSize), usage.DedupRatio)
+	assert.Equal(t, 2*contentSize, userUsage(usage, adminUser).LogicalBytes)
+	assert.Equal(t, storedSize, userUsage(usage, adminUser).PhysicalBytes)
+	assert.Equal(t, contentSize, userUsage(usage, testUser).LogicalBytes)
+	assert.Equal(t, storedSize, userUsage(usage, testUser).PhysicalBytes)
+
+	fs := services.NewFileService(cfg, db)
+	for _, fileValue := range []*ent.File{adminFiles[0], userFile} {
+		fileValue = db.File.Query().Where(file.ID(fileValue.ID)).WithData().OnlyX(t.Context())
+		require.NoError(t, fs.DeleteSingleFile(t.Context(), fileValue))
+	}
+	usage = fetchTestStorageUsage(t, r)
+	assert.Equal(t, contentSize, usage.LogicalBytes)
+	assert.Equal(t, storedSize, usage.PhysicalBytes)
+	assert.Equal(t, contentSize, userUsage(usage, adminUser).LogicalBytes)
+	assert.Equal(t, storedSize, userUsage(usage, adminUser).PhysicalBytes)
+	assert.Zero(t, userUsage(usage, testUser).LogicalBytes)
+	assert.Zero(t, userUsage(usage, testUser).PhysicalBytes)
+
+	r = setupTestStorageRouter(cfg, db, testutil.NewTestAuthMiddleware(testUser))
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/usage", nil))
+	assert.Equal(t, http.StatusForbidden, w.Code)
+}
@@ -22,10 +22,17 @@ import (
 )
 
 type ticketController struct {
-	config        config.Config
-	db            *ent.Client
-	ticketService services.TicketService
-	mailer        mail.Mailer
+	config             config.Config
+	db                 *ent.Client
+	ticketService      services.TicketService
+	fileService        services.FileService
+	uploadService      services.UploadService
+	quotaService       services.QuotaService
+	scanService        services.ScanService
+	previewService     services.PreviewService
+	recipientService   services.RecipientService
+	accessEventService services.AccessEventService
+	mailer             mail.Mailer
 }
 
 func (tc *ticketController) createTicketHandler(c *gin.Context) {
@@ -33,16 +40,25 @@ func (tc *ticketController) createTicketHandler(c *gin.Context) {
 	defer span.End()
 
 	currentUser := middleware.GetCurrentUser(c)
-	var form services.TicketFormParams
-	tx, err := tc.db.BeginTx(ctx, &sql.TxOptions{})
+	multipartUpload, err := tc.fileService.ReadMultipartUpload(ctx, c.Request)
 	if err != nil {
-		util.GinAbortWithError(ctx, c, http.StatusInternalServerError, err)
+		if services.IsDigestMismatch(err) {
+			// clients need the computed digests to find out what went wrong
+			util.GinAbortWithPublicError(ctx, c, http.StatusBadRequest, err)
+		} else if services.IsUploadRejected(err) {
+			util.GinAbortWithError(ctx, c, http.StatusBadRequest, err)
+		} else if services.IsInsufficientStorage(err) {
+			util.GinAbortWithError(ctx, c, http.StatusInsufficientStorage, err)
+		} else {
+			util.GinAbortWithError(ctx, c, http.StatusInternalServerError, err)
+		}
 		return
 	}
-	if err := c.ShouldBind(&form); err == nil {
-		multipartForm, _ := c.MultipartForm()
-		files := multipartForm.File["files[]"]
-		if len(files) > int(tc.config.MaxFiles) {
+	defer multipartUpload.Cleanup()
+	var form services.TicketFormParams
+	if err := multipartUpload.Bind(&form); err == nil {
+		files := multipartUpload.Files
+		if len(files)+len(form.Uploads) > int(tc.config.MaxFiles) {
 			util.GinAbortWithError(
 				ctx,
 				c,
@@ -50,19 +66,58 @@ func (tc *ticketController) createTicketHandler(c *gin.Context) {
 				fmt.Errorf(
 					"maximum of %d files allowed per upload. %d uploaded",
 					tc.config.MaxFiles,
-					len(files),
+					len(files)+len(form.Uploads),
 				),
 			)
 			return
 		}
-		ticketValue, err := tc.ticketService.CreateTicket(ctx, tx, currentUser, &form, files)
+		uploads, err := tc.uploadService.GetFinishedUploads(ctx, form.Uploads, currentUser, nil)
+		if err != nil {
+			if services.IsUploadRejected(err) {
+				util.GinAbortWithError(ctx, c, http.StatusBadRequest, err)
+			} else {
+				util.GinAbortWithError(ctx, c, http.StatusInternalServerError, err)
+			}
+			return
+		}
+		err = tc.quotaService.CheckNewTicket(
+			ctx,
+			currentUser,
+			services.TotalUploadSize(files, uploads),
+		)
+		if err != nil {
+			if services.IsQuotaExceeded(err) {
+				util.GinAbortWithPublicError(ctx, c, http.StatusForbidden, err)
+			} else {
+				util.GinAbortWithError(ctx, c, http.StatusInternalServerError, err)
+			}
+			return
+		}
+		tx, err := tc.db.BeginTx(ctx, &sql.TxOptions{})
+		if err != nil {
+			util.GinAbortWithError(ctx, c, http.StatusInternalServerError, err)
+			return
+		}
+		ticketValue, err := tc.ticketService.CreateTicket(
+			ctx,
+			tx,
+			currentUser,
+			&form,
+			files,
+			uploads,
+		)
 		if err != nil {
-			var errFileTooBig *services.ErrFileTooBig
-			if errors.As(err, &errFileTooBig) {
+			_ = tx.Rollback()
+			if services.IsFileTypeRejected(err) {
+				util.GinAbortWithPublicError(ctx, c, http.StatusUnsupportedMediaType, err)
+			} else if services.IsUploadRejected(err) {
 				util.GinAbortWithError(ctx, c, http.StatusBadRequest, err)
+			} else if services.IsInsufficientStorage(err) {
+				util.GinAbortWithError(ctx, c, http.StatusInsufficientStorage, err)
 			} else {
 				util.GinAbortWithError(ctx, c, http.StatusInternalServerError, err)
 			}
+			return
 		}
 
 		if form.Email != nil {
@@ -90,6 +145,8 @@ func (tc *ticketController) createTicketHandler(c *gin.Context) {
 			util.GinAbortWithError(ctx, c, http.StatusInternalServerError, err)
 			return
 		}
+		tc.scanService.ScanPendingFilesInBackground()
+		tc.previewService.GeneratePendingPreviewsInBackground()
 		c.JSON(http.StatusCreated, tc.ticketService.ToPublicTicket(ticketValue))
 	} else {
 		util.GinAbortWithError(ctx, c, http.StatusUnprocessableEntity, err)
@@ -97,6 +154,125 @@ func (tc *ticketController) createTicketHandler(c *gin.Context) {
 
 }
 
+// preflightTicketHandler lets clients validate an upload before sending any file content
+func (tc *ticketController) preflightTicketHandler(c *gin.Context) {
+	ctx, span := otel.NewSpan(c.Request.Context(), "preflightTicket")
+	defer span.End()
+	var preflight apiTypes.UploadPreflightRequest
+	if err := c.ShouldBindJSON(&preflight); err != nil {
+		util.GinAbortWithError(ctx, c, http.StatusUnprocessableEntity, err)
+		return
+	}
+	if err := tc.fileService.CheckUploadLimits(preflight.Sizes()); err != nil {
+		util.GinAbortWithError(ctx, c, http.StatusBadRequest, err)
+		return
+	}
+	if err := tc.fileService.CheckFileNames(preflight.Names()); err != nil {
+		util.GinAbortWithPublicError(ctx, c, http.StatusUnsupportedMediaType, err)
+		return
+	}
+	err := tc.quotaService.CheckNewTicket(
+		ctx,
+		middleware.GetCurrentUser(c),
+		preflight.TotalSize(),
+	)
+	if err != nil {
+		if services.IsQuotaExceeded(err) {
+			util.GinAbortWithPublicError(ctx, c, http.StatusForbidden, err)
+		} else {
+			util.GinAbortWithError(ctx, c, http.StatusInternalServerError, err)
+		}
+		return
+	}
+	c.Status(http.StatusNoContent)
+}
+
+// reshareTicketHandler creates a ticket from files the current user received through grants.
+// The content of the files is not copied, the new files reference the existing data.
+func (tc *ticketController) reshareTicketHandler(c *gin.Context) {
+	ctx, span := otel.NewSpan(c.Request.Context(), "reshareTicket")
+	defer span.End()
+	var params services.TicketReshareParams
+	if err := c.ShouldBindJSON(&params); err != nil {
+		util.GinAbortWithError(ctx, c, http.StatusUnprocessableEntity, err)
+		return
+	}
+	if len(params.Files) > int(tc.config.MaxFiles) {
+		util.GinAbortWithError(
+			ctx,
+			c,
+			http.StatusBadRequest,
+			fmt.Errorf(
+				"maximum of %d files allowed per ticket. %d requested",
+				tc.config.MaxFiles,
+				len(params.Files),
+			),
+		)
+		return
+	}
+	currentUser := middleware.GetCurrentUser(c)
+	files, err := tc.ticketService.ReceivedFiles(ctx, currentUser, params.Files)
+	if err != nil {
+		if errors.Is(err, services.ErrFileNotReceived) {
+			util.GinAbortWithError(ctx, c, http.StatusNotFound, err)
+		} else {
+			util.GinAbortWithError(ctx, c, http.StatusInternalServerError, err)
+		}
+		return
+	}
+	var size int64
+	for _, fileValue := range files {
+		if err := services.CheckScanStatus(fileValue); err != nil {
+			util.GinAbortWithPublicError(ctx, c, http.StatusForbidden, err)
+			return
+		}
+		size += int64(fileValue.Edges.Data.Size)
+	}
+	if err := tc.quotaService.CheckNewTicket(ctx, currentUser, size); err != nil {
+		if services.IsQuotaExceeded(err) {
+			util.GinAbortWithPublicError(ctx, c, http.StatusForbidden, err)
+		} else {
+			util.GinAbortWithError(ctx, c, http.StatusInternalServerError, err)
+		}
+		return
+	}
+	tx, err := tc.db.Tx(ctx)
+	if err != nil {
+		util.GinAbortWithError(ctx, c, http.StatusInternalServerError, err)
+		return
+	}
+	ticketValue, err := tc.ticketService.ReshareFiles(ctx, tx, currentUser, &params, files)
+	if err != nil {
+		_ = tx.Rollback()
+		util.GinAbortWithError(ctx, c, http.StatusInternalServerError, err)
+		return
+	}
+
+	if params.Email != nil {
+		var toBeEmailedPassword *string = nil
+		if params.EmailPassword {
+			toBeEmailedPassword = &params.Password
+		}
+		if err := tc.notifyTicketRecipients(
+			c,
+			ticketValue,
+			*params.Email,
+			params.ReceiverLang,
+			toBeEmailedPassword,
+		); err != nil {
+			_ = tx.Rollback()
+			util.GinAbortWithError(ctx, c, http.StatusInternalServerError, err)
+			return
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		util.GinAbortWithError(ctx, c, http.StatusInternalServerError, err)
+		return
+	}
+	c.JSON(http.StatusCreated, tc.ticketService.ToPublicTicket(ticketValue))
+}
+
 func (tc *ticketController) fetchTicketsHandler(c *gin.Context) {
 	ctx, span := otel.NewSpan(c.Request.Context(), "fetchTickets")
 	defer span.End()
@@ -120,6 +296,282 @@ func (tc *ticketController) fetchTicketsHandler(c *gin.Context) {
 	c.JSON(http.StatusOK, publicTickets)
 }
 
+// notifyTicketRecipients sends the current state of a changed ticket to recipients.
+// Without a receiver language the language of the creator is used.
+func (tc *ticketController) notifyTicketRecipients(
+	c *gin.Context,
+	ticketValue *ent.Ticket,
+	recipients []string,
+	receiverLang string,
+	password *string,
+) error {
+	if receiverLang == "" {
+		receiverLang = ticketValue.CreatorLang
+	}
+	for _, email := range recipients {
+		if err := tc.mailer.SendTicketSharedNotification(
+			c,
+			tc.ticketService,
+			email,
+			receiverLang,
+			ticketValue,
+			password,
+		); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (tc *ticketController) patchTicketHandler(c *gin.Context) {
+	ctx, span := otel.NewSpan(c.Request.Context(), "patchTicket")
+	defer span.End()
+	var requestedTicket apiTypes.RequestedTicketParam
+	if err := c.ShouldBindUri(&requestedTicket); err != nil {
+		util.GinAbortWithError(ctx, c, http.StatusBadRequest, err)
+		return
+	}
+	var params services.TicketPatchParams
+	if err := c.ShouldBindJSON(&params); err != nil {
+		util.GinAbortWithError(ctx, c, http.StatusUnprocessableEntity, err)
+		return
+	}
+	t, err := tc.db.Ticket.Query().
+		Where(ticket.ID(uuid.MustParse(requestedTicket.ID))).
+		WithOwner().
+		WithFiles().
+		Only(ctx)
+	if err != nil {
+		util.GinAbortWithError(ctx, c, http.StatusNotFound, err)
+		return
+	}
+	currentUser := middleware.GetCurrentUser(c)
+	if !currentUser.IsAdmin && t.Edges.Owner.ID != currentUser.ID {
+		c.AbortWithStatus(http.StatusForbidden)
+		return
+	}
+	tx, err := tc.db.Tx(ctx)
+	if err != nil {
+		util.GinAbortWithError(ctx, c, http.StatusInternalServerError, err)
+		return
+	}
+	ticketValue, err := tc.ticketService.UpdateTicket(ctx, tx, t, &params)
+	if err != nil {
+		_ = tx.Rollback()
+		if errors.Is(err, services.ErrFileNotInTicket) {
+			util.GinAbortWithError(ctx, c, http.StatusBadRequest, err)
+		} else {
+			util.GinAbortWithError(ctx, c, http.StatusInternalServerError, err)
+		}
+		return
+	}
+
+	if params.Email != nil {
+		var toBeEmailedPassword *string = nil
+		if params.EmailPassword {
+			toBeEmailedPassword = params.Password
+		}
+		if err := tc.notifyTicketRecipients(
+			c,
+			ticketValue,
+			*params.Email,
+			params.ReceiverLang,
+			toBeEmailedPassword,
+		); err != nil {
+			_ = tx.Rollback()
+			util.GinAbortWithError(ctx, c, http.StatusInternalServerError, err)
+			return
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		util.GinAbortWithError(ctx, c, http.StatusInternalServerError, err)
+		return
+	}
+	c.JSON(http.StatusOK, tc.ticketService.ToPublicTicket(ticketValue))
+}
+
+func (tc *ticketController) addTicketFilesHandler(c *gin.Context) {
+	ctx, span := otel.NewSpan(c.Request.Context(), "addTicketFiles")
+	defer span.End()
+	var requestedTicket apiTypes.RequestedTicketParam
+	if err := c.ShouldBindUri(&requestedTicket); err != nil {
+		util.GinAbortWithError(ctx, c, http.StatusBadRequest, err)
+		return
+	}
+	t, err := tc.db.Ticket.Query().
+		Where(ticket.ID(uuid.MustParse(requestedTicket.ID))).
+		WithOwner().
+		Only(ctx)
+	if err != nil {
+		util.GinAbortWithError(ctx, c, http.StatusNotFound, err)
+		return
+	}
+	currentUser := middleware.GetCurrentUser(c)
+	// files belong to the user who uploads them, so only the owner can add files
+	if t.Edges.Owner.ID != currentUser.ID {
+		c.AbortWithStatus(http.StatusForbidden)
+		return
+	}
+
+	multipartUpload, err := tc.fileService.ReadMultipartUpload(ctx, c.Request)
+	if err != nil {
+		if services.IsDigestMismatch(err) {
+			util.GinAbortWithPublicError(ctx, c, http.StatusBadRequest, err)
+		} else if services.IsUploadRejected(err) {
+			util.GinAbortWithError(ctx, c, http.StatusBadRequest, err)
+		} else if services.IsInsufficientStorage(err) {
+			util.GinAbortWithError(ctx, c, http.StatusInsufficientStorage, err)
+		} else {
+			util.GinAbortWithError(ctx, c, http.StatusInternalServerError, err)
+		}
+		return
+	}
+	defer multipartUpload.Cleanup()
+	var form services.TicketFilesFormParams
+	if err := multipartUpload.Bind(&form); err != nil {
+		util.GinAbortWithError(ctx, c, http.StatusUnprocessableEntity, err)
+		return
+	}
+	files := multipartUpload.Files
+	if len(files)+len(form.Uploads) == 0 {
+		util.GinAbortWithError(ctx, c, http.StatusBadRequest, errors.New("no files uploaded"))
+		return
+	}
+	if len(files)+len(form.Uploads) > int(tc.config.MaxFiles) {
+		util.GinAbortWithError(
+			ctx,
+			c,
+			http.StatusBadRequest,
+			fmt.Errorf(
+				"maximum of %d files allowed per upload. %d uploaded",
+				tc.config.MaxFiles,
+				len(files)+len(form.Uploads),
+			),
+		)
+		return
+	}
+	uploads, err := tc.uploadService.GetFinishedUploads(ctx, form.Uploads, currentUser, nil)
+	if err != nil {
+		if services.IsUploadRejected(err) {
+			util.GinAbortWithError(ctx, c, http.StatusBadRequest, err)
+		} else {
+			util.GinAbortWithError(ctx, c, http.StatusInternalServerError, err)
+		}
+		return
+	}
+	err = tc.quotaService.CheckBytes(ctx, currentUser, services.TotalUploadSize(files, uploads))
+	if err != nil {
+		if services.IsQuotaExceeded(err) {
+			util.GinAbortWithPublicError(ctx, c, http.StatusForbidden, err)
+		} else {
+			util.GinAbortWithError(ctx, c, http.StatusInternalServerError, err)
+		}
+		return
+	}
+	tx, err := tc.db.Tx(ctx)
+	if err != nil {
+		util.GinAbortWithError(ctx, c, http.StatusInternalServerError, err)
+		return
+	}
+	ticketValue, err := tc.ticketService.AddFiles(ctx, tx, t, currentUser, files, uploads)
+	if err != nil {
+		_ = tx.Rollback()
+		if services.IsFileTypeRejected(err) {
+			util.GinAbortWithPublicError(ctx, c, http.StatusUnsupportedMediaType, err)
+		} else if services.IsUploadRejected(err) {
+			util.GinAbortWithError(ctx, c, http.StatusBadRequest, err)
+		} else if services.IsInsufficientStorage(err) {
+			util.GinAbortWithError(ctx, c, http.StatusInsufficientStorage, err)
+		} else {
+			util.GinAbortWithError(ctx, c, http.StatusInternalServerError, err)
+		}
+		return
+	}
+	if form.Email != nil {
+		err := tc.notifyTicketRecipients(c, ticketValue, *form.Email, form.ReceiverLang, nil)
+		if err != nil {
+			_ = tx.Rollback()
+			util.GinAbortWithError(ctx, c, http.StatusInternalServerError, err)
+			return
+		}
+	}
+	if err := tx.Commit(); err != nil {
+		util.GinAbortWithError(ctx, c, http.StatusInternalServerError, err)
+		return
+	}
+	tc.scanService.ScanPendingFilesInBackground()
+	tc.previewService.GeneratePendingPreviewsInBackground()
+	c.JSON(http.StatusCreated, tc.ticketService.ToPublicTicket(ticketValue))
+}
+
+func (tc *ticketController) removeTicketFileHandler(c *gin.Context) {
+	ctx, span := otel.NewSpan(c.Request.Context(), "removeTicketFile")
+	defer span.End()
+	var requestedFile apiTypes.RequestedTicketFileParam
+	if err := c.ShouldBindUri(&requestedFile); err != nil {
+		util.GinAbortWithError(ctx, c, http.StatusBadRequest, err)
+		return
+	}
+	var query apiTypes.TicketFileRemovalQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		util.GinAbortWithError(ctx, c, http.StatusBadRequest, err)
+		return
+	}
+	t, err := tc.db.Ticket.Query().
+		Where(ticket.ID(uuid.MustParse(requestedFile.TicketID))).
+		WithOwner().
+		WithFiles(func(fq *ent.FileQuery) { fq.WithData() }).
+		Only(ctx)
+	if err != nil {
+		util.GinAbortWithError(ctx, c, http.StatusNotFound, err)
+		return
+	}
+	currentUser := middleware.GetCurrentUser(c)
+	if !currentUser.IsAdmin && t.Edges.Owner.ID != currentUser.ID {
+		c.AbortWithStatus(http.StatusForbidden)
+		return
+	}
+	err = tc.ticketService.RemoveFile(ctx, t, uuid.MustParse(requestedFile.FileID))
+	if err != nil {
+		if errors.Is(err, services.ErrFileNotInTicket) {
+			util.GinAbortWithError(ctx, c, http.StatusNotFound, err)
+		} else if errors.Is(err, services.ErrLastTicketFile) {
+			util.GinAbortWithPublicError(ctx, c, http.StatusConflict, err)
+		} else {
+			util.GinAbortWithError(ctx, c, http.StatusInternalServerError, err)
+		}
+		return
+	}
+	ticketValue, err := tc.db.Ticket.Query().
+		Where(ticket.ID(t.ID)).
+		WithFiles(func(fq *ent.FileQuery) { fq.WithData().WithOwner() }).
+		WithOwner().
+		Only(ctx)
+	if err != nil {
+		util.GinAbortWithError(ctx, c, http.StatusInternalServerError, err)
+		return
+	}
+	slog.InfoContext(
+		ctx,
+		"Removed file from ticket",
+		"username",
+		currentUser.Username,
+		"ticketId",
+		t.ID.String(),
+		"fileId",
+		requestedFile.FileID,
+	)
+	if len(query.Email) > 0 {
+		err := tc.notifyTicketRecipients(c, ticketValue, query.Email, query.ReceiverLang, nil)
+		if err != nil {
+			util.GinAbortWithError(ctx, c, http.StatusInternalServerError, err)
+			return
+		}
+	}
+	c.JSON(http.StatusOK, tc.ticketService.ToPublicTicket(ticketValue))
+}
+
 func (tc *ticketController) deleteTicketHandler(c *gin.Context) {
 	ctx, span := otel.NewSpan(c.Request.Context(), "deleteTicketManual")
 	defer span.End()
@@ -149,13 +601,16 @@ func (tc *ticketController) deleteTicketHandler(c *gin.Context) {
 		return
 	}
 
-	if err := tc.ticketService.DeleteTicket(ctx, tx, t); err != nil {
+	deletedData, err := tc.ticketService.DeleteTicket(ctx, tx, t)
+	if err != nil {
+		_ = tx.Rollback()
 		util.GinAbortWithError(ctx, c, http.StatusInternalServerError, err)
 		return
 	}
 
 	if !isUserOwner {
 		if err := tc.mailer.SendTicketDeletionNotification(t, tc.config.GetBaseURL(c.Request)); err != nil {
+			_ = tx.Rollback()
 			util.GinAbortWithError(ctx, c, http.StatusInternalServerError, err)
 			return
 		}
@@ -165,6 +620,7 @@ func (tc *ticketController) deleteTicketHandler(c *gin.Context) {
 		util.GinAbortWithError(ctx, c, http.StatusInternalServerError, err)
 		return
 	}
+	tc.fileService.PurgeFileData(ctx, deletedData...)
 	slog.InfoContext(
 		ctx,
 		"Manual ticket deletion",
@@ -179,13 +635,31 @@ func (tc *ticketController) deleteTicketHandler(c *gin.Context) {
 }
 
 func setupTicketGroup(r *gin.RouterGroup, configValue config.Config, db *ent.Client) {
+	mailer := mail.NewMailer(configValue)
 	controller := ticketController{
-		config:        configValue,
-		db:            db,
-		ticketService: services.NewTicketService(configValue, db),
-		mailer:        mail.NewMailer(configValue),
+		config:             configValue,
+		db:                 db,
+		ticketService:      services.NewTicketService(configValue, db),
+		fileService:        services.NewFileService(configValue, db),
+		uploadService:      services.NewUploadService(configValue, db),
+		quotaService:       services.NewQuotaService(configValue, db),
+		scanService:        services.NewScanService(configValue, db, &mailer),
+		previewService:     services.NewPreviewService(configValue, db),
+		recipientService:   services.NewRecipientService(configValue, db),
+		accessEventService: services.NewAccessEventService(db),
+		mailer:             mailer,
 	}
-	r.POST("", controller.createTicketHandler)
+	writableStorage := middleware.WritableStorageRequired(services.NewDiskService(configValue))
+	r.POST("", writableStorage, controller.createTicketHandler)
+	r.POST("/preflight", writableStorage, controller.preflightTicketHandler)
+	r.POST("/reshare", controller.reshareTicketHandler)
 	r.GET("", controller.fetchTicketsHandler)
+	r.PATCH("/:ticketId", controller.patchTicketHandler)
 	r.DELETE("/:ticketId", controller.deleteTicketHandler)
+	r.POST("/:ticketId/file", writableStorage, controller.addTicketFilesHandler)
+	r.DELETE("/:ticketId/file/:fileId", controller.removeTicketFileHandler)
+	r.POST("/:ticketId/recipient", controller.createRecipientHandler)
+	r.GET("/:ticketId/recipient", controller.fetchRecipientsHandler)
+	r.DELETE("/:ticketId/recipient/:recipientId", controller.revokeRecipientHandler)
+	r.GET("/:ticketId/event", controller.fetchTicketEventsHandler)
 }
@@ -2,22 +2,34 @@ package apiRoutes
 
 import (
 	"bytes"
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/base64"
+	"encoding/hex"
 	"encoding/json"
+	"fmt"
 	"io"
 	"log"
 	"mime/multipart"
 	"net/http"
 	"net/http/httptest"
+	"path/filepath"
 	"strings"
 	"testing"
+	"time"
 
 	"codeberg.org/jvllmr/frans/internal/config"
 	"codeberg.org/jvllmr/frans/internal/ent"
+	"codeberg.org/jvllmr/frans/internal/ent/file"
+	"codeberg.org/jvllmr/frans/internal/ent/ticket"
 	"codeberg.org/jvllmr/frans/internal/services"
 	"codeberg.org/jvllmr/frans/internal/testutil"
+	"codeberg.org/jvllmr/frans/internal/util"
 	"github.com/gin-gonic/gin"
 	"github.com/go-playground/form/v4"
+	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 var testFormEncoder *form.Encoder = form.NewEncoder()
@@ -34,11 +46,8 @@ func setupTestTicketRouter(
 	return r
 }
 
-func createTestTicket(
-	t *testing.T,
-	router *gin.Engine,
-	inputModifier func(writer *multipart.Writer) int,
-) *services.PublicTicket {
+// newTestTicketRequest builds a request creating a ticket and returns the expected status
+func newTestTicketRequest(inputModifier func(writer *multipart.Writer) int) (*http.Request, int) {
 	if inputModifier == nil {
 		inputModifier = func(writer *multipart.Writer) int { return http.StatusCreated }
 	}
@@ -85,7 +94,15 @@ func createTestTicket(
 
 	req := httptest.NewRequest(http.MethodPost, "/", &body)
 	req.Header.Set("Content-Type", writer.FormDataContentType())
+	return req, expectedStatus
+}
 
+func createTestTicket(
+	t *testing.T,
+	router *gin.Engine,
+	inputModifier func(writer *multipart.Writer) int,
+) *services.PublicTicket {
+	req, expectedStatus := newTestTicketRequest(inputModifier)
 	w := httptest.NewRecorder()
 	router.ServeHTTP(w, req)
 
@@ -94,7 +111,7 @@ func createTestTicket(
 		return nil
 	}
 	var publicTicket services.PublicTicket
-	if err = json.Unmarshal(w.Body.Bytes(), &publicTicket); err != nil {
+	if err := json.Unmarshal(w.Body.Bytes(), &publicTicket); err != nil {
 		log.Fatalf("unmarshal ticket: %v", err)
 	}
 	return &publicTicket
@@ -210,3 +227,552 @@ func TestDeleteTicketManually(t *testing.T) {
 	assert.Equal(t, http.StatusNotFound, w.Code)
 
 }
+
+func TestDeleteTicketRollback(t *testing.T) {
+	cfg := testutil.SetupTestConfig()
+	cfg.FilesDir = t.TempDir()
+	db := testutil.SetupTestDBClient(t)
+	testOwner := testutil.SetupTestUser(t, db, nil)
+	rOwner := setupTestTicketRouter(cfg, db, testutil.NewTestAuthMiddleware(testOwner))
+	testTicket := createTestTicket(t, rOwner, func(writer *multipart.Writer) int {
+		for name, content := range map[string]string{"a.txt": "First", "b.txt": "Second"} {
+			partWriter, _ := writer.CreateFormFile("files[]", name)
+			io.Copy(partWriter, strings.NewReader(content))
+		}
+		return http.StatusCreated
+	})
+	ts := services.NewTicketService(cfg, db)
+	fs := services.NewFileService(cfg, db)
+	loadTicket := func() *ent.Ticket {
+		return db.Ticket.Query().
+			Where(ticket.ID(testTicket.ID)).
+			WithFiles(func(fq *ent.FileQuery) { fq.WithData() }).
+			OnlyX(t.Context())
+	}
+	blobPaths := make([]string, 0, 2)
+	for _, fileData := range db.FileData.Query().AllX(t.Context()) {
+		blobPaths = append(blobPaths, filepath.Join(cfg.FilesDir, fs.BlobKey(fileData.ID)))
+	}
+
+	// a failure after the files were deleted keeps the whole ticket
+	tx, err := db.Tx(t.Context())
+	require.NoError(t, err)
+	deletedData, err := ts.DeleteTicket(t.Context(), tx, loadTicket())
+	require.NoError(t, err)
+	assert.Len(t, deletedData, 2)
+	require.NoError(t, tx.Rollback())
+	assert.Len(t, loadTicket().Edges.Files, 2)
+	assert.Equal(t, 2, db.FileData.Query().CountX(t.Context()))
+	for _, blobPath := range blobPaths {
+		assert.FileExists(t, blobPath)
+	}
+
+	tx, err = db.Tx(t.Context())
+	require.NoError(t, err)
+	deletedData, err = ts.DeleteTicket(t.Context(), tx, loadTicket())
+	require.NoError(t, err)
+	require.NoError(t, tx.Commit())
+	fs.PurgeFileData(t.Context(), deletedData...)
+	assert.Equal(t, 0, db.File.Query().CountX(t.Context()))
+	assert.Equal(t, 0, db.FileData.Query().CountX(t.Context()))
+	for _, blobPath := range blobPaths {
+		assert.NoFileExists(t, blobPath)
+	}
+}
+
+func TestPatchTicket(t *testing.T) {
+	cfg := testutil.SetupTestConfig()
+	db := testutil.SetupTestDBClient(t)
+	testUser := testutil.SetupTestUser(t, db, nil)
+	testOwner := testutil.SetupTestUser(t, db, nil)
+	rUser := setupTestTicketRouter(cfg, db, testutil.NewTestAuthMiddleware(testUser))
+	rOwner := setupTestTicketRouter(cfg, db, testutil.NewTestAuthMiddleware(testOwner))
+
+	testTicket := createTestTicket(t, rOwner, func(writer *multipart.Writer) int {
+		for _, name := range []string{"a.txt", "b.txt"} {
+			partWriter, _ := writer.CreateFormFile("files[]", name)
+			io.Copy(partWriter, strings.NewReader("Content of "+name))
+		}
+		return http.StatusCreated
+	})
+	pinnedFile := testTicket.Files[0].Id
+	otherFile := testTicket.Files[1].Id
+	db.ShareAccessToken.Create().
+		SetID("patch-token").
+		SetExpiry(time.Now().Add(time.Hour)).
+		SetTicketID(testTicket.ID).
+		ExecX(t.Context())
+
+	patchTicket := func(r *gin.Engine, body string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(
+			http.MethodPatch,
+			"/"+testTicket.ID.String(),
+			strings.NewReader(body),
+		)
+		req.Header.Set("Content-Type", "application/json")
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+		return w
+	}
+
+	w := patchTicket(rUser, `{"comment":"Fixed"}`)
+	assert.Equal(t, http.StatusForbidden, w.Code)
+	w = patchTicket(rOwner, `{"expiryType":"forever"}`)
+	assert.Equal(t, http.StatusUnprocessableEntity, w.Code)
+	w = patchTicket(rOwner, fmt.Sprintf(
+		`{"files":[{"id":"%s","expiryType":"none"}]}`,
+		uuid.New(),
+	))
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	// a custom expiry of a file needs its limits
+	expiryType := db.File.GetX(t.Context(), pinnedFile).ExpiryType
+	w = patchTicket(rOwner, fmt.Sprintf(
+		`{"files":[{"id":"%s","expiryType":"custom"}]}`,
+		pinnedFile,
+	))
+	assert.Equal(t, http.StatusUnprocessableEntity, w.Code)
+	assert.Equal(t, expiryType, db.File.GetX(t.Context(), pinnedFile).ExpiryType)
+
+	w = patchTicket(rOwner, fmt.Sprintf(`{
+		"comment": "Fixed",
+		"password": "def456",
+		"expiryType": "custom",
+		"expiryTotalDays": 3,
+		"expiryDaysSinceLastDownload": 1,
+		"expiryTotalDownloads": 2,
+		"emailOnDownload": [],
+		"files": [{"id": "%s", "expiryType": "none"}],
+		"email": ["test_receiver@vllmr.dev"],
+		"emailPassword": true
+	}`, pinnedFile))
+	assert.Equal(t, http.StatusOK, w.Code)
+	var publicTicket services.PublicTicket
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &publicTicket))
+	assert.Equal(t, "Fixed", *publicTicket.Comment)
+	// the file which never expires keeps the ticket
+	assert.Nil(t, publicTicket.EstimatedExpiry)
+
+	ticketValue := db.Ticket.Query().
+		Where(ticket.ID(testTicket.ID)).
+		WithFiles().
+		WithShareaccesstokens().
+		OnlyX(t.Context())
+	salt, err := hex.DecodeString(ticketValue.Salt)
+	require.NoError(t, err)
+	assert.Equal(t, util.HashPassword("def456", salt), ticketValue.HashedPassword)
+	assert.Empty(t, ticketValue.EmailOnDownload)
+	assert.Empty(t, ticketValue.Edges.Shareaccesstokens)
+	for _, fileValue := range ticketValue.Edges.Files {
+		switch fileValue.ID {
+		case pinnedFile:
+			assert.Equal(t, config.TicketExpiryTypeNone, fileValue.ExpiryType)
+		case otherFile:
+			assert.Equal(t, config.TicketExpiryTypeCustom, fileValue.ExpiryType)
+			assert.Equal(t, uint8(2), fileValue.ExpiryTotalDownloads)
+		}
+	}
+
+	w = patchTicket(rOwner, `{"comment":""}`)
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Nil(t, db.Ticket.GetX(t.Context(), testTicket.ID).Comment)
+	// files keep their expiry if the expiry of the ticket is not changed
+	pinnedFileValue := db.File.GetX(t.Context(), pinnedFile)
+	assert.Equal(t, config.TicketExpiryTypeNone, pinnedFileValue.ExpiryType)
+
+	// the ticket expires with its longest living file
+	w = patchTicket(rOwner, fmt.Sprintf(`{"files": [{
+		"id": "%s",
+		"expiryType": "custom",
+		"expiryTotalDays": 5,
+		"expiryDaysSinceLastDownload": 5,
+		"expiryTotalDownloads": 1
+	}]}`, pinnedFile))
+	assert.Equal(t, http.StatusOK, w.Code)
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &publicTicket))
+	require.NotNil(t, publicTicket.EstimatedExpiry)
+	estimatedExpiry, err := http.ParseTime(*publicTicket.EstimatedExpiry)
+	require.NoError(t, err)
+	assert.WithinDuration(t, time.Now().Add(5*24*time.Hour), estimatedExpiry, time.Minute)
+	ticketValue = db.Ticket.Query().Where(ticket.ID(testTicket.ID)).WithFiles().OnlyX(t.Context())
+	assert.False(t, services.NewTicketService(cfg, db).ShouldDeleteTicket(ticketValue))
+}
+
+func newTestTicketFilesRequest(ticketID uuid.UUID, files map[string]string) *http.Request {
+	var body bytes.Buffer
+	writer := multipart.NewWriter(&body)
+	writer.WriteField("email[]", "test_receiver@vllmr.dev")
+	for name, content := range files {
+		partWriter, _ := writer.CreateFormFile("files[]", name)
+		io.Copy(partWriter, strings.NewReader(content))
+	}
+	writer.Close()
+	req := httptest.NewRequest(http.MethodPost, "/"+ticketID.String()+"/file", &body)
+	req.Header.Set("Content-Type", writer.FormDataContentType())
+	return req
+}
+
+func TestAddTicketFiles(t *testing.T) {
+	cfg := testutil.SetupTestConfig()
+	db := testutil.SetupTestDBClient(t)
+	testUser := testutil.SetupTestUser(t, db, nil)
+	testOwner := testutil.SetupTestUser(t, db, nil)
+	rUser := setupTestTicketRouter(cfg, db, testutil.NewTestAuthMiddleware(testUser))
+	rOwner := setupTestTicketRouter(cfg, db, testutil.NewTestAuthMiddleware(testOwner))
+	testTicket := createTestTicket(t, rOwner, func(writer *multipart.Writer) int {
+		partWriter, _ := writer.CreateFormFile("files[]", "a.txt")
+		io.Copy(partWriter, strings.NewReader("First file"))
+		return http.StatusCreated
+	})
+
+	w := httptest.NewRecorder()
+	rUser.ServeHTTP(w, newTestTicketFilesRequest(testTicket.ID, map[string]string{"b.txt": "b"}))
+	assert.Equal(t, http.StatusForbidden, w.Code)
+
+	w = httptest.NewRecorder()
+	rOwner.ServeHTTP(w, newTestTicketFilesRequest(testTicket.ID, nil))
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+
+	w = httptest.NewRecorder()
+	rOwner.ServeHTTP(w, newTestTicketFilesRequest(testTicket.ID, map[string]string{
+		"forgotten.txt": "Forgotten file",
+	}))
+	require.Equal(t, http.StatusCreated, w.Code)
+	var publicTicket services.PublicTicket
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &publicTicket))
+	require.Len(t, publicTicket.Files, 2)
+	addedFile := db.File.Query().
+		Where(file.Name("forgotten.txt")).
+		WithTicket().
+		OnlyX(t.Context())
+	assert.Equal(t, testTicket.ID, addedFile.Edges.Ticket.ID)
+	ticketValue := db.Ticket.GetX(t.Context(), testTicket.ID)
+	assert.Equal(t, ticketValue.ExpiryType, addedFile.ExpiryType)
+	assert.Equal(t, ticketValue.ExpiryTotalDays, addedFile.ExpiryTotalDays)
+	ownerValue := db.User.GetX(t.Context(), testOwner.ID)
+	assert.Equal(t, int64(len("First file")+len("Forgotten file")), ownerValue.TotalDataSize)
+}
+
+func TestRemoveTicketFile(t *testing.T) {
+	cfg := testutil.SetupTestConfig()
+	cfg.FilesDir = t.TempDir()
+	db := testutil.SetupTestDBClient(t)
+	testUser := testutil.SetupTestUser(t, db, nil)
+	testOwner := testutil.SetupTestUser(t, db, nil)
+	rUser := setupTestTicketRouter(cfg, db, testutil.NewTestAuthMiddleware(testUser))
+	rOwner := setupTestTicketRouter(cfg, db, testutil.NewTestAuthMiddleware(testOwner))
+	testTicket := createTestTicket(t, rOwner, func(writer *multipart.Writer) int {
+		for name, content := range map[string]string{"a.txt": "Keep me", "b.txt": "Remove me"} {
+			partWriter, _ := writer.CreateFormFile("files[]", name)
+			io.Copy(partWriter, strings.NewReader(content))
+		}
+		return http.StatusCreated
+	})
+	var keptFile, removedFile uuid.UUID
+	for _, fileValue := range testTicket.Files {
+		if fileValue.Name == "a.txt" {
+			keptFile = fileValue.Id
+		} else {
+			removedFile = fileValue.Id
+		}
+	}
+	removeFile := func(r *gin.Engine, fileID uuid.UUID) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(
+			http.MethodDelete,
+			fmt.Sprintf("/%s/file/%s?email=test_receiver@vllmr.dev", testTicket.ID, fileID),
+			nil,
+		)
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+		return w
+	}
+
+	assert.Equal(t, http.StatusForbidden, removeFile(rUser, removedFile).Code)
+	assert.Equal(t, http.StatusNotFound, removeFile(rOwner, uuid.New()).Code)
+
+	w := removeFile(rOwner, removedFile)
+	require.Equal(t, http.StatusOK, w.Code)
+	var publicTicket services.PublicTicket
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &publicTicket))
+	require.Len(t, publicTicket.Files, 1)
+	assert.Equal(t, keptFile, publicTicket.Files[0].Id)
+	assert.False(t, db.File.Query().Where(file.ID(removedFile)).ExistX(t.Context()))
+	ownerValue := db.User.GetX(t.Context(), testOwner.ID)
+	assert.Equal(t, int64(len("Keep me")), ownerValue.TotalDataSize)
+
+	assert.Equal(t, http.StatusConflict, removeFile(rOwner, keptFile).Code)
+}
+
+func TestCreateTicketFileTooBig(t *testing.T) {
+	db := testutil.SetupTestDBClient(t)
+	testUser := testutil.SetupTestUser(t, db, nil)
+	testConfig := testutil.SetupTestConfig()
+	testConfig.MaxSizes = 16
+	router := setupTestTicketRouter(testConfig, db, testutil.NewTestAuthMiddleware(testUser))
+
+	ticketInputModifier := func(writer *multipart.Writer) int {
+		partWriter, _ := writer.CreateFormFile("files[]", "test.txt")
+		io.Copy(partWriter, strings.NewReader("This is a test file. Say hello!"))
+
+		return http.StatusBadRequest
+	}
+
+	createTestTicket(t, router, ticketInputModifier)
+	assert.Equal(t, 0, db.Ticket.Query().CountX(t.Context()))
+	assert.Equal(t, 0, db.FileData.Query().CountX(t.Context()))
+}
+
+func TestPreflightTicket(t *testing.T) {
+	db := testutil.SetupTestDBClient(t)
+	testUser := testutil.SetupTestUser(t, db, nil)
+	testConfig := testutil.SetupTestConfig()
+	testConfig.MaxSizes = 1024
+	testConfig.MaxFiles = 2
+	router := setupTestTicketRouter(testConfig, db, testutil.NewTestAuthMiddleware(testUser))
+
+	cases := map[string]struct {
+		body           string
+		expectedStatus int
+	}{
+		"ok": {
+			`{"files":[{"name":"a.txt","size":1024},{"name":"b.txt","size":0}]}`,
+			http.StatusNoContent,
+		},
+		"too big": {`{"files":[{"name":"a.txt","size":1025}]}`, http.StatusBadRequest},
+		"too many": {
+			`{"files":[{"name":"a","size":1},{"name":"b","size":1},{"name":"c","size":1}]}`,
+			http.StatusBadRequest,
+		},
+		"invalid": {`{"files":[{"size":1}]}`, http.StatusUnprocessableEntity},
+	}
+	for name, testCase := range cases {
+		t.Run(name, func(t *testing.T) {
+			req := httptest.NewRequest(
+				http.MethodPost,
+				"/preflight",
+				strings.NewReader(testCase.body),
+			)
+			req.Header.Set("Content-Type", "application/json")
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+			assert.Equal(t, testCase.expectedStatus, w.Code)
+		})
+	}
+}
+
+func TestCreateTicketQuota(t *testing.T) {
+	db := testutil.SetupTestDBClient(t)
+	testUser := testutil.SetupTestUser(t, db, nil)
+	testConfig := testutil.SetupTestConfig()
+	testConfig.QuotaConfig.MaxBytes = 40
+	testConfig.QuotaConfig.MaxActiveTickets = 2
+	router := setupTestTicketRouter(testConfig, db, testutil.NewTestAuthMiddleware(testUser))
+
+	ticketInputModifier := func(content string, expectedStatus int) func(*multipart.Writer) int {
+		return func(writer *multipart.Writer) int {
+			partWriter, _ := writer.CreateFormFile("files[]", "test.txt")
+			io.Copy(partWriter, strings.NewReader(content))
+			return expectedStatus
+		}
+	}
+
+	createTestTicket(t, router, ticketInputModifier("This is a test file. Say hello!", http.StatusCreated))
+	// 31 bytes are stored, another 31 bytes would exceed the quota
+	createTestTicket(
+		t,
+		router,
+		ticketInputModifier("This is a test file. Say hello!", http.StatusForbidden),
+	)
+	createTestTicket(t, router, ticketInputModifier("Hello!", http.StatusCreated))
+	// ticket quota is exhausted
+	createTestTicket(t, router, ticketInputModifier("Hi", http.StatusForbidden))
+	assert.Equal(t, 2, db.Ticket.Query().CountX(t.Context()))
+
+	req := httptest.NewRequest(
+		http.MethodPost,
+		"/preflight",
+		strings.NewReader(`{"files":[{"name":"a.txt","size":1}]}`),
+	)
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+	assert.Equal(t, http.StatusForbidden, w.Code)
+	assert.Contains(t, w.Body.String(), "quota exceeded")
+}
+
+func TestCreateTicketFileTypes(t *testing.T) {
+	db := testutil.SetupTestDBClient(t)
+	testUser := testutil.SetupTestUser(t, db, nil)
+	testConfig := testutil.SetupTestConfig()
+	testConfig.FilesTypes.Deny = []string{".exe", "image/*"}
+	testConfig.FilesTypes.RejectMismatch = true
+	router := setupTestTicketRouter(testConfig, db, testutil.NewTestAuthMiddleware(testUser))
+
+	ticketInputModifier := func(name string, expectedStatus int) func(*multipart.Writer) int {
+		return func(writer *multipart.Writer) int {
+			partWriter, _ := writer.CreateFormFile("files[]", name)
+			io.Copy(partWriter, strings.NewReader("This is a test file. Say hello!"))
+			return expectedStatus
+		}
+	}
+
+	createTestTicket(t, router, ticketInputModifier("setup.exe", http.StatusUnsupportedMediaType))
+	// content is plain text, but the extension claims an image
+	createTestTicket(t, router, ticketInputModifier("photo.png", http.StatusUnsupportedMediaType))
+	createTestTicket(t, router, ticketInputModifier("notes.pdf", http.StatusUnsupportedMediaType))
+	assert.Equal(t, 0, db.Ticket.Query().CountX(t.Context()))
+
+	publicTicket := createTestTicket(t, router, ticketInputModifier("notes.txt", http.StatusCreated))
+	if assert.NotNil(t, publicTicket) && assert.Len(t, publicTicket.Files, 1) {
+		assert.Equal(t, "text/plain; charset=utf-8", *publicTicket.Files[0].MimeType)
+	}
+	fileData := db.FileData.Query().OnlyX(t.Context())
+	assert.Equal(t, "text/plain; charset=utf-8", *fileData.MimeType)
+}
+
+func TestCreateTicketDigests(t *testing.T) {
+	db := testutil.SetupTestDBClient(t)
+	testUser := testutil.SetupTestUser(t, db, nil)
+	testConfig := testutil.SetupTestConfig()
+	router := setupTestTicketRouter(testConfig, db, testutil.NewTestAuthMiddleware(testUser))
+	content := "This is a test file. Say hello!"
+	contentSha256 := sha256.Sum256([]byte(content))
+	contentDigest := "sha-256=:" + base64.StdEncoding.EncodeToString(contentSha256[:]) + ":"
+
+	ticketInputModifier := func(checksum string, expectedStatus int) func(*multipart.Writer) int {
+		return func(writer *multipart.Writer) int {
+			partWriter, _ := writer.CreateFormFile("files[]", "test.txt")
+			io.Copy(partWriter, strings.NewReader(content))
+			writer.WriteField("checksums[]", checksum)
+			return expectedStatus
+		}
+	}
+
+	publicTicket := createTestTicket(
+		t,
+		router,
+		ticketInputModifier(contentDigest, http.StatusCreated),
+	)
+	if assert.NotNil(t, publicTicket) && assert.Len(t, publicTicket.Files, 1) {
+		assert.Equal(t, hex.EncodeToString(contentSha256[:]), *publicTicket.Files[0].Sha256)
+	}
+
+	wrongSha256 := sha256.Sum256([]byte("something else"))
+	req, _ := newTestTicketRequest(ticketInputModifier(
+		"sha-256=:"+base64.StdEncoding.EncodeToString(wrongSha256[:])+":",
+		http.StatusBadRequest,
+	))
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	// the response tells which digests the server computed
+	assert.Contains(t, w.Body.String(), contentDigest)
+	createTestTicket(t, router, ticketInputModifier("md5=:AAAA:", http.StatusBadRequest))
+	createTestTicket(t, router, ticketInputModifier("sha-256=:AAAA:", http.StatusBadRequest))
+	assert.Equal(t, 1, db.Ticket.Query().CountX(t.Context()))
+
+	sendWithBodyDigest := func(header string, tamper bool) int {
+		req, _ := newTestTicketRequest(ticketInputModifier("", http.StatusCreated))
+		body, _ := io.ReadAll(req.Body)
+		bodySha512 := sha512.Sum512(body)
+		if tamper {
+			bodySha512[0]++
+		}
+		req.Body = io.NopCloser(bytes.NewReader(body))
+		req.Header.Set(header, "sha-512=:"+base64.StdEncoding.EncodeToString(bodySha512[:])+":")
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+		return w.Code
+	}
+	assert.Equal(t, http.StatusCreated, sendWithBodyDigest("Content-Digest", false))
+	assert.Equal(t, http.StatusCreated, sendWithBodyDigest("Repr-Digest", false))
+	assert.Equal(t, http.StatusBadRequest, sendWithBodyDigest("Content-Digest", true))
+	assert.Equal(t, http.StatusBadRequest, sendWithBodyDigest("Repr-Digest", true))
+	assert.Equal(t, 3, db.Ticket.Query().CountX(t.Context()))
+}
+
+func TestReshareReceivedFiles(t *testing.T) {
+	cfg := testutil.SetupTestConfig()
+	cfg.FilesDir = t.TempDir()
+	db := testutil.SetupTestDBClient(t)
+	testUser := testutil.SetupTestUser(t, db, nil)
+	testOwner := testutil.SetupTestUser(t, db, nil)
+	rUser := setupTestTicketRouter(cfg, db, testutil.NewTestAuthMiddleware(testUser))
+	rOwner := setupTestTicketRouter(cfg, db, testutil.NewTestAuthMiddleware(testOwner))
+	fs := services.NewFileService(cfg, db)
+
+	content := "Received through a grant"
+	grantFile := testutil.SetupTestFile(t, cfg, db, "a.txt", content, testOwner, "auto", 0, 0, 0)
+	ownFile := testutil.SetupTestFile(t, cfg, db, "b.txt", "Own", testOwner, "auto", 0, 0, 0)
+	grantValue := createTestGrant(t, db, testOwner, nil)
+	db.Grant.UpdateOne(grantValue).AddFiles(grantFile).ExecX(t.Context())
+
+	reshare := func(r *gin.Engine, fileID uuid.UUID) *httptest.ResponseRecorder {
+		body := fmt.Sprintf(
+			`{"files":[%q],"password":"abc123","expiryType":"single","creatorLang":"en"}`,
+			fileID,
+		)
+		req := httptest.NewRequest(http.MethodPost, "/reshare", strings.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+		return w
+	}
+
+	assert.Equal(t, http.StatusNotFound, reshare(rUser, grantFile.ID).Code)
+	assert.Equal(t, http.StatusNotFound, reshare(rOwner, ownFile.ID).Code)
+	assert.Equal(t, http.StatusNotFound, reshare(rOwner, uuid.New()).Code)
+
+	// only files which were found to be clean can be reshared
+	for _, scanStatus := range []string{
+		config.FileScanStatusPending,
+		config.FileScanStatusInfected,
+	} {
+		db.File.UpdateOne(grantFile).SetScanStatus(scanStatus).ExecX(t.Context())
+		assert.Equal(t, http.StatusForbidden, reshare(rOwner, grantFile.ID).Code)
+	}
+	db.File.UpdateOne(grantFile).SetScanStatus(config.FileScanStatusClean).ExecX(t.Context())
+	assert.Equal(t, 0, db.Ticket.Query().CountX(t.Context()))
+
+	// a custom expiry needs its limits
+	req := httptest.NewRequest(http.MethodPost, "/reshare", strings.NewReader(fmt.Sprintf(
+		`{"files":[%q],"password":"abc123","expiryType":"custom","creatorLang":"en"}`,
+		grantFile.ID,
+	)))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+	rOwner.ServeHTTP(w, req)
+	assert.Equal(t, http.StatusUnprocessableEntity, w.Code)
+	assert.Equal(t, 0, db.Ticket.Query().CountX(t.Context()))
+
+	w = reshare(rOwner, grantFile.ID)
+	require.Equal(t, http.StatusCreated, w.Code)
+	var publicTicket services.PublicTicket
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &publicTicket))
+	require.Len(t, publicTicket.Files, 1)
+	assert.Equal(t, "a.txt", publicTicket.Files[0].Name)
+	assert.NotEqual(t, grantFile.ID, publicTicket.Files[0].Id)
+	resharedFile := db.File.Query().
+		Where(file.ID(publicTicket.Files[0].Id)).
+		WithData().
+		WithTicket().
+		OnlyX(t.Context())
+	assert.Equal(t, config.TicketExpiryTypeSingle, resharedFile.ExpiryType)
+	assert.Equal(t, publicTicket.ID, resharedFile.Edges.Ticket.ID)
+	assert.Equal(t, 3, db.File.Query().CountX(t.Context()))
+	assert.Equal(t, 2, db.FileData.Query().CountX(t.Context()))
+
+	// a file of a ticket is no longer received
+	assert.Equal(t, http.StatusNotFound, reshare(rOwner, resharedFile.ID).Code)
+
+	grantFile = db.File.Query().Where(file.ID(grantFile.ID)).WithData().OnlyX(t.Context())
+	require.NoError(t, fs.DeleteSingleFile(t.Context(), grantFile))
+	reader, err := fs.OpenFile(t.Context(), resharedFile)
+	require.NoError(t, err)
+	storedContent, err := io.ReadAll(reader)
+	require.NoError(t, reader.Close())
+	require.NoError(t, err)
+	assert.Equal(t, content, string(storedContent))
+
+	require.NoError(t, fs.DeleteSingleFile(t.Context(), resharedFile))
+	assert.Equal(t, 1, db.FileData.Query().CountX(t.Context()))
+}
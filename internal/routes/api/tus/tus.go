@@ -0,0 +1,258 @@
+package tusRoutes
+
+import (
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"net/http"
+	"path"
+	"strconv"
+	"strings"
+
+	"codeberg.org/jvllmr/frans/internal/config"
+	"codeberg.org/jvllmr/frans/internal/ent"
+	"codeberg.org/jvllmr/frans/internal/middleware"
+	"codeberg.org/jvllmr/frans/internal/otel"
+	"codeberg.org/jvllmr/frans/internal/services"
+	"codeberg.org/jvllmr/frans/internal/util"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// Implementation of the tus resumable upload protocol (https://tus.io/protocols/resumable-upload)
+// with the creation, termination and expiration extensions.
+const (
+	tusVersion       = "1.0.0"
+	tusExtensions    = "creation,termination,expiration"
+	tusContentType   = "application/offset+octet-stream"
+	uploadIDParamKey = "uploadId"
+)
+
+// UploadScope resolves who is uploading. Uploads either belong to a user or to a grant.
+type UploadScope func(c *gin.Context) (*ent.User, *ent.Grant)
+
+type tusController struct {
+	config        config.Config
+	fileService   services.FileService
+	uploadService services.UploadService
+	quotaService  services.QuotaService
+	scope         UploadScope
+}
+
+func tusResumableMiddleware(c *gin.Context) {
+	c.Header("Tus-Resumable", tusVersion)
+	if c.Request.Method != http.MethodOptions &&
+		c.GetHeader("Tus-Resumable") != tusVersion {
+		c.Header("Tus-Version", tusVersion)
+		c.AbortWithStatus(http.StatusPreconditionFailed)
+		return
+	}
+}
+
+// parseUploadMetadata parses the Upload-Metadata header which consists of comma separated
+// key value pairs with base64 encoded values
+func parseUploadMetadata(header string) (map[string]string, error) {
+	metadata := make(map[string]string)
+	if header == "" {
+		return metadata, nil
+	}
+	for pair := range strings.SplitSeq(header, ",") {
+		key, encodedValue, _ := strings.Cut(strings.TrimSpace(pair), " ")
+		value, err := base64.StdEncoding.DecodeString(encodedValue)
+		if err != nil {
+			return nil, fmt.Errorf("invalid upload metadata for key %s: %w", key, err)
+		}
+		metadata[key] = string(value)
+	}
+	return metadata, nil
+}
+
+func (tc *tusController) setUploadHeaders(c *gin.Context, uploadValue *ent.Upload) {
+	c.Header("Upload-Offset", strconv.FormatInt(uploadValue.Offset, 10))
+	c.Header("Upload-Expires", uploadValue.Expiry.UTC().Format(http.TimeFormat))
+}
+
+func (tc *tusController) getUpload(c *gin.Context) (*ent.Upload, bool) {
+	ctx := c.Request.Context()
+	uploadID, err := uuid.Parse(c.Param(uploadIDParamKey))
+	if err != nil {
+		util.GinAbortWithError(ctx, c, http.StatusNotFound, err)
+		return nil, false
+	}
+	owner, grantValue := tc.scope(c)
+	uploadValue, err := tc.uploadService.GetUpload(ctx, uploadID, owner, grantValue)
+	if err != nil {
+		if errors.Is(err, services.ErrUploadNotFound) {
+			util.GinAbortWithError(ctx, c, http.StatusNotFound, err)
+		} else {
+			util.GinAbortWithError(ctx, c, http.StatusInternalServerError, err)
+		}
+		return nil, false
+	}
+	if tc.uploadService.ShouldDeleteUpload(uploadValue) {
+		util.GinAbortWithError(ctx, c, http.StatusGone, fmt.Errorf("upload expired"))
+		return nil, false
+	}
+	return uploadValue, true
+}
+
+func (tc *tusController) optionsHandler(c *gin.Context) {
+	c.Header("Tus-Version", tusVersion)
+	c.Header("Tus-Extension", tusExtensions)
+	c.Header("Tus-Max-Size", strconv.FormatInt(tc.config.MaxSizes, 10))
+	c.Status(http.StatusNoContent)
+}
+
+func (tc *tusController) createUploadHandler(c *gin.Context) {
+	ctx, span := otel.NewSpan(c.Request.Context(), "createUpload")
+	defer span.End()
+	size, err := strconv.ParseInt(c.GetHeader("Upload-Length"), 10, 64)
+	if err != nil || size < 0 {
+		util.GinAbortWithError(ctx, c, http.StatusBadRequest, fmt.Errorf("invalid Upload-Length"))
+		return
+	}
+	if size > tc.config.MaxSizes {
+		util.GinAbortWithError(
+			ctx,
+			c,
+			http.StatusRequestEntityTooLarge,
+			fmt.Errorf("upload of %d bytes exceeds maximum of %d bytes", size, tc.config.MaxSizes),
+		)
+		return
+	}
+	metadata, err := parseUploadMetadata(c.GetHeader("Upload-Metadata"))
+	if err != nil {
+		util.GinAbortWithError(ctx, c, http.StatusBadRequest, err)
+		return
+	}
+	name := metadata["filename"]
+	if name == "" {
+		name = metadata["name"]
+	}
+	if name == "" {
+		util.GinAbortWithError(
+			ctx,
+			c,
+			http.StatusBadRequest,
+			fmt.Errorf("upload metadata has to contain a filename"),
+		)
+		return
+	}
+	if err := tc.fileService.CheckFileType(name, ""); err != nil {
+		util.GinAbortWithPublicError(ctx, c, http.StatusUnsupportedMediaType, err)
+		return
+	}
+
+	owner, grantValue := tc.scope(c)
+	quotaOwner := owner
+	if grantValue != nil {
+		quotaOwner = grantValue.Edges.Owner
+	}
+	if err := tc.quotaService.CheckBytes(ctx, quotaOwner, size); err != nil {
+		if services.IsQuotaExceeded(err) {
+			util.GinAbortWithPublicError(ctx, c, http.StatusForbidden, err)
+		} else {
+			util.GinAbortWithError(ctx, c, http.StatusInternalServerError, err)
+		}
+		return
+	}
+	uploadValue, err := tc.uploadService.CreateUpload(ctx, name, size, owner, grantValue)
+	if err != nil {
+		util.GinAbortWithError(ctx, c, http.StatusInternalServerError, err)
+		return
+	}
+	c.Header("Location", path.Join(c.Request.URL.Path, uploadValue.ID.String()))
+	tc.setUploadHeaders(c, uploadValue)
+	c.Status(http.StatusCreated)
+}
+
+func (tc *tusController) headUploadHandler(c *gin.Context) {
+	_, span := otel.NewSpan(c.Request.Context(), "headUpload")
+	defer span.End()
+	uploadValue, ok := tc.getUpload(c)
+	if !ok {
+		return
+	}
+	tc.setUploadHeaders(c, uploadValue)
+	c.Header("Upload-Length", strconv.FormatInt(uploadValue.Size, 10))
+	c.Header("Cache-Control", "no-store")
+	c.Status(http.StatusOK)
+}
+
+func (tc *tusController) patchUploadHandler(c *gin.Context) {
+	ctx, span := otel.NewSpan(c.Request.Context(), "patchUpload")
+	defer span.End()
+	if c.ContentType() != tusContentType {
+		util.GinAbortWithError(
+			ctx,
+			c,
+			http.StatusUnsupportedMediaType,
+			fmt.Errorf("content type has to be %s", tusContentType),
+		)
+		return
+	}
+	offset, err := strconv.ParseInt(c.GetHeader("Upload-Offset"), 10, 64)
+	if err != nil {
+		util.GinAbortWithError(ctx, c, http.StatusBadRequest, fmt.Errorf("invalid Upload-Offset"))
+		return
+	}
+	uploadValue, ok := tc.getUpload(c)
+	if !ok {
+		return
+	}
+	uploadValue, err = tc.uploadService.WriteUpload(ctx, uploadValue, offset, c.Request.Body)
+	if err != nil {
+		switch {
+		case errors.Is(err, services.ErrUploadOffsetMismatch):
+			util.GinAbortWithError(ctx, c, http.StatusConflict, err)
+		case errors.Is(err, services.ErrUploadExceedsLength):
+			util.GinAbortWithError(ctx, c, http.StatusRequestEntityTooLarge, err)
+		case services.IsInsufficientStorage(err):
+			util.GinAbortWithError(ctx, c, http.StatusInsufficientStorage, err)
+		default:
+			util.GinAbortWithError(ctx, c, http.StatusInternalServerError, err)
+		}
+		return
+	}
+	tc.setUploadHeaders(c, uploadValue)
+	c.Status(http.StatusNoContent)
+}
+
+func (tc *tusController) deleteUploadHandler(c *gin.Context) {
+	ctx, span := otel.NewSpan(c.Request.Context(), "deleteUpload")
+	defer span.End()
+	uploadValue, ok := tc.getUpload(c)
+	if !ok {
+		return
+	}
+	if err := tc.uploadService.DeleteUpload(ctx, uploadValue); err != nil {
+		util.GinAbortWithError(ctx, c, http.StatusInternalServerError, err)
+		return
+	}
+	c.Status(http.StatusNoContent)
+}
+
+func SetupTusRoutes(
+	r *gin.RouterGroup,
+	configValue config.Config,
+	db *ent.Client,
+	scope UploadScope,
+) {
+	controller := tusController{
+		config:        configValue,
+		fileService:   services.NewFileService(configValue, db),
+		uploadService: services.NewUploadService(configValue, db),
+		quotaService:  services.NewQuotaService(configValue, db),
+		scope:         scope,
+	}
+	tusGroup := r.Group("", tusResumableMiddleware)
+	singleUploadPath := fmt.Sprintf("/:%s", uploadIDParamKey)
+	writableStorage := middleware.WritableStorageRequired(services.NewDiskService(configValue))
+
+	tusGroup.OPTIONS("", controller.optionsHandler)
+	tusGroup.POST("", writableStorage, controller.createUploadHandler)
+	tusGroup.OPTIONS(singleUploadPath, controller.optionsHandler)
+	tusGroup.HEAD(singleUploadPath, controller.headUploadHandler)
+	tusGroup.PATCH(singleUploadPath, writableStorage, controller.patchUploadHandler)
+	tusGroup.DELETE(singleUploadPath, controller.deleteUploadHandler)
+}
@@ -0,0 +1,12 @@
+package apiTypes
+
+const (
+	HealthStatusOK       = "ok"
+	HealthStatusReadOnly = "read-only"
+)
+
+// HealthStatus is public, so the disk usage is only reported to administrators
+type HealthStatus struct {
+	Status   string `json:"status"`
+	ReadOnly bool   `json:"readOnly"`
+}
@@ -3,3 +3,24 @@ package apiTypes
 type RequestedTicketParam struct {
 	ID string `uri:"ticketId" binding:"required,uuid"`
 }
+
+type RequestedTicketFileParam struct {
+	TicketID string `uri:"ticketId" binding:"required,uuid"`
+	FileID   string `uri:"fileId"   binding:"required,uuid"`
+}
+
+// TicketFileRemovalQuery names the recipients who are notified about a removed file
+type TicketFileRemovalQuery struct {
+	Email        []string `form:"email"`
+	ReceiverLang string   `form:"receiverLang"`
+}
+
+type TicketArchiveQuery struct {
+	Format string   `form:"format" binding:"omitempty,oneof=zip tar.gz"`
+	Files  []string `form:"files" binding:"omitempty,dive,uuid"`
+}
+
+type RequestedTicketRecipientParam struct {
+	TicketID    string `uri:"ticketId"    binding:"required,uuid"`
+	RecipientID string `uri:"recipientId" binding:"required,uuid"`
+}
@@ -0,0 +1,34 @@
+package apiTypes
+
+type UploadPreflightFile struct {
+	Name string `json:"name" binding:"required"`
+	Size int64  `json:"size" binding:"min=0"`
+}
+
+type UploadPreflightRequest struct {
+	Files []UploadPreflightFile `json:"files" binding:"required,dive"`
+}
+
+func (upr UploadPreflightRequest) Sizes() []int64 {
+	sizes := make([]int64, len(upr.Files))
+	for i, file := range upr.Files {
+		sizes[i] = file.Size
+	}
+	return sizes
+}
+
+func (upr UploadPreflightRequest) Names() []string {
+	names := make([]string, len(upr.Files))
+	for i, file := range upr.Files {
+		names[i] = file.Name
+	}
+	return names
+}
+
+func (upr UploadPreflightRequest) TotalSize() int64 {
+	var size int64
+	for _, file := range upr.Files {
+		size += file.Size
+	}
+	return size
+}
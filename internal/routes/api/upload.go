@@ -0,0 +1,15 @@
+package apiRoutes
+
+import (
+	"codeberg.org/jvllmr/frans/internal/config"
+	"codeberg.org/jvllmr/frans/internal/ent"
+	"codeberg.org/jvllmr/frans/internal/middleware"
+	tusRoutes "codeberg.org/jvllmr/frans/internal/routes/api/tus"
+	"github.com/gin-gonic/gin"
+)
+
+func setupUploadGroup(r *gin.RouterGroup, configValue config.Config, db *ent.Client) {
+	tusRoutes.SetupTusRoutes(r, configValue, db, func(c *gin.Context) (*ent.User, *ent.Grant) {
+		return middleware.GetCurrentUser(c), nil
+	})
+}
@@ -0,0 +1,198 @@
+package apiRoutes
+
+import (
+	"encoding/base64"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"codeberg.org/jvllmr/frans/internal/config"
+	"codeberg.org/jvllmr/frans/internal/ent"
+	"codeberg.org/jvllmr/frans/internal/ent/file"
+	"codeberg.org/jvllmr/frans/internal/services"
+	"codeberg.org/jvllmr/frans/internal/testutil"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func setupTestUploadRouter(
+	testConfig config.Config,
+	db *ent.Client,
+	middlewares ...gin.HandlerFunc,
+) *gin.Engine {
+	r := gin.Default()
+	group := r.Group("/upload", middlewares...)
+	setupUploadGroup(group, testConfig, db)
+
+	return r
+}
+
+func newTestTusRequest(method string, target string, body string) *http.Request {
+	req := httptest.NewRequest(method, target, strings.NewReader(body))
+	req.Header.Set("Tus-Resumable", "1.0.0")
+	return req
+}
+
+func createTestUpload(t *testing.T, r *gin.Engine, size string) string {
+	req := newTestTusRequest(http.MethodPost, "/upload", "")
+	req.Header.Set("Upload-Length", size)
+	req.Header.Set(
+		"Upload-Metadata",
+		"filename "+base64.StdEncoding.EncodeToString([]byte("test.txt")),
+	)
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+	assert.Equal(t, http.StatusCreated, w.Code)
+	return w.Header().Get("Location")
+}
+
+func patchTestUpload(r *gin.Engine, location string, offset string, chunk string) int {
+	req := newTestTusRequest(http.MethodPatch, location, chunk)
+	req.Header.Set("Content-Type", "application/offset+octet-stream")
+	req.Header.Set("Upload-Offset", offset)
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+	return w.Code
+}
+
+func TestResumableUpload(t *testing.T) {
+	db := testutil.SetupTestDBClient(t)
+	testUser := testutil.SetupTestUser(t, db, nil)
+	testConfig := testutil.SetupTestConfig()
+	r := setupTestUploadRouter(testConfig, db, testutil.NewTestAuthMiddleware(testUser))
+
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, httptest.NewRequest(http.MethodOptions, "/upload", nil))
+	assert.Equal(t, http.StatusNoContent, w.Code)
+	assert.Contains(t, w.Header().Get("Tus-Extension"), "creation")
+
+	w = httptest.NewRecorder()
+	r.ServeHTTP(w, httptest.NewRequest(http.MethodPost, "/upload", nil))
+	assert.Equal(t, http.StatusPreconditionFailed, w.Code)
+
+	location := createTestUpload(t, r, "12")
+	assert.True(t, strings.HasPrefix(location, "/upload/"))
+
+	assert.Equal(t, http.StatusNoContent, patchTestUpload(r, location, "0", "Hello "))
+	assert.Equal(t, http.StatusConflict, patchTestUpload(r, location, "0", "Hello "))
+
+	w = httptest.NewRecorder()
+	r.ServeHTTP(w, newTestTusRequest(http.MethodHead, location, ""))
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "6", w.Header().Get("Upload-Offset"))
+	assert.Equal(t, "12", w.Header().Get("Upload-Length"))
+
+	uploadID := strings.TrimPrefix(location, "/upload/")
+	ticketRouter := setupTestTicketRouter(
+		testConfig,
+		db,
+		testutil.NewTestAuthMiddleware(testUser),
+	)
+	createTestTicket(t, ticketRouter, func(writer *multipart.Writer) int {
+		_ = writer.WriteField("uploads[]", uploadID)
+		return http.StatusBadRequest
+	})
+
+	assert.Equal(t, http.StatusNoContent, patchTestUpload(r, location, "6", "there!"))
+
+	newTicket := createTestTicket(t, ticketRouter, func(writer *multipart.Writer) int {
+		_ = writer.WriteField("uploads[]", uploadID)
+		partWriter, _ := writer.CreateFormFile("files[]", "test2.txt")
+		_, _ = partWriter.Write([]byte("General Kenobi!"))
+		return http.StatusCreated
+	})
+	assert.Equal(t, 2, len(newTicket.Files))
+	assert.Equal(t, 0, db.Upload.Query().CountX(t.Context()))
+
+	w = httptest.NewRecorder()
+	r.ServeHTTP(w, newTestTusRequest(http.MethodHead, location, ""))
+	assert.Equal(t, http.StatusNotFound, w.Code)
+}
+
+func TestResumableUploadLimits(t *testing.T) {
+	db := testutil.SetupTestDBClient(t)
+	testUser := testutil.SetupTestUser(t, db, nil)
+	testConfig := testutil.SetupTestConfig()
+	testConfig.MaxSizes = 10
+	r := setupTestUploadRouter(testConfig, db, testutil.NewTestAuthMiddleware(testUser))
+
+	req := newTestTusRequest(http.MethodPost, "/upload", "")
+	req.Header.Set("Upload-Length", "11")
+	req.Header.Set("Upload-Metadata", "filename dGVzdC50eHQ=")
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+	assert.Equal(t, http.StatusRequestEntityTooLarge, w.Code)
+
+	location := createTestUpload(t, r, "5")
+	assert.Equal(
+		t,
+		http.StatusRequestEntityTooLarge,
+		patchTestUpload(r, location, "0", "Hello there!"),
+	)
+
+	otherUser := testutil.SetupTestUser(t, db, nil)
+	rOtherUser := setupTestUploadRouter(
+		testConfig,
+		db,
+		testutil.NewTestAuthMiddleware(otherUser),
+	)
+	w = httptest.NewRecorder()
+	rOtherUser.ServeHTTP(w, newTestTusRequest(http.MethodHead, location, ""))
+	assert.Equal(t, http.StatusNotFound, w.Code)
+
+	w = httptest.NewRecorder()
+	r.ServeHTTP(w, newTestTusRequest(http.MethodDelete, location, ""))
+	assert.Equal(t, http.StatusNoContent, w.Code)
+	assert.Equal(t, 0, db.Upload.Query().CountX(t.Context()))
+}
+
+func TestResumableUploadConcurrentWrite(t *testing.T) {
+	db := testutil.SetupTestDBClient(t)
+	testUser := testutil.SetupTestUser(t, db, nil)
+	testConfig := testutil.SetupTestConfig()
+	testConfig.FilesDir = t.TempDir()
+	r := setupTestUploadRouter(testConfig, db, testutil.NewTestAuthMiddleware(testUser))
+	uploadService := services.NewUploadService(testConfig, db)
+
+	location := createTestUpload(t, r, "12")
+	uploadID := uuid.MustParse(strings.TrimPrefix(location, "/upload/"))
+	// loaded by a request which started before the other write finished
+	staleUpload := db.Upload.GetX(t.Context(), uploadID)
+	assert.Equal(t, http.StatusNoContent, patchTestUpload(r, location, "0", "Hello "))
+
+	_, err := uploadService.WriteUpload(t.Context(), staleUpload, 0, strings.NewReader("Bye   "))
+	assert.ErrorIs(t, err, services.ErrUploadOffsetMismatch)
+	assert.Equal(t, int64(6), db.Upload.GetX(t.Context(), uploadID).Offset)
+	assert.Equal(t, http.StatusNoContent, patchTestUpload(r, location, "6", "there!"))
+
+	ticketRouter := setupTestTicketRouter(
+		testConfig,
+		db,
+		testutil.NewTestAuthMiddleware(testUser),
+	)
+	newTicket := createTestTicket(t, ticketRouter, func(writer *multipart.Writer) int {
+		_ = writer.WriteField("uploads[]", uploadID.String())
+		return http.StatusCreated
+	})
+	require.Len(t, newTicket.Files, 1)
+	fileValue := db.File.Query().
+		Where(file.ID(newTicket.Files[0].Id)).
+		WithData().
+		OnlyX(t.Context())
+	reader, err := services.NewFileService(testConfig, db).OpenFile(t.Context(), fileValue)
+	require.NoError(t, err)
+	defer reader.Close()
+	content, err := io.ReadAll(reader)
+	require.NoError(t, err)
+	assert.Equal(t, "Hello there!", string(content))
+	// the chunks of the upload are removed with it
+	chunks, err := filepath.Glob(filepath.Join(testConfig.FilesDir, "uploads", "*", "*"))
+	require.NoError(t, err)
+	assert.Empty(t, chunks)
+}
@@ -3,6 +3,7 @@ package apiRoutes
 import (
 	"net/http"
 
+	"codeberg.org/jvllmr/frans/internal/config"
 	"codeberg.org/jvllmr/frans/internal/ent"
 	"codeberg.org/jvllmr/frans/internal/middleware"
 	"codeberg.org/jvllmr/frans/internal/otel"
@@ -11,7 +12,8 @@ import (
 )
 
 type userController struct {
-	db *ent.Client
+	db           *ent.Client
+	quotaService services.QuotaService
 }
 
 func (uc *userController) fetchMe(c *gin.Context) {
@@ -21,7 +23,10 @@ func (uc *userController) fetchMe(c *gin.Context) {
 	activeTickets := currentUser.QueryTickets().CountX(ctx)
 	activeGrants := currentUser.QueryGrants().CountX(ctx)
 
-	c.JSON(http.StatusOK, services.ToAdminViewUser(currentUser, activeTickets, activeGrants))
+	c.JSON(http.StatusOK, services.CurrentUser{
+		AdminViewUser: services.ToAdminViewUser(currentUser, activeTickets, activeGrants),
+		Quota:         uc.quotaService.ToPublicQuota(currentUser, activeTickets, activeGrants),
+	})
 }
 
 func (uc *userController) fetchUsers(c *gin.Context) {
@@ -40,8 +45,8 @@ func (uc *userController) fetchUsers(c *gin.Context) {
 	c.JSON(http.StatusOK, publicUsers)
 }
 
-func setupUserGroup(r *gin.RouterGroup, db *ent.Client) {
-	controller := userController{db: db}
+func setupUserGroup(r *gin.RouterGroup, configValue config.Config, db *ent.Client) {
+	controller := userController{db: db, quotaService: services.NewQuotaService(configValue, db)}
 
 	r.GET("/me", controller.fetchMe)
 	r.GET("", middleware.AdminRequired, controller.fetchUsers)
@@ -7,6 +7,7 @@ import (
 	"net/http/httptest"
 	"testing"
 
+	"codeberg.org/jvllmr/frans/internal/config"
 	"codeberg.org/jvllmr/frans/internal/ent"
 	"codeberg.org/jvllmr/frans/internal/services"
 	"codeberg.org/jvllmr/frans/internal/testutil"
@@ -14,10 +15,14 @@ import (
 	"github.com/stretchr/testify/assert"
 )
 
-func setupTestUserRouter(db *ent.Client, middlewares ...gin.HandlerFunc) *gin.Engine {
+func setupTestUserRouter(
+	testConfig config.Config,
+	db *ent.Client,
+	middlewares ...gin.HandlerFunc,
+) *gin.Engine {
 	r := gin.Default()
 	group := r.Group("", middlewares...)
-	setupUserGroup(group, db)
+	setupUserGroup(group, testConfig, db)
 
 	return r
 }
@@ -25,7 +30,11 @@ func setupTestUserRouter(db *ent.Client, middlewares ...gin.HandlerFunc) *gin.En
 func TestFetchMe(t *testing.T) {
 	db := testutil.SetupTestDBClient(t)
 	testUser := testutil.SetupTestUser(t, db, nil)
-	router := setupTestUserRouter(db, testutil.NewTestAuthMiddleware(testUser))
+	router := setupTestUserRouter(
+		testutil.SetupTestConfig(),
+		db,
+		testutil.NewTestAuthMiddleware(testUser),
+	)
 	w := httptest.NewRecorder()
 	req, _ := http.NewRequest("GET", "/me", nil)
 	router.ServeHTTP(w, req)
@@ -40,6 +49,58 @@ func TestFetchMe(t *testing.T) {
 	assert.Equal(t, services.ToAdminViewUser(testUser, 0, 0), meData)
 }
 
+func TestFetchMeQuota(t *testing.T) {
+	db := testutil.SetupTestDBClient(t)
+	testConfig := testutil.SetupTestConfig()
+	testConfig.QuotaConfig = config.QuotaConfig{
+		QuotaLimits: config.QuotaLimits{MaxBytes: 1000, MaxActiveTickets: 5, MaxActiveGrants: 5},
+		QuotaGroups: []config.GroupQuotaConfig{
+			{Group: "small", MaxActiveTickets: new(1)},
+			{Group: "large", MaxBytes: new(int64(5000)), MaxActiveTickets: new(10)},
+			{Group: "unlimited", MaxActiveTickets: new(0)},
+		},
+	}
+	cases := map[string]struct {
+		groups   []string
+		expected services.PublicQuota
+	}{
+		"default": {
+			[]string{},
+			services.PublicQuota{UsedBytes: 300, MaxBytes: 1000, MaxActiveTickets: 5, MaxActiveGrants: 5},
+		},
+		"override": {
+			[]string{"small"},
+			services.PublicQuota{UsedBytes: 300, MaxBytes: 1000, MaxActiveTickets: 1, MaxActiveGrants: 5},
+		},
+		"most generous": {
+			[]string{"small", "large"},
+			services.PublicQuota{UsedBytes: 300, MaxBytes: 5000, MaxActiveTickets: 10, MaxActiveGrants: 5},
+		},
+		"unlimited": {
+			[]string{"large", "unlimited"},
+			services.PublicQuota{UsedBytes: 300, MaxBytes: 5000, MaxActiveTickets: 0, MaxActiveGrants: 5},
+		},
+	}
+	for name, testCase := range cases {
+		t.Run(name, func(t *testing.T) {
+			testUser := testutil.SetupTestUser(t, db, func(uc *ent.UserCreate) *ent.UserCreate {
+				return uc.SetGroups(testCase.groups).SetTotalDataSize(300)
+			})
+			router := setupTestUserRouter(testConfig, db, testutil.NewTestAuthMiddleware(testUser))
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest("GET", "/me", nil)
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, 200, w.Code)
+			var meData services.CurrentUser
+			if err := json.Unmarshal(w.Body.Bytes(), &meData); err != nil {
+				log.Fatalf("Could not unmarshal body: %s %v", w.Body.String(), err)
+			}
+			assert.Equal(t, testCase.expected, meData.Quota)
+		})
+	}
+}
+
 func TestFetchUsers(t *testing.T) {
 	db := testutil.SetupTestDBClient(t)
 	users := []*ent.User{
@@ -51,7 +112,11 @@ func TestFetchUsers(t *testing.T) {
 		usersData[i] = services.ToAdminViewUser(testUser, 0, 0)
 	}
 	for _, testUser := range users {
-		router := setupTestUserRouter(db, testutil.NewTestAuthMiddleware(testUser))
+		router := setupTestUserRouter(
+			testutil.SetupTestConfig(),
+			db,
+			testutil.NewTestAuthMiddleware(testUser),
+		)
 		w := httptest.NewRecorder()
 		req, _ := http.NewRequest("GET", "/", nil)
 		router.ServeHTTP(w, req)
@@ -0,0 +1,131 @@
+package services
+
+import (
+	"context"
+	"net/http"
+	"time"
+	"unicode/utf8"
+
+	"codeberg.org/jvllmr/frans/internal/ent"
+	"codeberg.org/jvllmr/frans/internal/ent/accessevent"
+	"codeberg.org/jvllmr/frans/internal/ent/predicate"
+	"codeberg.org/jvllmr/frans/internal/otel"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// user agents are cut to the size of an indexed string column
+const maxUserAgentLength = 255
+
+// AccessEventService records how shared tickets and grants are accessed
+type AccessEventService struct {
+	db *ent.Client
+}
+
+// AccessEventParams describes an access of the share of either Ticket or Grant
+type AccessEventParams struct {
+	Type      string
+	Ticket    *ent.Ticket
+	Grant     *ent.Grant
+	Recipient *ent.Recipient
+	File      *ent.File
+	// bytes of the response body, only set for downloads
+	BytesServed int64
+}
+
+// truncateUserAgent cuts userAgent to maxUserAgentLength bytes without splitting a character
+func truncateUserAgent(userAgent string) string {
+	if len(userAgent) <= maxUserAgentLength {
+		return userAgent
+	}
+	end := maxUserAgentLength
+	for end > 0 && !utf8.RuneStart(userAgent[end]) {
+		end--
+	}
+	return userAgent[:end]
+}
+
+// Record stores an access event with the address and user agent of the request
+func (aes AccessEventService) Record(c *gin.Context, params AccessEventParams) error {
+	ctx, span := otel.NewSpan(c.Request.Context(), "recordAccessEvent")
+	defer span.End()
+	create := aes.db.AccessEvent.Create().
+		SetType(params.Type).
+		SetAddress(c.ClientIP()).
+		SetUserAgent(truncateUserAgent(c.Request.UserAgent())).
+		SetBytesServed(params.BytesServed)
+	if params.Ticket != nil {
+		create.SetTicket(params.Ticket)
+	}
+	if params.Grant != nil {
+		create.SetGrant(params.Grant)
+	}
+	if params.Recipient != nil {
+		create.SetRecipient(params.Recipient)
+	}
+	if params.File != nil {
+		create.SetFile(params.File).SetFileName(params.File.Name)
+	}
+	return create.Exec(ctx)
+}
+
+// Events returns the access events matching the predicates, the newest first
+func (aes AccessEventService) Events(
+	ctx context.Context,
+	predicates ...predicate.AccessEvent,
+) ([]*ent.AccessEvent, error) {
+	ctx, span := otel.NewSpan(ctx, "fetchAccessEvents")
+	defer span.End()
+	return aes.db.AccessEvent.Query().
+		Where(predicates...).
+		WithRecipient().
+		WithFile().
+		Order(ent.Desc(accessevent.FieldCreatedAt), ent.Desc(accessevent.FieldID)).
+		All(ctx)
+}
+
+// PruneEvents deletes the access events which were recorded before olderThan
+func (aes AccessEventService) PruneEvents(ctx context.Context, olderThan time.Time) (int, error) {
+	ctx, span := otel.NewSpan(ctx, "pruneAccessEvents")
+	defer span.End()
+	return aes.db.AccessEvent.Delete().
+		Where(accessevent.CreatedAtLT(olderThan)).
+		Exec(ctx)
+}
+
+type PublicAccessEvent struct {
+	ID          int        `json:"id"`
+	Type        string     `json:"type"`
+	CreatedAt   string     `json:"createdAt"`
+	Address     string     `json:"address"`
+	UserAgent   string     `json:"userAgent"`
+	BytesServed int64      `json:"bytesServed"`
+	FileID      *uuid.UUID `json:"fileId"`
+	FileName    *string    `json:"fileName"`
+	// name of the recipient who used their personal link
+	Recipient *string `json:"recipient"`
+}
+
+// ToPublicAccessEvent converts an event which was loaded with its file and recipient
+func ToPublicAccessEvent(event *ent.AccessEvent) PublicAccessEvent {
+	publicEvent := PublicAccessEvent{
+		ID:          event.ID,
+		Type:        event.Type,
+		CreatedAt:   event.CreatedAt.UTC().Format(http.TimeFormat),
+		Address:     event.Address,
+		UserAgent:   event.UserAgent,
+		BytesServed: event.BytesServed,
+		FileName:    event.FileName,
+	}
+	if event.Edges.File != nil {
+		publicEvent.FileID = &event.Edges.File.ID
+	}
+	if event.Edges.Recipient != nil {
+		publicEvent.Recipient = &event.Edges.Recipient.Name
+	}
+	return publicEvent
+}
+
+func NewAccessEventService(db *ent.Client) AccessEventService {
+	return AccessEventService{db: db}
+}
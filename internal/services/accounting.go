@@ -0,0 +1,159 @@
+package services
+
+import (
+	"context"
+	"database/sql"
+
+	"codeberg.org/jvllmr/frans/internal/ent"
+	"codeberg.org/jvllmr/frans/internal/ent/filedata"
+	"codeberg.org/jvllmr/frans/internal/ent/user"
+	"codeberg.org/jvllmr/frans/internal/otel"
+	"go.opentelemetry.io/otel/metric"
+)
+
+// StorageTotals compares what users store with what the storage backend holds.
+// Logical bytes count every file on its own, physical bytes count every blob once.
+type StorageTotals struct {
+	LogicalBytes  int64   `json:"logicalBytes"`
+	PhysicalBytes int64   `json:"physicalBytes"`
+	DedupRatio    float64 `json:"dedupRatio"`
+}
+
+// UserStorageUsage holds the bytes of the files of a user.
+// Blobs shared between users count towards the physical bytes of each of them.
+type UserStorageUsage struct {
+	User          PublicUser `json:"user"`
+	LogicalBytes  int64      `json:"logicalBytes"`
+	PhysicalBytes int64      `json:"physicalBytes"`
+}
+
+type StorageUsage struct {
+	StorageTotals
+	Users []UserStorageUsage `json:"users"`
+}
+
+// StorageAccountingService reports the counters maintained by the storage accounting hooks
+type StorageAccountingService struct {
+	db *ent.Client
+}
+
+type aggregateScanner interface {
+	Scan(ctx context.Context, v any) error
+}
+
+// sumField returns the result of a sum aggregation, which is NULL for empty tables
+func sumField(ctx context.Context, query aggregateScanner) (int64, error) {
+	var result []struct {
+		Sum sql.NullInt64 `json:"sum"`
+	}
+	if err := query.Scan(ctx, &result); err != nil {
+		return 0, err
+	}
+	if len(result) == 0 {
+		return 0, nil
+	}
+	return result[0].Sum.Int64, nil
+}
+
+// Totals returns the bytes stored by all users and the bytes of all blobs
+func (sas StorageAccountingService) Totals(ctx context.Context) (StorageTotals, error) {
+	ctx, span := otel.NewSpan(ctx, "storageTotals")
+	defer span.End()
+	logicalBytes, err := sumField(
+		ctx,
+		sas.db.User.Query().Aggregate(ent.Sum(user.FieldTotalDataSize)),
+	)
+	if err != nil {
+		return StorageTotals{}, err
+	}
+	physicalBytes, err := sumField(
+		ctx,
+		sas.db.FileData.Query().Aggregate(ent.Sum(filedata.FieldStoredSize)),
+	)
+	if err != nil {
+		return StorageTotals{}, err
+	}
+	dedupRatio := 1.0
+	if physicalBytes > 0 {
+		dedupRatio = float64(logicalBytes) / float64(physicalBytes)
+	}
+	return StorageTotals{
+		LogicalBytes:  logicalBytes,
+		PhysicalBytes: physicalBytes,
+		DedupRatio:    dedupRatio,
+	}, nil
+}
+
+// Usage returns the totals and the usage of every user
+func (sas StorageAccountingService) Usage(ctx context.Context) (*StorageUsage, error) {
+	ctx, span := otel.NewSpan(ctx, "storageUsage")
+	defer span.End()
+	totals, err := sas.Totals(ctx)
+	if err != nil {
+		return nil, err
+	}
+	users, err := sas.db.User.Query().Order(ent.Asc(user.FieldUsername)).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	usage := &StorageUsage{
+		StorageTotals: totals,
+		Users:         make([]UserStorageUsage, len(users)),
+	}
+	for i, userValue := range users {
+		usage.Users[i] = UserStorageUsage{
+			User:          ToPublicUser(userValue),
+			LogicalBytes:  userValue.TotalDataSize,
+			PhysicalBytes: userValue.PhysicalDataSize,
+		}
+	}
+	return usage, nil
+}
+
+// RegisterMetrics reports the storage totals as OpenTelemetry gauges
+func (sas StorageAccountingService) RegisterMetrics() error {
+	meter := otel.GetFransMeter()
+	logicalGauge, err := meter.Int64ObservableGauge(
+		"frans.storage.logical",
+		metric.WithDescription("Size of all files, counting duplicates"),
+		metric.WithUnit("By"),
+	)
+	if err != nil {
+		return err
+	}
+	physicalGauge, err := meter.Int64ObservableGauge(
+		"frans.storage.physical",
+		metric.WithDescription("Size of all blobs in the storage backend"),
+		metric.WithUnit("By"),
+	)
+	if err != nil {
+		return err
+	}
+	dedupRatioGauge, err := meter.Float64ObservableGauge(
+		"frans.storage.dedup_ratio",
+		metric.WithDescription("Ratio of logical to physical bytes"),
+	)
+	if err != nil {
+		return err
+	}
+	_, err = meter.RegisterCallback(
+		func(ctx context.Context, observer metric.Observer) error {
+			totals, err := sas.Totals(ctx)
+			if err != nil {
+				return err
+			}
+			observer.ObserveInt64(logicalGauge, totals.LogicalBytes)
+			observer.ObserveInt64(physicalGauge, totals.PhysicalBytes)
+			observer.ObserveFloat64(dedupRatioGauge, totals.DedupRatio)
+			return nil
+		},
+		logicalGauge,
+		physicalGauge,
+		dedupRatioGauge,
+	)
+	return err
+}
+
+func NewStorageAccountingService(db *ent.Client) StorageAccountingService {
+	return StorageAccountingService{db: db}
+}
@@ -0,0 +1,170 @@
+package services
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"compress/gzip"
+	"context"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"path"
+	"strings"
+
+	"codeberg.org/jvllmr/frans/internal/ent"
+	"codeberg.org/jvllmr/frans/internal/otel"
+)
+
+const (
+	ArchiveFormatZip   = "zip"
+	ArchiveFormatTarGz = "tar.gz"
+)
+
+// ArchiveContentType returns the media type of an archive format
+func ArchiveContentType(format string) string {
+	if format == ArchiveFormatTarGz {
+		return "application/gzip"
+	}
+	return "application/zip"
+}
+
+// archiveEntryNames returns unique entry names for files, since files of a ticket can share a name.
+// Duplicates get a counter appended like "file (1).txt".
+func archiveEntryNames(files []*ent.File) []string {
+	names := make([]string, len(files))
+	used := make(map[string]bool, len(files))
+	for i, fileValue := range files {
+		name := strings.ReplaceAll(path.Base(fileValue.Name), "\\", "_")
+		if name == "." || name == "/" || name == ".." {
+			name = fileValue.ID.String()
+		}
+		extension := path.Ext(name)
+		base := strings.TrimSuffix(name, extension)
+		for counter := 1; used[name]; counter++ {
+			name = fmt.Sprintf("%s (%d)%s", base, counter, extension)
+		}
+		used[name] = true
+		names[i] = name
+	}
+	return names
+}
+
+// archiveWriter abstracts over the supported archive formats
+type archiveWriter interface {
+	createEntry(name string, fileValue *ent.File) (io.Writer, error)
+	flush() error
+	close() error
+}
+
+type zipArchiveWriter struct {
+	writer *zip.Writer
+}
+
+func (zw *zipArchiveWriter) createEntry(name string, fileValue *ent.File) (io.Writer, error) {
+	// stored files were not compressed by us, so the archive does not compress them either
+	return zw.writer.CreateHeader(&zip.FileHeader{
+		Name:     name,
+		Method:   zip.Store,
+		Modified: fileValue.CreatedAt,
+	})
+}
+
+func (zw *zipArchiveWriter) flush() error {
+	return zw.writer.Flush()
+}
+
+func (zw *zipArchiveWriter) close() error {
+	return zw.writer.Close()
+}
+
+type tarGzArchiveWriter struct {
+	gzipWriter *gzip.Writer
+	tarWriter  *tar.Writer
+}
+
+func (tw *tarGzArchiveWriter) createEntry(name string, fileValue *ent.File) (io.Writer, error) {
+	err := tw.tarWriter.WriteHeader(&tar.Header{
+		Typeflag: tar.TypeReg,
+		Name:     name,
+		Size:     int64(fileValue.Edges.Data.Size),
+		Mode:     0644,
+		ModTime:  fileValue.CreatedAt,
+		Format:   tar.FormatPAX,
+	})
+	return tw.tarWriter, err
+}
+
+func (tw *tarGzArchiveWriter) flush() error {
+	if err := tw.tarWriter.Flush(); err != nil {
+		return err
+	}
+	return tw.gzipWriter.Flush()
+}
+
+func (tw *tarGzArchiveWriter) close() error {
+	if err := tw.tarWriter.Close(); err != nil {
+		return err
+	}
+	return tw.gzipWriter.Close()
+}
+
+func newArchiveWriter(w io.Writer, format string) (archiveWriter, error) {
+	switch format {
+	case ArchiveFormatZip:
+		return &zipArchiveWriter{writer: zip.NewWriter(w)}, nil
+	case ArchiveFormatTarGz:
+		gzipWriter := gzip.NewWriter(w)
+		return &tarGzArchiveWriter{gzipWriter: gzipWriter, tarWriter: tar.NewWriter(gzipWriter)}, nil
+	default:
+		return nil, fmt.Errorf("unknown archive format %s", format)
+	}
+}
+
+// WriteArchive streams the content of files as archive of the given format to w.
+// Nothing is buffered on disk. onFileWritten is called after the content of a file was
+// written and flushed to w completely.
+func (fs FileService) WriteArchive(
+	ctx context.Context,
+	w io.Writer,
+	format string,
+	files []*ent.File,
+	onFileWritten func(fileValue *ent.File),
+) error {
+	ctx, span := otel.NewSpan(ctx, "writeArchive")
+	defer span.End()
+	archive, err := newArchiveWriter(w, format)
+	if err != nil {
+		return err
+	}
+	flusher, _ := w.(http.Flusher)
+	for i, name := range archiveEntryNames(files) {
+		fileValue := files[i]
+		entry, err := archive.createEntry(name, fileValue)
+		if err != nil {
+			return fmt.Errorf("write archive: %w", err)
+		}
+		reader, err := fs.OpenFile(ctx, fileValue)
+		if err != nil {
+			return fmt.Errorf("write archive: %w", err)
+		}
+		_, err = io.Copy(entry, reader)
+		if closeErr := reader.Close(); closeErr != nil {
+			slog.Warn("could not close file reader", "file", fileValue.ID, "err", closeErr)
+		}
+		if err != nil {
+			return fmt.Errorf("write archive: %w", err)
+		}
+		if err := archive.flush(); err != nil {
+			return fmt.Errorf("write archive: %w", err)
+		}
+		if flusher != nil {
+			flusher.Flush()
+		}
+		onFileWritten(fileValue)
+	}
+	if err := archive.close(); err != nil {
+		return fmt.Errorf("write archive: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,633 @@
+package services
+
+import (
+	"archive/tar"
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+	"strings"
+	"time"
+
+	"codeberg.org/jvllmr/frans/internal/config"
+	"codeberg.org/jvllmr/frans/internal/ent"
+	"codeberg.org/jvllmr/frans/internal/otel"
+	"github.com/google/uuid"
+)
+
+// backupFormatVersion is increased whenever older backups can no longer be restored
+const backupFormatVersion = 1
+
+const (
+	backupManifestEntry = "manifest.json"
+	backupBlobsPrefix   = "blobs/"
+)
+
+var ErrNotEmpty = errors.New("database is not empty")
+
+type backupManifest struct {
+	Version   int       `json:"version"`
+	ID        uuid.UUID `json:"id"`
+	CreatedAt time.Time `json:"createdAt"`
+	// ID of the backup this backup is incremental to
+	BaseID *uuid.UUID `json:"baseId"`
+	// blobs contained in this archive. The other blobs are contained in the bases of the backup.
+	Blobs []string `json:"blobs"`
+}
+
+// the eager loaded edges of the entities are hidden by an Edges field of the records,
+// relations are stored as IDs instead
+
+type backupUser struct {
+	*ent.User
+	Edges *struct{} `json:"edges,omitempty"`
+}
+
+type backupFileData struct {
+	*ent.FileData
+	Edges *struct{} `json:"edges,omitempty"`
+}
+
+type backupTicket struct {
+	*ent.Ticket
+	Edges   *struct{}  `json:"edges,omitempty"`
+	OwnerID *uuid.UUID `json:"owner_id"`
+}
+
+type backupGrant struct {
+	*ent.Grant
+	Edges   *struct{}  `json:"edges,omitempty"`
+	OwnerID *uuid.UUID `json:"owner_id"`
+}
+
+type backupFile struct {
+	*ent.File
+	Edges    *struct{}  `json:"edges,omitempty"`
+	OwnerID  uuid.UUID  `json:"owner_id"`
+	DataID   string     `json:"data_id"`
+	TicketID *uuid.UUID `json:"ticket_id"`
+	GrantID  *uuid.UUID `json:"grant_id"`
+}
+
+type backupRecipient struct {
+	*ent.Recipient
+	Edges    *struct{} `json:"edges,omitempty"`
+	TicketID uuid.UUID `json:"ticket_id"`
+	// the hashed token is hidden from the JSON of the entity
+	HashedToken string `json:"hashed_token"`
+}
+
+// backupEntities is the logical export of the database.
+// Sessions, uploads in progress, download progress, access events and previews are not part of it.
+type backupEntities struct {
+	Users      []backupUser
+	FileData   []backupFileData
+	Tickets    []backupTicket
+	Grants     []backupGrant
+	Files      []backupFile
+	Recipients []backupRecipient
+}
+
+// entries returns the archive entries of the entities in the order they are restored
+func (be *backupEntities) entries() []struct {
+	name  string
+	value any
+} {
+	return []struct {
+		name  string
+		value any
+	}{
+		{"users.json", &be.Users},
+		{"filedata.json", &be.FileData},
+		{"tickets.json", &be.Tickets},
+		{"grants.json", &be.Grants},
+		{"files.json", &be.Files},
+		{"recipients.json", &be.Recipients},
+	}
+}
+
+func (be *backupEntities) count() int {
+	return len(be.Users) + len(be.FileData) + len(be.Tickets) + len(be.Grants) + len(be.Files) +
+		len(be.Recipients)
+}
+
+type BackupSummary struct {
+	ID       uuid.UUID
+	Entities int
+	// number of blobs in the archive
+	Blobs int
+	// number of blobs left out, because they are contained in the base backups
+	SkippedBlobs int
+}
+
+type RestoreSummary struct {
+	Entities int
+	Blobs    int
+}
+
+// optionalID returns the ID of an optional edge
+func optionalID[T any](value *T, id func(*T) uuid.UUID) *uuid.UUID {
+	if value == nil {
+		return nil
+	}
+	idValue := id(value)
+	return &idValue
+}
+
+// exportEntities reads all entities which are part of a backup
+func exportEntities(ctx context.Context, tx *ent.Tx) (*backupEntities, error) {
+	entities := &backupEntities{}
+	users, err := tx.User.Query().All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, userValue := range users {
+		entities.Users = append(entities.Users, backupUser{User: userValue})
+	}
+	fileDatas, err := tx.FileData.Query().All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, fileData := range fileDatas {
+		entities.FileData = append(entities.FileData, backupFileData{FileData: fileData})
+	}
+	userID := func(userValue *ent.User) uuid.UUID { return userValue.ID }
+	tickets, err := tx.Ticket.Query().WithOwner().All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, ticketValue := range tickets {
+		entities.Tickets = append(entities.Tickets, backupTicket{
+			Ticket:  ticketValue,
+			OwnerID: optionalID(ticketValue.Edges.Owner, userID),
+		})
+	}
+	grants, err := tx.Grant.Query().WithOwner().All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, grantValue := range grants {
+		entities.Grants = append(entities.Grants, backupGrant{
+			Grant:   grantValue,
+			OwnerID: optionalID(grantValue.Edges.Owner, userID),
+		})
+	}
+	files, err := tx.File.Query().WithOwner().WithData().WithTicket().WithGrant().All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, fileValue := range files {
+		entities.Files = append(entities.Files, backupFile{
+			File:    fileValue,
+			OwnerID: fileValue.Edges.Owner.ID,
+			DataID:  fileValue.Edges.Data.ID,
+			TicketID: optionalID(
+				fileValue.Edges.Ticket,
+				func(ticketValue *ent.Ticket) uuid.UUID { return ticketValue.ID },
+			),
+			GrantID: optionalID(
+				fileValue.Edges.Grant,
+				func(grantValue *ent.Grant) uuid.UUID { return grantValue.ID },
+			),
+		})
+	}
+	recipients, err := tx.Recipient.Query().WithTicket().All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, recipientValue := range recipients {
+		entities.Recipients = append(entities.Recipients, backupRecipient{
+			Recipient:   recipientValue,
+			TicketID:    recipientValue.Edges.Ticket.ID,
+			HashedToken: recipientValue.HashedToken,
+		})
+	}
+	return entities, nil
+}
+
+// importEntities creates all entities of a backup
+func importEntities(ctx context.Context, tx *ent.Tx, entities *backupEntities) error {
+	for _, userValue := range entities.Users {
+		groups := userValue.Groups
+		if groups == nil {
+			groups = []string{}
+		}
+		err := tx.User.Create().
+			SetID(userValue.ID).
+			SetUsername(userValue.Username).
+			SetFullName(userValue.FullName).
+			SetEmail(userValue.Email).
+			SetGroups(groups).
+			SetIsAdmin(userValue.IsAdmin).
+			SetCreatedAt(userValue.CreatedAt).
+			SetSubmittedTickets(userValue.SubmittedTickets).
+			SetSubmittedGrants(userValue.SubmittedGrants).
+			Exec(ctx)
+		if err != nil {
+			return fmt.Errorf("user %s: %w", userValue.ID, err)
+		}
+	}
+	for _, fileData := range entities.FileData {
+		// previews are not part of backups, they are generated again
+		previewStatus := fileData.PreviewStatus
+		if previewStatus == config.FilePreviewStatusReady {
+			previewStatus = config.FilePreviewStatusPending
+		}
+		err := tx.FileData.Create().
+			SetID(fileData.ID).
+			SetSize(fileData.Size).
+			SetNillableSha256(fileData.Sha256).
+			SetNillableKeyID(fileData.KeyID).
+			SetEncryptedKey(fileData.EncryptedKey).
+			SetNillableCompression(fileData.Compression).
+			SetNillableCompressedSize(fileData.CompressedSize).
+			SetNillableMimeType(fileData.MimeType).
+			SetPreviewStatus(previewStatus).
+			Exec(ctx)
+		if err != nil {
+			return fmt.Errorf("file data %s: %w", fileData.ID, err)
+		}
+	}
+	for _, ticketValue := range entities.Tickets {
+		err := tx.Ticket.Create().
+			SetID(ticketValue.ID).
+			SetNillableComment(ticketValue.Comment).
+			SetExpiryType(ticketValue.ExpiryType).
+			SetHashedPassword(ticketValue.HashedPassword).
+			SetSalt(ticketValue.Salt).
+			SetCreatedAt(ticketValue.CreatedAt).
+			SetExpiryTotalDays(ticketValue.ExpiryTotalDays).
+			SetExpiryDaysSinceLastDownload(ticketValue.ExpiryDaysSinceLastDownload).
+			SetExpiryTotalDownloads(ticketValue.ExpiryTotalDownloads).
+			SetEmailOnDownload(ticketValue.EmailOnDownload).
+			SetCreatorLang(ticketValue.CreatorLang).
+			SetViewsCountAsDownloads(ticketValue.ViewsCountAsDownloads).
+			SetNillableOwnerID(ticketValue.OwnerID).
+			Exec(ctx)
+		if err != nil {
+			return fmt.Errorf("ticket %s: %w", ticketValue.ID, err)
+		}
+	}
+	for _, grantValue := range entities.Grants {
+		err := tx.Grant.Create().
+			SetID(grantValue.ID).
+			SetNillableComment(grantValue.Comment).
+			SetExpiryType(grantValue.ExpiryType).
+			SetHashedPassword(grantValue.HashedPassword).
+			SetSalt(grantValue.Salt).
+			SetCreatedAt(grantValue.CreatedAt).
+			SetExpiryTotalDays(grantValue.ExpiryTotalDays).
+			SetExpiryDaysSinceLastUpload(grantValue.ExpiryDaysSinceLastUpload).
+			SetExpiryTotalUploads(grantValue.ExpiryTotalUploads).
+			SetFileExpiryType(grantValue.FileExpiryType).
+			SetFileExpiryTotalDays(grantValue.FileExpiryTotalDays).
+			SetFileExpiryDaysSinceLastDownload(grantValue.FileExpiryDaysSinceLastDownload).
+			SetFileExpiryTotalDownloads(grantValue.FileExpiryTotalDownloads).
+			SetNillableLastUpload(grantValue.LastUpload).
+			SetTimesUploaded(grantValue.TimesUploaded).
+			SetEmailOnUpload(grantValue.EmailOnUpload).
+			SetCreatorLang(grantValue.CreatorLang).
+			SetNillableOwnerID(grantValue.OwnerID).
+			Exec(ctx)
+		if err != nil {
+			return fmt.Errorf("grant %s: %w", grantValue.ID, err)
+		}
+	}
+	for _, fileValue := range entities.Files {
+		err := tx.File.Create().
+			SetID(fileValue.ID).
+			SetName(fileValue.Name).
+			SetCreatedAt(fileValue.CreatedAt).
+			SetNillableLastDownload(fileValue.LastDownload).
+			SetTimesDownloaded(fileValue.TimesDownloaded).
+			SetNillableLastView(fileValue.LastView).
+			SetTimesViewed(fileValue.TimesViewed).
+			SetExpiryType(fileValue.ExpiryType).
+			SetExpiryTotalDays(fileValue.ExpiryTotalDays).
+			SetExpiryDaysSinceLastDownload(fileValue.ExpiryDaysSinceLastDownload).
+			SetExpiryTotalDownloads(fileValue.ExpiryTotalDownloads).
+			SetScanStatus(fileValue.ScanStatus).
+			SetNillableScanSignature(fileValue.ScanSignature).
+			SetNillableMimeType(fileValue.MimeType).
+			SetOwnerID(fileValue.OwnerID).
+			SetDataID(fileValue.DataID).
+			SetNillableTicketID(fileValue.TicketID).
+			SetNillableGrantID(fileValue.GrantID).
+			Exec(ctx)
+		if err != nil {
+			return fmt.Errorf("file %s: %w", fileValue.ID, err)
+		}
+	}
+	for _, recipientValue := range entities.Recipients {
+		err := tx.Recipient.Create().
+			SetID(recipientValue.ID).
+			SetName(recipientValue.Name).
+			SetNillableEmail(recipientValue.Email).
+			SetHashedToken(recipientValue.HashedToken).
+			SetCreatedAt(recipientValue.CreatedAt).
+			SetNillableRevokedAt(recipientValue.RevokedAt).
+			SetNillableLastDownload(recipientValue.LastDownload).
+			SetTimesDownloaded(recipientValue.TimesDownloaded).
+			SetDownloadedFiles(recipientValue.DownloadedFiles).
+			SetTicketID(recipientValue.TicketID).
+			Exec(ctx)
+		if err != nil {
+			return fmt.Errorf("recipient %s: %w", recipientValue.ID, err)
+		}
+	}
+	return nil
+}
+
+// readBackupHead reads the manifest and the entities of the backup at path.
+// The blobs which follow them are not read.
+func readBackupHead(path string) (*backupManifest, *backupEntities, error) {
+	archiveFile, err := os.Open(path)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer func() { _ = archiveFile.Close() }()
+	archive := tar.NewReader(archiveFile)
+	header, err := archive.Next()
+	if err != nil || header.Name != backupManifestEntry {
+		return nil, nil, fmt.Errorf("%s is not a frans backup", path)
+	}
+	manifest := &backupManifest{}
+	if err := json.NewDecoder(archive).Decode(manifest); err != nil {
+		return nil, nil, fmt.Errorf("%s: %w", path, err)
+	}
+	if manifest.Version != backupFormatVersion {
+		return nil, nil, fmt.Errorf("%s: unsupported backup version %d", path, manifest.Version)
+	}
+	entities := &backupEntities{}
+	for {
+		header, err := archive.Next()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, nil, fmt.Errorf("%s: %w", path, err)
+		}
+		if strings.HasPrefix(header.Name, backupBlobsPrefix) {
+			break
+		}
+		for _, entry := range entities.entries() {
+			if entry.name != header.Name {
+				continue
+			}
+			if err := json.NewDecoder(archive).Decode(entry.value); err != nil {
+				return nil, nil, fmt.Errorf("%s: %s: %w", path, header.Name, err)
+			}
+		}
+	}
+	return manifest, entities, nil
+}
+
+func writeBackupEntry(archive *tar.Writer, name string, value any) error {
+	content, err := json.Marshal(value)
+	if err != nil {
+		return err
+	}
+	err = archive.WriteHeader(&tar.Header{
+		Typeflag: tar.TypeReg,
+		Name:     name,
+		Size:     int64(len(content)),
+		Mode:     0600,
+		ModTime:  time.Now(),
+	})
+	if err != nil {
+		return err
+	}
+	_, err = archive.Write(content)
+	return err
+}
+
+// backupTxOptions returns options for a transaction which sees a consistent snapshot
+func backupTxOptions(dbType string) *sql.TxOptions {
+	if dbType == "sqlite3" {
+		// transactions of SQLite always see a snapshot
+		return &sql.TxOptions{}
+	}
+	return &sql.TxOptions{Isolation: sql.LevelRepeatableRead, ReadOnly: true}
+}
+
+// Backup writes all users, tickets, grants and files together with their stored blobs
+// as tar archive to w. If basePath is set, the backup is incremental to the backup at basePath
+// and leaves out the blobs which are contained in it or its bases.
+// Blobs are copied as stored, so encrypted blobs need the same master keys after restoring.
+func (fs FileService) Backup(
+	ctx context.Context,
+	w io.Writer,
+	basePath string,
+) (*BackupSummary, error) {
+	ctx, span := otel.NewSpan(ctx, "backup")
+	defer span.End()
+	manifest := &backupManifest{
+		Version:   backupFormatVersion,
+		ID:        uuid.New(),
+		CreatedAt: time.Now().UTC(),
+		Blobs:     []string{},
+	}
+	baseBlobs := make(map[string]bool)
+	if basePath != "" {
+		baseManifest, baseEntities, err := readBackupHead(basePath)
+		if err != nil {
+			return nil, fmt.Errorf("backup: %w", err)
+		}
+		manifest.BaseID = &baseManifest.ID
+		// a backup can be restored, so all of its FileData have blobs in the backup or its bases
+		for _, fileData := range baseEntities.FileData {
+			baseBlobs[fileData.ID] = true
+		}
+	}
+
+	tx, err := fs.db.BeginTx(ctx, backupTxOptions(fs.config.DBType))
+	if err != nil {
+		return nil, fmt.Errorf("backup: %w", err)
+	}
+	defer func() { _ = tx.Rollback() }()
+	entities, err := exportEntities(ctx, tx)
+	if err != nil {
+		return nil, fmt.Errorf("backup: %w", err)
+	}
+	summary := &BackupSummary{ID: manifest.ID, Entities: entities.count()}
+	for _, fileData := range entities.FileData {
+		if baseBlobs[fileData.ID] {
+			summary.SkippedBlobs++
+			continue
+		}
+		manifest.Blobs = append(manifest.Blobs, fileData.ID)
+	}
+
+	archive := tar.NewWriter(w)
+	if err := writeBackupEntry(archive, backupManifestEntry, manifest); err != nil {
+		return nil, fmt.Errorf("backup: %w", err)
+	}
+	for _, entry := range entities.entries() {
+		if err := writeBackupEntry(archive, entry.name, entry.value); err != nil {
+			return nil, fmt.Errorf("backup: %w", err)
+		}
+	}
+	for _, fileDataID := range manifest.Blobs {
+		if err := fs.backupBlob(ctx, archive, fileDataID); err != nil {
+			return nil, fmt.Errorf("backup: %w", err)
+		}
+		summary.Blobs++
+	}
+	if err := archive.Close(); err != nil {
+		return nil, fmt.Errorf("backup: %w", err)
+	}
+	return summary, nil
+}
+
+// backupBlob copies the blob of the FileData with the given ID into archive
+func (fs FileService) backupBlob(
+	ctx context.Context,
+	archive *tar.Writer,
+	fileDataID string,
+) error {
+	blobInfo, err := fs.locateBlob(ctx, fileDataID)
+	if err != nil {
+		return fmt.Errorf("blob of %s: %w", fileDataID, err)
+	}
+	reader, err := fs.storage.Get(ctx, blobInfo.Key)
+	if err != nil {
+		return fmt.Errorf("blob of %s: %w", fileDataID, err)
+	}
+	defer func() { _ = reader.Close() }()
+	err = archive.WriteHeader(&tar.Header{
+		Typeflag: tar.TypeReg,
+		Name:     backupBlobsPrefix + fileDataID,
+		Size:     blobInfo.Size,
+		Mode:     0600,
+		ModTime:  blobInfo.ModTime,
+	})
+	if err != nil {
+		return err
+	}
+	if _, err := io.Copy(archive, reader); err != nil {
+		return fmt.Errorf("blob of %s: %w", fileDataID, err)
+	}
+	return nil
+}
+
+// restoreBlobs stores the blobs of the backup at path which are still missing.
+// Restored blobs are removed from missingBlobs.
+func (fs FileService) restoreBlobs(
+	ctx context.Context,
+	path string,
+	missingBlobs map[string]bool,
+) (int, error) {
+	archiveFile, err := os.Open(path)
+	if err != nil {
+		return 0, err
+	}
+	defer func() { _ = archiveFile.Close() }()
+	archive := tar.NewReader(archiveFile)
+	restored := 0
+	for {
+		header, err := archive.Next()
+		if errors.Is(err, io.EOF) {
+			return restored, nil
+		}
+		if err != nil {
+			return restored, fmt.Errorf("%s: %w", path, err)
+		}
+		fileDataID, isBlob := strings.CutPrefix(header.Name, backupBlobsPrefix)
+		if !isBlob || !missingBlobs[fileDataID] {
+			continue
+		}
+		blobKey := fs.BlobKey(fileDataID)
+		// blobs which survived in the storage are not written again
+		blobInfo, err := fs.storage.Stat(ctx, blobKey)
+		if err != nil || blobInfo.Size != header.Size {
+			if err := fs.storage.Put(ctx, blobKey, archive, header.Size); err != nil {
+				return restored, fmt.Errorf("%s: blob of %s: %w", path, fileDataID, err)
+			}
+		}
+		slog.DebugContext(ctx, "Restored blob", "key", blobKey)
+		delete(missingBlobs, fileDataID)
+		restored++
+	}
+}
+
+// Restore rebuilds an empty database and its blobs from the backups at archivePaths.
+// The entities are taken from the last backup, the backups before it are its chain of bases
+// starting with a full backup. The database can be of another type than the backed up one.
+func (fs FileService) Restore(ctx context.Context, archivePaths []string) (*RestoreSummary, error) {
+	ctx, span := otel.NewSpan(ctx, "restore")
+	defer span.End()
+	if len(archivePaths) == 0 {
+		return nil, errors.New("restore: no backup given")
+	}
+	var entities *backupEntities
+	var previousManifest *backupManifest
+	for i, path := range archivePaths {
+		manifest, archiveEntities, err := readBackupHead(path)
+		if err != nil {
+			return nil, fmt.Errorf("restore: %w", err)
+		}
+		if previousManifest == nil && manifest.BaseID != nil {
+			return nil, fmt.Errorf(
+				"restore: %s is incremental to backup %s, which has to be given before it",
+				path,
+				manifest.BaseID,
+			)
+		}
+		if previousManifest != nil &&
+			(manifest.BaseID == nil || *manifest.BaseID != previousManifest.ID) {
+			return nil, fmt.Errorf(
+				"restore: %s is not incremental to %s",
+				path,
+				archivePaths[i-1],
+			)
+		}
+		previousManifest = manifest
+		entities = archiveEntities
+	}
+
+	userCount, err := fs.db.User.Query().Count(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("restore: %w", err)
+	}
+	fileDataCount, err := fs.db.FileData.Query().Count(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("restore: %w", err)
+	}
+	if userCount > 0 || fileDataCount > 0 {
+		return nil, fmt.Errorf("restore: %w", ErrNotEmpty)
+	}
+
+	// blobs are restored first, so a failed restore leaves at most orphaned blobs behind
+	missingBlobs := make(map[string]bool, len(entities.FileData))
+	for _, fileData := range entities.FileData {
+		missingBlobs[fileData.ID] = true
+	}
+	summary := &RestoreSummary{Entities: entities.count()}
+	for _, path := range archivePaths {
+		restored, err := fs.restoreBlobs(ctx, path, missingBlobs)
+		summary.Blobs += restored
+		if err != nil {
+			return nil, fmt.Errorf("restore: %w", err)
+		}
+	}
+	if len(missingBlobs) > 0 {
+		return nil, fmt.Errorf("restore: %d blobs are missing in the backups", len(missingBlobs))
+	}
+
+	tx, err := fs.db.Tx(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("restore: %w", err)
+	}
+	if err := importEntities(ctx, tx, entities); err != nil {
+		_ = tx.Rollback()
+		return nil, fmt.Errorf("restore: %w", err)
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("restore: %w", err)
+	}
+	return summary, nil
+}
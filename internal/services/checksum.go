@@ -0,0 +1,157 @@
+package services
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"codeberg.org/jvllmr/frans/internal/ent"
+	"codeberg.org/jvllmr/frans/internal/otel"
+)
+
+const (
+	ChecksumAlgorithmSha256 = "sha256"
+	ChecksumAlgorithmSha512 = "sha512"
+)
+
+// MetalinkContentType is the media type of Metalink documents (RFC 5854)
+const MetalinkContentType = "application/metalink4+xml"
+
+// ChecksumManifestName returns the name sha256sum and sha512sum users expect for a manifest
+func ChecksumManifestName(algorithm string) string {
+	return strings.ToUpper(algorithm) + "SUMS"
+}
+
+// fileDataSha256 returns the sha256 of the content of fileData.
+// FileData stored before sha256 sums were computed is hashed once and updated.
+func (fs FileService) fileDataSha256(ctx context.Context, fileData *ent.FileData) (string, error) {
+	if fileData.Sha256 != nil {
+		return *fileData.Sha256, nil
+	}
+	reader, err := fs.OpenFileData(ctx, fileData)
+	if err != nil {
+		return "", err
+	}
+	defer func() { _ = reader.Close() }()
+	hasher := sha256.New()
+	if _, err := io.Copy(hasher, reader); err != nil {
+		return "", err
+	}
+	sha256sum := hex.EncodeToString(hasher.Sum(nil))
+	if err := fs.db.FileData.UpdateOne(fileData).SetSha256(sha256sum).Exec(ctx); err != nil {
+		return "", err
+	}
+	fileData.Sha256 = &sha256sum
+	return sha256sum, nil
+}
+
+// fileChecksum returns the checksum of the content of fileValue with the given algorithm
+func (fs FileService) fileChecksum(
+	ctx context.Context,
+	fileValue *ent.File,
+	algorithm string,
+) (string, error) {
+	switch algorithm {
+	case ChecksumAlgorithmSha512:
+		return fileValue.Edges.Data.ID, nil
+	case ChecksumAlgorithmSha256:
+		return fs.fileDataSha256(ctx, fileValue.Edges.Data)
+	default:
+		return "", fmt.Errorf("unknown checksum algorithm %s", algorithm)
+	}
+}
+
+// checksumManifestLine formats a line like sha256sum does.
+// Names with backslashes or line breaks are escaped and the line is marked with a backslash.
+func checksumManifestLine(checksum string, name string) string {
+	if !strings.ContainsAny(name, "\\\n\r") {
+		return fmt.Sprintf("%s  %s\n", checksum, name)
+	}
+	escapedName := strings.NewReplacer("\\", "\\\\", "\n", "\\n", "\r", "\\r").Replace(name)
+	return fmt.Sprintf("\\%s  %s\n", checksum, escapedName)
+}
+
+// ChecksumManifest lists the checksums of files in the format of sha256sum and sha512sum.
+// Files are listed under the names they have in archives, so an extracted archive
+// can be checked with "sha256sum -c".
+func (fs FileService) ChecksumManifest(
+	ctx context.Context,
+	algorithm string,
+	files []*ent.File,
+) ([]byte, error) {
+	ctx, span := otel.NewSpan(ctx, "checksumManifest")
+	defer span.End()
+	var manifest bytes.Buffer
+	for i, name := range archiveEntryNames(files) {
+		checksum, err := fs.fileChecksum(ctx, files[i], algorithm)
+		if err != nil {
+			return nil, fmt.Errorf("checksum manifest: %w", err)
+		}
+		manifest.WriteString(checksumManifestLine(checksum, name))
+	}
+	return manifest.Bytes(), nil
+}
+
+type metalinkDocument struct {
+	XMLName   xml.Name       `xml:"urn:ietf:params:xml:ns:metalink metalink"`
+	Generator string         `xml:"generator"`
+	Published string         `xml:"published"`
+	Files     []metalinkFile `xml:"file"`
+}
+
+type metalinkFile struct {
+	Name   string         `xml:"name,attr"`
+	Size   uint64         `xml:"size"`
+	Hashes []metalinkHash `xml:"hash"`
+	URL    string         `xml:"url"`
+}
+
+type metalinkHash struct {
+	// textual name from the IANA hash function registry
+	Type  string `xml:"type,attr"`
+	Value string `xml:",chardata"`
+}
+
+// Metalink builds a Metalink (RFC 5854) document for files, which download managers
+// use to fetch and verify all files in one go. fileURL returns the download URL of a file.
+func (fs FileService) Metalink(
+	ctx context.Context,
+	files []*ent.File,
+	published time.Time,
+	fileURL func(fileValue *ent.File) string,
+) ([]byte, error) {
+	ctx, span := otel.NewSpan(ctx, "metalink")
+	defer span.End()
+	document := metalinkDocument{
+		Generator: "frans",
+		Published: published.UTC().Format(time.RFC3339),
+		Files:     make([]metalinkFile, len(files)),
+	}
+	for i, name := range archiveEntryNames(files) {
+		fileValue := files[i]
+		sha256sum, err := fs.fileDataSha256(ctx, fileValue.Edges.Data)
+		if err != nil {
+			return nil, fmt.Errorf("metalink: %w", err)
+		}
+		document.Files[i] = metalinkFile{
+			Name: name,
+			Size: fileValue.Edges.Data.Size,
+			Hashes: []metalinkHash{
+				{Type: "sha-256", Value: sha256sum},
+				{Type: "sha-512", Value: fileValue.Edges.Data.ID},
+			},
+			URL: fileURL(fileValue),
+		}
+	}
+	content, err := xml.MarshalIndent(document, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("metalink: %w", err)
+	}
+	return append([]byte(xml.Header), content...), nil
+}
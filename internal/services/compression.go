@@ -0,0 +1,215 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	iofs "io/fs"
+	"log/slog"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"codeberg.org/jvllmr/frans/internal/ent"
+	"codeberg.org/jvllmr/frans/internal/otel"
+	"github.com/klauspost/compress/zstd"
+)
+
+const CompressionZstd = "zstd"
+
+// compressedExtensions lists file types whose content is already compressed
+var compressedExtensions = map[string]bool{
+	".7z": true, ".apk": true, ".avif": true, ".br": true, ".bz2": true, ".deb": true,
+	".docx": true, ".epub": true, ".flac": true, ".gif": true, ".gz": true, ".heic": true,
+	".jar": true, ".jpeg": true, ".jpg": true, ".lz": true, ".lz4": true, ".lzma": true,
+	".m4a": true, ".m4v": true, ".mkv": true, ".mov": true, ".mp3": true, ".mp4": true,
+	".odp": true, ".ods": true, ".odt": true, ".ogg": true, ".opus": true, ".pdf": true,
+	".png": true, ".pptx": true, ".rar": true, ".rpm": true, ".tgz": true, ".txz": true,
+	".webm": true, ".webp": true, ".whl": true, ".xlsx": true, ".xz": true, ".zip": true,
+	".zst": true,
+}
+
+// compressedContentTypes lists sniffed media types whose content is already compressed
+var compressedContentTypes = []string{
+	"application/zip",
+	"application/x-gzip",
+	"application/x-rar-compressed",
+	"application/pdf",
+	"application/wasm",
+	"audio/",
+	"font/woff",
+	"image/",
+	"video/",
+}
+
+// isCompressible guesses from the name and the first bytes of a file
+// whether compressing it is worthwhile
+func isCompressible(name string, head []byte) bool {
+	if compressedExtensions[strings.ToLower(filepath.Ext(name))] {
+		return false
+	}
+	contentType := http.DetectContentType(head)
+	for _, compressedContentType := range compressedContentTypes {
+		if strings.HasPrefix(contentType, compressedContentType) {
+			// bitmaps and wave audio are not compressed
+			return contentType == "image/bmp" || contentType == "audio/wave"
+		}
+	}
+	return true
+}
+
+// writeCompressed writes the zstd compressed content of source to a new file at path
+func (fs FileService) writeCompressed(path string, source io.Reader) (int64, error) {
+	compressedFile, err := os.Create(path)
+	if err != nil {
+		return 0, err
+	}
+	defer func() { _ = compressedFile.Close() }()
+	encoder, err := zstd.NewWriter(
+		compressedFile,
+		zstd.WithEncoderLevel(zstd.EncoderLevelFromZstd(fs.config.FilesCompression.Level)),
+	)
+	if err != nil {
+		return 0, err
+	}
+	if _, err := io.Copy(encoder, source); err != nil {
+		encoder.Close()
+		return 0, err
+	}
+	if err := encoder.Close(); err != nil {
+		return 0, err
+	}
+	return compressedFile.Seek(0, io.SeekCurrent)
+}
+
+// compressFile compresses the file at path into a temporary file.
+// An empty path is returned if the file should not be stored compressed.
+func (fs FileService) compressFile(
+	ctx context.Context,
+	path string,
+	name string,
+) (string, int64, error) {
+	_, span := otel.NewSpan(ctx, "compressFile")
+	defer span.End()
+	source, err := os.Open(path)
+	if err != nil {
+		return "", 0, err
+	}
+	defer func() { _ = source.Close() }()
+	sourceInfo, err := source.Stat()
+	if err != nil {
+		return "", 0, err
+	}
+	head := make([]byte, 512)
+	n, err := io.ReadFull(source, head)
+	if err != nil && !errors.Is(err, io.ErrUnexpectedEOF) && !errors.Is(err, io.EOF) {
+		return "", 0, err
+	}
+	if n == 0 || !isCompressible(name, head[:n]) {
+		return "", 0, nil
+	}
+	if _, err := source.Seek(0, io.SeekStart); err != nil {
+		return "", 0, err
+	}
+
+	compressedPath := fs.FilesTmpFilePath()
+	compressedSize, err := fs.writeCompressed(compressedPath, source)
+	if err != nil || compressedSize >= sourceInfo.Size() {
+		if removeErr := os.Remove(compressedPath); removeErr != nil &&
+			!errors.Is(removeErr, iofs.ErrNotExist) {
+			slog.Warn("could not remove compressed file", "path", compressedPath, "err", removeErr)
+		}
+		return "", 0, err
+	}
+	return compressedPath, compressedSize, nil
+}
+
+// decompressingReadSeeker decompresses a zstd stream while reading.
+// Seeking is lazy: the stream is only decompressed up to the requested offset on the next read.
+// Seeking backwards restarts decompression from the beginning.
+type decompressingReadSeeker struct {
+	source  io.ReadSeekCloser
+	decoder *zstd.Decoder
+	size    int64
+	// offset requested by the reader
+	offset int64
+	// offset of the decoder in the decompressed content
+	position int64
+}
+
+func (dr *decompressingReadSeeker) restart() error {
+	if _, err := dr.source.Seek(0, io.SeekStart); err != nil {
+		return err
+	}
+	dr.position = 0
+	if dr.decoder == nil {
+		decoder, err := zstd.NewReader(dr.source, zstd.WithDecoderConcurrency(1))
+		if err != nil {
+			return err
+		}
+		dr.decoder = decoder
+		return nil
+	}
+	return dr.decoder.Reset(dr.source)
+}
+
+func (dr *decompressingReadSeeker) Read(p []byte) (int, error) {
+	if dr.offset >= dr.size {
+		return 0, io.EOF
+	}
+	if dr.decoder == nil || dr.position > dr.offset {
+		if err := dr.restart(); err != nil {
+			return 0, fmt.Errorf("decompress: %w", err)
+		}
+	}
+	if dr.position < dr.offset {
+		skipped, err := io.CopyN(io.Discard, dr.decoder, dr.offset-dr.position)
+		dr.position += skipped
+		if err != nil {
+			return 0, fmt.Errorf("decompress: %w", err)
+		}
+	}
+	n, err := dr.decoder.Read(p)
+	dr.position += int64(n)
+	dr.offset += int64(n)
+	if errors.Is(err, io.EOF) && dr.offset < dr.size {
+		err = io.ErrUnexpectedEOF
+	}
+	return n, err
+}
+
+func (dr *decompressingReadSeeker) Seek(offset int64, whence int) (int64, error) {
+	var newOffset int64
+	switch whence {
+	case io.SeekStart:
+		newOffset = offset
+	case io.SeekCurrent:
+		newOffset = dr.offset + offset
+	case io.SeekEnd:
+		newOffset = dr.size + offset
+	default:
+		return 0, errors.New("seek: invalid whence")
+	}
+	if newOffset < 0 {
+		return 0, errors.New("seek: negative position")
+	}
+	dr.offset = newOffset
+	return dr.offset, nil
+}
+
+func (dr *decompressingReadSeeker) Close() error {
+	if dr.decoder != nil {
+		dr.decoder.Close()
+	}
+	return dr.source.Close()
+}
+
+// storedContentSize returns the size of the content of fileData before it is encrypted
+func storedContentSize(fileData *ent.FileData) int64 {
+	if fileData.CompressedSize != nil {
+		return int64(*fileData.CompressedSize)
+	}
+	return int64(fileData.Size)
+}
@@ -0,0 +1,152 @@
+package services
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"hash"
+	"io"
+	"net/http"
+	"slices"
+	"strings"
+)
+
+// digestAlgorithms maps the supported algorithms of RFC 9530 to the size of their digests
+var digestAlgorithms = map[string]int{
+	"sha-256": sha256.Size,
+	"sha-512": sha512.Size,
+}
+
+// bodyDigestHeaders carry digests of the whole request body.
+// A request is never partial, so its representation and its content are the same bytes.
+var bodyDigestHeaders = []string{"Content-Digest", "Repr-Digest"}
+
+type ErrDigestMismatch struct {
+	subject  string
+	computed Digests
+}
+
+func (e *ErrDigestMismatch) Error() string {
+	return fmt.Sprintf(
+		"%s does not match the digest sent, server computed %s",
+		e.subject,
+		e.computed,
+	)
+}
+
+var _ error = (*ErrDigestMismatch)(nil)
+
+func IsDigestMismatch(err error) bool {
+	var errDigestMismatch *ErrDigestMismatch
+	return errors.As(err, &errDigestMismatch)
+}
+
+// Digests holds digests by algorithm name like in the Content-Digest header
+type Digests map[string][]byte
+
+// ParseDigests parses a digest dictionary like "sha-256=:base64:, sha-512=:base64:".
+// Unsupported algorithms are skipped, but at least one supported digest is required.
+func ParseDigests(value string) (Digests, error) {
+	digests := Digests{}
+	for member := range strings.SplitSeq(value, ",") {
+		// parameters are not defined for digests and therefore ignored
+		member, _, _ = strings.Cut(strings.TrimSpace(member), ";")
+		algorithm, encodedDigest, found := strings.Cut(member, "=")
+		if !found || len(encodedDigest) < 2 ||
+			!strings.HasPrefix(encodedDigest, ":") || !strings.HasSuffix(encodedDigest, ":") {
+			return nil, fmt.Errorf("%w: malformed digest %q", ErrMalformedUpload, member)
+		}
+		size, ok := digestAlgorithms[strings.ToLower(algorithm)]
+		if !ok {
+			continue
+		}
+		digest, err := base64.StdEncoding.DecodeString(encodedDigest[1 : len(encodedDigest)-1])
+		if err != nil || len(digest) != size {
+			return nil, fmt.Errorf("%w: malformed %s digest", ErrMalformedUpload, algorithm)
+		}
+		digests[strings.ToLower(algorithm)] = digest
+	}
+	if len(digests) == 0 {
+		return nil, fmt.Errorf("%w: no supported digest algorithm in %q", ErrMalformedUpload, value)
+	}
+	return digests, nil
+}
+
+// hexDigests builds Digests from the hex encoded sums the server computes for files
+func hexDigests(sha256sum string, sha512sum string) Digests {
+	digests := Digests{}
+	if digest, err := hex.DecodeString(sha256sum); err == nil {
+		digests["sha-256"] = digest
+	}
+	if digest, err := hex.DecodeString(sha512sum); err == nil {
+		digests["sha-512"] = digest
+	}
+	return digests
+}
+
+func (d Digests) String() string {
+	algorithms := make([]string, 0, len(d))
+	for algorithm := range d {
+		algorithms = append(algorithms, algorithm)
+	}
+	slices.Sort(algorithms)
+	members := make([]string, len(algorithms))
+	for i, algorithm := range algorithms {
+		encodedDigest := base64.StdEncoding.EncodeToString(d[algorithm])
+		members[i] = fmt.Sprintf("%s=:%s:", algorithm, encodedDigest)
+	}
+	return strings.Join(members, ", ")
+}
+
+// verify compares every expected digest with the computed one of the same algorithm
+func (d Digests) verify(subject string, computed Digests) error {
+	for algorithm, digest := range d {
+		if !bytes.Equal(digest, computed[algorithm]) {
+			return &ErrDigestMismatch{subject: subject, computed: computed}
+		}
+	}
+	return nil
+}
+
+// parseBodyDigests returns the expected digests of the request body by header
+func parseBodyDigests(header http.Header) (map[string]Digests, error) {
+	expected := make(map[string]Digests)
+	for _, headerName := range bodyDigestHeaders {
+		value := strings.Join(header.Values(headerName), ",")
+		if value == "" {
+			continue
+		}
+		digests, err := ParseDigests(value)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", headerName, err)
+		}
+		expected[headerName] = digests
+	}
+	return expected, nil
+}
+
+// digestReader hashes everything read from a request body
+type digestReader struct {
+	io.ReadCloser
+	sha256 hash.Hash
+	sha512 hash.Hash
+}
+
+func newDigestReader(body io.ReadCloser) *digestReader {
+	return &digestReader{ReadCloser: body, sha256: sha256.New(), sha512: sha512.New()}
+}
+
+func (dr *digestReader) Read(p []byte) (int, error) {
+	n, err := dr.ReadCloser.Read(p)
+	dr.sha256.Write(p[:n])
+	dr.sha512.Write(p[:n])
+	return n, err
+}
+
+func (dr *digestReader) digests() Digests {
+	return Digests{"sha-256": dr.sha256.Sum(nil), "sha-512": dr.sha512.Sum(nil)}
+}
@@ -0,0 +1,188 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"sync"
+	"syscall"
+	"time"
+
+	"codeberg.org/jvllmr/frans/internal/config"
+	"codeberg.org/jvllmr/frans/internal/otel"
+	"codeberg.org/jvllmr/frans/internal/storage"
+	"go.opentelemetry.io/otel/metric"
+)
+
+var ErrInsufficientStorage = errors.New(
+	"insufficient storage: uploads are disabled until disk space was freed",
+)
+
+// IsInsufficientStorage reports whether err was caused by a full disk
+func IsInsufficientStorage(err error) bool {
+	return errors.Is(err, ErrInsufficientStorage) || errors.Is(err, syscall.ENOSPC)
+}
+
+// diskCheckInterval limits how often the volume behind the files directory is measured
+const diskCheckInterval = 10 * time.Second
+
+type DiskStatus struct {
+	TotalBytes    uint64  `json:"totalBytes"`
+	FreeBytes     uint64  `json:"freeBytes"`
+	UsedPercent   float64 `json:"usedPercent"`
+	HighWatermark float64 `json:"highWatermark"`
+	LowWatermark  float64 `json:"lowWatermark"`
+	ReadOnly      bool    `json:"readOnly"`
+}
+
+type diskState struct {
+	mutex     sync.Mutex
+	status    DiskStatus
+	checkedAt time.Time
+}
+
+// the read-only state has to survive between requests, so it is shared per files directory
+var (
+	diskStates      = make(map[string]*diskState)
+	diskStatesMutex sync.Mutex
+)
+
+// DiskService refuses uploads while the volume behind the files directory is almost full
+type DiskService struct {
+	config config.Config
+	state  *diskState
+}
+
+// enabled reports whether the watermarks apply. Other backends only keep temporary files
+// in the files directory, so its volume does not limit how much can be stored.
+func (ds DiskService) enabled() bool {
+	return ds.config.HighWatermark > 0 && ds.config.FilesBackend.Type == config.FilesBackendLocal
+}
+
+// Status returns the usage of the volume behind the files directory.
+// Uploads are disabled once the usage reaches the high watermark
+// and enabled again once it falls below the low watermark.
+func (ds DiskService) Status(ctx context.Context) (DiskStatus, error) {
+	ctx, span := otel.NewSpan(ctx, "diskStatus")
+	defer span.End()
+	ds.state.mutex.Lock()
+	defer ds.state.mutex.Unlock()
+	if time.Since(ds.state.checkedAt) < diskCheckInterval {
+		return ds.state.status, nil
+	}
+	usage, err := storage.GetDiskUsage(ds.config.FilesDir)
+	if err != nil {
+		return ds.state.status, fmt.Errorf("measure disk usage: %w", err)
+	}
+	status := DiskStatus{
+		TotalBytes:    usage.Total,
+		FreeBytes:     usage.Free,
+		UsedPercent:   usage.UsedPercent(),
+		HighWatermark: ds.config.HighWatermark,
+		LowWatermark:  ds.config.LowWatermark,
+		ReadOnly:      ds.state.status.ReadOnly,
+	}
+	switch {
+	case !ds.enabled():
+		status.ReadOnly = false
+	case !status.ReadOnly && status.UsedPercent >= ds.config.HighWatermark:
+		status.ReadOnly = true
+		slog.WarnContext(
+			ctx,
+			"Disk usage reached high watermark, uploads are disabled",
+			"usedPercent",
+			status.UsedPercent,
+			"highWatermark",
+			ds.config.HighWatermark,
+		)
+	case status.ReadOnly && status.UsedPercent < ds.config.LowWatermark:
+		status.ReadOnly = false
+		slog.InfoContext(
+			ctx,
+			"Disk usage fell below low watermark, uploads are enabled again",
+			"usedPercent",
+			status.UsedPercent,
+			"lowWatermark",
+			ds.config.LowWatermark,
+		)
+	}
+	ds.state.status = status
+	ds.state.checkedAt = time.Now()
+	return status, nil
+}
+
+// CheckWritable fails with ErrInsufficientStorage while uploads are disabled.
+// Uploads are not blocked if the disk usage cannot be measured.
+func (ds DiskService) CheckWritable(ctx context.Context) error {
+	if !ds.enabled() {
+		return nil
+	}
+	status, err := ds.Status(ctx)
+	if err != nil {
+		slog.WarnContext(ctx, "Could not check disk watermarks", "err", err)
+	}
+	if status.ReadOnly {
+		return ErrInsufficientStorage
+	}
+	return nil
+}
+
+// RegisterMetrics reports the disk status as OpenTelemetry gauges
+func (ds DiskService) RegisterMetrics() error {
+	meter := otel.GetFransMeter()
+	totalGauge, err := meter.Int64ObservableGauge(
+		"frans.disk.total",
+		metric.WithDescription("Size of the volume behind the files directory"),
+		metric.WithUnit("By"),
+	)
+	if err != nil {
+		return err
+	}
+	freeGauge, err := meter.Int64ObservableGauge(
+		"frans.disk.free",
+		metric.WithDescription("Available space of the volume behind the files directory"),
+		metric.WithUnit("By"),
+	)
+	if err != nil {
+		return err
+	}
+	readOnlyGauge, err := meter.Int64ObservableGauge(
+		"frans.disk.read_only",
+		metric.WithDescription("Whether uploads are disabled because of the disk watermarks"),
+	)
+	if err != nil {
+		return err
+	}
+	_, err = meter.RegisterCallback(
+		func(ctx context.Context, observer metric.Observer) error {
+			status, err := ds.Status(ctx)
+			if err != nil {
+				return err
+			}
+			observer.ObserveInt64(totalGauge, int64(status.TotalBytes))
+			observer.ObserveInt64(freeGauge, int64(status.FreeBytes))
+			readOnly := int64(0)
+			if status.ReadOnly {
+				readOnly = 1
+			}
+			observer.ObserveInt64(readOnlyGauge, readOnly)
+			return nil
+		},
+		totalGauge,
+		freeGauge,
+		readOnlyGauge,
+	)
+	return err
+}
+
+func NewDiskService(cfg config.Config) DiskService {
+	diskStatesMutex.Lock()
+	defer diskStatesMutex.Unlock()
+	state, ok := diskStates[cfg.FilesDir]
+	if !ok {
+		state = &diskState{}
+		diskStates[cfg.FilesDir] = state
+	}
+	return DiskService{config: cfg, state: state}
+}
@@ -0,0 +1,309 @@
+package services
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"slices"
+	"time"
+
+	"codeberg.org/jvllmr/frans/internal/config"
+	"codeberg.org/jvllmr/frans/internal/ent"
+	"codeberg.org/jvllmr/frans/internal/ent/downloadprogress"
+	"codeberg.org/jvllmr/frans/internal/ent/file"
+	"codeberg.org/jvllmr/frans/internal/otel"
+	"github.com/gin-gonic/gin"
+)
+
+// ByteRange is a half-open range [start, end) of delivered bytes
+type ByteRange = [2]int64
+
+// mergeByteRanges sorts ranges and merges overlapping or adjacent ones
+func mergeByteRanges(ranges []ByteRange) []ByteRange {
+	sorted := slices.Clone(ranges)
+	slices.SortFunc(sorted, func(a, b ByteRange) int { return cmp.Compare(a[0], b[0]) })
+	merged := make([]ByteRange, 0, len(sorted))
+	for _, byteRange := range sorted {
+		last := len(merged) - 1
+		if last >= 0 && byteRange[0] <= merged[last][1] {
+			merged[last][1] = max(merged[last][1], byteRange[1])
+			continue
+		}
+		merged = append(merged, byteRange)
+	}
+	return merged
+}
+
+// coversSize reports whether merged ranges contain every byte of a file with the given size
+func coversSize(merged []ByteRange, size int64) bool {
+	return len(merged) > 0 && merged[0][0] == 0 && merged[0][1] >= size
+}
+
+// deliveryResponseWriter remembers the status and whether writing the body failed.
+// It intentionally does not implement io.ReaderFrom, so the content is copied in chunks
+// and every chunk is written before the next one is read.
+type deliveryResponseWriter struct {
+	http.ResponseWriter
+	status   int
+	writeErr error
+}
+
+func (dw *deliveryResponseWriter) WriteHeader(status int) {
+	if dw.status == 0 {
+		dw.status = status
+	}
+	dw.ResponseWriter.WriteHeader(status)
+}
+
+func (dw *deliveryResponseWriter) Write(p []byte) (int, error) {
+	if dw.status == 0 {
+		dw.status = http.StatusOK
+	}
+	n, err := dw.ResponseWriter.Write(p)
+	if err != nil && dw.writeErr == nil {
+		dw.writeErr = err
+	}
+	return n, err
+}
+
+// deliveryTracker records which byte ranges of the content were sent to the client.
+// A chunk counts as delivered once the next chunk is read, because the previous one
+// has been written to the response by then.
+type deliveryTracker struct {
+	reader    io.ReadSeeker
+	offset    int64
+	pending   *ByteRange
+	delivered []ByteRange
+}
+
+func (dt *deliveryTracker) confirmPending() {
+	if dt.pending != nil && dt.pending[1] > dt.pending[0] {
+		dt.delivered = append(dt.delivered, *dt.pending)
+	}
+	dt.pending = nil
+}
+
+func (dt *deliveryTracker) Read(p []byte) (int, error) {
+	dt.confirmPending()
+	n, err := dt.reader.Read(p)
+	dt.pending = &ByteRange{dt.offset, dt.offset + int64(n)}
+	dt.offset += int64(n)
+	return n, err
+}
+
+func (dt *deliveryTracker) Seek(offset int64, whence int) (int64, error) {
+	// content which was read before seeking (e.g. for content sniffing) was not sent
+	dt.pending = nil
+	newOffset, err := dt.reader.Seek(offset, whence)
+	if err != nil {
+		return newOffset, err
+	}
+	dt.offset = newOffset
+	return newOffset, nil
+}
+
+// DownloadService counts downloads once all bytes of a file were delivered.
+// Delivered ranges are collected per download token, so resumed or split downloads
+// of the same token are counted once.
+type DownloadService struct {
+	config config.Config
+	db     *ent.Client
+	fs     FileService
+}
+
+// deliveries for the same token retry when they changed the progress concurrently
+const maxRecordDeliveryAttempts = 5
+
+var errDownloadProgressConflict = errors.New("download progress was changed concurrently")
+
+// ServeDownload serves a file as attachment and records the delivered ranges for token.
+// It reports whether the delivery completed a download.
+func (ds DownloadService) ServeDownload(
+	c *gin.Context,
+	fileValue *ent.File,
+	token string,
+) (bool, error) {
+	delivered, err := ds.fs.ServeFileAttachment(c, fileValue)
+	if err != nil {
+		return false, err
+	}
+	return ds.RecordDelivery(c.Request.Context(), fileValue, token, delivered)
+}
+
+// ServeView serves a file for viewing in the browser and counts the view.
+// If countAsDownload is set, the delivered ranges are recorded for token like for a download.
+// It reports whether the delivery completed a download.
+func (ds DownloadService) ServeView(
+	c *gin.Context,
+	fileValue *ent.File,
+	token string,
+	countAsDownload bool,
+) (bool, error) {
+	delivered, err := ds.fs.ServeFileInline(c, fileValue)
+	if err != nil {
+		return false, err
+	}
+	if err := ds.RecordView(c.Request.Context(), fileValue, delivered); err != nil {
+		return false, err
+	}
+	if !countAsDownload {
+		return false, nil
+	}
+	return ds.RecordDelivery(c.Request.Context(), fileValue, token, delivered)
+}
+
+// RecordView counts a view of a file if the beginning of its content was delivered.
+// Browsers fetch the rest of large files with further range requests, which are no new views.
+func (ds DownloadService) RecordView(
+	ctx context.Context,
+	fileValue *ent.File,
+	delivered []ByteRange,
+) error {
+	startsView := slices.ContainsFunc(delivered, func(byteRange ByteRange) bool {
+		return byteRange[0] == 0
+	})
+	if !startsView {
+		return nil
+	}
+	err := ds.db.File.UpdateOne(fileValue).
+		SetLastView(time.Now()).
+		AddTimesViewed(1).
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("record view: %w", err)
+	}
+	return nil
+}
+
+// RecordDelivery adds delivered byte ranges to the download progress of token.
+// Once the whole file was delivered, the download is counted and the progress is reset.
+// Without a token nothing is stored, the download only counts if delivered covers the file.
+func (ds DownloadService) RecordDelivery(
+	ctx context.Context,
+	fileValue *ent.File,
+	token string,
+	delivered []ByteRange,
+) (bool, error) {
+	ctx, span := otel.NewSpan(ctx, "recordDelivery")
+	defer span.End()
+	if len(delivered) == 0 {
+		return false, nil
+	}
+	if token == "" {
+		if !coversSize(mergeByteRanges(delivered), int64(fileValue.Edges.Data.Size)) {
+			return false, nil
+		}
+		if err := countDownload(ctx, ds.db, fileValue); err != nil {
+			return false, fmt.Errorf("record delivery: %w", err)
+		}
+		return true, nil
+	}
+	for attempt := 1; ; attempt++ {
+		completed, err := ds.mergeDelivery(ctx, fileValue, token, delivered)
+		if errors.Is(err, errDownloadProgressConflict) && attempt < maxRecordDeliveryAttempts {
+			continue
+		}
+		if err != nil {
+			return false, fmt.Errorf("record delivery: %w", err)
+		}
+		return completed, nil
+	}
+}
+
+// mergeDelivery adds delivered to the stored progress of token.
+// The progress is only changed if nobody else changed it since it was read,
+// otherwise errDownloadProgressConflict is returned.
+func (ds DownloadService) mergeDelivery(
+	ctx context.Context,
+	fileValue *ent.File,
+	token string,
+	delivered []ByteRange,
+) (bool, error) {
+	progress, err := ds.db.DownloadProgress.Query().
+		Where(
+			downloadprogress.Token(token),
+			downloadprogress.HasFileWith(file.ID(fileValue.ID)),
+		).
+		Only(ctx)
+	if err != nil && !ent.IsNotFound(err) {
+		return false, err
+	}
+	ranges := delivered
+	if progress != nil {
+		ranges = slices.Concat(delivered, progress.Ranges)
+	}
+	ranges = mergeByteRanges(ranges)
+	completed := coversSize(ranges, int64(fileValue.Edges.Data.Size))
+
+	switch {
+	case progress == nil && !completed:
+		err = ds.db.DownloadProgress.Create().
+			SetToken(token).
+			SetFile(fileValue).
+			SetRanges(ranges).
+			Exec(ctx)
+		if ent.IsConstraintError(err) {
+			// another delivery created the progress in the meantime
+			return false, errDownloadProgressConflict
+		}
+		return false, err
+	case progress != nil && !completed:
+		updated, err := ds.db.DownloadProgress.Update().
+			Where(
+				downloadprogress.ID(progress.ID),
+				downloadprogress.Version(progress.Version),
+			).
+			SetRanges(ranges).
+			AddVersion(1).
+			Save(ctx)
+		if err != nil {
+			return false, err
+		}
+		if updated == 0 {
+			return false, errDownloadProgressConflict
+		}
+		return false, nil
+	case progress != nil:
+		// the progress must not be dropped without counting the download
+		tx, err := ds.db.Tx(ctx)
+		if err != nil {
+			return false, err
+		}
+		defer func() { _ = tx.Rollback() }()
+		deleted, err := tx.DownloadProgress.Delete().
+			Where(
+				downloadprogress.ID(progress.ID),
+				downloadprogress.Version(progress.Version),
+			).
+			Exec(ctx)
+		if err != nil {
+			return false, err
+		}
+		if deleted == 0 {
+			return false, errDownloadProgressConflict
+		}
+		if err := countDownload(ctx, tx.Client(), fileValue); err != nil {
+			return false, err
+		}
+		return true, tx.Commit()
+	}
+
+	if err := countDownload(ctx, ds.db, fileValue); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+func countDownload(ctx context.Context, client *ent.Client, fileValue *ent.File) error {
+	return client.File.UpdateOne(fileValue).
+		SetLastDownload(time.Now()).
+		AddTimesDownloaded(1).
+		Exec(ctx)
+}
+
+func NewDownloadService(cfg config.Config, db *ent.Client) DownloadService {
+	return DownloadService{config: cfg, db: db, fs: NewFileService(cfg, db)}
+}
@@ -2,21 +2,29 @@ package services
 
 import (
 	"context"
+	"crypto/sha256"
 	"crypto/sha512"
 	"encoding/hex"
+	"errors"
 	"fmt"
 	"io"
+	iofs "io/fs"
 	"log/slog"
+	"mime"
 	"mime/multipart"
 	"net/http"
 	"os"
+	"strings"
 	"time"
 
 	"codeberg.org/jvllmr/frans/internal/config"
+	"codeberg.org/jvllmr/frans/internal/encryption"
 	"codeberg.org/jvllmr/frans/internal/ent"
 	"codeberg.org/jvllmr/frans/internal/ent/file"
 	"codeberg.org/jvllmr/frans/internal/ent/filedata"
 	"codeberg.org/jvllmr/frans/internal/otel"
+	"codeberg.org/jvllmr/frans/internal/storage"
+	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
 )
 
@@ -26,6 +34,12 @@ type ErrFileTooBig struct {
 }
 
 func (e *ErrFileTooBig) Error() string {
+	if e.size < 0 {
+		return fmt.Sprintf(
+			"file too big: only %d bytes are allowed",
+			e.maxSize,
+		)
+	}
 	return fmt.Sprintf(
 		"file too big: tried to create file with %d bytes in size, but only %d bytes are allowed",
 		e.size,
@@ -36,8 +50,10 @@ func (e *ErrFileTooBig) Error() string {
 var _ error = (*ErrFileTooBig)(nil)
 
 type FileService struct {
-	config config.Config
-	db     *ent.Client
+	config  config.Config
+	db      *ent.Client
+	storage storage.Backend
+	keyring *encryption.Keyring
 }
 
 func (fs FileService) EnsureFilesTmpPath() {
@@ -55,10 +71,6 @@ func (fs FileService) FilesTmpFilePath() string {
 	return fmt.Sprintf("%s/%s", fs.FilesTmpPath(), uuid.New())
 }
 
-func (fs FileService) FilesFilePath(fileName string) string {
-	return fmt.Sprintf("%s/%s", fs.config.FilesDir, fileName)
-}
-
 func (fs FileService) ShouldDeleteFile(
 	f *ent.File,
 ) bool {
@@ -102,61 +114,187 @@ func (fs FileService) CreateFile(
 	if err != nil {
 		return nil, fmt.Errorf("create file: %w", err)
 	}
-	hasher := sha512.New()
-	tmpFilePath := fs.FilesTmpFilePath()
-	tmpFileHandle, err := os.Create(tmpFilePath)
+	defer func() { _ = incomingFileHandle.Close() }()
+	stagedFile, err := fs.StageFile(ctx, fileHeader.Filename, incomingFileHandle)
 	if err != nil {
 		return nil, fmt.Errorf("create file: %w", err)
 	}
+	defer fs.RemoveStagedFile(stagedFile)
+
+	return fs.CreateFileFromStaged(
+		ctx,
+		tx,
+		stagedFile,
+		user,
+		expiryType,
+		expiryDaysSinceLastDownload,
+		expiryTotalDays,
+		expiryTotalDownloads,
+	)
+}
+
+// CreateFileFromUpload creates a file with the content of a finished resumable upload.
+// The upload is consumed in the process.
+func (fs FileService) CreateFileFromUpload(
+	ctx context.Context,
+	tx *ent.Tx,
+	uploadValue *ent.Upload,
+	user *ent.User,
+	expiryType string,
+	expiryDaysSinceLastDownload uint8,
+	expiryTotalDays uint8,
+	expiryTotalDownloads uint8,
+) (*ent.File, error) {
+	ctx, span := otel.NewSpan(ctx, "createFileFromUpload")
+	defer span.End()
+	if uploadValue.Offset != uploadValue.Size {
+		return nil, ErrUploadIncomplete
+	}
+	if uploadValue.Size > fs.config.MaxSizes {
+		return nil, &ErrFileTooBig{
+			size:    uploadValue.Size,
+			maxSize: fs.config.MaxSizes,
+		}
+	}
+
 	defer func() {
-		if err := tmpFileHandle.Close(); err != nil {
-			slog.Warn("could not close temporary file", "path", tmpFilePath, "err", err)
+		if err := fs.deleteUploadChunks(ctx, uploadValue.ID); err != nil {
+			slog.Warn("could not remove upload chunks", "upload", uploadValue.ID, "err", err)
 		}
-		if err := os.Remove(tmpFilePath); err != nil {
-			slog.Warn("could not remove temporary file", "path", tmpFilePath, "err", err)
+	}()
+	fs.EnsureFilesTmpPath()
+	uploadFilePath := fs.FilesTmpFilePath()
+	defer func() {
+		if err := os.Remove(uploadFilePath); err != nil && !errors.Is(err, iofs.ErrNotExist) {
+			slog.Warn("could not remove upload file", "path", uploadFilePath, "err", err)
 		}
 	}()
-	writer := io.MultiWriter(hasher, tmpFileHandle)
-	_, err = io.Copy(writer, incomingFileHandle)
+	uploadFileHandle, err := os.Create(uploadFilePath)
 	if err != nil {
-		return nil, fmt.Errorf("create file: %w", err)
+		return nil, fmt.Errorf("create file from upload: %w", err)
+	}
+	hasher := sha512.New()
+	sha256Hasher := sha256.New()
+	err = fs.copyUpload(ctx, uploadValue, io.MultiWriter(uploadFileHandle, hasher, sha256Hasher))
+	if closeErr := uploadFileHandle.Close(); err == nil {
+		err = closeErr
 	}
+	if err != nil {
+		return nil, fmt.Errorf("create file from upload: %w", err)
+	}
+	sha512sum := hex.EncodeToString(hasher.Sum(nil))
+	sha256sum := hex.EncodeToString(sha256Hasher.Sum(nil))
 
-	hash := hasher.Sum(nil)
-	sha512sum := hex.EncodeToString(hash)
+	dbFile, err := fs.createFileFromTmpFile(
+		ctx,
+		tx,
+		uploadFilePath,
+		sha512sum,
+		sha256sum,
+		uploadValue.Name,
+		uploadValue.Size,
+		user,
+		expiryType,
+		expiryDaysSinceLastDownload,
+		expiryTotalDays,
+		expiryTotalDownloads,
+	)
+	if err != nil {
+		return nil, err
+	}
+	if err := tx.Upload.DeleteOneID(uploadValue.ID).Exec(ctx); err != nil {
+		return nil, fmt.Errorf("create file from upload: %w", err)
+	}
+	return dbFile, nil
+}
 
+// createFileFromTmpFile creates the database entries for a file whose content was already
+// written to tmpFilePath. The content is only stored if no FileData with the same hash exists.
+func (fs FileService) createFileFromTmpFile(
+	ctx context.Context,
+	tx *ent.Tx,
+	tmpFilePath string,
+	sha512sum string,
+	sha256sum string,
+	name string,
+	size int64,
+	user *ent.User,
+	expiryType string,
+	expiryDaysSinceLastDownload uint8,
+	expiryTotalDays uint8,
+	expiryTotalDownloads uint8,
+) (*ent.File, error) {
+	detectedType, err := fs.detectFileType(tmpFilePath, name)
+	if err != nil {
+		return nil, err
+	}
 	fileData, err := tx.FileData.Get(ctx, sha512sum)
+	fileDataCreated := false
 	if err != nil {
 		if !ent.IsNotFound(err) {
 			return nil, err
 		}
-		fileData, err = tx.FileData.Create().
+		fileDataCreate := tx.FileData.Create().
 			SetID(sha512sum).
-			SetSize(uint64(fileHeader.Size)).
-			Save(ctx)
+			SetSize(uint64(size)).
+			SetSha256(sha256sum).
+			SetMimeType(detectedType.String())
+		if fs.keyring.Enabled() {
+			keyID, wrappedKey, err := fs.keyring.NewDataKey(sha512sum)
+			if err != nil {
+				return nil, fmt.Errorf("create file: %w", err)
+			}
+			fileDataCreate.SetKeyID(keyID).SetEncryptedKey(wrappedKey)
+		}
+		fileData, err = fileDataCreate.Save(ctx)
+		if err != nil {
+			return nil, err
+		}
+		fileDataCreated = true
+	} else if fileData.MimeType == nil || fileData.Sha256 == nil {
+		// fill in what was not known when the FileData was created
+		fileDataUpdate := tx.FileData.UpdateOne(fileData)
+		if fileData.MimeType == nil {
+			fileDataUpdate.SetMimeType(detectedType.String())
+		}
+		if fileData.Sha256 == nil {
+			fileDataUpdate.SetSha256(sha256sum)
+		}
+		fileData, err = fileDataUpdate.Save(ctx)
 		if err != nil {
 			return nil, err
 		}
 	}
-	if err != nil {
-		return nil, err
+	scanStatus := config.FileScanStatusClean
+	if fs.config.FilesAntivirus.Enabled {
+		scanStatus = config.FileScanStatusPending
 	}
 	dbFile, err := tx.File.Create().
 		SetID(uuid.New()).
-		SetName(fileHeader.Filename).
+		SetName(name).
 		SetExpiryType(expiryType).
 		SetExpiryDaysSinceLastDownload(expiryDaysSinceLastDownload).
 		SetExpiryTotalDays(expiryTotalDays).
 		SetExpiryTotalDownloads(expiryTotalDownloads).
+		SetScanStatus(scanStatus).
+		SetMimeType(servedMimeType(name, detectedType)).
 		SetData(fileData).
 		SetOwner(user).
 		Save(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("create file: %w", err)
 	}
-	targetFilePath := fs.FilesFilePath(sha512sum)
-	if _, err = os.Stat(targetFilePath); err != nil {
-		if err = os.Rename(tmpFilePath, targetFilePath); err != nil {
+	shouldStoreBlob := fileDataCreated
+	if !fileDataCreated {
+		if _, err = fs.locateBlob(ctx, sha512sum); err != nil {
+			if !errors.Is(err, storage.ErrBlobNotFound) {
+				return nil, fmt.Errorf("create file: %w", err)
+			}
+			shouldStoreBlob = true
+		}
+	}
+	if shouldStoreBlob {
+		if err = fs.storeBlob(ctx, tx, fileData, tmpFilePath, name); err != nil {
 			return nil, fmt.Errorf("create file: %w", err)
 		}
 	}
@@ -164,34 +302,320 @@ func (fs FileService) CreateFile(
 	return dbFile, nil
 }
 
-func (fs FileService) DeleteFile(ctx context.Context, fileValue *ent.File) error {
+// ReferenceFile creates a file of user which shares the stored content of source.
+// source has to be loaded with its data. Nothing is written to the storage,
+// the blob is kept until the last file referencing it is deleted.
+func (fs FileService) ReferenceFile(
+	ctx context.Context,
+	tx *ent.Tx,
+	source *ent.File,
+	user *ent.User,
+	expiryType string,
+	expiryDaysSinceLastDownload uint8,
+	expiryTotalDays uint8,
+	expiryTotalDownloads uint8,
+) (*ent.File, error) {
+	ctx, span := otel.NewSpan(ctx, "referenceFile")
+	defer span.End()
+	dbFile, err := tx.File.Create().
+		SetID(uuid.New()).
+		SetName(source.Name).
+		SetExpiryType(expiryType).
+		SetExpiryDaysSinceLastDownload(expiryDaysSinceLastDownload).
+		SetExpiryTotalDays(expiryTotalDays).
+		SetExpiryTotalDownloads(expiryTotalDownloads).
+		SetScanStatus(source.ScanStatus).
+		SetNillableScanSignature(source.ScanSignature).
+		SetNillableMimeType(source.MimeType).
+		SetData(source.Edges.Data).
+		SetOwner(user).
+		Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("reference file: %w", err)
+	}
+	return dbFile, nil
+}
+
+// storeBlob writes the local file at path as blob of fileData.
+// The content is compressed if enabled and worthwhile and
+// encrypted if the FileData has a data key.
+func (fs FileService) storeBlob(
+	ctx context.Context,
+	tx *ent.Tx,
+	fileData *ent.FileData,
+	path string,
+	name string,
+) error {
+	blobKey := fs.BlobKey(fileData.ID)
+	if fs.config.FilesCompression.Enabled || fileData.Compression != nil {
+		compressedPath, compressedSize, err := fs.compressFile(ctx, path, name)
+		if err != nil {
+			return err
+		}
+		fileDataUpdate := tx.FileData.UpdateOne(fileData)
+		if compressedPath == "" {
+			fileDataUpdate.ClearCompression().ClearCompressedSize()
+		} else {
+			defer func() {
+				if err := os.Remove(compressedPath); err != nil && !errors.Is(err, iofs.ErrNotExist) {
+					slog.Warn("could not remove compressed file", "path", compressedPath, "err", err)
+				}
+			}()
+			fileDataUpdate.SetCompression(CompressionZstd).SetCompressedSize(uint64(compressedSize))
+			path = compressedPath
+		}
+		if fileData, err = fileDataUpdate.Save(ctx); err != nil {
+			return err
+		}
+	}
+	if fileData.KeyID == nil {
+		return storage.PutFile(ctx, fs.storage, blobKey, path)
+	}
+	dataKey, err := fs.keyring.UnwrapKey(*fileData.KeyID, fileData.ID, fileData.EncryptedKey)
+	if err != nil {
+		return err
+	}
+	fileHandle, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = fileHandle.Close() }()
+	size := storedContentSize(fileData)
+	reader, err := encryption.NewEncryptingReader(fileHandle, dataKey, size)
+	if err != nil {
+		return err
+	}
+	return fs.storage.Put(ctx, blobKey, reader, encryption.EncryptedSize(size))
+}
+
+// DeleteFile deletes a file in tx. The FileData of the file is deleted together with the last
+// file referencing it and returned, so its stored content can be removed with PurgeFileData
+// once tx was committed.
+func (fs FileService) DeleteFile(
+	ctx context.Context,
+	tx *ent.Tx,
+	fileValue *ent.File,
+) (*ent.FileData, error) {
 	ctx, span := otel.NewSpan(ctx, "DeleteFile")
 	defer span.End()
-	fileDataFilesCount, err := fs.db.FileData.Query().
+	fileDataFilesCount, err := tx.FileData.Query().
 		Where(filedata.HasFilesWith(file.ID(fileValue.ID))).
 		QueryFiles().
 		Count(ctx)
+	if err != nil {
+		return nil, err
+	}
+	err = tx.File.DeleteOne(fileValue).Exec(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if fileDataFilesCount > 1 {
+		return nil, nil
+	}
+	err = tx.FileData.DeleteOne(fileValue.Edges.Data).Exec(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return fileValue.Edges.Data, nil
+}
+
+// PurgeFileData removes the blobs and previews of FileData which was deleted by DeleteFile.
+// Missing blobs count as deleted and content which was uploaded again in the meantime is kept.
+// Failures are only logged, the garbage collection removes the remaining blobs later on.
+func (fs FileService) PurgeFileData(ctx context.Context, fileDatas ...*ent.FileData) {
+	ctx, span := otel.NewSpan(ctx, "purgeFileData")
+	defer span.End()
+	for _, fileData := range fileDatas {
+		if fileData == nil {
+			continue
+		}
+		if err := fs.purgeFileData(ctx, fileData); err != nil {
+			slog.WarnContext(ctx, "Could not purge file data", "fileData", fileData.ID, "err", err)
+		}
+	}
+}
+
+func (fs FileService) purgeFileData(ctx context.Context, fileData *ent.FileData) error {
+	reuploaded, err := fs.db.FileData.Query().Where(filedata.ID(fileData.ID)).Exist(ctx)
+	if err != nil || reuploaded {
+		return err
+	}
+	blobInfo, err := fs.locateBlob(ctx, fileData.ID)
+	if err == nil {
+		err = fs.storage.Delete(ctx, blobInfo.Key)
+	}
+	if err != nil && !errors.Is(err, storage.ErrBlobNotFound) {
+		return err
+	}
+	return fs.deletePreview(ctx, fileData.ID)
+}
+
+// DeleteSingleFile deletes a file in its own transaction and purges its stored content
+// unless other files still reference it
+func (fs FileService) DeleteSingleFile(ctx context.Context, fileValue *ent.File) error {
+	tx, err := fs.db.Tx(ctx)
 	if err != nil {
 		return err
 	}
-	err = fs.db.File.DeleteOne(fileValue).Exec(ctx)
+	defer func() { _ = tx.Rollback() }()
+	deletedData, err := fs.DeleteFile(ctx, tx, fileValue)
 	if err != nil {
 		return err
 	}
-	deleteFromFS := fileDataFilesCount <= 1
-	if deleteFromFS {
-		filePath := fs.FilesFilePath(fileValue.Edges.Data.ID)
-		err := os.Remove(filePath)
+	if err := tx.Commit(); err != nil {
+		return err
+	}
+	fs.PurgeFileData(ctx, deletedData)
+	return nil
+}
+
+// OpenFileData opens the stored content of a FileData for reading.
+// It is decrypted and decompressed if necessary.
+func (fs FileService) OpenFileData(
+	ctx context.Context,
+	fileData *ent.FileData,
+) (io.ReadSeekCloser, error) {
+	blobInfo, err := fs.locateBlob(ctx, fileData.ID)
+	if err != nil {
+		return nil, fmt.Errorf("open file: %w", err)
+	}
+	blobKey := blobInfo.Key
+	size := storedContentSize(fileData)
+	var reader io.ReadSeekCloser
+	if fileData.KeyID == nil {
+		reader = storage.NewReadSeeker(ctx, fs.storage, blobKey, size)
+	} else {
+		dataKey, err := fs.keyring.UnwrapKey(*fileData.KeyID, fileData.ID, fileData.EncryptedKey)
 		if err != nil {
-			return err
+			return nil, fmt.Errorf("open file: %w", err)
 		}
-		err = fs.db.FileData.DeleteOne(fileValue.Edges.Data).Exec(ctx)
+		encryptedReader := storage.NewReadSeeker(
+			ctx,
+			fs.storage,
+			blobKey,
+			encryption.EncryptedSize(size),
+		)
+		reader, err = encryption.NewDecryptingReadSeeker(encryptedReader, dataKey, size)
 		if err != nil {
-			return err
+			return nil, fmt.Errorf("open file: %w", err)
 		}
 	}
+	if fileData.Compression == nil {
+		return reader, nil
+	}
+	if *fileData.Compression != CompressionZstd {
+		_ = reader.Close()
+		return nil, fmt.Errorf("open file: unknown compression %s", *fileData.Compression)
+	}
+	return &decompressingReadSeeker{source: reader, size: int64(fileData.Size)}, nil
+}
+
+// OpenFile opens the stored content of a file for reading
+func (fs FileService) OpenFile(
+	ctx context.Context,
+	fileValue *ent.File,
+) (io.ReadSeekCloser, error) {
+	return fs.OpenFileData(ctx, fileValue.Edges.Data)
+}
 
-	return err
+// RotateFileDataKey wraps the data key of fileData with the current master key.
+// It reports whether the FileData had to be updated.
+func (fs FileService) RotateFileDataKey(ctx context.Context, fileData *ent.FileData) (bool, error) {
+	if fileData.KeyID == nil || *fileData.KeyID == fs.keyring.CurrentKeyID() {
+		return false, nil
+	}
+	keyID, wrappedKey, err := fs.keyring.RewrapKey(
+		*fileData.KeyID,
+		fileData.ID,
+		fileData.EncryptedKey,
+	)
+	if err != nil {
+		return false, fmt.Errorf("rotate key: %w", err)
+	}
+	err = fs.db.FileData.UpdateOne(fileData).
+		SetKeyID(keyID).
+		SetEncryptedKey(wrappedKey).
+		Exec(ctx)
+	if err != nil {
+		return false, fmt.Errorf("rotate key: %w", err)
+	}
+	return true, nil
+}
+
+func (fs FileService) EncryptionEnabled() bool {
+	return fs.keyring.Enabled()
+}
+
+// ServeFileAttachment writes the content of a file as attachment to the response.
+// Range and If-Range requests are supported. It returns the byte ranges which were delivered.
+func (fs FileService) ServeFileAttachment(
+	c *gin.Context,
+	fileValue *ent.File,
+) ([]ByteRange, error) {
+	ctx, span := otel.NewSpan(c.Request.Context(), "serveFileAttachment")
+	defer span.End()
+	return fs.serveFile(ctx, c, fileValue, "attachment")
+}
+
+// ServeFileInline writes the content of a file to the response so that browsers display it.
+// The content is sandboxed, so it cannot run scripts or load anything from elsewhere.
+func (fs FileService) ServeFileInline(
+	c *gin.Context,
+	fileValue *ent.File,
+) ([]ByteRange, error) {
+	ctx, span := otel.NewSpan(c.Request.Context(), "serveFileInline")
+	defer span.End()
+	c.Header("Content-Security-Policy", inlineContentSecurityPolicy)
+	c.Header("X-Content-Type-Options", "nosniff")
+	return fs.serveFile(ctx, c, fileValue, "inline")
+}
+
+// serveFile writes the content of a file with the given disposition type to the response
+func (fs FileService) serveFile(
+	ctx context.Context,
+	c *gin.Context,
+	fileValue *ent.File,
+	disposition string,
+) ([]ByteRange, error) {
+	blobInfo, err := fs.locateBlob(ctx, fileValue.Edges.Data.ID)
+	if err != nil {
+		return nil, fmt.Errorf("serve file: %w", err)
+	}
+	reader, err := fs.OpenFile(ctx, fileValue)
+	if err != nil {
+		return nil, fmt.Errorf("serve file: %w", err)
+	}
+	defer func() {
+		if err := reader.Close(); err != nil {
+			slog.Warn("could not close file reader", "file", fileValue.ID, "err", err)
+		}
+	}()
+	c.Header(
+		"Content-Disposition",
+		mime.FormatMediaType(disposition, map[string]string{"filename": fileValue.Name}),
+	)
+	if fileValue.MimeType != nil {
+		c.Header("Content-Type", *fileValue.MimeType)
+	}
+	// the content hash is a strong validator for If-Range
+	c.Header("ETag", fmt.Sprintf("%q", fileValue.Edges.Data.ID))
+	// requests for multiple ranges are answered with the whole content, which is allowed
+	// by RFC 9110 and keeps tracking the delivered bytes simple
+	if strings.Contains(c.GetHeader("Range"), ",") {
+		c.Request.Header.Del("Range")
+	}
+	writer := &deliveryResponseWriter{ResponseWriter: c.Writer}
+	tracker := &deliveryTracker{reader: reader}
+	http.ServeContent(writer, c.Request, fileValue.Name, blobInfo.ModTime, tracker)
+	if writer.writeErr != nil {
+		return tracker.delivered, nil
+	}
+	tracker.confirmPending()
+	if writer.status == http.StatusOK && c.Request.Method != http.MethodHead &&
+		fileValue.Edges.Data.Size == 0 {
+		return []ByteRange{{0, 0}}, nil
+	}
+	return tracker.delivered, nil
 }
 
 func (fs FileService) FileEstimatedExpiry(fileValue *ent.File) *time.Time {
@@ -209,12 +633,19 @@ func (fs FileService) FileEstimatedExpiry(fileValue *ent.File) *time.Time {
 type PublicFile struct {
 	Id              uuid.UUID  `json:"id"`
 	Sha512          string     `json:"sha512"`
+	Sha256          *string    `json:"sha256"`
 	Size            uint64     `json:"size"`
 	Name            string     `json:"name"`
 	CreatedAt       string     `json:"createdAt"`
 	TimesDownloaded uint64     `json:"timesDownloaded"`
 	LastDownloaded  *string    `json:"lastDownloaded"`
+	TimesViewed     uint64     `json:"timesViewed"`
+	LastViewed      *string    `json:"lastViewed"`
 	EstimatedExpiry *string    `json:"estimatedExpiry"`
+	ScanStatus      string     `json:"scanStatus"`
+	MimeType        *string    `json:"mimeType"`
+	PreviewType     *string    `json:"previewType"`
+	Viewable        bool       `json:"viewable"`
 	User            PublicUser `json:"owner"`
 }
 
@@ -225,6 +656,12 @@ func (fs FileService) ToPublicFile(file *ent.File) PublicFile {
 		lastDownloadedValue = &formattedValue
 	}
 
+	var lastViewedValue *string = nil
+	if file.LastView != nil {
+		formattedValue := file.LastView.UTC().Format(http.TimeFormat)
+		lastViewedValue = &formattedValue
+	}
+
 	var estimatedExpiryValue *string = nil
 
 	if estimatedExpiryResult := fs.FileEstimatedExpiry(file); estimatedExpiryResult != nil {
@@ -232,22 +669,45 @@ func (fs FileService) ToPublicFile(file *ent.File) PublicFile {
 		estimatedExpiryValue = &estimatedExpiry
 	}
 
+	var previewType *string
+	if file.Edges.Data.PreviewStatus == config.FilePreviewStatusReady {
+		previewType = file.Edges.Data.PreviewType
+	}
+
 	return PublicFile{
 		Id:              file.ID,
 		Sha512:          file.Edges.Data.ID,
+		Sha256:          file.Edges.Data.Sha256,
 		Size:            file.Edges.Data.Size,
 		Name:            file.Name,
 		CreatedAt:       file.CreatedAt.UTC().Format(http.TimeFormat),
 		TimesDownloaded: file.TimesDownloaded,
 		LastDownloaded:  lastDownloadedValue,
+		TimesViewed:     file.TimesViewed,
+		LastViewed:      lastViewedValue,
 		EstimatedExpiry: estimatedExpiryValue,
+		ScanStatus:      file.ScanStatus,
+		MimeType:        file.MimeType,
+		PreviewType:     previewType,
+		Viewable:        fs.CanViewInline(file),
 		User:            ToPublicUser(file.Edges.Owner),
 	}
 
 }
 
 func NewFileService(c config.Config, db *ent.Client) FileService {
-	fs := FileService{config: c, db: db}
+	if c.FilesLayout != config.FilesLayoutFlat && c.FilesLayout != config.FilesLayoutSharded {
+		panic(fmt.Errorf("files layout %s is not supported by frans", c.FilesLayout))
+	}
+	backend, err := storage.NewBackend(c.FilesConfig)
+	if err != nil {
+		panic(err)
+	}
+	keyring, err := encryption.NewKeyring(c.FilesEncryption)
+	if err != nil {
+		panic(err)
+	}
+	fs := FileService{config: c, db: db, storage: backend, keyring: keyring}
 	fs.EnsureFilesTmpPath()
 	return fs
 }
@@ -0,0 +1,173 @@
+package services
+
+import (
+	"errors"
+	"fmt"
+	"mime"
+	"path/filepath"
+	"strings"
+
+	"codeberg.org/jvllmr/frans/internal/ent"
+	"github.com/gabriel-vasile/mimetype"
+)
+
+type ErrFileTypeRejected struct {
+	name   string
+	reason string
+}
+
+func (e *ErrFileTypeRejected) Error() string {
+	return fmt.Sprintf("file type rejected: %s %s", e.name, e.reason)
+}
+
+// IsFileTypeRejected reports whether err was caused by the file type policy
+func IsFileTypeRejected(err error) bool {
+	var errFileTypeRejected *ErrFileTypeRejected
+	return errors.As(err, &errFileTypeRejected)
+}
+
+// ErrInlineViewNotAllowed is returned for files which are not of a type that can be viewed inline
+var ErrInlineViewNotAllowed = errors.New("file type cannot be viewed in the browser")
+
+// inlineContentSecurityPolicy only allows displaying the content of a file itself
+const inlineContentSecurityPolicy = "default-src 'none'; img-src 'self'; media-src 'self'; " +
+	"style-src 'unsafe-inline'; sandbox"
+
+// matchesFileType reports whether a policy entry matches a file.
+// Entries starting with a dot match the end of the name, all others match the MIME type.
+// A MIME type entry can end with /* to match all subtypes.
+// An empty mimeType only matches extension entries.
+func matchesFileType(entry string, name string, mimeType string) bool {
+	entry = strings.ToLower(strings.TrimSpace(entry))
+	if strings.HasPrefix(entry, ".") {
+		return strings.HasSuffix(strings.ToLower(name), entry)
+	}
+	if mimeType == "" {
+		return false
+	}
+	mediaType, _, err := mime.ParseMediaType(mimeType)
+	if err != nil {
+		return false
+	}
+	if prefix, ok := strings.CutSuffix(entry, "*"); ok {
+		return strings.HasPrefix(mediaType, prefix)
+	}
+	if detected := mimetype.Lookup(mediaType); detected != nil {
+		// also matches aliases
+		return detected.Is(entry)
+	}
+	return mediaType == entry
+}
+
+// extensionMimeType returns the MIME type which is registered for the extension of name
+func extensionMimeType(name string) string {
+	return mime.TypeByExtension(strings.ToLower(filepath.Ext(name)))
+}
+
+// isMimeTypeOrAncestor reports whether mimeType is node or a more generic type of it
+func isMimeTypeOrAncestor(mimeType string, node *mimetype.MIME) bool {
+	for ; node != nil; node = node.Parent() {
+		if node.Is(mimeType) {
+			return true
+		}
+	}
+	return false
+}
+
+// hasTypeMismatch reports whether the content of a file is of a different type than its extension
+// says. Content which is only detected as a more generic type, e.g. text/plain for a .csv file,
+// is no mismatch.
+func hasTypeMismatch(name string, detected *mimetype.MIME) bool {
+	extensionType := extensionMimeType(name)
+	if extensionType == "" {
+		return false
+	}
+	extensionNode := mimetype.Lookup(extensionType)
+	if extensionNode == nil {
+		return false
+	}
+	return !isMimeTypeOrAncestor(detected.String(), extensionNode) &&
+		!isMimeTypeOrAncestor(extensionType, detected)
+}
+
+// servedMimeType returns the MIME type a file is served with. The type of the extension is
+// preferred if the content was detected as a more generic type, e.g. text/csv over text/plain.
+func servedMimeType(name string, detected *mimetype.MIME) string {
+	extensionType := extensionMimeType(name)
+	if extensionType == "" {
+		return detected.String()
+	}
+	if isMimeTypeOrAncestor(detected.String(), mimetype.Lookup(extensionType)) {
+		return extensionType
+	}
+	return detected.String()
+}
+
+// CheckFileType applies the configured file type policy to a file with the detected MIME type.
+// If mimeType is empty, only entries for extensions are checked.
+func (fs FileService) CheckFileType(name string, mimeType string) error {
+	policy := fs.config.FilesTypes
+	for _, entry := range policy.Deny {
+		if matchesFileType(entry, name, mimeType) {
+			return &ErrFileTypeRejected{name: name, reason: "is of a denied type"}
+		}
+	}
+	if len(policy.Allow) == 0 {
+		return nil
+	}
+	for _, entry := range policy.Allow {
+		if matchesFileType(entry, name, mimeType) {
+			return nil
+		}
+	}
+	if mimeType == "" {
+		for _, entry := range policy.Allow {
+			if !strings.HasPrefix(strings.TrimSpace(entry), ".") {
+				// the type might still be allowed once the content is known
+				return nil
+			}
+		}
+	}
+	return &ErrFileTypeRejected{name: name, reason: "is not of an allowed type"}
+}
+
+// CheckFileNames applies the file type policy to the names of files before they are uploaded
+func (fs FileService) CheckFileNames(names []string) error {
+	for _, name := range names {
+		if err := fs.CheckFileType(name, ""); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// detectFileType detects the MIME type of the file at path and applies the file type policy
+func (fs FileService) detectFileType(path string, name string) (*mimetype.MIME, error) {
+	detected, err := mimetype.DetectFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("detect file type: %w", err)
+	}
+	if err := fs.CheckFileType(name, detected.String()); err != nil {
+		return nil, err
+	}
+	if fs.config.FilesTypes.RejectMismatch && hasTypeMismatch(name, detected) {
+		return nil, &ErrFileTypeRejected{
+			name:   name,
+			reason: fmt.Sprintf("does not match its content of type %s", detected.String()),
+		}
+	}
+	return detected, nil
+}
+
+// CanViewInline reports whether a file is of a type which can be displayed in the browser
+func (fs FileService) CanViewInline(fileValue *ent.File) bool {
+	if fileValue.MimeType == nil {
+		return false
+	}
+	for _, entry := range fs.config.InlineTypes {
+		if matchesFileType(entry, fileValue.Name, *fileValue.MimeType) {
+			return true
+		}
+	}
+	return false
+}
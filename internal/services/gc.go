@@ -0,0 +1,201 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	iofs "io/fs"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"time"
+
+	"codeberg.org/jvllmr/frans/internal/ent/filedata"
+	"codeberg.org/jvllmr/frans/internal/otel"
+	"codeberg.org/jvllmr/frans/internal/storage"
+)
+
+// GarbageCollection lists everything which was removed by a garbage collection run
+// or which would have been removed in dry-run mode
+type GarbageCollection struct {
+	FileData  []string
+	Blobs     []string
+	TmpFiles  []string
+	FreedSize int64
+}
+
+// collectUnusedFileData removes FileData rows which are not referenced by any file anymore
+// together with their blobs
+func (fs FileService) collectUnusedFileData(
+	ctx context.Context,
+	threshold time.Time,
+	dryRun bool,
+	gc *GarbageCollection,
+) error {
+	fileDatas, err := fs.db.FileData.Query().
+		Where(filedata.Not(filedata.HasFiles())).
+		All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, fileData := range fileDatas {
+		blobInfo, err := fs.locateBlob(ctx, fileData.ID)
+		blobExists := true
+		if err != nil {
+			if !errors.Is(err, storage.ErrBlobNotFound) {
+				return err
+			}
+			blobExists = false
+		} else if blobInfo.ModTime.After(threshold) {
+			// might be in use by a transaction which is not committed yet
+			continue
+		}
+		gc.FileData = append(gc.FileData, fileData.ID)
+		if blobExists {
+			gc.FreedSize += blobInfo.Size
+		}
+		if dryRun {
+			continue
+		}
+		if blobExists {
+			err := fs.storage.Delete(ctx, blobInfo.Key)
+			if err != nil && !errors.Is(err, storage.ErrBlobNotFound) {
+				return err
+			}
+		}
+		if err := fs.deletePreview(ctx, fileData.ID); err != nil {
+			return err
+		}
+		if err := fs.db.FileData.DeleteOne(fileData).Exec(ctx); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// collectOrphanedBlobs removes blobs without FileData row.
+// Chunks of resumable uploads are kept as long as the upload exists.
+func (fs FileService) collectOrphanedBlobs(
+	ctx context.Context,
+	threshold time.Time,
+	dryRun bool,
+	gc *GarbageCollection,
+) error {
+	fileDataIDs, err := fs.db.FileData.Query().IDs(ctx)
+	if err != nil {
+		return err
+	}
+	knownKeys := make(map[string]bool, len(fileDataIDs))
+	for _, fileDataID := range fileDataIDs {
+		for _, blobKey := range fs.blobKeys(fileDataID) {
+			knownKeys[blobKey] = true
+		}
+		for _, previewKey := range fs.previewKeys(fileDataID) {
+			knownKeys[previewKey] = true
+		}
+	}
+	knownUploads, err := fs.knownUploads(ctx)
+	if err != nil {
+		return err
+	}
+	orphanedBlobs := make([]storage.BlobInfo, 0)
+	for blobInfo, err := range fs.storage.List(ctx) {
+		if err != nil {
+			return err
+		}
+		if uploadID, ok := uploadIDOfChunkKey(blobInfo.Key); ok && knownUploads[uploadID] {
+			continue
+		}
+		if !knownKeys[blobInfo.Key] && blobInfo.ModTime.Before(threshold) {
+			orphanedBlobs = append(orphanedBlobs, blobInfo)
+		}
+	}
+	for _, blobInfo := range orphanedBlobs {
+		gc.Blobs = append(gc.Blobs, blobInfo.Key)
+		gc.FreedSize += blobInfo.Size
+		if dryRun {
+			continue
+		}
+		err := fs.storage.Delete(ctx, blobInfo.Key)
+		if err != nil && !errors.Is(err, storage.ErrBlobNotFound) {
+			return err
+		}
+	}
+	return nil
+}
+
+// collectTmpFiles removes leftovers in the temporary files directory
+func (fs FileService) collectTmpFiles(
+	ctx context.Context,
+	threshold time.Time,
+	dryRun bool,
+	gc *GarbageCollection,
+) error {
+	tmpPath := fs.FilesTmpPath()
+	err := filepath.WalkDir(tmpPath, func(path string, entry iofs.DirEntry, err error) error {
+		if err != nil {
+			if path == tmpPath && errors.Is(err, iofs.ErrNotExist) {
+				return iofs.SkipAll
+			}
+			return err
+		}
+		if entry.IsDir() {
+			return nil
+		}
+		info, err := entry.Info()
+		if err != nil {
+			return err
+		}
+		if info.ModTime().After(threshold) {
+			return nil
+		}
+		gc.TmpFiles = append(gc.TmpFiles, path)
+		gc.FreedSize += info.Size()
+		if dryRun {
+			return nil
+		}
+		if err := os.Remove(path); err != nil && !errors.Is(err, iofs.ErrNotExist) {
+			return err
+		}
+		return nil
+	})
+	return err
+}
+
+// CollectGarbage reconciles the storage, the temporary files directory and the filedata table.
+// Only data which was not modified within gracePeriod is removed.
+// Nothing is removed in dry-run mode.
+func (fs FileService) CollectGarbage(
+	ctx context.Context,
+	gracePeriod time.Duration,
+	dryRun bool,
+) (*GarbageCollection, error) {
+	ctx, span := otel.NewSpan(ctx, "collectGarbage")
+	defer span.End()
+	threshold := time.Now().Add(-gracePeriod)
+	gc := &GarbageCollection{}
+	if err := fs.collectUnusedFileData(ctx, threshold, dryRun, gc); err != nil {
+		return gc, fmt.Errorf("collect unused file data: %w", err)
+	}
+	if err := fs.collectOrphanedBlobs(ctx, threshold, dryRun, gc); err != nil {
+		return gc, fmt.Errorf("collect orphaned blobs: %w", err)
+	}
+	if err := fs.collectTmpFiles(ctx, threshold, dryRun, gc); err != nil {
+		return gc, fmt.Errorf("collect temporary files: %w", err)
+	}
+	// a dry run is only useful if it shows what would be removed
+	logLevel := slog.LevelDebug
+	if dryRun {
+		logLevel = slog.LevelInfo
+	}
+	for _, fileDataID := range gc.FileData {
+		slog.Log(ctx, logLevel, "Collected unused file data", "fileData", fileDataID, "dryRun", dryRun)
+	}
+	for _, blobKey := range gc.Blobs {
+		slog.Log(ctx, logLevel, "Collected orphaned blob", "key", blobKey, "dryRun", dryRun)
+	}
+	for _, tmpFile := range gc.TmpFiles {
+		slog.Log(ctx, logLevel, "Collected temporary file", "path", tmpFile, "dryRun", dryRun)
+	}
+	return gc, nil
+}
@@ -0,0 +1,123 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"codeberg.org/jvllmr/frans/internal/config"
+	"codeberg.org/jvllmr/frans/internal/otel"
+	"codeberg.org/jvllmr/frans/internal/storage"
+)
+
+func blobKeyInLayout(layout string, sha512sum string) string {
+	if layout == config.FilesLayoutSharded {
+		return storage.ShardedKey(sha512sum)
+	}
+	return sha512sum
+}
+
+// BlobKey returns the key under which the blob of the FileData with the given sha512 is stored
+func (fs FileService) BlobKey(sha512sum string) string {
+	return blobKeyInLayout(fs.config.FilesLayout, sha512sum)
+}
+
+// blobKeys returns the key of a blob in the configured layout followed by its keys in other layouts.
+// Blobs which were not migrated yet are still found under the latter.
+func (fs FileService) blobKeys(sha512sum string) []string {
+	keys := []string{fs.BlobKey(sha512sum)}
+	for _, layout := range []string{config.FilesLayoutSharded, config.FilesLayoutFlat} {
+		if layout != fs.config.FilesLayout {
+			keys = append(keys, blobKeyInLayout(layout, sha512sum))
+		}
+	}
+	return keys
+}
+
+// previewKeys returns the keys of the preview of a FileData in the same order as blobKeys
+func (fs FileService) previewKeys(sha512sum string) []string {
+	keys := fs.blobKeys(sha512sum)
+	for i, key := range keys {
+		keys[i] = key + previewKeySuffix
+	}
+	return keys
+}
+
+// locateBlob looks up the blob of the FileData with the given sha512 in all layouts
+func (fs FileService) locateBlob(ctx context.Context, sha512sum string) (storage.BlobInfo, error) {
+	return fs.locateKey(ctx, fs.blobKeys(sha512sum))
+}
+
+// locatePreview looks up the preview of the FileData with the given sha512 in all layouts
+func (fs FileService) locatePreview(
+	ctx context.Context,
+	sha512sum string,
+) (storage.BlobInfo, error) {
+	return fs.locateKey(ctx, fs.previewKeys(sha512sum))
+}
+
+// locateKey returns the first of keys which exists in the storage
+func (fs FileService) locateKey(ctx context.Context, keys []string) (storage.BlobInfo, error) {
+	var blobInfo storage.BlobInfo
+	var err error
+	for _, key := range keys {
+		blobInfo, err = fs.storage.Stat(ctx, key)
+		if !errors.Is(err, storage.ErrBlobNotFound) {
+			return blobInfo, err
+		}
+	}
+	return blobInfo, err
+}
+
+// StorageMigration lists the blobs which were moved to the configured layout
+type StorageMigration struct {
+	Moved   []string
+	Checked int
+}
+
+// MigrateStorage moves all blobs which are stored in another layout to the configured layout.
+// It can run while frans serves files, since blobs are found in all layouts,
+// and it can be repeated after it was interrupted.
+func (fs FileService) MigrateStorage(ctx context.Context) (*StorageMigration, error) {
+	ctx, span := otel.NewSpan(ctx, "migrateStorage")
+	defer span.End()
+	fileDataIDs, err := fs.db.FileData.Query().IDs(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("migrate storage: %w", err)
+	}
+	migration := &StorageMigration{}
+	for _, fileDataID := range fileDataIDs {
+		if err := fs.migrateKeys(ctx, fs.blobKeys(fileDataID), migration); err != nil {
+			return migration, fmt.Errorf("migrate storage: %w", err)
+		}
+		if err := fs.migrateKeys(ctx, fs.previewKeys(fileDataID), migration); err != nil {
+			return migration, fmt.Errorf("migrate storage: %w", err)
+		}
+		migration.Checked++
+	}
+	return migration, nil
+}
+
+// migrateKeys moves the blobs stored under all but the first of keys to the first key
+func (fs FileService) migrateKeys(
+	ctx context.Context,
+	keys []string,
+	migration *StorageMigration,
+) error {
+	for _, oldKey := range keys[1:] {
+		if _, err := fs.storage.Stat(ctx, oldKey); err != nil {
+			if errors.Is(err, storage.ErrBlobNotFound) {
+				continue
+			}
+			return err
+		}
+		// both keys belong to the same FileData, so a blob under the new key has the same content
+		if err := storage.MoveBlob(ctx, fs.storage, oldKey, keys[0]); err != nil {
+			return err
+		}
+		slog.DebugContext(ctx, "Moved blob", "from", oldKey, "to", keys[0])
+		migration.Moved = append(migration.Moved, keys[0])
+	}
+	return nil
+}
@@ -0,0 +1,321 @@
+package services
+
+import (
+	"context"
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	iofs "io/fs"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"os"
+
+	"codeberg.org/jvllmr/frans/internal/ent"
+	"codeberg.org/jvllmr/frans/internal/otel"
+	"github.com/gin-gonic/gin/binding"
+)
+
+// MultipartFilesField is the form field which holds the files of an upload request
+const MultipartFilesField = "files[]"
+
+// MultipartChecksumsField is the form field which holds the expected digests of the files of
+// an upload request in the order of the files. Values use the syntax of the Content-Digest header.
+const MultipartChecksumsField = "checksums[]"
+
+// maxFormValuesSize limits the combined size of all non-file form values of an upload request
+const maxFormValuesSize = 1 << 20
+
+var ErrMalformedUpload = errors.New("malformed upload request")
+
+type ErrTooManyFiles struct {
+	maxFiles uint8
+}
+
+func (e *ErrTooManyFiles) Error() string {
+	return fmt.Sprintf("maximum of %d files allowed per upload", e.maxFiles)
+}
+
+var _ error = (*ErrTooManyFiles)(nil)
+
+// StagedFile is an uploaded file whose content was written to the temporary files directory
+// but which has no database entries yet
+type StagedFile struct {
+	Name   string
+	Size   int64
+	Sha512 string
+	Sha256 string
+	path   string
+}
+
+func (sf *StagedFile) digests() Digests {
+	return hexDigests(sf.Sha256, sf.Sha512)
+}
+
+// sizeLimitedReader fails as soon as more than limit bytes were read
+type sizeLimitedReader struct {
+	reader io.Reader
+	limit  int64
+	read   int64
+}
+
+func (lr *sizeLimitedReader) Read(p []byte) (int, error) {
+	n, err := lr.reader.Read(p)
+	lr.read += int64(n)
+	if lr.read > lr.limit {
+		return n, &ErrFileTooBig{size: -1, maxSize: lr.limit}
+	}
+	return n, err
+}
+
+// StageFile streams reader into the temporary files directory while hashing it.
+// Reading is aborted as soon as the content exceeds the maximum file size.
+func (fs FileService) StageFile(
+	ctx context.Context,
+	name string,
+	reader io.Reader,
+) (*StagedFile, error) {
+	_, span := otel.NewSpan(ctx, "stageFile")
+	defer span.End()
+	tmpFilePath := fs.FilesTmpFilePath()
+	tmpFileHandle, err := os.Create(tmpFilePath)
+	if err != nil {
+		return nil, fmt.Errorf("stage file: %w", err)
+	}
+	hasher := sha512.New()
+	sha256Hasher := sha256.New()
+	size, err := io.Copy(
+		io.MultiWriter(hasher, sha256Hasher, tmpFileHandle),
+		&sizeLimitedReader{reader: reader, limit: fs.config.MaxSizes},
+	)
+	if closeErr := tmpFileHandle.Close(); err == nil {
+		err = closeErr
+	}
+	stagedFile := &StagedFile{
+		Name:   name,
+		Size:   size,
+		Sha512: hex.EncodeToString(hasher.Sum(nil)),
+		Sha256: hex.EncodeToString(sha256Hasher.Sum(nil)),
+		path:   tmpFilePath,
+	}
+	if err != nil {
+		fs.RemoveStagedFile(stagedFile)
+		var errFileTooBig *ErrFileTooBig
+		if errors.As(err, &errFileTooBig) {
+			return nil, err
+		}
+		return nil, fmt.Errorf("stage file: %w", err)
+	}
+	return stagedFile, nil
+}
+
+// RemoveStagedFile removes the temporary content of a staged file if it still exists
+func (fs FileService) RemoveStagedFile(stagedFile *StagedFile) {
+	if err := os.Remove(stagedFile.path); err != nil && !errors.Is(err, iofs.ErrNotExist) {
+		slog.Warn("could not remove temporary file", "path", stagedFile.path, "err", err)
+	}
+}
+
+// CreateFileFromStaged creates the database entries for a staged file and stores its content
+func (fs FileService) CreateFileFromStaged(
+	ctx context.Context,
+	tx *ent.Tx,
+	stagedFile *StagedFile,
+	user *ent.User,
+	expiryType string,
+	expiryDaysSinceLastDownload uint8,
+	expiryTotalDays uint8,
+	expiryTotalDownloads uint8,
+) (*ent.File, error) {
+	return fs.createFileFromTmpFile(
+		ctx,
+		tx,
+		stagedFile.path,
+		stagedFile.Sha512,
+		stagedFile.Sha256,
+		stagedFile.Name,
+		stagedFile.Size,
+		user,
+		expiryType,
+		expiryDaysSinceLastDownload,
+		expiryTotalDays,
+		expiryTotalDownloads,
+	)
+}
+
+// MultipartUpload holds the form values and staged files of a streamed upload request
+type MultipartUpload struct {
+	Values url.Values
+	Files  []*StagedFile
+	fs     FileService
+}
+
+// Bind maps the form values onto obj and validates it
+func (mu *MultipartUpload) Bind(obj any) error {
+	if err := binding.MapFormWithTag(obj, mu.Values, "form"); err != nil {
+		return err
+	}
+	return binding.Validator.ValidateStruct(obj)
+}
+
+// Cleanup removes the temporary content of all staged files which were not stored
+func (mu *MultipartUpload) Cleanup() {
+	for _, stagedFile := range mu.Files {
+		mu.fs.RemoveStagedFile(stagedFile)
+	}
+}
+
+// verifyChecksums compares the digests sent for the files with the computed ones
+func (mu *MultipartUpload) verifyChecksums() error {
+	checksums := mu.Values[MultipartChecksumsField]
+	if len(checksums) > len(mu.Files) {
+		return fmt.Errorf("%w: more checksums than files", ErrMalformedUpload)
+	}
+	for i, checksum := range checksums {
+		// files without checksum are sent with an empty value to keep the order
+		if checksum == "" {
+			continue
+		}
+		expected, err := ParseDigests(checksum)
+		if err != nil {
+			return fmt.Errorf("%s: %w", mu.Files[i].Name, err)
+		}
+		if err := expected.verify(mu.Files[i].Name, mu.Files[i].digests()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ReadMultipartUpload streams the body of an upload request. Files are written directly to
+// the temporary files directory while the request is read, so they are never spooled twice.
+// Form values can be sent before or after the files.
+// Digests sent via Content-Digest, Repr-Digest or the checksums form field are verified.
+func (fs FileService) ReadMultipartUpload(
+	ctx context.Context,
+	request *http.Request,
+) (*MultipartUpload, error) {
+	ctx, span := otel.NewSpan(ctx, "readMultipartUpload")
+	defer span.End()
+	expectedBodyDigests, err := parseBodyDigests(request.Header)
+	if err != nil {
+		return nil, err
+	}
+	var body *digestReader
+	if len(expectedBodyDigests) > 0 {
+		body = newDigestReader(request.Body)
+		request.Body = body
+	}
+	upload, err := fs.readMultipartUpload(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+	if body != nil {
+		// whatever follows the closing boundary is part of the content as well
+		if _, err := io.Copy(io.Discard, body); err != nil {
+			upload.Cleanup()
+			return nil, fmt.Errorf("%w: %w", ErrMalformedUpload, err)
+		}
+		for headerName, expected := range expectedBodyDigests {
+			if err := expected.verify(headerName, body.digests()); err != nil {
+				upload.Cleanup()
+				return nil, err
+			}
+		}
+	}
+	if err := upload.verifyChecksums(); err != nil {
+		upload.Cleanup()
+		return nil, err
+	}
+	return upload, nil
+}
+
+func (fs FileService) readMultipartUpload(
+	ctx context.Context,
+	request *http.Request,
+) (*MultipartUpload, error) {
+	upload := &MultipartUpload{Values: url.Values{}, fs: fs}
+	reader, err := request.MultipartReader()
+	if err != nil {
+		if !errors.Is(err, http.ErrNotMultipart) {
+			return nil, fmt.Errorf("%w: %w", ErrMalformedUpload, err)
+		}
+		if err := request.ParseForm(); err != nil {
+			return nil, fmt.Errorf("%w: %w", ErrMalformedUpload, err)
+		}
+		upload.Values = request.PostForm
+		return upload, nil
+	}
+
+	valuesSize := 0
+	for {
+		part, err := reader.NextPart()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			upload.Cleanup()
+			return nil, fmt.Errorf("%w: %w", ErrMalformedUpload, err)
+		}
+
+		if part.FormName() == MultipartFilesField && part.FileName() != "" {
+			if len(upload.Files) >= int(fs.config.MaxFiles) {
+				_ = part.Close()
+				upload.Cleanup()
+				return nil, &ErrTooManyFiles{maxFiles: fs.config.MaxFiles}
+			}
+			stagedFile, err := fs.StageFile(ctx, part.FileName(), part)
+			_ = part.Close()
+			if err != nil {
+				upload.Cleanup()
+				return nil, err
+			}
+			upload.Files = append(upload.Files, stagedFile)
+			continue
+		}
+
+		value, err := io.ReadAll(io.LimitReader(part, int64(maxFormValuesSize-valuesSize+1)))
+		_ = part.Close()
+		if err != nil {
+			upload.Cleanup()
+			return nil, fmt.Errorf("%w: %w", ErrMalformedUpload, err)
+		}
+		valuesSize += len(value)
+		if valuesSize > maxFormValuesSize {
+			upload.Cleanup()
+			return nil, fmt.Errorf("%w: form values too large", ErrMalformedUpload)
+		}
+		upload.Values.Add(part.FormName(), string(value))
+	}
+	return upload, nil
+}
+
+// CheckUploadLimits validates declared file sizes against the configured limits
+// before any content is sent
+func (fs FileService) CheckUploadLimits(sizes []int64) error {
+	if len(sizes) > int(fs.config.MaxFiles) {
+		return &ErrTooManyFiles{maxFiles: fs.config.MaxFiles}
+	}
+	for _, size := range sizes {
+		if size > fs.config.MaxSizes {
+			return &ErrFileTooBig{size: size, maxSize: fs.config.MaxSizes}
+		}
+	}
+	return nil
+}
+
+// IsUploadRejected reports whether err was caused by a malformed upload request or by an upload
+// which violates the configured limits
+func IsUploadRejected(err error) bool {
+	var errFileTooBig *ErrFileTooBig
+	var errTooManyFiles *ErrTooManyFiles
+	return errors.As(err, &errFileTooBig) ||
+		errors.As(err, &errTooManyFiles) ||
+		IsDigestMismatch(err) ||
+		errors.Is(err, ErrMalformedUpload) ||
+		errors.Is(err, ErrUploadNotFound) ||
+		errors.Is(err, ErrUploadIncomplete)
+}
@@ -0,0 +1,440 @@
+package services
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"image"
+	"image/color"
+	_ "image/gif"
+	"image/jpeg"
+	"image/png"
+	"io"
+	"log/slog"
+	"mime"
+	"net/http"
+	"os"
+	"os/exec"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+	"unicode/utf8"
+
+	"codeberg.org/jvllmr/frans/internal/config"
+	"codeberg.org/jvllmr/frans/internal/encryption"
+	"codeberg.org/jvllmr/frans/internal/ent"
+	"codeberg.org/jvllmr/frans/internal/ent/file"
+	"codeberg.org/jvllmr/frans/internal/ent/filedata"
+	"codeberg.org/jvllmr/frans/internal/otel"
+	"codeberg.org/jvllmr/frans/internal/storage"
+	"github.com/gabriel-vasile/mimetype"
+	"github.com/gin-gonic/gin"
+)
+
+// previews are stored next to the blob of their FileData
+const previewKeySuffix = ".preview"
+
+const (
+	previewTypePNG  = "image/png"
+	previewTypeJPEG = "image/jpeg"
+	previewTypeText = "text/plain; charset=utf-8"
+)
+
+const (
+	// number of bytes of a text file which are shown in its preview
+	textPreviewSize = 4 << 10
+	// larger images are not decoded to protect against decompression bombs
+	maxPreviewPixels = 50_000_000
+	pdfRenderTimeout = time.Minute
+)
+
+var ErrPreviewNotFound = errors.New("file has no preview")
+
+// errPreviewUnsupported is returned if no preview can be generated for the content of a file
+var errPreviewUnsupported = errors.New("preview not supported")
+
+// previewDataKey returns the key the preview of an encrypted FileData is encrypted with
+func (fs FileService) previewDataKey(fileData *ent.FileData) ([]byte, error) {
+	dataKey, err := fs.keyring.UnwrapKey(*fileData.KeyID, fileData.ID, fileData.EncryptedKey)
+	if err != nil {
+		return nil, err
+	}
+	return encryption.DeriveKey(dataKey, "preview"), nil
+}
+
+// storePreview stores the preview of fileData. It is encrypted if the FileData is encrypted.
+func (fs FileService) storePreview(
+	ctx context.Context,
+	fileData *ent.FileData,
+	content []byte,
+) error {
+	previewKey := fs.previewKeys(fileData.ID)[0]
+	size := int64(len(content))
+	if fileData.KeyID == nil {
+		return fs.storage.Put(ctx, previewKey, bytes.NewReader(content), size)
+	}
+	dataKey, err := fs.previewDataKey(fileData)
+	if err != nil {
+		return err
+	}
+	reader, err := encryption.NewEncryptingReader(bytes.NewReader(content), dataKey, size)
+	if err != nil {
+		return err
+	}
+	return fs.storage.Put(ctx, previewKey, reader, encryption.EncryptedSize(size))
+}
+
+// OpenPreview reads the preview of fileData
+func (fs FileService) OpenPreview(ctx context.Context, fileData *ent.FileData) ([]byte, error) {
+	if fileData.PreviewStatus != config.FilePreviewStatusReady {
+		return nil, ErrPreviewNotFound
+	}
+	previewInfo, err := fs.locatePreview(ctx, fileData.ID)
+	if err != nil {
+		if errors.Is(err, storage.ErrBlobNotFound) {
+			return nil, ErrPreviewNotFound
+		}
+		return nil, fmt.Errorf("open preview: %w", err)
+	}
+	var reader io.ReadCloser
+	if fileData.KeyID == nil {
+		reader, err = fs.storage.Get(ctx, previewInfo.Key)
+		if err != nil {
+			return nil, fmt.Errorf("open preview: %w", err)
+		}
+	} else {
+		dataKey, err := fs.previewDataKey(fileData)
+		if err != nil {
+			return nil, fmt.Errorf("open preview: %w", err)
+		}
+		encryptedReader := storage.NewReadSeeker(ctx, fs.storage, previewInfo.Key, previewInfo.Size)
+		reader, err = encryption.NewDecryptingReadSeeker(
+			encryptedReader,
+			dataKey,
+			encryption.PlainSize(previewInfo.Size),
+		)
+		if err != nil {
+			return nil, fmt.Errorf("open preview: %w", err)
+		}
+	}
+	defer func() { _ = reader.Close() }()
+	content, err := io.ReadAll(reader)
+	if err != nil {
+		return nil, fmt.Errorf("open preview: %w", err)
+	}
+	return content, nil
+}
+
+// deletePreview removes the preview of the FileData with the given sha512 in all layouts
+func (fs FileService) deletePreview(ctx context.Context, sha512sum string) error {
+	for _, previewKey := range fs.previewKeys(sha512sum) {
+		err := fs.storage.Delete(ctx, previewKey)
+		if err != nil && !errors.Is(err, storage.ErrBlobNotFound) {
+			return err
+		}
+	}
+	return nil
+}
+
+// ServePreview writes the preview of a file to the response.
+// It returns ErrPreviewNotFound if no preview was generated for the file.
+func (fs FileService) ServePreview(c *gin.Context, fileValue *ent.File) error {
+	ctx, span := otel.NewSpan(c.Request.Context(), "servePreview")
+	defer span.End()
+	fileData := fileValue.Edges.Data
+	content, err := fs.OpenPreview(ctx, fileData)
+	if err != nil {
+		return err
+	}
+	c.Header("Content-Type", *fileData.PreviewType)
+	// text previews must never be interpreted as markup
+	c.Header("X-Content-Type-Options", "nosniff")
+	c.Header("Content-Security-Policy", "default-src 'none'; sandbox")
+	c.Header("Cache-Control", "private, max-age=3600")
+	c.Header("ETag", fmt.Sprintf("%q", fileData.ID+previewKeySuffix))
+	http.ServeContent(c.Writer, c.Request, "", time.Time{}, bytes.NewReader(content))
+	return nil
+}
+
+// scaleImage shrinks img to fit into a square with sides of maxDimension pixels.
+// Every pixel of the result is the average of the pixels it covers.
+func scaleImage(img image.Image, maxDimension int) *image.RGBA {
+	bounds := img.Bounds()
+	width, height := bounds.Dx(), bounds.Dy()
+	scale := max(float64(max(width, height))/float64(maxDimension), 1)
+	targetWidth := max(int(float64(width)/scale), 1)
+	targetHeight := max(int(float64(height)/scale), 1)
+	result := image.NewRGBA(image.Rect(0, 0, targetWidth, targetHeight))
+	for y := range targetHeight {
+		y0 := bounds.Min.Y + y*height/targetHeight
+		y1 := max(bounds.Min.Y+(y+1)*height/targetHeight, y0+1)
+		for x := range targetWidth {
+			x0 := bounds.Min.X + x*width/targetWidth
+			x1 := max(bounds.Min.X+(x+1)*width/targetWidth, x0+1)
+			var r, g, b, a, count uint64
+			for sourceY := y0; sourceY < y1; sourceY++ {
+				for sourceX := x0; sourceX < x1; sourceX++ {
+					pixelR, pixelG, pixelB, pixelA := img.At(sourceX, sourceY).RGBA()
+					r += uint64(pixelR)
+					g += uint64(pixelG)
+					b += uint64(pixelB)
+					a += uint64(pixelA)
+					count++
+				}
+			}
+			result.Set(x, y, color.RGBA64{
+				R: uint16(r / count),
+				G: uint16(g / count),
+				B: uint16(b / count),
+				A: uint16(a / count),
+			})
+		}
+	}
+	return result
+}
+
+// renderImagePreview scales down an image. JPEGs stay JPEGs, all other images become PNGs.
+func renderImagePreview(content []byte, maxDimension int) ([]byte, string, error) {
+	imageConfig, format, err := image.DecodeConfig(bytes.NewReader(content))
+	if err != nil {
+		return nil, "", fmt.Errorf("%w: %w", errPreviewUnsupported, err)
+	}
+	pixels := imageConfig.Width * imageConfig.Height
+	if pixels == 0 || pixels > maxPreviewPixels {
+		return nil, "", fmt.Errorf("%w: image has %d pixels", errPreviewUnsupported, pixels)
+	}
+	img, _, err := image.Decode(bytes.NewReader(content))
+	if err != nil {
+		return nil, "", fmt.Errorf("%w: %w", errPreviewUnsupported, err)
+	}
+	thumbnail := scaleImage(img, maxDimension)
+	var buffer bytes.Buffer
+	if format == "jpeg" {
+		if err := jpeg.Encode(&buffer, thumbnail, &jpeg.Options{Quality: 80}); err != nil {
+			return nil, "", err
+		}
+		return buffer.Bytes(), previewTypeJPEG, nil
+	}
+	if err := png.Encode(&buffer, thumbnail); err != nil {
+		return nil, "", err
+	}
+	return buffer.Bytes(), previewTypePNG, nil
+}
+
+// renderTextPreview returns the beginning of a text file as valid UTF-8
+func renderTextPreview(reader io.Reader) ([]byte, error) {
+	content := make([]byte, textPreviewSize)
+	n, err := io.ReadFull(reader, content)
+	if err != nil && !errors.Is(err, io.EOF) && !errors.Is(err, io.ErrUnexpectedEOF) {
+		return nil, err
+	}
+	content = content[:n]
+	if n == textPreviewSize {
+		// the last character might be cut off
+		for i := 0; i < utf8.UTFMax-1 && !utf8.Valid(content); i++ {
+			content = content[:len(content)-1]
+		}
+	}
+	return bytes.ToValidUTF8(content, []byte(string(utf8.RuneError))), nil
+}
+
+// isTextType reports whether content of the MIME type can be shown as UTF-8 text
+func isTextType(mediaType string, params map[string]string) bool {
+	charset := strings.ToLower(params["charset"])
+	if charset != "" && charset != "utf-8" && charset != "us-ascii" {
+		return false
+	}
+	return strings.HasPrefix(mediaType, "text/") ||
+		isMimeTypeOrAncestor("text/plain", mimetype.Lookup(mediaType))
+}
+
+// prevents that concurrent runs generate the same previews
+var previewMutex sync.Mutex
+
+// PreviewGeneration counts the FileData which were handled by a preview run
+type PreviewGeneration struct {
+	Generated   int
+	Unavailable int
+}
+
+type PreviewService struct {
+	config config.Config
+	db     *ent.Client
+	fs     FileService
+}
+
+func (ps PreviewService) Enabled() bool {
+	return ps.config.FilesPreviews.Enabled
+}
+
+// renderPdfPreview renders the first page of a PDF with pdftoppm
+func (ps PreviewService) renderPdfPreview(
+	ctx context.Context,
+	reader io.Reader,
+	maxDimension int,
+) ([]byte, error) {
+	command := ps.config.FilesPreviews.PdfCommand
+	if command == "" {
+		return nil, errPreviewUnsupported
+	}
+	sourcePath := ps.fs.FilesTmpFilePath()
+	defer func() { _ = os.Remove(sourcePath) }()
+	sourceFile, err := os.Create(sourcePath)
+	if err != nil {
+		return nil, err
+	}
+	_, err = io.Copy(sourceFile, reader)
+	if closeErr := sourceFile.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	// pdftoppm appends the extension to the output prefix
+	outputPrefix := ps.fs.FilesTmpFilePath()
+	defer func() { _ = os.Remove(outputPrefix + ".png") }()
+	ctx, cancel := context.WithTimeout(ctx, pdfRenderTimeout)
+	defer cancel()
+	output, err := exec.CommandContext(
+		ctx,
+		command,
+		"-png",
+		"-singlefile",
+		"-f", "1",
+		"-l", "1",
+		"-scale-to", strconv.Itoa(maxDimension),
+		sourcePath,
+		outputPrefix,
+	).CombinedOutput()
+	if err != nil {
+		var exitErr *exec.ExitError
+		if errors.As(err, &exitErr) || errors.Is(err, exec.ErrNotFound) {
+			return nil, fmt.Errorf(
+				"%w: %w: %s",
+				errPreviewUnsupported,
+				err,
+				strings.TrimSpace(string(output)),
+			)
+		}
+		return nil, err
+	}
+	return os.ReadFile(outputPrefix + ".png")
+}
+
+// renderPreview renders the preview of the content of fileData and returns its MIME type
+func (ps PreviewService) renderPreview(
+	ctx context.Context,
+	fileData *ent.FileData,
+) ([]byte, string, error) {
+	if fileData.MimeType == nil {
+		return nil, "", errPreviewUnsupported
+	}
+	if int64(fileData.Size) > ps.config.FilesPreviews.MaxSourceSize {
+		return nil, "", fmt.Errorf("%w: file is too big", errPreviewUnsupported)
+	}
+	mediaType, params, err := mime.ParseMediaType(*fileData.MimeType)
+	if err != nil {
+		return nil, "", fmt.Errorf("%w: %w", errPreviewUnsupported, err)
+	}
+	isImage := mediaType == "image/png" || mediaType == "image/jpeg" || mediaType == "image/gif"
+	isPdf := mediaType == "application/pdf"
+	isText := isTextType(mediaType, params)
+	if !isImage && !isPdf && !isText {
+		return nil, "", fmt.Errorf("%w: %s", errPreviewUnsupported, mediaType)
+	}
+
+	reader, err := ps.fs.OpenFileData(ctx, fileData)
+	if err != nil {
+		return nil, "", err
+	}
+	defer func() { _ = reader.Close() }()
+	maxDimension := int(ps.config.FilesPreviews.MaxDimension)
+	switch {
+	case isImage:
+		// read errors must not be mistaken for broken images
+		content, err := io.ReadAll(reader)
+		if err != nil {
+			return nil, "", err
+		}
+		return renderImagePreview(content, maxDimension)
+	case isPdf:
+		content, err := ps.renderPdfPreview(ctx, reader, maxDimension)
+		return content, previewTypePNG, err
+	default:
+		content, err := renderTextPreview(reader)
+		return content, previewTypeText, err
+	}
+}
+
+// GeneratePendingPreviews generates previews for all FileData which were not previewed yet.
+// Only content of files which were found to be clean is previewed.
+func (ps PreviewService) GeneratePendingPreviews(ctx context.Context) (*PreviewGeneration, error) {
+	ctx, span := otel.NewSpan(ctx, "generatePendingPreviews")
+	defer span.End()
+	previewMutex.Lock()
+	defer previewMutex.Unlock()
+	generation := &PreviewGeneration{}
+	if !ps.Enabled() {
+		return generation, nil
+	}
+	fileDatas, err := ps.db.FileData.Query().
+		Where(
+			filedata.PreviewStatus(config.FilePreviewStatusPending),
+			filedata.HasFilesWith(file.ScanStatus(config.FileScanStatusClean)),
+		).
+		All(ctx)
+	if err != nil {
+		return generation, fmt.Errorf("generate previews: %w", err)
+	}
+	for _, fileData := range fileDatas {
+		content, contentType, err := ps.renderPreview(ctx, fileData)
+		fileDataUpdate := ps.db.FileData.UpdateOne(fileData)
+		available := err == nil
+		if errors.Is(err, errPreviewUnsupported) {
+			slog.DebugContext(ctx, "No preview generated", "fileData", fileData.ID, "err", err)
+			fileDataUpdate.SetPreviewStatus(config.FilePreviewStatusUnavailable)
+		} else if err != nil {
+			return generation, fmt.Errorf("generate previews: %w", err)
+		} else {
+			if err := ps.fs.storePreview(ctx, fileData, content); err != nil {
+				return generation, fmt.Errorf("generate previews: %w", err)
+			}
+			fileDataUpdate.
+				SetPreviewStatus(config.FilePreviewStatusReady).
+				SetPreviewType(contentType)
+		}
+		if err := fileDataUpdate.Exec(ctx); err != nil {
+			if ent.IsNotFound(err) {
+				// the FileData was deleted in the meantime, its preview is garbage collected
+				continue
+			}
+			return generation, fmt.Errorf("generate previews: %w", err)
+		}
+		if available {
+			generation.Generated++
+		} else {
+			generation.Unavailable++
+		}
+	}
+	return generation, nil
+}
+
+// GeneratePendingPreviewsInBackground generates previews for new files
+// without delaying the response to an upload
+func (ps PreviewService) GeneratePendingPreviewsInBackground() {
+	if !ps.Enabled() {
+		return
+	}
+	go func() {
+		if _, err := ps.GeneratePendingPreviews(context.Background()); err != nil {
+			slog.Error("Could not generate previews", "err", err)
+		}
+	}()
+}
+
+func NewPreviewService(c config.Config, db *ent.Client) PreviewService {
+	return PreviewService{config: c, db: db, fs: NewFileService(c, db)}
+}
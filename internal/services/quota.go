@@ -0,0 +1,201 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	"codeberg.org/jvllmr/frans/internal/config"
+	"codeberg.org/jvllmr/frans/internal/ent"
+	"codeberg.org/jvllmr/frans/internal/ent/user"
+	"codeberg.org/jvllmr/frans/internal/otel"
+)
+
+const (
+	QuotaResourceBytes   = "bytes"
+	QuotaResourceTickets = "active tickets"
+	QuotaResourceGrants  = "active grants"
+)
+
+type ErrQuotaExceeded struct {
+	Resource string
+	Usage    int64
+	Limit    int64
+}
+
+func (e *ErrQuotaExceeded) Error() string {
+	return fmt.Sprintf(
+		"quota exceeded: request would result in %d %s, but only %d are allowed",
+		e.Usage,
+		e.Resource,
+		e.Limit,
+	)
+}
+
+// IsQuotaExceeded reports whether err was caused by a quota violation
+func IsQuotaExceeded(err error) bool {
+	var errQuotaExceeded *ErrQuotaExceeded
+	return errors.As(err, &errQuotaExceeded)
+}
+
+// moreGenerousLimit returns the limit which allows more. 0 means unlimited.
+func moreGenerousLimit[T int | int64](a T, b T) T {
+	if a == 0 || b == 0 {
+		return 0
+	}
+	return max(a, b)
+}
+
+// mergeGroupLimit combines the limit of a group override with the limits of previous groups
+func mergeGroupLimit[T int | int64](merged *T, override *T) *T {
+	if override == nil {
+		return merged
+	}
+	if merged == nil {
+		return override
+	}
+	value := moreGenerousLimit(*merged, *override)
+	return &value
+}
+
+// checkLimit fails if usage exceeds a limit. 0 means unlimited.
+func checkLimit(resource string, usage int64, limit int64) error {
+	if limit != 0 && usage > limit {
+		return &ErrQuotaExceeded{Resource: resource, Usage: usage, Limit: limit}
+	}
+	return nil
+}
+
+type PublicQuota struct {
+	UsedBytes        int64 `json:"usedBytes"`
+	MaxBytes         int64 `json:"maxBytes"`
+	ActiveTickets    int   `json:"activeTickets"`
+	MaxActiveTickets int   `json:"maxActiveTickets"`
+	ActiveGrants     int   `json:"activeGrants"`
+	MaxActiveGrants  int   `json:"maxActiveGrants"`
+}
+
+type QuotaService struct {
+	config config.Config
+	db     *ent.Client
+}
+
+// UserLimits returns the quota limits of a user.
+// Group overrides replace the default limits. If multiple groups of the user override a limit,
+// the most generous one applies.
+func (qs QuotaService) UserLimits(userValue *ent.User) config.QuotaLimits {
+	limits := qs.config.QuotaConfig.QuotaLimits
+	var maxBytes *int64
+	var maxActiveTickets, maxActiveGrants *int
+	for _, groupQuota := range qs.config.QuotaGroups {
+		if !slices.Contains(userValue.Groups, groupQuota.Group) {
+			continue
+		}
+		maxBytes = mergeGroupLimit(maxBytes, groupQuota.MaxBytes)
+		maxActiveTickets = mergeGroupLimit(maxActiveTickets, groupQuota.MaxActiveTickets)
+		maxActiveGrants = mergeGroupLimit(maxActiveGrants, groupQuota.MaxActiveGrants)
+	}
+	if maxBytes != nil {
+		limits.MaxBytes = *maxBytes
+	}
+	if maxActiveTickets != nil {
+		limits.MaxActiveTickets = *maxActiveTickets
+	}
+	if maxActiveGrants != nil {
+		limits.MaxActiveGrants = *maxActiveGrants
+	}
+	return limits
+}
+
+// CheckBytes fails if storing additionalBytes would exceed the byte quota of a user
+func (qs QuotaService) CheckBytes(
+	ctx context.Context,
+	userValue *ent.User,
+	additionalBytes int64,
+) error {
+	ctx, span := otel.NewSpan(ctx, "checkBytesQuota")
+	defer span.End()
+	limit := qs.UserLimits(userValue).MaxBytes
+	if limit == 0 {
+		return nil
+	}
+	// the passed user might have been loaded before the last upload finished
+	totalDataSize, err := qs.db.User.Query().
+		Where(user.ID(userValue.ID)).
+		Select(user.FieldTotalDataSize).
+		Int(ctx)
+	if err != nil {
+		return fmt.Errorf("check bytes quota: %w", err)
+	}
+	return checkLimit(QuotaResourceBytes, int64(totalDataSize)+additionalBytes, limit)
+}
+
+// CheckNewTicket fails if a user cannot create another ticket with files of additionalBytes
+func (qs QuotaService) CheckNewTicket(
+	ctx context.Context,
+	userValue *ent.User,
+	additionalBytes int64,
+) error {
+	ctx, span := otel.NewSpan(ctx, "checkNewTicketQuota")
+	defer span.End()
+	if err := qs.CheckBytes(ctx, userValue, additionalBytes); err != nil {
+		return err
+	}
+	activeTickets, err := qs.db.User.QueryTickets(userValue).Count(ctx)
+	if err != nil {
+		return fmt.Errorf("check ticket quota: %w", err)
+	}
+	return checkLimit(
+		QuotaResourceTickets,
+		int64(activeTickets+1),
+		int64(qs.UserLimits(userValue).MaxActiveTickets),
+	)
+}
+
+// CheckNewGrant fails if a user cannot create another grant
+func (qs QuotaService) CheckNewGrant(ctx context.Context, userValue *ent.User) error {
+	ctx, span := otel.NewSpan(ctx, "checkNewGrantQuota")
+	defer span.End()
+	activeGrants, err := qs.db.User.QueryGrants(userValue).Count(ctx)
+	if err != nil {
+		return fmt.Errorf("check grant quota: %w", err)
+	}
+	return checkLimit(
+		QuotaResourceGrants,
+		int64(activeGrants+1),
+		int64(qs.UserLimits(userValue).MaxActiveGrants),
+	)
+}
+
+func (qs QuotaService) ToPublicQuota(
+	userValue *ent.User,
+	activeTickets int,
+	activeGrants int,
+) PublicQuota {
+	limits := qs.UserLimits(userValue)
+	return PublicQuota{
+		UsedBytes:        userValue.TotalDataSize,
+		MaxBytes:         limits.MaxBytes,
+		ActiveTickets:    activeTickets,
+		MaxActiveTickets: limits.MaxActiveTickets,
+		ActiveGrants:     activeGrants,
+		MaxActiveGrants:  limits.MaxActiveGrants,
+	}
+}
+
+func NewQuotaService(cfg config.Config, db *ent.Client) QuotaService {
+	return QuotaService{config: cfg, db: db}
+}
+
+// TotalUploadSize sums the sizes of staged files and finished resumable uploads
+func TotalUploadSize(stagedFiles []*StagedFile, uploads []*ent.Upload) int64 {
+	var size int64
+	for _, stagedFile := range stagedFiles {
+		size += stagedFile.Size
+	}
+	for _, uploadValue := range uploads {
+		size += uploadValue.Size
+	}
+	return size
+}
@@ -0,0 +1,193 @@
+package services
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"time"
+
+	"codeberg.org/jvllmr/frans/internal/config"
+	"codeberg.org/jvllmr/frans/internal/ent"
+	"codeberg.org/jvllmr/frans/internal/ent/recipient"
+	"codeberg.org/jvllmr/frans/internal/ent/shareaccesstoken"
+	"codeberg.org/jvllmr/frans/internal/ent/ticket"
+	"codeberg.org/jvllmr/frans/internal/otel"
+	"codeberg.org/jvllmr/frans/internal/util"
+	entsql "entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// RecipientService manages the personal links of tickets
+type RecipientService struct {
+	cfg config.Config
+	db  *ent.Client
+}
+
+type RecipientParams struct {
+	Name  string  `json:"name"  binding:"required,max=255"`
+	Email *string `json:"email" binding:"omitempty,email"`
+	// language of the mail with the personal link
+	ReceiverLang string `json:"receiverLang"`
+}
+
+// RecipientShareLink returns the personal link of a recipient with the given token.
+// The token is part of the fragment, so it is never sent to the server with the page request.
+func (rs RecipientService) RecipientShareLink(
+	ctx *gin.Context,
+	ticketValue *ent.Ticket,
+	token string,
+) string {
+	return fmt.Sprintf(
+		"%s/s/%s#%s",
+		rs.cfg.GetBaseURL(ctx.Request),
+		ticketValue.ID.String(),
+		token,
+	)
+}
+
+// CreateRecipient creates a recipient and returns the token of their personal link.
+// Only the hash of the token is stored, so the link cannot be shown again later.
+func (rs RecipientService) CreateRecipient(
+	ctx context.Context,
+	ticketValue *ent.Ticket,
+	params RecipientParams,
+) (*ent.Recipient, string, error) {
+	ctx, span := otel.NewSpan(ctx, "createRecipient")
+	defer span.End()
+	token := hex.EncodeToString(util.GenerateSalt())
+	recipientValue, err := rs.db.Recipient.Create().
+		SetID(uuid.New()).
+		SetName(params.Name).
+		SetNillableEmail(params.Email).
+		SetHashedToken(util.HashToken(token)).
+		SetTicket(ticketValue).
+		Save(ctx)
+	if err != nil {
+		return nil, "", err
+	}
+	return recipientValue, token, nil
+}
+
+// FindRecipient returns the recipient of a ticket who owns token and was not revoked
+func (rs RecipientService) FindRecipient(
+	ctx context.Context,
+	ticketID uuid.UUID,
+	token string,
+) (*ent.Recipient, error) {
+	return rs.db.Recipient.Query().
+		Where(
+			recipient.HashedToken(util.HashToken(token)),
+			recipient.RevokedAtIsNil(),
+			recipient.HasTicketWith(ticket.ID(ticketID)),
+		).
+		Only(ctx)
+}
+
+// RevokeRecipient disables the personal link of a recipient.
+// Access tokens issued to the recipient are deleted, so running downloads cannot be resumed.
+func (rs RecipientService) RevokeRecipient(
+	ctx context.Context,
+	recipientValue *ent.Recipient,
+) (*ent.Recipient, error) {
+	ctx, span := otel.NewSpan(ctx, "revokeRecipient")
+	defer span.End()
+	tx, err := rs.db.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = tx.Rollback() }()
+	_, err = tx.ShareAccessToken.Delete().
+		Where(shareaccesstoken.HasRecipientWith(recipient.ID(recipientValue.ID))).
+		Exec(ctx)
+	if err != nil {
+		return nil, err
+	}
+	revokedRecipient, err := tx.Recipient.UpdateOne(recipientValue).
+		SetRevokedAt(time.Now()).
+		Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return revokedRecipient, tx.Commit()
+}
+
+// RecordDownload counts a completed download of fileValue by a recipient.
+// It reports whether the recipient downloaded the file for the first time.
+// The file is only added to the downloaded files if it is not listed yet, so of several
+// downloads which finish at the same time only one is the first.
+func (rs RecipientService) RecordDownload(
+	ctx context.Context,
+	recipientValue *ent.Recipient,
+	fileValue *ent.File,
+) (bool, error) {
+	ctx, span := otel.NewSpan(ctx, "recordRecipientDownload")
+	defer span.End()
+	fileID := fileValue.ID.String()
+	tx, err := rs.db.Tx(ctx)
+	if err != nil {
+		return false, err
+	}
+	defer func() { _ = tx.Rollback() }()
+	added, err := tx.Recipient.Update().
+		Where(
+			recipient.ID(recipientValue.ID),
+			recipient.Or(
+				recipient.DownloadedFilesIsNil(),
+				recipient.Not(func(s *entsql.Selector) {
+					s.Where(sqljson.ValueContains(recipient.FieldDownloadedFiles, fileID))
+				}),
+			),
+		).
+		AppendDownloadedFiles([]string{fileID}).
+		Save(ctx)
+	if err != nil {
+		return false, err
+	}
+	err = tx.Recipient.UpdateOneID(recipientValue.ID).
+		SetLastDownload(time.Now()).
+		AddTimesDownloaded(1).
+		Exec(ctx)
+	if err != nil {
+		return false, err
+	}
+	return added > 0, tx.Commit()
+}
+
+type PublicRecipient struct {
+	ID    uuid.UUID `json:"id"`
+	Name  string    `json:"name"`
+	Email *string   `json:"email"`
+	// only known right after the recipient was created
+	Link            *string `json:"link"`
+	CreatedAt       string  `json:"createdAt"`
+	RevokedAt       *string `json:"revokedAt"`
+	TimesDownloaded uint64  `json:"timesDownloaded"`
+	LastDownloaded  *string `json:"lastDownloaded"`
+}
+
+func formatOptionalTime(value *time.Time) *string {
+	if value == nil {
+		return nil
+	}
+	formattedValue := value.UTC().Format(http.TimeFormat)
+	return &formattedValue
+}
+
+func (rs RecipientService) ToPublicRecipient(recipientValue *ent.Recipient) PublicRecipient {
+	return PublicRecipient{
+		ID:              recipientValue.ID,
+		Name:            recipientValue.Name,
+		Email:           recipientValue.Email,
+		CreatedAt:       recipientValue.CreatedAt.UTC().Format(http.TimeFormat),
+		RevokedAt:       formatOptionalTime(recipientValue.RevokedAt),
+		TimesDownloaded: recipientValue.TimesDownloaded,
+		LastDownloaded:  formatOptionalTime(recipientValue.LastDownload),
+	}
+}
+
+func NewRecipientService(cfg config.Config, db *ent.Client) RecipientService {
+	return RecipientService{cfg: cfg, db: db}
+}
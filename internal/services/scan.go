@@ -0,0 +1,205 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"slices"
+	"sync"
+	"time"
+
+	"codeberg.org/jvllmr/frans/internal/antivirus"
+	"codeberg.org/jvllmr/frans/internal/config"
+	"codeberg.org/jvllmr/frans/internal/ent"
+	"codeberg.org/jvllmr/frans/internal/ent/file"
+	"codeberg.org/jvllmr/frans/internal/ent/user"
+	"codeberg.org/jvllmr/frans/internal/otel"
+)
+
+var (
+	ErrFileScanPending = errors.New("file is still being scanned for malware")
+	ErrFileInfected    = errors.New("file was quarantined because malware was detected")
+)
+
+// CheckScanStatus fails unless a file was found to be clean
+func CheckScanStatus(fileValue *ent.File) error {
+	switch fileValue.ScanStatus {
+	case config.FileScanStatusClean:
+		return nil
+	case config.FileScanStatusInfected:
+		return ErrFileInfected
+	default:
+		return ErrFileScanPending
+	}
+}
+
+// InfectedFileNotifier informs users about a file which was quarantined.
+// It is implemented by mail.Mailer.
+type InfectedFileNotifier interface {
+	SendInfectedFileNotification(to string, fileValue *ent.File, signature string) error
+}
+
+// prevents that concurrent scan runs scan the same files
+var scanMutex sync.Mutex
+
+// FileScan counts the files which were scanned by a scan run
+type FileScan struct {
+	Clean    int
+	Infected int
+	Failed   int
+}
+
+type ScanService struct {
+	config   config.Config
+	db       *ent.Client
+	fs       FileService
+	clamd    *antivirus.Clamd
+	notifier InfectedFileNotifier
+}
+
+func (ss ScanService) Enabled() bool {
+	return ss.config.FilesAntivirus.Enabled
+}
+
+func (ss ScanService) scanFileData(
+	ctx context.Context,
+	fileData *ent.FileData,
+) (antivirus.ScanResult, error) {
+	reader, err := ss.fs.OpenFileData(ctx, fileData)
+	if err != nil {
+		return antivirus.ScanResult{}, err
+	}
+	defer func() { _ = reader.Close() }()
+	return ss.clamd.Scan(ctx, reader)
+}
+
+// notifyInfected informs the owner of a file and all admins about a detection
+func (ss ScanService) notifyInfected(ctx context.Context, fileValue *ent.File, signature string) {
+	recipients := []string{fileValue.Edges.Owner.Email}
+	adminEmails, err := ss.db.User.Query().
+		Where(user.IsAdmin(true)).
+		Select(user.FieldEmail).
+		Strings(ctx)
+	if err != nil {
+		slog.ErrorContext(ctx, "Could not query admins", "err", err)
+	}
+	for _, email := range adminEmails {
+		if !slices.Contains(recipients, email) {
+			recipients = append(recipients, email)
+		}
+	}
+	for _, email := range recipients {
+		err := ss.notifier.SendInfectedFileNotification(email, fileValue, signature)
+		if err != nil {
+			slog.ErrorContext(
+				ctx,
+				"Could not send malware notification",
+				"file",
+				fileValue.ID,
+				"err",
+				err,
+			)
+		}
+	}
+}
+
+// ScanPendingFiles scans all files which were not scanned yet.
+// Files are left pending if clamd could not scan them, so they are scanned again by the next run.
+func (ss ScanService) ScanPendingFiles(ctx context.Context) (*FileScan, error) {
+	ctx, span := otel.NewSpan(ctx, "scanPendingFiles")
+	defer span.End()
+	scanMutex.Lock()
+	defer scanMutex.Unlock()
+	scan := &FileScan{}
+	if !ss.Enabled() {
+		return scan, nil
+	}
+	files, err := ss.db.File.Query().
+		Where(file.ScanStatus(config.FileScanStatusPending)).
+		WithData().
+		WithOwner().
+		WithGrant().
+		WithTicket().
+		All(ctx)
+	if err != nil {
+		return scan, fmt.Errorf("scan files: %w", err)
+	}
+	// files with the same content only have to be scanned once
+	results := make(map[string]antivirus.ScanResult)
+	for _, fileValue := range files {
+		result, ok := results[fileValue.Edges.Data.ID]
+		if !ok {
+			result, err = ss.scanFileData(ctx, fileValue.Edges.Data)
+			if errors.Is(err, antivirus.ErrScanFailed) {
+				slog.WarnContext(ctx, "Could not scan file", "file", fileValue.ID, "err", err)
+				scan.Failed++
+				continue
+			}
+			if err != nil {
+				return scan, fmt.Errorf("scan files: %w", err)
+			}
+			results[fileValue.Edges.Data.ID] = result
+		}
+
+		fileUpdate := ss.db.File.UpdateOne(fileValue)
+		if result.Infected {
+			fileUpdate.
+				SetScanStatus(config.FileScanStatusInfected).
+				SetScanSignature(result.Signature)
+		} else {
+			fileUpdate.SetScanStatus(config.FileScanStatusClean)
+		}
+		if err := fileUpdate.Exec(ctx); err != nil {
+			if ent.IsNotFound(err) {
+				// the file was deleted while it was scanned
+				continue
+			}
+			return scan, fmt.Errorf("scan files: %w", err)
+		}
+		if !result.Infected {
+			scan.Clean++
+			continue
+		}
+		scan.Infected++
+		slog.WarnContext(
+			ctx,
+			"Malware detected",
+			"file",
+			fileValue.ID,
+			"owner",
+			fileValue.Edges.Owner.Username,
+			"signature",
+			result.Signature,
+		)
+		ss.notifyInfected(ctx, fileValue, result.Signature)
+	}
+	return scan, nil
+}
+
+// ScanPendingFilesInBackground scans new files without delaying the response to an upload
+func (ss ScanService) ScanPendingFilesInBackground() {
+	if !ss.Enabled() {
+		return
+	}
+	go func() {
+		if _, err := ss.ScanPendingFiles(context.Background()); err != nil {
+			slog.Error("Could not scan files", "err", err)
+		}
+	}()
+}
+
+func NewScanService(c config.Config, db *ent.Client, notifier InfectedFileNotifier) ScanService {
+	ss := ScanService{config: c, db: db, fs: NewFileService(c, db), notifier: notifier}
+	if c.FilesAntivirus.Enabled {
+		clamd, err := antivirus.NewClamd(
+			c.FilesAntivirus.Address,
+			time.Duration(c.FilesAntivirus.TimeoutSeconds)*time.Second,
+		)
+		if err != nil {
+			panic(err)
+		}
+		ss.clamd = clamd
+	}
+	return ss
+}
@@ -3,15 +3,20 @@ package services
 import (
 	"context"
 	"encoding/hex"
+	"errors"
 	"fmt"
-	"log/slog"
-	"mime/multipart"
 	"net/http"
+	"slices"
+	"strings"
 	"time"
 
 	"codeberg.org/jvllmr/frans/internal/config"
 	"codeberg.org/jvllmr/frans/internal/ent"
+	"codeberg.org/jvllmr/frans/internal/ent/file"
+	"codeberg.org/jvllmr/frans/internal/ent/shareaccesstoken"
 	"codeberg.org/jvllmr/frans/internal/ent/ticket"
+	"codeberg.org/jvllmr/frans/internal/ent/user"
+	"codeberg.org/jvllmr/frans/internal/otel"
 	"codeberg.org/jvllmr/frans/internal/util"
 	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
@@ -22,30 +27,51 @@ type TicketService struct {
 	fs  FileService
 }
 
+// TicketEstimatedExpiry returns when the last file of a ticket expires.
+// Files can override the expiry of the ticket, so the latest expiry of its files counts.
 func (ts TicketService) TicketEstimatedExpiry(ticketValue *ent.Ticket) *time.Time {
-	var latestDownload *time.Time = nil
-	for _, file := range ticketValue.Edges.Files {
-		if latestDownload == nil ||
-			(file.LastDownload != nil && latestDownload.Before(*file.LastDownload)) {
-			latestDownload = file.LastDownload
-		}
-	}
-
-	return estimatedExpiry(
-		ticketValue.ExpiryType,
-		ts.cfg.DefaultExpiryTotalDays,
-		ts.cfg.DefaultExpiryDaysSinceLastDownload,
-		ticketValue.ExpiryTotalDays,
-		ticketValue.ExpiryDaysSinceLastDownload,
-		ticketValue.CreatedAt,
-		latestDownload,
-	)
+	if len(ticketValue.Edges.Files) == 0 {
+		return estimatedExpiry(
+			ticketValue.ExpiryType,
+			ts.cfg.DefaultExpiryTotalDays,
+			ts.cfg.DefaultExpiryDaysSinceLastDownload,
+			ticketValue.ExpiryTotalDays,
+			ticketValue.ExpiryDaysSinceLastDownload,
+			ticketValue.CreatedAt,
+			nil,
+		)
+	}
+	var latestExpiry *time.Time
+	for _, fileValue := range ticketValue.Edges.Files {
+		fileExpiry := ts.fs.FileEstimatedExpiry(fileValue)
+		if fileExpiry == nil {
+			return nil
+		}
+		if latestExpiry == nil || fileExpiry.After(*latestExpiry) {
+			latestExpiry = fileExpiry
+		}
+	}
+	return latestExpiry
 }
 
 func (ts TicketService) TicketShareLink(ctx *gin.Context, ticket *ent.Ticket) string {
 	return fmt.Sprintf("%s/s/%s", ts.cfg.GetBaseURL(ctx.Request), ticket.ID.String())
 }
 
+// TicketShareFileURL returns the API URL a file of a shared ticket can be downloaded from
+func (ts TicketService) TicketShareFileURL(
+	ctx *gin.Context,
+	ticket *ent.Ticket,
+	fileValue *ent.File,
+) string {
+	return fmt.Sprintf(
+		"%s/api/v1/share/ticket/%s/file/%s",
+		strings.TrimSuffix(ts.cfg.GetBaseURL(ctx.Request), "/"),
+		ticket.ID.String(),
+		fileValue.ID.String(),
+	)
+}
+
 func (ts TicketService) ShouldDeleteTicket(ticketValue *ent.Ticket) bool {
 	estimatedExpiry := ts.TicketEstimatedExpiry(ticketValue)
 	now := time.Now()
@@ -66,6 +92,8 @@ type TicketFormParams struct {
 	EmailOnDownload             *[]string `form:"emailOnDownload[]"`
 	CreatorLang                 string    `form:"creatorLang"                 binding:"required"`
 	ReceiverLang                string    `form:"receiverLang"                binding:"required"`
+	Uploads                     []string  `form:"uploads[]"`
+	ViewsCountAsDownloads       bool      `form:"viewsCountAsDownloads"`
 }
 
 func (ts TicketService) CreateTicket(
@@ -73,7 +101,8 @@ func (ts TicketService) CreateTicket(
 	tx *ent.Tx,
 	user *ent.User,
 	form *TicketFormParams,
-	files []*multipart.FileHeader,
+	files []*StagedFile,
+	uploads []*ent.Upload,
 ) (*ent.Ticket, error) {
 	salt := util.GenerateSalt()
 
@@ -87,7 +116,8 @@ func (ts TicketService) CreateTicket(
 		SetHashedPassword(hashedPassword).
 		SetSalt(hex.EncodeToString(salt)).
 		SetOwner(user).
-		SetCreatorLang(form.CreatorLang)
+		SetCreatorLang(form.CreatorLang).
+		SetViewsCountAsDownloads(form.ViewsCountAsDownloads)
 
 	if form.Comment != nil {
 		ticketBuilder = ticketBuilder.SetComment(*form.Comment)
@@ -102,13 +132,44 @@ func (ts TicketService) CreateTicket(
 		return nil, err
 	}
 
+	ticketValue, err = ts.AddFiles(ctx, tx, ticketValue, user, files, uploads)
+	if err != nil {
+		return nil, err
+	}
+	err = tx.User.UpdateOne(user).AddSubmittedTickets(1).Exec(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return ticketValue, nil
+}
+
+// reloadTicket loads a ticket with everything needed to make it public
+func reloadTicket(ctx context.Context, tx *ent.Tx, ticketID uuid.UUID) (*ent.Ticket, error) {
+	return tx.Ticket.Query().
+		Where(ticket.ID(ticketID)).
+		WithFiles(func(fq *ent.FileQuery) { fq.WithData().WithOwner() }).
+		WithOwner().
+		Only(ctx)
+}
+
+// AddFiles stores files and finished uploads of user as files of ticketValue.
+// The files expire like the ticket. The ticket is returned with its files and owner.
+func (ts TicketService) AddFiles(
+	ctx context.Context,
+	tx *ent.Tx,
+	ticketValue *ent.Ticket,
+	user *ent.User,
+	files []*StagedFile,
+	uploads []*ent.Upload,
+) (*ent.Ticket, error) {
 	ts.fs.EnsureFilesTmpPath()
 
-	for _, fileHeader := range files {
-		dbFile, err := ts.fs.CreateFile(
+	for _, stagedFile := range files {
+		dbFile, err := ts.fs.CreateFileFromStaged(
 			ctx,
 			tx,
-			fileHeader,
+			stagedFile,
 			user,
 			ticketValue.ExpiryType,
 			ticketValue.ExpiryDaysSinceLastDownload,
@@ -119,59 +180,345 @@ func (ts TicketService) CreateTicket(
 			return nil, err
 		}
 
-		ticketValue, err = tx.Ticket.UpdateOne(ticketValue).
-			AddFiles(dbFile).
-			Save(ctx)
+		err = tx.Ticket.UpdateOne(ticketValue).AddFiles(dbFile).Exec(ctx)
 		if err != nil {
 			return nil, err
 		}
 	}
 
-	ticketValue, err = tx.Ticket.Query().
-		Where(ticket.ID(ticketValue.ID)).
-		WithFiles(func(fq *ent.FileQuery) { fq.WithData().WithOwner() }).
-		WithOwner().
-		Only(ctx)
+	for _, uploadValue := range uploads {
+		dbFile, err := ts.fs.CreateFileFromUpload(
+			ctx,
+			tx,
+			uploadValue,
+			user,
+			ticketValue.ExpiryType,
+			ticketValue.ExpiryDaysSinceLastDownload,
+			ticketValue.ExpiryTotalDays,
+			ticketValue.ExpiryTotalDownloads,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		err = tx.Ticket.UpdateOne(ticketValue).AddFiles(dbFile).Exec(ctx)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return reloadTicket(ctx, tx, ticketValue.ID)
+}
+
+// TicketReshareParams creates a ticket from files the user received through grants
+type TicketReshareParams struct {
+	Files                       []string  `json:"files"                       binding:"required,min=1,dive,uuid"`
+	Comment                     *string   `json:"comment"`
+	Email                       *[]string `json:"email"`
+	Password                    string    `json:"password"                    binding:"required"`
+	EmailPassword               bool      `json:"emailPassword"`
+	ExpiryType                  string    `json:"expiryType"                  binding:"required,oneof=auto single none custom"`
+	ExpiryTotalDays             uint8     `json:"expiryTotalDays"             binding:"required_if=ExpiryType custom"`
+	ExpiryDaysSinceLastDownload uint8     `json:"expiryDaysSinceLastDownload" binding:"required_if=ExpiryType custom"`
+	ExpiryTotalDownloads        uint8     `json:"expiryTotalDownloads"        binding:"required_if=ExpiryType custom"`
+	EmailOnDownload             *[]string `json:"emailOnDownload"`
+	CreatorLang                 string    `json:"creatorLang"                 binding:"required"`
+	ReceiverLang                string    `json:"receiverLang"`
+	ViewsCountAsDownloads       bool      `json:"viewsCountAsDownloads"`
+}
+
+func (trp TicketReshareParams) ticketForm() TicketFormParams {
+	return TicketFormParams{
+		Comment:                     trp.Comment,
+		Email:                       trp.Email,
+		Password:                    trp.Password,
+		EmailPassword:               trp.EmailPassword,
+		ExpiryType:                  trp.ExpiryType,
+		ExpiryTotalDays:             trp.ExpiryTotalDays,
+		ExpiryDaysSinceLastDownload: trp.ExpiryDaysSinceLastDownload,
+		ExpiryTotalDownloads:        trp.ExpiryTotalDownloads,
+		EmailOnDownload:             trp.EmailOnDownload,
+		CreatorLang:                 trp.CreatorLang,
+		ReceiverLang:                trp.ReceiverLang,
+		ViewsCountAsDownloads:       trp.ViewsCountAsDownloads,
+	}
+}
+
+var ErrFileNotReceived = errors.New("file was not received by the user through a grant")
+
+// ReceivedFiles returns the files with fileIDs which userValue received through a grant.
+// The files are loaded with their data.
+func (ts TicketService) ReceivedFiles(
+	ctx context.Context,
+	userValue *ent.User,
+	fileIDs []string,
+) ([]*ent.File, error) {
+	ctx, span := otel.NewSpan(ctx, "fetchReceivedFiles")
+	defer span.End()
+	ids := make([]uuid.UUID, 0, len(fileIDs))
+	for _, fileID := range fileIDs {
+		id, err := uuid.Parse(fileID)
+		if err != nil {
+			return nil, err
+		}
+		if !slices.Contains(ids, id) {
+			ids = append(ids, id)
+		}
+	}
+	files, err := ts.fs.db.File.Query().
+		Where(
+			file.IDIn(ids...),
+			file.HasOwnerWith(user.ID(userValue.ID)),
+			file.HasGrant(),
+			file.Not(file.HasTicket()),
+		).
+		WithData().
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(files) != len(ids) {
+		return nil, ErrFileNotReceived
+	}
+	return files, nil
+}
+
+// ReshareFiles creates a ticket of user with the content of files which were received
+// through grants. The new files reference the stored content of the received files
+// and expire like the new ticket. The ticket is returned with its files and owner.
+func (ts TicketService) ReshareFiles(
+	ctx context.Context,
+	tx *ent.Tx,
+	user *ent.User,
+	params *TicketReshareParams,
+	files []*ent.File,
+) (*ent.Ticket, error) {
+	ctx, span := otel.NewSpan(ctx, "reshareFiles")
+	defer span.End()
+	form := params.ticketForm()
+	ticketValue, err := ts.CreateTicket(ctx, tx, user, &form, nil, nil)
 	if err != nil {
 		return nil, err
 	}
-	if len(files) > 0 {
-		err = util.RefreshUserTotalDataSize(ctx, user, tx)
+	for _, source := range files {
+		dbFile, err := ts.fs.ReferenceFile(
+			ctx,
+			tx,
+			source,
+			user,
+			ticketValue.ExpiryType,
+			ticketValue.ExpiryDaysSinceLastDownload,
+			ticketValue.ExpiryTotalDays,
+			ticketValue.ExpiryTotalDownloads,
+		)
+		if err != nil {
+			return nil, err
+		}
+		err = tx.Ticket.UpdateOne(ticketValue).AddFiles(dbFile).Exec(ctx)
 		if err != nil {
-			slog.Error(
-				"Could not refresh total data size of user",
-				"err",
-				err,
-				"username",
-				user.Username,
-			)
 			return nil, err
 		}
 	}
-	err = tx.User.UpdateOne(user).AddSubmittedTickets(1).Exec(ctx)
+	return reloadTicket(ctx, tx, ticketValue.ID)
+}
+
+var (
+	ErrFileNotInTicket = errors.New("file does not belong to the ticket")
+	ErrLastTicketFile  = errors.New(
+		"the last file of a ticket cannot be removed, delete the ticket instead",
+	)
+)
+
+// TicketFilesFormParams adds files to an existing ticket
+type TicketFilesFormParams struct {
+	// recipients who are notified about the new files
+	Email        *[]string `form:"email[]"`
+	ReceiverLang string    `form:"receiverLang"`
+	Uploads      []string  `form:"uploads[]"`
+}
+
+// RemoveFile detaches a file from ticketValue and deletes it.
+// ticketValue has to be loaded with its files and their data.
+func (ts TicketService) RemoveFile(
+	ctx context.Context,
+	ticketValue *ent.Ticket,
+	fileID uuid.UUID,
+) error {
+	ctx, span := otel.NewSpan(ctx, "removeTicketFile")
+	defer span.End()
+	index := slices.IndexFunc(ticketValue.Edges.Files, func(f *ent.File) bool {
+		return f.ID == fileID
+	})
+	if index == -1 {
+		return fmt.Errorf("%w: %s", ErrFileNotInTicket, fileID)
+	}
+	if len(ticketValue.Edges.Files) == 1 {
+		return ErrLastTicketFile
+	}
+	return ts.fs.DeleteSingleFile(ctx, ticketValue.Edges.Files[index])
+}
+
+// FileExpiryParams overrides the expiry of a single file of a ticket
+type FileExpiryParams struct {
+	ID                          string `json:"id"                          binding:"required,uuid"`
+	ExpiryType                  string `json:"expiryType"                  binding:"required,oneof=auto single none custom"`
+	ExpiryTotalDays             uint8  `json:"expiryTotalDays"             binding:"required_if=ExpiryType custom"`
+	ExpiryDaysSinceLastDownload uint8  `json:"expiryDaysSinceLastDownload" binding:"required_if=ExpiryType custom"`
+	ExpiryTotalDownloads        uint8  `json:"expiryTotalDownloads"        binding:"required_if=ExpiryType custom"`
+}
+
+// TicketPatchParams changes an existing ticket. Fields which are not set are left unchanged.
+type TicketPatchParams struct {
+	// an empty comment removes the comment
+	Comment                     *string   `json:"comment"`
+	Password                    *string   `json:"password"                    binding:"omitempty,min=1"`
+	ExpiryType                  *string   `json:"expiryType"                  binding:"omitempty,oneof=auto single none custom"`
+	ExpiryTotalDays             *uint8    `json:"expiryTotalDays"`
+	ExpiryDaysSinceLastDownload *uint8    `json:"expiryDaysSinceLastDownload"`
+	ExpiryTotalDownloads        *uint8    `json:"expiryTotalDownloads"`
+	EmailOnDownload             *[]string `json:"emailOnDownload"`
+	ViewsCountAsDownloads       *bool     `json:"viewsCountAsDownloads"`
+	// expiry overrides for files; other files follow the expiry of the ticket
+	Files []FileExpiryParams `json:"files" binding:"omitempty,dive"`
+	// recipients who are notified about the changed ticket
+	Email         *[]string `json:"email"`
+	EmailPassword bool      `json:"emailPassword"`
+	ReceiverLang  string    `json:"receiverLang"`
+}
+
+func (tpp TicketPatchParams) changesExpiry() bool {
+	return tpp.ExpiryType != nil || tpp.ExpiryTotalDays != nil ||
+		tpp.ExpiryDaysSinceLastDownload != nil || tpp.ExpiryTotalDownloads != nil
+}
+
+// UpdateTicket applies params to ticketValue, which has to be loaded with its files.
+// Files take over a changed expiry of the ticket unless params override their expiry.
+// The updated ticket is returned with its files and owner.
+func (ts TicketService) UpdateTicket(
+	ctx context.Context,
+	tx *ent.Tx,
+	ticketValue *ent.Ticket,
+	params *TicketPatchParams,
+) (*ent.Ticket, error) {
+	ctx, span := otel.NewSpan(ctx, "updateTicket")
+	defer span.End()
+	fileExpiries := make(map[uuid.UUID]FileExpiryParams, len(params.Files))
+	for _, fileExpiry := range params.Files {
+		fileID := uuid.MustParse(fileExpiry.ID)
+		if !slices.ContainsFunc(ticketValue.Edges.Files, func(f *ent.File) bool {
+			return f.ID == fileID
+		}) {
+			return nil, fmt.Errorf("%w: %s", ErrFileNotInTicket, fileID)
+		}
+		fileExpiries[fileID] = fileExpiry
+	}
+
+	ticketUpdate := tx.Ticket.UpdateOne(ticketValue)
+	if params.Comment != nil {
+		if *params.Comment == "" {
+			ticketUpdate.ClearComment()
+		} else {
+			ticketUpdate.SetComment(*params.Comment)
+		}
+	}
+	if params.Password != nil {
+		salt := util.GenerateSalt()
+		ticketUpdate.
+			SetHashedPassword(util.HashPassword(*params.Password, salt)).
+			SetSalt(hex.EncodeToString(salt))
+	}
+	if params.ExpiryType != nil {
+		ticketUpdate.SetExpiryType(*params.ExpiryType)
+	}
+	if params.ExpiryTotalDays != nil {
+		ticketUpdate.SetExpiryTotalDays(*params.ExpiryTotalDays)
+	}
+	if params.ExpiryDaysSinceLastDownload != nil {
+		ticketUpdate.SetExpiryDaysSinceLastDownload(*params.ExpiryDaysSinceLastDownload)
+	}
+	if params.ExpiryTotalDownloads != nil {
+		ticketUpdate.SetExpiryTotalDownloads(*params.ExpiryTotalDownloads)
+	}
+	if params.EmailOnDownload != nil {
+		if len(*params.EmailOnDownload) == 0 {
+			ticketUpdate.ClearEmailOnDownload()
+		} else {
+			ticketUpdate.SetEmailOnDownload(*params.EmailOnDownload)
+		}
+	}
+	if params.ViewsCountAsDownloads != nil {
+		ticketUpdate.SetViewsCountAsDownloads(*params.ViewsCountAsDownloads)
+	}
+	updatedTicket, err := ticketUpdate.Save(ctx)
 	if err != nil {
 		return nil, err
 	}
+	if params.Password != nil {
+		// access tokens were issued for the old password
+		_, err := tx.ShareAccessToken.Delete().
+			Where(shareaccesstoken.HasTicketWith(ticket.ID(ticketValue.ID))).
+			Exec(ctx)
+		if err != nil {
+			return nil, err
+		}
+	}
 
-	return ticketValue, nil
+	for _, fileValue := range ticketValue.Edges.Files {
+		fileExpiry, overridden := fileExpiries[fileValue.ID]
+		if !overridden {
+			if !params.changesExpiry() {
+				continue
+			}
+			fileExpiry = FileExpiryParams{
+				ExpiryType:                  updatedTicket.ExpiryType,
+				ExpiryTotalDays:             updatedTicket.ExpiryTotalDays,
+				ExpiryDaysSinceLastDownload: updatedTicket.ExpiryDaysSinceLastDownload,
+				ExpiryTotalDownloads:        updatedTicket.ExpiryTotalDownloads,
+			}
+		}
+		err := tx.File.UpdateOne(fileValue).
+			SetExpiryType(fileExpiry.ExpiryType).
+			SetExpiryTotalDays(fileExpiry.ExpiryTotalDays).
+			SetExpiryDaysSinceLastDownload(fileExpiry.ExpiryDaysSinceLastDownload).
+			SetExpiryTotalDownloads(fileExpiry.ExpiryTotalDownloads).
+			Exec(ctx)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return reloadTicket(ctx, tx, ticketValue.ID)
 }
 
-func (ts TicketService) DeleteTicket(ctx context.Context, tx *ent.Tx, t *ent.Ticket) error {
+// DeleteTicket deletes a ticket with its files in tx. t has to be loaded with its files
+// and their data. The returned FileData lost their last file and have to be purged
+// with FileService.PurgeFileData after tx was committed.
+func (ts TicketService) DeleteTicket(
+	ctx context.Context,
+	tx *ent.Tx,
+	t *ent.Ticket,
+) ([]*ent.FileData, error) {
+	deletedData := make([]*ent.FileData, 0, len(t.Edges.Files))
 	for _, f := range t.Edges.Files {
-		if err := ts.fs.DeleteFile(ctx, f); err != nil {
-			return err
+		fileData, err := ts.fs.DeleteFile(ctx, tx, f)
+		if err != nil {
+			return nil, err
+		}
+		if fileData != nil {
+			deletedData = append(deletedData, fileData)
 		}
 	}
-	return tx.Ticket.DeleteOne(t).Exec(ctx)
+	return deletedData, tx.Ticket.DeleteOne(t).Exec(ctx)
 }
 
 type PublicTicket struct {
-	ID              uuid.UUID    `json:"id"`
-	Comment         *string      `json:"comment"`
-	EstimatedExpiry *string      `json:"estimatedExpiry"`
-	User            PublicUser   `json:"owner"`
-	Files           []PublicFile `json:"files"`
-	CreatedAt       string       `json:"createdAt"`
+	ID                    uuid.UUID    `json:"id"`
+	Comment               *string      `json:"comment"`
+	EstimatedExpiry       *string      `json:"estimatedExpiry"`
+	User                  PublicUser   `json:"owner"`
+	Files                 []PublicFile `json:"files"`
+	CreatedAt             string       `json:"createdAt"`
+	ViewsCountAsDownloads bool         `json:"viewsCountAsDownloads"`
 }
 
 func (ts TicketService) ToPublicTicket(ticket *ent.Ticket) PublicTicket {
@@ -187,12 +534,13 @@ func (ts TicketService) ToPublicTicket(ticket *ent.Ticket) PublicTicket {
 	}
 
 	return PublicTicket{
-		ID:              ticket.ID,
-		Comment:         ticket.Comment,
-		User:            ToPublicUser(ticket.Edges.Owner),
-		EstimatedExpiry: estimatedExpiryValue,
-		Files:           files,
-		CreatedAt:       ticket.CreatedAt.UTC().Format(http.TimeFormat),
+		ID:                    ticket.ID,
+		Comment:               ticket.Comment,
+		User:                  ToPublicUser(ticket.Edges.Owner),
+		EstimatedExpiry:       estimatedExpiryValue,
+		Files:                 files,
+		CreatedAt:             ticket.CreatedAt.UTC().Format(http.TimeFormat),
+		ViewsCountAsDownloads: ticket.ViewsCountAsDownloads,
 	}
 }
 
@@ -0,0 +1,289 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"time"
+
+	"codeberg.org/jvllmr/frans/internal/config"
+	"codeberg.org/jvllmr/frans/internal/ent"
+	"codeberg.org/jvllmr/frans/internal/ent/grant"
+	"codeberg.org/jvllmr/frans/internal/ent/predicate"
+	"codeberg.org/jvllmr/frans/internal/ent/upload"
+	"codeberg.org/jvllmr/frans/internal/ent/user"
+	"codeberg.org/jvllmr/frans/internal/otel"
+	"codeberg.org/jvllmr/frans/internal/storage"
+	"github.com/google/uuid"
+)
+
+var (
+	ErrUploadNotFound       = errors.New("upload not found")
+	ErrUploadIncomplete     = errors.New("upload is not finished yet")
+	ErrUploadOffsetMismatch = errors.New("upload offset does not match")
+	ErrUploadExceedsLength  = errors.New("upload exceeds declared length")
+)
+
+// UploadService manages resumable uploads. Their content is collected in chunks in the
+// storage backend until they are attached to a ticket or grant, so every instance of frans
+// which shares the backend can continue an upload.
+type UploadService struct {
+	config config.Config
+	db     *ent.Client
+	fs     FileService
+}
+
+const uploadChunkKeyPrefix = "uploads/"
+
+// uploadChunkKey returns the key of the chunk of an upload which starts at offset
+func uploadChunkKey(uploadID uuid.UUID, offset int64) string {
+	return fmt.Sprintf("%s%s/%020d", uploadChunkKeyPrefix, uploadID, offset)
+}
+
+// uploadIDOfChunkKey returns the ID of the upload a chunk key belongs to
+func uploadIDOfChunkKey(key string) (uuid.UUID, bool) {
+	uploadKey, found := strings.CutPrefix(key, uploadChunkKeyPrefix)
+	if !found {
+		return uuid.UUID{}, false
+	}
+	uploadID, _, _ := strings.Cut(uploadKey, "/")
+	parsedID, err := uuid.Parse(uploadID)
+	return parsedID, err == nil
+}
+
+// knownUploads returns the IDs of all existing uploads. Their chunks are no orphans.
+func (fs FileService) knownUploads(ctx context.Context) (map[uuid.UUID]bool, error) {
+	uploadIDs, err := fs.db.Upload.Query().IDs(ctx)
+	if err != nil {
+		return nil, err
+	}
+	knownUploads := make(map[uuid.UUID]bool, len(uploadIDs))
+	for _, uploadID := range uploadIDs {
+		knownUploads[uploadID] = true
+	}
+	return knownUploads, nil
+}
+
+// copyUpload writes the content of a finished upload to writer.
+// Every chunk starts where the previous one ended.
+func (fs FileService) copyUpload(
+	ctx context.Context,
+	uploadValue *ent.Upload,
+	writer io.Writer,
+) error {
+	for offset := int64(0); offset < uploadValue.Size; {
+		chunkReader, err := fs.storage.Get(ctx, uploadChunkKey(uploadValue.ID, offset))
+		if err != nil {
+			return err
+		}
+		written, err := io.Copy(writer, chunkReader)
+		_ = chunkReader.Close()
+		if err != nil {
+			return err
+		}
+		if written == 0 {
+			return fmt.Errorf("chunk of upload %s at offset %d is empty", uploadValue.ID, offset)
+		}
+		offset += written
+	}
+	return nil
+}
+
+// deleteUploadChunks deletes the chunks of an upload. A chunk of an interrupted write
+// after the last committed one is deleted as well.
+func (fs FileService) deleteUploadChunks(ctx context.Context, uploadID uuid.UUID) error {
+	for offset := int64(0); ; {
+		chunkKey := uploadChunkKey(uploadID, offset)
+		chunkInfo, err := fs.storage.Stat(ctx, chunkKey)
+		if errors.Is(err, storage.ErrBlobNotFound) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if err := fs.storage.Delete(ctx, chunkKey); err != nil &&
+			!errors.Is(err, storage.ErrBlobNotFound) {
+			return err
+		}
+		if chunkInfo.Size == 0 {
+			return nil
+		}
+		offset += chunkInfo.Size
+	}
+}
+
+func uploadScopePredicate(owner *ent.User, grantValue *ent.Grant) predicate.Upload {
+	if grantValue != nil {
+		return upload.HasGrantWith(grant.ID(grantValue.ID))
+	}
+	return upload.HasOwnerWith(user.ID(owner.ID))
+}
+
+func (us UploadService) CreateUpload(
+	ctx context.Context,
+	name string,
+	size int64,
+	owner *ent.User,
+	grantValue *ent.Grant,
+) (*ent.Upload, error) {
+	ctx, span := otel.NewSpan(ctx, "createUpload")
+	defer span.End()
+	if size > us.config.MaxSizes {
+		return nil, &ErrFileTooBig{size: size, maxSize: us.config.MaxSizes}
+	}
+	uploadBuilder := us.db.Upload.Create().
+		SetID(uuid.New()).
+		SetName(name).
+		SetSize(size).
+		SetExpiry(time.Now().Add(config.UploadExpiryHours * time.Hour))
+	if grantValue != nil {
+		uploadBuilder = uploadBuilder.SetGrant(grantValue)
+	} else {
+		uploadBuilder = uploadBuilder.SetOwner(owner)
+	}
+	uploadValue, err := uploadBuilder.Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("create upload: %w", err)
+	}
+	return uploadValue, nil
+}
+
+// GetUpload returns an upload which was created by the given user or through the given grant
+func (us UploadService) GetUpload(
+	ctx context.Context,
+	uploadID uuid.UUID,
+	owner *ent.User,
+	grantValue *ent.Grant,
+) (*ent.Upload, error) {
+	uploadValue, err := us.db.Upload.Query().
+		Where(upload.ID(uploadID), uploadScopePredicate(owner, grantValue)).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, ErrUploadNotFound
+		}
+		return nil, err
+	}
+	return uploadValue, nil
+}
+
+// GetFinishedUploads returns the finished uploads with the given ids which were created
+// by the given user or through the given grant
+func (us UploadService) GetFinishedUploads(
+	ctx context.Context,
+	uploadIDs []string,
+	owner *ent.User,
+	grantValue *ent.Grant,
+) ([]*ent.Upload, error) {
+	if len(uploadIDs) == 0 {
+		return nil, nil
+	}
+	parsedIDs := make([]uuid.UUID, len(uploadIDs))
+	for i, uploadID := range uploadIDs {
+		parsedID, err := uuid.Parse(uploadID)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %s", ErrUploadNotFound, uploadID)
+		}
+		parsedIDs[i] = parsedID
+	}
+	uploads, err := us.db.Upload.Query().
+		Where(upload.IDIn(parsedIDs...), uploadScopePredicate(owner, grantValue)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(uploads) != len(parsedIDs) {
+		return nil, ErrUploadNotFound
+	}
+	for _, uploadValue := range uploads {
+		if uploadValue.Offset != uploadValue.Size {
+			return nil, fmt.Errorf("%w: %s", ErrUploadIncomplete, uploadValue.ID)
+		}
+	}
+	return uploads, nil
+}
+
+// WriteUpload appends the content of reader to the upload at offset.
+// Received data is kept even if reading fails, so the client can resume at the new offset.
+// The content is collected in a temporary file first and only stored as chunk of the upload
+// after offset was claimed in the database, so concurrent writes at the same offset cannot
+// overwrite each other. All but one of them fail with ErrUploadOffsetMismatch.
+func (us UploadService) WriteUpload(
+	ctx context.Context,
+	uploadValue *ent.Upload,
+	offset int64,
+	reader io.Reader,
+) (*ent.Upload, error) {
+	ctx, span := otel.NewSpan(ctx, "writeUpload")
+	defer span.End()
+	if offset != uploadValue.Offset {
+		return nil, ErrUploadOffsetMismatch
+	}
+	us.fs.EnsureFilesTmpPath()
+	chunkPath := us.fs.FilesTmpFilePath()
+	chunkHandle, err := os.Create(chunkPath)
+	if err != nil {
+		return nil, fmt.Errorf("write upload: %w", err)
+	}
+	defer func() { _ = os.Remove(chunkPath) }()
+	remaining := uploadValue.Size - offset
+	written, copyErr := io.Copy(chunkHandle, io.LimitReader(reader, remaining))
+	if copyErr == nil && written == remaining {
+		if n, _ := reader.Read(make([]byte, 1)); n > 0 {
+			copyErr = ErrUploadExceedsLength
+		}
+	}
+	if err := chunkHandle.Close(); err != nil {
+		return nil, fmt.Errorf("write upload: %w", err)
+	}
+
+	tx, err := us.db.Tx(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("write upload: %w", err)
+	}
+	defer func() { _ = tx.Rollback() }()
+	// the row stays locked until the chunk was stored
+	claimed, err := tx.Upload.Update().
+		Where(upload.ID(uploadValue.ID), upload.Offset(offset)).
+		SetOffset(offset + written).
+		Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("write upload: %w", err)
+	}
+	if claimed == 0 {
+		return nil, ErrUploadOffsetMismatch
+	}
+	// a chunk of a previously interrupted write at the same offset is replaced
+	if written > 0 {
+		err := storage.PutFile(ctx, us.fs.storage, uploadChunkKey(uploadValue.ID, offset), chunkPath)
+		if err != nil {
+			return nil, fmt.Errorf("write upload: %w", err)
+		}
+	}
+	uploadValue, err = tx.Upload.Get(ctx, uploadValue.ID)
+	if err != nil {
+		return nil, fmt.Errorf("write upload: %w", err)
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("write upload: %w", err)
+	}
+	return uploadValue, copyErr
+}
+
+func (us UploadService) DeleteUpload(ctx context.Context, uploadValue *ent.Upload) error {
+	if err := us.fs.deleteUploadChunks(ctx, uploadValue.ID); err != nil {
+		return err
+	}
+	return us.db.Upload.DeleteOne(uploadValue).Exec(ctx)
+}
+
+func (us UploadService) ShouldDeleteUpload(uploadValue *ent.Upload) bool {
+	return uploadValue.Expiry.Before(time.Now())
+}
+
+func NewUploadService(cfg config.Config, db *ent.Client) UploadService {
+	return UploadService{config: cfg, db: db, fs: NewFileService(cfg, db)}
+}
@@ -25,6 +25,7 @@ type AdminViewUser struct {
 	ActiveGrants     int   `json:"activeGrants"`
 	SubmittedGrants  int   `json:"submittedGrants"`
 	TotalDataSize    int64 `json:"totalDataSize"`
+	PhysicalDataSize int64 `json:"physicalDataSize"`
 }
 
 func ToAdminViewUser(user *ent.User, activeTickets int, activeGrants int) AdminViewUser {
@@ -40,5 +41,11 @@ func ToAdminViewUser(user *ent.User, activeTickets int, activeGrants int) AdminV
 		ActiveGrants:     activeGrants,
 		SubmittedGrants:  user.SubmittedGrants,
 		TotalDataSize:    user.TotalDataSize,
+		PhysicalDataSize: user.PhysicalDataSize,
 	}
 }
+
+type CurrentUser struct {
+	AdminViewUser
+	Quota PublicQuota `json:"quota"`
+}
@@ -0,0 +1,184 @@
+package services
+
+import (
+	"context"
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"codeberg.org/jvllmr/frans/internal/db"
+	"codeberg.org/jvllmr/frans/internal/encryption"
+	"codeberg.org/jvllmr/frans/internal/ent"
+	"codeberg.org/jvllmr/frans/internal/ent/storageverification"
+	"codeberg.org/jvllmr/frans/internal/otel"
+	"codeberg.org/jvllmr/frans/internal/storage"
+)
+
+// number of verification runs which are kept in the database
+const keptStorageVerifications = 10
+
+// verifyFileData rehashes the content of fileData. Since the ID of a FileData is
+// the sha512 of its content, any difference means the blob was altered.
+// A missing sha256 of an intact blob is filled in on the way.
+func (fs FileService) verifyFileData(ctx context.Context, fileData *ent.FileData) *storage.Issue {
+	blobInfo, err := fs.locateBlob(ctx, fileData.ID)
+	if err != nil {
+		blobKey := fs.BlobKey(fileData.ID)
+		if errors.Is(err, storage.ErrBlobNotFound) {
+			return &storage.Issue{Kind: storage.IssueMissing, Key: blobKey}
+		}
+		return &storage.Issue{Kind: storage.IssueUnreadable, Key: blobKey, Detail: err.Error()}
+	}
+	blobKey := blobInfo.Key
+	if expectedSize := db.StoredBlobSize(fileData); blobInfo.Size != expectedSize {
+		return &storage.Issue{
+			Kind:   storage.IssueMismatch,
+			Key:    blobKey,
+			Detail: fmt.Sprintf("expected %d bytes, but found %d bytes", expectedSize, blobInfo.Size),
+		}
+	}
+
+	reader, err := fs.OpenFileData(ctx, fileData)
+	if err != nil {
+		return &storage.Issue{Kind: storage.IssueUnreadable, Key: blobKey, Detail: err.Error()}
+	}
+	defer func() { _ = reader.Close() }()
+	hasher := sha512.New()
+	sha256Hasher := sha256.New()
+	if _, err := io.Copy(io.MultiWriter(hasher, sha256Hasher), reader); err != nil {
+		kind := storage.IssueUnreadable
+		if errors.Is(err, encryption.ErrCorruptedBlob) || errors.Is(err, io.ErrUnexpectedEOF) {
+			kind = storage.IssueMismatch
+		}
+		return &storage.Issue{Kind: kind, Key: blobKey, Detail: err.Error()}
+	}
+	if sha := hex.EncodeToString(hasher.Sum(nil)); sha != fileData.ID {
+		return &storage.Issue{
+			Kind:   storage.IssueMismatch,
+			Key:    blobKey,
+			Detail: fmt.Sprintf("content hash is %s", sha),
+		}
+	}
+	if fileData.Sha256 == nil {
+		err := fs.db.FileData.UpdateOne(fileData).
+			SetSha256(hex.EncodeToString(sha256Hasher.Sum(nil))).
+			Exec(ctx)
+		if err != nil {
+			slog.Warn("could not save sha256 of blob", "key", blobKey, "err", err)
+		}
+	}
+	return nil
+}
+
+// VerifyStorage rehashes all stored blobs and looks for blobs without FileData.
+// The result is saved as StorageVerification.
+func (fs FileService) VerifyStorage(ctx context.Context) (*ent.StorageVerification, error) {
+	ctx, span := otel.NewSpan(ctx, "verifyStorage")
+	defer span.End()
+	verification, err := fs.db.StorageVerification.Create().Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("verify storage: %w", err)
+	}
+
+	fileDatas, err := fs.db.FileData.Query().All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("verify storage: %w", err)
+	}
+	issues := make([]storage.Issue, 0)
+	knownKeys := make(map[string]bool, len(fileDatas))
+	for _, fileData := range fileDatas {
+		for _, blobKey := range fs.blobKeys(fileData.ID) {
+			knownKeys[blobKey] = true
+		}
+		for _, previewKey := range fs.previewKeys(fileData.ID) {
+			knownKeys[previewKey] = true
+		}
+		if issue := fs.verifyFileData(ctx, fileData); issue != nil {
+			slog.Warn("Storage verification found issue", "kind", issue.Kind, "key", issue.Key)
+			issues = append(issues, *issue)
+		}
+	}
+
+	knownUploads, err := fs.knownUploads(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("verify storage: %w", err)
+	}
+	for blobInfo, err := range fs.storage.List(ctx) {
+		if err != nil {
+			return nil, fmt.Errorf("verify storage: %w", err)
+		}
+		if uploadID, ok := uploadIDOfChunkKey(blobInfo.Key); ok && knownUploads[uploadID] {
+			continue
+		}
+		if !knownKeys[blobInfo.Key] {
+			slog.Warn("Storage verification found orphaned blob", "key", blobInfo.Key)
+			issues = append(issues, storage.Issue{Kind: storage.IssueOrphaned, Key: blobInfo.Key})
+		}
+	}
+
+	verification, err = fs.db.StorageVerification.UpdateOne(verification).
+		SetFinishedAt(time.Now()).
+		SetCheckedBlobs(int64(len(fileDatas))).
+		SetIssues(issues).
+		Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("verify storage: %w", err)
+	}
+	outdatedIDs, err := fs.db.StorageVerification.Query().
+		Order(ent.Desc(storageverification.FieldStartedAt)).
+		Offset(keptStorageVerifications).
+		IDs(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("verify storage: %w", err)
+	}
+	if len(outdatedIDs) > 0 {
+		_, err = fs.db.StorageVerification.Delete().
+			Where(storageverification.IDIn(outdatedIDs...)).
+			Exec(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("verify storage: %w", err)
+		}
+	}
+	return verification, nil
+}
+
+// LatestStorageVerification returns the most recently finished storage verification
+func (fs FileService) LatestStorageVerification(
+	ctx context.Context,
+) (*ent.StorageVerification, error) {
+	return fs.db.StorageVerification.Query().
+		Where(storageverification.FinishedAtNotNil()).
+		Order(ent.Desc(storageverification.FieldFinishedAt)).
+		First(ctx)
+}
+
+type PublicStorageVerification struct {
+	StartedAt    string          `json:"startedAt"`
+	FinishedAt   *string         `json:"finishedAt"`
+	CheckedBlobs int64           `json:"checkedBlobs"`
+	Issues       []storage.Issue `json:"issues"`
+}
+
+func ToPublicStorageVerification(verification *ent.StorageVerification) PublicStorageVerification {
+	var finishedAt *string
+	if verification.FinishedAt != nil {
+		formattedValue := verification.FinishedAt.UTC().Format(http.TimeFormat)
+		finishedAt = &formattedValue
+	}
+	issues := verification.Issues
+	if issues == nil {
+		issues = []storage.Issue{}
+	}
+	return PublicStorageVerification{
+		StartedAt:    verification.StartedAt.UTC().Format(http.TimeFormat),
+		FinishedAt:   finishedAt,
+		CheckedBlobs: verification.CheckedBlobs,
+		Issues:       issues,
+	}
+}
@@ -0,0 +1,19 @@
+package storage
+
+import "errors"
+
+var ErrDiskUsageUnsupported = errors.New("disk usage is not supported on this platform")
+
+// DiskUsage describes the capacity of the volume behind a path
+type DiskUsage struct {
+	Total uint64
+	Free  uint64
+}
+
+// UsedPercent returns the share of the volume which is not available anymore
+func (du DiskUsage) UsedPercent() float64 {
+	if du.Total == 0 {
+		return 0
+	}
+	return float64(du.Total-du.Free) / float64(du.Total) * 100
+}
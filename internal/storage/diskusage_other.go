@@ -0,0 +1,7 @@
+//go:build !linux && !darwin && !freebsd && !windows
+
+package storage
+
+func GetDiskUsage(path string) (DiskUsage, error) {
+	return DiskUsage{}, ErrDiskUsageUnsupported
+}
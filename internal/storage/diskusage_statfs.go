@@ -0,0 +1,19 @@
+//go:build linux || darwin || freebsd
+
+package storage
+
+import "golang.org/x/sys/unix"
+
+// GetDiskUsage returns the capacity of the volume behind path.
+// Free only counts space which is available to unprivileged users.
+func GetDiskUsage(path string) (DiskUsage, error) {
+	var stat unix.Statfs_t
+	if err := unix.Statfs(path, &stat); err != nil {
+		return DiskUsage{}, err
+	}
+	blockSize := uint64(stat.Bsize)
+	return DiskUsage{
+		Total: uint64(stat.Blocks) * blockSize,
+		Free:  uint64(stat.Bavail) * blockSize,
+	}, nil
+}
@@ -0,0 +1,19 @@
+//go:build windows
+
+package storage
+
+import "golang.org/x/sys/windows"
+
+// GetDiskUsage returns the capacity of the volume behind path.
+// Free only counts space which is available to the current user.
+func GetDiskUsage(path string) (DiskUsage, error) {
+	pathPtr, err := windows.UTF16PtrFromString(path)
+	if err != nil {
+		return DiskUsage{}, err
+	}
+	var free, total, totalFree uint64
+	if err := windows.GetDiskFreeSpaceEx(pathPtr, &free, &total, &totalFree); err != nil {
+		return DiskUsage{}, err
+	}
+	return DiskUsage{Total: total, Free: free}, nil
+}
@@ -0,0 +1,16 @@
+package storage
+
+// Kinds of integrity issues found when verifying stored blobs
+const (
+	IssueMissing    = "missing"
+	IssueMismatch   = "mismatch"
+	IssueOrphaned   = "orphaned"
+	IssueUnreadable = "unreadable"
+)
+
+// Issue describes a blob which does not match the database
+type Issue struct {
+	Kind   string `json:"kind"`
+	Key    string `json:"key"`
+	Detail string `json:"detail,omitempty"`
+}
@@ -0,0 +1,173 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"iter"
+	"os"
+	"path/filepath"
+)
+
+type LocalBackend struct {
+	dir string
+}
+
+func (lb *LocalBackend) blobPath(key string) string {
+	return filepath.Join(lb.dir, filepath.FromSlash(key))
+}
+
+func (lb *LocalBackend) tmpPath() string {
+	return filepath.Join(lb.dir, "tmp")
+}
+
+func mapLocalError(err error) error {
+	if errors.Is(err, fs.ErrNotExist) {
+		return fmt.Errorf("%w: %w", ErrBlobNotFound, err)
+	}
+	return err
+}
+
+// Put implements Backend.
+func (lb *LocalBackend) Put(ctx context.Context, key string, reader io.Reader, size int64) error {
+	if err := os.MkdirAll(lb.tmpPath(), 0775); err != nil {
+		return fmt.Errorf("put blob: %w", err)
+	}
+	tmpFileHandle, err := os.CreateTemp(lb.tmpPath(), "put-*")
+	if err != nil {
+		return fmt.Errorf("put blob: %w", err)
+	}
+	tmpFilePath := tmpFileHandle.Name()
+	defer func() { _ = os.Remove(tmpFilePath) }()
+
+	_, err = io.Copy(tmpFileHandle, reader)
+	if closeErr := tmpFileHandle.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return fmt.Errorf("put blob: %w", err)
+	}
+	return lb.MoveFile(ctx, key, tmpFilePath)
+}
+
+// Get implements Backend.
+func (lb *LocalBackend) Get(ctx context.Context, key string) (io.ReadCloser, error) {
+	fileHandle, err := os.Open(lb.blobPath(key))
+	if err != nil {
+		return nil, mapLocalError(err)
+	}
+	return fileHandle, nil
+}
+
+type limitedReadCloser struct {
+	io.Reader
+	io.Closer
+}
+
+// GetRange implements Backend.
+func (lb *LocalBackend) GetRange(
+	ctx context.Context,
+	key string,
+	offset int64,
+	length int64,
+) (io.ReadCloser, error) {
+	fileHandle, err := os.Open(lb.blobPath(key))
+	if err != nil {
+		return nil, mapLocalError(err)
+	}
+	if _, err := fileHandle.Seek(offset, io.SeekStart); err != nil {
+		_ = fileHandle.Close()
+		return nil, err
+	}
+	if length < 0 {
+		return fileHandle, nil
+	}
+	return limitedReadCloser{Reader: io.LimitReader(fileHandle, length), Closer: fileHandle}, nil
+}
+
+// Stat implements Backend.
+func (lb *LocalBackend) Stat(ctx context.Context, key string) (BlobInfo, error) {
+	info, err := os.Stat(lb.blobPath(key))
+	if err != nil {
+		return BlobInfo{}, mapLocalError(err)
+	}
+	return BlobInfo{Key: key, Size: info.Size(), ModTime: info.ModTime()}, nil
+}
+
+// Delete implements Backend.
+func (lb *LocalBackend) Delete(ctx context.Context, key string) error {
+	return mapLocalError(os.Remove(lb.blobPath(key)))
+}
+
+// List implements Backend. The temporary directory and files of others are skipped.
+func (lb *LocalBackend) List(ctx context.Context) iter.Seq2[BlobInfo, error] {
+	return func(yield func(BlobInfo, error) bool) {
+		err := filepath.WalkDir(lb.dir, func(path string, entry fs.DirEntry, err error) error {
+			if err != nil {
+				if path == lb.dir && errors.Is(err, fs.ErrNotExist) {
+					return fs.SkipAll
+				}
+				return err
+			}
+			if entry.IsDir() {
+				if path == lb.tmpPath() {
+					return fs.SkipDir
+				}
+				return nil
+			}
+			if !entry.Type().IsRegular() {
+				return nil
+			}
+			relativePath, err := filepath.Rel(lb.dir, path)
+			if err != nil {
+				return err
+			}
+			key := filepath.ToSlash(relativePath)
+			if !isBlobKey(key) {
+				return nil
+			}
+			info, err := entry.Info()
+			if err != nil {
+				return err
+			}
+			blobInfo := BlobInfo{
+				Key:     key,
+				Size:    info.Size(),
+				ModTime: info.ModTime(),
+			}
+			if !yield(blobInfo, nil) {
+				return fs.SkipAll
+			}
+			return ctx.Err()
+		})
+		if err != nil {
+			yield(BlobInfo{}, fmt.Errorf("list blobs: %w", err))
+		}
+	}
+}
+
+// MoveFile implements FileMover.
+func (lb *LocalBackend) MoveFile(ctx context.Context, key string, path string) error {
+	targetPath := lb.blobPath(key)
+	if err := os.MkdirAll(filepath.Dir(targetPath), 0775); err != nil {
+		return fmt.Errorf("move blob: %w", err)
+	}
+	if err := os.Rename(path, targetPath); err != nil {
+		return fmt.Errorf("move blob: %w", err)
+	}
+	return nil
+}
+
+// MoveBlob implements BlobMover.
+func (lb *LocalBackend) MoveBlob(ctx context.Context, fromKey string, toKey string) error {
+	if err := lb.MoveFile(ctx, toKey, lb.blobPath(fromKey)); err != nil {
+		return mapLocalError(err)
+	}
+	return nil
+}
+
+func NewLocalBackend(dir string) *LocalBackend {
+	return &LocalBackend{dir: dir}
+}
@@ -0,0 +1,85 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"io"
+)
+
+// blobReadSeeker lazily opens range reads on a backend so that blobs can be seeked
+// without being downloaded as a whole.
+type blobReadSeeker struct {
+	ctx     context.Context
+	backend Backend
+	key     string
+	size    int64
+	offset  int64
+	current io.ReadCloser
+}
+
+func (brs *blobReadSeeker) Read(p []byte) (int, error) {
+	if brs.offset >= brs.size {
+		return 0, io.EOF
+	}
+	if brs.current == nil {
+		reader, err := brs.backend.GetRange(brs.ctx, brs.key, brs.offset, brs.size-brs.offset)
+		if err != nil {
+			return 0, err
+		}
+		brs.current = reader
+	}
+	n, err := brs.current.Read(p)
+	brs.offset += int64(n)
+	if errors.Is(err, io.EOF) && brs.offset < brs.size {
+		err = io.ErrUnexpectedEOF
+	}
+	return n, err
+}
+
+func (brs *blobReadSeeker) Seek(offset int64, whence int) (int64, error) {
+	var newOffset int64
+	switch whence {
+	case io.SeekStart:
+		newOffset = offset
+	case io.SeekCurrent:
+		newOffset = brs.offset + offset
+	case io.SeekEnd:
+		newOffset = brs.size + offset
+	default:
+		return 0, errors.New("seek: invalid whence")
+	}
+	if newOffset < 0 {
+		return 0, errors.New("seek: negative position")
+	}
+	if newOffset != brs.offset {
+		if err := brs.closeCurrent(); err != nil {
+			return 0, err
+		}
+		brs.offset = newOffset
+	}
+	return brs.offset, nil
+}
+
+func (brs *blobReadSeeker) closeCurrent() error {
+	if brs.current == nil {
+		return nil
+	}
+	err := brs.current.Close()
+	brs.current = nil
+	return err
+}
+
+func (brs *blobReadSeeker) Close() error {
+	return brs.closeCurrent()
+}
+
+// NewReadSeeker returns a reader for the blob stored under key which supports seeking
+// through range reads. size has to be the size of the stored blob.
+func NewReadSeeker(
+	ctx context.Context,
+	backend Backend,
+	key string,
+	size int64,
+) io.ReadSeekCloser {
+	return &blobReadSeeker{ctx: ctx, backend: backend, key: key, size: size}
+}
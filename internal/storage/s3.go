@@ -0,0 +1,174 @@
+package storage
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"iter"
+	"strings"
+
+	"codeberg.org/jvllmr/frans/internal/config"
+	"github.com/minio/minio-go/v7"
+	"github.com/minio/minio-go/v7/pkg/credentials"
+)
+
+type S3Backend struct {
+	client *minio.Client
+	bucket string
+	prefix string
+}
+
+func (sb *S3Backend) objectName(key string) string {
+	return sb.prefix + key
+}
+
+func mapS3Error(err error) error {
+	if err == nil {
+		return nil
+	}
+	code := minio.ToErrorResponse(err).Code
+	if code == minio.NoSuchKey || code == "NotFound" {
+		return fmt.Errorf("%w: %w", ErrBlobNotFound, err)
+	}
+	return err
+}
+
+// Put implements Backend.
+func (sb *S3Backend) Put(ctx context.Context, key string, reader io.Reader, size int64) error {
+	_, err := sb.client.PutObject(
+		ctx,
+		sb.bucket,
+		sb.objectName(key),
+		reader,
+		size,
+		minio.PutObjectOptions{ContentType: "application/octet-stream"},
+	)
+	if err != nil {
+		return fmt.Errorf("put blob: %w", err)
+	}
+	return nil
+}
+
+func (sb *S3Backend) getObject(
+	ctx context.Context,
+	key string,
+	opts minio.GetObjectOptions,
+) (io.ReadCloser, error) {
+	object, err := sb.client.GetObject(ctx, sb.bucket, sb.objectName(key), opts)
+	if err != nil {
+		return nil, mapS3Error(err)
+	}
+	// GetObject is lazy, Stat issues the request and surfaces missing objects
+	if _, err := object.Stat(); err != nil {
+		_ = object.Close()
+		return nil, mapS3Error(err)
+	}
+	return object, nil
+}
+
+// Get implements Backend.
+func (sb *S3Backend) Get(ctx context.Context, key string) (io.ReadCloser, error) {
+	return sb.getObject(ctx, key, minio.GetObjectOptions{})
+}
+
+// GetRange implements Backend.
+func (sb *S3Backend) GetRange(
+	ctx context.Context,
+	key string,
+	offset int64,
+	length int64,
+) (io.ReadCloser, error) {
+	opts := minio.GetObjectOptions{}
+	var err error
+	switch {
+	case length == 0:
+		return io.NopCloser(strings.NewReader("")), nil
+	case length > 0:
+		err = opts.SetRange(offset, offset+length-1)
+	case offset > 0:
+		// an end of 0 requests everything from offset onwards
+		err = opts.SetRange(offset, 0)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return sb.getObject(ctx, key, opts)
+}
+
+// Stat implements Backend.
+func (sb *S3Backend) Stat(ctx context.Context, key string) (BlobInfo, error) {
+	info, err := sb.client.StatObject(
+		ctx,
+		sb.bucket,
+		sb.objectName(key),
+		minio.StatObjectOptions{},
+	)
+	if err != nil {
+		return BlobInfo{}, mapS3Error(err)
+	}
+	return BlobInfo{Key: key, Size: info.Size, ModTime: info.LastModified}, nil
+}
+
+// Delete implements Backend.
+func (sb *S3Backend) Delete(ctx context.Context, key string) error {
+	return mapS3Error(
+		sb.client.RemoveObject(ctx, sb.bucket, sb.objectName(key), minio.RemoveObjectOptions{}),
+	)
+}
+
+// MoveBlob implements BlobMover. The object is copied within the bucket and removed afterwards.
+func (sb *S3Backend) MoveBlob(ctx context.Context, fromKey string, toKey string) error {
+	_, err := sb.client.CopyObject(
+		ctx,
+		minio.CopyDestOptions{Bucket: sb.bucket, Object: sb.objectName(toKey)},
+		minio.CopySrcOptions{Bucket: sb.bucket, Object: sb.objectName(fromKey)},
+	)
+	if err != nil {
+		return fmt.Errorf("move blob: %w", mapS3Error(err))
+	}
+	return sb.Delete(ctx, fromKey)
+}
+
+// List implements Backend.
+func (sb *S3Backend) List(ctx context.Context) iter.Seq2[BlobInfo, error] {
+	return func(yield func(BlobInfo, error) bool) {
+		// stops the listing goroutine of the client if iteration ends early
+		ctx, cancel := context.WithCancel(ctx)
+		defer cancel()
+		objects := sb.client.ListObjects(
+			ctx,
+			sb.bucket,
+			minio.ListObjectsOptions{Prefix: sb.prefix, Recursive: true},
+		)
+		for object := range objects {
+			if object.Err != nil {
+				yield(BlobInfo{}, fmt.Errorf("list blobs: %w", object.Err))
+				return
+			}
+			key := strings.TrimPrefix(object.Key, sb.prefix)
+			if !isBlobKey(key) {
+				continue
+			}
+			blobInfo := BlobInfo{
+				Key:     key,
+				Size:    object.Size,
+				ModTime: object.LastModified,
+			}
+			if !yield(blobInfo, nil) {
+				return
+			}
+		}
+	}
+}
+
+func NewS3Backend(cfg config.FilesS3Config) (*S3Backend, error) {
+	client, err := minio.New(cfg.Endpoint, &minio.Options{
+		Creds:  credentials.NewStaticV4(cfg.AccessKeyID, cfg.SecretAccessKey, ""),
+		Secure: !cfg.Insecure,
+		Region: cfg.Region,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("create s3 client: %w", err)
+	}
+	return &S3Backend{client: client, bucket: cfg.Bucket, prefix: cfg.Prefix}, nil
+}
@@ -0,0 +1,128 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"iter"
+	"os"
+	"path"
+	"regexp"
+	"time"
+
+	"codeberg.org/jvllmr/frans/internal/config"
+)
+
+var ErrBlobNotFound = errors.New("blob not found")
+
+type BlobInfo struct {
+	Key     string
+	Size    int64
+	ModTime time.Time
+}
+
+// Backend stores blobs addressed by a key.
+type Backend interface {
+	Put(ctx context.Context, key string, reader io.Reader, size int64) error
+	Get(ctx context.Context, key string) (io.ReadCloser, error)
+	// GetRange reads length bytes starting at offset. A negative length reads until the end.
+	GetRange(ctx context.Context, key string, offset int64, length int64) (io.ReadCloser, error)
+	Stat(ctx context.Context, key string) (BlobInfo, error)
+	Delete(ctx context.Context, key string) error
+	// List iterates over all stored blobs. Keys which were not written by frans are skipped.
+	// Iteration stops after the first error.
+	List(ctx context.Context) iter.Seq2[BlobInfo, error]
+}
+
+// FileMover is implemented by backends which can take over a local file without copying it.
+type FileMover interface {
+	MoveFile(ctx context.Context, key string, path string) error
+}
+
+// BlobMover is implemented by backends which can rename a blob without copying its content
+// through frans.
+type BlobMover interface {
+	MoveBlob(ctx context.Context, fromKey string, toKey string) error
+}
+
+// ShardedKey spreads keys over two levels of prefixes taken from the key itself,
+// e.g. abcdef becomes ab/cd/abcdef.
+func ShardedKey(key string) string {
+	if len(key) < 4 {
+		return key
+	}
+	return path.Join(key[0:2], key[2:4], key)
+}
+
+var (
+	// a sha512 hex digest, optionally followed by the suffix of previews
+	blobNamePattern = regexp.MustCompile(`^[0-9a-f]{128}(\.preview)?$`)
+	// chunks of resumable uploads are stored under uploads/<upload id>/<offset>
+	uploadChunkKeyPattern = regexp.MustCompile(`^uploads/[0-9a-f-]{36}/[0-9]{20}$`)
+)
+
+// isBlobKey reports whether key was written by frans, either as a blob or preview
+// in the flat or sharded layout or as a chunk of a resumable upload.
+// Backends only list such keys, so files of others in the same directory or bucket are left alone.
+func isBlobKey(key string) bool {
+	if uploadChunkKeyPattern.MatchString(key) {
+		return true
+	}
+	name := path.Base(key)
+	if !blobNamePattern.MatchString(name) {
+		return false
+	}
+	return key == name || key == ShardedKey(name)
+}
+
+// MoveBlob moves the blob stored under fromKey to toKey. An existing blob under toKey is replaced.
+func MoveBlob(ctx context.Context, backend Backend, fromKey string, toKey string) error {
+	if mover, ok := backend.(BlobMover); ok {
+		return mover.MoveBlob(ctx, fromKey, toKey)
+	}
+	info, err := backend.Stat(ctx, fromKey)
+	if err != nil {
+		return fmt.Errorf("move blob: %w", err)
+	}
+	reader, err := backend.Get(ctx, fromKey)
+	if err != nil {
+		return fmt.Errorf("move blob: %w", err)
+	}
+	defer func() { _ = reader.Close() }()
+	if err := backend.Put(ctx, toKey, reader, info.Size); err != nil {
+		return fmt.Errorf("move blob: %w", err)
+	}
+	if err := backend.Delete(ctx, fromKey); err != nil {
+		return fmt.Errorf("move blob: %w", err)
+	}
+	return nil
+}
+
+// PutFile stores the local file at path under key. The file is moved if the backend supports it.
+func PutFile(ctx context.Context, backend Backend, key string, path string) error {
+	if mover, ok := backend.(FileMover); ok {
+		return mover.MoveFile(ctx, key, path)
+	}
+	fileHandle, err := os.Open(path)
+	if err != nil {
+		return fmt.Errorf("put file: %w", err)
+	}
+	defer func() { _ = fileHandle.Close() }()
+	info, err := fileHandle.Stat()
+	if err != nil {
+		return fmt.Errorf("put file: %w", err)
+	}
+	return backend.Put(ctx, key, fileHandle, info.Size())
+}
+
+func NewBackend(cfg config.FilesConfig) (Backend, error) {
+	switch cfg.FilesBackend.Type {
+	case config.FilesBackendLocal:
+		return NewLocalBackend(cfg.FilesDir), nil
+	case config.FilesBackendS3:
+		return NewS3Backend(cfg.FilesBackend.S3)
+	default:
+		return nil, fmt.Errorf("files backend %s is not supported by frans", cfg.FilesBackend.Type)
+	}
+}
@@ -0,0 +1,164 @@
+package storage
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"os"
+	"slices"
+	"strings"
+	"testing"
+
+	"codeberg.org/jvllmr/frans/internal/config"
+	"github.com/google/uuid"
+	"github.com/minio/minio-go/v7"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// setupTestBackends returns the backends to test against.
+// The S3 backend is only tested if FRANS_TEST_S3_ENDPOINT is set, e.g. to a local MinIO instance.
+func setupTestBackends(t *testing.T) map[string]Backend {
+	backends := map[string]Backend{
+		config.FilesBackendLocal: NewLocalBackend(t.TempDir()),
+	}
+
+	endpoint := os.Getenv("FRANS_TEST_S3_ENDPOINT")
+	if endpoint == "" {
+		return backends
+	}
+	s3Backend, err := NewS3Backend(config.FilesS3Config{
+		Endpoint:        endpoint,
+		Bucket:          "frans-test",
+		Prefix:          t.Name() + "/",
+		AccessKeyID:     os.Getenv("FRANS_TEST_S3_ACCESS_KEY_ID"),
+		SecretAccessKey: os.Getenv("FRANS_TEST_S3_SECRET_ACCESS_KEY"),
+		Insecure:        true,
+	})
+	require.NoError(t, err)
+	exists, err := s3Backend.client.BucketExists(t.Context(), s3Backend.bucket)
+	require.NoError(t, err)
+	if !exists {
+		err = s3Backend.client.MakeBucket(t.Context(), s3Backend.bucket, minio.MakeBucketOptions{})
+		require.NoError(t, err)
+	}
+	backends[config.FilesBackendS3] = s3Backend
+	return backends
+}
+
+func readAll(t *testing.T, reader io.ReadCloser, err error) string {
+	require.NoError(t, err)
+	defer func() { _ = reader.Close() }()
+	content, err := io.ReadAll(reader)
+	require.NoError(t, err)
+	return string(content)
+}
+
+func TestBackendRoundTrip(t *testing.T) {
+	for name, backend := range setupTestBackends(t) {
+		t.Run(name, func(t *testing.T) {
+			ctx := t.Context()
+			content := "Hello there! General Kenobi!"
+			err := backend.Put(ctx, "abc", bytes.NewBufferString(content), int64(len(content)))
+			require.NoError(t, err)
+
+			info, err := backend.Stat(ctx, "abc")
+			require.NoError(t, err)
+			assert.Equal(t, int64(len(content)), info.Size)
+
+			reader, err := backend.Get(ctx, "abc")
+			assert.Equal(t, content, readAll(t, reader, err))
+
+			reader, err = backend.GetRange(ctx, "abc", 0, 1)
+			assert.Equal(t, "H", readAll(t, reader, err))
+			reader, err = backend.GetRange(ctx, "abc", 6, 5)
+			assert.Equal(t, "there", readAll(t, reader, err))
+			reader, err = backend.GetRange(ctx, "abc", 13, -1)
+			assert.Equal(t, "General Kenobi!", readAll(t, reader, err))
+
+			readSeeker := NewReadSeeker(ctx, backend, "abc", info.Size)
+			_, err = readSeeker.Seek(-7, io.SeekEnd)
+			require.NoError(t, err)
+			assert.Equal(t, "Kenobi!", readAll(t, readSeeker, nil))
+
+			require.NoError(t, backend.Delete(ctx, "abc"))
+			_, err = backend.Stat(ctx, "abc")
+			assert.ErrorIs(t, err, ErrBlobNotFound)
+			_, err = backend.Get(ctx, "abc")
+			assert.ErrorIs(t, err, ErrBlobNotFound)
+		})
+	}
+}
+
+func TestPutFile(t *testing.T) {
+	for name, backend := range setupTestBackends(t) {
+		t.Run(name, func(t *testing.T) {
+			ctx := context.Background()
+			path := t.TempDir() + "/upload"
+			require.NoError(t, os.WriteFile(path, []byte("Hello there!"), 0600))
+
+			require.NoError(t, PutFile(ctx, backend, "def", path))
+			reader, err := backend.Get(ctx, "def")
+			assert.Equal(t, "Hello there!", readAll(t, reader, err))
+			require.NoError(t, backend.Delete(ctx, "def"))
+		})
+	}
+}
+
+func TestList(t *testing.T) {
+	for name, backend := range setupTestBackends(t) {
+		t.Run(name, func(t *testing.T) {
+			ctx := t.Context()
+			blobName := strings.Repeat("ab", 64)
+			blobKeys := []string{
+				blobName,
+				blobName + ".preview",
+				ShardedKey(blobName),
+				ShardedKey(blobName) + ".preview",
+				"uploads/" + uuid.NewString() + "/00000000000000000000",
+			}
+			// not written by frans
+			otherKeys := []string{
+				".gitignore",
+				"abc",
+				"notes/" + blobName,
+				"cd/ef/" + blobName,
+				blobName + ".txt",
+				"uploads/abc/00000000000000000000",
+			}
+			for _, key := range slices.Concat(blobKeys, otherKeys) {
+				require.NoError(t, backend.Put(ctx, key, bytes.NewBufferString(key), int64(len(key))))
+			}
+
+			keys := make([]string, 0)
+			for blobInfo, err := range backend.List(ctx) {
+				require.NoError(t, err)
+				keys = append(keys, blobInfo.Key)
+			}
+			assert.ElementsMatch(t, blobKeys, keys)
+		})
+	}
+}
+
+func TestMoveBlob(t *testing.T) {
+	for name, backend := range setupTestBackends(t) {
+		t.Run(name, func(t *testing.T) {
+			ctx := t.Context()
+			content := "Hello there!"
+			err := backend.Put(ctx, "abcdef", bytes.NewBufferString(content), int64(len(content)))
+			require.NoError(t, err)
+
+			shardedKey := ShardedKey("abcdef")
+			assert.Equal(t, "ab/cd/abcdef", shardedKey)
+			require.NoError(t, MoveBlob(ctx, backend, "abcdef", shardedKey))
+			reader, err := backend.Get(ctx, shardedKey)
+			assert.Equal(t, content, readAll(t, reader, err))
+			_, err = backend.Stat(ctx, "abcdef")
+			assert.ErrorIs(t, err, ErrBlobNotFound)
+
+			err = MoveBlob(ctx, backend, "abcdef", shardedKey)
+			assert.ErrorIs(t, err, ErrBlobNotFound)
+			require.NoError(t, backend.Delete(ctx, shardedKey))
+		})
+	}
+}
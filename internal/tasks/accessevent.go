@@ -0,0 +1,19 @@
+package tasks
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"codeberg.org/jvllmr/frans/internal/services"
+)
+
+// AccessEventRetentionTask deletes access events which were recorded longer than retention ago
+func AccessEventRetentionTask(aes services.AccessEventService, retention time.Duration) {
+	deleted, err := aes.PruneEvents(context.Background(), time.Now().Add(-retention))
+	if err != nil {
+		slog.Error("Could not delete access events", "err", err)
+		return
+	}
+	slog.Info("Deleted access events", "count", deleted)
+}
@@ -0,0 +1,32 @@
+package tasks
+
+import (
+	"testing"
+	"time"
+
+	"codeberg.org/jvllmr/frans/internal/config"
+	"codeberg.org/jvllmr/frans/internal/services"
+	"codeberg.org/jvllmr/frans/internal/testutil"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAccessEventRetentionTask(t *testing.T) {
+	db := testutil.SetupTestDBClient(t)
+	now := time.Now()
+	recentEvent := db.AccessEvent.Create().
+		SetType(config.AccessEventTypeView).
+		SetAddress("127.0.0.1").
+		SetCreatedAt(now.Add(-24 * time.Hour)).
+		SaveX(t.Context())
+	_ = db.AccessEvent.Create().
+		SetType(config.AccessEventTypeDownload).
+		SetAddress("127.0.0.1").
+		SetCreatedAt(now.Add(-72 * time.Hour)).
+		SaveX(t.Context())
+
+	AccessEventRetentionTask(services.NewAccessEventService(db), 48*time.Hour)
+
+	remainingEvents := db.AccessEvent.Query().AllX(t.Context())
+	assert.Equal(t, 1, len(remainingEvents))
+	assert.Equal(t, recentEvent.ID, remainingEvents[0].ID)
+}
@@ -0,0 +1,53 @@
+package tasks
+
+import (
+	"context"
+	"log/slog"
+	"os"
+
+	"codeberg.org/jvllmr/frans/internal/services"
+)
+
+// BackupTask writes a backup to path, which is incremental to the backup at basePath if set.
+// Unlike other tasks, errors are returned so a failed backup can be noticed.
+func BackupTask(fs services.FileService, path string, basePath string) error {
+	archiveFile, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	summary, err := fs.Backup(context.Background(), archiveFile, basePath)
+	if closeErr := archiveFile.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		// an incomplete backup must not be mistaken for a usable one
+		if removeErr := os.Remove(path); removeErr != nil {
+			slog.Warn("Could not remove incomplete backup", "path", path, "err", removeErr)
+		}
+		return err
+	}
+	slog.Info(
+		"Wrote backup",
+		"id",
+		summary.ID,
+		"path",
+		path,
+		"entities",
+		summary.Entities,
+		"blobs",
+		summary.Blobs,
+		"skippedBlobs",
+		summary.SkippedBlobs,
+	)
+	return nil
+}
+
+// RestoreTask restores the backups at paths into an empty instance
+func RestoreTask(fs services.FileService, paths []string) error {
+	summary, err := fs.Restore(context.Background(), paths)
+	if err != nil {
+		return err
+	}
+	slog.Info("Restored backup", "entities", summary.Entities, "blobs", summary.Blobs)
+	return nil
+}
@@ -0,0 +1,118 @@
+package tasks
+
+import (
+	"io"
+	"path/filepath"
+	"testing"
+
+	"codeberg.org/jvllmr/frans/internal/config"
+	"codeberg.org/jvllmr/frans/internal/db"
+	"codeberg.org/jvllmr/frans/internal/ent"
+	"codeberg.org/jvllmr/frans/internal/ent/file"
+	"codeberg.org/jvllmr/frans/internal/services"
+	"codeberg.org/jvllmr/frans/internal/testutil"
+	"codeberg.org/jvllmr/frans/internal/util"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func setupRestoreDBClient(t *testing.T) *ent.Client {
+	dbConfig := config.DBConfig{
+		DBType: "sqlite3",
+		DBHost: filepath.Join(t.TempDir(), "restore.db"),
+	}
+	db.Migrate(dbConfig)
+	client, err := db.NewDBClient(dbConfig)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = client.Close() })
+	return client
+}
+
+func TestBackupRestoreTask(t *testing.T) {
+	cfg := testutil.SetupTestConfig()
+	cfg.FilesDir = t.TempDir()
+	cfg.FilesEncryption.Key = newTestMasterKey(t)
+	sourceDB := testutil.SetupTestDBClient(t)
+	testUser := testutil.SetupTestUser(t, sourceDB, nil)
+	helloFile := testutil.SetupTestFile(
+		t,
+		cfg,
+		sourceDB,
+		"hello.txt",
+		"Hello there!",
+		testUser,
+		"none",
+		0,
+		0,
+		0,
+	)
+	ticketValue := sourceDB.Ticket.Create().
+		SetID(uuid.New()).
+		SetExpiryType("none").
+		SetExpiryDaysSinceLastDownload(0).
+		SetExpiryTotalDays(0).
+		SetExpiryTotalDownloads(0).
+		SetHashedPassword(util.HashPassword("abc123", []byte("salt"))).
+		SetSalt("salt").
+		SetOwner(testUser).
+		AddFiles(helloFile).
+		SaveX(t.Context())
+	sourceFs := services.NewFileService(cfg, sourceDB)
+	backupDir := t.TempDir()
+	fullPath := filepath.Join(backupDir, "full.tar")
+	require.NoError(t, BackupTask(sourceFs, fullPath, ""))
+
+	kenobiFile := testutil.SetupTestFile(
+		t,
+		cfg,
+		sourceDB,
+		"kenobi.txt",
+		"General Kenobi!",
+		testUser,
+		"none",
+		0,
+		0,
+		0,
+	)
+	incrementalPath := filepath.Join(backupDir, "incremental.tar")
+	require.NoError(t, BackupTask(sourceFs, incrementalPath, fullPath))
+
+	restoreCfg := cfg
+	restoreCfg.FilesDir = t.TempDir()
+	restoreCfg.FilesLayout = config.FilesLayoutFlat
+	restoreDB := setupRestoreDBClient(t)
+	restoreFs := services.NewFileService(restoreCfg, restoreDB)
+	// the incremental backup does not contain the blob of hello.txt
+	require.Error(t, RestoreTask(restoreFs, []string{incrementalPath}))
+	require.NoError(t, RestoreTask(restoreFs, []string{fullPath, incrementalPath}))
+
+	restoredTicket := restoreDB.Ticket.Query().WithOwner().WithFiles().OnlyX(t.Context())
+	assert.Equal(t, ticketValue.ID, restoredTicket.ID)
+	assert.Equal(t, ticketValue.HashedPassword, restoredTicket.HashedPassword)
+	assert.Equal(t, testUser.ID, restoredTicket.Edges.Owner.ID)
+	require.Len(t, restoredTicket.Edges.Files, 1)
+	assert.Equal(t, helloFile.ID, restoredTicket.Edges.Files[0].ID)
+	for fileID, content := range map[uuid.UUID]string{
+		helloFile.ID:  "Hello there!",
+		kenobiFile.ID: "General Kenobi!",
+	} {
+		restoredFile := restoreDB.File.Query().
+			Where(file.ID(fileID)).
+			WithData().
+			OnlyX(t.Context())
+		reader, err := restoreFs.OpenFile(t.Context(), restoredFile)
+		require.NoError(t, err)
+		restoredContent, err := io.ReadAll(reader)
+		require.NoError(t, reader.Close())
+		require.NoError(t, err)
+		assert.Equal(t, content, string(restoredContent))
+	}
+
+	// restoring twice would duplicate everything
+	assert.ErrorIs(
+		t,
+		RestoreTask(restoreFs, []string{fullPath, incrementalPath}),
+		services.ErrNotEmpty,
+	)
+}
@@ -0,0 +1,32 @@
+package tasks
+
+import (
+	"context"
+	"log/slog"
+
+	"codeberg.org/jvllmr/frans/internal/ent"
+	"codeberg.org/jvllmr/frans/internal/ent/filedata"
+	"codeberg.org/jvllmr/frans/internal/services"
+)
+
+func RotateKeysTask(db *ent.Client, fs services.FileService) {
+	if !fs.EncryptionEnabled() {
+		slog.Error("Could not rotate keys: no master key configured")
+		return
+	}
+	fileDatas := db.FileData.Query().
+		Where(filedata.KeyIDNotNil()).
+		AllX(context.Background())
+	rotatedCount := 0
+	for _, fileData := range fileDatas {
+		rotated, err := fs.RotateFileDataKey(context.Background(), fileData)
+		if err != nil {
+			slog.Error("Could not rotate data key", "fileData", fileData.ID, "err", err)
+			continue
+		}
+		if rotated {
+			rotatedCount += 1
+		}
+	}
+	slog.Info("Rotated data keys", "count", rotatedCount)
+}
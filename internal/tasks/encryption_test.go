@@ -0,0 +1,60 @@
+package tasks
+
+import (
+	"crypto/rand"
+	"encoding/base64"
+	"io"
+	"testing"
+
+	"codeberg.org/jvllmr/frans/internal/services"
+	"codeberg.org/jvllmr/frans/internal/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestMasterKey(t *testing.T) string {
+	key := make([]byte, 32)
+	_, err := rand.Read(key)
+	require.NoError(t, err)
+	return base64.StdEncoding.EncodeToString(key)
+}
+
+func TestRotateKeysTask(t *testing.T) {
+	cfg := testutil.SetupTestConfig()
+	oldKey := newTestMasterKey(t)
+	cfg.FilesEncryption.Key = oldKey
+	db := testutil.SetupTestDBClient(t)
+	testUser := testutil.SetupTestUser(t, db, nil)
+	testFile := testutil.SetupTestFile(
+		t,
+		cfg,
+		db,
+		"test.txt",
+		"Hello there!",
+		testUser,
+		"single",
+		0,
+		0,
+		1,
+	)
+	oldFileData := db.File.QueryData(testFile).OnlyX(t.Context())
+	require.NotNil(t, oldFileData.KeyID)
+
+	cfg.FilesEncryption.Key = newTestMasterKey(t)
+	cfg.FilesEncryption.OldKeys = []string{oldKey}
+	fs := services.NewFileService(cfg, db)
+	RotateKeysTask(db, fs)
+
+	newFileData := db.FileData.GetX(t.Context(), oldFileData.ID)
+	require.NotNil(t, newFileData.KeyID)
+	assert.NotEqual(t, *oldFileData.KeyID, *newFileData.KeyID)
+
+	cfg.FilesEncryption.OldKeys = nil
+	fs = services.NewFileService(cfg, db)
+	reader, err := fs.OpenFileData(t.Context(), newFileData)
+	require.NoError(t, err)
+	defer func() { _ = reader.Close() }()
+	content, err := io.ReadAll(reader)
+	require.NoError(t, err)
+	assert.Equal(t, "Hello there!", string(content))
+}
@@ -7,7 +7,6 @@ import (
 	"codeberg.org/jvllmr/frans/internal/ent"
 	"codeberg.org/jvllmr/frans/internal/ent/file"
 	"codeberg.org/jvllmr/frans/internal/services"
-	"codeberg.org/jvllmr/frans/internal/util"
 )
 
 func FileLifecycleTask(db *ent.Client, fs services.FileService) {
@@ -18,47 +17,18 @@ func FileLifecycleTask(db *ent.Client, fs services.FileService) {
 		WithGrant().WithTicket().
 		AllX(context.Background())
 	deletedCount := 0
-	var users []*ent.User
 	for _, fileValue := range files {
 		if fs.ShouldDeleteFile(fileValue) {
-			fileOwner := fileValue.Edges.Owner
-			err := fs.DeleteFile(context.Background(), fileValue)
+			err := fs.DeleteSingleFile(context.Background(), fileValue)
 			if err != nil {
-				filePath := fs.FilesFilePath(fileValue.Edges.Data.ID)
-				slog.Error("Could not delete file", "file", filePath, "err", err)
+				blobKey := fs.BlobKey(fileValue.Edges.Data.ID)
+				slog.Error("Could not delete file", "file", blobKey, "err", err)
 				continue
 			}
 			deletedCount += 1
-			users = append(users, fileOwner)
 
 		}
 
 	}
 	slog.Info("Deleted files", "count", deletedCount)
-	tx, err := db.Tx(context.Background())
-	if err != nil {
-		slog.Error("Could not update users data size", "err", err)
-		return
-	}
-	for _, u := range users {
-		err := util.RefreshUserTotalDataSize(context.Background(), u, tx)
-		if err != nil {
-			slog.Error(
-				"Could not refresh total data size of user",
-				"err",
-				err,
-				"username",
-				u.Username,
-			)
-		}
-	}
-	if err := tx.Commit(); err != nil {
-		slog.Error("Could not commit users data size updates", "err", err)
-		return
-	}
-	slog.Info(
-		"Refreshed totalDataSize field for all users affected by file deletions",
-		"count",
-		len(users),
-	)
 }
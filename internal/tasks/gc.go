@@ -0,0 +1,29 @@
+package tasks
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"codeberg.org/jvllmr/frans/internal/services"
+)
+
+func GarbageCollectionTask(fs services.FileService, gracePeriod time.Duration, dryRun bool) {
+	gc, err := fs.CollectGarbage(context.Background(), gracePeriod, dryRun)
+	if err != nil {
+		slog.Error("Could not collect garbage", "err", err)
+	}
+	slog.Info(
+		"Collected garbage",
+		"fileData",
+		len(gc.FileData),
+		"blobs",
+		len(gc.Blobs),
+		"tmpFiles",
+		len(gc.TmpFiles),
+		"freedBytes",
+		gc.FreedSize,
+		"dryRun",
+		dryRun,
+	)
+}
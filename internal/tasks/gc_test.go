@@ -0,0 +1,90 @@
+package tasks
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"codeberg.org/jvllmr/frans/internal/services"
+	"codeberg.org/jvllmr/frans/internal/testutil"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGarbageCollectionTask(t *testing.T) {
+	cfg := testutil.SetupTestConfig()
+	cfg.FilesDir = t.TempDir()
+	db := testutil.SetupTestDBClient(t)
+	testUser := testutil.SetupTestUser(t, db, nil)
+	fs := services.NewFileService(cfg, db)
+	fs.EnsureFilesTmpPath()
+	past := time.Now().Add(-48 * time.Hour)
+
+	usedFile := testutil.SetupTestFile(
+		t, cfg, db, "used.txt", "Hello there!", testUser, "auto", 0, 0, 0,
+	)
+	usedBlob := filepath.Join(cfg.FilesDir, fs.BlobKey(db.File.QueryData(usedFile).OnlyX(t.Context()).ID))
+	unusedFile := testutil.SetupTestFile(
+		t, cfg, db, "unused.txt", "General Kenobi!", testUser, "auto", 0, 0, 0,
+	)
+	unusedFileData := db.File.QueryData(unusedFile).OnlyX(t.Context())
+	unusedBlob := filepath.Join(cfg.FilesDir, fs.BlobKey(unusedFileData.ID))
+	db.File.DeleteOne(unusedFile).ExecX(t.Context())
+
+	us := services.NewUploadService(cfg, db)
+	activeUpload, err := us.CreateUpload(t.Context(), "active.txt", 10, testUser, nil)
+	require.NoError(t, err)
+	_, err = us.WriteUpload(t.Context(), activeUpload, 0, strings.NewReader("Hello"))
+	require.NoError(t, err)
+	activeChunks, err := filepath.Glob(
+		filepath.Join(cfg.FilesDir, "uploads", activeUpload.ID.String(), "*"),
+	)
+	require.NoError(t, err)
+	require.Len(t, activeChunks, 1)
+	activeChunk := activeChunks[0]
+	orphanedChunk := filepath.Join(
+		cfg.FilesDir,
+		"uploads",
+		uuid.New().String(),
+		filepath.Base(activeChunk),
+	)
+	require.NoError(t, os.MkdirAll(filepath.Dir(orphanedChunk), 0755))
+
+	orphanedBlob := filepath.Join(cfg.FilesDir, strings.Repeat("ab", 64))
+	recentBlob := filepath.Join(cfg.FilesDir, strings.Repeat("cd", 64))
+	// files which were not written by frans are never collected
+	otherFile := filepath.Join(cfg.FilesDir, ".gitignore")
+	oldTmpFile := filepath.Join(fs.FilesTmpPath(), "old")
+	recentTmpFile := filepath.Join(fs.FilesTmpPath(), "recent")
+	for _, path := range []string{
+		orphanedBlob, recentBlob, oldTmpFile, recentTmpFile, orphanedChunk, otherFile,
+	} {
+		require.NoError(t, os.WriteFile(path, []byte("?"), 0644))
+	}
+	for _, path := range []string{
+		usedBlob, unusedBlob, orphanedBlob, oldTmpFile, activeChunk, orphanedChunk, otherFile,
+	} {
+		require.NoError(t, os.Chtimes(path, past, past))
+	}
+
+	GarbageCollectionTask(fs, 24*time.Hour, true)
+	for _, path := range []string{usedBlob, unusedBlob, orphanedBlob, oldTmpFile, recentTmpFile} {
+		assert.FileExists(t, path)
+	}
+	assert.True(t, db.FileData.Query().ExistX(t.Context()))
+
+	GarbageCollectionTask(fs, 24*time.Hour, false)
+	// chunks of uploads are kept as long as the upload exists
+	for _, path := range []string{usedBlob, recentBlob, recentTmpFile, activeChunk, otherFile} {
+		assert.FileExists(t, path)
+	}
+	for _, path := range []string{unusedBlob, orphanedBlob, oldTmpFile, orphanedChunk} {
+		assert.NoFileExists(t, path)
+	}
+	assert.Equal(t, 1, db.FileData.Query().CountX(t.Context()))
+	_, err = db.FileData.Get(t.Context(), unusedFileData.ID)
+	assert.Error(t, err)
+}
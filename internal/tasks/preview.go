@@ -0,0 +1,22 @@
+package tasks
+
+import (
+	"context"
+	"log/slog"
+
+	"codeberg.org/jvllmr/frans/internal/services"
+)
+
+func GeneratePreviewsTask(ps services.PreviewService) {
+	generation, err := ps.GeneratePendingPreviews(context.Background())
+	if err != nil {
+		slog.Error("Could not generate previews", "err", err)
+	}
+	slog.Info(
+		"Generated previews",
+		"generated",
+		generation.Generated,
+		"unavailable",
+		generation.Unavailable,
+	)
+}
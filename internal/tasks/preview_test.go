@@ -0,0 +1,102 @@
+package tasks
+
+import (
+	"bytes"
+	"image"
+	"image/color"
+	"image/png"
+	"testing"
+
+	"codeberg.org/jvllmr/frans/internal/config"
+	"codeberg.org/jvllmr/frans/internal/ent"
+	"codeberg.org/jvllmr/frans/internal/ent/file"
+	"codeberg.org/jvllmr/frans/internal/ent/filedata"
+	"codeberg.org/jvllmr/frans/internal/services"
+	"codeberg.org/jvllmr/frans/internal/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createTestPNG(t *testing.T, width int, height int) string {
+	img := image.NewRGBA(image.Rect(0, 0, width, height))
+	for x := range width {
+		for y := range height {
+			img.Set(x, y, color.RGBA{R: uint8(x), G: uint8(y), B: 128, A: 255})
+		}
+	}
+	var buffer bytes.Buffer
+	require.NoError(t, png.Encode(&buffer, img))
+	return buffer.String()
+}
+
+func TestGeneratePreviewsTask(t *testing.T) {
+	for name, encrypted := range map[string]bool{"plain": false, "encrypted": true} {
+		t.Run(name, func(t *testing.T) {
+			cfg := testutil.SetupTestConfig()
+			cfg.FilesDir = t.TempDir()
+			cfg.FilesPreviews.Enabled = true
+			cfg.FilesPreviews.MaxDimension = 32
+			if encrypted {
+				cfg.FilesEncryption.Key = newTestMasterKey(t)
+			}
+			db := testutil.SetupTestDBClient(t)
+			testUser := testutil.SetupTestUser(t, db, nil)
+			setupFile := func(name string, content string) *ent.FileData {
+				fileValue := testutil.SetupTestFile(
+					t,
+					cfg,
+					db,
+					name,
+					content,
+					testUser,
+					"none",
+					0,
+					0,
+					0,
+				)
+				return db.File.QueryData(fileValue).OnlyX(t.Context())
+			}
+			imageData := setupFile("image.png", createTestPNG(t, 100, 50))
+			textData := setupFile("notes.txt", "Hello there!")
+			binaryData := setupFile("data.bin", "\x00\x01\x02\x03")
+			pendingData := setupFile("pending.txt", "General Kenobi!")
+			db.File.Update().
+				Where(file.HasDataWith(filedata.ID(pendingData.ID))).
+				SetScanStatus(config.FileScanStatusPending).
+				ExecX(t.Context())
+
+			fs := services.NewFileService(cfg, db)
+			GeneratePreviewsTask(services.NewPreviewService(cfg, db))
+
+			imageData = db.FileData.GetX(t.Context(), imageData.ID)
+			assert.Equal(t, config.FilePreviewStatusReady, imageData.PreviewStatus)
+			assert.Equal(t, "image/png", *imageData.PreviewType)
+			content, err := fs.OpenPreview(t.Context(), imageData)
+			require.NoError(t, err)
+			preview, err := png.Decode(bytes.NewReader(content))
+			require.NoError(t, err)
+			assert.Equal(t, image.Rect(0, 0, 32, 16), preview.Bounds())
+
+			textData = db.FileData.GetX(t.Context(), textData.ID)
+			assert.Equal(t, config.FilePreviewStatusReady, textData.PreviewStatus)
+			assert.Equal(t, "text/plain; charset=utf-8", *textData.PreviewType)
+			content, err = fs.OpenPreview(t.Context(), textData)
+			require.NoError(t, err)
+			assert.Equal(t, "Hello there!", string(content))
+
+			binaryData = db.FileData.GetX(t.Context(), binaryData.ID)
+			assert.Equal(t, config.FilePreviewStatusUnavailable, binaryData.PreviewStatus)
+			_, err = fs.OpenPreview(t.Context(), binaryData)
+			assert.ErrorIs(t, err, services.ErrPreviewNotFound)
+
+			// content of files which were not scanned yet is not touched
+			pendingData = db.FileData.GetX(t.Context(), pendingData.ID)
+			assert.Equal(t, config.FilePreviewStatusPending, pendingData.PreviewStatus)
+
+			// previews belong to their FileData and are not garbage
+			gc, err := fs.CollectGarbage(t.Context(), 0, true)
+			require.NoError(t, err)
+			assert.Empty(t, gc.Blobs)
+		})
+	}
+}
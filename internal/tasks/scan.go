@@ -0,0 +1,24 @@
+package tasks
+
+import (
+	"context"
+	"log/slog"
+
+	"codeberg.org/jvllmr/frans/internal/services"
+)
+
+func ScanFilesTask(ss services.ScanService) {
+	scan, err := ss.ScanPendingFiles(context.Background())
+	if err != nil {
+		slog.Error("Could not scan files", "err", err)
+	}
+	slog.Info(
+		"Scanned files",
+		"clean",
+		scan.Clean,
+		"infected",
+		scan.Infected,
+		"failed",
+		scan.Failed,
+	)
+}
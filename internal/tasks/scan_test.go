@@ -0,0 +1,102 @@
+package tasks
+
+import (
+	"testing"
+
+	"codeberg.org/jvllmr/frans/internal/config"
+	"codeberg.org/jvllmr/frans/internal/ent"
+	"codeberg.org/jvllmr/frans/internal/services"
+	"codeberg.org/jvllmr/frans/internal/testutil"
+	"github.com/stretchr/testify/assert"
+)
+
+type testInfectedFileNotifier struct {
+	notifications map[string]string
+}
+
+func (n *testInfectedFileNotifier) SendInfectedFileNotification(
+	to string,
+	fileValue *ent.File,
+	signature string,
+) error {
+	n.notifications[to] = fileValue.Name + ": " + signature
+	return nil
+}
+
+func TestScanFilesTask(t *testing.T) {
+	cfg := testutil.SetupTestConfig()
+	cfg.FilesDir = t.TempDir()
+	cfg.FilesAntivirus.Enabled = true
+	cfg.FilesAntivirus.Address = testutil.StartFakeClamd(t)
+	db := testutil.SetupTestDBClient(t)
+	testUser := testutil.SetupTestUser(t, db, nil)
+	testutil.SetupTestAdminUser(t, db, nil)
+	notifier := &testInfectedFileNotifier{notifications: make(map[string]string)}
+	ss := services.NewScanService(cfg, db, notifier)
+
+	cleanFile := testutil.SetupTestFile(
+		t,
+		cfg,
+		db,
+		"clean.txt",
+		"Hello there!",
+		testUser,
+		"auto",
+		0,
+		0,
+		0,
+	)
+	infectedFile := testutil.SetupTestFile(
+		t,
+		cfg,
+		db,
+		"infected.txt",
+		"General "+testutil.TestMalwareMarker,
+		testUser,
+		"auto",
+		0,
+		0,
+		0,
+	)
+	assert.Equal(t, config.FileScanStatusPending, cleanFile.ScanStatus)
+	assert.Equal(t, config.FileScanStatusPending, infectedFile.ScanStatus)
+
+	ScanFilesTask(ss)
+	cleanFile = db.File.GetX(t.Context(), cleanFile.ID)
+	assert.Equal(t, config.FileScanStatusClean, cleanFile.ScanStatus)
+	assert.Nil(t, cleanFile.ScanSignature)
+	infectedFile = db.File.GetX(t.Context(), infectedFile.ID)
+	assert.Equal(t, config.FileScanStatusInfected, infectedFile.ScanStatus)
+	assert.Equal(t, testutil.TestMalwareSignature, *infectedFile.ScanSignature)
+	assert.Equal(t, map[string]string{
+		"testuser@vllmr.dev":  "infected.txt: " + testutil.TestMalwareSignature,
+		"testadmin@vllmr.dev": "infected.txt: " + testutil.TestMalwareSignature,
+	}, notifier.notifications)
+}
+
+func TestScanFilesTaskUnavailable(t *testing.T) {
+	cfg := testutil.SetupTestConfig()
+	cfg.FilesDir = t.TempDir()
+	cfg.FilesAntivirus.Enabled = true
+	// nothing listens on the discard port
+	cfg.FilesAntivirus.Address = "tcp://127.0.0.1:9"
+	db := testutil.SetupTestDBClient(t)
+	testUser := testutil.SetupTestUser(t, db, nil)
+	testFile := testutil.SetupTestFile(
+		t,
+		cfg,
+		db,
+		"test.txt",
+		"Hello there!",
+		testUser,
+		"auto",
+		0,
+		0,
+		0,
+	)
+
+	notifier := &testInfectedFileNotifier{notifications: make(map[string]string)}
+	ScanFilesTask(services.NewScanService(cfg, db, notifier))
+	testFile = db.File.GetX(t.Context(), testFile.ID)
+	assert.Equal(t, config.FileScanStatusPending, testFile.ScanStatus)
+}
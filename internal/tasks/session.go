@@ -5,7 +5,9 @@ import (
 	"log/slog"
 	"time"
 
+	"codeberg.org/jvllmr/frans/internal/config"
 	"codeberg.org/jvllmr/frans/internal/ent"
+	"codeberg.org/jvllmr/frans/internal/ent/downloadprogress"
 	"codeberg.org/jvllmr/frans/internal/ent/session"
 	"codeberg.org/jvllmr/frans/internal/ent/shareaccesstoken"
 )
@@ -17,6 +19,12 @@ func SessionLifecycleTask(db *ent.Client) {
 		Where(shareaccesstoken.ExpiryLT(now)).
 		ExecX(context.Background())
 	slog.Info("Deleted shared access tokens", "count", deletedTokens)
+	deletedDownloadProgresses := db.DownloadProgress.Delete().
+		Where(downloadprogress.UpdatedAtLT(
+			now.Add(-config.DownloadProgressExpiryHours * time.Hour),
+		)).
+		ExecX(context.Background())
+	slog.Info("Deleted download progresses", "count", deletedDownloadProgresses)
 	deletedSessions := db.Session.Delete().
 		Where(session.ExpireLT(now.Add(-1 * time.Hour))).
 		ExecX(context.Background())
@@ -29,6 +29,28 @@ func TestSessionLifecycleTask(t *testing.T) {
 		SetRefreshToken("dummy_refresh").
 		SetExpire(now.Add(-time.Hour)).
 		SaveX(t.Context())
+	testUser := testutil.SetupTestUser(t, db, nil)
+	testFile := testutil.SetupTestFile(
+		t,
+		testutil.SetupTestConfig(),
+		db,
+		"test.txt",
+		"Hello there!",
+		testUser,
+		"auto",
+		0,
+		0,
+		0,
+	)
+	downloadProgress := db.DownloadProgress.Create().
+		SetToken("not_expired").
+		SetFile(testFile).
+		SaveX(t.Context())
+	_ = db.DownloadProgress.Create().
+		SetToken("expired").
+		SetFile(testFile).
+		SetUpdatedAt(now.Add(-48 * time.Hour)).
+		SaveX(t.Context())
 	SessionLifecycleTask(db)
 
 	remainingTokens := db.ShareAccessToken.Query().AllX(t.Context())
@@ -38,4 +60,8 @@ func TestSessionLifecycleTask(t *testing.T) {
 	remainingSessions := db.Session.Query().AllX(t.Context())
 	assert.Equal(t, 1, len(remainingSessions))
 	assert.Equal(t, session.ID, remainingSessions[0].ID)
+
+	remainingDownloadProgresses := db.DownloadProgress.Query().AllX(t.Context())
+	assert.Equal(t, 1, len(remainingDownloadProgresses))
+	assert.Equal(t, downloadProgress.ID, remainingDownloadProgresses[0].ID)
 }
@@ -0,0 +1,38 @@
+package tasks
+
+import (
+	"context"
+	"log/slog"
+
+	"codeberg.org/jvllmr/frans/internal/services"
+)
+
+func VerifyStorageTask(fs services.FileService) {
+	verification, err := fs.VerifyStorage(context.Background())
+	if err != nil {
+		slog.Error("Could not verify storage", "err", err)
+		return
+	}
+	if len(verification.Issues) > 0 {
+		slog.Warn(
+			"Storage verification found issues",
+			"checked",
+			verification.CheckedBlobs,
+			"issues",
+			len(verification.Issues),
+		)
+		return
+	}
+	slog.Info("Verified storage", "checked", verification.CheckedBlobs)
+}
+
+func MigrateStorageTask(fs services.FileService) {
+	migration, err := fs.MigrateStorage(context.Background())
+	if err != nil {
+		slog.Error("Could not migrate storage", "err", err)
+		if migration == nil {
+			return
+		}
+	}
+	slog.Info("Migrated storage", "checked", migration.Checked, "moved", len(migration.Moved))
+}
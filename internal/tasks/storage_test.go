@@ -0,0 +1,133 @@
+package tasks
+
+import (
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"codeberg.org/jvllmr/frans/internal/config"
+	"codeberg.org/jvllmr/frans/internal/services"
+	"codeberg.org/jvllmr/frans/internal/storage"
+	"codeberg.org/jvllmr/frans/internal/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVerifyStorageTask(t *testing.T) {
+	cfg := testutil.SetupTestConfig()
+	cfg.FilesDir = t.TempDir()
+	db := testutil.SetupTestDBClient(t)
+	testUser := testutil.SetupTestUser(t, db, nil)
+	fs := services.NewFileService(cfg, db)
+	fileDatas := make(map[string]string)
+	for _, content := range []string{"Hello there!", "General Kenobi!", "You are a bold one."} {
+		testFile := testutil.SetupTestFile(
+			t,
+			cfg,
+			db,
+			"test.txt",
+			content,
+			testUser,
+			"auto",
+			0,
+			0,
+			0,
+		)
+		fileDatas[content] = db.File.QueryData(testFile).OnlyX(t.Context()).ID
+	}
+
+	VerifyStorageTask(fs)
+	verification, err := fs.LatestStorageVerification(t.Context())
+	require.NoError(t, err)
+	assert.Equal(t, int64(3), verification.CheckedBlobs)
+	assert.Empty(t, verification.Issues)
+
+	tamperedKey := fs.BlobKey(fileDatas["General Kenobi!"])
+	err = os.WriteFile(filepath.Join(cfg.FilesDir, tamperedKey), []byte("General Grievous"), 0644)
+	require.NoError(t, err)
+	missingKey := fs.BlobKey(fileDatas["You are a bold one."])
+	require.NoError(t, os.Remove(filepath.Join(cfg.FilesDir, missingKey)))
+	orphanedKey := strings.Repeat("ab", 64)
+	require.NoError(t, os.WriteFile(filepath.Join(cfg.FilesDir, orphanedKey), []byte("?"), 0644))
+	otherFile := filepath.Join(cfg.FilesDir, ".gitignore")
+	require.NoError(t, os.WriteFile(otherFile, []byte("?"), 0644))
+	// chunks of uploads are no orphans as long as the upload exists
+	us := services.NewUploadService(cfg, db)
+	activeUpload, err := us.CreateUpload(t.Context(), "active.txt", 10, testUser, nil)
+	require.NoError(t, err)
+	_, err = us.WriteUpload(t.Context(), activeUpload, 0, strings.NewReader("Hello"))
+	require.NoError(t, err)
+
+	VerifyStorageTask(fs)
+	verification, err = fs.LatestStorageVerification(t.Context())
+	require.NoError(t, err)
+	issueKinds := make(map[string]string)
+	for _, issue := range verification.Issues {
+		issueKinds[issue.Key] = issue.Kind
+	}
+	assert.Equal(t, map[string]string{
+		tamperedKey: storage.IssueMismatch,
+		missingKey:  storage.IssueMissing,
+		orphanedKey: storage.IssueOrphaned,
+	}, issueKinds)
+}
+
+func TestMigrateStorageTask(t *testing.T) {
+	cfg := testutil.SetupTestConfig()
+	cfg.FilesDir = t.TempDir()
+	cfg.FilesLayout = config.FilesLayoutFlat
+	db := testutil.SetupTestDBClient(t)
+	testUser := testutil.SetupTestUser(t, db, nil)
+	fileDataIDs := make(map[string]string)
+	for _, content := range []string{"Hello there!", "General Kenobi!"} {
+		testFile := testutil.SetupTestFile(
+			t,
+			cfg,
+			db,
+			"test.txt",
+			content,
+			testUser,
+			"auto",
+			0,
+			0,
+			0,
+		)
+		fileDataIDs[content] = db.File.QueryData(testFile).OnlyX(t.Context()).ID
+	}
+	// simulates a migration which was interrupted after copying the blob
+	interruptedBlob := filepath.Join(cfg.FilesDir, fileDataIDs["General Kenobi!"])
+	copiedBlob := filepath.Join(cfg.FilesDir, storage.ShardedKey(fileDataIDs["General Kenobi!"]))
+	require.NoError(t, os.MkdirAll(filepath.Dir(copiedBlob), 0775))
+	require.NoError(t, os.WriteFile(copiedBlob, []byte("General Kenobi!"), 0644))
+
+	cfg.FilesLayout = config.FilesLayoutSharded
+	fs := services.NewFileService(cfg, db)
+	// files are found before they were migrated
+	for content, fileDataID := range fileDataIDs {
+		reader, err := fs.OpenFileData(t.Context(), db.FileData.GetX(t.Context(), fileDataID))
+		require.NoError(t, err)
+		storedContent, err := io.ReadAll(reader)
+		require.NoError(t, reader.Close())
+		require.NoError(t, err)
+		assert.Equal(t, content, string(storedContent))
+	}
+
+	for range 2 {
+		MigrateStorageTask(fs)
+		for content, fileDataID := range fileDataIDs {
+			assert.NoFileExists(t, filepath.Join(cfg.FilesDir, fileDataID))
+			storedContent, err := os.ReadFile(filepath.Join(cfg.FilesDir, fs.BlobKey(fileDataID)))
+			require.NoError(t, err)
+			assert.Equal(t, content, string(storedContent))
+		}
+	}
+	assert.NoFileExists(t, interruptedBlob)
+
+	VerifyStorageTask(fs)
+	verification, err := fs.LatestStorageVerification(t.Context())
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), verification.CheckedBlobs)
+	assert.Empty(t, verification.Issues)
+}
@@ -0,0 +1,29 @@
+package tasks
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"codeberg.org/jvllmr/frans/internal/ent"
+	"codeberg.org/jvllmr/frans/internal/ent/upload"
+	"codeberg.org/jvllmr/frans/internal/services"
+)
+
+func UploadLifecycleTask(db *ent.Client, us services.UploadService) {
+	uploads := db.Upload.Query().
+		Where(upload.ExpiryLT(time.Now())).
+		AllX(context.Background())
+	deletedCount := 0
+	for _, uploadValue := range uploads {
+		if !us.ShouldDeleteUpload(uploadValue) {
+			continue
+		}
+		if err := us.DeleteUpload(context.Background(), uploadValue); err != nil {
+			slog.Error("Could not delete upload", "upload", uploadValue.ID, "err", err)
+			continue
+		}
+		deletedCount += 1
+	}
+	slog.Info("Deleted expired uploads", "count", deletedCount)
+}
@@ -0,0 +1,32 @@
+package tasks
+
+import (
+	"testing"
+	"time"
+
+	"codeberg.org/jvllmr/frans/internal/services"
+	"codeberg.org/jvllmr/frans/internal/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUploadLifecycleTask(t *testing.T) {
+	cfg := testutil.SetupTestConfig()
+	db := testutil.SetupTestDBClient(t)
+	us := services.NewUploadService(cfg, db)
+	testUser := testutil.SetupTestUser(t, db, nil)
+
+	activeUpload, err := us.CreateUpload(t.Context(), "active.txt", 10, testUser, nil)
+	require.NoError(t, err)
+	expiredUpload, err := us.CreateUpload(t.Context(), "expired.txt", 10, testUser, nil)
+	require.NoError(t, err)
+	db.Upload.UpdateOne(expiredUpload).
+		SetExpiry(time.Now().Add(-time.Minute)).
+		ExecX(t.Context())
+
+	UploadLifecycleTask(db, us)
+
+	remainingUploads := db.Upload.Query().AllX(t.Context())
+	assert.Equal(t, 1, len(remainingUploads))
+	assert.Equal(t, activeUpload.ID, remainingUploads[0].ID)
+}
@@ -0,0 +1,71 @@
+package testutil
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"io"
+	"net"
+	"testing"
+)
+
+// TestMalwareMarker makes the fake clamd report content as infected
+const TestMalwareMarker = "FRANS-TEST-MALWARE"
+
+const TestMalwareSignature = "Frans.Test.Malware"
+
+func serveFakeClamdConn(conn net.Conn) {
+	defer func() { _ = conn.Close() }()
+	reader := bufio.NewReader(conn)
+	command, err := reader.ReadString(0)
+	if err != nil {
+		return
+	}
+	if command != "zINSTREAM\x00" {
+		_, _ = conn.Write([]byte("UNKNOWN COMMAND\x00"))
+		return
+	}
+	var content bytes.Buffer
+	for {
+		var size uint32
+		if err := binary.Read(reader, binary.BigEndian, &size); err != nil {
+			return
+		}
+		if size == 0 {
+			break
+		}
+		if _, err := io.CopyN(&content, reader, int64(size)); err != nil {
+			return
+		}
+	}
+	reply := "stream: OK\x00"
+	if bytes.Contains(content.Bytes(), []byte(TestMalwareMarker)) {
+		reply = "stream: " + TestMalwareSignature + " FOUND\x00"
+	}
+	_, _ = conn.Write([]byte(reply))
+}
+
+// StartFakeClamd starts a server which answers INSTREAM commands like clamd.
+// Content containing TestMalwareMarker is reported as infected.
+// It returns the address of the server.
+func StartFakeClamd(t *testing.T) string {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("could not start fake clamd: %v", err)
+	}
+	t.Cleanup(func() { _ = listener.Close() })
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if errors.Is(err, net.ErrClosed) {
+				return
+			}
+			if err != nil {
+				continue
+			}
+			go serveFakeClamdConn(conn)
+		}
+	}()
+	return "tcp://" + listener.Addr().String()
+}
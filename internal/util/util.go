@@ -93,6 +93,13 @@ func VerifyPassword(password string, hashedPassword string, salt string) bool {
 	return compareStringsTimingSafe(HashPassword(password, decodedSalt), hashedPassword)
 }
 
+// HashToken hashes a random token, so it can be stored and looked up without knowing it.
+// Unlike passwords, random tokens need no salt.
+func HashToken(token string) string {
+	hashedToken := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(hashedToken[:])
+}
+
 func GinAbortWithError(ctx context.Context, c *gin.Context, code int, err error) {
 	span := trace.SpanFromContext(ctx)
 	span.RecordError(err)
@@ -102,6 +109,17 @@ func GinAbortWithError(ctx context.Context, c *gin.Context, code int, err error)
 	slog.ErrorContext(ctx, "route resulted in error", "err", err)
 }
 
+// GinAbortWithPublicError works like GinAbortWithError, but also sends the error message
+// to the client. Only use it for errors which are meant to be read by users.
+func GinAbortWithPublicError(ctx context.Context, c *gin.Context, code int, err error) {
+	span := trace.SpanFromContext(ctx)
+	span.RecordError(err)
+	span.SetStatus(codes.Error, "route logic expected error")
+	_ = c.Error(err)
+	c.AbortWithStatusJSON(code, gin.H{"error": err.Error()})
+	slog.ErrorContext(ctx, "route resulted in error", "err", err)
+}
+
 func MapToHTMLAttributes(attrs map[string]string) string {
 	var parts []string
 	for k, v := range attrs {